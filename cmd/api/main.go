@@ -13,6 +13,7 @@ import (
 	"back/internal/database"
 	_ "back/internal/docs"
 	"back/internal/server"
+	"back/internal/services"
 )
 
 // @title Reloj Control API
@@ -68,6 +69,19 @@ func main() {
 		log.Fatal("Error creando esquema (migración Ent):", err)
 	}
 
+	// roles de sistema (admin, supervisor, user, device)
+	if err := services.NewRoleService(client).EnsureDefaults(context.Background()); err != nil {
+		log.Fatal("Error creando roles por defecto:", err)
+	}
+
+	// marcaciones antiguas con el código de acceso en claro
+	if err := services.MaskLegacyAccessCodePayloads(context.Background(), db); err != nil {
+		log.Fatal("Error enmascarando códigos de acceso en marcaciones:", err)
+	}
+
+	// audit log de mutaciones administrativas (usuarios, sucursales, turnos, ...)
+	client.Use(services.AuditHook())
+
 	srv := server.New(cfg, client, db)
 
 	log.Println("Server escuchando en puerto:", cfg.Port)
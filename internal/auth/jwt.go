@@ -15,23 +15,53 @@ var ErrInvalidToken = errors.New("invalid token")
 type Claims struct {
 	Username string `json:"username"`
 	Role     string `json:"role"`
+
+	// Permisos del rol al emitir el token (ver permissions.go). Sin omitempty: un rol
+	// sin permisos viaja como [] y solo los tokens anteriores a los permisos no traen la
+	// clave (nil).
+	Permissions []string `json:"perms"`
+
+	// Solo para tokens de dispositivo: entrada y sentido del lector
+	AccessPointID int    `json:"access_point_id,omitempty"`
+	Direction     string `json:"direction,omitempty"`
+
 	jwt.RegisteredClaims
 }
 
-func GenerateAccessToken(cfg *config.Config, userID int, username, role string) (string, time.Time, error) {
+func GenerateAccessToken(cfg *config.Config, userID int, username, role string, perms []string) (string, time.Time, error) {
+	return signAccessToken(cfg, Claims{
+		Username:    username,
+		Role:        role,
+		Permissions: perms,
+	}, userID)
+}
+
+// GenerateDeviceAccessToken emite el token de un dispositivo incluyendo su access point y dirección.
+func GenerateDeviceAccessToken(cfg *config.Config, deviceID int, username, role string, perms []string, accessPointID int, direction string) (string, time.Time, error) {
+	return signAccessToken(cfg, Claims{
+		Username:      username,
+		Role:          role,
+		Permissions:   perms,
+		AccessPointID: accessPointID,
+		Direction:     direction,
+	}, deviceID)
+}
+
+func signAccessToken(cfg *config.Config, claims Claims, subjectID int) (string, time.Time, error) {
+	// nunca nil: nil en el token significa "anterior a los permisos" y recibe los del rol por defecto
+	if claims.Permissions == nil {
+		claims.Permissions = []string{}
+	}
+
 	now := time.Now()
 	exp := now.Add(time.Duration(cfg.JWT.AccessTTLMinutes) * time.Minute)
 
-	claims := Claims{
-		Username: username,
-		Role:     role,
-		RegisteredClaims: jwt.RegisteredClaims{
-			Subject:   fmt.Sprintf("%d", userID),
-			Issuer:    cfg.JWT.Issuer,
-			Audience:  jwt.ClaimStrings(cfg.JWT.Audience),
-			IssuedAt:  jwt.NewNumericDate(now),
-			ExpiresAt: jwt.NewNumericDate(exp),
-		},
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		Subject:   fmt.Sprintf("%d", subjectID),
+		Issuer:    cfg.JWT.Issuer,
+		Audience:  jwt.ClaimStrings(cfg.JWT.Audience),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(exp),
 	}
 
 	tkn := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
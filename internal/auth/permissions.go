@@ -0,0 +1,137 @@
+package auth
+
+// Catálogo de permisos. Los roles (tabla roles) agrupan permisos y el access token
+// lleva los permisos del rol del usuario al momento de emitirse.
+const (
+	PermSelf = "self" // endpoints propios: /me, sesiones, solicitudes y saldos propios
+
+	PermUsersRead  = "users:read"
+	PermUsersWrite = "users:write"
+
+	PermBranchesRead   = "branches:read"
+	PermBranchesManage = "branches:manage" // sucursales y accesos
+	PermBranchesAll    = "branches:all"    // alcance sobre todas las sucursales
+	PermDevicesManage  = "devices:manage"
+
+	PermShiftsRead      = "shifts:read"
+	PermShiftsManage    = "shifts:manage"    // definición de turnos y sus días
+	PermSchedulesManage = "schedules:manage" // asignaciones de turno y cambios de jornada
+	PermCalendarRead    = "calendar:read"    // calendario de otros usuarios y sucursales
+	PermHolidaysManage  = "holidays:manage"
+
+	PermMarkingsRead = "markings:read"
+	PermMarkingsEdit = "markings:edit"
+	PermAttendance   = "attendance:punch" // marcación desde dispositivos
+
+	PermDashboardRead = "dashboard:read"
+	PermReportsExport = "reports:export"
+	PermPayrollManage = "payroll:manage"
+
+	PermLeaveReview    = "leave:review"
+	PermLeaveManage    = "leave:manage" // crear/cancelar permisos de otros
+	PermOvertimeReview = "overtime:review"
+	PermOvertimeManage = "overtime:manage" // cancelar autorizaciones de otros
+	PermVacationManage = "vacation:manage"
+	PermHourBankManage = "hourbank:manage"
+
+	PermRolesManage = "roles:manage"
+	PermAuditRead   = "audit:read"
+)
+
+type Permission struct {
+	Key         string `json:"key"`
+	Description string `json:"description"`
+}
+
+// Permissions es el catálogo completo, en el orden en que se muestra.
+var Permissions = []Permission{
+	{PermSelf, "Acceso a los datos y solicitudes propias"},
+	{PermUsersRead, "Ver usuarios"},
+	{PermUsersWrite, "Crear, editar, importar y eliminar usuarios"},
+	{PermBranchesRead, "Ver sucursales y accesos"},
+	{PermBranchesManage, "Administrar sucursales y accesos"},
+	{PermBranchesAll, "Ver y gestionar todas las sucursales (sin restricción por sucursal asignada)"},
+	{PermDevicesManage, "Administrar dispositivos"},
+	{PermShiftsRead, "Ver turnos"},
+	{PermShiftsManage, "Administrar turnos"},
+	{PermSchedulesManage, "Asignar turnos y cambios de jornada"},
+	{PermCalendarRead, "Ver calendario de otros usuarios y sucursales"},
+	{PermHolidaysManage, "Administrar feriados"},
+	{PermMarkingsRead, "Ver marcaciones"},
+	{PermMarkingsEdit, "Corregir marcaciones"},
+	{PermAttendance, "Registrar marcaciones (dispositivos)"},
+	{PermDashboardRead, "Ver dashboard"},
+	{PermReportsExport, "Exportar reportes y planillas"},
+	{PermPayrollManage, "Administrar perfiles de exportación de remuneraciones"},
+	{PermLeaveReview, "Revisar permisos y licencias"},
+	{PermLeaveManage, "Crear y cancelar permisos de otros usuarios"},
+	{PermOvertimeReview, "Revisar y autorizar horas extra"},
+	{PermOvertimeManage, "Cancelar autorizaciones de horas extra de otros usuarios"},
+	{PermVacationManage, "Ver y ajustar vacaciones de otros usuarios"},
+	{PermHourBankManage, "Ver y ajustar la bolsa de horas de otros usuarios"},
+	{PermRolesManage, "Administrar roles y permisos"},
+	{PermAuditRead, "Ver el registro de auditoría"},
+}
+
+// IsPermission indica si la clave pertenece al catálogo.
+func IsPermission(key string) bool {
+	for _, p := range Permissions {
+		if p.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// AllPermissions devuelve todas las claves del catálogo.
+func AllPermissions() []string {
+	out := make([]string, len(Permissions))
+	for i, p := range Permissions {
+		out[i] = p.Key
+	}
+	return out
+}
+
+// DefaultRoles son los roles de sistema que se crean al iniciar. Se usan también para
+// tokens emitidos antes de que existieran los permisos.
+var DefaultRoles = map[string][]string{
+	"admin": AllPermissions(),
+	"supervisor": {
+		PermSelf,
+		PermUsersRead,
+		PermBranchesRead,
+		PermShiftsRead,
+		PermSchedulesManage,
+		PermMarkingsRead,
+		PermMarkingsEdit,
+		PermDashboardRead,
+		PermReportsExport,
+		PermLeaveReview,
+		PermOvertimeReview,
+	},
+	"user":   {PermSelf, PermBranchesRead, PermShiftsRead},
+	"device": {PermAttendance},
+}
+
+// PermissionList devuelve los permisos del token. Solo un token sin la clave "perms"
+// (emitido antes de que existieran los permisos) recibe los del rol por defecto; un
+// rol al que se le quitaron todos los permisos llega como [] y se respeta.
+func (c *Claims) PermissionList() []string {
+	if c.Permissions != nil {
+		return c.Permissions
+	}
+	if perms, ok := DefaultRoles[c.Role]; ok {
+		return perms
+	}
+	return []string{}
+}
+
+// HasPermission indica si el token incluye el permiso.
+func (c *Claims) HasPermission(perm string) bool {
+	for _, p := range c.PermissionList() {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
@@ -27,6 +27,9 @@ type Config struct {
 	CORS    CORSConfig
 	Swagger SwaggerConfig
 
+	HourBank   HourBankConfig
+	Attendance AttendanceConfig
+
 	RequestTimeout time.Duration
 	LogLevel       string
 }
@@ -51,6 +54,16 @@ type SwaggerConfig struct {
 	Pass string
 }
 
+type HourBankConfig struct {
+	// días tras los cuales vencen las horas acumuladas (0 = no vencen)
+	ExpiryDays int
+}
+
+type AttendanceConfig struct {
+	// antigüedad máxima (en días) de una marcación offline sincronizada
+	SyncMaxBackfillDays int
+}
+
 func Load() *Config {
 	env := Environment(getEnv("ENV", string(EnvDevelopment)))
 	if env != EnvDevelopment && env != EnvLab && env != EnvProduction {
@@ -83,6 +96,14 @@ func Load() *Config {
 			Pass: mustEnv("SWAGGER_PASS"),
 		},
 
+		HourBank: HourBankConfig{
+			ExpiryDays: getInt("HOUR_BANK_EXPIRY_DAYS", 0),
+		},
+
+		Attendance: AttendanceConfig{
+			SyncMaxBackfillDays: getInt("ATTENDANCE_SYNC_MAX_BACKFILL_DAYS", 7),
+		},
+
 		RequestTimeout: time.Duration(mustInt("REQUEST_TIMEOUT_SECONDS")) * time.Second,
 		LogLevel:       getEnv("LOG_LEVEL", defaultLogLevel(env)),
 	}
@@ -137,6 +158,14 @@ func validate(cfg *Config) {
 			log.Fatal("CORS_ALLOWED_ORIGINS contiene un origen vacío")
 		}
 	}
+
+	if cfg.HourBank.ExpiryDays < 0 {
+		log.Fatal("HOUR_BANK_EXPIRY_DAYS debe ser >= 0")
+	}
+
+	if cfg.Attendance.SyncMaxBackfillDays <= 0 {
+		log.Fatal("ATTENDANCE_SYNC_MAX_BACKFILL_DAYS debe ser > 0")
+	}
 }
 
 func defaultLogLevel(env Environment) string {
@@ -185,6 +214,18 @@ func mustInt(key string) int {
 	return n
 }
 
+func getInt(key string, def int) int {
+	raw := strings.TrimSpace(os.Getenv(key))
+	if raw == "" {
+		return def
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil {
+		log.Fatalf("%s debe ser int, recibido: %q", key, raw)
+	}
+	return n
+}
+
 func mustBool(key string) bool {
 	raw := strings.ToLower(strings.TrimSpace(mustEnv(key)))
 	switch raw {
@@ -200,7 +241,7 @@ func mustBool(key string) bool {
 
 func (c *Config) StringSafe() string {
 	return fmt.Sprintf(
-		"ENV=%s PORT=%s DB=set JWT_ISSUER=%s JWT_AUD=%v JWT_TTL=%d CORS_ORIGINS=%v SWAGGER_USER=%s SWAGGER_PASS=set TIMEOUT=%s LOG_LEVEL=%s",
-		c.Env, c.Port, c.JWT.Issuer, c.JWT.Audience, c.JWT.AccessTTLMinutes, c.CORS.AllowedOrigins, c.Swagger.User, c.RequestTimeout, c.LogLevel,
+		"ENV=%s PORT=%s DB=set JWT_ISSUER=%s JWT_AUD=%v JWT_TTL=%d CORS_ORIGINS=%v SWAGGER_USER=%s SWAGGER_PASS=set TIMEOUT=%s LOG_LEVEL=%s HOUR_BANK_EXPIRY_DAYS=%d ATTENDANCE_SYNC_MAX_BACKFILL_DAYS=%d",
+		c.Env, c.Port, c.JWT.Issuer, c.JWT.Audience, c.JWT.AccessTTLMinutes, c.CORS.AllowedOrigins, c.Swagger.User, c.RequestTimeout, c.LogLevel, c.HourBank.ExpiryDays, c.Attendance.SyncMaxBackfillDays,
 	)
 }
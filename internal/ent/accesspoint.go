@@ -44,9 +44,11 @@ type AccessPointEdges struct {
 	AttendanceDays []*AttendanceDay `json:"attendance_days,omitempty"`
 	// Devices holds the value of the devices edge.
 	Devices []*Device `json:"devices,omitempty"`
+	// Punches holds the value of the punches edge.
+	Punches []*Punch `json:"punches,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [5]bool
 }
 
 // BranchOrErr returns the Branch value or an error if the edge
@@ -87,6 +89,15 @@ func (e AccessPointEdges) DevicesOrErr() ([]*Device, error) {
 	return nil, &NotLoadedError{edge: "devices"}
 }
 
+// PunchesOrErr returns the Punches value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccessPointEdges) PunchesOrErr() ([]*Punch, error) {
+	if e.loadedTypes[4] {
+		return e.Punches, nil
+	}
+	return nil, &NotLoadedError{edge: "punches"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*AccessPoint) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -184,6 +195,11 @@ func (_m *AccessPoint) QueryDevices() *DeviceQuery {
 	return NewAccessPointClient(_m.config).QueryDevices(_m)
 }
 
+// QueryPunches queries the "punches" edge of the AccessPoint entity.
+func (_m *AccessPoint) QueryPunches() *PunchQuery {
+	return NewAccessPointClient(_m.config).QueryPunches(_m)
+}
+
 // Update returns a builder for updating this AccessPoint.
 // Note that you need to call AccessPoint.Unwrap() before calling this method if this AccessPoint
 // was returned from a transaction, and the transaction was committed or rolled back.
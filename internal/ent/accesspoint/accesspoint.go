@@ -32,6 +32,8 @@ const (
 	EdgeAttendanceDays = "attendance_days"
 	// EdgeDevices holds the string denoting the devices edge name in mutations.
 	EdgeDevices = "devices"
+	// EdgePunches holds the string denoting the punches edge name in mutations.
+	EdgePunches = "punches"
 	// Table holds the table name of the accesspoint in the database.
 	Table = "access_points"
 	// BranchTable is the table that holds the branch relation/edge.
@@ -62,6 +64,13 @@ const (
 	DevicesInverseTable = "devices"
 	// DevicesColumn is the table column denoting the devices relation/edge.
 	DevicesColumn = "access_point_id"
+	// PunchesTable is the table that holds the punches relation/edge.
+	PunchesTable = "punches"
+	// PunchesInverseTable is the table name for the Punch entity.
+	// It exists in this package in order to avoid circular dependency with the "punch" package.
+	PunchesInverseTable = "punches"
+	// PunchesColumn is the table column denoting the punches relation/edge.
+	PunchesColumn = "access_point_id"
 )
 
 // Columns holds all SQL columns for accesspoint fields.
@@ -178,6 +187,20 @@ func ByDevices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newDevicesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPunchesCount orders the results by punches count.
+func ByPunchesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPunchesStep(), opts...)
+	}
+}
+
+// ByPunches orders the results by punches terms.
+func ByPunches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPunchesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newBranchStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -206,3 +229,10 @@ func newDevicesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, DevicesTable, DevicesColumn),
 	)
 }
+func newPunchesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PunchesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PunchesTable, PunchesColumn),
+	)
+}
@@ -347,6 +347,29 @@ func HasDevicesWith(preds ...predicate.Device) predicate.AccessPoint {
 	})
 }
 
+// HasPunches applies the HasEdge predicate on the "punches" edge.
+func HasPunches() predicate.AccessPoint {
+	return predicate.AccessPoint(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PunchesTable, PunchesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPunchesWith applies the HasEdge predicate on the "punches" edge with a given conditions (other predicates).
+func HasPunchesWith(preds ...predicate.Punch) predicate.AccessPoint {
+	return predicate.AccessPoint(func(s *sql.Selector) {
+		step := newPunchesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.AccessPoint) predicate.AccessPoint {
 	return predicate.AccessPoint(sql.AndPredicates(predicates...))
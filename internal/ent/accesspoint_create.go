@@ -7,6 +7,7 @@ import (
 	"back/internal/ent/attendanceday"
 	"back/internal/ent/branch"
 	"back/internal/ent/device"
+	"back/internal/ent/punch"
 	"back/internal/ent/useraccesspoint"
 	"context"
 	"errors"
@@ -128,6 +129,21 @@ func (_c *AccessPointCreate) AddDevices(v ...*Device) *AccessPointCreate {
 	return _c.AddDeviceIDs(ids...)
 }
 
+// AddPunchIDs adds the "punches" edge to the Punch entity by IDs.
+func (_c *AccessPointCreate) AddPunchIDs(ids ...int) *AccessPointCreate {
+	_c.mutation.AddPunchIDs(ids...)
+	return _c
+}
+
+// AddPunches adds the "punches" edges to the Punch entity.
+func (_c *AccessPointCreate) AddPunches(v ...*Punch) *AccessPointCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPunchIDs(ids...)
+}
+
 // Mutation returns the AccessPointMutation object of the builder.
 func (_c *AccessPointCreate) Mutation() *AccessPointMutation {
 	return _c.mutation
@@ -309,6 +325,22 @@ func (_c *AccessPointCreate) createSpec() (*AccessPoint, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PunchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   accesspoint.PunchesTable,
+			Columns: []string{accesspoint.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -8,6 +8,7 @@ import (
 	"back/internal/ent/branch"
 	"back/internal/ent/device"
 	"back/internal/ent/predicate"
+	"back/internal/ent/punch"
 	"back/internal/ent/useraccesspoint"
 	"context"
 	"database/sql/driver"
@@ -31,6 +32,7 @@ type AccessPointQuery struct {
 	withUserAccessPoints *UserAccessPointQuery
 	withAttendanceDays   *AttendanceDayQuery
 	withDevices          *DeviceQuery
+	withPunches          *PunchQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -155,6 +157,28 @@ func (_q *AccessPointQuery) QueryDevices() *DeviceQuery {
 	return query
 }
 
+// QueryPunches chains the current query on the "punches" edge.
+func (_q *AccessPointQuery) QueryPunches() *PunchQuery {
+	query := (&PunchClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accesspoint.Table, accesspoint.FieldID, selector),
+			sqlgraph.To(punch.Table, punch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, accesspoint.PunchesTable, accesspoint.PunchesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first AccessPoint entity from the query.
 // Returns a *NotFoundError when no AccessPoint was found.
 func (_q *AccessPointQuery) First(ctx context.Context) (*AccessPoint, error) {
@@ -351,6 +375,7 @@ func (_q *AccessPointQuery) Clone() *AccessPointQuery {
 		withUserAccessPoints: _q.withUserAccessPoints.Clone(),
 		withAttendanceDays:   _q.withAttendanceDays.Clone(),
 		withDevices:          _q.withDevices.Clone(),
+		withPunches:          _q.withPunches.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -401,6 +426,17 @@ func (_q *AccessPointQuery) WithDevices(opts ...func(*DeviceQuery)) *AccessPoint
 	return _q
 }
 
+// WithPunches tells the query-builder to eager-load the nodes that are connected to
+// the "punches" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccessPointQuery) WithPunches(opts ...func(*PunchQuery)) *AccessPointQuery {
+	query := (&PunchClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPunches = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -479,11 +515,12 @@ func (_q *AccessPointQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	var (
 		nodes       = []*AccessPoint{}
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [5]bool{
 			_q.withBranch != nil,
 			_q.withUserAccessPoints != nil,
 			_q.withAttendanceDays != nil,
 			_q.withDevices != nil,
+			_q.withPunches != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -533,6 +570,13 @@ func (_q *AccessPointQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := _q.withPunches; query != nil {
+		if err := _q.loadPunches(ctx, query, nodes,
+			func(n *AccessPoint) { n.Edges.Punches = []*Punch{} },
+			func(n *AccessPoint, e *Punch) { n.Edges.Punches = append(n.Edges.Punches, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -656,6 +700,39 @@ func (_q *AccessPointQuery) loadDevices(ctx context.Context, query *DeviceQuery,
 	}
 	return nil
 }
+func (_q *AccessPointQuery) loadPunches(ctx context.Context, query *PunchQuery, nodes []*AccessPoint, init func(*AccessPoint), assign func(*AccessPoint, *Punch)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*AccessPoint)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(punch.FieldAccessPointID)
+	}
+	query.Where(predicate.Punch(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(accesspoint.PunchesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccessPointID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "access_point_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "access_point_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *AccessPointQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -8,6 +8,7 @@ import (
 	"back/internal/ent/branch"
 	"back/internal/ent/device"
 	"back/internal/ent/predicate"
+	"back/internal/ent/punch"
 	"back/internal/ent/useraccesspoint"
 	"context"
 	"errors"
@@ -130,6 +131,21 @@ func (_u *AccessPointUpdate) AddDevices(v ...*Device) *AccessPointUpdate {
 	return _u.AddDeviceIDs(ids...)
 }
 
+// AddPunchIDs adds the "punches" edge to the Punch entity by IDs.
+func (_u *AccessPointUpdate) AddPunchIDs(ids ...int) *AccessPointUpdate {
+	_u.mutation.AddPunchIDs(ids...)
+	return _u
+}
+
+// AddPunches adds the "punches" edges to the Punch entity.
+func (_u *AccessPointUpdate) AddPunches(v ...*Punch) *AccessPointUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPunchIDs(ids...)
+}
+
 // Mutation returns the AccessPointMutation object of the builder.
 func (_u *AccessPointUpdate) Mutation() *AccessPointMutation {
 	return _u.mutation
@@ -204,6 +220,27 @@ func (_u *AccessPointUpdate) RemoveDevices(v ...*Device) *AccessPointUpdate {
 	return _u.RemoveDeviceIDs(ids...)
 }
 
+// ClearPunches clears all "punches" edges to the Punch entity.
+func (_u *AccessPointUpdate) ClearPunches() *AccessPointUpdate {
+	_u.mutation.ClearPunches()
+	return _u
+}
+
+// RemovePunchIDs removes the "punches" edge to Punch entities by IDs.
+func (_u *AccessPointUpdate) RemovePunchIDs(ids ...int) *AccessPointUpdate {
+	_u.mutation.RemovePunchIDs(ids...)
+	return _u
+}
+
+// RemovePunches removes "punches" edges to Punch entities.
+func (_u *AccessPointUpdate) RemovePunches(v ...*Punch) *AccessPointUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePunchIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *AccessPointUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -438,6 +475,51 @@ func (_u *AccessPointUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   accesspoint.PunchesTable,
+			Columns: []string{accesspoint.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPunchesIDs(); len(nodes) > 0 && !_u.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   accesspoint.PunchesTable,
+			Columns: []string{accesspoint.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PunchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   accesspoint.PunchesTable,
+			Columns: []string{accesspoint.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{accesspoint.Label}
@@ -556,6 +638,21 @@ func (_u *AccessPointUpdateOne) AddDevices(v ...*Device) *AccessPointUpdateOne {
 	return _u.AddDeviceIDs(ids...)
 }
 
+// AddPunchIDs adds the "punches" edge to the Punch entity by IDs.
+func (_u *AccessPointUpdateOne) AddPunchIDs(ids ...int) *AccessPointUpdateOne {
+	_u.mutation.AddPunchIDs(ids...)
+	return _u
+}
+
+// AddPunches adds the "punches" edges to the Punch entity.
+func (_u *AccessPointUpdateOne) AddPunches(v ...*Punch) *AccessPointUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPunchIDs(ids...)
+}
+
 // Mutation returns the AccessPointMutation object of the builder.
 func (_u *AccessPointUpdateOne) Mutation() *AccessPointMutation {
 	return _u.mutation
@@ -630,6 +727,27 @@ func (_u *AccessPointUpdateOne) RemoveDevices(v ...*Device) *AccessPointUpdateOn
 	return _u.RemoveDeviceIDs(ids...)
 }
 
+// ClearPunches clears all "punches" edges to the Punch entity.
+func (_u *AccessPointUpdateOne) ClearPunches() *AccessPointUpdateOne {
+	_u.mutation.ClearPunches()
+	return _u
+}
+
+// RemovePunchIDs removes the "punches" edge to Punch entities by IDs.
+func (_u *AccessPointUpdateOne) RemovePunchIDs(ids ...int) *AccessPointUpdateOne {
+	_u.mutation.RemovePunchIDs(ids...)
+	return _u
+}
+
+// RemovePunches removes "punches" edges to Punch entities.
+func (_u *AccessPointUpdateOne) RemovePunches(v ...*Punch) *AccessPointUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePunchIDs(ids...)
+}
+
 // Where appends a list predicates to the AccessPointUpdate builder.
 func (_u *AccessPointUpdateOne) Where(ps ...predicate.AccessPoint) *AccessPointUpdateOne {
 	_u.mutation.Where(ps...)
@@ -894,6 +1012,51 @@ func (_u *AccessPointUpdateOne) sqlSave(ctx context.Context) (_node *AccessPoint
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   accesspoint.PunchesTable,
+			Columns: []string{accesspoint.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPunchesIDs(); len(nodes) > 0 && !_u.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   accesspoint.PunchesTable,
+			Columns: []string{accesspoint.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PunchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   accesspoint.PunchesTable,
+			Columns: []string{accesspoint.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &AccessPoint{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -28,6 +28,8 @@ type AttendanceDay struct {
 	AccessPointID *int `json:"access_point_id,omitempty"`
 	// WorkDate holds the value of the "work_date" field.
 	WorkDate time.Time `json:"work_date,omitempty"`
+	// IsHoliday holds the value of the "is_holiday" field.
+	IsHoliday bool `json:"is_holiday,omitempty"`
 	// WorkInAt holds the value of the "work_in_at" field.
 	WorkInAt *time.Time `json:"work_in_at,omitempty"`
 	// BreakOutAt holds the value of the "break_out_at" field.
@@ -40,6 +42,10 @@ type AttendanceDay struct {
 	LateMinutes *int `json:"late_minutes,omitempty"`
 	// OvertimeMinutes holds the value of the "overtime_minutes" field.
 	OvertimeMinutes *int `json:"overtime_minutes,omitempty"`
+	// ApprovedOvertimeMinutes holds the value of the "approved_overtime_minutes" field.
+	ApprovedOvertimeMinutes *int `json:"approved_overtime_minutes,omitempty"`
+	// UnapprovedOvertimeMinutes holds the value of the "unapproved_overtime_minutes" field.
+	UnapprovedOvertimeMinutes *int `json:"unapproved_overtime_minutes,omitempty"`
 	// EarlyExitMinutes holds the value of the "early_exit_minutes" field.
 	EarlyExitMinutes *int `json:"early_exit_minutes,omitempty"`
 	// BreakDiffMinutes holds the value of the "break_diff_minutes" field.
@@ -52,6 +58,8 @@ type AttendanceDay struct {
 	LastEditReason *string `json:"last_edit_reason,omitempty"`
 	// EditedAt holds the value of the "edited_at" field.
 	EditedAt *time.Time `json:"edited_at,omitempty"`
+	// LastCorrectionRequestID holds the value of the "last_correction_request_id" field.
+	LastCorrectionRequestID *int `json:"last_correction_request_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -72,9 +80,11 @@ type AttendanceDayEdges struct {
 	Branch *Branch `json:"branch,omitempty"`
 	// AccessPoint holds the value of the access_point edge.
 	AccessPoint *AccessPoint `json:"access_point,omitempty"`
+	// Punches holds the value of the punches edge.
+	Punches []*Punch `json:"punches,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -110,14 +120,23 @@ func (e AttendanceDayEdges) AccessPointOrErr() (*AccessPoint, error) {
 	return nil, &NotLoadedError{edge: "access_point"}
 }
 
+// PunchesOrErr returns the Punches value or an error if the edge
+// was not loaded in eager-loading.
+func (e AttendanceDayEdges) PunchesOrErr() ([]*Punch, error) {
+	if e.loadedTypes[3] {
+		return e.Punches, nil
+	}
+	return nil, &NotLoadedError{edge: "punches"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*AttendanceDay) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case attendanceday.FieldEdited:
+		case attendanceday.FieldIsHoliday, attendanceday.FieldEdited:
 			values[i] = new(sql.NullBool)
-		case attendanceday.FieldID, attendanceday.FieldUserID, attendanceday.FieldBranchID, attendanceday.FieldAccessPointID, attendanceday.FieldLateMinutes, attendanceday.FieldOvertimeMinutes, attendanceday.FieldEarlyExitMinutes, attendanceday.FieldBreakDiffMinutes, attendanceday.FieldNetMinutesBalance:
+		case attendanceday.FieldID, attendanceday.FieldUserID, attendanceday.FieldBranchID, attendanceday.FieldAccessPointID, attendanceday.FieldLateMinutes, attendanceday.FieldOvertimeMinutes, attendanceday.FieldApprovedOvertimeMinutes, attendanceday.FieldUnapprovedOvertimeMinutes, attendanceday.FieldEarlyExitMinutes, attendanceday.FieldBreakDiffMinutes, attendanceday.FieldNetMinutesBalance, attendanceday.FieldLastCorrectionRequestID:
 			values[i] = new(sql.NullInt64)
 		case attendanceday.FieldLastEditReason:
 			values[i] = new(sql.NullString)
@@ -173,6 +192,12 @@ func (_m *AttendanceDay) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.WorkDate = value.Time
 			}
+		case attendanceday.FieldIsHoliday:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_holiday", values[i])
+			} else if value.Valid {
+				_m.IsHoliday = value.Bool
+			}
 		case attendanceday.FieldWorkInAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field work_in_at", values[i])
@@ -215,6 +240,20 @@ func (_m *AttendanceDay) assignValues(columns []string, values []any) error {
 				_m.OvertimeMinutes = new(int)
 				*_m.OvertimeMinutes = int(value.Int64)
 			}
+		case attendanceday.FieldApprovedOvertimeMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_overtime_minutes", values[i])
+			} else if value.Valid {
+				_m.ApprovedOvertimeMinutes = new(int)
+				*_m.ApprovedOvertimeMinutes = int(value.Int64)
+			}
+		case attendanceday.FieldUnapprovedOvertimeMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field unapproved_overtime_minutes", values[i])
+			} else if value.Valid {
+				_m.UnapprovedOvertimeMinutes = new(int)
+				*_m.UnapprovedOvertimeMinutes = int(value.Int64)
+			}
 		case attendanceday.FieldEarlyExitMinutes:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field early_exit_minutes", values[i])
@@ -256,6 +295,13 @@ func (_m *AttendanceDay) assignValues(columns []string, values []any) error {
 				_m.EditedAt = new(time.Time)
 				*_m.EditedAt = value.Time
 			}
+		case attendanceday.FieldLastCorrectionRequestID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_correction_request_id", values[i])
+			} else if value.Valid {
+				_m.LastCorrectionRequestID = new(int)
+				*_m.LastCorrectionRequestID = int(value.Int64)
+			}
 		case attendanceday.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -310,6 +356,11 @@ func (_m *AttendanceDay) QueryAccessPoint() *AccessPointQuery {
 	return NewAttendanceDayClient(_m.config).QueryAccessPoint(_m)
 }
 
+// QueryPunches queries the "punches" edge of the AttendanceDay entity.
+func (_m *AttendanceDay) QueryPunches() *PunchQuery {
+	return NewAttendanceDayClient(_m.config).QueryPunches(_m)
+}
+
 // Update returns a builder for updating this AttendanceDay.
 // Note that you need to call AttendanceDay.Unwrap() before calling this method if this AttendanceDay
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -347,6 +398,9 @@ func (_m *AttendanceDay) String() string {
 	builder.WriteString("work_date=")
 	builder.WriteString(_m.WorkDate.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("is_holiday=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsHoliday))
+	builder.WriteString(", ")
 	if v := _m.WorkInAt; v != nil {
 		builder.WriteString("work_in_at=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -377,6 +431,16 @@ func (_m *AttendanceDay) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := _m.ApprovedOvertimeMinutes; v != nil {
+		builder.WriteString("approved_overtime_minutes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UnapprovedOvertimeMinutes; v != nil {
+		builder.WriteString("unapproved_overtime_minutes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.EarlyExitMinutes; v != nil {
 		builder.WriteString("early_exit_minutes=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -405,6 +469,11 @@ func (_m *AttendanceDay) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := _m.LastCorrectionRequestID; v != nil {
+		builder.WriteString("last_correction_request_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
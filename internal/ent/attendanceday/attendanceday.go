@@ -22,6 +22,8 @@ const (
 	FieldAccessPointID = "access_point_id"
 	// FieldWorkDate holds the string denoting the work_date field in the database.
 	FieldWorkDate = "work_date"
+	// FieldIsHoliday holds the string denoting the is_holiday field in the database.
+	FieldIsHoliday = "is_holiday"
 	// FieldWorkInAt holds the string denoting the work_in_at field in the database.
 	FieldWorkInAt = "work_in_at"
 	// FieldBreakOutAt holds the string denoting the break_out_at field in the database.
@@ -34,6 +36,10 @@ const (
 	FieldLateMinutes = "late_minutes"
 	// FieldOvertimeMinutes holds the string denoting the overtime_minutes field in the database.
 	FieldOvertimeMinutes = "overtime_minutes"
+	// FieldApprovedOvertimeMinutes holds the string denoting the approved_overtime_minutes field in the database.
+	FieldApprovedOvertimeMinutes = "approved_overtime_minutes"
+	// FieldUnapprovedOvertimeMinutes holds the string denoting the unapproved_overtime_minutes field in the database.
+	FieldUnapprovedOvertimeMinutes = "unapproved_overtime_minutes"
 	// FieldEarlyExitMinutes holds the string denoting the early_exit_minutes field in the database.
 	FieldEarlyExitMinutes = "early_exit_minutes"
 	// FieldBreakDiffMinutes holds the string denoting the break_diff_minutes field in the database.
@@ -46,6 +52,8 @@ const (
 	FieldLastEditReason = "last_edit_reason"
 	// FieldEditedAt holds the string denoting the edited_at field in the database.
 	FieldEditedAt = "edited_at"
+	// FieldLastCorrectionRequestID holds the string denoting the last_correction_request_id field in the database.
+	FieldLastCorrectionRequestID = "last_correction_request_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -56,6 +64,8 @@ const (
 	EdgeBranch = "branch"
 	// EdgeAccessPoint holds the string denoting the access_point edge name in mutations.
 	EdgeAccessPoint = "access_point"
+	// EdgePunches holds the string denoting the punches edge name in mutations.
+	EdgePunches = "punches"
 	// Table holds the table name of the attendanceday in the database.
 	Table = "attendance_days"
 	// UserTable is the table that holds the user relation/edge.
@@ -79,6 +89,13 @@ const (
 	AccessPointInverseTable = "access_points"
 	// AccessPointColumn is the table column denoting the access_point relation/edge.
 	AccessPointColumn = "access_point_id"
+	// PunchesTable is the table that holds the punches relation/edge.
+	PunchesTable = "punches"
+	// PunchesInverseTable is the table name for the Punch entity.
+	// It exists in this package in order to avoid circular dependency with the "punch" package.
+	PunchesInverseTable = "punches"
+	// PunchesColumn is the table column denoting the punches relation/edge.
+	PunchesColumn = "attendance_day_id"
 )
 
 // Columns holds all SQL columns for attendanceday fields.
@@ -88,18 +105,22 @@ var Columns = []string{
 	FieldBranchID,
 	FieldAccessPointID,
 	FieldWorkDate,
+	FieldIsHoliday,
 	FieldWorkInAt,
 	FieldBreakOutAt,
 	FieldBreakInAt,
 	FieldWorkOutAt,
 	FieldLateMinutes,
 	FieldOvertimeMinutes,
+	FieldApprovedOvertimeMinutes,
+	FieldUnapprovedOvertimeMinutes,
 	FieldEarlyExitMinutes,
 	FieldBreakDiffMinutes,
 	FieldNetMinutesBalance,
 	FieldEdited,
 	FieldLastEditReason,
 	FieldEditedAt,
+	FieldLastCorrectionRequestID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -127,6 +148,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultIsHoliday holds the default value on creation for the "is_holiday" field.
+	DefaultIsHoliday bool
 	// DefaultEdited holds the default value on creation for the "edited" field.
 	DefaultEdited bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -165,6 +188,11 @@ func ByWorkDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWorkDate, opts...).ToFunc()
 }
 
+// ByIsHoliday orders the results by the is_holiday field.
+func ByIsHoliday(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsHoliday, opts...).ToFunc()
+}
+
 // ByWorkInAt orders the results by the work_in_at field.
 func ByWorkInAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWorkInAt, opts...).ToFunc()
@@ -195,6 +223,16 @@ func ByOvertimeMinutes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOvertimeMinutes, opts...).ToFunc()
 }
 
+// ByApprovedOvertimeMinutes orders the results by the approved_overtime_minutes field.
+func ByApprovedOvertimeMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovedOvertimeMinutes, opts...).ToFunc()
+}
+
+// ByUnapprovedOvertimeMinutes orders the results by the unapproved_overtime_minutes field.
+func ByUnapprovedOvertimeMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnapprovedOvertimeMinutes, opts...).ToFunc()
+}
+
 // ByEarlyExitMinutes orders the results by the early_exit_minutes field.
 func ByEarlyExitMinutes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEarlyExitMinutes, opts...).ToFunc()
@@ -225,6 +263,11 @@ func ByEditedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEditedAt, opts...).ToFunc()
 }
 
+// ByLastCorrectionRequestID orders the results by the last_correction_request_id field.
+func ByLastCorrectionRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastCorrectionRequestID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -255,6 +298,20 @@ func ByAccessPointField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAccessPointStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByPunchesCount orders the results by punches count.
+func ByPunchesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPunchesStep(), opts...)
+	}
+}
+
+// ByPunches orders the results by punches terms.
+func ByPunches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPunchesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -276,3 +333,10 @@ func newAccessPointStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, AccessPointTable, AccessPointColumn),
 	)
 }
+func newPunchesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PunchesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PunchesTable, PunchesColumn),
+	)
+}
@@ -75,6 +75,11 @@ func WorkDate(v time.Time) predicate.AttendanceDay {
 	return predicate.AttendanceDay(sql.FieldEQ(FieldWorkDate, v))
 }
 
+// IsHoliday applies equality check predicate on the "is_holiday" field. It's identical to IsHolidayEQ.
+func IsHoliday(v bool) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldEQ(FieldIsHoliday, v))
+}
+
 // WorkInAt applies equality check predicate on the "work_in_at" field. It's identical to WorkInAtEQ.
 func WorkInAt(v time.Time) predicate.AttendanceDay {
 	return predicate.AttendanceDay(sql.FieldEQ(FieldWorkInAt, v))
@@ -105,6 +110,16 @@ func OvertimeMinutes(v int) predicate.AttendanceDay {
 	return predicate.AttendanceDay(sql.FieldEQ(FieldOvertimeMinutes, v))
 }
 
+// ApprovedOvertimeMinutes applies equality check predicate on the "approved_overtime_minutes" field. It's identical to ApprovedOvertimeMinutesEQ.
+func ApprovedOvertimeMinutes(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldEQ(FieldApprovedOvertimeMinutes, v))
+}
+
+// UnapprovedOvertimeMinutes applies equality check predicate on the "unapproved_overtime_minutes" field. It's identical to UnapprovedOvertimeMinutesEQ.
+func UnapprovedOvertimeMinutes(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldEQ(FieldUnapprovedOvertimeMinutes, v))
+}
+
 // EarlyExitMinutes applies equality check predicate on the "early_exit_minutes" field. It's identical to EarlyExitMinutesEQ.
 func EarlyExitMinutes(v int) predicate.AttendanceDay {
 	return predicate.AttendanceDay(sql.FieldEQ(FieldEarlyExitMinutes, v))
@@ -135,6 +150,11 @@ func EditedAt(v time.Time) predicate.AttendanceDay {
 	return predicate.AttendanceDay(sql.FieldEQ(FieldEditedAt, v))
 }
 
+// LastCorrectionRequestID applies equality check predicate on the "last_correction_request_id" field. It's identical to LastCorrectionRequestIDEQ.
+func LastCorrectionRequestID(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldEQ(FieldLastCorrectionRequestID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.AttendanceDay {
 	return predicate.AttendanceDay(sql.FieldEQ(FieldCreatedAt, v))
@@ -255,6 +275,16 @@ func WorkDateLTE(v time.Time) predicate.AttendanceDay {
 	return predicate.AttendanceDay(sql.FieldLTE(FieldWorkDate, v))
 }
 
+// IsHolidayEQ applies the EQ predicate on the "is_holiday" field.
+func IsHolidayEQ(v bool) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldEQ(FieldIsHoliday, v))
+}
+
+// IsHolidayNEQ applies the NEQ predicate on the "is_holiday" field.
+func IsHolidayNEQ(v bool) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldNEQ(FieldIsHoliday, v))
+}
+
 // WorkInAtEQ applies the EQ predicate on the "work_in_at" field.
 func WorkInAtEQ(v time.Time) predicate.AttendanceDay {
 	return predicate.AttendanceDay(sql.FieldEQ(FieldWorkInAt, v))
@@ -555,6 +585,106 @@ func OvertimeMinutesNotNil() predicate.AttendanceDay {
 	return predicate.AttendanceDay(sql.FieldNotNull(FieldOvertimeMinutes))
 }
 
+// ApprovedOvertimeMinutesEQ applies the EQ predicate on the "approved_overtime_minutes" field.
+func ApprovedOvertimeMinutesEQ(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldEQ(FieldApprovedOvertimeMinutes, v))
+}
+
+// ApprovedOvertimeMinutesNEQ applies the NEQ predicate on the "approved_overtime_minutes" field.
+func ApprovedOvertimeMinutesNEQ(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldNEQ(FieldApprovedOvertimeMinutes, v))
+}
+
+// ApprovedOvertimeMinutesIn applies the In predicate on the "approved_overtime_minutes" field.
+func ApprovedOvertimeMinutesIn(vs ...int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldIn(FieldApprovedOvertimeMinutes, vs...))
+}
+
+// ApprovedOvertimeMinutesNotIn applies the NotIn predicate on the "approved_overtime_minutes" field.
+func ApprovedOvertimeMinutesNotIn(vs ...int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldNotIn(FieldApprovedOvertimeMinutes, vs...))
+}
+
+// ApprovedOvertimeMinutesGT applies the GT predicate on the "approved_overtime_minutes" field.
+func ApprovedOvertimeMinutesGT(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldGT(FieldApprovedOvertimeMinutes, v))
+}
+
+// ApprovedOvertimeMinutesGTE applies the GTE predicate on the "approved_overtime_minutes" field.
+func ApprovedOvertimeMinutesGTE(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldGTE(FieldApprovedOvertimeMinutes, v))
+}
+
+// ApprovedOvertimeMinutesLT applies the LT predicate on the "approved_overtime_minutes" field.
+func ApprovedOvertimeMinutesLT(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldLT(FieldApprovedOvertimeMinutes, v))
+}
+
+// ApprovedOvertimeMinutesLTE applies the LTE predicate on the "approved_overtime_minutes" field.
+func ApprovedOvertimeMinutesLTE(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldLTE(FieldApprovedOvertimeMinutes, v))
+}
+
+// ApprovedOvertimeMinutesIsNil applies the IsNil predicate on the "approved_overtime_minutes" field.
+func ApprovedOvertimeMinutesIsNil() predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldIsNull(FieldApprovedOvertimeMinutes))
+}
+
+// ApprovedOvertimeMinutesNotNil applies the NotNil predicate on the "approved_overtime_minutes" field.
+func ApprovedOvertimeMinutesNotNil() predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldNotNull(FieldApprovedOvertimeMinutes))
+}
+
+// UnapprovedOvertimeMinutesEQ applies the EQ predicate on the "unapproved_overtime_minutes" field.
+func UnapprovedOvertimeMinutesEQ(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldEQ(FieldUnapprovedOvertimeMinutes, v))
+}
+
+// UnapprovedOvertimeMinutesNEQ applies the NEQ predicate on the "unapproved_overtime_minutes" field.
+func UnapprovedOvertimeMinutesNEQ(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldNEQ(FieldUnapprovedOvertimeMinutes, v))
+}
+
+// UnapprovedOvertimeMinutesIn applies the In predicate on the "unapproved_overtime_minutes" field.
+func UnapprovedOvertimeMinutesIn(vs ...int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldIn(FieldUnapprovedOvertimeMinutes, vs...))
+}
+
+// UnapprovedOvertimeMinutesNotIn applies the NotIn predicate on the "unapproved_overtime_minutes" field.
+func UnapprovedOvertimeMinutesNotIn(vs ...int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldNotIn(FieldUnapprovedOvertimeMinutes, vs...))
+}
+
+// UnapprovedOvertimeMinutesGT applies the GT predicate on the "unapproved_overtime_minutes" field.
+func UnapprovedOvertimeMinutesGT(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldGT(FieldUnapprovedOvertimeMinutes, v))
+}
+
+// UnapprovedOvertimeMinutesGTE applies the GTE predicate on the "unapproved_overtime_minutes" field.
+func UnapprovedOvertimeMinutesGTE(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldGTE(FieldUnapprovedOvertimeMinutes, v))
+}
+
+// UnapprovedOvertimeMinutesLT applies the LT predicate on the "unapproved_overtime_minutes" field.
+func UnapprovedOvertimeMinutesLT(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldLT(FieldUnapprovedOvertimeMinutes, v))
+}
+
+// UnapprovedOvertimeMinutesLTE applies the LTE predicate on the "unapproved_overtime_minutes" field.
+func UnapprovedOvertimeMinutesLTE(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldLTE(FieldUnapprovedOvertimeMinutes, v))
+}
+
+// UnapprovedOvertimeMinutesIsNil applies the IsNil predicate on the "unapproved_overtime_minutes" field.
+func UnapprovedOvertimeMinutesIsNil() predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldIsNull(FieldUnapprovedOvertimeMinutes))
+}
+
+// UnapprovedOvertimeMinutesNotNil applies the NotNil predicate on the "unapproved_overtime_minutes" field.
+func UnapprovedOvertimeMinutesNotNil() predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldNotNull(FieldUnapprovedOvertimeMinutes))
+}
+
 // EarlyExitMinutesEQ applies the EQ predicate on the "early_exit_minutes" field.
 func EarlyExitMinutesEQ(v int) predicate.AttendanceDay {
 	return predicate.AttendanceDay(sql.FieldEQ(FieldEarlyExitMinutes, v))
@@ -840,6 +970,56 @@ func EditedAtNotNil() predicate.AttendanceDay {
 	return predicate.AttendanceDay(sql.FieldNotNull(FieldEditedAt))
 }
 
+// LastCorrectionRequestIDEQ applies the EQ predicate on the "last_correction_request_id" field.
+func LastCorrectionRequestIDEQ(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldEQ(FieldLastCorrectionRequestID, v))
+}
+
+// LastCorrectionRequestIDNEQ applies the NEQ predicate on the "last_correction_request_id" field.
+func LastCorrectionRequestIDNEQ(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldNEQ(FieldLastCorrectionRequestID, v))
+}
+
+// LastCorrectionRequestIDIn applies the In predicate on the "last_correction_request_id" field.
+func LastCorrectionRequestIDIn(vs ...int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldIn(FieldLastCorrectionRequestID, vs...))
+}
+
+// LastCorrectionRequestIDNotIn applies the NotIn predicate on the "last_correction_request_id" field.
+func LastCorrectionRequestIDNotIn(vs ...int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldNotIn(FieldLastCorrectionRequestID, vs...))
+}
+
+// LastCorrectionRequestIDGT applies the GT predicate on the "last_correction_request_id" field.
+func LastCorrectionRequestIDGT(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldGT(FieldLastCorrectionRequestID, v))
+}
+
+// LastCorrectionRequestIDGTE applies the GTE predicate on the "last_correction_request_id" field.
+func LastCorrectionRequestIDGTE(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldGTE(FieldLastCorrectionRequestID, v))
+}
+
+// LastCorrectionRequestIDLT applies the LT predicate on the "last_correction_request_id" field.
+func LastCorrectionRequestIDLT(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldLT(FieldLastCorrectionRequestID, v))
+}
+
+// LastCorrectionRequestIDLTE applies the LTE predicate on the "last_correction_request_id" field.
+func LastCorrectionRequestIDLTE(v int) predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldLTE(FieldLastCorrectionRequestID, v))
+}
+
+// LastCorrectionRequestIDIsNil applies the IsNil predicate on the "last_correction_request_id" field.
+func LastCorrectionRequestIDIsNil() predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldIsNull(FieldLastCorrectionRequestID))
+}
+
+// LastCorrectionRequestIDNotNil applies the NotNil predicate on the "last_correction_request_id" field.
+func LastCorrectionRequestIDNotNil() predicate.AttendanceDay {
+	return predicate.AttendanceDay(sql.FieldNotNull(FieldLastCorrectionRequestID))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.AttendanceDay {
 	return predicate.AttendanceDay(sql.FieldEQ(FieldCreatedAt, v))
@@ -989,6 +1169,29 @@ func HasAccessPointWith(preds ...predicate.AccessPoint) predicate.AttendanceDay
 	})
 }
 
+// HasPunches applies the HasEdge predicate on the "punches" edge.
+func HasPunches() predicate.AttendanceDay {
+	return predicate.AttendanceDay(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PunchesTable, PunchesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPunchesWith applies the HasEdge predicate on the "punches" edge with a given conditions (other predicates).
+func HasPunchesWith(preds ...predicate.Punch) predicate.AttendanceDay {
+	return predicate.AttendanceDay(func(s *sql.Selector) {
+		step := newPunchesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.AttendanceDay) predicate.AttendanceDay {
 	return predicate.AttendanceDay(sql.AndPredicates(predicates...))
@@ -6,6 +6,7 @@ import (
 	"back/internal/ent/accesspoint"
 	"back/internal/ent/attendanceday"
 	"back/internal/ent/branch"
+	"back/internal/ent/punch"
 	"back/internal/ent/user"
 	"context"
 	"errors"
@@ -55,6 +56,20 @@ func (_c *AttendanceDayCreate) SetWorkDate(v time.Time) *AttendanceDayCreate {
 	return _c
 }
 
+// SetIsHoliday sets the "is_holiday" field.
+func (_c *AttendanceDayCreate) SetIsHoliday(v bool) *AttendanceDayCreate {
+	_c.mutation.SetIsHoliday(v)
+	return _c
+}
+
+// SetNillableIsHoliday sets the "is_holiday" field if the given value is not nil.
+func (_c *AttendanceDayCreate) SetNillableIsHoliday(v *bool) *AttendanceDayCreate {
+	if v != nil {
+		_c.SetIsHoliday(*v)
+	}
+	return _c
+}
+
 // SetWorkInAt sets the "work_in_at" field.
 func (_c *AttendanceDayCreate) SetWorkInAt(v time.Time) *AttendanceDayCreate {
 	_c.mutation.SetWorkInAt(v)
@@ -139,6 +154,34 @@ func (_c *AttendanceDayCreate) SetNillableOvertimeMinutes(v *int) *AttendanceDay
 	return _c
 }
 
+// SetApprovedOvertimeMinutes sets the "approved_overtime_minutes" field.
+func (_c *AttendanceDayCreate) SetApprovedOvertimeMinutes(v int) *AttendanceDayCreate {
+	_c.mutation.SetApprovedOvertimeMinutes(v)
+	return _c
+}
+
+// SetNillableApprovedOvertimeMinutes sets the "approved_overtime_minutes" field if the given value is not nil.
+func (_c *AttendanceDayCreate) SetNillableApprovedOvertimeMinutes(v *int) *AttendanceDayCreate {
+	if v != nil {
+		_c.SetApprovedOvertimeMinutes(*v)
+	}
+	return _c
+}
+
+// SetUnapprovedOvertimeMinutes sets the "unapproved_overtime_minutes" field.
+func (_c *AttendanceDayCreate) SetUnapprovedOvertimeMinutes(v int) *AttendanceDayCreate {
+	_c.mutation.SetUnapprovedOvertimeMinutes(v)
+	return _c
+}
+
+// SetNillableUnapprovedOvertimeMinutes sets the "unapproved_overtime_minutes" field if the given value is not nil.
+func (_c *AttendanceDayCreate) SetNillableUnapprovedOvertimeMinutes(v *int) *AttendanceDayCreate {
+	if v != nil {
+		_c.SetUnapprovedOvertimeMinutes(*v)
+	}
+	return _c
+}
+
 // SetEarlyExitMinutes sets the "early_exit_minutes" field.
 func (_c *AttendanceDayCreate) SetEarlyExitMinutes(v int) *AttendanceDayCreate {
 	_c.mutation.SetEarlyExitMinutes(v)
@@ -223,6 +266,20 @@ func (_c *AttendanceDayCreate) SetNillableEditedAt(v *time.Time) *AttendanceDayC
 	return _c
 }
 
+// SetLastCorrectionRequestID sets the "last_correction_request_id" field.
+func (_c *AttendanceDayCreate) SetLastCorrectionRequestID(v int) *AttendanceDayCreate {
+	_c.mutation.SetLastCorrectionRequestID(v)
+	return _c
+}
+
+// SetNillableLastCorrectionRequestID sets the "last_correction_request_id" field if the given value is not nil.
+func (_c *AttendanceDayCreate) SetNillableLastCorrectionRequestID(v *int) *AttendanceDayCreate {
+	if v != nil {
+		_c.SetLastCorrectionRequestID(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *AttendanceDayCreate) SetCreatedAt(v time.Time) *AttendanceDayCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -266,6 +323,21 @@ func (_c *AttendanceDayCreate) SetAccessPoint(v *AccessPoint) *AttendanceDayCrea
 	return _c.SetAccessPointID(v.ID)
 }
 
+// AddPunchIDs adds the "punches" edge to the Punch entity by IDs.
+func (_c *AttendanceDayCreate) AddPunchIDs(ids ...int) *AttendanceDayCreate {
+	_c.mutation.AddPunchIDs(ids...)
+	return _c
+}
+
+// AddPunches adds the "punches" edges to the Punch entity.
+func (_c *AttendanceDayCreate) AddPunches(v ...*Punch) *AttendanceDayCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPunchIDs(ids...)
+}
+
 // Mutation returns the AttendanceDayMutation object of the builder.
 func (_c *AttendanceDayCreate) Mutation() *AttendanceDayMutation {
 	return _c.mutation
@@ -301,6 +373,10 @@ func (_c *AttendanceDayCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *AttendanceDayCreate) defaults() {
+	if _, ok := _c.mutation.IsHoliday(); !ok {
+		v := attendanceday.DefaultIsHoliday
+		_c.mutation.SetIsHoliday(v)
+	}
 	if _, ok := _c.mutation.Edited(); !ok {
 		v := attendanceday.DefaultEdited
 		_c.mutation.SetEdited(v)
@@ -326,6 +402,9 @@ func (_c *AttendanceDayCreate) check() error {
 	if _, ok := _c.mutation.WorkDate(); !ok {
 		return &ValidationError{Name: "work_date", err: errors.New(`ent: missing required field "AttendanceDay.work_date"`)}
 	}
+	if _, ok := _c.mutation.IsHoliday(); !ok {
+		return &ValidationError{Name: "is_holiday", err: errors.New(`ent: missing required field "AttendanceDay.is_holiday"`)}
+	}
 	if _, ok := _c.mutation.Edited(); !ok {
 		return &ValidationError{Name: "edited", err: errors.New(`ent: missing required field "AttendanceDay.edited"`)}
 	}
@@ -371,6 +450,10 @@ func (_c *AttendanceDayCreate) createSpec() (*AttendanceDay, *sqlgraph.CreateSpe
 		_spec.SetField(attendanceday.FieldWorkDate, field.TypeTime, value)
 		_node.WorkDate = value
 	}
+	if value, ok := _c.mutation.IsHoliday(); ok {
+		_spec.SetField(attendanceday.FieldIsHoliday, field.TypeBool, value)
+		_node.IsHoliday = value
+	}
 	if value, ok := _c.mutation.WorkInAt(); ok {
 		_spec.SetField(attendanceday.FieldWorkInAt, field.TypeTime, value)
 		_node.WorkInAt = &value
@@ -395,6 +478,14 @@ func (_c *AttendanceDayCreate) createSpec() (*AttendanceDay, *sqlgraph.CreateSpe
 		_spec.SetField(attendanceday.FieldOvertimeMinutes, field.TypeInt, value)
 		_node.OvertimeMinutes = &value
 	}
+	if value, ok := _c.mutation.ApprovedOvertimeMinutes(); ok {
+		_spec.SetField(attendanceday.FieldApprovedOvertimeMinutes, field.TypeInt, value)
+		_node.ApprovedOvertimeMinutes = &value
+	}
+	if value, ok := _c.mutation.UnapprovedOvertimeMinutes(); ok {
+		_spec.SetField(attendanceday.FieldUnapprovedOvertimeMinutes, field.TypeInt, value)
+		_node.UnapprovedOvertimeMinutes = &value
+	}
 	if value, ok := _c.mutation.EarlyExitMinutes(); ok {
 		_spec.SetField(attendanceday.FieldEarlyExitMinutes, field.TypeInt, value)
 		_node.EarlyExitMinutes = &value
@@ -419,6 +510,10 @@ func (_c *AttendanceDayCreate) createSpec() (*AttendanceDay, *sqlgraph.CreateSpe
 		_spec.SetField(attendanceday.FieldEditedAt, field.TypeTime, value)
 		_node.EditedAt = &value
 	}
+	if value, ok := _c.mutation.LastCorrectionRequestID(); ok {
+		_spec.SetField(attendanceday.FieldLastCorrectionRequestID, field.TypeInt, value)
+		_node.LastCorrectionRequestID = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(attendanceday.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -478,6 +573,22 @@ func (_c *AttendanceDayCreate) createSpec() (*AttendanceDay, *sqlgraph.CreateSpe
 		_node.AccessPointID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PunchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendanceday.PunchesTable,
+			Columns: []string{attendanceday.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -7,8 +7,10 @@ import (
 	"back/internal/ent/attendanceday"
 	"back/internal/ent/branch"
 	"back/internal/ent/predicate"
+	"back/internal/ent/punch"
 	"back/internal/ent/user"
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -28,6 +30,7 @@ type AttendanceDayQuery struct {
 	withUser        *UserQuery
 	withBranch      *BranchQuery
 	withAccessPoint *AccessPointQuery
+	withPunches     *PunchQuery
 	withFKs         bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -131,6 +134,28 @@ func (_q *AttendanceDayQuery) QueryAccessPoint() *AccessPointQuery {
 	return query
 }
 
+// QueryPunches chains the current query on the "punches" edge.
+func (_q *AttendanceDayQuery) QueryPunches() *PunchQuery {
+	query := (&PunchClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendanceday.Table, attendanceday.FieldID, selector),
+			sqlgraph.To(punch.Table, punch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attendanceday.PunchesTable, attendanceday.PunchesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first AttendanceDay entity from the query.
 // Returns a *NotFoundError when no AttendanceDay was found.
 func (_q *AttendanceDayQuery) First(ctx context.Context) (*AttendanceDay, error) {
@@ -326,6 +351,7 @@ func (_q *AttendanceDayQuery) Clone() *AttendanceDayQuery {
 		withUser:        _q.withUser.Clone(),
 		withBranch:      _q.withBranch.Clone(),
 		withAccessPoint: _q.withAccessPoint.Clone(),
+		withPunches:     _q.withPunches.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -365,6 +391,17 @@ func (_q *AttendanceDayQuery) WithAccessPoint(opts ...func(*AccessPointQuery)) *
 	return _q
 }
 
+// WithPunches tells the query-builder to eager-load the nodes that are connected to
+// the "punches" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AttendanceDayQuery) WithPunches(opts ...func(*PunchQuery)) *AttendanceDayQuery {
+	query := (&PunchClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPunches = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -444,10 +481,11 @@ func (_q *AttendanceDayQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 		nodes       = []*AttendanceDay{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			_q.withUser != nil,
 			_q.withBranch != nil,
 			_q.withAccessPoint != nil,
+			_q.withPunches != nil,
 		}
 	)
 	if withFKs {
@@ -489,6 +527,13 @@ func (_q *AttendanceDayQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 			return nil, err
 		}
 	}
+	if query := _q.withPunches; query != nil {
+		if err := _q.loadPunches(ctx, query, nodes,
+			func(n *AttendanceDay) { n.Edges.Punches = []*Punch{} },
+			func(n *AttendanceDay, e *Punch) { n.Edges.Punches = append(n.Edges.Punches, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -582,6 +627,39 @@ func (_q *AttendanceDayQuery) loadAccessPoint(ctx context.Context, query *Access
 	}
 	return nil
 }
+func (_q *AttendanceDayQuery) loadPunches(ctx context.Context, query *PunchQuery, nodes []*AttendanceDay, init func(*AttendanceDay), assign func(*AttendanceDay, *Punch)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*AttendanceDay)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(punch.FieldAttendanceDayID)
+	}
+	query.Where(predicate.Punch(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(attendanceday.PunchesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AttendanceDayID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "attendance_day_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "attendance_day_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *AttendanceDayQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
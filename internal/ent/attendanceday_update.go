@@ -7,6 +7,7 @@ import (
 	"back/internal/ent/attendanceday"
 	"back/internal/ent/branch"
 	"back/internal/ent/predicate"
+	"back/internal/ent/punch"
 	"back/internal/ent/user"
 	"context"
 	"errors"
@@ -93,6 +94,20 @@ func (_u *AttendanceDayUpdate) SetNillableWorkDate(v *time.Time) *AttendanceDayU
 	return _u
 }
 
+// SetIsHoliday sets the "is_holiday" field.
+func (_u *AttendanceDayUpdate) SetIsHoliday(v bool) *AttendanceDayUpdate {
+	_u.mutation.SetIsHoliday(v)
+	return _u
+}
+
+// SetNillableIsHoliday sets the "is_holiday" field if the given value is not nil.
+func (_u *AttendanceDayUpdate) SetNillableIsHoliday(v *bool) *AttendanceDayUpdate {
+	if v != nil {
+		_u.SetIsHoliday(*v)
+	}
+	return _u
+}
+
 // SetWorkInAt sets the "work_in_at" field.
 func (_u *AttendanceDayUpdate) SetWorkInAt(v time.Time) *AttendanceDayUpdate {
 	_u.mutation.SetWorkInAt(v)
@@ -227,6 +242,60 @@ func (_u *AttendanceDayUpdate) ClearOvertimeMinutes() *AttendanceDayUpdate {
 	return _u
 }
 
+// SetApprovedOvertimeMinutes sets the "approved_overtime_minutes" field.
+func (_u *AttendanceDayUpdate) SetApprovedOvertimeMinutes(v int) *AttendanceDayUpdate {
+	_u.mutation.ResetApprovedOvertimeMinutes()
+	_u.mutation.SetApprovedOvertimeMinutes(v)
+	return _u
+}
+
+// SetNillableApprovedOvertimeMinutes sets the "approved_overtime_minutes" field if the given value is not nil.
+func (_u *AttendanceDayUpdate) SetNillableApprovedOvertimeMinutes(v *int) *AttendanceDayUpdate {
+	if v != nil {
+		_u.SetApprovedOvertimeMinutes(*v)
+	}
+	return _u
+}
+
+// AddApprovedOvertimeMinutes adds value to the "approved_overtime_minutes" field.
+func (_u *AttendanceDayUpdate) AddApprovedOvertimeMinutes(v int) *AttendanceDayUpdate {
+	_u.mutation.AddApprovedOvertimeMinutes(v)
+	return _u
+}
+
+// ClearApprovedOvertimeMinutes clears the value of the "approved_overtime_minutes" field.
+func (_u *AttendanceDayUpdate) ClearApprovedOvertimeMinutes() *AttendanceDayUpdate {
+	_u.mutation.ClearApprovedOvertimeMinutes()
+	return _u
+}
+
+// SetUnapprovedOvertimeMinutes sets the "unapproved_overtime_minutes" field.
+func (_u *AttendanceDayUpdate) SetUnapprovedOvertimeMinutes(v int) *AttendanceDayUpdate {
+	_u.mutation.ResetUnapprovedOvertimeMinutes()
+	_u.mutation.SetUnapprovedOvertimeMinutes(v)
+	return _u
+}
+
+// SetNillableUnapprovedOvertimeMinutes sets the "unapproved_overtime_minutes" field if the given value is not nil.
+func (_u *AttendanceDayUpdate) SetNillableUnapprovedOvertimeMinutes(v *int) *AttendanceDayUpdate {
+	if v != nil {
+		_u.SetUnapprovedOvertimeMinutes(*v)
+	}
+	return _u
+}
+
+// AddUnapprovedOvertimeMinutes adds value to the "unapproved_overtime_minutes" field.
+func (_u *AttendanceDayUpdate) AddUnapprovedOvertimeMinutes(v int) *AttendanceDayUpdate {
+	_u.mutation.AddUnapprovedOvertimeMinutes(v)
+	return _u
+}
+
+// ClearUnapprovedOvertimeMinutes clears the value of the "unapproved_overtime_minutes" field.
+func (_u *AttendanceDayUpdate) ClearUnapprovedOvertimeMinutes() *AttendanceDayUpdate {
+	_u.mutation.ClearUnapprovedOvertimeMinutes()
+	return _u
+}
+
 // SetEarlyExitMinutes sets the "early_exit_minutes" field.
 func (_u *AttendanceDayUpdate) SetEarlyExitMinutes(v int) *AttendanceDayUpdate {
 	_u.mutation.ResetEarlyExitMinutes()
@@ -362,6 +431,33 @@ func (_u *AttendanceDayUpdate) ClearEditedAt() *AttendanceDayUpdate {
 	return _u
 }
 
+// SetLastCorrectionRequestID sets the "last_correction_request_id" field.
+func (_u *AttendanceDayUpdate) SetLastCorrectionRequestID(v int) *AttendanceDayUpdate {
+	_u.mutation.ResetLastCorrectionRequestID()
+	_u.mutation.SetLastCorrectionRequestID(v)
+	return _u
+}
+
+// SetNillableLastCorrectionRequestID sets the "last_correction_request_id" field if the given value is not nil.
+func (_u *AttendanceDayUpdate) SetNillableLastCorrectionRequestID(v *int) *AttendanceDayUpdate {
+	if v != nil {
+		_u.SetLastCorrectionRequestID(*v)
+	}
+	return _u
+}
+
+// AddLastCorrectionRequestID adds value to the "last_correction_request_id" field.
+func (_u *AttendanceDayUpdate) AddLastCorrectionRequestID(v int) *AttendanceDayUpdate {
+	_u.mutation.AddLastCorrectionRequestID(v)
+	return _u
+}
+
+// ClearLastCorrectionRequestID clears the value of the "last_correction_request_id" field.
+func (_u *AttendanceDayUpdate) ClearLastCorrectionRequestID() *AttendanceDayUpdate {
+	_u.mutation.ClearLastCorrectionRequestID()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *AttendanceDayUpdate) SetCreatedAt(v time.Time) *AttendanceDayUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -397,6 +493,21 @@ func (_u *AttendanceDayUpdate) SetAccessPoint(v *AccessPoint) *AttendanceDayUpda
 	return _u.SetAccessPointID(v.ID)
 }
 
+// AddPunchIDs adds the "punches" edge to the Punch entity by IDs.
+func (_u *AttendanceDayUpdate) AddPunchIDs(ids ...int) *AttendanceDayUpdate {
+	_u.mutation.AddPunchIDs(ids...)
+	return _u
+}
+
+// AddPunches adds the "punches" edges to the Punch entity.
+func (_u *AttendanceDayUpdate) AddPunches(v ...*Punch) *AttendanceDayUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPunchIDs(ids...)
+}
+
 // Mutation returns the AttendanceDayMutation object of the builder.
 func (_u *AttendanceDayUpdate) Mutation() *AttendanceDayMutation {
 	return _u.mutation
@@ -420,6 +531,27 @@ func (_u *AttendanceDayUpdate) ClearAccessPoint() *AttendanceDayUpdate {
 	return _u
 }
 
+// ClearPunches clears all "punches" edges to the Punch entity.
+func (_u *AttendanceDayUpdate) ClearPunches() *AttendanceDayUpdate {
+	_u.mutation.ClearPunches()
+	return _u
+}
+
+// RemovePunchIDs removes the "punches" edge to Punch entities by IDs.
+func (_u *AttendanceDayUpdate) RemovePunchIDs(ids ...int) *AttendanceDayUpdate {
+	_u.mutation.RemovePunchIDs(ids...)
+	return _u
+}
+
+// RemovePunches removes "punches" edges to Punch entities.
+func (_u *AttendanceDayUpdate) RemovePunches(v ...*Punch) *AttendanceDayUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePunchIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *AttendanceDayUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -482,6 +614,9 @@ func (_u *AttendanceDayUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.WorkDate(); ok {
 		_spec.SetField(attendanceday.FieldWorkDate, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.IsHoliday(); ok {
+		_spec.SetField(attendanceday.FieldIsHoliday, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.WorkInAt(); ok {
 		_spec.SetField(attendanceday.FieldWorkInAt, field.TypeTime, value)
 	}
@@ -524,6 +659,24 @@ func (_u *AttendanceDayUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.OvertimeMinutesCleared() {
 		_spec.ClearField(attendanceday.FieldOvertimeMinutes, field.TypeInt)
 	}
+	if value, ok := _u.mutation.ApprovedOvertimeMinutes(); ok {
+		_spec.SetField(attendanceday.FieldApprovedOvertimeMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedApprovedOvertimeMinutes(); ok {
+		_spec.AddField(attendanceday.FieldApprovedOvertimeMinutes, field.TypeInt, value)
+	}
+	if _u.mutation.ApprovedOvertimeMinutesCleared() {
+		_spec.ClearField(attendanceday.FieldApprovedOvertimeMinutes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UnapprovedOvertimeMinutes(); ok {
+		_spec.SetField(attendanceday.FieldUnapprovedOvertimeMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUnapprovedOvertimeMinutes(); ok {
+		_spec.AddField(attendanceday.FieldUnapprovedOvertimeMinutes, field.TypeInt, value)
+	}
+	if _u.mutation.UnapprovedOvertimeMinutesCleared() {
+		_spec.ClearField(attendanceday.FieldUnapprovedOvertimeMinutes, field.TypeInt)
+	}
 	if value, ok := _u.mutation.EarlyExitMinutes(); ok {
 		_spec.SetField(attendanceday.FieldEarlyExitMinutes, field.TypeInt, value)
 	}
@@ -566,6 +719,15 @@ func (_u *AttendanceDayUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.EditedAtCleared() {
 		_spec.ClearField(attendanceday.FieldEditedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.LastCorrectionRequestID(); ok {
+		_spec.SetField(attendanceday.FieldLastCorrectionRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastCorrectionRequestID(); ok {
+		_spec.AddField(attendanceday.FieldLastCorrectionRequestID, field.TypeInt, value)
+	}
+	if _u.mutation.LastCorrectionRequestIDCleared() {
+		_spec.ClearField(attendanceday.FieldLastCorrectionRequestID, field.TypeInt)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(attendanceday.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -659,6 +821,51 @@ func (_u *AttendanceDayUpdate) sqlSave(ctx context.Context) (_node int, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendanceday.PunchesTable,
+			Columns: []string{attendanceday.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPunchesIDs(); len(nodes) > 0 && !_u.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendanceday.PunchesTable,
+			Columns: []string{attendanceday.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PunchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendanceday.PunchesTable,
+			Columns: []string{attendanceday.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{attendanceday.Label}
@@ -741,6 +948,20 @@ func (_u *AttendanceDayUpdateOne) SetNillableWorkDate(v *time.Time) *AttendanceD
 	return _u
 }
 
+// SetIsHoliday sets the "is_holiday" field.
+func (_u *AttendanceDayUpdateOne) SetIsHoliday(v bool) *AttendanceDayUpdateOne {
+	_u.mutation.SetIsHoliday(v)
+	return _u
+}
+
+// SetNillableIsHoliday sets the "is_holiday" field if the given value is not nil.
+func (_u *AttendanceDayUpdateOne) SetNillableIsHoliday(v *bool) *AttendanceDayUpdateOne {
+	if v != nil {
+		_u.SetIsHoliday(*v)
+	}
+	return _u
+}
+
 // SetWorkInAt sets the "work_in_at" field.
 func (_u *AttendanceDayUpdateOne) SetWorkInAt(v time.Time) *AttendanceDayUpdateOne {
 	_u.mutation.SetWorkInAt(v)
@@ -875,6 +1096,60 @@ func (_u *AttendanceDayUpdateOne) ClearOvertimeMinutes() *AttendanceDayUpdateOne
 	return _u
 }
 
+// SetApprovedOvertimeMinutes sets the "approved_overtime_minutes" field.
+func (_u *AttendanceDayUpdateOne) SetApprovedOvertimeMinutes(v int) *AttendanceDayUpdateOne {
+	_u.mutation.ResetApprovedOvertimeMinutes()
+	_u.mutation.SetApprovedOvertimeMinutes(v)
+	return _u
+}
+
+// SetNillableApprovedOvertimeMinutes sets the "approved_overtime_minutes" field if the given value is not nil.
+func (_u *AttendanceDayUpdateOne) SetNillableApprovedOvertimeMinutes(v *int) *AttendanceDayUpdateOne {
+	if v != nil {
+		_u.SetApprovedOvertimeMinutes(*v)
+	}
+	return _u
+}
+
+// AddApprovedOvertimeMinutes adds value to the "approved_overtime_minutes" field.
+func (_u *AttendanceDayUpdateOne) AddApprovedOvertimeMinutes(v int) *AttendanceDayUpdateOne {
+	_u.mutation.AddApprovedOvertimeMinutes(v)
+	return _u
+}
+
+// ClearApprovedOvertimeMinutes clears the value of the "approved_overtime_minutes" field.
+func (_u *AttendanceDayUpdateOne) ClearApprovedOvertimeMinutes() *AttendanceDayUpdateOne {
+	_u.mutation.ClearApprovedOvertimeMinutes()
+	return _u
+}
+
+// SetUnapprovedOvertimeMinutes sets the "unapproved_overtime_minutes" field.
+func (_u *AttendanceDayUpdateOne) SetUnapprovedOvertimeMinutes(v int) *AttendanceDayUpdateOne {
+	_u.mutation.ResetUnapprovedOvertimeMinutes()
+	_u.mutation.SetUnapprovedOvertimeMinutes(v)
+	return _u
+}
+
+// SetNillableUnapprovedOvertimeMinutes sets the "unapproved_overtime_minutes" field if the given value is not nil.
+func (_u *AttendanceDayUpdateOne) SetNillableUnapprovedOvertimeMinutes(v *int) *AttendanceDayUpdateOne {
+	if v != nil {
+		_u.SetUnapprovedOvertimeMinutes(*v)
+	}
+	return _u
+}
+
+// AddUnapprovedOvertimeMinutes adds value to the "unapproved_overtime_minutes" field.
+func (_u *AttendanceDayUpdateOne) AddUnapprovedOvertimeMinutes(v int) *AttendanceDayUpdateOne {
+	_u.mutation.AddUnapprovedOvertimeMinutes(v)
+	return _u
+}
+
+// ClearUnapprovedOvertimeMinutes clears the value of the "unapproved_overtime_minutes" field.
+func (_u *AttendanceDayUpdateOne) ClearUnapprovedOvertimeMinutes() *AttendanceDayUpdateOne {
+	_u.mutation.ClearUnapprovedOvertimeMinutes()
+	return _u
+}
+
 // SetEarlyExitMinutes sets the "early_exit_minutes" field.
 func (_u *AttendanceDayUpdateOne) SetEarlyExitMinutes(v int) *AttendanceDayUpdateOne {
 	_u.mutation.ResetEarlyExitMinutes()
@@ -1010,6 +1285,33 @@ func (_u *AttendanceDayUpdateOne) ClearEditedAt() *AttendanceDayUpdateOne {
 	return _u
 }
 
+// SetLastCorrectionRequestID sets the "last_correction_request_id" field.
+func (_u *AttendanceDayUpdateOne) SetLastCorrectionRequestID(v int) *AttendanceDayUpdateOne {
+	_u.mutation.ResetLastCorrectionRequestID()
+	_u.mutation.SetLastCorrectionRequestID(v)
+	return _u
+}
+
+// SetNillableLastCorrectionRequestID sets the "last_correction_request_id" field if the given value is not nil.
+func (_u *AttendanceDayUpdateOne) SetNillableLastCorrectionRequestID(v *int) *AttendanceDayUpdateOne {
+	if v != nil {
+		_u.SetLastCorrectionRequestID(*v)
+	}
+	return _u
+}
+
+// AddLastCorrectionRequestID adds value to the "last_correction_request_id" field.
+func (_u *AttendanceDayUpdateOne) AddLastCorrectionRequestID(v int) *AttendanceDayUpdateOne {
+	_u.mutation.AddLastCorrectionRequestID(v)
+	return _u
+}
+
+// ClearLastCorrectionRequestID clears the value of the "last_correction_request_id" field.
+func (_u *AttendanceDayUpdateOne) ClearLastCorrectionRequestID() *AttendanceDayUpdateOne {
+	_u.mutation.ClearLastCorrectionRequestID()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *AttendanceDayUpdateOne) SetCreatedAt(v time.Time) *AttendanceDayUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -1045,6 +1347,21 @@ func (_u *AttendanceDayUpdateOne) SetAccessPoint(v *AccessPoint) *AttendanceDayU
 	return _u.SetAccessPointID(v.ID)
 }
 
+// AddPunchIDs adds the "punches" edge to the Punch entity by IDs.
+func (_u *AttendanceDayUpdateOne) AddPunchIDs(ids ...int) *AttendanceDayUpdateOne {
+	_u.mutation.AddPunchIDs(ids...)
+	return _u
+}
+
+// AddPunches adds the "punches" edges to the Punch entity.
+func (_u *AttendanceDayUpdateOne) AddPunches(v ...*Punch) *AttendanceDayUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPunchIDs(ids...)
+}
+
 // Mutation returns the AttendanceDayMutation object of the builder.
 func (_u *AttendanceDayUpdateOne) Mutation() *AttendanceDayMutation {
 	return _u.mutation
@@ -1068,6 +1385,27 @@ func (_u *AttendanceDayUpdateOne) ClearAccessPoint() *AttendanceDayUpdateOne {
 	return _u
 }
 
+// ClearPunches clears all "punches" edges to the Punch entity.
+func (_u *AttendanceDayUpdateOne) ClearPunches() *AttendanceDayUpdateOne {
+	_u.mutation.ClearPunches()
+	return _u
+}
+
+// RemovePunchIDs removes the "punches" edge to Punch entities by IDs.
+func (_u *AttendanceDayUpdateOne) RemovePunchIDs(ids ...int) *AttendanceDayUpdateOne {
+	_u.mutation.RemovePunchIDs(ids...)
+	return _u
+}
+
+// RemovePunches removes "punches" edges to Punch entities.
+func (_u *AttendanceDayUpdateOne) RemovePunches(v ...*Punch) *AttendanceDayUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePunchIDs(ids...)
+}
+
 // Where appends a list predicates to the AttendanceDayUpdate builder.
 func (_u *AttendanceDayUpdateOne) Where(ps ...predicate.AttendanceDay) *AttendanceDayUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1160,6 +1498,9 @@ func (_u *AttendanceDayUpdateOne) sqlSave(ctx context.Context) (_node *Attendanc
 	if value, ok := _u.mutation.WorkDate(); ok {
 		_spec.SetField(attendanceday.FieldWorkDate, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.IsHoliday(); ok {
+		_spec.SetField(attendanceday.FieldIsHoliday, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.WorkInAt(); ok {
 		_spec.SetField(attendanceday.FieldWorkInAt, field.TypeTime, value)
 	}
@@ -1202,6 +1543,24 @@ func (_u *AttendanceDayUpdateOne) sqlSave(ctx context.Context) (_node *Attendanc
 	if _u.mutation.OvertimeMinutesCleared() {
 		_spec.ClearField(attendanceday.FieldOvertimeMinutes, field.TypeInt)
 	}
+	if value, ok := _u.mutation.ApprovedOvertimeMinutes(); ok {
+		_spec.SetField(attendanceday.FieldApprovedOvertimeMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedApprovedOvertimeMinutes(); ok {
+		_spec.AddField(attendanceday.FieldApprovedOvertimeMinutes, field.TypeInt, value)
+	}
+	if _u.mutation.ApprovedOvertimeMinutesCleared() {
+		_spec.ClearField(attendanceday.FieldApprovedOvertimeMinutes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UnapprovedOvertimeMinutes(); ok {
+		_spec.SetField(attendanceday.FieldUnapprovedOvertimeMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUnapprovedOvertimeMinutes(); ok {
+		_spec.AddField(attendanceday.FieldUnapprovedOvertimeMinutes, field.TypeInt, value)
+	}
+	if _u.mutation.UnapprovedOvertimeMinutesCleared() {
+		_spec.ClearField(attendanceday.FieldUnapprovedOvertimeMinutes, field.TypeInt)
+	}
 	if value, ok := _u.mutation.EarlyExitMinutes(); ok {
 		_spec.SetField(attendanceday.FieldEarlyExitMinutes, field.TypeInt, value)
 	}
@@ -1244,6 +1603,15 @@ func (_u *AttendanceDayUpdateOne) sqlSave(ctx context.Context) (_node *Attendanc
 	if _u.mutation.EditedAtCleared() {
 		_spec.ClearField(attendanceday.FieldEditedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.LastCorrectionRequestID(); ok {
+		_spec.SetField(attendanceday.FieldLastCorrectionRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastCorrectionRequestID(); ok {
+		_spec.AddField(attendanceday.FieldLastCorrectionRequestID, field.TypeInt, value)
+	}
+	if _u.mutation.LastCorrectionRequestIDCleared() {
+		_spec.ClearField(attendanceday.FieldLastCorrectionRequestID, field.TypeInt)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(attendanceday.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -1337,6 +1705,51 @@ func (_u *AttendanceDayUpdateOne) sqlSave(ctx context.Context) (_node *Attendanc
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendanceday.PunchesTable,
+			Columns: []string{attendanceday.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPunchesIDs(); len(nodes) > 0 && !_u.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendanceday.PunchesTable,
+			Columns: []string{attendanceday.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PunchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendanceday.PunchesTable,
+			Columns: []string{attendanceday.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &AttendanceDay{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/attendanceday"
+	"back/internal/ent/attendanceedit"
+	"back/internal/ent/markcorrectionrequest"
+	"back/internal/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AttendanceEdit is the model entity for the AttendanceEdit schema.
+type AttendanceEdit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// AttendanceDayID holds the value of the "attendance_day_id" field.
+	AttendanceDayID int `json:"attendance_day_id,omitempty"`
+	// EditorID holds the value of the "editor_id" field.
+	EditorID int `json:"editor_id,omitempty"`
+	// CorrectionRequestID holds the value of the "correction_request_id" field.
+	CorrectionRequestID *int `json:"correction_request_id,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// WorkInAtBefore holds the value of the "work_in_at_before" field.
+	WorkInAtBefore *time.Time `json:"work_in_at_before,omitempty"`
+	// WorkInAtAfter holds the value of the "work_in_at_after" field.
+	WorkInAtAfter *time.Time `json:"work_in_at_after,omitempty"`
+	// BreakOutAtBefore holds the value of the "break_out_at_before" field.
+	BreakOutAtBefore *time.Time `json:"break_out_at_before,omitempty"`
+	// BreakOutAtAfter holds the value of the "break_out_at_after" field.
+	BreakOutAtAfter *time.Time `json:"break_out_at_after,omitempty"`
+	// BreakInAtBefore holds the value of the "break_in_at_before" field.
+	BreakInAtBefore *time.Time `json:"break_in_at_before,omitempty"`
+	// BreakInAtAfter holds the value of the "break_in_at_after" field.
+	BreakInAtAfter *time.Time `json:"break_in_at_after,omitempty"`
+	// WorkOutAtBefore holds the value of the "work_out_at_before" field.
+	WorkOutAtBefore *time.Time `json:"work_out_at_before,omitempty"`
+	// WorkOutAtAfter holds the value of the "work_out_at_after" field.
+	WorkOutAtAfter *time.Time `json:"work_out_at_after,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AttendanceEditQuery when eager-loading is set.
+	Edges        AttendanceEditEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AttendanceEditEdges holds the relations/edges for other nodes in the graph.
+type AttendanceEditEdges struct {
+	// AttendanceDay holds the value of the attendance_day edge.
+	AttendanceDay *AttendanceDay `json:"attendance_day,omitempty"`
+	// Editor holds the value of the editor edge.
+	Editor *User `json:"editor,omitempty"`
+	// CorrectionRequest holds the value of the correction_request edge.
+	CorrectionRequest *MarkCorrectionRequest `json:"correction_request,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// AttendanceDayOrErr returns the AttendanceDay value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttendanceEditEdges) AttendanceDayOrErr() (*AttendanceDay, error) {
+	if e.AttendanceDay != nil {
+		return e.AttendanceDay, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: attendanceday.Label}
+	}
+	return nil, &NotLoadedError{edge: "attendance_day"}
+}
+
+// EditorOrErr returns the Editor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttendanceEditEdges) EditorOrErr() (*User, error) {
+	if e.Editor != nil {
+		return e.Editor, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "editor"}
+}
+
+// CorrectionRequestOrErr returns the CorrectionRequest value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttendanceEditEdges) CorrectionRequestOrErr() (*MarkCorrectionRequest, error) {
+	if e.CorrectionRequest != nil {
+		return e.CorrectionRequest, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: markcorrectionrequest.Label}
+	}
+	return nil, &NotLoadedError{edge: "correction_request"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AttendanceEdit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case attendanceedit.FieldID, attendanceedit.FieldAttendanceDayID, attendanceedit.FieldEditorID, attendanceedit.FieldCorrectionRequestID:
+			values[i] = new(sql.NullInt64)
+		case attendanceedit.FieldReason:
+			values[i] = new(sql.NullString)
+		case attendanceedit.FieldWorkInAtBefore, attendanceedit.FieldWorkInAtAfter, attendanceedit.FieldBreakOutAtBefore, attendanceedit.FieldBreakOutAtAfter, attendanceedit.FieldBreakInAtBefore, attendanceedit.FieldBreakInAtAfter, attendanceedit.FieldWorkOutAtBefore, attendanceedit.FieldWorkOutAtAfter, attendanceedit.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AttendanceEdit fields.
+func (_m *AttendanceEdit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case attendanceedit.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case attendanceedit.FieldAttendanceDayID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attendance_day_id", values[i])
+			} else if value.Valid {
+				_m.AttendanceDayID = int(value.Int64)
+			}
+		case attendanceedit.FieldEditorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field editor_id", values[i])
+			} else if value.Valid {
+				_m.EditorID = int(value.Int64)
+			}
+		case attendanceedit.FieldCorrectionRequestID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field correction_request_id", values[i])
+			} else if value.Valid {
+				_m.CorrectionRequestID = new(int)
+				*_m.CorrectionRequestID = int(value.Int64)
+			}
+		case attendanceedit.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case attendanceedit.FieldWorkInAtBefore:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field work_in_at_before", values[i])
+			} else if value.Valid {
+				_m.WorkInAtBefore = new(time.Time)
+				*_m.WorkInAtBefore = value.Time
+			}
+		case attendanceedit.FieldWorkInAtAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field work_in_at_after", values[i])
+			} else if value.Valid {
+				_m.WorkInAtAfter = new(time.Time)
+				*_m.WorkInAtAfter = value.Time
+			}
+		case attendanceedit.FieldBreakOutAtBefore:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field break_out_at_before", values[i])
+			} else if value.Valid {
+				_m.BreakOutAtBefore = new(time.Time)
+				*_m.BreakOutAtBefore = value.Time
+			}
+		case attendanceedit.FieldBreakOutAtAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field break_out_at_after", values[i])
+			} else if value.Valid {
+				_m.BreakOutAtAfter = new(time.Time)
+				*_m.BreakOutAtAfter = value.Time
+			}
+		case attendanceedit.FieldBreakInAtBefore:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field break_in_at_before", values[i])
+			} else if value.Valid {
+				_m.BreakInAtBefore = new(time.Time)
+				*_m.BreakInAtBefore = value.Time
+			}
+		case attendanceedit.FieldBreakInAtAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field break_in_at_after", values[i])
+			} else if value.Valid {
+				_m.BreakInAtAfter = new(time.Time)
+				*_m.BreakInAtAfter = value.Time
+			}
+		case attendanceedit.FieldWorkOutAtBefore:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field work_out_at_before", values[i])
+			} else if value.Valid {
+				_m.WorkOutAtBefore = new(time.Time)
+				*_m.WorkOutAtBefore = value.Time
+			}
+		case attendanceedit.FieldWorkOutAtAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field work_out_at_after", values[i])
+			} else if value.Valid {
+				_m.WorkOutAtAfter = new(time.Time)
+				*_m.WorkOutAtAfter = value.Time
+			}
+		case attendanceedit.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AttendanceEdit.
+// This includes values selected through modifiers, order, etc.
+func (_m *AttendanceEdit) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAttendanceDay queries the "attendance_day" edge of the AttendanceEdit entity.
+func (_m *AttendanceEdit) QueryAttendanceDay() *AttendanceDayQuery {
+	return NewAttendanceEditClient(_m.config).QueryAttendanceDay(_m)
+}
+
+// QueryEditor queries the "editor" edge of the AttendanceEdit entity.
+func (_m *AttendanceEdit) QueryEditor() *UserQuery {
+	return NewAttendanceEditClient(_m.config).QueryEditor(_m)
+}
+
+// QueryCorrectionRequest queries the "correction_request" edge of the AttendanceEdit entity.
+func (_m *AttendanceEdit) QueryCorrectionRequest() *MarkCorrectionRequestQuery {
+	return NewAttendanceEditClient(_m.config).QueryCorrectionRequest(_m)
+}
+
+// Update returns a builder for updating this AttendanceEdit.
+// Note that you need to call AttendanceEdit.Unwrap() before calling this method if this AttendanceEdit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AttendanceEdit) Update() *AttendanceEditUpdateOne {
+	return NewAttendanceEditClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AttendanceEdit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AttendanceEdit) Unwrap() *AttendanceEdit {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AttendanceEdit is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AttendanceEdit) String() string {
+	var builder strings.Builder
+	builder.WriteString("AttendanceEdit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("attendance_day_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttendanceDayID))
+	builder.WriteString(", ")
+	builder.WriteString("editor_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EditorID))
+	builder.WriteString(", ")
+	if v := _m.CorrectionRequestID; v != nil {
+		builder.WriteString("correction_request_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	if v := _m.WorkInAtBefore; v != nil {
+		builder.WriteString("work_in_at_before=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.WorkInAtAfter; v != nil {
+		builder.WriteString("work_in_at_after=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.BreakOutAtBefore; v != nil {
+		builder.WriteString("break_out_at_before=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.BreakOutAtAfter; v != nil {
+		builder.WriteString("break_out_at_after=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.BreakInAtBefore; v != nil {
+		builder.WriteString("break_in_at_before=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.BreakInAtAfter; v != nil {
+		builder.WriteString("break_in_at_after=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.WorkOutAtBefore; v != nil {
+		builder.WriteString("work_out_at_before=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.WorkOutAtAfter; v != nil {
+		builder.WriteString("work_out_at_after=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AttendanceEdits is a parsable slice of AttendanceEdit.
+type AttendanceEdits []*AttendanceEdit
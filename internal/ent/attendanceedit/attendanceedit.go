@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attendanceedit
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the attendanceedit type in the database.
+	Label = "attendance_edit"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAttendanceDayID holds the string denoting the attendance_day_id field in the database.
+	FieldAttendanceDayID = "attendance_day_id"
+	// FieldEditorID holds the string denoting the editor_id field in the database.
+	FieldEditorID = "editor_id"
+	// FieldCorrectionRequestID holds the string denoting the correction_request_id field in the database.
+	FieldCorrectionRequestID = "correction_request_id"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldWorkInAtBefore holds the string denoting the work_in_at_before field in the database.
+	FieldWorkInAtBefore = "work_in_at_before"
+	// FieldWorkInAtAfter holds the string denoting the work_in_at_after field in the database.
+	FieldWorkInAtAfter = "work_in_at_after"
+	// FieldBreakOutAtBefore holds the string denoting the break_out_at_before field in the database.
+	FieldBreakOutAtBefore = "break_out_at_before"
+	// FieldBreakOutAtAfter holds the string denoting the break_out_at_after field in the database.
+	FieldBreakOutAtAfter = "break_out_at_after"
+	// FieldBreakInAtBefore holds the string denoting the break_in_at_before field in the database.
+	FieldBreakInAtBefore = "break_in_at_before"
+	// FieldBreakInAtAfter holds the string denoting the break_in_at_after field in the database.
+	FieldBreakInAtAfter = "break_in_at_after"
+	// FieldWorkOutAtBefore holds the string denoting the work_out_at_before field in the database.
+	FieldWorkOutAtBefore = "work_out_at_before"
+	// FieldWorkOutAtAfter holds the string denoting the work_out_at_after field in the database.
+	FieldWorkOutAtAfter = "work_out_at_after"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeAttendanceDay holds the string denoting the attendance_day edge name in mutations.
+	EdgeAttendanceDay = "attendance_day"
+	// EdgeEditor holds the string denoting the editor edge name in mutations.
+	EdgeEditor = "editor"
+	// EdgeCorrectionRequest holds the string denoting the correction_request edge name in mutations.
+	EdgeCorrectionRequest = "correction_request"
+	// Table holds the table name of the attendanceedit in the database.
+	Table = "attendance_edits"
+	// AttendanceDayTable is the table that holds the attendance_day relation/edge.
+	AttendanceDayTable = "attendance_edits"
+	// AttendanceDayInverseTable is the table name for the AttendanceDay entity.
+	// It exists in this package in order to avoid circular dependency with the "attendanceday" package.
+	AttendanceDayInverseTable = "attendance_days"
+	// AttendanceDayColumn is the table column denoting the attendance_day relation/edge.
+	AttendanceDayColumn = "attendance_day_id"
+	// EditorTable is the table that holds the editor relation/edge.
+	EditorTable = "attendance_edits"
+	// EditorInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	EditorInverseTable = "users"
+	// EditorColumn is the table column denoting the editor relation/edge.
+	EditorColumn = "editor_id"
+	// CorrectionRequestTable is the table that holds the correction_request relation/edge.
+	CorrectionRequestTable = "attendance_edits"
+	// CorrectionRequestInverseTable is the table name for the MarkCorrectionRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "markcorrectionrequest" package.
+	CorrectionRequestInverseTable = "mark_correction_requests"
+	// CorrectionRequestColumn is the table column denoting the correction_request relation/edge.
+	CorrectionRequestColumn = "correction_request_id"
+)
+
+// Columns holds all SQL columns for attendanceedit fields.
+var Columns = []string{
+	FieldID,
+	FieldAttendanceDayID,
+	FieldEditorID,
+	FieldCorrectionRequestID,
+	FieldReason,
+	FieldWorkInAtBefore,
+	FieldWorkInAtAfter,
+	FieldBreakOutAtBefore,
+	FieldBreakOutAtAfter,
+	FieldBreakInAtBefore,
+	FieldBreakInAtAfter,
+	FieldWorkOutAtBefore,
+	FieldWorkOutAtAfter,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	ReasonValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the AttendanceEdit queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAttendanceDayID orders the results by the attendance_day_id field.
+func ByAttendanceDayID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttendanceDayID, opts...).ToFunc()
+}
+
+// ByEditorID orders the results by the editor_id field.
+func ByEditorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEditorID, opts...).ToFunc()
+}
+
+// ByCorrectionRequestID orders the results by the correction_request_id field.
+func ByCorrectionRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCorrectionRequestID, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByWorkInAtBefore orders the results by the work_in_at_before field.
+func ByWorkInAtBefore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkInAtBefore, opts...).ToFunc()
+}
+
+// ByWorkInAtAfter orders the results by the work_in_at_after field.
+func ByWorkInAtAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkInAtAfter, opts...).ToFunc()
+}
+
+// ByBreakOutAtBefore orders the results by the break_out_at_before field.
+func ByBreakOutAtBefore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBreakOutAtBefore, opts...).ToFunc()
+}
+
+// ByBreakOutAtAfter orders the results by the break_out_at_after field.
+func ByBreakOutAtAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBreakOutAtAfter, opts...).ToFunc()
+}
+
+// ByBreakInAtBefore orders the results by the break_in_at_before field.
+func ByBreakInAtBefore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBreakInAtBefore, opts...).ToFunc()
+}
+
+// ByBreakInAtAfter orders the results by the break_in_at_after field.
+func ByBreakInAtAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBreakInAtAfter, opts...).ToFunc()
+}
+
+// ByWorkOutAtBefore orders the results by the work_out_at_before field.
+func ByWorkOutAtBefore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkOutAtBefore, opts...).ToFunc()
+}
+
+// ByWorkOutAtAfter orders the results by the work_out_at_after field.
+func ByWorkOutAtAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkOutAtAfter, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByAttendanceDayField orders the results by attendance_day field.
+func ByAttendanceDayField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttendanceDayStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEditorField orders the results by editor field.
+func ByEditorField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEditorStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCorrectionRequestField orders the results by correction_request field.
+func ByCorrectionRequestField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCorrectionRequestStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAttendanceDayStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttendanceDayInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, AttendanceDayTable, AttendanceDayColumn),
+	)
+}
+func newEditorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EditorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, EditorTable, EditorColumn),
+	)
+}
+func newCorrectionRequestStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CorrectionRequestInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, CorrectionRequestTable, CorrectionRequestColumn),
+	)
+}
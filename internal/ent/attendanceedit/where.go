@@ -0,0 +1,780 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attendanceedit
+
+import (
+	"back/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLTE(FieldID, id))
+}
+
+// AttendanceDayID applies equality check predicate on the "attendance_day_id" field. It's identical to AttendanceDayIDEQ.
+func AttendanceDayID(v int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldAttendanceDayID, v))
+}
+
+// EditorID applies equality check predicate on the "editor_id" field. It's identical to EditorIDEQ.
+func EditorID(v int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldEditorID, v))
+}
+
+// CorrectionRequestID applies equality check predicate on the "correction_request_id" field. It's identical to CorrectionRequestIDEQ.
+func CorrectionRequestID(v int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldCorrectionRequestID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldReason, v))
+}
+
+// WorkInAtBefore applies equality check predicate on the "work_in_at_before" field. It's identical to WorkInAtBeforeEQ.
+func WorkInAtBefore(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldWorkInAtBefore, v))
+}
+
+// WorkInAtAfter applies equality check predicate on the "work_in_at_after" field. It's identical to WorkInAtAfterEQ.
+func WorkInAtAfter(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldWorkInAtAfter, v))
+}
+
+// BreakOutAtBefore applies equality check predicate on the "break_out_at_before" field. It's identical to BreakOutAtBeforeEQ.
+func BreakOutAtBefore(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldBreakOutAtBefore, v))
+}
+
+// BreakOutAtAfter applies equality check predicate on the "break_out_at_after" field. It's identical to BreakOutAtAfterEQ.
+func BreakOutAtAfter(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldBreakOutAtAfter, v))
+}
+
+// BreakInAtBefore applies equality check predicate on the "break_in_at_before" field. It's identical to BreakInAtBeforeEQ.
+func BreakInAtBefore(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldBreakInAtBefore, v))
+}
+
+// BreakInAtAfter applies equality check predicate on the "break_in_at_after" field. It's identical to BreakInAtAfterEQ.
+func BreakInAtAfter(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldBreakInAtAfter, v))
+}
+
+// WorkOutAtBefore applies equality check predicate on the "work_out_at_before" field. It's identical to WorkOutAtBeforeEQ.
+func WorkOutAtBefore(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldWorkOutAtBefore, v))
+}
+
+// WorkOutAtAfter applies equality check predicate on the "work_out_at_after" field. It's identical to WorkOutAtAfterEQ.
+func WorkOutAtAfter(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldWorkOutAtAfter, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AttendanceDayIDEQ applies the EQ predicate on the "attendance_day_id" field.
+func AttendanceDayIDEQ(v int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldAttendanceDayID, v))
+}
+
+// AttendanceDayIDNEQ applies the NEQ predicate on the "attendance_day_id" field.
+func AttendanceDayIDNEQ(v int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNEQ(FieldAttendanceDayID, v))
+}
+
+// AttendanceDayIDIn applies the In predicate on the "attendance_day_id" field.
+func AttendanceDayIDIn(vs ...int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIn(FieldAttendanceDayID, vs...))
+}
+
+// AttendanceDayIDNotIn applies the NotIn predicate on the "attendance_day_id" field.
+func AttendanceDayIDNotIn(vs ...int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotIn(FieldAttendanceDayID, vs...))
+}
+
+// EditorIDEQ applies the EQ predicate on the "editor_id" field.
+func EditorIDEQ(v int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldEditorID, v))
+}
+
+// EditorIDNEQ applies the NEQ predicate on the "editor_id" field.
+func EditorIDNEQ(v int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNEQ(FieldEditorID, v))
+}
+
+// EditorIDIn applies the In predicate on the "editor_id" field.
+func EditorIDIn(vs ...int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIn(FieldEditorID, vs...))
+}
+
+// EditorIDNotIn applies the NotIn predicate on the "editor_id" field.
+func EditorIDNotIn(vs ...int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotIn(FieldEditorID, vs...))
+}
+
+// CorrectionRequestIDEQ applies the EQ predicate on the "correction_request_id" field.
+func CorrectionRequestIDEQ(v int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldCorrectionRequestID, v))
+}
+
+// CorrectionRequestIDNEQ applies the NEQ predicate on the "correction_request_id" field.
+func CorrectionRequestIDNEQ(v int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNEQ(FieldCorrectionRequestID, v))
+}
+
+// CorrectionRequestIDIn applies the In predicate on the "correction_request_id" field.
+func CorrectionRequestIDIn(vs ...int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIn(FieldCorrectionRequestID, vs...))
+}
+
+// CorrectionRequestIDNotIn applies the NotIn predicate on the "correction_request_id" field.
+func CorrectionRequestIDNotIn(vs ...int) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotIn(FieldCorrectionRequestID, vs...))
+}
+
+// CorrectionRequestIDIsNil applies the IsNil predicate on the "correction_request_id" field.
+func CorrectionRequestIDIsNil() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIsNull(FieldCorrectionRequestID))
+}
+
+// CorrectionRequestIDNotNil applies the NotNil predicate on the "correction_request_id" field.
+func CorrectionRequestIDNotNil() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotNull(FieldCorrectionRequestID))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldContainsFold(FieldReason, v))
+}
+
+// WorkInAtBeforeEQ applies the EQ predicate on the "work_in_at_before" field.
+func WorkInAtBeforeEQ(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldWorkInAtBefore, v))
+}
+
+// WorkInAtBeforeNEQ applies the NEQ predicate on the "work_in_at_before" field.
+func WorkInAtBeforeNEQ(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNEQ(FieldWorkInAtBefore, v))
+}
+
+// WorkInAtBeforeIn applies the In predicate on the "work_in_at_before" field.
+func WorkInAtBeforeIn(vs ...time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIn(FieldWorkInAtBefore, vs...))
+}
+
+// WorkInAtBeforeNotIn applies the NotIn predicate on the "work_in_at_before" field.
+func WorkInAtBeforeNotIn(vs ...time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotIn(FieldWorkInAtBefore, vs...))
+}
+
+// WorkInAtBeforeGT applies the GT predicate on the "work_in_at_before" field.
+func WorkInAtBeforeGT(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGT(FieldWorkInAtBefore, v))
+}
+
+// WorkInAtBeforeGTE applies the GTE predicate on the "work_in_at_before" field.
+func WorkInAtBeforeGTE(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGTE(FieldWorkInAtBefore, v))
+}
+
+// WorkInAtBeforeLT applies the LT predicate on the "work_in_at_before" field.
+func WorkInAtBeforeLT(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLT(FieldWorkInAtBefore, v))
+}
+
+// WorkInAtBeforeLTE applies the LTE predicate on the "work_in_at_before" field.
+func WorkInAtBeforeLTE(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLTE(FieldWorkInAtBefore, v))
+}
+
+// WorkInAtBeforeIsNil applies the IsNil predicate on the "work_in_at_before" field.
+func WorkInAtBeforeIsNil() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIsNull(FieldWorkInAtBefore))
+}
+
+// WorkInAtBeforeNotNil applies the NotNil predicate on the "work_in_at_before" field.
+func WorkInAtBeforeNotNil() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotNull(FieldWorkInAtBefore))
+}
+
+// WorkInAtAfterEQ applies the EQ predicate on the "work_in_at_after" field.
+func WorkInAtAfterEQ(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldWorkInAtAfter, v))
+}
+
+// WorkInAtAfterNEQ applies the NEQ predicate on the "work_in_at_after" field.
+func WorkInAtAfterNEQ(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNEQ(FieldWorkInAtAfter, v))
+}
+
+// WorkInAtAfterIn applies the In predicate on the "work_in_at_after" field.
+func WorkInAtAfterIn(vs ...time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIn(FieldWorkInAtAfter, vs...))
+}
+
+// WorkInAtAfterNotIn applies the NotIn predicate on the "work_in_at_after" field.
+func WorkInAtAfterNotIn(vs ...time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotIn(FieldWorkInAtAfter, vs...))
+}
+
+// WorkInAtAfterGT applies the GT predicate on the "work_in_at_after" field.
+func WorkInAtAfterGT(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGT(FieldWorkInAtAfter, v))
+}
+
+// WorkInAtAfterGTE applies the GTE predicate on the "work_in_at_after" field.
+func WorkInAtAfterGTE(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGTE(FieldWorkInAtAfter, v))
+}
+
+// WorkInAtAfterLT applies the LT predicate on the "work_in_at_after" field.
+func WorkInAtAfterLT(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLT(FieldWorkInAtAfter, v))
+}
+
+// WorkInAtAfterLTE applies the LTE predicate on the "work_in_at_after" field.
+func WorkInAtAfterLTE(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLTE(FieldWorkInAtAfter, v))
+}
+
+// WorkInAtAfterIsNil applies the IsNil predicate on the "work_in_at_after" field.
+func WorkInAtAfterIsNil() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIsNull(FieldWorkInAtAfter))
+}
+
+// WorkInAtAfterNotNil applies the NotNil predicate on the "work_in_at_after" field.
+func WorkInAtAfterNotNil() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotNull(FieldWorkInAtAfter))
+}
+
+// BreakOutAtBeforeEQ applies the EQ predicate on the "break_out_at_before" field.
+func BreakOutAtBeforeEQ(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldBreakOutAtBefore, v))
+}
+
+// BreakOutAtBeforeNEQ applies the NEQ predicate on the "break_out_at_before" field.
+func BreakOutAtBeforeNEQ(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNEQ(FieldBreakOutAtBefore, v))
+}
+
+// BreakOutAtBeforeIn applies the In predicate on the "break_out_at_before" field.
+func BreakOutAtBeforeIn(vs ...time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIn(FieldBreakOutAtBefore, vs...))
+}
+
+// BreakOutAtBeforeNotIn applies the NotIn predicate on the "break_out_at_before" field.
+func BreakOutAtBeforeNotIn(vs ...time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotIn(FieldBreakOutAtBefore, vs...))
+}
+
+// BreakOutAtBeforeGT applies the GT predicate on the "break_out_at_before" field.
+func BreakOutAtBeforeGT(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGT(FieldBreakOutAtBefore, v))
+}
+
+// BreakOutAtBeforeGTE applies the GTE predicate on the "break_out_at_before" field.
+func BreakOutAtBeforeGTE(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGTE(FieldBreakOutAtBefore, v))
+}
+
+// BreakOutAtBeforeLT applies the LT predicate on the "break_out_at_before" field.
+func BreakOutAtBeforeLT(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLT(FieldBreakOutAtBefore, v))
+}
+
+// BreakOutAtBeforeLTE applies the LTE predicate on the "break_out_at_before" field.
+func BreakOutAtBeforeLTE(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLTE(FieldBreakOutAtBefore, v))
+}
+
+// BreakOutAtBeforeIsNil applies the IsNil predicate on the "break_out_at_before" field.
+func BreakOutAtBeforeIsNil() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIsNull(FieldBreakOutAtBefore))
+}
+
+// BreakOutAtBeforeNotNil applies the NotNil predicate on the "break_out_at_before" field.
+func BreakOutAtBeforeNotNil() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotNull(FieldBreakOutAtBefore))
+}
+
+// BreakOutAtAfterEQ applies the EQ predicate on the "break_out_at_after" field.
+func BreakOutAtAfterEQ(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldBreakOutAtAfter, v))
+}
+
+// BreakOutAtAfterNEQ applies the NEQ predicate on the "break_out_at_after" field.
+func BreakOutAtAfterNEQ(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNEQ(FieldBreakOutAtAfter, v))
+}
+
+// BreakOutAtAfterIn applies the In predicate on the "break_out_at_after" field.
+func BreakOutAtAfterIn(vs ...time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIn(FieldBreakOutAtAfter, vs...))
+}
+
+// BreakOutAtAfterNotIn applies the NotIn predicate on the "break_out_at_after" field.
+func BreakOutAtAfterNotIn(vs ...time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotIn(FieldBreakOutAtAfter, vs...))
+}
+
+// BreakOutAtAfterGT applies the GT predicate on the "break_out_at_after" field.
+func BreakOutAtAfterGT(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGT(FieldBreakOutAtAfter, v))
+}
+
+// BreakOutAtAfterGTE applies the GTE predicate on the "break_out_at_after" field.
+func BreakOutAtAfterGTE(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGTE(FieldBreakOutAtAfter, v))
+}
+
+// BreakOutAtAfterLT applies the LT predicate on the "break_out_at_after" field.
+func BreakOutAtAfterLT(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLT(FieldBreakOutAtAfter, v))
+}
+
+// BreakOutAtAfterLTE applies the LTE predicate on the "break_out_at_after" field.
+func BreakOutAtAfterLTE(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLTE(FieldBreakOutAtAfter, v))
+}
+
+// BreakOutAtAfterIsNil applies the IsNil predicate on the "break_out_at_after" field.
+func BreakOutAtAfterIsNil() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIsNull(FieldBreakOutAtAfter))
+}
+
+// BreakOutAtAfterNotNil applies the NotNil predicate on the "break_out_at_after" field.
+func BreakOutAtAfterNotNil() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotNull(FieldBreakOutAtAfter))
+}
+
+// BreakInAtBeforeEQ applies the EQ predicate on the "break_in_at_before" field.
+func BreakInAtBeforeEQ(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldBreakInAtBefore, v))
+}
+
+// BreakInAtBeforeNEQ applies the NEQ predicate on the "break_in_at_before" field.
+func BreakInAtBeforeNEQ(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNEQ(FieldBreakInAtBefore, v))
+}
+
+// BreakInAtBeforeIn applies the In predicate on the "break_in_at_before" field.
+func BreakInAtBeforeIn(vs ...time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIn(FieldBreakInAtBefore, vs...))
+}
+
+// BreakInAtBeforeNotIn applies the NotIn predicate on the "break_in_at_before" field.
+func BreakInAtBeforeNotIn(vs ...time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotIn(FieldBreakInAtBefore, vs...))
+}
+
+// BreakInAtBeforeGT applies the GT predicate on the "break_in_at_before" field.
+func BreakInAtBeforeGT(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGT(FieldBreakInAtBefore, v))
+}
+
+// BreakInAtBeforeGTE applies the GTE predicate on the "break_in_at_before" field.
+func BreakInAtBeforeGTE(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGTE(FieldBreakInAtBefore, v))
+}
+
+// BreakInAtBeforeLT applies the LT predicate on the "break_in_at_before" field.
+func BreakInAtBeforeLT(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLT(FieldBreakInAtBefore, v))
+}
+
+// BreakInAtBeforeLTE applies the LTE predicate on the "break_in_at_before" field.
+func BreakInAtBeforeLTE(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLTE(FieldBreakInAtBefore, v))
+}
+
+// BreakInAtBeforeIsNil applies the IsNil predicate on the "break_in_at_before" field.
+func BreakInAtBeforeIsNil() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIsNull(FieldBreakInAtBefore))
+}
+
+// BreakInAtBeforeNotNil applies the NotNil predicate on the "break_in_at_before" field.
+func BreakInAtBeforeNotNil() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotNull(FieldBreakInAtBefore))
+}
+
+// BreakInAtAfterEQ applies the EQ predicate on the "break_in_at_after" field.
+func BreakInAtAfterEQ(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldBreakInAtAfter, v))
+}
+
+// BreakInAtAfterNEQ applies the NEQ predicate on the "break_in_at_after" field.
+func BreakInAtAfterNEQ(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNEQ(FieldBreakInAtAfter, v))
+}
+
+// BreakInAtAfterIn applies the In predicate on the "break_in_at_after" field.
+func BreakInAtAfterIn(vs ...time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIn(FieldBreakInAtAfter, vs...))
+}
+
+// BreakInAtAfterNotIn applies the NotIn predicate on the "break_in_at_after" field.
+func BreakInAtAfterNotIn(vs ...time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotIn(FieldBreakInAtAfter, vs...))
+}
+
+// BreakInAtAfterGT applies the GT predicate on the "break_in_at_after" field.
+func BreakInAtAfterGT(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGT(FieldBreakInAtAfter, v))
+}
+
+// BreakInAtAfterGTE applies the GTE predicate on the "break_in_at_after" field.
+func BreakInAtAfterGTE(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGTE(FieldBreakInAtAfter, v))
+}
+
+// BreakInAtAfterLT applies the LT predicate on the "break_in_at_after" field.
+func BreakInAtAfterLT(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLT(FieldBreakInAtAfter, v))
+}
+
+// BreakInAtAfterLTE applies the LTE predicate on the "break_in_at_after" field.
+func BreakInAtAfterLTE(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLTE(FieldBreakInAtAfter, v))
+}
+
+// BreakInAtAfterIsNil applies the IsNil predicate on the "break_in_at_after" field.
+func BreakInAtAfterIsNil() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIsNull(FieldBreakInAtAfter))
+}
+
+// BreakInAtAfterNotNil applies the NotNil predicate on the "break_in_at_after" field.
+func BreakInAtAfterNotNil() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotNull(FieldBreakInAtAfter))
+}
+
+// WorkOutAtBeforeEQ applies the EQ predicate on the "work_out_at_before" field.
+func WorkOutAtBeforeEQ(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldWorkOutAtBefore, v))
+}
+
+// WorkOutAtBeforeNEQ applies the NEQ predicate on the "work_out_at_before" field.
+func WorkOutAtBeforeNEQ(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNEQ(FieldWorkOutAtBefore, v))
+}
+
+// WorkOutAtBeforeIn applies the In predicate on the "work_out_at_before" field.
+func WorkOutAtBeforeIn(vs ...time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIn(FieldWorkOutAtBefore, vs...))
+}
+
+// WorkOutAtBeforeNotIn applies the NotIn predicate on the "work_out_at_before" field.
+func WorkOutAtBeforeNotIn(vs ...time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotIn(FieldWorkOutAtBefore, vs...))
+}
+
+// WorkOutAtBeforeGT applies the GT predicate on the "work_out_at_before" field.
+func WorkOutAtBeforeGT(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGT(FieldWorkOutAtBefore, v))
+}
+
+// WorkOutAtBeforeGTE applies the GTE predicate on the "work_out_at_before" field.
+func WorkOutAtBeforeGTE(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGTE(FieldWorkOutAtBefore, v))
+}
+
+// WorkOutAtBeforeLT applies the LT predicate on the "work_out_at_before" field.
+func WorkOutAtBeforeLT(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLT(FieldWorkOutAtBefore, v))
+}
+
+// WorkOutAtBeforeLTE applies the LTE predicate on the "work_out_at_before" field.
+func WorkOutAtBeforeLTE(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLTE(FieldWorkOutAtBefore, v))
+}
+
+// WorkOutAtBeforeIsNil applies the IsNil predicate on the "work_out_at_before" field.
+func WorkOutAtBeforeIsNil() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIsNull(FieldWorkOutAtBefore))
+}
+
+// WorkOutAtBeforeNotNil applies the NotNil predicate on the "work_out_at_before" field.
+func WorkOutAtBeforeNotNil() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotNull(FieldWorkOutAtBefore))
+}
+
+// WorkOutAtAfterEQ applies the EQ predicate on the "work_out_at_after" field.
+func WorkOutAtAfterEQ(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldWorkOutAtAfter, v))
+}
+
+// WorkOutAtAfterNEQ applies the NEQ predicate on the "work_out_at_after" field.
+func WorkOutAtAfterNEQ(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNEQ(FieldWorkOutAtAfter, v))
+}
+
+// WorkOutAtAfterIn applies the In predicate on the "work_out_at_after" field.
+func WorkOutAtAfterIn(vs ...time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIn(FieldWorkOutAtAfter, vs...))
+}
+
+// WorkOutAtAfterNotIn applies the NotIn predicate on the "work_out_at_after" field.
+func WorkOutAtAfterNotIn(vs ...time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotIn(FieldWorkOutAtAfter, vs...))
+}
+
+// WorkOutAtAfterGT applies the GT predicate on the "work_out_at_after" field.
+func WorkOutAtAfterGT(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGT(FieldWorkOutAtAfter, v))
+}
+
+// WorkOutAtAfterGTE applies the GTE predicate on the "work_out_at_after" field.
+func WorkOutAtAfterGTE(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGTE(FieldWorkOutAtAfter, v))
+}
+
+// WorkOutAtAfterLT applies the LT predicate on the "work_out_at_after" field.
+func WorkOutAtAfterLT(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLT(FieldWorkOutAtAfter, v))
+}
+
+// WorkOutAtAfterLTE applies the LTE predicate on the "work_out_at_after" field.
+func WorkOutAtAfterLTE(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLTE(FieldWorkOutAtAfter, v))
+}
+
+// WorkOutAtAfterIsNil applies the IsNil predicate on the "work_out_at_after" field.
+func WorkOutAtAfterIsNil() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIsNull(FieldWorkOutAtAfter))
+}
+
+// WorkOutAtAfterNotNil applies the NotNil predicate on the "work_out_at_after" field.
+func WorkOutAtAfterNotNil() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotNull(FieldWorkOutAtAfter))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasAttendanceDay applies the HasEdge predicate on the "attendance_day" edge.
+func HasAttendanceDay() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AttendanceDayTable, AttendanceDayColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttendanceDayWith applies the HasEdge predicate on the "attendance_day" edge with a given conditions (other predicates).
+func HasAttendanceDayWith(preds ...predicate.AttendanceDay) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(func(s *sql.Selector) {
+		step := newAttendanceDayStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEditor applies the HasEdge predicate on the "editor" edge.
+func HasEditor() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, EditorTable, EditorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEditorWith applies the HasEdge predicate on the "editor" edge with a given conditions (other predicates).
+func HasEditorWith(preds ...predicate.User) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(func(s *sql.Selector) {
+		step := newEditorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCorrectionRequest applies the HasEdge predicate on the "correction_request" edge.
+func HasCorrectionRequest() predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CorrectionRequestTable, CorrectionRequestColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCorrectionRequestWith applies the HasEdge predicate on the "correction_request" edge with a given conditions (other predicates).
+func HasCorrectionRequestWith(preds ...predicate.MarkCorrectionRequest) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(func(s *sql.Selector) {
+		step := newCorrectionRequestStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AttendanceEdit) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AttendanceEdit) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AttendanceEdit) predicate.AttendanceEdit {
+	return predicate.AttendanceEdit(sql.NotPredicates(p))
+}
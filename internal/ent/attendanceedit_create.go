@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/attendanceday"
+	"back/internal/ent/attendanceedit"
+	"back/internal/ent/markcorrectionrequest"
+	"back/internal/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttendanceEditCreate is the builder for creating a AttendanceEdit entity.
+type AttendanceEditCreate struct {
+	config
+	mutation *AttendanceEditMutation
+	hooks    []Hook
+}
+
+// SetAttendanceDayID sets the "attendance_day_id" field.
+func (_c *AttendanceEditCreate) SetAttendanceDayID(v int) *AttendanceEditCreate {
+	_c.mutation.SetAttendanceDayID(v)
+	return _c
+}
+
+// SetEditorID sets the "editor_id" field.
+func (_c *AttendanceEditCreate) SetEditorID(v int) *AttendanceEditCreate {
+	_c.mutation.SetEditorID(v)
+	return _c
+}
+
+// SetCorrectionRequestID sets the "correction_request_id" field.
+func (_c *AttendanceEditCreate) SetCorrectionRequestID(v int) *AttendanceEditCreate {
+	_c.mutation.SetCorrectionRequestID(v)
+	return _c
+}
+
+// SetNillableCorrectionRequestID sets the "correction_request_id" field if the given value is not nil.
+func (_c *AttendanceEditCreate) SetNillableCorrectionRequestID(v *int) *AttendanceEditCreate {
+	if v != nil {
+		_c.SetCorrectionRequestID(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *AttendanceEditCreate) SetReason(v string) *AttendanceEditCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetWorkInAtBefore sets the "work_in_at_before" field.
+func (_c *AttendanceEditCreate) SetWorkInAtBefore(v time.Time) *AttendanceEditCreate {
+	_c.mutation.SetWorkInAtBefore(v)
+	return _c
+}
+
+// SetNillableWorkInAtBefore sets the "work_in_at_before" field if the given value is not nil.
+func (_c *AttendanceEditCreate) SetNillableWorkInAtBefore(v *time.Time) *AttendanceEditCreate {
+	if v != nil {
+		_c.SetWorkInAtBefore(*v)
+	}
+	return _c
+}
+
+// SetWorkInAtAfter sets the "work_in_at_after" field.
+func (_c *AttendanceEditCreate) SetWorkInAtAfter(v time.Time) *AttendanceEditCreate {
+	_c.mutation.SetWorkInAtAfter(v)
+	return _c
+}
+
+// SetNillableWorkInAtAfter sets the "work_in_at_after" field if the given value is not nil.
+func (_c *AttendanceEditCreate) SetNillableWorkInAtAfter(v *time.Time) *AttendanceEditCreate {
+	if v != nil {
+		_c.SetWorkInAtAfter(*v)
+	}
+	return _c
+}
+
+// SetBreakOutAtBefore sets the "break_out_at_before" field.
+func (_c *AttendanceEditCreate) SetBreakOutAtBefore(v time.Time) *AttendanceEditCreate {
+	_c.mutation.SetBreakOutAtBefore(v)
+	return _c
+}
+
+// SetNillableBreakOutAtBefore sets the "break_out_at_before" field if the given value is not nil.
+func (_c *AttendanceEditCreate) SetNillableBreakOutAtBefore(v *time.Time) *AttendanceEditCreate {
+	if v != nil {
+		_c.SetBreakOutAtBefore(*v)
+	}
+	return _c
+}
+
+// SetBreakOutAtAfter sets the "break_out_at_after" field.
+func (_c *AttendanceEditCreate) SetBreakOutAtAfter(v time.Time) *AttendanceEditCreate {
+	_c.mutation.SetBreakOutAtAfter(v)
+	return _c
+}
+
+// SetNillableBreakOutAtAfter sets the "break_out_at_after" field if the given value is not nil.
+func (_c *AttendanceEditCreate) SetNillableBreakOutAtAfter(v *time.Time) *AttendanceEditCreate {
+	if v != nil {
+		_c.SetBreakOutAtAfter(*v)
+	}
+	return _c
+}
+
+// SetBreakInAtBefore sets the "break_in_at_before" field.
+func (_c *AttendanceEditCreate) SetBreakInAtBefore(v time.Time) *AttendanceEditCreate {
+	_c.mutation.SetBreakInAtBefore(v)
+	return _c
+}
+
+// SetNillableBreakInAtBefore sets the "break_in_at_before" field if the given value is not nil.
+func (_c *AttendanceEditCreate) SetNillableBreakInAtBefore(v *time.Time) *AttendanceEditCreate {
+	if v != nil {
+		_c.SetBreakInAtBefore(*v)
+	}
+	return _c
+}
+
+// SetBreakInAtAfter sets the "break_in_at_after" field.
+func (_c *AttendanceEditCreate) SetBreakInAtAfter(v time.Time) *AttendanceEditCreate {
+	_c.mutation.SetBreakInAtAfter(v)
+	return _c
+}
+
+// SetNillableBreakInAtAfter sets the "break_in_at_after" field if the given value is not nil.
+func (_c *AttendanceEditCreate) SetNillableBreakInAtAfter(v *time.Time) *AttendanceEditCreate {
+	if v != nil {
+		_c.SetBreakInAtAfter(*v)
+	}
+	return _c
+}
+
+// SetWorkOutAtBefore sets the "work_out_at_before" field.
+func (_c *AttendanceEditCreate) SetWorkOutAtBefore(v time.Time) *AttendanceEditCreate {
+	_c.mutation.SetWorkOutAtBefore(v)
+	return _c
+}
+
+// SetNillableWorkOutAtBefore sets the "work_out_at_before" field if the given value is not nil.
+func (_c *AttendanceEditCreate) SetNillableWorkOutAtBefore(v *time.Time) *AttendanceEditCreate {
+	if v != nil {
+		_c.SetWorkOutAtBefore(*v)
+	}
+	return _c
+}
+
+// SetWorkOutAtAfter sets the "work_out_at_after" field.
+func (_c *AttendanceEditCreate) SetWorkOutAtAfter(v time.Time) *AttendanceEditCreate {
+	_c.mutation.SetWorkOutAtAfter(v)
+	return _c
+}
+
+// SetNillableWorkOutAtAfter sets the "work_out_at_after" field if the given value is not nil.
+func (_c *AttendanceEditCreate) SetNillableWorkOutAtAfter(v *time.Time) *AttendanceEditCreate {
+	if v != nil {
+		_c.SetWorkOutAtAfter(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AttendanceEditCreate) SetCreatedAt(v time.Time) *AttendanceEditCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AttendanceEditCreate) SetNillableCreatedAt(v *time.Time) *AttendanceEditCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAttendanceDay sets the "attendance_day" edge to the AttendanceDay entity.
+func (_c *AttendanceEditCreate) SetAttendanceDay(v *AttendanceDay) *AttendanceEditCreate {
+	return _c.SetAttendanceDayID(v.ID)
+}
+
+// SetEditor sets the "editor" edge to the User entity.
+func (_c *AttendanceEditCreate) SetEditor(v *User) *AttendanceEditCreate {
+	return _c.SetEditorID(v.ID)
+}
+
+// SetCorrectionRequest sets the "correction_request" edge to the MarkCorrectionRequest entity.
+func (_c *AttendanceEditCreate) SetCorrectionRequest(v *MarkCorrectionRequest) *AttendanceEditCreate {
+	return _c.SetCorrectionRequestID(v.ID)
+}
+
+// Mutation returns the AttendanceEditMutation object of the builder.
+func (_c *AttendanceEditCreate) Mutation() *AttendanceEditMutation {
+	return _c.mutation
+}
+
+// Save creates the AttendanceEdit in the database.
+func (_c *AttendanceEditCreate) Save(ctx context.Context) (*AttendanceEdit, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AttendanceEditCreate) SaveX(ctx context.Context) *AttendanceEdit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AttendanceEditCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AttendanceEditCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AttendanceEditCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := attendanceedit.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AttendanceEditCreate) check() error {
+	if _, ok := _c.mutation.AttendanceDayID(); !ok {
+		return &ValidationError{Name: "attendance_day_id", err: errors.New(`ent: missing required field "AttendanceEdit.attendance_day_id"`)}
+	}
+	if _, ok := _c.mutation.EditorID(); !ok {
+		return &ValidationError{Name: "editor_id", err: errors.New(`ent: missing required field "AttendanceEdit.editor_id"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "AttendanceEdit.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := attendanceedit.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "AttendanceEdit.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AttendanceEdit.created_at"`)}
+	}
+	if len(_c.mutation.AttendanceDayIDs()) == 0 {
+		return &ValidationError{Name: "attendance_day", err: errors.New(`ent: missing required edge "AttendanceEdit.attendance_day"`)}
+	}
+	if len(_c.mutation.EditorIDs()) == 0 {
+		return &ValidationError{Name: "editor", err: errors.New(`ent: missing required edge "AttendanceEdit.editor"`)}
+	}
+	return nil
+}
+
+func (_c *AttendanceEditCreate) sqlSave(ctx context.Context) (*AttendanceEdit, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AttendanceEditCreate) createSpec() (*AttendanceEdit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AttendanceEdit{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(attendanceedit.Table, sqlgraph.NewFieldSpec(attendanceedit.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(attendanceedit.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.WorkInAtBefore(); ok {
+		_spec.SetField(attendanceedit.FieldWorkInAtBefore, field.TypeTime, value)
+		_node.WorkInAtBefore = &value
+	}
+	if value, ok := _c.mutation.WorkInAtAfter(); ok {
+		_spec.SetField(attendanceedit.FieldWorkInAtAfter, field.TypeTime, value)
+		_node.WorkInAtAfter = &value
+	}
+	if value, ok := _c.mutation.BreakOutAtBefore(); ok {
+		_spec.SetField(attendanceedit.FieldBreakOutAtBefore, field.TypeTime, value)
+		_node.BreakOutAtBefore = &value
+	}
+	if value, ok := _c.mutation.BreakOutAtAfter(); ok {
+		_spec.SetField(attendanceedit.FieldBreakOutAtAfter, field.TypeTime, value)
+		_node.BreakOutAtAfter = &value
+	}
+	if value, ok := _c.mutation.BreakInAtBefore(); ok {
+		_spec.SetField(attendanceedit.FieldBreakInAtBefore, field.TypeTime, value)
+		_node.BreakInAtBefore = &value
+	}
+	if value, ok := _c.mutation.BreakInAtAfter(); ok {
+		_spec.SetField(attendanceedit.FieldBreakInAtAfter, field.TypeTime, value)
+		_node.BreakInAtAfter = &value
+	}
+	if value, ok := _c.mutation.WorkOutAtBefore(); ok {
+		_spec.SetField(attendanceedit.FieldWorkOutAtBefore, field.TypeTime, value)
+		_node.WorkOutAtBefore = &value
+	}
+	if value, ok := _c.mutation.WorkOutAtAfter(); ok {
+		_spec.SetField(attendanceedit.FieldWorkOutAtAfter, field.TypeTime, value)
+		_node.WorkOutAtAfter = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(attendanceedit.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.AttendanceDayIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attendanceedit.AttendanceDayTable,
+			Columns: []string{attendanceedit.AttendanceDayColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AttendanceDayID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EditorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attendanceedit.EditorTable,
+			Columns: []string{attendanceedit.EditorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EditorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CorrectionRequestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attendanceedit.CorrectionRequestTable,
+			Columns: []string{attendanceedit.CorrectionRequestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(markcorrectionrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CorrectionRequestID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AttendanceEditCreateBulk is the builder for creating many AttendanceEdit entities in bulk.
+type AttendanceEditCreateBulk struct {
+	config
+	err      error
+	builders []*AttendanceEditCreate
+}
+
+// Save creates the AttendanceEdit entities in the database.
+func (_c *AttendanceEditCreateBulk) Save(ctx context.Context) ([]*AttendanceEdit, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AttendanceEdit, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttendanceEditMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AttendanceEditCreateBulk) SaveX(ctx context.Context) []*AttendanceEdit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AttendanceEditCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AttendanceEditCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
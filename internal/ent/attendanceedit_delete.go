@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/attendanceedit"
+	"back/internal/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttendanceEditDelete is the builder for deleting a AttendanceEdit entity.
+type AttendanceEditDelete struct {
+	config
+	hooks    []Hook
+	mutation *AttendanceEditMutation
+}
+
+// Where appends a list predicates to the AttendanceEditDelete builder.
+func (_d *AttendanceEditDelete) Where(ps ...predicate.AttendanceEdit) *AttendanceEditDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AttendanceEditDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AttendanceEditDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AttendanceEditDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(attendanceedit.Table, sqlgraph.NewFieldSpec(attendanceedit.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AttendanceEditDeleteOne is the builder for deleting a single AttendanceEdit entity.
+type AttendanceEditDeleteOne struct {
+	_d *AttendanceEditDelete
+}
+
+// Where appends a list predicates to the AttendanceEditDelete builder.
+func (_d *AttendanceEditDeleteOne) Where(ps ...predicate.AttendanceEdit) *AttendanceEditDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AttendanceEditDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{attendanceedit.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AttendanceEditDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
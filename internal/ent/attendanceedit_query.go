@@ -0,0 +1,759 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/attendanceday"
+	"back/internal/ent/attendanceedit"
+	"back/internal/ent/markcorrectionrequest"
+	"back/internal/ent/predicate"
+	"back/internal/ent/user"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttendanceEditQuery is the builder for querying AttendanceEdit entities.
+type AttendanceEditQuery struct {
+	config
+	ctx                   *QueryContext
+	order                 []attendanceedit.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.AttendanceEdit
+	withAttendanceDay     *AttendanceDayQuery
+	withEditor            *UserQuery
+	withCorrectionRequest *MarkCorrectionRequestQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AttendanceEditQuery builder.
+func (_q *AttendanceEditQuery) Where(ps ...predicate.AttendanceEdit) *AttendanceEditQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *AttendanceEditQuery) Limit(limit int) *AttendanceEditQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *AttendanceEditQuery) Offset(offset int) *AttendanceEditQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *AttendanceEditQuery) Unique(unique bool) *AttendanceEditQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *AttendanceEditQuery) Order(o ...attendanceedit.OrderOption) *AttendanceEditQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryAttendanceDay chains the current query on the "attendance_day" edge.
+func (_q *AttendanceEditQuery) QueryAttendanceDay() *AttendanceDayQuery {
+	query := (&AttendanceDayClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendanceedit.Table, attendanceedit.FieldID, selector),
+			sqlgraph.To(attendanceday.Table, attendanceday.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, attendanceedit.AttendanceDayTable, attendanceedit.AttendanceDayColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEditor chains the current query on the "editor" edge.
+func (_q *AttendanceEditQuery) QueryEditor() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendanceedit.Table, attendanceedit.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, attendanceedit.EditorTable, attendanceedit.EditorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCorrectionRequest chains the current query on the "correction_request" edge.
+func (_q *AttendanceEditQuery) QueryCorrectionRequest() *MarkCorrectionRequestQuery {
+	query := (&MarkCorrectionRequestClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendanceedit.Table, attendanceedit.FieldID, selector),
+			sqlgraph.To(markcorrectionrequest.Table, markcorrectionrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, attendanceedit.CorrectionRequestTable, attendanceedit.CorrectionRequestColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first AttendanceEdit entity from the query.
+// Returns a *NotFoundError when no AttendanceEdit was found.
+func (_q *AttendanceEditQuery) First(ctx context.Context) (*AttendanceEdit, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{attendanceedit.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *AttendanceEditQuery) FirstX(ctx context.Context) *AttendanceEdit {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AttendanceEdit ID from the query.
+// Returns a *NotFoundError when no AttendanceEdit ID was found.
+func (_q *AttendanceEditQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{attendanceedit.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *AttendanceEditQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AttendanceEdit entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AttendanceEdit entity is found.
+// Returns a *NotFoundError when no AttendanceEdit entities are found.
+func (_q *AttendanceEditQuery) Only(ctx context.Context) (*AttendanceEdit, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{attendanceedit.Label}
+	default:
+		return nil, &NotSingularError{attendanceedit.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *AttendanceEditQuery) OnlyX(ctx context.Context) *AttendanceEdit {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AttendanceEdit ID in the query.
+// Returns a *NotSingularError when more than one AttendanceEdit ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *AttendanceEditQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{attendanceedit.Label}
+	default:
+		err = &NotSingularError{attendanceedit.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *AttendanceEditQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AttendanceEdits.
+func (_q *AttendanceEditQuery) All(ctx context.Context) ([]*AttendanceEdit, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AttendanceEdit, *AttendanceEditQuery]()
+	return withInterceptors[[]*AttendanceEdit](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *AttendanceEditQuery) AllX(ctx context.Context) []*AttendanceEdit {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AttendanceEdit IDs.
+func (_q *AttendanceEditQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(attendanceedit.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *AttendanceEditQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *AttendanceEditQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*AttendanceEditQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *AttendanceEditQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *AttendanceEditQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *AttendanceEditQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AttendanceEditQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *AttendanceEditQuery) Clone() *AttendanceEditQuery {
+	if _q == nil {
+		return nil
+	}
+	return &AttendanceEditQuery{
+		config:                _q.config,
+		ctx:                   _q.ctx.Clone(),
+		order:                 append([]attendanceedit.OrderOption{}, _q.order...),
+		inters:                append([]Interceptor{}, _q.inters...),
+		predicates:            append([]predicate.AttendanceEdit{}, _q.predicates...),
+		withAttendanceDay:     _q.withAttendanceDay.Clone(),
+		withEditor:            _q.withEditor.Clone(),
+		withCorrectionRequest: _q.withCorrectionRequest.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithAttendanceDay tells the query-builder to eager-load the nodes that are connected to
+// the "attendance_day" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AttendanceEditQuery) WithAttendanceDay(opts ...func(*AttendanceDayQuery)) *AttendanceEditQuery {
+	query := (&AttendanceDayClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAttendanceDay = query
+	return _q
+}
+
+// WithEditor tells the query-builder to eager-load the nodes that are connected to
+// the "editor" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AttendanceEditQuery) WithEditor(opts ...func(*UserQuery)) *AttendanceEditQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEditor = query
+	return _q
+}
+
+// WithCorrectionRequest tells the query-builder to eager-load the nodes that are connected to
+// the "correction_request" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AttendanceEditQuery) WithCorrectionRequest(opts ...func(*MarkCorrectionRequestQuery)) *AttendanceEditQuery {
+	query := (&MarkCorrectionRequestClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCorrectionRequest = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AttendanceDayID int `json:"attendance_day_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AttendanceEdit.Query().
+//		GroupBy(attendanceedit.FieldAttendanceDayID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *AttendanceEditQuery) GroupBy(field string, fields ...string) *AttendanceEditGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AttendanceEditGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = attendanceedit.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AttendanceDayID int `json:"attendance_day_id,omitempty"`
+//	}
+//
+//	client.AttendanceEdit.Query().
+//		Select(attendanceedit.FieldAttendanceDayID).
+//		Scan(ctx, &v)
+func (_q *AttendanceEditQuery) Select(fields ...string) *AttendanceEditSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &AttendanceEditSelect{AttendanceEditQuery: _q}
+	sbuild.label = attendanceedit.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AttendanceEditSelect configured with the given aggregations.
+func (_q *AttendanceEditQuery) Aggregate(fns ...AggregateFunc) *AttendanceEditSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *AttendanceEditQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !attendanceedit.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *AttendanceEditQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AttendanceEdit, error) {
+	var (
+		nodes       = []*AttendanceEdit{}
+		_spec       = _q.querySpec()
+		loadedTypes = [3]bool{
+			_q.withAttendanceDay != nil,
+			_q.withEditor != nil,
+			_q.withCorrectionRequest != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AttendanceEdit).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AttendanceEdit{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withAttendanceDay; query != nil {
+		if err := _q.loadAttendanceDay(ctx, query, nodes, nil,
+			func(n *AttendanceEdit, e *AttendanceDay) { n.Edges.AttendanceDay = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEditor; query != nil {
+		if err := _q.loadEditor(ctx, query, nodes, nil,
+			func(n *AttendanceEdit, e *User) { n.Edges.Editor = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCorrectionRequest; query != nil {
+		if err := _q.loadCorrectionRequest(ctx, query, nodes, nil,
+			func(n *AttendanceEdit, e *MarkCorrectionRequest) { n.Edges.CorrectionRequest = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *AttendanceEditQuery) loadAttendanceDay(ctx context.Context, query *AttendanceDayQuery, nodes []*AttendanceEdit, init func(*AttendanceEdit), assign func(*AttendanceEdit, *AttendanceDay)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*AttendanceEdit)
+	for i := range nodes {
+		fk := nodes[i].AttendanceDayID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(attendanceday.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "attendance_day_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *AttendanceEditQuery) loadEditor(ctx context.Context, query *UserQuery, nodes []*AttendanceEdit, init func(*AttendanceEdit), assign func(*AttendanceEdit, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*AttendanceEdit)
+	for i := range nodes {
+		fk := nodes[i].EditorID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "editor_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *AttendanceEditQuery) loadCorrectionRequest(ctx context.Context, query *MarkCorrectionRequestQuery, nodes []*AttendanceEdit, init func(*AttendanceEdit), assign func(*AttendanceEdit, *MarkCorrectionRequest)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*AttendanceEdit)
+	for i := range nodes {
+		if nodes[i].CorrectionRequestID == nil {
+			continue
+		}
+		fk := *nodes[i].CorrectionRequestID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(markcorrectionrequest.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "correction_request_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *AttendanceEditQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *AttendanceEditQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(attendanceedit.Table, attendanceedit.Columns, sqlgraph.NewFieldSpec(attendanceedit.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attendanceedit.FieldID)
+		for i := range fields {
+			if fields[i] != attendanceedit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withAttendanceDay != nil {
+			_spec.Node.AddColumnOnce(attendanceedit.FieldAttendanceDayID)
+		}
+		if _q.withEditor != nil {
+			_spec.Node.AddColumnOnce(attendanceedit.FieldEditorID)
+		}
+		if _q.withCorrectionRequest != nil {
+			_spec.Node.AddColumnOnce(attendanceedit.FieldCorrectionRequestID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *AttendanceEditQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(attendanceedit.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = attendanceedit.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// AttendanceEditGroupBy is the group-by builder for AttendanceEdit entities.
+type AttendanceEditGroupBy struct {
+	selector
+	build *AttendanceEditQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *AttendanceEditGroupBy) Aggregate(fns ...AggregateFunc) *AttendanceEditGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *AttendanceEditGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AttendanceEditQuery, *AttendanceEditGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *AttendanceEditGroupBy) sqlScan(ctx context.Context, root *AttendanceEditQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AttendanceEditSelect is the builder for selecting fields of AttendanceEdit entities.
+type AttendanceEditSelect struct {
+	*AttendanceEditQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *AttendanceEditSelect) Aggregate(fns ...AggregateFunc) *AttendanceEditSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *AttendanceEditSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AttendanceEditQuery, *AttendanceEditSelect](ctx, _s.AttendanceEditQuery, _s, _s.inters, v)
+}
+
+func (_s *AttendanceEditSelect) sqlScan(ctx context.Context, root *AttendanceEditQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
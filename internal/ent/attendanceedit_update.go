@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/attendanceedit"
+	"back/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttendanceEditUpdate is the builder for updating AttendanceEdit entities.
+type AttendanceEditUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AttendanceEditMutation
+}
+
+// Where appends a list predicates to the AttendanceEditUpdate builder.
+func (_u *AttendanceEditUpdate) Where(ps ...predicate.AttendanceEdit) *AttendanceEditUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the AttendanceEditMutation object of the builder.
+func (_u *AttendanceEditUpdate) Mutation() *AttendanceEditMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AttendanceEditUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AttendanceEditUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AttendanceEditUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AttendanceEditUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AttendanceEditUpdate) check() error {
+	if _u.mutation.AttendanceDayCleared() && len(_u.mutation.AttendanceDayIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttendanceEdit.attendance_day"`)
+	}
+	if _u.mutation.EditorCleared() && len(_u.mutation.EditorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttendanceEdit.editor"`)
+	}
+	return nil
+}
+
+func (_u *AttendanceEditUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attendanceedit.Table, attendanceedit.Columns, sqlgraph.NewFieldSpec(attendanceedit.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.WorkInAtBeforeCleared() {
+		_spec.ClearField(attendanceedit.FieldWorkInAtBefore, field.TypeTime)
+	}
+	if _u.mutation.WorkInAtAfterCleared() {
+		_spec.ClearField(attendanceedit.FieldWorkInAtAfter, field.TypeTime)
+	}
+	if _u.mutation.BreakOutAtBeforeCleared() {
+		_spec.ClearField(attendanceedit.FieldBreakOutAtBefore, field.TypeTime)
+	}
+	if _u.mutation.BreakOutAtAfterCleared() {
+		_spec.ClearField(attendanceedit.FieldBreakOutAtAfter, field.TypeTime)
+	}
+	if _u.mutation.BreakInAtBeforeCleared() {
+		_spec.ClearField(attendanceedit.FieldBreakInAtBefore, field.TypeTime)
+	}
+	if _u.mutation.BreakInAtAfterCleared() {
+		_spec.ClearField(attendanceedit.FieldBreakInAtAfter, field.TypeTime)
+	}
+	if _u.mutation.WorkOutAtBeforeCleared() {
+		_spec.ClearField(attendanceedit.FieldWorkOutAtBefore, field.TypeTime)
+	}
+	if _u.mutation.WorkOutAtAfterCleared() {
+		_spec.ClearField(attendanceedit.FieldWorkOutAtAfter, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attendanceedit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AttendanceEditUpdateOne is the builder for updating a single AttendanceEdit entity.
+type AttendanceEditUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AttendanceEditMutation
+}
+
+// Mutation returns the AttendanceEditMutation object of the builder.
+func (_u *AttendanceEditUpdateOne) Mutation() *AttendanceEditMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AttendanceEditUpdate builder.
+func (_u *AttendanceEditUpdateOne) Where(ps ...predicate.AttendanceEdit) *AttendanceEditUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AttendanceEditUpdateOne) Select(field string, fields ...string) *AttendanceEditUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AttendanceEdit entity.
+func (_u *AttendanceEditUpdateOne) Save(ctx context.Context) (*AttendanceEdit, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AttendanceEditUpdateOne) SaveX(ctx context.Context) *AttendanceEdit {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AttendanceEditUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AttendanceEditUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AttendanceEditUpdateOne) check() error {
+	if _u.mutation.AttendanceDayCleared() && len(_u.mutation.AttendanceDayIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttendanceEdit.attendance_day"`)
+	}
+	if _u.mutation.EditorCleared() && len(_u.mutation.EditorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttendanceEdit.editor"`)
+	}
+	return nil
+}
+
+func (_u *AttendanceEditUpdateOne) sqlSave(ctx context.Context) (_node *AttendanceEdit, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attendanceedit.Table, attendanceedit.Columns, sqlgraph.NewFieldSpec(attendanceedit.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AttendanceEdit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attendanceedit.FieldID)
+		for _, f := range fields {
+			if !attendanceedit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attendanceedit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.WorkInAtBeforeCleared() {
+		_spec.ClearField(attendanceedit.FieldWorkInAtBefore, field.TypeTime)
+	}
+	if _u.mutation.WorkInAtAfterCleared() {
+		_spec.ClearField(attendanceedit.FieldWorkInAtAfter, field.TypeTime)
+	}
+	if _u.mutation.BreakOutAtBeforeCleared() {
+		_spec.ClearField(attendanceedit.FieldBreakOutAtBefore, field.TypeTime)
+	}
+	if _u.mutation.BreakOutAtAfterCleared() {
+		_spec.ClearField(attendanceedit.FieldBreakOutAtAfter, field.TypeTime)
+	}
+	if _u.mutation.BreakInAtBeforeCleared() {
+		_spec.ClearField(attendanceedit.FieldBreakInAtBefore, field.TypeTime)
+	}
+	if _u.mutation.BreakInAtAfterCleared() {
+		_spec.ClearField(attendanceedit.FieldBreakInAtAfter, field.TypeTime)
+	}
+	if _u.mutation.WorkOutAtBeforeCleared() {
+		_spec.ClearField(attendanceedit.FieldWorkOutAtBefore, field.TypeTime)
+	}
+	if _u.mutation.WorkOutAtAfterCleared() {
+		_spec.ClearField(attendanceedit.FieldWorkOutAtAfter, field.TypeTime)
+	}
+	_node = &AttendanceEdit{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attendanceedit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
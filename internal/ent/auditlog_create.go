@@ -0,0 +1,320 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/auditlog"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditLogCreate is the builder for creating a AuditLog entity.
+type AuditLogCreate struct {
+	config
+	mutation *AuditLogMutation
+	hooks    []Hook
+}
+
+// SetActorID sets the "actor_id" field.
+func (_c *AuditLogCreate) SetActorID(v int) *AuditLogCreate {
+	_c.mutation.SetActorID(v)
+	return _c
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableActorID(v *int) *AuditLogCreate {
+	if v != nil {
+		_c.SetActorID(*v)
+	}
+	return _c
+}
+
+// SetActorDeviceID sets the "actor_device_id" field.
+func (_c *AuditLogCreate) SetActorDeviceID(v int) *AuditLogCreate {
+	_c.mutation.SetActorDeviceID(v)
+	return _c
+}
+
+// SetNillableActorDeviceID sets the "actor_device_id" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableActorDeviceID(v *int) *AuditLogCreate {
+	if v != nil {
+		_c.SetActorDeviceID(*v)
+	}
+	return _c
+}
+
+// SetRequestID sets the "request_id" field.
+func (_c *AuditLogCreate) SetRequestID(v string) *AuditLogCreate {
+	_c.mutation.SetRequestID(v)
+	return _c
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableRequestID(v *string) *AuditLogCreate {
+	if v != nil {
+		_c.SetRequestID(*v)
+	}
+	return _c
+}
+
+// SetEntityType sets the "entity_type" field.
+func (_c *AuditLogCreate) SetEntityType(v string) *AuditLogCreate {
+	_c.mutation.SetEntityType(v)
+	return _c
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_c *AuditLogCreate) SetEntityID(v int) *AuditLogCreate {
+	_c.mutation.SetEntityID(v)
+	return _c
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableEntityID(v *int) *AuditLogCreate {
+	if v != nil {
+		_c.SetEntityID(*v)
+	}
+	return _c
+}
+
+// SetOperation sets the "operation" field.
+func (_c *AuditLogCreate) SetOperation(v string) *AuditLogCreate {
+	_c.mutation.SetOperation(v)
+	return _c
+}
+
+// SetChanges sets the "changes" field.
+func (_c *AuditLogCreate) SetChanges(v map[string]interface{}) *AuditLogCreate {
+	_c.mutation.SetChanges(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AuditLogCreate) SetCreatedAt(v time.Time) *AuditLogCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AuditLogCreate) SetNillableCreatedAt(v *time.Time) *AuditLogCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (_c *AuditLogCreate) Mutation() *AuditLogMutation {
+	return _c.mutation
+}
+
+// Save creates the AuditLog in the database.
+func (_c *AuditLogCreate) Save(ctx context.Context) (*AuditLog, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AuditLogCreate) SaveX(ctx context.Context) *AuditLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditLogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditLogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AuditLogCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := auditlog.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AuditLogCreate) check() error {
+	if _, ok := _c.mutation.EntityType(); !ok {
+		return &ValidationError{Name: "entity_type", err: errors.New(`ent: missing required field "AuditLog.entity_type"`)}
+	}
+	if v, ok := _c.mutation.EntityType(); ok {
+		if err := auditlog.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "AuditLog.entity_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`ent: missing required field "AuditLog.operation"`)}
+	}
+	if v, ok := _c.mutation.Operation(); ok {
+		if err := auditlog.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "AuditLog.operation": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditLog.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AuditLogCreate) sqlSave(ctx context.Context) (*AuditLog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(auditlog.Table, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeInt, value)
+		_node.ActorID = &value
+	}
+	if value, ok := _c.mutation.ActorDeviceID(); ok {
+		_spec.SetField(auditlog.FieldActorDeviceID, field.TypeInt, value)
+		_node.ActorDeviceID = &value
+	}
+	if value, ok := _c.mutation.RequestID(); ok {
+		_spec.SetField(auditlog.FieldRequestID, field.TypeString, value)
+		_node.RequestID = &value
+	}
+	if value, ok := _c.mutation.EntityType(); ok {
+		_spec.SetField(auditlog.FieldEntityType, field.TypeString, value)
+		_node.EntityType = value
+	}
+	if value, ok := _c.mutation.EntityID(); ok {
+		_spec.SetField(auditlog.FieldEntityID, field.TypeInt, value)
+		_node.EntityID = &value
+	}
+	if value, ok := _c.mutation.Operation(); ok {
+		_spec.SetField(auditlog.FieldOperation, field.TypeString, value)
+		_node.Operation = value
+	}
+	if value, ok := _c.mutation.Changes(); ok {
+		_spec.SetField(auditlog.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AuditLogCreateBulk is the builder for creating many AuditLog entities in bulk.
+type AuditLogCreateBulk struct {
+	config
+	err      error
+	builders []*AuditLogCreate
+}
+
+// Save creates the AuditLog entities in the database.
+func (_c *AuditLogCreateBulk) Save(ctx context.Context) ([]*AuditLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AuditLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AuditLogCreateBulk) SaveX(ctx context.Context) []*AuditLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/auditlog"
+	"back/internal/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditLogUpdate is the builder for updating AuditLog entities.
+type AuditLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (_u *AuditLogUpdate) Where(ps ...predicate.AuditLog) *AuditLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (_u *AuditLogUpdate) Mutation() *AuditLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuditLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AuditLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(auditlog.FieldActorID, field.TypeInt)
+	}
+	if _u.mutation.ActorDeviceIDCleared() {
+		_spec.ClearField(auditlog.FieldActorDeviceID, field.TypeInt)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(auditlog.FieldRequestID, field.TypeString)
+	}
+	if _u.mutation.EntityIDCleared() {
+		_spec.ClearField(auditlog.FieldEntityID, field.TypeInt)
+	}
+	if _u.mutation.ChangesCleared() {
+		_spec.ClearField(auditlog.FieldChanges, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuditLogUpdateOne is the builder for updating a single AuditLog entity.
+type AuditLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (_u *AuditLogUpdateOne) Mutation() *AuditLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (_u *AuditLogUpdateOne) Where(ps ...predicate.AuditLog) *AuditLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuditLogUpdateOne) Select(field string, fields ...string) *AuditLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuditLog entity.
+func (_u *AuditLogUpdateOne) Save(ctx context.Context) (*AuditLog, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditLogUpdateOne) SaveX(ctx context.Context) *AuditLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlog.FieldID)
+		for _, f := range fields {
+			if !auditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(auditlog.FieldActorID, field.TypeInt)
+	}
+	if _u.mutation.ActorDeviceIDCleared() {
+		_spec.ClearField(auditlog.FieldActorDeviceID, field.TypeInt)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(auditlog.FieldRequestID, field.TypeString)
+	}
+	if _u.mutation.EntityIDCleared() {
+		_spec.ClearField(auditlog.FieldEntityID, field.TypeInt)
+	}
+	if _u.mutation.ChangesCleared() {
+		_spec.ClearField(auditlog.FieldChanges, field.TypeJSON)
+	}
+	_node = &AuditLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
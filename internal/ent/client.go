@@ -14,14 +14,25 @@ import (
 	"back/internal/ent/accesspoint"
 	"back/internal/ent/address"
 	"back/internal/ent/attendanceday"
+	"back/internal/ent/attendanceedit"
+	"back/internal/ent/auditlog"
 	"back/internal/ent/branch"
 	"back/internal/ent/branchaddress"
 	"back/internal/ent/city"
 	"back/internal/ent/commune"
 	"back/internal/ent/device"
+	"back/internal/ent/holiday"
+	"back/internal/ent/hourbankmovement"
+	"back/internal/ent/leaverequest"
+	"back/internal/ent/markcorrectionrequest"
+	"back/internal/ent/overtimeauthorization"
+	"back/internal/ent/payrollexportprofile"
+	"back/internal/ent/punch"
 	"back/internal/ent/refreshtoken"
 	"back/internal/ent/region"
+	"back/internal/ent/role"
 	"back/internal/ent/shift"
+	"back/internal/ent/shiftcycleday"
 	"back/internal/ent/shiftday"
 	"back/internal/ent/shiftinstance"
 	"back/internal/ent/user"
@@ -30,6 +41,7 @@ import (
 	"back/internal/ent/userdayoverride"
 	"back/internal/ent/userqrsession"
 	"back/internal/ent/usershiftassignment"
+	"back/internal/ent/vacationadjustment"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -48,6 +60,10 @@ type Client struct {
 	Address *AddressClient
 	// AttendanceDay is the client for interacting with the AttendanceDay builders.
 	AttendanceDay *AttendanceDayClient
+	// AttendanceEdit is the client for interacting with the AttendanceEdit builders.
+	AttendanceEdit *AttendanceEditClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
 	// Branch is the client for interacting with the Branch builders.
 	Branch *BranchClient
 	// BranchAddress is the client for interacting with the BranchAddress builders.
@@ -58,12 +74,30 @@ type Client struct {
 	Commune *CommuneClient
 	// Device is the client for interacting with the Device builders.
 	Device *DeviceClient
+	// Holiday is the client for interacting with the Holiday builders.
+	Holiday *HolidayClient
+	// HourBankMovement is the client for interacting with the HourBankMovement builders.
+	HourBankMovement *HourBankMovementClient
+	// LeaveRequest is the client for interacting with the LeaveRequest builders.
+	LeaveRequest *LeaveRequestClient
+	// MarkCorrectionRequest is the client for interacting with the MarkCorrectionRequest builders.
+	MarkCorrectionRequest *MarkCorrectionRequestClient
+	// OvertimeAuthorization is the client for interacting with the OvertimeAuthorization builders.
+	OvertimeAuthorization *OvertimeAuthorizationClient
+	// PayrollExportProfile is the client for interacting with the PayrollExportProfile builders.
+	PayrollExportProfile *PayrollExportProfileClient
+	// Punch is the client for interacting with the Punch builders.
+	Punch *PunchClient
 	// RefreshToken is the client for interacting with the RefreshToken builders.
 	RefreshToken *RefreshTokenClient
 	// Region is the client for interacting with the Region builders.
 	Region *RegionClient
+	// Role is the client for interacting with the Role builders.
+	Role *RoleClient
 	// Shift is the client for interacting with the Shift builders.
 	Shift *ShiftClient
+	// ShiftCycleDay is the client for interacting with the ShiftCycleDay builders.
+	ShiftCycleDay *ShiftCycleDayClient
 	// ShiftDay is the client for interacting with the ShiftDay builders.
 	ShiftDay *ShiftDayClient
 	// ShiftInstance is the client for interacting with the ShiftInstance builders.
@@ -80,6 +114,8 @@ type Client struct {
 	UserQRSession *UserQRSessionClient
 	// UserShiftAssignment is the client for interacting with the UserShiftAssignment builders.
 	UserShiftAssignment *UserShiftAssignmentClient
+	// VacationAdjustment is the client for interacting with the VacationAdjustment builders.
+	VacationAdjustment *VacationAdjustmentClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -94,14 +130,25 @@ func (c *Client) init() {
 	c.AccessPoint = NewAccessPointClient(c.config)
 	c.Address = NewAddressClient(c.config)
 	c.AttendanceDay = NewAttendanceDayClient(c.config)
+	c.AttendanceEdit = NewAttendanceEditClient(c.config)
+	c.AuditLog = NewAuditLogClient(c.config)
 	c.Branch = NewBranchClient(c.config)
 	c.BranchAddress = NewBranchAddressClient(c.config)
 	c.City = NewCityClient(c.config)
 	c.Commune = NewCommuneClient(c.config)
 	c.Device = NewDeviceClient(c.config)
+	c.Holiday = NewHolidayClient(c.config)
+	c.HourBankMovement = NewHourBankMovementClient(c.config)
+	c.LeaveRequest = NewLeaveRequestClient(c.config)
+	c.MarkCorrectionRequest = NewMarkCorrectionRequestClient(c.config)
+	c.OvertimeAuthorization = NewOvertimeAuthorizationClient(c.config)
+	c.PayrollExportProfile = NewPayrollExportProfileClient(c.config)
+	c.Punch = NewPunchClient(c.config)
 	c.RefreshToken = NewRefreshTokenClient(c.config)
 	c.Region = NewRegionClient(c.config)
+	c.Role = NewRoleClient(c.config)
 	c.Shift = NewShiftClient(c.config)
+	c.ShiftCycleDay = NewShiftCycleDayClient(c.config)
 	c.ShiftDay = NewShiftDayClient(c.config)
 	c.ShiftInstance = NewShiftInstanceClient(c.config)
 	c.User = NewUserClient(c.config)
@@ -110,6 +157,7 @@ func (c *Client) init() {
 	c.UserDayOverride = NewUserDayOverrideClient(c.config)
 	c.UserQRSession = NewUserQRSessionClient(c.config)
 	c.UserShiftAssignment = NewUserShiftAssignmentClient(c.config)
+	c.VacationAdjustment = NewVacationAdjustmentClient(c.config)
 }
 
 type (
@@ -200,27 +248,39 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                 ctx,
-		config:              cfg,
-		AccessPoint:         NewAccessPointClient(cfg),
-		Address:             NewAddressClient(cfg),
-		AttendanceDay:       NewAttendanceDayClient(cfg),
-		Branch:              NewBranchClient(cfg),
-		BranchAddress:       NewBranchAddressClient(cfg),
-		City:                NewCityClient(cfg),
-		Commune:             NewCommuneClient(cfg),
-		Device:              NewDeviceClient(cfg),
-		RefreshToken:        NewRefreshTokenClient(cfg),
-		Region:              NewRegionClient(cfg),
-		Shift:               NewShiftClient(cfg),
-		ShiftDay:            NewShiftDayClient(cfg),
-		ShiftInstance:       NewShiftInstanceClient(cfg),
-		User:                NewUserClient(cfg),
-		UserAccessPoint:     NewUserAccessPointClient(cfg),
-		UserBranch:          NewUserBranchClient(cfg),
-		UserDayOverride:     NewUserDayOverrideClient(cfg),
-		UserQRSession:       NewUserQRSessionClient(cfg),
-		UserShiftAssignment: NewUserShiftAssignmentClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		AccessPoint:           NewAccessPointClient(cfg),
+		Address:               NewAddressClient(cfg),
+		AttendanceDay:         NewAttendanceDayClient(cfg),
+		AttendanceEdit:        NewAttendanceEditClient(cfg),
+		AuditLog:              NewAuditLogClient(cfg),
+		Branch:                NewBranchClient(cfg),
+		BranchAddress:         NewBranchAddressClient(cfg),
+		City:                  NewCityClient(cfg),
+		Commune:               NewCommuneClient(cfg),
+		Device:                NewDeviceClient(cfg),
+		Holiday:               NewHolidayClient(cfg),
+		HourBankMovement:      NewHourBankMovementClient(cfg),
+		LeaveRequest:          NewLeaveRequestClient(cfg),
+		MarkCorrectionRequest: NewMarkCorrectionRequestClient(cfg),
+		OvertimeAuthorization: NewOvertimeAuthorizationClient(cfg),
+		PayrollExportProfile:  NewPayrollExportProfileClient(cfg),
+		Punch:                 NewPunchClient(cfg),
+		RefreshToken:          NewRefreshTokenClient(cfg),
+		Region:                NewRegionClient(cfg),
+		Role:                  NewRoleClient(cfg),
+		Shift:                 NewShiftClient(cfg),
+		ShiftCycleDay:         NewShiftCycleDayClient(cfg),
+		ShiftDay:              NewShiftDayClient(cfg),
+		ShiftInstance:         NewShiftInstanceClient(cfg),
+		User:                  NewUserClient(cfg),
+		UserAccessPoint:       NewUserAccessPointClient(cfg),
+		UserBranch:            NewUserBranchClient(cfg),
+		UserDayOverride:       NewUserDayOverrideClient(cfg),
+		UserQRSession:         NewUserQRSessionClient(cfg),
+		UserShiftAssignment:   NewUserShiftAssignmentClient(cfg),
+		VacationAdjustment:    NewVacationAdjustmentClient(cfg),
 	}, nil
 }
 
@@ -238,27 +298,39 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                 ctx,
-		config:              cfg,
-		AccessPoint:         NewAccessPointClient(cfg),
-		Address:             NewAddressClient(cfg),
-		AttendanceDay:       NewAttendanceDayClient(cfg),
-		Branch:              NewBranchClient(cfg),
-		BranchAddress:       NewBranchAddressClient(cfg),
-		City:                NewCityClient(cfg),
-		Commune:             NewCommuneClient(cfg),
-		Device:              NewDeviceClient(cfg),
-		RefreshToken:        NewRefreshTokenClient(cfg),
-		Region:              NewRegionClient(cfg),
-		Shift:               NewShiftClient(cfg),
-		ShiftDay:            NewShiftDayClient(cfg),
-		ShiftInstance:       NewShiftInstanceClient(cfg),
-		User:                NewUserClient(cfg),
-		UserAccessPoint:     NewUserAccessPointClient(cfg),
-		UserBranch:          NewUserBranchClient(cfg),
-		UserDayOverride:     NewUserDayOverrideClient(cfg),
-		UserQRSession:       NewUserQRSessionClient(cfg),
-		UserShiftAssignment: NewUserShiftAssignmentClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		AccessPoint:           NewAccessPointClient(cfg),
+		Address:               NewAddressClient(cfg),
+		AttendanceDay:         NewAttendanceDayClient(cfg),
+		AttendanceEdit:        NewAttendanceEditClient(cfg),
+		AuditLog:              NewAuditLogClient(cfg),
+		Branch:                NewBranchClient(cfg),
+		BranchAddress:         NewBranchAddressClient(cfg),
+		City:                  NewCityClient(cfg),
+		Commune:               NewCommuneClient(cfg),
+		Device:                NewDeviceClient(cfg),
+		Holiday:               NewHolidayClient(cfg),
+		HourBankMovement:      NewHourBankMovementClient(cfg),
+		LeaveRequest:          NewLeaveRequestClient(cfg),
+		MarkCorrectionRequest: NewMarkCorrectionRequestClient(cfg),
+		OvertimeAuthorization: NewOvertimeAuthorizationClient(cfg),
+		PayrollExportProfile:  NewPayrollExportProfileClient(cfg),
+		Punch:                 NewPunchClient(cfg),
+		RefreshToken:          NewRefreshTokenClient(cfg),
+		Region:                NewRegionClient(cfg),
+		Role:                  NewRoleClient(cfg),
+		Shift:                 NewShiftClient(cfg),
+		ShiftCycleDay:         NewShiftCycleDayClient(cfg),
+		ShiftDay:              NewShiftDayClient(cfg),
+		ShiftInstance:         NewShiftInstanceClient(cfg),
+		User:                  NewUserClient(cfg),
+		UserAccessPoint:       NewUserAccessPointClient(cfg),
+		UserBranch:            NewUserBranchClient(cfg),
+		UserDayOverride:       NewUserDayOverrideClient(cfg),
+		UserQRSession:         NewUserQRSessionClient(cfg),
+		UserShiftAssignment:   NewUserShiftAssignmentClient(cfg),
+		VacationAdjustment:    NewVacationAdjustmentClient(cfg),
 	}, nil
 }
 
@@ -288,10 +360,13 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AccessPoint, c.Address, c.AttendanceDay, c.Branch, c.BranchAddress, c.City,
-		c.Commune, c.Device, c.RefreshToken, c.Region, c.Shift, c.ShiftDay,
-		c.ShiftInstance, c.User, c.UserAccessPoint, c.UserBranch, c.UserDayOverride,
-		c.UserQRSession, c.UserShiftAssignment,
+		c.AccessPoint, c.Address, c.AttendanceDay, c.AttendanceEdit, c.AuditLog,
+		c.Branch, c.BranchAddress, c.City, c.Commune, c.Device, c.Holiday,
+		c.HourBankMovement, c.LeaveRequest, c.MarkCorrectionRequest,
+		c.OvertimeAuthorization, c.PayrollExportProfile, c.Punch, c.RefreshToken,
+		c.Region, c.Role, c.Shift, c.ShiftCycleDay, c.ShiftDay, c.ShiftInstance,
+		c.User, c.UserAccessPoint, c.UserBranch, c.UserDayOverride, c.UserQRSession,
+		c.UserShiftAssignment, c.VacationAdjustment,
 	} {
 		n.Use(hooks...)
 	}
@@ -301,10 +376,13 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AccessPoint, c.Address, c.AttendanceDay, c.Branch, c.BranchAddress, c.City,
-		c.Commune, c.Device, c.RefreshToken, c.Region, c.Shift, c.ShiftDay,
-		c.ShiftInstance, c.User, c.UserAccessPoint, c.UserBranch, c.UserDayOverride,
-		c.UserQRSession, c.UserShiftAssignment,
+		c.AccessPoint, c.Address, c.AttendanceDay, c.AttendanceEdit, c.AuditLog,
+		c.Branch, c.BranchAddress, c.City, c.Commune, c.Device, c.Holiday,
+		c.HourBankMovement, c.LeaveRequest, c.MarkCorrectionRequest,
+		c.OvertimeAuthorization, c.PayrollExportProfile, c.Punch, c.RefreshToken,
+		c.Region, c.Role, c.Shift, c.ShiftCycleDay, c.ShiftDay, c.ShiftInstance,
+		c.User, c.UserAccessPoint, c.UserBranch, c.UserDayOverride, c.UserQRSession,
+		c.UserShiftAssignment, c.VacationAdjustment,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -319,6 +397,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Address.mutate(ctx, m)
 	case *AttendanceDayMutation:
 		return c.AttendanceDay.mutate(ctx, m)
+	case *AttendanceEditMutation:
+		return c.AttendanceEdit.mutate(ctx, m)
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
 	case *BranchMutation:
 		return c.Branch.mutate(ctx, m)
 	case *BranchAddressMutation:
@@ -329,12 +411,30 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Commune.mutate(ctx, m)
 	case *DeviceMutation:
 		return c.Device.mutate(ctx, m)
+	case *HolidayMutation:
+		return c.Holiday.mutate(ctx, m)
+	case *HourBankMovementMutation:
+		return c.HourBankMovement.mutate(ctx, m)
+	case *LeaveRequestMutation:
+		return c.LeaveRequest.mutate(ctx, m)
+	case *MarkCorrectionRequestMutation:
+		return c.MarkCorrectionRequest.mutate(ctx, m)
+	case *OvertimeAuthorizationMutation:
+		return c.OvertimeAuthorization.mutate(ctx, m)
+	case *PayrollExportProfileMutation:
+		return c.PayrollExportProfile.mutate(ctx, m)
+	case *PunchMutation:
+		return c.Punch.mutate(ctx, m)
 	case *RefreshTokenMutation:
 		return c.RefreshToken.mutate(ctx, m)
 	case *RegionMutation:
 		return c.Region.mutate(ctx, m)
+	case *RoleMutation:
+		return c.Role.mutate(ctx, m)
 	case *ShiftMutation:
 		return c.Shift.mutate(ctx, m)
+	case *ShiftCycleDayMutation:
+		return c.ShiftCycleDay.mutate(ctx, m)
 	case *ShiftDayMutation:
 		return c.ShiftDay.mutate(ctx, m)
 	case *ShiftInstanceMutation:
@@ -351,6 +451,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.UserQRSession.mutate(ctx, m)
 	case *UserShiftAssignmentMutation:
 		return c.UserShiftAssignment.mutate(ctx, m)
+	case *VacationAdjustmentMutation:
+		return c.VacationAdjustment.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -528,6 +630,22 @@ func (c *AccessPointClient) QueryDevices(_m *AccessPoint) *DeviceQuery {
 	return query
 }
 
+// QueryPunches queries the punches edge of a AccessPoint.
+func (c *AccessPointClient) QueryPunches(_m *AccessPoint) *PunchQuery {
+	query := (&PunchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accesspoint.Table, accesspoint.FieldID, id),
+			sqlgraph.To(punch.Table, punch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, accesspoint.PunchesTable, accesspoint.PunchesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *AccessPointClient) Hooks() []Hook {
 	return c.hooks.AccessPoint
@@ -874,6 +992,22 @@ func (c *AttendanceDayClient) QueryAccessPoint(_m *AttendanceDay) *AccessPointQu
 	return query
 }
 
+// QueryPunches queries the punches edge of a AttendanceDay.
+func (c *AttendanceDayClient) QueryPunches(_m *AttendanceDay) *PunchQuery {
+	query := (&PunchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendanceday.Table, attendanceday.FieldID, id),
+			sqlgraph.To(punch.Table, punch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attendanceday.PunchesTable, attendanceday.PunchesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *AttendanceDayClient) Hooks() []Hook {
 	return c.hooks.AttendanceDay
@@ -899,6 +1033,320 @@ func (c *AttendanceDayClient) mutate(ctx context.Context, m *AttendanceDayMutati
 	}
 }
 
+// AttendanceEditClient is a client for the AttendanceEdit schema.
+type AttendanceEditClient struct {
+	config
+}
+
+// NewAttendanceEditClient returns a client for the AttendanceEdit from the given config.
+func NewAttendanceEditClient(c config) *AttendanceEditClient {
+	return &AttendanceEditClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `attendanceedit.Hooks(f(g(h())))`.
+func (c *AttendanceEditClient) Use(hooks ...Hook) {
+	c.hooks.AttendanceEdit = append(c.hooks.AttendanceEdit, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `attendanceedit.Intercept(f(g(h())))`.
+func (c *AttendanceEditClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AttendanceEdit = append(c.inters.AttendanceEdit, interceptors...)
+}
+
+// Create returns a builder for creating a AttendanceEdit entity.
+func (c *AttendanceEditClient) Create() *AttendanceEditCreate {
+	mutation := newAttendanceEditMutation(c.config, OpCreate)
+	return &AttendanceEditCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AttendanceEdit entities.
+func (c *AttendanceEditClient) CreateBulk(builders ...*AttendanceEditCreate) *AttendanceEditCreateBulk {
+	return &AttendanceEditCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AttendanceEditClient) MapCreateBulk(slice any, setFunc func(*AttendanceEditCreate, int)) *AttendanceEditCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AttendanceEditCreateBulk{err: fmt.Errorf("calling to AttendanceEditClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AttendanceEditCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AttendanceEditCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AttendanceEdit.
+func (c *AttendanceEditClient) Update() *AttendanceEditUpdate {
+	mutation := newAttendanceEditMutation(c.config, OpUpdate)
+	return &AttendanceEditUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AttendanceEditClient) UpdateOne(_m *AttendanceEdit) *AttendanceEditUpdateOne {
+	mutation := newAttendanceEditMutation(c.config, OpUpdateOne, withAttendanceEdit(_m))
+	return &AttendanceEditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AttendanceEditClient) UpdateOneID(id int) *AttendanceEditUpdateOne {
+	mutation := newAttendanceEditMutation(c.config, OpUpdateOne, withAttendanceEditID(id))
+	return &AttendanceEditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AttendanceEdit.
+func (c *AttendanceEditClient) Delete() *AttendanceEditDelete {
+	mutation := newAttendanceEditMutation(c.config, OpDelete)
+	return &AttendanceEditDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AttendanceEditClient) DeleteOne(_m *AttendanceEdit) *AttendanceEditDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AttendanceEditClient) DeleteOneID(id int) *AttendanceEditDeleteOne {
+	builder := c.Delete().Where(attendanceedit.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AttendanceEditDeleteOne{builder}
+}
+
+// Query returns a query builder for AttendanceEdit.
+func (c *AttendanceEditClient) Query() *AttendanceEditQuery {
+	return &AttendanceEditQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAttendanceEdit},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AttendanceEdit entity by its id.
+func (c *AttendanceEditClient) Get(ctx context.Context, id int) (*AttendanceEdit, error) {
+	return c.Query().Where(attendanceedit.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AttendanceEditClient) GetX(ctx context.Context, id int) *AttendanceEdit {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAttendanceDay queries the attendance_day edge of a AttendanceEdit.
+func (c *AttendanceEditClient) QueryAttendanceDay(_m *AttendanceEdit) *AttendanceDayQuery {
+	query := (&AttendanceDayClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendanceedit.Table, attendanceedit.FieldID, id),
+			sqlgraph.To(attendanceday.Table, attendanceday.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, attendanceedit.AttendanceDayTable, attendanceedit.AttendanceDayColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEditor queries the editor edge of a AttendanceEdit.
+func (c *AttendanceEditClient) QueryEditor(_m *AttendanceEdit) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendanceedit.Table, attendanceedit.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, attendanceedit.EditorTable, attendanceedit.EditorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCorrectionRequest queries the correction_request edge of a AttendanceEdit.
+func (c *AttendanceEditClient) QueryCorrectionRequest(_m *AttendanceEdit) *MarkCorrectionRequestQuery {
+	query := (&MarkCorrectionRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendanceedit.Table, attendanceedit.FieldID, id),
+			sqlgraph.To(markcorrectionrequest.Table, markcorrectionrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, attendanceedit.CorrectionRequestTable, attendanceedit.CorrectionRequestColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AttendanceEditClient) Hooks() []Hook {
+	return c.hooks.AttendanceEdit
+}
+
+// Interceptors returns the client interceptors.
+func (c *AttendanceEditClient) Interceptors() []Interceptor {
+	return c.inters.AttendanceEdit
+}
+
+func (c *AttendanceEditClient) mutate(ctx context.Context, m *AttendanceEditMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AttendanceEditCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AttendanceEditUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AttendanceEditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AttendanceEditDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AttendanceEdit mutation op: %q", m.Op())
+	}
+}
+
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
+	config
+}
+
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
+}
+
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditLogClient) MapCreateBulk(slice any, setFunc func(*AuditLogCreate, int)) *AuditLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditLogCreateBulk{err: fmt.Errorf("calling to AuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogClient) UpdateOne(_m *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(_m))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogClient) UpdateOneID(id int) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditLogClient) DeleteOne(_m *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditLogClient) DeleteOneID(id int) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id int) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogClient) GetX(ctx context.Context, id int) *AuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogClient) Hooks() []Hook {
+	return c.hooks.AuditLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
+}
+
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditLog mutation op: %q", m.Op())
+	}
+}
+
 // BranchClient is a client for the Branch schema.
 type BranchClient struct {
 	config
@@ -1699,6 +2147,22 @@ func (c *DeviceClient) QueryAccessPoint(_m *Device) *AccessPointQuery {
 	return query
 }
 
+// QueryPunches queries the punches edge of a Device.
+func (c *DeviceClient) QueryPunches(_m *Device) *PunchQuery {
+	query := (&PunchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(punch.Table, punch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, device.PunchesTable, device.PunchesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *DeviceClient) Hooks() []Hook {
 	return c.hooks.Device
@@ -1724,86 +2188,1289 @@ func (c *DeviceClient) mutate(ctx context.Context, m *DeviceMutation) (Value, er
 	}
 }
 
-// RefreshTokenClient is a client for the RefreshToken schema.
-type RefreshTokenClient struct {
+// HolidayClient is a client for the Holiday schema.
+type HolidayClient struct {
 	config
 }
 
-// NewRefreshTokenClient returns a client for the RefreshToken from the given config.
-func NewRefreshTokenClient(c config) *RefreshTokenClient {
-	return &RefreshTokenClient{config: c}
+// NewHolidayClient returns a client for the Holiday from the given config.
+func NewHolidayClient(c config) *HolidayClient {
+	return &HolidayClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `refreshtoken.Hooks(f(g(h())))`.
-func (c *RefreshTokenClient) Use(hooks ...Hook) {
-	c.hooks.RefreshToken = append(c.hooks.RefreshToken, hooks...)
+// A call to `Use(f, g, h)` equals to `holiday.Hooks(f(g(h())))`.
+func (c *HolidayClient) Use(hooks ...Hook) {
+	c.hooks.Holiday = append(c.hooks.Holiday, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `refreshtoken.Intercept(f(g(h())))`.
-func (c *RefreshTokenClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RefreshToken = append(c.inters.RefreshToken, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `holiday.Intercept(f(g(h())))`.
+func (c *HolidayClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Holiday = append(c.inters.Holiday, interceptors...)
 }
 
-// Create returns a builder for creating a RefreshToken entity.
-func (c *RefreshTokenClient) Create() *RefreshTokenCreate {
-	mutation := newRefreshTokenMutation(c.config, OpCreate)
-	return &RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Holiday entity.
+func (c *HolidayClient) Create() *HolidayCreate {
+	mutation := newHolidayMutation(c.config, OpCreate)
+	return &HolidayCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RefreshToken entities.
-func (c *RefreshTokenClient) CreateBulk(builders ...*RefreshTokenCreate) *RefreshTokenCreateBulk {
-	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Holiday entities.
+func (c *HolidayClient) CreateBulk(builders ...*HolidayCreate) *HolidayCreateBulk {
+	return &HolidayCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RefreshTokenClient) MapCreateBulk(slice any, setFunc func(*RefreshTokenCreate, int)) *RefreshTokenCreateBulk {
+func (c *HolidayClient) MapCreateBulk(slice any, setFunc func(*HolidayCreate, int)) *HolidayCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RefreshTokenCreateBulk{err: fmt.Errorf("calling to RefreshTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &HolidayCreateBulk{err: fmt.Errorf("calling to HolidayClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RefreshTokenCreate, rv.Len())
+	builders := make([]*HolidayCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+	return &HolidayCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RefreshToken.
-func (c *RefreshTokenClient) Update() *RefreshTokenUpdate {
-	mutation := newRefreshTokenMutation(c.config, OpUpdate)
-	return &RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Holiday.
+func (c *HolidayClient) Update() *HolidayUpdate {
+	mutation := newHolidayMutation(c.config, OpUpdate)
+	return &HolidayUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RefreshTokenClient) UpdateOne(_m *RefreshToken) *RefreshTokenUpdateOne {
-	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshToken(_m))
-	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *HolidayClient) UpdateOne(_m *Holiday) *HolidayUpdateOne {
+	mutation := newHolidayMutation(c.config, OpUpdateOne, withHoliday(_m))
+	return &HolidayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RefreshTokenClient) UpdateOneID(id int) *RefreshTokenUpdateOne {
-	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshTokenID(id))
-	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *HolidayClient) UpdateOneID(id int) *HolidayUpdateOne {
+	mutation := newHolidayMutation(c.config, OpUpdateOne, withHolidayID(id))
+	return &HolidayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for RefreshToken.
-func (c *RefreshTokenClient) Delete() *RefreshTokenDelete {
-	mutation := newRefreshTokenMutation(c.config, OpDelete)
-	return &RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Holiday.
+func (c *HolidayClient) Delete() *HolidayDelete {
+	mutation := newHolidayMutation(c.config, OpDelete)
+	return &HolidayDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RefreshTokenClient) DeleteOne(_m *RefreshToken) *RefreshTokenDeleteOne {
+func (c *HolidayClient) DeleteOne(_m *Holiday) *HolidayDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RefreshTokenClient) DeleteOneID(id int) *RefreshTokenDeleteOne {
-	builder := c.Delete().Where(refreshtoken.ID(id))
+func (c *HolidayClient) DeleteOneID(id int) *HolidayDeleteOne {
+	builder := c.Delete().Where(holiday.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HolidayDeleteOne{builder}
+}
+
+// Query returns a query builder for Holiday.
+func (c *HolidayClient) Query() *HolidayQuery {
+	return &HolidayQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHoliday},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Holiday entity by its id.
+func (c *HolidayClient) Get(ctx context.Context, id int) (*Holiday, error) {
+	return c.Query().Where(holiday.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HolidayClient) GetX(ctx context.Context, id int) *Holiday {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRegion queries the region edge of a Holiday.
+func (c *HolidayClient) QueryRegion(_m *Holiday) *RegionQuery {
+	query := (&RegionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(holiday.Table, holiday.FieldID, id),
+			sqlgraph.To(region.Table, region.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, holiday.RegionTable, holiday.RegionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBranch queries the branch edge of a Holiday.
+func (c *HolidayClient) QueryBranch(_m *Holiday) *BranchQuery {
+	query := (&BranchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(holiday.Table, holiday.FieldID, id),
+			sqlgraph.To(branch.Table, branch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, holiday.BranchTable, holiday.BranchColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HolidayClient) Hooks() []Hook {
+	return c.hooks.Holiday
+}
+
+// Interceptors returns the client interceptors.
+func (c *HolidayClient) Interceptors() []Interceptor {
+	return c.inters.Holiday
+}
+
+func (c *HolidayClient) mutate(ctx context.Context, m *HolidayMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HolidayCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HolidayUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HolidayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HolidayDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Holiday mutation op: %q", m.Op())
+	}
+}
+
+// HourBankMovementClient is a client for the HourBankMovement schema.
+type HourBankMovementClient struct {
+	config
+}
+
+// NewHourBankMovementClient returns a client for the HourBankMovement from the given config.
+func NewHourBankMovementClient(c config) *HourBankMovementClient {
+	return &HourBankMovementClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `hourbankmovement.Hooks(f(g(h())))`.
+func (c *HourBankMovementClient) Use(hooks ...Hook) {
+	c.hooks.HourBankMovement = append(c.hooks.HourBankMovement, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `hourbankmovement.Intercept(f(g(h())))`.
+func (c *HourBankMovementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HourBankMovement = append(c.inters.HourBankMovement, interceptors...)
+}
+
+// Create returns a builder for creating a HourBankMovement entity.
+func (c *HourBankMovementClient) Create() *HourBankMovementCreate {
+	mutation := newHourBankMovementMutation(c.config, OpCreate)
+	return &HourBankMovementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HourBankMovement entities.
+func (c *HourBankMovementClient) CreateBulk(builders ...*HourBankMovementCreate) *HourBankMovementCreateBulk {
+	return &HourBankMovementCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HourBankMovementClient) MapCreateBulk(slice any, setFunc func(*HourBankMovementCreate, int)) *HourBankMovementCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HourBankMovementCreateBulk{err: fmt.Errorf("calling to HourBankMovementClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HourBankMovementCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HourBankMovementCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HourBankMovement.
+func (c *HourBankMovementClient) Update() *HourBankMovementUpdate {
+	mutation := newHourBankMovementMutation(c.config, OpUpdate)
+	return &HourBankMovementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HourBankMovementClient) UpdateOne(_m *HourBankMovement) *HourBankMovementUpdateOne {
+	mutation := newHourBankMovementMutation(c.config, OpUpdateOne, withHourBankMovement(_m))
+	return &HourBankMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HourBankMovementClient) UpdateOneID(id int) *HourBankMovementUpdateOne {
+	mutation := newHourBankMovementMutation(c.config, OpUpdateOne, withHourBankMovementID(id))
+	return &HourBankMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HourBankMovement.
+func (c *HourBankMovementClient) Delete() *HourBankMovementDelete {
+	mutation := newHourBankMovementMutation(c.config, OpDelete)
+	return &HourBankMovementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HourBankMovementClient) DeleteOne(_m *HourBankMovement) *HourBankMovementDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HourBankMovementClient) DeleteOneID(id int) *HourBankMovementDeleteOne {
+	builder := c.Delete().Where(hourbankmovement.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HourBankMovementDeleteOne{builder}
+}
+
+// Query returns a query builder for HourBankMovement.
+func (c *HourBankMovementClient) Query() *HourBankMovementQuery {
+	return &HourBankMovementQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHourBankMovement},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HourBankMovement entity by its id.
+func (c *HourBankMovementClient) Get(ctx context.Context, id int) (*HourBankMovement, error) {
+	return c.Query().Where(hourbankmovement.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HourBankMovementClient) GetX(ctx context.Context, id int) *HourBankMovement {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a HourBankMovement.
+func (c *HourBankMovementClient) QueryUser(_m *HourBankMovement) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hourbankmovement.Table, hourbankmovement.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, hourbankmovement.UserTable, hourbankmovement.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttendanceDay queries the attendance_day edge of a HourBankMovement.
+func (c *HourBankMovementClient) QueryAttendanceDay(_m *HourBankMovement) *AttendanceDayQuery {
+	query := (&AttendanceDayClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hourbankmovement.Table, hourbankmovement.FieldID, id),
+			sqlgraph.To(attendanceday.Table, attendanceday.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hourbankmovement.AttendanceDayTable, hourbankmovement.AttendanceDayColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreatedBy queries the created_by edge of a HourBankMovement.
+func (c *HourBankMovementClient) QueryCreatedBy(_m *HourBankMovement) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hourbankmovement.Table, hourbankmovement.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hourbankmovement.CreatedByTable, hourbankmovement.CreatedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HourBankMovementClient) Hooks() []Hook {
+	return c.hooks.HourBankMovement
+}
+
+// Interceptors returns the client interceptors.
+func (c *HourBankMovementClient) Interceptors() []Interceptor {
+	return c.inters.HourBankMovement
+}
+
+func (c *HourBankMovementClient) mutate(ctx context.Context, m *HourBankMovementMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HourBankMovementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HourBankMovementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HourBankMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HourBankMovementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown HourBankMovement mutation op: %q", m.Op())
+	}
+}
+
+// LeaveRequestClient is a client for the LeaveRequest schema.
+type LeaveRequestClient struct {
+	config
+}
+
+// NewLeaveRequestClient returns a client for the LeaveRequest from the given config.
+func NewLeaveRequestClient(c config) *LeaveRequestClient {
+	return &LeaveRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leaverequest.Hooks(f(g(h())))`.
+func (c *LeaveRequestClient) Use(hooks ...Hook) {
+	c.hooks.LeaveRequest = append(c.hooks.LeaveRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leaverequest.Intercept(f(g(h())))`.
+func (c *LeaveRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeaveRequest = append(c.inters.LeaveRequest, interceptors...)
+}
+
+// Create returns a builder for creating a LeaveRequest entity.
+func (c *LeaveRequestClient) Create() *LeaveRequestCreate {
+	mutation := newLeaveRequestMutation(c.config, OpCreate)
+	return &LeaveRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeaveRequest entities.
+func (c *LeaveRequestClient) CreateBulk(builders ...*LeaveRequestCreate) *LeaveRequestCreateBulk {
+	return &LeaveRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeaveRequestClient) MapCreateBulk(slice any, setFunc func(*LeaveRequestCreate, int)) *LeaveRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeaveRequestCreateBulk{err: fmt.Errorf("calling to LeaveRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeaveRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeaveRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeaveRequest.
+func (c *LeaveRequestClient) Update() *LeaveRequestUpdate {
+	mutation := newLeaveRequestMutation(c.config, OpUpdate)
+	return &LeaveRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeaveRequestClient) UpdateOne(_m *LeaveRequest) *LeaveRequestUpdateOne {
+	mutation := newLeaveRequestMutation(c.config, OpUpdateOne, withLeaveRequest(_m))
+	return &LeaveRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeaveRequestClient) UpdateOneID(id int) *LeaveRequestUpdateOne {
+	mutation := newLeaveRequestMutation(c.config, OpUpdateOne, withLeaveRequestID(id))
+	return &LeaveRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeaveRequest.
+func (c *LeaveRequestClient) Delete() *LeaveRequestDelete {
+	mutation := newLeaveRequestMutation(c.config, OpDelete)
+	return &LeaveRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeaveRequestClient) DeleteOne(_m *LeaveRequest) *LeaveRequestDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeaveRequestClient) DeleteOneID(id int) *LeaveRequestDeleteOne {
+	builder := c.Delete().Where(leaverequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeaveRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for LeaveRequest.
+func (c *LeaveRequestClient) Query() *LeaveRequestQuery {
+	return &LeaveRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeaveRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeaveRequest entity by its id.
+func (c *LeaveRequestClient) Get(ctx context.Context, id int) (*LeaveRequest, error) {
+	return c.Query().Where(leaverequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeaveRequestClient) GetX(ctx context.Context, id int) *LeaveRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a LeaveRequest.
+func (c *LeaveRequestClient) QueryUser(_m *LeaveRequest) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leaverequest.Table, leaverequest.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leaverequest.UserTable, leaverequest.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReviewedBy queries the reviewed_by edge of a LeaveRequest.
+func (c *LeaveRequestClient) QueryReviewedBy(_m *LeaveRequest) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leaverequest.Table, leaverequest.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, leaverequest.ReviewedByTable, leaverequest.ReviewedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LeaveRequestClient) Hooks() []Hook {
+	return c.hooks.LeaveRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeaveRequestClient) Interceptors() []Interceptor {
+	return c.inters.LeaveRequest
+}
+
+func (c *LeaveRequestClient) mutate(ctx context.Context, m *LeaveRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeaveRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeaveRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeaveRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeaveRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LeaveRequest mutation op: %q", m.Op())
+	}
+}
+
+// MarkCorrectionRequestClient is a client for the MarkCorrectionRequest schema.
+type MarkCorrectionRequestClient struct {
+	config
+}
+
+// NewMarkCorrectionRequestClient returns a client for the MarkCorrectionRequest from the given config.
+func NewMarkCorrectionRequestClient(c config) *MarkCorrectionRequestClient {
+	return &MarkCorrectionRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `markcorrectionrequest.Hooks(f(g(h())))`.
+func (c *MarkCorrectionRequestClient) Use(hooks ...Hook) {
+	c.hooks.MarkCorrectionRequest = append(c.hooks.MarkCorrectionRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `markcorrectionrequest.Intercept(f(g(h())))`.
+func (c *MarkCorrectionRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MarkCorrectionRequest = append(c.inters.MarkCorrectionRequest, interceptors...)
+}
+
+// Create returns a builder for creating a MarkCorrectionRequest entity.
+func (c *MarkCorrectionRequestClient) Create() *MarkCorrectionRequestCreate {
+	mutation := newMarkCorrectionRequestMutation(c.config, OpCreate)
+	return &MarkCorrectionRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MarkCorrectionRequest entities.
+func (c *MarkCorrectionRequestClient) CreateBulk(builders ...*MarkCorrectionRequestCreate) *MarkCorrectionRequestCreateBulk {
+	return &MarkCorrectionRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MarkCorrectionRequestClient) MapCreateBulk(slice any, setFunc func(*MarkCorrectionRequestCreate, int)) *MarkCorrectionRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MarkCorrectionRequestCreateBulk{err: fmt.Errorf("calling to MarkCorrectionRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MarkCorrectionRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MarkCorrectionRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MarkCorrectionRequest.
+func (c *MarkCorrectionRequestClient) Update() *MarkCorrectionRequestUpdate {
+	mutation := newMarkCorrectionRequestMutation(c.config, OpUpdate)
+	return &MarkCorrectionRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MarkCorrectionRequestClient) UpdateOne(_m *MarkCorrectionRequest) *MarkCorrectionRequestUpdateOne {
+	mutation := newMarkCorrectionRequestMutation(c.config, OpUpdateOne, withMarkCorrectionRequest(_m))
+	return &MarkCorrectionRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MarkCorrectionRequestClient) UpdateOneID(id int) *MarkCorrectionRequestUpdateOne {
+	mutation := newMarkCorrectionRequestMutation(c.config, OpUpdateOne, withMarkCorrectionRequestID(id))
+	return &MarkCorrectionRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MarkCorrectionRequest.
+func (c *MarkCorrectionRequestClient) Delete() *MarkCorrectionRequestDelete {
+	mutation := newMarkCorrectionRequestMutation(c.config, OpDelete)
+	return &MarkCorrectionRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MarkCorrectionRequestClient) DeleteOne(_m *MarkCorrectionRequest) *MarkCorrectionRequestDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MarkCorrectionRequestClient) DeleteOneID(id int) *MarkCorrectionRequestDeleteOne {
+	builder := c.Delete().Where(markcorrectionrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MarkCorrectionRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for MarkCorrectionRequest.
+func (c *MarkCorrectionRequestClient) Query() *MarkCorrectionRequestQuery {
+	return &MarkCorrectionRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMarkCorrectionRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MarkCorrectionRequest entity by its id.
+func (c *MarkCorrectionRequestClient) Get(ctx context.Context, id int) (*MarkCorrectionRequest, error) {
+	return c.Query().Where(markcorrectionrequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MarkCorrectionRequestClient) GetX(ctx context.Context, id int) *MarkCorrectionRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a MarkCorrectionRequest.
+func (c *MarkCorrectionRequestClient) QueryUser(_m *MarkCorrectionRequest) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(markcorrectionrequest.Table, markcorrectionrequest.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, markcorrectionrequest.UserTable, markcorrectionrequest.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttendanceDay queries the attendance_day edge of a MarkCorrectionRequest.
+func (c *MarkCorrectionRequestClient) QueryAttendanceDay(_m *MarkCorrectionRequest) *AttendanceDayQuery {
+	query := (&AttendanceDayClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(markcorrectionrequest.Table, markcorrectionrequest.FieldID, id),
+			sqlgraph.To(attendanceday.Table, attendanceday.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, markcorrectionrequest.AttendanceDayTable, markcorrectionrequest.AttendanceDayColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReviewedBy queries the reviewed_by edge of a MarkCorrectionRequest.
+func (c *MarkCorrectionRequestClient) QueryReviewedBy(_m *MarkCorrectionRequest) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(markcorrectionrequest.Table, markcorrectionrequest.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, markcorrectionrequest.ReviewedByTable, markcorrectionrequest.ReviewedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MarkCorrectionRequestClient) Hooks() []Hook {
+	return c.hooks.MarkCorrectionRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *MarkCorrectionRequestClient) Interceptors() []Interceptor {
+	return c.inters.MarkCorrectionRequest
+}
+
+func (c *MarkCorrectionRequestClient) mutate(ctx context.Context, m *MarkCorrectionRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MarkCorrectionRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MarkCorrectionRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MarkCorrectionRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MarkCorrectionRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MarkCorrectionRequest mutation op: %q", m.Op())
+	}
+}
+
+// OvertimeAuthorizationClient is a client for the OvertimeAuthorization schema.
+type OvertimeAuthorizationClient struct {
+	config
+}
+
+// NewOvertimeAuthorizationClient returns a client for the OvertimeAuthorization from the given config.
+func NewOvertimeAuthorizationClient(c config) *OvertimeAuthorizationClient {
+	return &OvertimeAuthorizationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `overtimeauthorization.Hooks(f(g(h())))`.
+func (c *OvertimeAuthorizationClient) Use(hooks ...Hook) {
+	c.hooks.OvertimeAuthorization = append(c.hooks.OvertimeAuthorization, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `overtimeauthorization.Intercept(f(g(h())))`.
+func (c *OvertimeAuthorizationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OvertimeAuthorization = append(c.inters.OvertimeAuthorization, interceptors...)
+}
+
+// Create returns a builder for creating a OvertimeAuthorization entity.
+func (c *OvertimeAuthorizationClient) Create() *OvertimeAuthorizationCreate {
+	mutation := newOvertimeAuthorizationMutation(c.config, OpCreate)
+	return &OvertimeAuthorizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OvertimeAuthorization entities.
+func (c *OvertimeAuthorizationClient) CreateBulk(builders ...*OvertimeAuthorizationCreate) *OvertimeAuthorizationCreateBulk {
+	return &OvertimeAuthorizationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OvertimeAuthorizationClient) MapCreateBulk(slice any, setFunc func(*OvertimeAuthorizationCreate, int)) *OvertimeAuthorizationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OvertimeAuthorizationCreateBulk{err: fmt.Errorf("calling to OvertimeAuthorizationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OvertimeAuthorizationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OvertimeAuthorizationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OvertimeAuthorization.
+func (c *OvertimeAuthorizationClient) Update() *OvertimeAuthorizationUpdate {
+	mutation := newOvertimeAuthorizationMutation(c.config, OpUpdate)
+	return &OvertimeAuthorizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OvertimeAuthorizationClient) UpdateOne(_m *OvertimeAuthorization) *OvertimeAuthorizationUpdateOne {
+	mutation := newOvertimeAuthorizationMutation(c.config, OpUpdateOne, withOvertimeAuthorization(_m))
+	return &OvertimeAuthorizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OvertimeAuthorizationClient) UpdateOneID(id int) *OvertimeAuthorizationUpdateOne {
+	mutation := newOvertimeAuthorizationMutation(c.config, OpUpdateOne, withOvertimeAuthorizationID(id))
+	return &OvertimeAuthorizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OvertimeAuthorization.
+func (c *OvertimeAuthorizationClient) Delete() *OvertimeAuthorizationDelete {
+	mutation := newOvertimeAuthorizationMutation(c.config, OpDelete)
+	return &OvertimeAuthorizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OvertimeAuthorizationClient) DeleteOne(_m *OvertimeAuthorization) *OvertimeAuthorizationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OvertimeAuthorizationClient) DeleteOneID(id int) *OvertimeAuthorizationDeleteOne {
+	builder := c.Delete().Where(overtimeauthorization.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OvertimeAuthorizationDeleteOne{builder}
+}
+
+// Query returns a query builder for OvertimeAuthorization.
+func (c *OvertimeAuthorizationClient) Query() *OvertimeAuthorizationQuery {
+	return &OvertimeAuthorizationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOvertimeAuthorization},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OvertimeAuthorization entity by its id.
+func (c *OvertimeAuthorizationClient) Get(ctx context.Context, id int) (*OvertimeAuthorization, error) {
+	return c.Query().Where(overtimeauthorization.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OvertimeAuthorizationClient) GetX(ctx context.Context, id int) *OvertimeAuthorization {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a OvertimeAuthorization.
+func (c *OvertimeAuthorizationClient) QueryUser(_m *OvertimeAuthorization) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(overtimeauthorization.Table, overtimeauthorization.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, overtimeauthorization.UserTable, overtimeauthorization.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRequestedBy queries the requested_by edge of a OvertimeAuthorization.
+func (c *OvertimeAuthorizationClient) QueryRequestedBy(_m *OvertimeAuthorization) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(overtimeauthorization.Table, overtimeauthorization.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, overtimeauthorization.RequestedByTable, overtimeauthorization.RequestedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryApprovedBy queries the approved_by edge of a OvertimeAuthorization.
+func (c *OvertimeAuthorizationClient) QueryApprovedBy(_m *OvertimeAuthorization) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(overtimeauthorization.Table, overtimeauthorization.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, overtimeauthorization.ApprovedByTable, overtimeauthorization.ApprovedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OvertimeAuthorizationClient) Hooks() []Hook {
+	return c.hooks.OvertimeAuthorization
+}
+
+// Interceptors returns the client interceptors.
+func (c *OvertimeAuthorizationClient) Interceptors() []Interceptor {
+	return c.inters.OvertimeAuthorization
+}
+
+func (c *OvertimeAuthorizationClient) mutate(ctx context.Context, m *OvertimeAuthorizationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OvertimeAuthorizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OvertimeAuthorizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OvertimeAuthorizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OvertimeAuthorizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OvertimeAuthorization mutation op: %q", m.Op())
+	}
+}
+
+// PayrollExportProfileClient is a client for the PayrollExportProfile schema.
+type PayrollExportProfileClient struct {
+	config
+}
+
+// NewPayrollExportProfileClient returns a client for the PayrollExportProfile from the given config.
+func NewPayrollExportProfileClient(c config) *PayrollExportProfileClient {
+	return &PayrollExportProfileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `payrollexportprofile.Hooks(f(g(h())))`.
+func (c *PayrollExportProfileClient) Use(hooks ...Hook) {
+	c.hooks.PayrollExportProfile = append(c.hooks.PayrollExportProfile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `payrollexportprofile.Intercept(f(g(h())))`.
+func (c *PayrollExportProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PayrollExportProfile = append(c.inters.PayrollExportProfile, interceptors...)
+}
+
+// Create returns a builder for creating a PayrollExportProfile entity.
+func (c *PayrollExportProfileClient) Create() *PayrollExportProfileCreate {
+	mutation := newPayrollExportProfileMutation(c.config, OpCreate)
+	return &PayrollExportProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PayrollExportProfile entities.
+func (c *PayrollExportProfileClient) CreateBulk(builders ...*PayrollExportProfileCreate) *PayrollExportProfileCreateBulk {
+	return &PayrollExportProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PayrollExportProfileClient) MapCreateBulk(slice any, setFunc func(*PayrollExportProfileCreate, int)) *PayrollExportProfileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PayrollExportProfileCreateBulk{err: fmt.Errorf("calling to PayrollExportProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PayrollExportProfileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PayrollExportProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PayrollExportProfile.
+func (c *PayrollExportProfileClient) Update() *PayrollExportProfileUpdate {
+	mutation := newPayrollExportProfileMutation(c.config, OpUpdate)
+	return &PayrollExportProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PayrollExportProfileClient) UpdateOne(_m *PayrollExportProfile) *PayrollExportProfileUpdateOne {
+	mutation := newPayrollExportProfileMutation(c.config, OpUpdateOne, withPayrollExportProfile(_m))
+	return &PayrollExportProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PayrollExportProfileClient) UpdateOneID(id int) *PayrollExportProfileUpdateOne {
+	mutation := newPayrollExportProfileMutation(c.config, OpUpdateOne, withPayrollExportProfileID(id))
+	return &PayrollExportProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PayrollExportProfile.
+func (c *PayrollExportProfileClient) Delete() *PayrollExportProfileDelete {
+	mutation := newPayrollExportProfileMutation(c.config, OpDelete)
+	return &PayrollExportProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PayrollExportProfileClient) DeleteOne(_m *PayrollExportProfile) *PayrollExportProfileDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PayrollExportProfileClient) DeleteOneID(id int) *PayrollExportProfileDeleteOne {
+	builder := c.Delete().Where(payrollexportprofile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PayrollExportProfileDeleteOne{builder}
+}
+
+// Query returns a query builder for PayrollExportProfile.
+func (c *PayrollExportProfileClient) Query() *PayrollExportProfileQuery {
+	return &PayrollExportProfileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePayrollExportProfile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PayrollExportProfile entity by its id.
+func (c *PayrollExportProfileClient) Get(ctx context.Context, id int) (*PayrollExportProfile, error) {
+	return c.Query().Where(payrollexportprofile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PayrollExportProfileClient) GetX(ctx context.Context, id int) *PayrollExportProfile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PayrollExportProfileClient) Hooks() []Hook {
+	return c.hooks.PayrollExportProfile
+}
+
+// Interceptors returns the client interceptors.
+func (c *PayrollExportProfileClient) Interceptors() []Interceptor {
+	return c.inters.PayrollExportProfile
+}
+
+func (c *PayrollExportProfileClient) mutate(ctx context.Context, m *PayrollExportProfileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PayrollExportProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PayrollExportProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PayrollExportProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PayrollExportProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PayrollExportProfile mutation op: %q", m.Op())
+	}
+}
+
+// PunchClient is a client for the Punch schema.
+type PunchClient struct {
+	config
+}
+
+// NewPunchClient returns a client for the Punch from the given config.
+func NewPunchClient(c config) *PunchClient {
+	return &PunchClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `punch.Hooks(f(g(h())))`.
+func (c *PunchClient) Use(hooks ...Hook) {
+	c.hooks.Punch = append(c.hooks.Punch, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `punch.Intercept(f(g(h())))`.
+func (c *PunchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Punch = append(c.inters.Punch, interceptors...)
+}
+
+// Create returns a builder for creating a Punch entity.
+func (c *PunchClient) Create() *PunchCreate {
+	mutation := newPunchMutation(c.config, OpCreate)
+	return &PunchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Punch entities.
+func (c *PunchClient) CreateBulk(builders ...*PunchCreate) *PunchCreateBulk {
+	return &PunchCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PunchClient) MapCreateBulk(slice any, setFunc func(*PunchCreate, int)) *PunchCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PunchCreateBulk{err: fmt.Errorf("calling to PunchClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PunchCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PunchCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Punch.
+func (c *PunchClient) Update() *PunchUpdate {
+	mutation := newPunchMutation(c.config, OpUpdate)
+	return &PunchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PunchClient) UpdateOne(_m *Punch) *PunchUpdateOne {
+	mutation := newPunchMutation(c.config, OpUpdateOne, withPunch(_m))
+	return &PunchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PunchClient) UpdateOneID(id int) *PunchUpdateOne {
+	mutation := newPunchMutation(c.config, OpUpdateOne, withPunchID(id))
+	return &PunchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Punch.
+func (c *PunchClient) Delete() *PunchDelete {
+	mutation := newPunchMutation(c.config, OpDelete)
+	return &PunchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PunchClient) DeleteOne(_m *Punch) *PunchDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PunchClient) DeleteOneID(id int) *PunchDeleteOne {
+	builder := c.Delete().Where(punch.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PunchDeleteOne{builder}
+}
+
+// Query returns a query builder for Punch.
+func (c *PunchClient) Query() *PunchQuery {
+	return &PunchQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePunch},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Punch entity by its id.
+func (c *PunchClient) Get(ctx context.Context, id int) (*Punch, error) {
+	return c.Query().Where(punch.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PunchClient) GetX(ctx context.Context, id int) *Punch {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Punch.
+func (c *PunchClient) QueryUser(_m *Punch) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(punch.Table, punch.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, punch.UserTable, punch.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccessPoint queries the access_point edge of a Punch.
+func (c *PunchClient) QueryAccessPoint(_m *Punch) *AccessPointQuery {
+	query := (&AccessPointClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(punch.Table, punch.FieldID, id),
+			sqlgraph.To(accesspoint.Table, accesspoint.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, punch.AccessPointTable, punch.AccessPointColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDevice queries the device edge of a Punch.
+func (c *PunchClient) QueryDevice(_m *Punch) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(punch.Table, punch.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, punch.DeviceTable, punch.DeviceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttendanceDay queries the attendance_day edge of a Punch.
+func (c *PunchClient) QueryAttendanceDay(_m *Punch) *AttendanceDayQuery {
+	query := (&AttendanceDayClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(punch.Table, punch.FieldID, id),
+			sqlgraph.To(attendanceday.Table, attendanceday.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, punch.AttendanceDayTable, punch.AttendanceDayColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PunchClient) Hooks() []Hook {
+	return c.hooks.Punch
+}
+
+// Interceptors returns the client interceptors.
+func (c *PunchClient) Interceptors() []Interceptor {
+	return c.inters.Punch
+}
+
+func (c *PunchClient) mutate(ctx context.Context, m *PunchMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PunchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PunchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PunchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PunchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Punch mutation op: %q", m.Op())
+	}
+}
+
+// RefreshTokenClient is a client for the RefreshToken schema.
+type RefreshTokenClient struct {
+	config
+}
+
+// NewRefreshTokenClient returns a client for the RefreshToken from the given config.
+func NewRefreshTokenClient(c config) *RefreshTokenClient {
+	return &RefreshTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `refreshtoken.Hooks(f(g(h())))`.
+func (c *RefreshTokenClient) Use(hooks ...Hook) {
+	c.hooks.RefreshToken = append(c.hooks.RefreshToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `refreshtoken.Intercept(f(g(h())))`.
+func (c *RefreshTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RefreshToken = append(c.inters.RefreshToken, interceptors...)
+}
+
+// Create returns a builder for creating a RefreshToken entity.
+func (c *RefreshTokenClient) Create() *RefreshTokenCreate {
+	mutation := newRefreshTokenMutation(c.config, OpCreate)
+	return &RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RefreshToken entities.
+func (c *RefreshTokenClient) CreateBulk(builders ...*RefreshTokenCreate) *RefreshTokenCreateBulk {
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RefreshTokenClient) MapCreateBulk(slice any, setFunc func(*RefreshTokenCreate, int)) *RefreshTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RefreshTokenCreateBulk{err: fmt.Errorf("calling to RefreshTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RefreshTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RefreshToken.
+func (c *RefreshTokenClient) Update() *RefreshTokenUpdate {
+	mutation := newRefreshTokenMutation(c.config, OpUpdate)
+	return &RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RefreshTokenClient) UpdateOne(_m *RefreshToken) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshToken(_m))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RefreshTokenClient) UpdateOneID(id int) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshTokenID(id))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RefreshToken.
+func (c *RefreshTokenClient) Delete() *RefreshTokenDelete {
+	mutation := newRefreshTokenMutation(c.config, OpDelete)
+	return &RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RefreshTokenClient) DeleteOne(_m *RefreshToken) *RefreshTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RefreshTokenClient) DeleteOneID(id int) *RefreshTokenDeleteOne {
+	builder := c.Delete().Where(refreshtoken.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
 	return &RefreshTokenDeleteOne{builder}
@@ -2022,6 +3689,139 @@ func (c *RegionClient) mutate(ctx context.Context, m *RegionMutation) (Value, er
 	}
 }
 
+// RoleClient is a client for the Role schema.
+type RoleClient struct {
+	config
+}
+
+// NewRoleClient returns a client for the Role from the given config.
+func NewRoleClient(c config) *RoleClient {
+	return &RoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
+func (c *RoleClient) Use(hooks ...Hook) {
+	c.hooks.Role = append(c.hooks.Role, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
+func (c *RoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Role = append(c.inters.Role, interceptors...)
+}
+
+// Create returns a builder for creating a Role entity.
+func (c *RoleClient) Create() *RoleCreate {
+	mutation := newRoleMutation(c.config, OpCreate)
+	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Role entities.
+func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
+	return &RoleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleClient) MapCreateBulk(slice any, setFunc func(*RoleCreate, int)) *RoleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleCreateBulk{err: fmt.Errorf("calling to RoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Role.
+func (c *RoleClient) Update() *RoleUpdate {
+	mutation := newRoleMutation(c.config, OpUpdate)
+	return &RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleClient) UpdateOne(_m *Role) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRole(_m))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleClient) UpdateOneID(id int) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRoleID(id))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Role.
+func (c *RoleClient) Delete() *RoleDelete {
+	mutation := newRoleMutation(c.config, OpDelete)
+	return &RoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleClient) DeleteOne(_m *Role) *RoleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleClient) DeleteOneID(id int) *RoleDeleteOne {
+	builder := c.Delete().Where(role.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleDeleteOne{builder}
+}
+
+// Query returns a query builder for Role.
+func (c *RoleClient) Query() *RoleQuery {
+	return &RoleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRole},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Role entity by its id.
+func (c *RoleClient) Get(ctx context.Context, id int) (*Role, error) {
+	return c.Query().Where(role.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleClient) GetX(ctx context.Context, id int) *Role {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RoleClient) Hooks() []Hook {
+	return c.hooks.Role
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleClient) Interceptors() []Interceptor {
+	return c.inters.Role
+}
+
+func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
+	}
+}
+
 // ShiftClient is a client for the Shift schema.
 type ShiftClient struct {
 	config
@@ -2137,8 +3937,24 @@ func (c *ShiftClient) QueryDays(_m *Shift) *ShiftDayQuery {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(shift.Table, shift.FieldID, id),
-			sqlgraph.To(shiftday.Table, shiftday.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, shift.DaysTable, shift.DaysColumn),
+			sqlgraph.To(shiftday.Table, shiftday.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, shift.DaysTable, shift.DaysColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCycleDays queries the cycle_days edge of a Shift.
+func (c *ShiftClient) QueryCycleDays(_m *Shift) *ShiftCycleDayQuery {
+	query := (&ShiftCycleDayClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shift.Table, shift.FieldID, id),
+			sqlgraph.To(shiftcycleday.Table, shiftcycleday.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, shift.CycleDaysTable, shift.CycleDaysColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2219,6 +4035,155 @@ func (c *ShiftClient) mutate(ctx context.Context, m *ShiftMutation) (Value, erro
 	}
 }
 
+// ShiftCycleDayClient is a client for the ShiftCycleDay schema.
+type ShiftCycleDayClient struct {
+	config
+}
+
+// NewShiftCycleDayClient returns a client for the ShiftCycleDay from the given config.
+func NewShiftCycleDayClient(c config) *ShiftCycleDayClient {
+	return &ShiftCycleDayClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `shiftcycleday.Hooks(f(g(h())))`.
+func (c *ShiftCycleDayClient) Use(hooks ...Hook) {
+	c.hooks.ShiftCycleDay = append(c.hooks.ShiftCycleDay, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `shiftcycleday.Intercept(f(g(h())))`.
+func (c *ShiftCycleDayClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ShiftCycleDay = append(c.inters.ShiftCycleDay, interceptors...)
+}
+
+// Create returns a builder for creating a ShiftCycleDay entity.
+func (c *ShiftCycleDayClient) Create() *ShiftCycleDayCreate {
+	mutation := newShiftCycleDayMutation(c.config, OpCreate)
+	return &ShiftCycleDayCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ShiftCycleDay entities.
+func (c *ShiftCycleDayClient) CreateBulk(builders ...*ShiftCycleDayCreate) *ShiftCycleDayCreateBulk {
+	return &ShiftCycleDayCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ShiftCycleDayClient) MapCreateBulk(slice any, setFunc func(*ShiftCycleDayCreate, int)) *ShiftCycleDayCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ShiftCycleDayCreateBulk{err: fmt.Errorf("calling to ShiftCycleDayClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ShiftCycleDayCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ShiftCycleDayCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ShiftCycleDay.
+func (c *ShiftCycleDayClient) Update() *ShiftCycleDayUpdate {
+	mutation := newShiftCycleDayMutation(c.config, OpUpdate)
+	return &ShiftCycleDayUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShiftCycleDayClient) UpdateOne(_m *ShiftCycleDay) *ShiftCycleDayUpdateOne {
+	mutation := newShiftCycleDayMutation(c.config, OpUpdateOne, withShiftCycleDay(_m))
+	return &ShiftCycleDayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShiftCycleDayClient) UpdateOneID(id int) *ShiftCycleDayUpdateOne {
+	mutation := newShiftCycleDayMutation(c.config, OpUpdateOne, withShiftCycleDayID(id))
+	return &ShiftCycleDayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ShiftCycleDay.
+func (c *ShiftCycleDayClient) Delete() *ShiftCycleDayDelete {
+	mutation := newShiftCycleDayMutation(c.config, OpDelete)
+	return &ShiftCycleDayDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ShiftCycleDayClient) DeleteOne(_m *ShiftCycleDay) *ShiftCycleDayDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ShiftCycleDayClient) DeleteOneID(id int) *ShiftCycleDayDeleteOne {
+	builder := c.Delete().Where(shiftcycleday.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShiftCycleDayDeleteOne{builder}
+}
+
+// Query returns a query builder for ShiftCycleDay.
+func (c *ShiftCycleDayClient) Query() *ShiftCycleDayQuery {
+	return &ShiftCycleDayQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeShiftCycleDay},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ShiftCycleDay entity by its id.
+func (c *ShiftCycleDayClient) Get(ctx context.Context, id int) (*ShiftCycleDay, error) {
+	return c.Query().Where(shiftcycleday.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShiftCycleDayClient) GetX(ctx context.Context, id int) *ShiftCycleDay {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryShift queries the shift edge of a ShiftCycleDay.
+func (c *ShiftCycleDayClient) QueryShift(_m *ShiftCycleDay) *ShiftQuery {
+	query := (&ShiftClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shiftcycleday.Table, shiftcycleday.FieldID, id),
+			sqlgraph.To(shift.Table, shift.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shiftcycleday.ShiftTable, shiftcycleday.ShiftColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ShiftCycleDayClient) Hooks() []Hook {
+	return c.hooks.ShiftCycleDay
+}
+
+// Interceptors returns the client interceptors.
+func (c *ShiftCycleDayClient) Interceptors() []Interceptor {
+	return c.inters.ShiftCycleDay
+}
+
+func (c *ShiftCycleDayClient) mutate(ctx context.Context, m *ShiftCycleDayMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ShiftCycleDayCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ShiftCycleDayUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ShiftCycleDayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ShiftCycleDayDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ShiftCycleDay mutation op: %q", m.Op())
+	}
+}
+
 // ShiftDayClient is a client for the ShiftDay schema.
 type ShiftDayClient struct {
 	config
@@ -2753,6 +4718,102 @@ func (c *UserClient) QueryQrSessions(_m *User) *UserQRSessionQuery {
 	return query
 }
 
+// QueryPunches queries the punches edge of a User.
+func (c *UserClient) QueryPunches(_m *User) *PunchQuery {
+	query := (&PunchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(punch.Table, punch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PunchesTable, user.PunchesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLeaveRequests queries the leave_requests edge of a User.
+func (c *UserClient) QueryLeaveRequests(_m *User) *LeaveRequestQuery {
+	query := (&LeaveRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(leaverequest.Table, leaverequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LeaveRequestsTable, user.LeaveRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVacationAdjustments queries the vacation_adjustments edge of a User.
+func (c *UserClient) QueryVacationAdjustments(_m *User) *VacationAdjustmentQuery {
+	query := (&VacationAdjustmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(vacationadjustment.Table, vacationadjustment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.VacationAdjustmentsTable, user.VacationAdjustmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOvertimeAuthorizations queries the overtime_authorizations edge of a User.
+func (c *UserClient) QueryOvertimeAuthorizations(_m *User) *OvertimeAuthorizationQuery {
+	query := (&OvertimeAuthorizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(overtimeauthorization.Table, overtimeauthorization.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.OvertimeAuthorizationsTable, user.OvertimeAuthorizationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHourBankMovements queries the hour_bank_movements edge of a User.
+func (c *UserClient) QueryHourBankMovements(_m *User) *HourBankMovementQuery {
+	query := (&HourBankMovementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(hourbankmovement.Table, hourbankmovement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.HourBankMovementsTable, user.HourBankMovementsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMarkCorrectionRequests queries the mark_correction_requests edge of a User.
+func (c *UserClient) QueryMarkCorrectionRequests(_m *User) *MarkCorrectionRequestQuery {
+	query := (&MarkCorrectionRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(markcorrectionrequest.Table, markcorrectionrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MarkCorrectionRequestsTable, user.MarkCorrectionRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -3587,18 +5648,187 @@ func (c *UserShiftAssignmentClient) mutate(ctx context.Context, m *UserShiftAssi
 	}
 }
 
+// VacationAdjustmentClient is a client for the VacationAdjustment schema.
+type VacationAdjustmentClient struct {
+	config
+}
+
+// NewVacationAdjustmentClient returns a client for the VacationAdjustment from the given config.
+func NewVacationAdjustmentClient(c config) *VacationAdjustmentClient {
+	return &VacationAdjustmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vacationadjustment.Hooks(f(g(h())))`.
+func (c *VacationAdjustmentClient) Use(hooks ...Hook) {
+	c.hooks.VacationAdjustment = append(c.hooks.VacationAdjustment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vacationadjustment.Intercept(f(g(h())))`.
+func (c *VacationAdjustmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VacationAdjustment = append(c.inters.VacationAdjustment, interceptors...)
+}
+
+// Create returns a builder for creating a VacationAdjustment entity.
+func (c *VacationAdjustmentClient) Create() *VacationAdjustmentCreate {
+	mutation := newVacationAdjustmentMutation(c.config, OpCreate)
+	return &VacationAdjustmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VacationAdjustment entities.
+func (c *VacationAdjustmentClient) CreateBulk(builders ...*VacationAdjustmentCreate) *VacationAdjustmentCreateBulk {
+	return &VacationAdjustmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VacationAdjustmentClient) MapCreateBulk(slice any, setFunc func(*VacationAdjustmentCreate, int)) *VacationAdjustmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VacationAdjustmentCreateBulk{err: fmt.Errorf("calling to VacationAdjustmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VacationAdjustmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VacationAdjustmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VacationAdjustment.
+func (c *VacationAdjustmentClient) Update() *VacationAdjustmentUpdate {
+	mutation := newVacationAdjustmentMutation(c.config, OpUpdate)
+	return &VacationAdjustmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VacationAdjustmentClient) UpdateOne(_m *VacationAdjustment) *VacationAdjustmentUpdateOne {
+	mutation := newVacationAdjustmentMutation(c.config, OpUpdateOne, withVacationAdjustment(_m))
+	return &VacationAdjustmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VacationAdjustmentClient) UpdateOneID(id int) *VacationAdjustmentUpdateOne {
+	mutation := newVacationAdjustmentMutation(c.config, OpUpdateOne, withVacationAdjustmentID(id))
+	return &VacationAdjustmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VacationAdjustment.
+func (c *VacationAdjustmentClient) Delete() *VacationAdjustmentDelete {
+	mutation := newVacationAdjustmentMutation(c.config, OpDelete)
+	return &VacationAdjustmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VacationAdjustmentClient) DeleteOne(_m *VacationAdjustment) *VacationAdjustmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VacationAdjustmentClient) DeleteOneID(id int) *VacationAdjustmentDeleteOne {
+	builder := c.Delete().Where(vacationadjustment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VacationAdjustmentDeleteOne{builder}
+}
+
+// Query returns a query builder for VacationAdjustment.
+func (c *VacationAdjustmentClient) Query() *VacationAdjustmentQuery {
+	return &VacationAdjustmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVacationAdjustment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VacationAdjustment entity by its id.
+func (c *VacationAdjustmentClient) Get(ctx context.Context, id int) (*VacationAdjustment, error) {
+	return c.Query().Where(vacationadjustment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VacationAdjustmentClient) GetX(ctx context.Context, id int) *VacationAdjustment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a VacationAdjustment.
+func (c *VacationAdjustmentClient) QueryUser(_m *VacationAdjustment) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vacationadjustment.Table, vacationadjustment.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, vacationadjustment.UserTable, vacationadjustment.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreatedBy queries the created_by edge of a VacationAdjustment.
+func (c *VacationAdjustmentClient) QueryCreatedBy(_m *VacationAdjustment) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vacationadjustment.Table, vacationadjustment.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, vacationadjustment.CreatedByTable, vacationadjustment.CreatedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *VacationAdjustmentClient) Hooks() []Hook {
+	return c.hooks.VacationAdjustment
+}
+
+// Interceptors returns the client interceptors.
+func (c *VacationAdjustmentClient) Interceptors() []Interceptor {
+	return c.inters.VacationAdjustment
+}
+
+func (c *VacationAdjustmentClient) mutate(ctx context.Context, m *VacationAdjustmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VacationAdjustmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VacationAdjustmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VacationAdjustmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VacationAdjustmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown VacationAdjustment mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AccessPoint, Address, AttendanceDay, Branch, BranchAddress, City, Commune,
-		Device, RefreshToken, Region, Shift, ShiftDay, ShiftInstance, User,
-		UserAccessPoint, UserBranch, UserDayOverride, UserQRSession,
-		UserShiftAssignment []ent.Hook
+		AccessPoint, Address, AttendanceDay, AttendanceEdit, AuditLog, Branch,
+		BranchAddress, City, Commune, Device, Holiday, HourBankMovement, LeaveRequest,
+		MarkCorrectionRequest, OvertimeAuthorization, PayrollExportProfile, Punch,
+		RefreshToken, Region, Role, Shift, ShiftCycleDay, ShiftDay, ShiftInstance,
+		User, UserAccessPoint, UserBranch, UserDayOverride, UserQRSession,
+		UserShiftAssignment, VacationAdjustment []ent.Hook
 	}
 	inters struct {
-		AccessPoint, Address, AttendanceDay, Branch, BranchAddress, City, Commune,
-		Device, RefreshToken, Region, Shift, ShiftDay, ShiftInstance, User,
-		UserAccessPoint, UserBranch, UserDayOverride, UserQRSession,
-		UserShiftAssignment []ent.Interceptor
+		AccessPoint, Address, AttendanceDay, AttendanceEdit, AuditLog, Branch,
+		BranchAddress, City, Commune, Device, Holiday, HourBankMovement, LeaveRequest,
+		MarkCorrectionRequest, OvertimeAuthorization, PayrollExportProfile, Punch,
+		RefreshToken, Region, Role, Shift, ShiftCycleDay, ShiftDay, ShiftInstance,
+		User, UserAccessPoint, UserBranch, UserDayOverride, UserQRSession,
+		UserShiftAssignment, VacationAdjustment []ent.Interceptor
 	}
 )
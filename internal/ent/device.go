@@ -50,9 +50,11 @@ type Device struct {
 type DeviceEdges struct {
 	// AccessPoint holds the value of the access_point edge.
 	AccessPoint *AccessPoint `json:"access_point,omitempty"`
+	// Punches holds the value of the punches edge.
+	Punches []*Punch `json:"punches,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // AccessPointOrErr returns the AccessPoint value or an error if the edge
@@ -66,6 +68,15 @@ func (e DeviceEdges) AccessPointOrErr() (*AccessPoint, error) {
 	return nil, &NotLoadedError{edge: "access_point"}
 }
 
+// PunchesOrErr returns the Punches value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeviceEdges) PunchesOrErr() ([]*Punch, error) {
+	if e.loadedTypes[1] {
+		return e.Punches, nil
+	}
+	return nil, &NotLoadedError{edge: "punches"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Device) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -185,6 +196,11 @@ func (_m *Device) QueryAccessPoint() *AccessPointQuery {
 	return NewDeviceClient(_m.config).QueryAccessPoint(_m)
 }
 
+// QueryPunches queries the "punches" edge of the Device entity.
+func (_m *Device) QueryPunches() *PunchQuery {
+	return NewDeviceClient(_m.config).QueryPunches(_m)
+}
+
 // Update returns a builder for updating this Device.
 // Note that you need to call Device.Unwrap() before calling this method if this Device
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -38,6 +38,8 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// EdgeAccessPoint holds the string denoting the access_point edge name in mutations.
 	EdgeAccessPoint = "access_point"
+	// EdgePunches holds the string denoting the punches edge name in mutations.
+	EdgePunches = "punches"
 	// Table holds the table name of the device in the database.
 	Table = "devices"
 	// AccessPointTable is the table that holds the access_point relation/edge.
@@ -47,6 +49,13 @@ const (
 	AccessPointInverseTable = "access_points"
 	// AccessPointColumn is the table column denoting the access_point relation/edge.
 	AccessPointColumn = "access_point_id"
+	// PunchesTable is the table that holds the punches relation/edge.
+	PunchesTable = "punches"
+	// PunchesInverseTable is the table name for the Punch entity.
+	// It exists in this package in order to avoid circular dependency with the "punch" package.
+	PunchesInverseTable = "punches"
+	// PunchesColumn is the table column denoting the punches relation/edge.
+	PunchesColumn = "device_id"
 )
 
 // Columns holds all SQL columns for device fields.
@@ -165,6 +174,20 @@ func ByAccessPointField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAccessPointStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByPunchesCount orders the results by punches count.
+func ByPunchesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPunchesStep(), opts...)
+	}
+}
+
+// ByPunches orders the results by punches terms.
+func ByPunches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPunchesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newAccessPointStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -172,3 +195,10 @@ func newAccessPointStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, AccessPointTable, AccessPointColumn),
 	)
 }
+func newPunchesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PunchesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PunchesTable, PunchesColumn),
+	)
+}
@@ -683,6 +683,29 @@ func HasAccessPointWith(preds ...predicate.AccessPoint) predicate.Device {
 	})
 }
 
+// HasPunches applies the HasEdge predicate on the "punches" edge.
+func HasPunches() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PunchesTable, PunchesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPunchesWith applies the HasEdge predicate on the "punches" edge with a given conditions (other predicates).
+func HasPunchesWith(preds ...predicate.Punch) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newPunchesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Device) predicate.Device {
 	return predicate.Device(sql.AndPredicates(predicates...))
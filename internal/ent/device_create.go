@@ -5,6 +5,7 @@ package ent
 import (
 	"back/internal/ent/accesspoint"
 	"back/internal/ent/device"
+	"back/internal/ent/punch"
 	"context"
 	"errors"
 	"fmt"
@@ -132,6 +133,21 @@ func (_c *DeviceCreate) SetAccessPoint(v *AccessPoint) *DeviceCreate {
 	return _c.SetAccessPointID(v.ID)
 }
 
+// AddPunchIDs adds the "punches" edge to the Punch entity by IDs.
+func (_c *DeviceCreate) AddPunchIDs(ids ...int) *DeviceCreate {
+	_c.mutation.AddPunchIDs(ids...)
+	return _c
+}
+
+// AddPunches adds the "punches" edges to the Punch entity.
+func (_c *DeviceCreate) AddPunches(v ...*Punch) *DeviceCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPunchIDs(ids...)
+}
+
 // Mutation returns the DeviceMutation object of the builder.
 func (_c *DeviceCreate) Mutation() *DeviceMutation {
 	return _c.mutation
@@ -323,6 +339,22 @@ func (_c *DeviceCreate) createSpec() (*Device, *sqlgraph.CreateSpec) {
 		_node.AccessPointID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PunchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.PunchesTable,
+			Columns: []string{device.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
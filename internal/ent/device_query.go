@@ -6,7 +6,9 @@ import (
 	"back/internal/ent/accesspoint"
 	"back/internal/ent/device"
 	"back/internal/ent/predicate"
+	"back/internal/ent/punch"
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -24,6 +26,7 @@ type DeviceQuery struct {
 	inters          []Interceptor
 	predicates      []predicate.Device
 	withAccessPoint *AccessPointQuery
+	withPunches     *PunchQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -82,6 +85,28 @@ func (_q *DeviceQuery) QueryAccessPoint() *AccessPointQuery {
 	return query
 }
 
+// QueryPunches chains the current query on the "punches" edge.
+func (_q *DeviceQuery) QueryPunches() *PunchQuery {
+	query := (&PunchClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, selector),
+			sqlgraph.To(punch.Table, punch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, device.PunchesTable, device.PunchesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Device entity from the query.
 // Returns a *NotFoundError when no Device was found.
 func (_q *DeviceQuery) First(ctx context.Context) (*Device, error) {
@@ -275,6 +300,7 @@ func (_q *DeviceQuery) Clone() *DeviceQuery {
 		inters:          append([]Interceptor{}, _q.inters...),
 		predicates:      append([]predicate.Device{}, _q.predicates...),
 		withAccessPoint: _q.withAccessPoint.Clone(),
+		withPunches:     _q.withPunches.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -292,6 +318,17 @@ func (_q *DeviceQuery) WithAccessPoint(opts ...func(*AccessPointQuery)) *DeviceQ
 	return _q
 }
 
+// WithPunches tells the query-builder to eager-load the nodes that are connected to
+// the "punches" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DeviceQuery) WithPunches(opts ...func(*PunchQuery)) *DeviceQuery {
+	query := (&PunchClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPunches = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -370,8 +407,9 @@ func (_q *DeviceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Devic
 	var (
 		nodes       = []*Device{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withAccessPoint != nil,
+			_q.withPunches != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -398,6 +436,13 @@ func (_q *DeviceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Devic
 			return nil, err
 		}
 	}
+	if query := _q.withPunches; query != nil {
+		if err := _q.loadPunches(ctx, query, nodes,
+			func(n *Device) { n.Edges.Punches = []*Punch{} },
+			func(n *Device, e *Punch) { n.Edges.Punches = append(n.Edges.Punches, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -430,6 +475,39 @@ func (_q *DeviceQuery) loadAccessPoint(ctx context.Context, query *AccessPointQu
 	}
 	return nil
 }
+func (_q *DeviceQuery) loadPunches(ctx context.Context, query *PunchQuery, nodes []*Device, init func(*Device), assign func(*Device, *Punch)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Device)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(punch.FieldDeviceID)
+	}
+	query.Where(predicate.Punch(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(device.PunchesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DeviceID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "device_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "device_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *DeviceQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
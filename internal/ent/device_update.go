@@ -6,6 +6,7 @@ import (
 	"back/internal/ent/accesspoint"
 	"back/internal/ent/device"
 	"back/internal/ent/predicate"
+	"back/internal/ent/punch"
 	"context"
 	"errors"
 	"fmt"
@@ -172,6 +173,21 @@ func (_u *DeviceUpdate) SetAccessPoint(v *AccessPoint) *DeviceUpdate {
 	return _u.SetAccessPointID(v.ID)
 }
 
+// AddPunchIDs adds the "punches" edge to the Punch entity by IDs.
+func (_u *DeviceUpdate) AddPunchIDs(ids ...int) *DeviceUpdate {
+	_u.mutation.AddPunchIDs(ids...)
+	return _u
+}
+
+// AddPunches adds the "punches" edges to the Punch entity.
+func (_u *DeviceUpdate) AddPunches(v ...*Punch) *DeviceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPunchIDs(ids...)
+}
+
 // Mutation returns the DeviceMutation object of the builder.
 func (_u *DeviceUpdate) Mutation() *DeviceMutation {
 	return _u.mutation
@@ -183,6 +199,27 @@ func (_u *DeviceUpdate) ClearAccessPoint() *DeviceUpdate {
 	return _u
 }
 
+// ClearPunches clears all "punches" edges to the Punch entity.
+func (_u *DeviceUpdate) ClearPunches() *DeviceUpdate {
+	_u.mutation.ClearPunches()
+	return _u
+}
+
+// RemovePunchIDs removes the "punches" edge to Punch entities by IDs.
+func (_u *DeviceUpdate) RemovePunchIDs(ids ...int) *DeviceUpdate {
+	_u.mutation.RemovePunchIDs(ids...)
+	return _u
+}
+
+// RemovePunches removes "punches" edges to Punch entities.
+func (_u *DeviceUpdate) RemovePunches(v ...*Punch) *DeviceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePunchIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *DeviceUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -318,6 +355,51 @@ func (_u *DeviceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.PunchesTable,
+			Columns: []string{device.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPunchesIDs(); len(nodes) > 0 && !_u.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.PunchesTable,
+			Columns: []string{device.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PunchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.PunchesTable,
+			Columns: []string{device.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{device.Label}
@@ -481,6 +563,21 @@ func (_u *DeviceUpdateOne) SetAccessPoint(v *AccessPoint) *DeviceUpdateOne {
 	return _u.SetAccessPointID(v.ID)
 }
 
+// AddPunchIDs adds the "punches" edge to the Punch entity by IDs.
+func (_u *DeviceUpdateOne) AddPunchIDs(ids ...int) *DeviceUpdateOne {
+	_u.mutation.AddPunchIDs(ids...)
+	return _u
+}
+
+// AddPunches adds the "punches" edges to the Punch entity.
+func (_u *DeviceUpdateOne) AddPunches(v ...*Punch) *DeviceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPunchIDs(ids...)
+}
+
 // Mutation returns the DeviceMutation object of the builder.
 func (_u *DeviceUpdateOne) Mutation() *DeviceMutation {
 	return _u.mutation
@@ -492,6 +589,27 @@ func (_u *DeviceUpdateOne) ClearAccessPoint() *DeviceUpdateOne {
 	return _u
 }
 
+// ClearPunches clears all "punches" edges to the Punch entity.
+func (_u *DeviceUpdateOne) ClearPunches() *DeviceUpdateOne {
+	_u.mutation.ClearPunches()
+	return _u
+}
+
+// RemovePunchIDs removes the "punches" edge to Punch entities by IDs.
+func (_u *DeviceUpdateOne) RemovePunchIDs(ids ...int) *DeviceUpdateOne {
+	_u.mutation.RemovePunchIDs(ids...)
+	return _u
+}
+
+// RemovePunches removes "punches" edges to Punch entities.
+func (_u *DeviceUpdateOne) RemovePunches(v ...*Punch) *DeviceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePunchIDs(ids...)
+}
+
 // Where appends a list predicates to the DeviceUpdate builder.
 func (_u *DeviceUpdateOne) Where(ps ...predicate.Device) *DeviceUpdateOne {
 	_u.mutation.Where(ps...)
@@ -657,6 +775,51 @@ func (_u *DeviceUpdateOne) sqlSave(ctx context.Context) (_node *Device, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.PunchesTable,
+			Columns: []string{device.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPunchesIDs(); len(nodes) > 0 && !_u.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.PunchesTable,
+			Columns: []string{device.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PunchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.PunchesTable,
+			Columns: []string{device.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Device{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -6,14 +6,25 @@ import (
 	"back/internal/ent/accesspoint"
 	"back/internal/ent/address"
 	"back/internal/ent/attendanceday"
+	"back/internal/ent/attendanceedit"
+	"back/internal/ent/auditlog"
 	"back/internal/ent/branch"
 	"back/internal/ent/branchaddress"
 	"back/internal/ent/city"
 	"back/internal/ent/commune"
 	"back/internal/ent/device"
+	"back/internal/ent/holiday"
+	"back/internal/ent/hourbankmovement"
+	"back/internal/ent/leaverequest"
+	"back/internal/ent/markcorrectionrequest"
+	"back/internal/ent/overtimeauthorization"
+	"back/internal/ent/payrollexportprofile"
+	"back/internal/ent/punch"
 	"back/internal/ent/refreshtoken"
 	"back/internal/ent/region"
+	"back/internal/ent/role"
 	"back/internal/ent/shift"
+	"back/internal/ent/shiftcycleday"
 	"back/internal/ent/shiftday"
 	"back/internal/ent/shiftinstance"
 	"back/internal/ent/user"
@@ -22,6 +33,7 @@ import (
 	"back/internal/ent/userdayoverride"
 	"back/internal/ent/userqrsession"
 	"back/internal/ent/usershiftassignment"
+	"back/internal/ent/vacationadjustment"
 	"context"
 	"errors"
 	"fmt"
@@ -91,25 +103,37 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			accesspoint.Table:         accesspoint.ValidColumn,
-			address.Table:             address.ValidColumn,
-			attendanceday.Table:       attendanceday.ValidColumn,
-			branch.Table:              branch.ValidColumn,
-			branchaddress.Table:       branchaddress.ValidColumn,
-			city.Table:                city.ValidColumn,
-			commune.Table:             commune.ValidColumn,
-			device.Table:              device.ValidColumn,
-			refreshtoken.Table:        refreshtoken.ValidColumn,
-			region.Table:              region.ValidColumn,
-			shift.Table:               shift.ValidColumn,
-			shiftday.Table:            shiftday.ValidColumn,
-			shiftinstance.Table:       shiftinstance.ValidColumn,
-			user.Table:                user.ValidColumn,
-			useraccesspoint.Table:     useraccesspoint.ValidColumn,
-			userbranch.Table:          userbranch.ValidColumn,
-			userdayoverride.Table:     userdayoverride.ValidColumn,
-			userqrsession.Table:       userqrsession.ValidColumn,
-			usershiftassignment.Table: usershiftassignment.ValidColumn,
+			accesspoint.Table:           accesspoint.ValidColumn,
+			address.Table:               address.ValidColumn,
+			attendanceday.Table:         attendanceday.ValidColumn,
+			attendanceedit.Table:        attendanceedit.ValidColumn,
+			auditlog.Table:              auditlog.ValidColumn,
+			branch.Table:                branch.ValidColumn,
+			branchaddress.Table:         branchaddress.ValidColumn,
+			city.Table:                  city.ValidColumn,
+			commune.Table:               commune.ValidColumn,
+			device.Table:                device.ValidColumn,
+			holiday.Table:               holiday.ValidColumn,
+			hourbankmovement.Table:      hourbankmovement.ValidColumn,
+			leaverequest.Table:          leaverequest.ValidColumn,
+			markcorrectionrequest.Table: markcorrectionrequest.ValidColumn,
+			overtimeauthorization.Table: overtimeauthorization.ValidColumn,
+			payrollexportprofile.Table:  payrollexportprofile.ValidColumn,
+			punch.Table:                 punch.ValidColumn,
+			refreshtoken.Table:          refreshtoken.ValidColumn,
+			region.Table:                region.ValidColumn,
+			role.Table:                  role.ValidColumn,
+			shift.Table:                 shift.ValidColumn,
+			shiftcycleday.Table:         shiftcycleday.ValidColumn,
+			shiftday.Table:              shiftday.ValidColumn,
+			shiftinstance.Table:         shiftinstance.ValidColumn,
+			user.Table:                  user.ValidColumn,
+			useraccesspoint.Table:       useraccesspoint.ValidColumn,
+			userbranch.Table:            userbranch.ValidColumn,
+			userdayoverride.Table:       userdayoverride.ValidColumn,
+			userqrsession.Table:         userqrsession.ValidColumn,
+			usershiftassignment.Table:   usershiftassignment.ValidColumn,
+			vacationadjustment.Table:    vacationadjustment.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/branch"
+	"back/internal/ent/holiday"
+	"back/internal/ent/region"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Holiday is the model entity for the Holiday schema.
+type Holiday struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Date holds the value of the "date" field.
+	Date time.Time `json:"date,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Scope holds the value of the "scope" field.
+	Scope string `json:"scope,omitempty"`
+	// RegionID holds the value of the "region_id" field.
+	RegionID *int `json:"region_id,omitempty"`
+	// BranchID holds the value of the "branch_id" field.
+	BranchID *int `json:"branch_id,omitempty"`
+	// Irrenunciable holds the value of the "irrenunciable" field.
+	Irrenunciable bool `json:"irrenunciable,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HolidayQuery when eager-loading is set.
+	Edges        HolidayEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// HolidayEdges holds the relations/edges for other nodes in the graph.
+type HolidayEdges struct {
+	// Region holds the value of the region edge.
+	Region *Region `json:"region,omitempty"`
+	// Branch holds the value of the branch edge.
+	Branch *Branch `json:"branch,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// RegionOrErr returns the Region value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HolidayEdges) RegionOrErr() (*Region, error) {
+	if e.Region != nil {
+		return e.Region, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: region.Label}
+	}
+	return nil, &NotLoadedError{edge: "region"}
+}
+
+// BranchOrErr returns the Branch value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HolidayEdges) BranchOrErr() (*Branch, error) {
+	if e.Branch != nil {
+		return e.Branch, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: branch.Label}
+	}
+	return nil, &NotLoadedError{edge: "branch"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Holiday) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case holiday.FieldIrrenunciable:
+			values[i] = new(sql.NullBool)
+		case holiday.FieldID, holiday.FieldRegionID, holiday.FieldBranchID:
+			values[i] = new(sql.NullInt64)
+		case holiday.FieldName, holiday.FieldScope:
+			values[i] = new(sql.NullString)
+		case holiday.FieldDate, holiday.FieldCreatedAt, holiday.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Holiday fields.
+func (_m *Holiday) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case holiday.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case holiday.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				_m.Date = value.Time
+			}
+		case holiday.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case holiday.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				_m.Scope = value.String
+			}
+		case holiday.FieldRegionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field region_id", values[i])
+			} else if value.Valid {
+				_m.RegionID = new(int)
+				*_m.RegionID = int(value.Int64)
+			}
+		case holiday.FieldBranchID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field branch_id", values[i])
+			} else if value.Valid {
+				_m.BranchID = new(int)
+				*_m.BranchID = int(value.Int64)
+			}
+		case holiday.FieldIrrenunciable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field irrenunciable", values[i])
+			} else if value.Valid {
+				_m.Irrenunciable = value.Bool
+			}
+		case holiday.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case holiday.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Holiday.
+// This includes values selected through modifiers, order, etc.
+func (_m *Holiday) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRegion queries the "region" edge of the Holiday entity.
+func (_m *Holiday) QueryRegion() *RegionQuery {
+	return NewHolidayClient(_m.config).QueryRegion(_m)
+}
+
+// QueryBranch queries the "branch" edge of the Holiday entity.
+func (_m *Holiday) QueryBranch() *BranchQuery {
+	return NewHolidayClient(_m.config).QueryBranch(_m)
+}
+
+// Update returns a builder for updating this Holiday.
+// Note that you need to call Holiday.Unwrap() before calling this method if this Holiday
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Holiday) Update() *HolidayUpdateOne {
+	return NewHolidayClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Holiday entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Holiday) Unwrap() *Holiday {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Holiday is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Holiday) String() string {
+	var builder strings.Builder
+	builder.WriteString("Holiday(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("date=")
+	builder.WriteString(_m.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("scope=")
+	builder.WriteString(_m.Scope)
+	builder.WriteString(", ")
+	if v := _m.RegionID; v != nil {
+		builder.WriteString("region_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.BranchID; v != nil {
+		builder.WriteString("branch_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("irrenunciable=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Irrenunciable))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Holidays is a parsable slice of Holiday.
+type Holidays []*Holiday
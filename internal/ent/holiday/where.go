@@ -0,0 +1,477 @@
+// Code generated by ent, DO NOT EDIT.
+
+package holiday
+
+import (
+	"back/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Holiday {
+	return predicate.Holiday(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLTE(FieldID, id))
+}
+
+// Date applies equality check predicate on the "date" field. It's identical to DateEQ.
+func Date(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldDate, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldName, v))
+}
+
+// Scope applies equality check predicate on the "scope" field. It's identical to ScopeEQ.
+func Scope(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldScope, v))
+}
+
+// RegionID applies equality check predicate on the "region_id" field. It's identical to RegionIDEQ.
+func RegionID(v int) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldRegionID, v))
+}
+
+// BranchID applies equality check predicate on the "branch_id" field. It's identical to BranchIDEQ.
+func BranchID(v int) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldBranchID, v))
+}
+
+// Irrenunciable applies equality check predicate on the "irrenunciable" field. It's identical to IrrenunciableEQ.
+func Irrenunciable(v bool) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldIrrenunciable, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DateEQ applies the EQ predicate on the "date" field.
+func DateEQ(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldDate, v))
+}
+
+// DateNEQ applies the NEQ predicate on the "date" field.
+func DateNEQ(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNEQ(FieldDate, v))
+}
+
+// DateIn applies the In predicate on the "date" field.
+func DateIn(vs ...time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldIn(FieldDate, vs...))
+}
+
+// DateNotIn applies the NotIn predicate on the "date" field.
+func DateNotIn(vs ...time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotIn(FieldDate, vs...))
+}
+
+// DateGT applies the GT predicate on the "date" field.
+func DateGT(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGT(FieldDate, v))
+}
+
+// DateGTE applies the GTE predicate on the "date" field.
+func DateGTE(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGTE(FieldDate, v))
+}
+
+// DateLT applies the LT predicate on the "date" field.
+func DateLT(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLT(FieldDate, v))
+}
+
+// DateLTE applies the LTE predicate on the "date" field.
+func DateLTE(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLTE(FieldDate, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldContainsFold(FieldName, v))
+}
+
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldScope, v))
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNEQ(FieldScope, v))
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldIn(FieldScope, vs...))
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotIn(FieldScope, vs...))
+}
+
+// ScopeGT applies the GT predicate on the "scope" field.
+func ScopeGT(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGT(FieldScope, v))
+}
+
+// ScopeGTE applies the GTE predicate on the "scope" field.
+func ScopeGTE(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGTE(FieldScope, v))
+}
+
+// ScopeLT applies the LT predicate on the "scope" field.
+func ScopeLT(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLT(FieldScope, v))
+}
+
+// ScopeLTE applies the LTE predicate on the "scope" field.
+func ScopeLTE(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLTE(FieldScope, v))
+}
+
+// ScopeContains applies the Contains predicate on the "scope" field.
+func ScopeContains(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldContains(FieldScope, v))
+}
+
+// ScopeHasPrefix applies the HasPrefix predicate on the "scope" field.
+func ScopeHasPrefix(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldHasPrefix(FieldScope, v))
+}
+
+// ScopeHasSuffix applies the HasSuffix predicate on the "scope" field.
+func ScopeHasSuffix(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldHasSuffix(FieldScope, v))
+}
+
+// ScopeEqualFold applies the EqualFold predicate on the "scope" field.
+func ScopeEqualFold(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEqualFold(FieldScope, v))
+}
+
+// ScopeContainsFold applies the ContainsFold predicate on the "scope" field.
+func ScopeContainsFold(v string) predicate.Holiday {
+	return predicate.Holiday(sql.FieldContainsFold(FieldScope, v))
+}
+
+// RegionIDEQ applies the EQ predicate on the "region_id" field.
+func RegionIDEQ(v int) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldRegionID, v))
+}
+
+// RegionIDNEQ applies the NEQ predicate on the "region_id" field.
+func RegionIDNEQ(v int) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNEQ(FieldRegionID, v))
+}
+
+// RegionIDIn applies the In predicate on the "region_id" field.
+func RegionIDIn(vs ...int) predicate.Holiday {
+	return predicate.Holiday(sql.FieldIn(FieldRegionID, vs...))
+}
+
+// RegionIDNotIn applies the NotIn predicate on the "region_id" field.
+func RegionIDNotIn(vs ...int) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotIn(FieldRegionID, vs...))
+}
+
+// RegionIDIsNil applies the IsNil predicate on the "region_id" field.
+func RegionIDIsNil() predicate.Holiday {
+	return predicate.Holiday(sql.FieldIsNull(FieldRegionID))
+}
+
+// RegionIDNotNil applies the NotNil predicate on the "region_id" field.
+func RegionIDNotNil() predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotNull(FieldRegionID))
+}
+
+// BranchIDEQ applies the EQ predicate on the "branch_id" field.
+func BranchIDEQ(v int) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldBranchID, v))
+}
+
+// BranchIDNEQ applies the NEQ predicate on the "branch_id" field.
+func BranchIDNEQ(v int) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNEQ(FieldBranchID, v))
+}
+
+// BranchIDIn applies the In predicate on the "branch_id" field.
+func BranchIDIn(vs ...int) predicate.Holiday {
+	return predicate.Holiday(sql.FieldIn(FieldBranchID, vs...))
+}
+
+// BranchIDNotIn applies the NotIn predicate on the "branch_id" field.
+func BranchIDNotIn(vs ...int) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotIn(FieldBranchID, vs...))
+}
+
+// BranchIDIsNil applies the IsNil predicate on the "branch_id" field.
+func BranchIDIsNil() predicate.Holiday {
+	return predicate.Holiday(sql.FieldIsNull(FieldBranchID))
+}
+
+// BranchIDNotNil applies the NotNil predicate on the "branch_id" field.
+func BranchIDNotNil() predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotNull(FieldBranchID))
+}
+
+// IrrenunciableEQ applies the EQ predicate on the "irrenunciable" field.
+func IrrenunciableEQ(v bool) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldIrrenunciable, v))
+}
+
+// IrrenunciableNEQ applies the NEQ predicate on the "irrenunciable" field.
+func IrrenunciableNEQ(v bool) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNEQ(FieldIrrenunciable, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Holiday {
+	return predicate.Holiday(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasRegion applies the HasEdge predicate on the "region" edge.
+func HasRegion() predicate.Holiday {
+	return predicate.Holiday(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RegionTable, RegionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRegionWith applies the HasEdge predicate on the "region" edge with a given conditions (other predicates).
+func HasRegionWith(preds ...predicate.Region) predicate.Holiday {
+	return predicate.Holiday(func(s *sql.Selector) {
+		step := newRegionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBranch applies the HasEdge predicate on the "branch" edge.
+func HasBranch() predicate.Holiday {
+	return predicate.Holiday(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, BranchTable, BranchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBranchWith applies the HasEdge predicate on the "branch" edge with a given conditions (other predicates).
+func HasBranchWith(preds ...predicate.Branch) predicate.Holiday {
+	return predicate.Holiday(func(s *sql.Selector) {
+		step := newBranchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Holiday) predicate.Holiday {
+	return predicate.Holiday(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Holiday) predicate.Holiday {
+	return predicate.Holiday(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Holiday) predicate.Holiday {
+	return predicate.Holiday(sql.NotPredicates(p))
+}
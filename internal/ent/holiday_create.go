@@ -0,0 +1,387 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/branch"
+	"back/internal/ent/holiday"
+	"back/internal/ent/region"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HolidayCreate is the builder for creating a Holiday entity.
+type HolidayCreate struct {
+	config
+	mutation *HolidayMutation
+	hooks    []Hook
+}
+
+// SetDate sets the "date" field.
+func (_c *HolidayCreate) SetDate(v time.Time) *HolidayCreate {
+	_c.mutation.SetDate(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *HolidayCreate) SetName(v string) *HolidayCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetScope sets the "scope" field.
+func (_c *HolidayCreate) SetScope(v string) *HolidayCreate {
+	_c.mutation.SetScope(v)
+	return _c
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_c *HolidayCreate) SetNillableScope(v *string) *HolidayCreate {
+	if v != nil {
+		_c.SetScope(*v)
+	}
+	return _c
+}
+
+// SetRegionID sets the "region_id" field.
+func (_c *HolidayCreate) SetRegionID(v int) *HolidayCreate {
+	_c.mutation.SetRegionID(v)
+	return _c
+}
+
+// SetNillableRegionID sets the "region_id" field if the given value is not nil.
+func (_c *HolidayCreate) SetNillableRegionID(v *int) *HolidayCreate {
+	if v != nil {
+		_c.SetRegionID(*v)
+	}
+	return _c
+}
+
+// SetBranchID sets the "branch_id" field.
+func (_c *HolidayCreate) SetBranchID(v int) *HolidayCreate {
+	_c.mutation.SetBranchID(v)
+	return _c
+}
+
+// SetNillableBranchID sets the "branch_id" field if the given value is not nil.
+func (_c *HolidayCreate) SetNillableBranchID(v *int) *HolidayCreate {
+	if v != nil {
+		_c.SetBranchID(*v)
+	}
+	return _c
+}
+
+// SetIrrenunciable sets the "irrenunciable" field.
+func (_c *HolidayCreate) SetIrrenunciable(v bool) *HolidayCreate {
+	_c.mutation.SetIrrenunciable(v)
+	return _c
+}
+
+// SetNillableIrrenunciable sets the "irrenunciable" field if the given value is not nil.
+func (_c *HolidayCreate) SetNillableIrrenunciable(v *bool) *HolidayCreate {
+	if v != nil {
+		_c.SetIrrenunciable(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *HolidayCreate) SetCreatedAt(v time.Time) *HolidayCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *HolidayCreate) SetNillableCreatedAt(v *time.Time) *HolidayCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *HolidayCreate) SetUpdatedAt(v time.Time) *HolidayCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *HolidayCreate) SetNillableUpdatedAt(v *time.Time) *HolidayCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetRegion sets the "region" edge to the Region entity.
+func (_c *HolidayCreate) SetRegion(v *Region) *HolidayCreate {
+	return _c.SetRegionID(v.ID)
+}
+
+// SetBranch sets the "branch" edge to the Branch entity.
+func (_c *HolidayCreate) SetBranch(v *Branch) *HolidayCreate {
+	return _c.SetBranchID(v.ID)
+}
+
+// Mutation returns the HolidayMutation object of the builder.
+func (_c *HolidayCreate) Mutation() *HolidayMutation {
+	return _c.mutation
+}
+
+// Save creates the Holiday in the database.
+func (_c *HolidayCreate) Save(ctx context.Context) (*Holiday, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *HolidayCreate) SaveX(ctx context.Context) *Holiday {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HolidayCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HolidayCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *HolidayCreate) defaults() {
+	if _, ok := _c.mutation.Scope(); !ok {
+		v := holiday.DefaultScope
+		_c.mutation.SetScope(v)
+	}
+	if _, ok := _c.mutation.Irrenunciable(); !ok {
+		v := holiday.DefaultIrrenunciable
+		_c.mutation.SetIrrenunciable(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := holiday.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := holiday.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *HolidayCreate) check() error {
+	if _, ok := _c.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "Holiday.date"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Holiday.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := holiday.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Holiday.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "Holiday.scope"`)}
+	}
+	if v, ok := _c.mutation.Scope(); ok {
+		if err := holiday.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "Holiday.scope": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Irrenunciable(); !ok {
+		return &ValidationError{Name: "irrenunciable", err: errors.New(`ent: missing required field "Holiday.irrenunciable"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Holiday.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Holiday.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *HolidayCreate) sqlSave(ctx context.Context) (*Holiday, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *HolidayCreate) createSpec() (*Holiday, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Holiday{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(holiday.Table, sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Date(); ok {
+		_spec.SetField(holiday.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(holiday.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Scope(); ok {
+		_spec.SetField(holiday.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
+	if value, ok := _c.mutation.Irrenunciable(); ok {
+		_spec.SetField(holiday.FieldIrrenunciable, field.TypeBool, value)
+		_node.Irrenunciable = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(holiday.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(holiday.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.RegionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holiday.RegionTable,
+			Columns: []string{holiday.RegionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RegionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BranchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holiday.BranchTable,
+			Columns: []string{holiday.BranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BranchID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// HolidayCreateBulk is the builder for creating many Holiday entities in bulk.
+type HolidayCreateBulk struct {
+	config
+	err      error
+	builders []*HolidayCreate
+}
+
+// Save creates the Holiday entities in the database.
+func (_c *HolidayCreateBulk) Save(ctx context.Context) ([]*Holiday, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Holiday, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HolidayMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *HolidayCreateBulk) SaveX(ctx context.Context) []*Holiday {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HolidayCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HolidayCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
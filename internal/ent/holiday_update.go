@@ -0,0 +1,626 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/branch"
+	"back/internal/ent/holiday"
+	"back/internal/ent/predicate"
+	"back/internal/ent/region"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HolidayUpdate is the builder for updating Holiday entities.
+type HolidayUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HolidayMutation
+}
+
+// Where appends a list predicates to the HolidayUpdate builder.
+func (_u *HolidayUpdate) Where(ps ...predicate.Holiday) *HolidayUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *HolidayUpdate) SetDate(v time.Time) *HolidayUpdate {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *HolidayUpdate) SetNillableDate(v *time.Time) *HolidayUpdate {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *HolidayUpdate) SetName(v string) *HolidayUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *HolidayUpdate) SetNillableName(v *string) *HolidayUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *HolidayUpdate) SetScope(v string) *HolidayUpdate {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *HolidayUpdate) SetNillableScope(v *string) *HolidayUpdate {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetRegionID sets the "region_id" field.
+func (_u *HolidayUpdate) SetRegionID(v int) *HolidayUpdate {
+	_u.mutation.SetRegionID(v)
+	return _u
+}
+
+// SetNillableRegionID sets the "region_id" field if the given value is not nil.
+func (_u *HolidayUpdate) SetNillableRegionID(v *int) *HolidayUpdate {
+	if v != nil {
+		_u.SetRegionID(*v)
+	}
+	return _u
+}
+
+// ClearRegionID clears the value of the "region_id" field.
+func (_u *HolidayUpdate) ClearRegionID() *HolidayUpdate {
+	_u.mutation.ClearRegionID()
+	return _u
+}
+
+// SetBranchID sets the "branch_id" field.
+func (_u *HolidayUpdate) SetBranchID(v int) *HolidayUpdate {
+	_u.mutation.SetBranchID(v)
+	return _u
+}
+
+// SetNillableBranchID sets the "branch_id" field if the given value is not nil.
+func (_u *HolidayUpdate) SetNillableBranchID(v *int) *HolidayUpdate {
+	if v != nil {
+		_u.SetBranchID(*v)
+	}
+	return _u
+}
+
+// ClearBranchID clears the value of the "branch_id" field.
+func (_u *HolidayUpdate) ClearBranchID() *HolidayUpdate {
+	_u.mutation.ClearBranchID()
+	return _u
+}
+
+// SetIrrenunciable sets the "irrenunciable" field.
+func (_u *HolidayUpdate) SetIrrenunciable(v bool) *HolidayUpdate {
+	_u.mutation.SetIrrenunciable(v)
+	return _u
+}
+
+// SetNillableIrrenunciable sets the "irrenunciable" field if the given value is not nil.
+func (_u *HolidayUpdate) SetNillableIrrenunciable(v *bool) *HolidayUpdate {
+	if v != nil {
+		_u.SetIrrenunciable(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *HolidayUpdate) SetUpdatedAt(v time.Time) *HolidayUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRegion sets the "region" edge to the Region entity.
+func (_u *HolidayUpdate) SetRegion(v *Region) *HolidayUpdate {
+	return _u.SetRegionID(v.ID)
+}
+
+// SetBranch sets the "branch" edge to the Branch entity.
+func (_u *HolidayUpdate) SetBranch(v *Branch) *HolidayUpdate {
+	return _u.SetBranchID(v.ID)
+}
+
+// Mutation returns the HolidayMutation object of the builder.
+func (_u *HolidayUpdate) Mutation() *HolidayMutation {
+	return _u.mutation
+}
+
+// ClearRegion clears the "region" edge to the Region entity.
+func (_u *HolidayUpdate) ClearRegion() *HolidayUpdate {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// ClearBranch clears the "branch" edge to the Branch entity.
+func (_u *HolidayUpdate) ClearBranch() *HolidayUpdate {
+	_u.mutation.ClearBranch()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *HolidayUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HolidayUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *HolidayUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HolidayUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *HolidayUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := holiday.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HolidayUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := holiday.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Holiday.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := holiday.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "Holiday.scope": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *HolidayUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(holiday.Table, holiday.Columns, sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(holiday.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(holiday.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(holiday.FieldScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Irrenunciable(); ok {
+		_spec.SetField(holiday.FieldIrrenunciable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(holiday.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RegionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holiday.RegionTable,
+			Columns: []string{holiday.RegionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RegionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holiday.RegionTable,
+			Columns: []string{holiday.RegionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BranchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holiday.BranchTable,
+			Columns: []string{holiday.BranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BranchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holiday.BranchTable,
+			Columns: []string{holiday.BranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{holiday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// HolidayUpdateOne is the builder for updating a single Holiday entity.
+type HolidayUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HolidayMutation
+}
+
+// SetDate sets the "date" field.
+func (_u *HolidayUpdateOne) SetDate(v time.Time) *HolidayUpdateOne {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *HolidayUpdateOne) SetNillableDate(v *time.Time) *HolidayUpdateOne {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *HolidayUpdateOne) SetName(v string) *HolidayUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *HolidayUpdateOne) SetNillableName(v *string) *HolidayUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *HolidayUpdateOne) SetScope(v string) *HolidayUpdateOne {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *HolidayUpdateOne) SetNillableScope(v *string) *HolidayUpdateOne {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetRegionID sets the "region_id" field.
+func (_u *HolidayUpdateOne) SetRegionID(v int) *HolidayUpdateOne {
+	_u.mutation.SetRegionID(v)
+	return _u
+}
+
+// SetNillableRegionID sets the "region_id" field if the given value is not nil.
+func (_u *HolidayUpdateOne) SetNillableRegionID(v *int) *HolidayUpdateOne {
+	if v != nil {
+		_u.SetRegionID(*v)
+	}
+	return _u
+}
+
+// ClearRegionID clears the value of the "region_id" field.
+func (_u *HolidayUpdateOne) ClearRegionID() *HolidayUpdateOne {
+	_u.mutation.ClearRegionID()
+	return _u
+}
+
+// SetBranchID sets the "branch_id" field.
+func (_u *HolidayUpdateOne) SetBranchID(v int) *HolidayUpdateOne {
+	_u.mutation.SetBranchID(v)
+	return _u
+}
+
+// SetNillableBranchID sets the "branch_id" field if the given value is not nil.
+func (_u *HolidayUpdateOne) SetNillableBranchID(v *int) *HolidayUpdateOne {
+	if v != nil {
+		_u.SetBranchID(*v)
+	}
+	return _u
+}
+
+// ClearBranchID clears the value of the "branch_id" field.
+func (_u *HolidayUpdateOne) ClearBranchID() *HolidayUpdateOne {
+	_u.mutation.ClearBranchID()
+	return _u
+}
+
+// SetIrrenunciable sets the "irrenunciable" field.
+func (_u *HolidayUpdateOne) SetIrrenunciable(v bool) *HolidayUpdateOne {
+	_u.mutation.SetIrrenunciable(v)
+	return _u
+}
+
+// SetNillableIrrenunciable sets the "irrenunciable" field if the given value is not nil.
+func (_u *HolidayUpdateOne) SetNillableIrrenunciable(v *bool) *HolidayUpdateOne {
+	if v != nil {
+		_u.SetIrrenunciable(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *HolidayUpdateOne) SetUpdatedAt(v time.Time) *HolidayUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRegion sets the "region" edge to the Region entity.
+func (_u *HolidayUpdateOne) SetRegion(v *Region) *HolidayUpdateOne {
+	return _u.SetRegionID(v.ID)
+}
+
+// SetBranch sets the "branch" edge to the Branch entity.
+func (_u *HolidayUpdateOne) SetBranch(v *Branch) *HolidayUpdateOne {
+	return _u.SetBranchID(v.ID)
+}
+
+// Mutation returns the HolidayMutation object of the builder.
+func (_u *HolidayUpdateOne) Mutation() *HolidayMutation {
+	return _u.mutation
+}
+
+// ClearRegion clears the "region" edge to the Region entity.
+func (_u *HolidayUpdateOne) ClearRegion() *HolidayUpdateOne {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// ClearBranch clears the "branch" edge to the Branch entity.
+func (_u *HolidayUpdateOne) ClearBranch() *HolidayUpdateOne {
+	_u.mutation.ClearBranch()
+	return _u
+}
+
+// Where appends a list predicates to the HolidayUpdate builder.
+func (_u *HolidayUpdateOne) Where(ps ...predicate.Holiday) *HolidayUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *HolidayUpdateOne) Select(field string, fields ...string) *HolidayUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Holiday entity.
+func (_u *HolidayUpdateOne) Save(ctx context.Context) (*Holiday, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HolidayUpdateOne) SaveX(ctx context.Context) *Holiday {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *HolidayUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HolidayUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *HolidayUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := holiday.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HolidayUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := holiday.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Holiday.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := holiday.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "Holiday.scope": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *HolidayUpdateOne) sqlSave(ctx context.Context) (_node *Holiday, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(holiday.Table, holiday.Columns, sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Holiday.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, holiday.FieldID)
+		for _, f := range fields {
+			if !holiday.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != holiday.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(holiday.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(holiday.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(holiday.FieldScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Irrenunciable(); ok {
+		_spec.SetField(holiday.FieldIrrenunciable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(holiday.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RegionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holiday.RegionTable,
+			Columns: []string{holiday.RegionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RegionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holiday.RegionTable,
+			Columns: []string{holiday.RegionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BranchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holiday.BranchTable,
+			Columns: []string{holiday.BranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BranchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holiday.BranchTable,
+			Columns: []string{holiday.BranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Holiday{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{holiday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -44,6 +44,30 @@ func (f AttendanceDayFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttendanceDayMutation", m)
 }
 
+// The AttendanceEditFunc type is an adapter to allow the use of ordinary
+// function as AttendanceEdit mutator.
+type AttendanceEditFunc func(context.Context, *ent.AttendanceEditMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AttendanceEditFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AttendanceEditMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttendanceEditMutation", m)
+}
+
+// The AuditLogFunc type is an adapter to allow the use of ordinary
+// function as AuditLog mutator.
+type AuditLogFunc func(context.Context, *ent.AuditLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
+}
+
 // The BranchFunc type is an adapter to allow the use of ordinary
 // function as Branch mutator.
 type BranchFunc func(context.Context, *ent.BranchMutation) (ent.Value, error)
@@ -104,6 +128,90 @@ func (f DeviceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeviceMutation", m)
 }
 
+// The HolidayFunc type is an adapter to allow the use of ordinary
+// function as Holiday mutator.
+type HolidayFunc func(context.Context, *ent.HolidayMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HolidayFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.HolidayMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.HolidayMutation", m)
+}
+
+// The HourBankMovementFunc type is an adapter to allow the use of ordinary
+// function as HourBankMovement mutator.
+type HourBankMovementFunc func(context.Context, *ent.HourBankMovementMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HourBankMovementFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.HourBankMovementMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.HourBankMovementMutation", m)
+}
+
+// The LeaveRequestFunc type is an adapter to allow the use of ordinary
+// function as LeaveRequest mutator.
+type LeaveRequestFunc func(context.Context, *ent.LeaveRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeaveRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LeaveRequestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeaveRequestMutation", m)
+}
+
+// The MarkCorrectionRequestFunc type is an adapter to allow the use of ordinary
+// function as MarkCorrectionRequest mutator.
+type MarkCorrectionRequestFunc func(context.Context, *ent.MarkCorrectionRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MarkCorrectionRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MarkCorrectionRequestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MarkCorrectionRequestMutation", m)
+}
+
+// The OvertimeAuthorizationFunc type is an adapter to allow the use of ordinary
+// function as OvertimeAuthorization mutator.
+type OvertimeAuthorizationFunc func(context.Context, *ent.OvertimeAuthorizationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OvertimeAuthorizationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OvertimeAuthorizationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OvertimeAuthorizationMutation", m)
+}
+
+// The PayrollExportProfileFunc type is an adapter to allow the use of ordinary
+// function as PayrollExportProfile mutator.
+type PayrollExportProfileFunc func(context.Context, *ent.PayrollExportProfileMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PayrollExportProfileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PayrollExportProfileMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PayrollExportProfileMutation", m)
+}
+
+// The PunchFunc type is an adapter to allow the use of ordinary
+// function as Punch mutator.
+type PunchFunc func(context.Context, *ent.PunchMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PunchFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PunchMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PunchMutation", m)
+}
+
 // The RefreshTokenFunc type is an adapter to allow the use of ordinary
 // function as RefreshToken mutator.
 type RefreshTokenFunc func(context.Context, *ent.RefreshTokenMutation) (ent.Value, error)
@@ -128,6 +236,18 @@ func (f RegionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RegionMutation", m)
 }
 
+// The RoleFunc type is an adapter to allow the use of ordinary
+// function as Role mutator.
+type RoleFunc func(context.Context, *ent.RoleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RoleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RoleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleMutation", m)
+}
+
 // The ShiftFunc type is an adapter to allow the use of ordinary
 // function as Shift mutator.
 type ShiftFunc func(context.Context, *ent.ShiftMutation) (ent.Value, error)
@@ -140,6 +260,18 @@ func (f ShiftFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShiftMutation", m)
 }
 
+// The ShiftCycleDayFunc type is an adapter to allow the use of ordinary
+// function as ShiftCycleDay mutator.
+type ShiftCycleDayFunc func(context.Context, *ent.ShiftCycleDayMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShiftCycleDayFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ShiftCycleDayMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShiftCycleDayMutation", m)
+}
+
 // The ShiftDayFunc type is an adapter to allow the use of ordinary
 // function as ShiftDay mutator.
 type ShiftDayFunc func(context.Context, *ent.ShiftDayMutation) (ent.Value, error)
@@ -236,6 +368,18 @@ func (f UserShiftAssignmentFunc) Mutate(ctx context.Context, m ent.Mutation) (en
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserShiftAssignmentMutation", m)
 }
 
+// The VacationAdjustmentFunc type is an adapter to allow the use of ordinary
+// function as VacationAdjustment mutator.
+type VacationAdjustmentFunc func(context.Context, *ent.VacationAdjustmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VacationAdjustmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VacationAdjustmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VacationAdjustmentMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -0,0 +1,269 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/attendanceday"
+	"back/internal/ent/hourbankmovement"
+	"back/internal/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// HourBankMovement is the model entity for the HourBankMovement schema.
+type HourBankMovement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Date holds the value of the "date" field.
+	Date time.Time `json:"date,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Minutes holds the value of the "minutes" field.
+	Minutes int `json:"minutes,omitempty"`
+	// AttendanceDayID holds the value of the "attendance_day_id" field.
+	AttendanceDayID *int `json:"attendance_day_id,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason *string `json:"reason,omitempty"`
+	// CreatedByID holds the value of the "created_by_id" field.
+	CreatedByID *int `json:"created_by_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HourBankMovementQuery when eager-loading is set.
+	Edges        HourBankMovementEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// HourBankMovementEdges holds the relations/edges for other nodes in the graph.
+type HourBankMovementEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// AttendanceDay holds the value of the attendance_day edge.
+	AttendanceDay *AttendanceDay `json:"attendance_day,omitempty"`
+	// CreatedBy holds the value of the created_by edge.
+	CreatedBy *User `json:"created_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HourBankMovementEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// AttendanceDayOrErr returns the AttendanceDay value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HourBankMovementEdges) AttendanceDayOrErr() (*AttendanceDay, error) {
+	if e.AttendanceDay != nil {
+		return e.AttendanceDay, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: attendanceday.Label}
+	}
+	return nil, &NotLoadedError{edge: "attendance_day"}
+}
+
+// CreatedByOrErr returns the CreatedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HourBankMovementEdges) CreatedByOrErr() (*User, error) {
+	if e.CreatedBy != nil {
+		return e.CreatedBy, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "created_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*HourBankMovement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case hourbankmovement.FieldID, hourbankmovement.FieldUserID, hourbankmovement.FieldMinutes, hourbankmovement.FieldAttendanceDayID, hourbankmovement.FieldCreatedByID:
+			values[i] = new(sql.NullInt64)
+		case hourbankmovement.FieldKind, hourbankmovement.FieldReason:
+			values[i] = new(sql.NullString)
+		case hourbankmovement.FieldDate, hourbankmovement.FieldCreatedAt, hourbankmovement.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the HourBankMovement fields.
+func (_m *HourBankMovement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case hourbankmovement.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case hourbankmovement.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case hourbankmovement.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				_m.Date = value.Time
+			}
+		case hourbankmovement.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case hourbankmovement.FieldMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field minutes", values[i])
+			} else if value.Valid {
+				_m.Minutes = int(value.Int64)
+			}
+		case hourbankmovement.FieldAttendanceDayID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attendance_day_id", values[i])
+			} else if value.Valid {
+				_m.AttendanceDayID = new(int)
+				*_m.AttendanceDayID = int(value.Int64)
+			}
+		case hourbankmovement.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = new(string)
+				*_m.Reason = value.String
+			}
+		case hourbankmovement.FieldCreatedByID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by_id", values[i])
+			} else if value.Valid {
+				_m.CreatedByID = new(int)
+				*_m.CreatedByID = int(value.Int64)
+			}
+		case hourbankmovement.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case hourbankmovement.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the HourBankMovement.
+// This includes values selected through modifiers, order, etc.
+func (_m *HourBankMovement) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the HourBankMovement entity.
+func (_m *HourBankMovement) QueryUser() *UserQuery {
+	return NewHourBankMovementClient(_m.config).QueryUser(_m)
+}
+
+// QueryAttendanceDay queries the "attendance_day" edge of the HourBankMovement entity.
+func (_m *HourBankMovement) QueryAttendanceDay() *AttendanceDayQuery {
+	return NewHourBankMovementClient(_m.config).QueryAttendanceDay(_m)
+}
+
+// QueryCreatedBy queries the "created_by" edge of the HourBankMovement entity.
+func (_m *HourBankMovement) QueryCreatedBy() *UserQuery {
+	return NewHourBankMovementClient(_m.config).QueryCreatedBy(_m)
+}
+
+// Update returns a builder for updating this HourBankMovement.
+// Note that you need to call HourBankMovement.Unwrap() before calling this method if this HourBankMovement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *HourBankMovement) Update() *HourBankMovementUpdateOne {
+	return NewHourBankMovementClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the HourBankMovement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *HourBankMovement) Unwrap() *HourBankMovement {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: HourBankMovement is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *HourBankMovement) String() string {
+	var builder strings.Builder
+	builder.WriteString("HourBankMovement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(_m.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Minutes))
+	builder.WriteString(", ")
+	if v := _m.AttendanceDayID; v != nil {
+		builder.WriteString("attendance_day_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Reason; v != nil {
+		builder.WriteString("reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.CreatedByID; v != nil {
+		builder.WriteString("created_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// HourBankMovements is a parsable slice of HourBankMovement.
+type HourBankMovements []*HourBankMovement
@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hourbankmovement
+
+import (
+	"back/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldUserID, v))
+}
+
+// Date applies equality check predicate on the "date" field. It's identical to DateEQ.
+func Date(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldDate, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldKind, v))
+}
+
+// Minutes applies equality check predicate on the "minutes" field. It's identical to MinutesEQ.
+func Minutes(v int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldMinutes, v))
+}
+
+// AttendanceDayID applies equality check predicate on the "attendance_day_id" field. It's identical to AttendanceDayIDEQ.
+func AttendanceDayID(v int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldAttendanceDayID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldReason, v))
+}
+
+// CreatedByID applies equality check predicate on the "created_by_id" field. It's identical to CreatedByIDEQ.
+func CreatedByID(v int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldCreatedByID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// DateEQ applies the EQ predicate on the "date" field.
+func DateEQ(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldDate, v))
+}
+
+// DateNEQ applies the NEQ predicate on the "date" field.
+func DateNEQ(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNEQ(FieldDate, v))
+}
+
+// DateIn applies the In predicate on the "date" field.
+func DateIn(vs ...time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldIn(FieldDate, vs...))
+}
+
+// DateNotIn applies the NotIn predicate on the "date" field.
+func DateNotIn(vs ...time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNotIn(FieldDate, vs...))
+}
+
+// DateGT applies the GT predicate on the "date" field.
+func DateGT(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldGT(FieldDate, v))
+}
+
+// DateGTE applies the GTE predicate on the "date" field.
+func DateGTE(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldGTE(FieldDate, v))
+}
+
+// DateLT applies the LT predicate on the "date" field.
+func DateLT(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldLT(FieldDate, v))
+}
+
+// DateLTE applies the LTE predicate on the "date" field.
+func DateLTE(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldLTE(FieldDate, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldContainsFold(FieldKind, v))
+}
+
+// MinutesEQ applies the EQ predicate on the "minutes" field.
+func MinutesEQ(v int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldMinutes, v))
+}
+
+// MinutesNEQ applies the NEQ predicate on the "minutes" field.
+func MinutesNEQ(v int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNEQ(FieldMinutes, v))
+}
+
+// MinutesIn applies the In predicate on the "minutes" field.
+func MinutesIn(vs ...int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldIn(FieldMinutes, vs...))
+}
+
+// MinutesNotIn applies the NotIn predicate on the "minutes" field.
+func MinutesNotIn(vs ...int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNotIn(FieldMinutes, vs...))
+}
+
+// MinutesGT applies the GT predicate on the "minutes" field.
+func MinutesGT(v int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldGT(FieldMinutes, v))
+}
+
+// MinutesGTE applies the GTE predicate on the "minutes" field.
+func MinutesGTE(v int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldGTE(FieldMinutes, v))
+}
+
+// MinutesLT applies the LT predicate on the "minutes" field.
+func MinutesLT(v int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldLT(FieldMinutes, v))
+}
+
+// MinutesLTE applies the LTE predicate on the "minutes" field.
+func MinutesLTE(v int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldLTE(FieldMinutes, v))
+}
+
+// AttendanceDayIDEQ applies the EQ predicate on the "attendance_day_id" field.
+func AttendanceDayIDEQ(v int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldAttendanceDayID, v))
+}
+
+// AttendanceDayIDNEQ applies the NEQ predicate on the "attendance_day_id" field.
+func AttendanceDayIDNEQ(v int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNEQ(FieldAttendanceDayID, v))
+}
+
+// AttendanceDayIDIn applies the In predicate on the "attendance_day_id" field.
+func AttendanceDayIDIn(vs ...int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldIn(FieldAttendanceDayID, vs...))
+}
+
+// AttendanceDayIDNotIn applies the NotIn predicate on the "attendance_day_id" field.
+func AttendanceDayIDNotIn(vs ...int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNotIn(FieldAttendanceDayID, vs...))
+}
+
+// AttendanceDayIDIsNil applies the IsNil predicate on the "attendance_day_id" field.
+func AttendanceDayIDIsNil() predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldIsNull(FieldAttendanceDayID))
+}
+
+// AttendanceDayIDNotNil applies the NotNil predicate on the "attendance_day_id" field.
+func AttendanceDayIDNotNil() predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNotNull(FieldAttendanceDayID))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldContainsFold(FieldReason, v))
+}
+
+// CreatedByIDEQ applies the EQ predicate on the "created_by_id" field.
+func CreatedByIDEQ(v int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldCreatedByID, v))
+}
+
+// CreatedByIDNEQ applies the NEQ predicate on the "created_by_id" field.
+func CreatedByIDNEQ(v int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNEQ(FieldCreatedByID, v))
+}
+
+// CreatedByIDIn applies the In predicate on the "created_by_id" field.
+func CreatedByIDIn(vs ...int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldIn(FieldCreatedByID, vs...))
+}
+
+// CreatedByIDNotIn applies the NotIn predicate on the "created_by_id" field.
+func CreatedByIDNotIn(vs ...int) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNotIn(FieldCreatedByID, vs...))
+}
+
+// CreatedByIDIsNil applies the IsNil predicate on the "created_by_id" field.
+func CreatedByIDIsNil() predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldIsNull(FieldCreatedByID))
+}
+
+// CreatedByIDNotNil applies the NotNil predicate on the "created_by_id" field.
+func CreatedByIDNotNil() predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNotNull(FieldCreatedByID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.HourBankMovement {
+	return predicate.HourBankMovement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.HourBankMovement {
+	return predicate.HourBankMovement(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttendanceDay applies the HasEdge predicate on the "attendance_day" edge.
+func HasAttendanceDay() predicate.HourBankMovement {
+	return predicate.HourBankMovement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AttendanceDayTable, AttendanceDayColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttendanceDayWith applies the HasEdge predicate on the "attendance_day" edge with a given conditions (other predicates).
+func HasAttendanceDayWith(preds ...predicate.AttendanceDay) predicate.HourBankMovement {
+	return predicate.HourBankMovement(func(s *sql.Selector) {
+		step := newAttendanceDayStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCreatedBy applies the HasEdge predicate on the "created_by" edge.
+func HasCreatedBy() predicate.HourBankMovement {
+	return predicate.HourBankMovement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CreatedByTable, CreatedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreatedByWith applies the HasEdge predicate on the "created_by" edge with a given conditions (other predicates).
+func HasCreatedByWith(preds ...predicate.User) predicate.HourBankMovement {
+	return predicate.HourBankMovement(func(s *sql.Selector) {
+		step := newCreatedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.HourBankMovement) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.HourBankMovement) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.HourBankMovement) predicate.HourBankMovement {
+	return predicate.HourBankMovement(sql.NotPredicates(p))
+}
@@ -0,0 +1,397 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/attendanceday"
+	"back/internal/ent/hourbankmovement"
+	"back/internal/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HourBankMovementCreate is the builder for creating a HourBankMovement entity.
+type HourBankMovementCreate struct {
+	config
+	mutation *HourBankMovementMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *HourBankMovementCreate) SetUserID(v int) *HourBankMovementCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetDate sets the "date" field.
+func (_c *HourBankMovementCreate) SetDate(v time.Time) *HourBankMovementCreate {
+	_c.mutation.SetDate(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *HourBankMovementCreate) SetKind(v string) *HourBankMovementCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetMinutes sets the "minutes" field.
+func (_c *HourBankMovementCreate) SetMinutes(v int) *HourBankMovementCreate {
+	_c.mutation.SetMinutes(v)
+	return _c
+}
+
+// SetAttendanceDayID sets the "attendance_day_id" field.
+func (_c *HourBankMovementCreate) SetAttendanceDayID(v int) *HourBankMovementCreate {
+	_c.mutation.SetAttendanceDayID(v)
+	return _c
+}
+
+// SetNillableAttendanceDayID sets the "attendance_day_id" field if the given value is not nil.
+func (_c *HourBankMovementCreate) SetNillableAttendanceDayID(v *int) *HourBankMovementCreate {
+	if v != nil {
+		_c.SetAttendanceDayID(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *HourBankMovementCreate) SetReason(v string) *HourBankMovementCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *HourBankMovementCreate) SetNillableReason(v *string) *HourBankMovementCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (_c *HourBankMovementCreate) SetCreatedByID(v int) *HourBankMovementCreate {
+	_c.mutation.SetCreatedByID(v)
+	return _c
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (_c *HourBankMovementCreate) SetNillableCreatedByID(v *int) *HourBankMovementCreate {
+	if v != nil {
+		_c.SetCreatedByID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *HourBankMovementCreate) SetCreatedAt(v time.Time) *HourBankMovementCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *HourBankMovementCreate) SetNillableCreatedAt(v *time.Time) *HourBankMovementCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *HourBankMovementCreate) SetUpdatedAt(v time.Time) *HourBankMovementCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *HourBankMovementCreate) SetNillableUpdatedAt(v *time.Time) *HourBankMovementCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *HourBankMovementCreate) SetUser(v *User) *HourBankMovementCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetAttendanceDay sets the "attendance_day" edge to the AttendanceDay entity.
+func (_c *HourBankMovementCreate) SetAttendanceDay(v *AttendanceDay) *HourBankMovementCreate {
+	return _c.SetAttendanceDayID(v.ID)
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (_c *HourBankMovementCreate) SetCreatedBy(v *User) *HourBankMovementCreate {
+	return _c.SetCreatedByID(v.ID)
+}
+
+// Mutation returns the HourBankMovementMutation object of the builder.
+func (_c *HourBankMovementCreate) Mutation() *HourBankMovementMutation {
+	return _c.mutation
+}
+
+// Save creates the HourBankMovement in the database.
+func (_c *HourBankMovementCreate) Save(ctx context.Context) (*HourBankMovement, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *HourBankMovementCreate) SaveX(ctx context.Context) *HourBankMovement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HourBankMovementCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HourBankMovementCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *HourBankMovementCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := hourbankmovement.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := hourbankmovement.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *HourBankMovementCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "HourBankMovement.user_id"`)}
+	}
+	if _, ok := _c.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "HourBankMovement.date"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "HourBankMovement.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := hourbankmovement.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "HourBankMovement.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Minutes(); !ok {
+		return &ValidationError{Name: "minutes", err: errors.New(`ent: missing required field "HourBankMovement.minutes"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "HourBankMovement.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "HourBankMovement.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "HourBankMovement.user"`)}
+	}
+	return nil
+}
+
+func (_c *HourBankMovementCreate) sqlSave(ctx context.Context) (*HourBankMovement, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *HourBankMovementCreate) createSpec() (*HourBankMovement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &HourBankMovement{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(hourbankmovement.Table, sqlgraph.NewFieldSpec(hourbankmovement.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Date(); ok {
+		_spec.SetField(hourbankmovement.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(hourbankmovement.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Minutes(); ok {
+		_spec.SetField(hourbankmovement.FieldMinutes, field.TypeInt, value)
+		_node.Minutes = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(hourbankmovement.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(hourbankmovement.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(hourbankmovement.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   hourbankmovement.UserTable,
+			Columns: []string{hourbankmovement.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AttendanceDayIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hourbankmovement.AttendanceDayTable,
+			Columns: []string{hourbankmovement.AttendanceDayColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AttendanceDayID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hourbankmovement.CreatedByTable,
+			Columns: []string{hourbankmovement.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreatedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// HourBankMovementCreateBulk is the builder for creating many HourBankMovement entities in bulk.
+type HourBankMovementCreateBulk struct {
+	config
+	err      error
+	builders []*HourBankMovementCreate
+}
+
+// Save creates the HourBankMovement entities in the database.
+func (_c *HourBankMovementCreateBulk) Save(ctx context.Context) ([]*HourBankMovement, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*HourBankMovement, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HourBankMovementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *HourBankMovementCreateBulk) SaveX(ctx context.Context) []*HourBankMovement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HourBankMovementCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HourBankMovementCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
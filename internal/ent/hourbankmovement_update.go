@@ -0,0 +1,768 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/attendanceday"
+	"back/internal/ent/hourbankmovement"
+	"back/internal/ent/predicate"
+	"back/internal/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HourBankMovementUpdate is the builder for updating HourBankMovement entities.
+type HourBankMovementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HourBankMovementMutation
+}
+
+// Where appends a list predicates to the HourBankMovementUpdate builder.
+func (_u *HourBankMovementUpdate) Where(ps ...predicate.HourBankMovement) *HourBankMovementUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *HourBankMovementUpdate) SetUserID(v int) *HourBankMovementUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *HourBankMovementUpdate) SetNillableUserID(v *int) *HourBankMovementUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *HourBankMovementUpdate) SetDate(v time.Time) *HourBankMovementUpdate {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *HourBankMovementUpdate) SetNillableDate(v *time.Time) *HourBankMovementUpdate {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *HourBankMovementUpdate) SetKind(v string) *HourBankMovementUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *HourBankMovementUpdate) SetNillableKind(v *string) *HourBankMovementUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetMinutes sets the "minutes" field.
+func (_u *HourBankMovementUpdate) SetMinutes(v int) *HourBankMovementUpdate {
+	_u.mutation.ResetMinutes()
+	_u.mutation.SetMinutes(v)
+	return _u
+}
+
+// SetNillableMinutes sets the "minutes" field if the given value is not nil.
+func (_u *HourBankMovementUpdate) SetNillableMinutes(v *int) *HourBankMovementUpdate {
+	if v != nil {
+		_u.SetMinutes(*v)
+	}
+	return _u
+}
+
+// AddMinutes adds value to the "minutes" field.
+func (_u *HourBankMovementUpdate) AddMinutes(v int) *HourBankMovementUpdate {
+	_u.mutation.AddMinutes(v)
+	return _u
+}
+
+// SetAttendanceDayID sets the "attendance_day_id" field.
+func (_u *HourBankMovementUpdate) SetAttendanceDayID(v int) *HourBankMovementUpdate {
+	_u.mutation.SetAttendanceDayID(v)
+	return _u
+}
+
+// SetNillableAttendanceDayID sets the "attendance_day_id" field if the given value is not nil.
+func (_u *HourBankMovementUpdate) SetNillableAttendanceDayID(v *int) *HourBankMovementUpdate {
+	if v != nil {
+		_u.SetAttendanceDayID(*v)
+	}
+	return _u
+}
+
+// ClearAttendanceDayID clears the value of the "attendance_day_id" field.
+func (_u *HourBankMovementUpdate) ClearAttendanceDayID() *HourBankMovementUpdate {
+	_u.mutation.ClearAttendanceDayID()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *HourBankMovementUpdate) SetReason(v string) *HourBankMovementUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *HourBankMovementUpdate) SetNillableReason(v *string) *HourBankMovementUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *HourBankMovementUpdate) ClearReason() *HourBankMovementUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (_u *HourBankMovementUpdate) SetCreatedByID(v int) *HourBankMovementUpdate {
+	_u.mutation.SetCreatedByID(v)
+	return _u
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (_u *HourBankMovementUpdate) SetNillableCreatedByID(v *int) *HourBankMovementUpdate {
+	if v != nil {
+		_u.SetCreatedByID(*v)
+	}
+	return _u
+}
+
+// ClearCreatedByID clears the value of the "created_by_id" field.
+func (_u *HourBankMovementUpdate) ClearCreatedByID() *HourBankMovementUpdate {
+	_u.mutation.ClearCreatedByID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *HourBankMovementUpdate) SetUpdatedAt(v time.Time) *HourBankMovementUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *HourBankMovementUpdate) SetUser(v *User) *HourBankMovementUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetAttendanceDay sets the "attendance_day" edge to the AttendanceDay entity.
+func (_u *HourBankMovementUpdate) SetAttendanceDay(v *AttendanceDay) *HourBankMovementUpdate {
+	return _u.SetAttendanceDayID(v.ID)
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (_u *HourBankMovementUpdate) SetCreatedBy(v *User) *HourBankMovementUpdate {
+	return _u.SetCreatedByID(v.ID)
+}
+
+// Mutation returns the HourBankMovementMutation object of the builder.
+func (_u *HourBankMovementUpdate) Mutation() *HourBankMovementMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *HourBankMovementUpdate) ClearUser() *HourBankMovementUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearAttendanceDay clears the "attendance_day" edge to the AttendanceDay entity.
+func (_u *HourBankMovementUpdate) ClearAttendanceDay() *HourBankMovementUpdate {
+	_u.mutation.ClearAttendanceDay()
+	return _u
+}
+
+// ClearCreatedBy clears the "created_by" edge to the User entity.
+func (_u *HourBankMovementUpdate) ClearCreatedBy() *HourBankMovementUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *HourBankMovementUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HourBankMovementUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *HourBankMovementUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HourBankMovementUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *HourBankMovementUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := hourbankmovement.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HourBankMovementUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := hourbankmovement.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "HourBankMovement.kind": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HourBankMovement.user"`)
+	}
+	return nil
+}
+
+func (_u *HourBankMovementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(hourbankmovement.Table, hourbankmovement.Columns, sqlgraph.NewFieldSpec(hourbankmovement.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(hourbankmovement.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(hourbankmovement.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Minutes(); ok {
+		_spec.SetField(hourbankmovement.FieldMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinutes(); ok {
+		_spec.AddField(hourbankmovement.FieldMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(hourbankmovement.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(hourbankmovement.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(hourbankmovement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   hourbankmovement.UserTable,
+			Columns: []string{hourbankmovement.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   hourbankmovement.UserTable,
+			Columns: []string{hourbankmovement.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AttendanceDayCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hourbankmovement.AttendanceDayTable,
+			Columns: []string{hourbankmovement.AttendanceDayColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceday.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttendanceDayIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hourbankmovement.AttendanceDayTable,
+			Columns: []string{hourbankmovement.AttendanceDayColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CreatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hourbankmovement.CreatedByTable,
+			Columns: []string{hourbankmovement.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hourbankmovement.CreatedByTable,
+			Columns: []string{hourbankmovement.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hourbankmovement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// HourBankMovementUpdateOne is the builder for updating a single HourBankMovement entity.
+type HourBankMovementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HourBankMovementMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *HourBankMovementUpdateOne) SetUserID(v int) *HourBankMovementUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *HourBankMovementUpdateOne) SetNillableUserID(v *int) *HourBankMovementUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *HourBankMovementUpdateOne) SetDate(v time.Time) *HourBankMovementUpdateOne {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *HourBankMovementUpdateOne) SetNillableDate(v *time.Time) *HourBankMovementUpdateOne {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *HourBankMovementUpdateOne) SetKind(v string) *HourBankMovementUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *HourBankMovementUpdateOne) SetNillableKind(v *string) *HourBankMovementUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetMinutes sets the "minutes" field.
+func (_u *HourBankMovementUpdateOne) SetMinutes(v int) *HourBankMovementUpdateOne {
+	_u.mutation.ResetMinutes()
+	_u.mutation.SetMinutes(v)
+	return _u
+}
+
+// SetNillableMinutes sets the "minutes" field if the given value is not nil.
+func (_u *HourBankMovementUpdateOne) SetNillableMinutes(v *int) *HourBankMovementUpdateOne {
+	if v != nil {
+		_u.SetMinutes(*v)
+	}
+	return _u
+}
+
+// AddMinutes adds value to the "minutes" field.
+func (_u *HourBankMovementUpdateOne) AddMinutes(v int) *HourBankMovementUpdateOne {
+	_u.mutation.AddMinutes(v)
+	return _u
+}
+
+// SetAttendanceDayID sets the "attendance_day_id" field.
+func (_u *HourBankMovementUpdateOne) SetAttendanceDayID(v int) *HourBankMovementUpdateOne {
+	_u.mutation.SetAttendanceDayID(v)
+	return _u
+}
+
+// SetNillableAttendanceDayID sets the "attendance_day_id" field if the given value is not nil.
+func (_u *HourBankMovementUpdateOne) SetNillableAttendanceDayID(v *int) *HourBankMovementUpdateOne {
+	if v != nil {
+		_u.SetAttendanceDayID(*v)
+	}
+	return _u
+}
+
+// ClearAttendanceDayID clears the value of the "attendance_day_id" field.
+func (_u *HourBankMovementUpdateOne) ClearAttendanceDayID() *HourBankMovementUpdateOne {
+	_u.mutation.ClearAttendanceDayID()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *HourBankMovementUpdateOne) SetReason(v string) *HourBankMovementUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *HourBankMovementUpdateOne) SetNillableReason(v *string) *HourBankMovementUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *HourBankMovementUpdateOne) ClearReason() *HourBankMovementUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (_u *HourBankMovementUpdateOne) SetCreatedByID(v int) *HourBankMovementUpdateOne {
+	_u.mutation.SetCreatedByID(v)
+	return _u
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (_u *HourBankMovementUpdateOne) SetNillableCreatedByID(v *int) *HourBankMovementUpdateOne {
+	if v != nil {
+		_u.SetCreatedByID(*v)
+	}
+	return _u
+}
+
+// ClearCreatedByID clears the value of the "created_by_id" field.
+func (_u *HourBankMovementUpdateOne) ClearCreatedByID() *HourBankMovementUpdateOne {
+	_u.mutation.ClearCreatedByID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *HourBankMovementUpdateOne) SetUpdatedAt(v time.Time) *HourBankMovementUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *HourBankMovementUpdateOne) SetUser(v *User) *HourBankMovementUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetAttendanceDay sets the "attendance_day" edge to the AttendanceDay entity.
+func (_u *HourBankMovementUpdateOne) SetAttendanceDay(v *AttendanceDay) *HourBankMovementUpdateOne {
+	return _u.SetAttendanceDayID(v.ID)
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (_u *HourBankMovementUpdateOne) SetCreatedBy(v *User) *HourBankMovementUpdateOne {
+	return _u.SetCreatedByID(v.ID)
+}
+
+// Mutation returns the HourBankMovementMutation object of the builder.
+func (_u *HourBankMovementUpdateOne) Mutation() *HourBankMovementMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *HourBankMovementUpdateOne) ClearUser() *HourBankMovementUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearAttendanceDay clears the "attendance_day" edge to the AttendanceDay entity.
+func (_u *HourBankMovementUpdateOne) ClearAttendanceDay() *HourBankMovementUpdateOne {
+	_u.mutation.ClearAttendanceDay()
+	return _u
+}
+
+// ClearCreatedBy clears the "created_by" edge to the User entity.
+func (_u *HourBankMovementUpdateOne) ClearCreatedBy() *HourBankMovementUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// Where appends a list predicates to the HourBankMovementUpdate builder.
+func (_u *HourBankMovementUpdateOne) Where(ps ...predicate.HourBankMovement) *HourBankMovementUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *HourBankMovementUpdateOne) Select(field string, fields ...string) *HourBankMovementUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated HourBankMovement entity.
+func (_u *HourBankMovementUpdateOne) Save(ctx context.Context) (*HourBankMovement, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HourBankMovementUpdateOne) SaveX(ctx context.Context) *HourBankMovement {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *HourBankMovementUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HourBankMovementUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *HourBankMovementUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := hourbankmovement.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HourBankMovementUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := hourbankmovement.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "HourBankMovement.kind": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HourBankMovement.user"`)
+	}
+	return nil
+}
+
+func (_u *HourBankMovementUpdateOne) sqlSave(ctx context.Context) (_node *HourBankMovement, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(hourbankmovement.Table, hourbankmovement.Columns, sqlgraph.NewFieldSpec(hourbankmovement.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "HourBankMovement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, hourbankmovement.FieldID)
+		for _, f := range fields {
+			if !hourbankmovement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != hourbankmovement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(hourbankmovement.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(hourbankmovement.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Minutes(); ok {
+		_spec.SetField(hourbankmovement.FieldMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinutes(); ok {
+		_spec.AddField(hourbankmovement.FieldMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(hourbankmovement.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(hourbankmovement.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(hourbankmovement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   hourbankmovement.UserTable,
+			Columns: []string{hourbankmovement.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   hourbankmovement.UserTable,
+			Columns: []string{hourbankmovement.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AttendanceDayCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hourbankmovement.AttendanceDayTable,
+			Columns: []string{hourbankmovement.AttendanceDayColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceday.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttendanceDayIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hourbankmovement.AttendanceDayTable,
+			Columns: []string{hourbankmovement.AttendanceDayColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CreatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hourbankmovement.CreatedByTable,
+			Columns: []string{hourbankmovement.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hourbankmovement.CreatedByTable,
+			Columns: []string{hourbankmovement.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &HourBankMovement{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hourbankmovement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/leaverequest"
+	"back/internal/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LeaveRequest is the model entity for the LeaveRequest schema.
+type LeaveRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// StartDate holds the value of the "start_date" field.
+	StartDate time.Time `json:"start_date,omitempty"`
+	// EndDate holds the value of the "end_date" field.
+	EndDate time.Time `json:"end_date,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason *string `json:"reason,omitempty"`
+	// ReviewedByID holds the value of the "reviewed_by_id" field.
+	ReviewedByID *int `json:"reviewed_by_id,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// ReviewNotes holds the value of the "review_notes" field.
+	ReviewNotes *string `json:"review_notes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LeaveRequestQuery when eager-loading is set.
+	Edges        LeaveRequestEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LeaveRequestEdges holds the relations/edges for other nodes in the graph.
+type LeaveRequestEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// ReviewedBy holds the value of the reviewed_by edge.
+	ReviewedBy *User `json:"reviewed_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LeaveRequestEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ReviewedByOrErr returns the ReviewedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LeaveRequestEdges) ReviewedByOrErr() (*User, error) {
+	if e.ReviewedBy != nil {
+		return e.ReviewedBy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "reviewed_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LeaveRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case leaverequest.FieldID, leaverequest.FieldUserID, leaverequest.FieldReviewedByID:
+			values[i] = new(sql.NullInt64)
+		case leaverequest.FieldType, leaverequest.FieldStatus, leaverequest.FieldReason, leaverequest.FieldReviewNotes:
+			values[i] = new(sql.NullString)
+		case leaverequest.FieldStartDate, leaverequest.FieldEndDate, leaverequest.FieldReviewedAt, leaverequest.FieldCreatedAt, leaverequest.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LeaveRequest fields.
+func (_m *LeaveRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case leaverequest.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case leaverequest.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case leaverequest.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = value.String
+			}
+		case leaverequest.FieldStartDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_date", values[i])
+			} else if value.Valid {
+				_m.StartDate = value.Time
+			}
+		case leaverequest.FieldEndDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_date", values[i])
+			} else if value.Valid {
+				_m.EndDate = value.Time
+			}
+		case leaverequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case leaverequest.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = new(string)
+				*_m.Reason = value.String
+			}
+		case leaverequest.FieldReviewedByID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by_id", values[i])
+			} else if value.Valid {
+				_m.ReviewedByID = new(int)
+				*_m.ReviewedByID = int(value.Int64)
+			}
+		case leaverequest.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				_m.ReviewedAt = new(time.Time)
+				*_m.ReviewedAt = value.Time
+			}
+		case leaverequest.FieldReviewNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_notes", values[i])
+			} else if value.Valid {
+				_m.ReviewNotes = new(string)
+				*_m.ReviewNotes = value.String
+			}
+		case leaverequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case leaverequest.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LeaveRequest.
+// This includes values selected through modifiers, order, etc.
+func (_m *LeaveRequest) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the LeaveRequest entity.
+func (_m *LeaveRequest) QueryUser() *UserQuery {
+	return NewLeaveRequestClient(_m.config).QueryUser(_m)
+}
+
+// QueryReviewedBy queries the "reviewed_by" edge of the LeaveRequest entity.
+func (_m *LeaveRequest) QueryReviewedBy() *UserQuery {
+	return NewLeaveRequestClient(_m.config).QueryReviewedBy(_m)
+}
+
+// Update returns a builder for updating this LeaveRequest.
+// Note that you need to call LeaveRequest.Unwrap() before calling this method if this LeaveRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LeaveRequest) Update() *LeaveRequestUpdateOne {
+	return NewLeaveRequestClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LeaveRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LeaveRequest) Unwrap() *LeaveRequest {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LeaveRequest is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LeaveRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("LeaveRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(_m.Type)
+	builder.WriteString(", ")
+	builder.WriteString("start_date=")
+	builder.WriteString(_m.StartDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_date=")
+	builder.WriteString(_m.EndDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.Reason; v != nil {
+		builder.WriteString("reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ReviewedByID; v != nil {
+		builder.WriteString("reviewed_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReviewNotes; v != nil {
+		builder.WriteString("review_notes=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LeaveRequests is a parsable slice of LeaveRequest.
+type LeaveRequests []*LeaveRequest
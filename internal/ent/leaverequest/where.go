@@ -0,0 +1,712 @@
+// Code generated by ent, DO NOT EDIT.
+
+package leaverequest
+
+import (
+	"back/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldUserID, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldType, v))
+}
+
+// StartDate applies equality check predicate on the "start_date" field. It's identical to StartDateEQ.
+func StartDate(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldStartDate, v))
+}
+
+// EndDate applies equality check predicate on the "end_date" field. It's identical to EndDateEQ.
+func EndDate(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldEndDate, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldStatus, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldReason, v))
+}
+
+// ReviewedByID applies equality check predicate on the "reviewed_by_id" field. It's identical to ReviewedByIDEQ.
+func ReviewedByID(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldReviewedByID, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewNotes applies equality check predicate on the "review_notes" field. It's identical to ReviewNotesEQ.
+func ReviewNotes(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldReviewNotes, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldContainsFold(FieldType, v))
+}
+
+// StartDateEQ applies the EQ predicate on the "start_date" field.
+func StartDateEQ(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldStartDate, v))
+}
+
+// StartDateNEQ applies the NEQ predicate on the "start_date" field.
+func StartDateNEQ(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldStartDate, v))
+}
+
+// StartDateIn applies the In predicate on the "start_date" field.
+func StartDateIn(vs ...time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldStartDate, vs...))
+}
+
+// StartDateNotIn applies the NotIn predicate on the "start_date" field.
+func StartDateNotIn(vs ...time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldStartDate, vs...))
+}
+
+// StartDateGT applies the GT predicate on the "start_date" field.
+func StartDateGT(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldStartDate, v))
+}
+
+// StartDateGTE applies the GTE predicate on the "start_date" field.
+func StartDateGTE(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldStartDate, v))
+}
+
+// StartDateLT applies the LT predicate on the "start_date" field.
+func StartDateLT(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldStartDate, v))
+}
+
+// StartDateLTE applies the LTE predicate on the "start_date" field.
+func StartDateLTE(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldStartDate, v))
+}
+
+// EndDateEQ applies the EQ predicate on the "end_date" field.
+func EndDateEQ(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldEndDate, v))
+}
+
+// EndDateNEQ applies the NEQ predicate on the "end_date" field.
+func EndDateNEQ(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldEndDate, v))
+}
+
+// EndDateIn applies the In predicate on the "end_date" field.
+func EndDateIn(vs ...time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldEndDate, vs...))
+}
+
+// EndDateNotIn applies the NotIn predicate on the "end_date" field.
+func EndDateNotIn(vs ...time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldEndDate, vs...))
+}
+
+// EndDateGT applies the GT predicate on the "end_date" field.
+func EndDateGT(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldEndDate, v))
+}
+
+// EndDateGTE applies the GTE predicate on the "end_date" field.
+func EndDateGTE(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldEndDate, v))
+}
+
+// EndDateLT applies the LT predicate on the "end_date" field.
+func EndDateLT(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldEndDate, v))
+}
+
+// EndDateLTE applies the LTE predicate on the "end_date" field.
+func EndDateLTE(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldEndDate, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldContainsFold(FieldReason, v))
+}
+
+// ReviewedByIDEQ applies the EQ predicate on the "reviewed_by_id" field.
+func ReviewedByIDEQ(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldReviewedByID, v))
+}
+
+// ReviewedByIDNEQ applies the NEQ predicate on the "reviewed_by_id" field.
+func ReviewedByIDNEQ(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldReviewedByID, v))
+}
+
+// ReviewedByIDIn applies the In predicate on the "reviewed_by_id" field.
+func ReviewedByIDIn(vs ...int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldReviewedByID, vs...))
+}
+
+// ReviewedByIDNotIn applies the NotIn predicate on the "reviewed_by_id" field.
+func ReviewedByIDNotIn(vs ...int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldReviewedByID, vs...))
+}
+
+// ReviewedByIDIsNil applies the IsNil predicate on the "reviewed_by_id" field.
+func ReviewedByIDIsNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIsNull(FieldReviewedByID))
+}
+
+// ReviewedByIDNotNil applies the NotNil predicate on the "reviewed_by_id" field.
+func ReviewedByIDNotNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotNull(FieldReviewedByID))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// ReviewNotesEQ applies the EQ predicate on the "review_notes" field.
+func ReviewNotesEQ(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldReviewNotes, v))
+}
+
+// ReviewNotesNEQ applies the NEQ predicate on the "review_notes" field.
+func ReviewNotesNEQ(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldReviewNotes, v))
+}
+
+// ReviewNotesIn applies the In predicate on the "review_notes" field.
+func ReviewNotesIn(vs ...string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldReviewNotes, vs...))
+}
+
+// ReviewNotesNotIn applies the NotIn predicate on the "review_notes" field.
+func ReviewNotesNotIn(vs ...string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldReviewNotes, vs...))
+}
+
+// ReviewNotesGT applies the GT predicate on the "review_notes" field.
+func ReviewNotesGT(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldReviewNotes, v))
+}
+
+// ReviewNotesGTE applies the GTE predicate on the "review_notes" field.
+func ReviewNotesGTE(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldReviewNotes, v))
+}
+
+// ReviewNotesLT applies the LT predicate on the "review_notes" field.
+func ReviewNotesLT(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldReviewNotes, v))
+}
+
+// ReviewNotesLTE applies the LTE predicate on the "review_notes" field.
+func ReviewNotesLTE(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldReviewNotes, v))
+}
+
+// ReviewNotesContains applies the Contains predicate on the "review_notes" field.
+func ReviewNotesContains(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldContains(FieldReviewNotes, v))
+}
+
+// ReviewNotesHasPrefix applies the HasPrefix predicate on the "review_notes" field.
+func ReviewNotesHasPrefix(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldHasPrefix(FieldReviewNotes, v))
+}
+
+// ReviewNotesHasSuffix applies the HasSuffix predicate on the "review_notes" field.
+func ReviewNotesHasSuffix(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldHasSuffix(FieldReviewNotes, v))
+}
+
+// ReviewNotesIsNil applies the IsNil predicate on the "review_notes" field.
+func ReviewNotesIsNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIsNull(FieldReviewNotes))
+}
+
+// ReviewNotesNotNil applies the NotNil predicate on the "review_notes" field.
+func ReviewNotesNotNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotNull(FieldReviewNotes))
+}
+
+// ReviewNotesEqualFold applies the EqualFold predicate on the "review_notes" field.
+func ReviewNotesEqualFold(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEqualFold(FieldReviewNotes, v))
+}
+
+// ReviewNotesContainsFold applies the ContainsFold predicate on the "review_notes" field.
+func ReviewNotesContainsFold(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldContainsFold(FieldReviewNotes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.LeaveRequest {
+	return predicate.LeaveRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.LeaveRequest {
+	return predicate.LeaveRequest(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReviewedBy applies the HasEdge predicate on the "reviewed_by" edge.
+func HasReviewedBy() predicate.LeaveRequest {
+	return predicate.LeaveRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ReviewedByTable, ReviewedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReviewedByWith applies the HasEdge predicate on the "reviewed_by" edge with a given conditions (other predicates).
+func HasReviewedByWith(preds ...predicate.User) predicate.LeaveRequest {
+	return predicate.LeaveRequest(func(s *sql.Selector) {
+		step := newReviewedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LeaveRequest) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LeaveRequest) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LeaveRequest) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.NotPredicates(p))
+}
@@ -0,0 +1,426 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/leaverequest"
+	"back/internal/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaveRequestCreate is the builder for creating a LeaveRequest entity.
+type LeaveRequestCreate struct {
+	config
+	mutation *LeaveRequestMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LeaveRequestCreate) SetUserID(v int) *LeaveRequestCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *LeaveRequestCreate) SetType(v string) *LeaveRequestCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetStartDate sets the "start_date" field.
+func (_c *LeaveRequestCreate) SetStartDate(v time.Time) *LeaveRequestCreate {
+	_c.mutation.SetStartDate(v)
+	return _c
+}
+
+// SetEndDate sets the "end_date" field.
+func (_c *LeaveRequestCreate) SetEndDate(v time.Time) *LeaveRequestCreate {
+	_c.mutation.SetEndDate(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *LeaveRequestCreate) SetStatus(v string) *LeaveRequestCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableStatus(v *string) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *LeaveRequestCreate) SetReason(v string) *LeaveRequestCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableReason(v *string) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetReviewedByID sets the "reviewed_by_id" field.
+func (_c *LeaveRequestCreate) SetReviewedByID(v int) *LeaveRequestCreate {
+	_c.mutation.SetReviewedByID(v)
+	return _c
+}
+
+// SetNillableReviewedByID sets the "reviewed_by_id" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableReviewedByID(v *int) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetReviewedByID(*v)
+	}
+	return _c
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_c *LeaveRequestCreate) SetReviewedAt(v time.Time) *LeaveRequestCreate {
+	_c.mutation.SetReviewedAt(v)
+	return _c
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableReviewedAt(v *time.Time) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetReviewedAt(*v)
+	}
+	return _c
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (_c *LeaveRequestCreate) SetReviewNotes(v string) *LeaveRequestCreate {
+	_c.mutation.SetReviewNotes(v)
+	return _c
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableReviewNotes(v *string) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetReviewNotes(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LeaveRequestCreate) SetCreatedAt(v time.Time) *LeaveRequestCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableCreatedAt(v *time.Time) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LeaveRequestCreate) SetUpdatedAt(v time.Time) *LeaveRequestCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableUpdatedAt(v *time.Time) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *LeaveRequestCreate) SetUser(v *User) *LeaveRequestCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetReviewedBy sets the "reviewed_by" edge to the User entity.
+func (_c *LeaveRequestCreate) SetReviewedBy(v *User) *LeaveRequestCreate {
+	return _c.SetReviewedByID(v.ID)
+}
+
+// Mutation returns the LeaveRequestMutation object of the builder.
+func (_c *LeaveRequestCreate) Mutation() *LeaveRequestMutation {
+	return _c.mutation
+}
+
+// Save creates the LeaveRequest in the database.
+func (_c *LeaveRequestCreate) Save(ctx context.Context) (*LeaveRequest, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LeaveRequestCreate) SaveX(ctx context.Context) *LeaveRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeaveRequestCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeaveRequestCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LeaveRequestCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := leaverequest.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := leaverequest.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := leaverequest.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LeaveRequestCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "LeaveRequest.user_id"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "LeaveRequest.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := leaverequest.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartDate(); !ok {
+		return &ValidationError{Name: "start_date", err: errors.New(`ent: missing required field "LeaveRequest.start_date"`)}
+	}
+	if _, ok := _c.mutation.EndDate(); !ok {
+		return &ValidationError{Name: "end_date", err: errors.New(`ent: missing required field "LeaveRequest.end_date"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "LeaveRequest.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := leaverequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LeaveRequest.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LeaveRequest.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "LeaveRequest.user"`)}
+	}
+	return nil
+}
+
+func (_c *LeaveRequestCreate) sqlSave(ctx context.Context) (*LeaveRequest, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LeaveRequestCreate) createSpec() (*LeaveRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LeaveRequest{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(leaverequest.Table, sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(leaverequest.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.StartDate(); ok {
+		_spec.SetField(leaverequest.FieldStartDate, field.TypeTime, value)
+		_node.StartDate = value
+	}
+	if value, ok := _c.mutation.EndDate(); ok {
+		_spec.SetField(leaverequest.FieldEndDate, field.TypeTime, value)
+		_node.EndDate = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(leaverequest.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(leaverequest.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	if value, ok := _c.mutation.ReviewedAt(); ok {
+		_spec.SetField(leaverequest.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := _c.mutation.ReviewNotes(); ok {
+		_spec.SetField(leaverequest.FieldReviewNotes, field.TypeString, value)
+		_node.ReviewNotes = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(leaverequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(leaverequest.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaverequest.UserTable,
+			Columns: []string{leaverequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReviewedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   leaverequest.ReviewedByTable,
+			Columns: []string{leaverequest.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReviewedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LeaveRequestCreateBulk is the builder for creating many LeaveRequest entities in bulk.
+type LeaveRequestCreateBulk struct {
+	config
+	err      error
+	builders []*LeaveRequestCreate
+}
+
+// Save creates the LeaveRequest entities in the database.
+func (_c *LeaveRequestCreateBulk) Save(ctx context.Context) ([]*LeaveRequest, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LeaveRequest, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeaveRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LeaveRequestCreateBulk) SaveX(ctx context.Context) []*LeaveRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeaveRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeaveRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,775 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/leaverequest"
+	"back/internal/ent/predicate"
+	"back/internal/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LeaveRequestUpdate is the builder for updating LeaveRequest entities.
+type LeaveRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LeaveRequestMutation
+}
+
+// Where appends a list predicates to the LeaveRequestUpdate builder.
+func (_u *LeaveRequestUpdate) Where(ps ...predicate.LeaveRequest) *LeaveRequestUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LeaveRequestUpdate) SetUserID(v int) *LeaveRequestUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableUserID(v *int) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *LeaveRequestUpdate) SetType(v string) *LeaveRequestUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableType(v *string) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *LeaveRequestUpdate) SetStartDate(v time.Time) *LeaveRequestUpdate {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableStartDate(v *time.Time) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetEndDate sets the "end_date" field.
+func (_u *LeaveRequestUpdate) SetEndDate(v time.Time) *LeaveRequestUpdate {
+	_u.mutation.SetEndDate(v)
+	return _u
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableEndDate(v *time.Time) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetEndDate(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LeaveRequestUpdate) SetStatus(v string) *LeaveRequestUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableStatus(v *string) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *LeaveRequestUpdate) SetReason(v string) *LeaveRequestUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableReason(v *string) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *LeaveRequestUpdate) ClearReason() *LeaveRequestUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetReviewedByID sets the "reviewed_by_id" field.
+func (_u *LeaveRequestUpdate) SetReviewedByID(v int) *LeaveRequestUpdate {
+	_u.mutation.SetReviewedByID(v)
+	return _u
+}
+
+// SetNillableReviewedByID sets the "reviewed_by_id" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableReviewedByID(v *int) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetReviewedByID(*v)
+	}
+	return _u
+}
+
+// ClearReviewedByID clears the value of the "reviewed_by_id" field.
+func (_u *LeaveRequestUpdate) ClearReviewedByID() *LeaveRequestUpdate {
+	_u.mutation.ClearReviewedByID()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *LeaveRequestUpdate) SetReviewedAt(v time.Time) *LeaveRequestUpdate {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableReviewedAt(v *time.Time) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *LeaveRequestUpdate) ClearReviewedAt() *LeaveRequestUpdate {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (_u *LeaveRequestUpdate) SetReviewNotes(v string) *LeaveRequestUpdate {
+	_u.mutation.SetReviewNotes(v)
+	return _u
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableReviewNotes(v *string) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetReviewNotes(*v)
+	}
+	return _u
+}
+
+// ClearReviewNotes clears the value of the "review_notes" field.
+func (_u *LeaveRequestUpdate) ClearReviewNotes() *LeaveRequestUpdate {
+	_u.mutation.ClearReviewNotes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LeaveRequestUpdate) SetUpdatedAt(v time.Time) *LeaveRequestUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LeaveRequestUpdate) SetUser(v *User) *LeaveRequestUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetReviewedBy sets the "reviewed_by" edge to the User entity.
+func (_u *LeaveRequestUpdate) SetReviewedBy(v *User) *LeaveRequestUpdate {
+	return _u.SetReviewedByID(v.ID)
+}
+
+// Mutation returns the LeaveRequestMutation object of the builder.
+func (_u *LeaveRequestUpdate) Mutation() *LeaveRequestMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LeaveRequestUpdate) ClearUser() *LeaveRequestUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearReviewedBy clears the "reviewed_by" edge to the User entity.
+func (_u *LeaveRequestUpdate) ClearReviewedBy() *LeaveRequestUpdate {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LeaveRequestUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeaveRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LeaveRequestUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeaveRequestUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LeaveRequestUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := leaverequest.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeaveRequestUpdate) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := leaverequest.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := leaverequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.status": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LeaveRequest.user"`)
+	}
+	return nil
+}
+
+func (_u *LeaveRequestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leaverequest.Table, leaverequest.Columns, sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(leaverequest.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(leaverequest.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndDate(); ok {
+		_spec.SetField(leaverequest.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(leaverequest.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(leaverequest.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(leaverequest.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(leaverequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(leaverequest.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReviewNotes(); ok {
+		_spec.SetField(leaverequest.FieldReviewNotes, field.TypeString, value)
+	}
+	if _u.mutation.ReviewNotesCleared() {
+		_spec.ClearField(leaverequest.FieldReviewNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(leaverequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaverequest.UserTable,
+			Columns: []string{leaverequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaverequest.UserTable,
+			Columns: []string{leaverequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   leaverequest.ReviewedByTable,
+			Columns: []string{leaverequest.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   leaverequest.ReviewedByTable,
+			Columns: []string{leaverequest.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leaverequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LeaveRequestUpdateOne is the builder for updating a single LeaveRequest entity.
+type LeaveRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LeaveRequestMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LeaveRequestUpdateOne) SetUserID(v int) *LeaveRequestUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableUserID(v *int) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *LeaveRequestUpdateOne) SetType(v string) *LeaveRequestUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableType(v *string) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *LeaveRequestUpdateOne) SetStartDate(v time.Time) *LeaveRequestUpdateOne {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableStartDate(v *time.Time) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetEndDate sets the "end_date" field.
+func (_u *LeaveRequestUpdateOne) SetEndDate(v time.Time) *LeaveRequestUpdateOne {
+	_u.mutation.SetEndDate(v)
+	return _u
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableEndDate(v *time.Time) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetEndDate(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LeaveRequestUpdateOne) SetStatus(v string) *LeaveRequestUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableStatus(v *string) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *LeaveRequestUpdateOne) SetReason(v string) *LeaveRequestUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableReason(v *string) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *LeaveRequestUpdateOne) ClearReason() *LeaveRequestUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetReviewedByID sets the "reviewed_by_id" field.
+func (_u *LeaveRequestUpdateOne) SetReviewedByID(v int) *LeaveRequestUpdateOne {
+	_u.mutation.SetReviewedByID(v)
+	return _u
+}
+
+// SetNillableReviewedByID sets the "reviewed_by_id" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableReviewedByID(v *int) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetReviewedByID(*v)
+	}
+	return _u
+}
+
+// ClearReviewedByID clears the value of the "reviewed_by_id" field.
+func (_u *LeaveRequestUpdateOne) ClearReviewedByID() *LeaveRequestUpdateOne {
+	_u.mutation.ClearReviewedByID()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *LeaveRequestUpdateOne) SetReviewedAt(v time.Time) *LeaveRequestUpdateOne {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableReviewedAt(v *time.Time) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *LeaveRequestUpdateOne) ClearReviewedAt() *LeaveRequestUpdateOne {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (_u *LeaveRequestUpdateOne) SetReviewNotes(v string) *LeaveRequestUpdateOne {
+	_u.mutation.SetReviewNotes(v)
+	return _u
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableReviewNotes(v *string) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetReviewNotes(*v)
+	}
+	return _u
+}
+
+// ClearReviewNotes clears the value of the "review_notes" field.
+func (_u *LeaveRequestUpdateOne) ClearReviewNotes() *LeaveRequestUpdateOne {
+	_u.mutation.ClearReviewNotes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LeaveRequestUpdateOne) SetUpdatedAt(v time.Time) *LeaveRequestUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LeaveRequestUpdateOne) SetUser(v *User) *LeaveRequestUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetReviewedBy sets the "reviewed_by" edge to the User entity.
+func (_u *LeaveRequestUpdateOne) SetReviewedBy(v *User) *LeaveRequestUpdateOne {
+	return _u.SetReviewedByID(v.ID)
+}
+
+// Mutation returns the LeaveRequestMutation object of the builder.
+func (_u *LeaveRequestUpdateOne) Mutation() *LeaveRequestMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LeaveRequestUpdateOne) ClearUser() *LeaveRequestUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearReviewedBy clears the "reviewed_by" edge to the User entity.
+func (_u *LeaveRequestUpdateOne) ClearReviewedBy() *LeaveRequestUpdateOne {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// Where appends a list predicates to the LeaveRequestUpdate builder.
+func (_u *LeaveRequestUpdateOne) Where(ps ...predicate.LeaveRequest) *LeaveRequestUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LeaveRequestUpdateOne) Select(field string, fields ...string) *LeaveRequestUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LeaveRequest entity.
+func (_u *LeaveRequestUpdateOne) Save(ctx context.Context) (*LeaveRequest, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeaveRequestUpdateOne) SaveX(ctx context.Context) *LeaveRequest {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LeaveRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeaveRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LeaveRequestUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := leaverequest.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeaveRequestUpdateOne) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := leaverequest.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := leaverequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.status": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LeaveRequest.user"`)
+	}
+	return nil
+}
+
+func (_u *LeaveRequestUpdateOne) sqlSave(ctx context.Context) (_node *LeaveRequest, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leaverequest.Table, leaverequest.Columns, sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LeaveRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leaverequest.FieldID)
+		for _, f := range fields {
+			if !leaverequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != leaverequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(leaverequest.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(leaverequest.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndDate(); ok {
+		_spec.SetField(leaverequest.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(leaverequest.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(leaverequest.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(leaverequest.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(leaverequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(leaverequest.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReviewNotes(); ok {
+		_spec.SetField(leaverequest.FieldReviewNotes, field.TypeString, value)
+	}
+	if _u.mutation.ReviewNotesCleared() {
+		_spec.ClearField(leaverequest.FieldReviewNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(leaverequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaverequest.UserTable,
+			Columns: []string{leaverequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   leaverequest.UserTable,
+			Columns: []string{leaverequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   leaverequest.ReviewedByTable,
+			Columns: []string{leaverequest.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   leaverequest.ReviewedByTable,
+			Columns: []string{leaverequest.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LeaveRequest{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leaverequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,379 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/attendanceday"
+	"back/internal/ent/markcorrectionrequest"
+	"back/internal/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MarkCorrectionRequest is the model entity for the MarkCorrectionRequest schema.
+type MarkCorrectionRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// AttendanceDayID holds the value of the "attendance_day_id" field.
+	AttendanceDayID int `json:"attendance_day_id,omitempty"`
+	// WorkDate holds the value of the "work_date" field.
+	WorkDate time.Time `json:"work_date,omitempty"`
+	// WorkInAt holds the value of the "work_in_at" field.
+	WorkInAt *string `json:"work_in_at,omitempty"`
+	// BreakOutAt holds the value of the "break_out_at" field.
+	BreakOutAt *string `json:"break_out_at,omitempty"`
+	// BreakInAt holds the value of the "break_in_at" field.
+	BreakInAt *string `json:"break_in_at,omitempty"`
+	// WorkOutAt holds the value of the "work_out_at" field.
+	WorkOutAt *string `json:"work_out_at,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Attachment holds the value of the "attachment" field.
+	Attachment *[]byte `json:"attachment,omitempty"`
+	// AttachmentName holds the value of the "attachment_name" field.
+	AttachmentName *string `json:"attachment_name,omitempty"`
+	// AttachmentContentType holds the value of the "attachment_content_type" field.
+	AttachmentContentType *string `json:"attachment_content_type,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// ReviewedByID holds the value of the "reviewed_by_id" field.
+	ReviewedByID *int `json:"reviewed_by_id,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// ReviewNotes holds the value of the "review_notes" field.
+	ReviewNotes *string `json:"review_notes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MarkCorrectionRequestQuery when eager-loading is set.
+	Edges        MarkCorrectionRequestEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MarkCorrectionRequestEdges holds the relations/edges for other nodes in the graph.
+type MarkCorrectionRequestEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// AttendanceDay holds the value of the attendance_day edge.
+	AttendanceDay *AttendanceDay `json:"attendance_day,omitempty"`
+	// ReviewedBy holds the value of the reviewed_by edge.
+	ReviewedBy *User `json:"reviewed_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MarkCorrectionRequestEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// AttendanceDayOrErr returns the AttendanceDay value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MarkCorrectionRequestEdges) AttendanceDayOrErr() (*AttendanceDay, error) {
+	if e.AttendanceDay != nil {
+		return e.AttendanceDay, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: attendanceday.Label}
+	}
+	return nil, &NotLoadedError{edge: "attendance_day"}
+}
+
+// ReviewedByOrErr returns the ReviewedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MarkCorrectionRequestEdges) ReviewedByOrErr() (*User, error) {
+	if e.ReviewedBy != nil {
+		return e.ReviewedBy, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "reviewed_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MarkCorrectionRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case markcorrectionrequest.FieldAttachment:
+			values[i] = new([]byte)
+		case markcorrectionrequest.FieldID, markcorrectionrequest.FieldUserID, markcorrectionrequest.FieldAttendanceDayID, markcorrectionrequest.FieldReviewedByID:
+			values[i] = new(sql.NullInt64)
+		case markcorrectionrequest.FieldWorkInAt, markcorrectionrequest.FieldBreakOutAt, markcorrectionrequest.FieldBreakInAt, markcorrectionrequest.FieldWorkOutAt, markcorrectionrequest.FieldReason, markcorrectionrequest.FieldAttachmentName, markcorrectionrequest.FieldAttachmentContentType, markcorrectionrequest.FieldStatus, markcorrectionrequest.FieldReviewNotes:
+			values[i] = new(sql.NullString)
+		case markcorrectionrequest.FieldWorkDate, markcorrectionrequest.FieldReviewedAt, markcorrectionrequest.FieldCreatedAt, markcorrectionrequest.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MarkCorrectionRequest fields.
+func (_m *MarkCorrectionRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case markcorrectionrequest.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case markcorrectionrequest.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case markcorrectionrequest.FieldAttendanceDayID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attendance_day_id", values[i])
+			} else if value.Valid {
+				_m.AttendanceDayID = int(value.Int64)
+			}
+		case markcorrectionrequest.FieldWorkDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field work_date", values[i])
+			} else if value.Valid {
+				_m.WorkDate = value.Time
+			}
+		case markcorrectionrequest.FieldWorkInAt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field work_in_at", values[i])
+			} else if value.Valid {
+				_m.WorkInAt = new(string)
+				*_m.WorkInAt = value.String
+			}
+		case markcorrectionrequest.FieldBreakOutAt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field break_out_at", values[i])
+			} else if value.Valid {
+				_m.BreakOutAt = new(string)
+				*_m.BreakOutAt = value.String
+			}
+		case markcorrectionrequest.FieldBreakInAt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field break_in_at", values[i])
+			} else if value.Valid {
+				_m.BreakInAt = new(string)
+				*_m.BreakInAt = value.String
+			}
+		case markcorrectionrequest.FieldWorkOutAt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field work_out_at", values[i])
+			} else if value.Valid {
+				_m.WorkOutAt = new(string)
+				*_m.WorkOutAt = value.String
+			}
+		case markcorrectionrequest.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case markcorrectionrequest.FieldAttachment:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment", values[i])
+			} else if value != nil {
+				_m.Attachment = value
+			}
+		case markcorrectionrequest.FieldAttachmentName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment_name", values[i])
+			} else if value.Valid {
+				_m.AttachmentName = new(string)
+				*_m.AttachmentName = value.String
+			}
+		case markcorrectionrequest.FieldAttachmentContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment_content_type", values[i])
+			} else if value.Valid {
+				_m.AttachmentContentType = new(string)
+				*_m.AttachmentContentType = value.String
+			}
+		case markcorrectionrequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case markcorrectionrequest.FieldReviewedByID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by_id", values[i])
+			} else if value.Valid {
+				_m.ReviewedByID = new(int)
+				*_m.ReviewedByID = int(value.Int64)
+			}
+		case markcorrectionrequest.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				_m.ReviewedAt = new(time.Time)
+				*_m.ReviewedAt = value.Time
+			}
+		case markcorrectionrequest.FieldReviewNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_notes", values[i])
+			} else if value.Valid {
+				_m.ReviewNotes = new(string)
+				*_m.ReviewNotes = value.String
+			}
+		case markcorrectionrequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case markcorrectionrequest.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MarkCorrectionRequest.
+// This includes values selected through modifiers, order, etc.
+func (_m *MarkCorrectionRequest) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the MarkCorrectionRequest entity.
+func (_m *MarkCorrectionRequest) QueryUser() *UserQuery {
+	return NewMarkCorrectionRequestClient(_m.config).QueryUser(_m)
+}
+
+// QueryAttendanceDay queries the "attendance_day" edge of the MarkCorrectionRequest entity.
+func (_m *MarkCorrectionRequest) QueryAttendanceDay() *AttendanceDayQuery {
+	return NewMarkCorrectionRequestClient(_m.config).QueryAttendanceDay(_m)
+}
+
+// QueryReviewedBy queries the "reviewed_by" edge of the MarkCorrectionRequest entity.
+func (_m *MarkCorrectionRequest) QueryReviewedBy() *UserQuery {
+	return NewMarkCorrectionRequestClient(_m.config).QueryReviewedBy(_m)
+}
+
+// Update returns a builder for updating this MarkCorrectionRequest.
+// Note that you need to call MarkCorrectionRequest.Unwrap() before calling this method if this MarkCorrectionRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MarkCorrectionRequest) Update() *MarkCorrectionRequestUpdateOne {
+	return NewMarkCorrectionRequestClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MarkCorrectionRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MarkCorrectionRequest) Unwrap() *MarkCorrectionRequest {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MarkCorrectionRequest is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MarkCorrectionRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("MarkCorrectionRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("attendance_day_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttendanceDayID))
+	builder.WriteString(", ")
+	builder.WriteString("work_date=")
+	builder.WriteString(_m.WorkDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.WorkInAt; v != nil {
+		builder.WriteString("work_in_at=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.BreakOutAt; v != nil {
+		builder.WriteString("break_out_at=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.BreakInAt; v != nil {
+		builder.WriteString("break_in_at=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.WorkOutAt; v != nil {
+		builder.WriteString("work_out_at=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	if v := _m.Attachment; v != nil {
+		builder.WriteString("attachment=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AttachmentName; v != nil {
+		builder.WriteString("attachment_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.AttachmentContentType; v != nil {
+		builder.WriteString("attachment_content_type=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.ReviewedByID; v != nil {
+		builder.WriteString("reviewed_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReviewNotes; v != nil {
+		builder.WriteString("review_notes=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MarkCorrectionRequests is a parsable slice of MarkCorrectionRequest.
+type MarkCorrectionRequests []*MarkCorrectionRequest
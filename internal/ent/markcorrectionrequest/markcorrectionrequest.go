@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package markcorrectionrequest
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the markcorrectionrequest type in the database.
+	Label = "mark_correction_request"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldAttendanceDayID holds the string denoting the attendance_day_id field in the database.
+	FieldAttendanceDayID = "attendance_day_id"
+	// FieldWorkDate holds the string denoting the work_date field in the database.
+	FieldWorkDate = "work_date"
+	// FieldWorkInAt holds the string denoting the work_in_at field in the database.
+	FieldWorkInAt = "work_in_at"
+	// FieldBreakOutAt holds the string denoting the break_out_at field in the database.
+	FieldBreakOutAt = "break_out_at"
+	// FieldBreakInAt holds the string denoting the break_in_at field in the database.
+	FieldBreakInAt = "break_in_at"
+	// FieldWorkOutAt holds the string denoting the work_out_at field in the database.
+	FieldWorkOutAt = "work_out_at"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldAttachment holds the string denoting the attachment field in the database.
+	FieldAttachment = "attachment"
+	// FieldAttachmentName holds the string denoting the attachment_name field in the database.
+	FieldAttachmentName = "attachment_name"
+	// FieldAttachmentContentType holds the string denoting the attachment_content_type field in the database.
+	FieldAttachmentContentType = "attachment_content_type"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReviewedByID holds the string denoting the reviewed_by_id field in the database.
+	FieldReviewedByID = "reviewed_by_id"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// FieldReviewNotes holds the string denoting the review_notes field in the database.
+	FieldReviewNotes = "review_notes"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeAttendanceDay holds the string denoting the attendance_day edge name in mutations.
+	EdgeAttendanceDay = "attendance_day"
+	// EdgeReviewedBy holds the string denoting the reviewed_by edge name in mutations.
+	EdgeReviewedBy = "reviewed_by"
+	// Table holds the table name of the markcorrectionrequest in the database.
+	Table = "mark_correction_requests"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "mark_correction_requests"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+	// AttendanceDayTable is the table that holds the attendance_day relation/edge.
+	AttendanceDayTable = "mark_correction_requests"
+	// AttendanceDayInverseTable is the table name for the AttendanceDay entity.
+	// It exists in this package in order to avoid circular dependency with the "attendanceday" package.
+	AttendanceDayInverseTable = "attendance_days"
+	// AttendanceDayColumn is the table column denoting the attendance_day relation/edge.
+	AttendanceDayColumn = "attendance_day_id"
+	// ReviewedByTable is the table that holds the reviewed_by relation/edge.
+	ReviewedByTable = "mark_correction_requests"
+	// ReviewedByInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ReviewedByInverseTable = "users"
+	// ReviewedByColumn is the table column denoting the reviewed_by relation/edge.
+	ReviewedByColumn = "reviewed_by_id"
+)
+
+// Columns holds all SQL columns for markcorrectionrequest fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldAttendanceDayID,
+	FieldWorkDate,
+	FieldWorkInAt,
+	FieldBreakOutAt,
+	FieldBreakInAt,
+	FieldWorkOutAt,
+	FieldReason,
+	FieldAttachment,
+	FieldAttachmentName,
+	FieldAttachmentContentType,
+	FieldStatus,
+	FieldReviewedByID,
+	FieldReviewedAt,
+	FieldReviewNotes,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	ReasonValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the MarkCorrectionRequest queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByAttendanceDayID orders the results by the attendance_day_id field.
+func ByAttendanceDayID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttendanceDayID, opts...).ToFunc()
+}
+
+// ByWorkDate orders the results by the work_date field.
+func ByWorkDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkDate, opts...).ToFunc()
+}
+
+// ByWorkInAt orders the results by the work_in_at field.
+func ByWorkInAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkInAt, opts...).ToFunc()
+}
+
+// ByBreakOutAt orders the results by the break_out_at field.
+func ByBreakOutAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBreakOutAt, opts...).ToFunc()
+}
+
+// ByBreakInAt orders the results by the break_in_at field.
+func ByBreakInAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBreakInAt, opts...).ToFunc()
+}
+
+// ByWorkOutAt orders the results by the work_out_at field.
+func ByWorkOutAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkOutAt, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByAttachmentName orders the results by the attachment_name field.
+func ByAttachmentName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttachmentName, opts...).ToFunc()
+}
+
+// ByAttachmentContentType orders the results by the attachment_content_type field.
+func ByAttachmentContentType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttachmentContentType, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReviewedByID orders the results by the reviewed_by_id field.
+func ByReviewedByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedByID, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
+
+// ByReviewNotes orders the results by the review_notes field.
+func ByReviewNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewNotes, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAttendanceDayField orders the results by attendance_day field.
+func ByAttendanceDayField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttendanceDayStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByReviewedByField orders the results by reviewed_by field.
+func ByReviewedByField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReviewedByStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+func newAttendanceDayStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttendanceDayInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, AttendanceDayTable, AttendanceDayColumn),
+	)
+}
+func newReviewedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReviewedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ReviewedByTable, ReviewedByColumn),
+	)
+}
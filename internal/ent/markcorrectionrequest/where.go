@@ -0,0 +1,1170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package markcorrectionrequest
+
+import (
+	"back/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldUserID, v))
+}
+
+// AttendanceDayID applies equality check predicate on the "attendance_day_id" field. It's identical to AttendanceDayIDEQ.
+func AttendanceDayID(v int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldAttendanceDayID, v))
+}
+
+// WorkDate applies equality check predicate on the "work_date" field. It's identical to WorkDateEQ.
+func WorkDate(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldWorkDate, v))
+}
+
+// WorkInAt applies equality check predicate on the "work_in_at" field. It's identical to WorkInAtEQ.
+func WorkInAt(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldWorkInAt, v))
+}
+
+// BreakOutAt applies equality check predicate on the "break_out_at" field. It's identical to BreakOutAtEQ.
+func BreakOutAt(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldBreakOutAt, v))
+}
+
+// BreakInAt applies equality check predicate on the "break_in_at" field. It's identical to BreakInAtEQ.
+func BreakInAt(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldBreakInAt, v))
+}
+
+// WorkOutAt applies equality check predicate on the "work_out_at" field. It's identical to WorkOutAtEQ.
+func WorkOutAt(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldWorkOutAt, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldReason, v))
+}
+
+// Attachment applies equality check predicate on the "attachment" field. It's identical to AttachmentEQ.
+func Attachment(v []byte) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldAttachment, v))
+}
+
+// AttachmentName applies equality check predicate on the "attachment_name" field. It's identical to AttachmentNameEQ.
+func AttachmentName(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldAttachmentName, v))
+}
+
+// AttachmentContentType applies equality check predicate on the "attachment_content_type" field. It's identical to AttachmentContentTypeEQ.
+func AttachmentContentType(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldAttachmentContentType, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldStatus, v))
+}
+
+// ReviewedByID applies equality check predicate on the "reviewed_by_id" field. It's identical to ReviewedByIDEQ.
+func ReviewedByID(v int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldReviewedByID, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewNotes applies equality check predicate on the "review_notes" field. It's identical to ReviewNotesEQ.
+func ReviewNotes(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldReviewNotes, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// AttendanceDayIDEQ applies the EQ predicate on the "attendance_day_id" field.
+func AttendanceDayIDEQ(v int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldAttendanceDayID, v))
+}
+
+// AttendanceDayIDNEQ applies the NEQ predicate on the "attendance_day_id" field.
+func AttendanceDayIDNEQ(v int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNEQ(FieldAttendanceDayID, v))
+}
+
+// AttendanceDayIDIn applies the In predicate on the "attendance_day_id" field.
+func AttendanceDayIDIn(vs ...int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIn(FieldAttendanceDayID, vs...))
+}
+
+// AttendanceDayIDNotIn applies the NotIn predicate on the "attendance_day_id" field.
+func AttendanceDayIDNotIn(vs ...int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotIn(FieldAttendanceDayID, vs...))
+}
+
+// WorkDateEQ applies the EQ predicate on the "work_date" field.
+func WorkDateEQ(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldWorkDate, v))
+}
+
+// WorkDateNEQ applies the NEQ predicate on the "work_date" field.
+func WorkDateNEQ(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNEQ(FieldWorkDate, v))
+}
+
+// WorkDateIn applies the In predicate on the "work_date" field.
+func WorkDateIn(vs ...time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIn(FieldWorkDate, vs...))
+}
+
+// WorkDateNotIn applies the NotIn predicate on the "work_date" field.
+func WorkDateNotIn(vs ...time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotIn(FieldWorkDate, vs...))
+}
+
+// WorkDateGT applies the GT predicate on the "work_date" field.
+func WorkDateGT(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGT(FieldWorkDate, v))
+}
+
+// WorkDateGTE applies the GTE predicate on the "work_date" field.
+func WorkDateGTE(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGTE(FieldWorkDate, v))
+}
+
+// WorkDateLT applies the LT predicate on the "work_date" field.
+func WorkDateLT(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLT(FieldWorkDate, v))
+}
+
+// WorkDateLTE applies the LTE predicate on the "work_date" field.
+func WorkDateLTE(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLTE(FieldWorkDate, v))
+}
+
+// WorkInAtEQ applies the EQ predicate on the "work_in_at" field.
+func WorkInAtEQ(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldWorkInAt, v))
+}
+
+// WorkInAtNEQ applies the NEQ predicate on the "work_in_at" field.
+func WorkInAtNEQ(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNEQ(FieldWorkInAt, v))
+}
+
+// WorkInAtIn applies the In predicate on the "work_in_at" field.
+func WorkInAtIn(vs ...string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIn(FieldWorkInAt, vs...))
+}
+
+// WorkInAtNotIn applies the NotIn predicate on the "work_in_at" field.
+func WorkInAtNotIn(vs ...string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotIn(FieldWorkInAt, vs...))
+}
+
+// WorkInAtGT applies the GT predicate on the "work_in_at" field.
+func WorkInAtGT(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGT(FieldWorkInAt, v))
+}
+
+// WorkInAtGTE applies the GTE predicate on the "work_in_at" field.
+func WorkInAtGTE(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGTE(FieldWorkInAt, v))
+}
+
+// WorkInAtLT applies the LT predicate on the "work_in_at" field.
+func WorkInAtLT(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLT(FieldWorkInAt, v))
+}
+
+// WorkInAtLTE applies the LTE predicate on the "work_in_at" field.
+func WorkInAtLTE(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLTE(FieldWorkInAt, v))
+}
+
+// WorkInAtContains applies the Contains predicate on the "work_in_at" field.
+func WorkInAtContains(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldContains(FieldWorkInAt, v))
+}
+
+// WorkInAtHasPrefix applies the HasPrefix predicate on the "work_in_at" field.
+func WorkInAtHasPrefix(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldHasPrefix(FieldWorkInAt, v))
+}
+
+// WorkInAtHasSuffix applies the HasSuffix predicate on the "work_in_at" field.
+func WorkInAtHasSuffix(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldHasSuffix(FieldWorkInAt, v))
+}
+
+// WorkInAtIsNil applies the IsNil predicate on the "work_in_at" field.
+func WorkInAtIsNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIsNull(FieldWorkInAt))
+}
+
+// WorkInAtNotNil applies the NotNil predicate on the "work_in_at" field.
+func WorkInAtNotNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotNull(FieldWorkInAt))
+}
+
+// WorkInAtEqualFold applies the EqualFold predicate on the "work_in_at" field.
+func WorkInAtEqualFold(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEqualFold(FieldWorkInAt, v))
+}
+
+// WorkInAtContainsFold applies the ContainsFold predicate on the "work_in_at" field.
+func WorkInAtContainsFold(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldContainsFold(FieldWorkInAt, v))
+}
+
+// BreakOutAtEQ applies the EQ predicate on the "break_out_at" field.
+func BreakOutAtEQ(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldBreakOutAt, v))
+}
+
+// BreakOutAtNEQ applies the NEQ predicate on the "break_out_at" field.
+func BreakOutAtNEQ(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNEQ(FieldBreakOutAt, v))
+}
+
+// BreakOutAtIn applies the In predicate on the "break_out_at" field.
+func BreakOutAtIn(vs ...string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIn(FieldBreakOutAt, vs...))
+}
+
+// BreakOutAtNotIn applies the NotIn predicate on the "break_out_at" field.
+func BreakOutAtNotIn(vs ...string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotIn(FieldBreakOutAt, vs...))
+}
+
+// BreakOutAtGT applies the GT predicate on the "break_out_at" field.
+func BreakOutAtGT(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGT(FieldBreakOutAt, v))
+}
+
+// BreakOutAtGTE applies the GTE predicate on the "break_out_at" field.
+func BreakOutAtGTE(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGTE(FieldBreakOutAt, v))
+}
+
+// BreakOutAtLT applies the LT predicate on the "break_out_at" field.
+func BreakOutAtLT(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLT(FieldBreakOutAt, v))
+}
+
+// BreakOutAtLTE applies the LTE predicate on the "break_out_at" field.
+func BreakOutAtLTE(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLTE(FieldBreakOutAt, v))
+}
+
+// BreakOutAtContains applies the Contains predicate on the "break_out_at" field.
+func BreakOutAtContains(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldContains(FieldBreakOutAt, v))
+}
+
+// BreakOutAtHasPrefix applies the HasPrefix predicate on the "break_out_at" field.
+func BreakOutAtHasPrefix(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldHasPrefix(FieldBreakOutAt, v))
+}
+
+// BreakOutAtHasSuffix applies the HasSuffix predicate on the "break_out_at" field.
+func BreakOutAtHasSuffix(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldHasSuffix(FieldBreakOutAt, v))
+}
+
+// BreakOutAtIsNil applies the IsNil predicate on the "break_out_at" field.
+func BreakOutAtIsNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIsNull(FieldBreakOutAt))
+}
+
+// BreakOutAtNotNil applies the NotNil predicate on the "break_out_at" field.
+func BreakOutAtNotNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotNull(FieldBreakOutAt))
+}
+
+// BreakOutAtEqualFold applies the EqualFold predicate on the "break_out_at" field.
+func BreakOutAtEqualFold(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEqualFold(FieldBreakOutAt, v))
+}
+
+// BreakOutAtContainsFold applies the ContainsFold predicate on the "break_out_at" field.
+func BreakOutAtContainsFold(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldContainsFold(FieldBreakOutAt, v))
+}
+
+// BreakInAtEQ applies the EQ predicate on the "break_in_at" field.
+func BreakInAtEQ(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldBreakInAt, v))
+}
+
+// BreakInAtNEQ applies the NEQ predicate on the "break_in_at" field.
+func BreakInAtNEQ(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNEQ(FieldBreakInAt, v))
+}
+
+// BreakInAtIn applies the In predicate on the "break_in_at" field.
+func BreakInAtIn(vs ...string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIn(FieldBreakInAt, vs...))
+}
+
+// BreakInAtNotIn applies the NotIn predicate on the "break_in_at" field.
+func BreakInAtNotIn(vs ...string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotIn(FieldBreakInAt, vs...))
+}
+
+// BreakInAtGT applies the GT predicate on the "break_in_at" field.
+func BreakInAtGT(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGT(FieldBreakInAt, v))
+}
+
+// BreakInAtGTE applies the GTE predicate on the "break_in_at" field.
+func BreakInAtGTE(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGTE(FieldBreakInAt, v))
+}
+
+// BreakInAtLT applies the LT predicate on the "break_in_at" field.
+func BreakInAtLT(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLT(FieldBreakInAt, v))
+}
+
+// BreakInAtLTE applies the LTE predicate on the "break_in_at" field.
+func BreakInAtLTE(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLTE(FieldBreakInAt, v))
+}
+
+// BreakInAtContains applies the Contains predicate on the "break_in_at" field.
+func BreakInAtContains(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldContains(FieldBreakInAt, v))
+}
+
+// BreakInAtHasPrefix applies the HasPrefix predicate on the "break_in_at" field.
+func BreakInAtHasPrefix(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldHasPrefix(FieldBreakInAt, v))
+}
+
+// BreakInAtHasSuffix applies the HasSuffix predicate on the "break_in_at" field.
+func BreakInAtHasSuffix(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldHasSuffix(FieldBreakInAt, v))
+}
+
+// BreakInAtIsNil applies the IsNil predicate on the "break_in_at" field.
+func BreakInAtIsNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIsNull(FieldBreakInAt))
+}
+
+// BreakInAtNotNil applies the NotNil predicate on the "break_in_at" field.
+func BreakInAtNotNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotNull(FieldBreakInAt))
+}
+
+// BreakInAtEqualFold applies the EqualFold predicate on the "break_in_at" field.
+func BreakInAtEqualFold(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEqualFold(FieldBreakInAt, v))
+}
+
+// BreakInAtContainsFold applies the ContainsFold predicate on the "break_in_at" field.
+func BreakInAtContainsFold(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldContainsFold(FieldBreakInAt, v))
+}
+
+// WorkOutAtEQ applies the EQ predicate on the "work_out_at" field.
+func WorkOutAtEQ(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldWorkOutAt, v))
+}
+
+// WorkOutAtNEQ applies the NEQ predicate on the "work_out_at" field.
+func WorkOutAtNEQ(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNEQ(FieldWorkOutAt, v))
+}
+
+// WorkOutAtIn applies the In predicate on the "work_out_at" field.
+func WorkOutAtIn(vs ...string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIn(FieldWorkOutAt, vs...))
+}
+
+// WorkOutAtNotIn applies the NotIn predicate on the "work_out_at" field.
+func WorkOutAtNotIn(vs ...string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotIn(FieldWorkOutAt, vs...))
+}
+
+// WorkOutAtGT applies the GT predicate on the "work_out_at" field.
+func WorkOutAtGT(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGT(FieldWorkOutAt, v))
+}
+
+// WorkOutAtGTE applies the GTE predicate on the "work_out_at" field.
+func WorkOutAtGTE(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGTE(FieldWorkOutAt, v))
+}
+
+// WorkOutAtLT applies the LT predicate on the "work_out_at" field.
+func WorkOutAtLT(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLT(FieldWorkOutAt, v))
+}
+
+// WorkOutAtLTE applies the LTE predicate on the "work_out_at" field.
+func WorkOutAtLTE(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLTE(FieldWorkOutAt, v))
+}
+
+// WorkOutAtContains applies the Contains predicate on the "work_out_at" field.
+func WorkOutAtContains(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldContains(FieldWorkOutAt, v))
+}
+
+// WorkOutAtHasPrefix applies the HasPrefix predicate on the "work_out_at" field.
+func WorkOutAtHasPrefix(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldHasPrefix(FieldWorkOutAt, v))
+}
+
+// WorkOutAtHasSuffix applies the HasSuffix predicate on the "work_out_at" field.
+func WorkOutAtHasSuffix(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldHasSuffix(FieldWorkOutAt, v))
+}
+
+// WorkOutAtIsNil applies the IsNil predicate on the "work_out_at" field.
+func WorkOutAtIsNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIsNull(FieldWorkOutAt))
+}
+
+// WorkOutAtNotNil applies the NotNil predicate on the "work_out_at" field.
+func WorkOutAtNotNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotNull(FieldWorkOutAt))
+}
+
+// WorkOutAtEqualFold applies the EqualFold predicate on the "work_out_at" field.
+func WorkOutAtEqualFold(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEqualFold(FieldWorkOutAt, v))
+}
+
+// WorkOutAtContainsFold applies the ContainsFold predicate on the "work_out_at" field.
+func WorkOutAtContainsFold(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldContainsFold(FieldWorkOutAt, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldContainsFold(FieldReason, v))
+}
+
+// AttachmentEQ applies the EQ predicate on the "attachment" field.
+func AttachmentEQ(v []byte) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldAttachment, v))
+}
+
+// AttachmentNEQ applies the NEQ predicate on the "attachment" field.
+func AttachmentNEQ(v []byte) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNEQ(FieldAttachment, v))
+}
+
+// AttachmentIn applies the In predicate on the "attachment" field.
+func AttachmentIn(vs ...[]byte) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIn(FieldAttachment, vs...))
+}
+
+// AttachmentNotIn applies the NotIn predicate on the "attachment" field.
+func AttachmentNotIn(vs ...[]byte) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotIn(FieldAttachment, vs...))
+}
+
+// AttachmentGT applies the GT predicate on the "attachment" field.
+func AttachmentGT(v []byte) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGT(FieldAttachment, v))
+}
+
+// AttachmentGTE applies the GTE predicate on the "attachment" field.
+func AttachmentGTE(v []byte) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGTE(FieldAttachment, v))
+}
+
+// AttachmentLT applies the LT predicate on the "attachment" field.
+func AttachmentLT(v []byte) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLT(FieldAttachment, v))
+}
+
+// AttachmentLTE applies the LTE predicate on the "attachment" field.
+func AttachmentLTE(v []byte) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLTE(FieldAttachment, v))
+}
+
+// AttachmentIsNil applies the IsNil predicate on the "attachment" field.
+func AttachmentIsNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIsNull(FieldAttachment))
+}
+
+// AttachmentNotNil applies the NotNil predicate on the "attachment" field.
+func AttachmentNotNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotNull(FieldAttachment))
+}
+
+// AttachmentNameEQ applies the EQ predicate on the "attachment_name" field.
+func AttachmentNameEQ(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldAttachmentName, v))
+}
+
+// AttachmentNameNEQ applies the NEQ predicate on the "attachment_name" field.
+func AttachmentNameNEQ(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNEQ(FieldAttachmentName, v))
+}
+
+// AttachmentNameIn applies the In predicate on the "attachment_name" field.
+func AttachmentNameIn(vs ...string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIn(FieldAttachmentName, vs...))
+}
+
+// AttachmentNameNotIn applies the NotIn predicate on the "attachment_name" field.
+func AttachmentNameNotIn(vs ...string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotIn(FieldAttachmentName, vs...))
+}
+
+// AttachmentNameGT applies the GT predicate on the "attachment_name" field.
+func AttachmentNameGT(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGT(FieldAttachmentName, v))
+}
+
+// AttachmentNameGTE applies the GTE predicate on the "attachment_name" field.
+func AttachmentNameGTE(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGTE(FieldAttachmentName, v))
+}
+
+// AttachmentNameLT applies the LT predicate on the "attachment_name" field.
+func AttachmentNameLT(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLT(FieldAttachmentName, v))
+}
+
+// AttachmentNameLTE applies the LTE predicate on the "attachment_name" field.
+func AttachmentNameLTE(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLTE(FieldAttachmentName, v))
+}
+
+// AttachmentNameContains applies the Contains predicate on the "attachment_name" field.
+func AttachmentNameContains(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldContains(FieldAttachmentName, v))
+}
+
+// AttachmentNameHasPrefix applies the HasPrefix predicate on the "attachment_name" field.
+func AttachmentNameHasPrefix(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldHasPrefix(FieldAttachmentName, v))
+}
+
+// AttachmentNameHasSuffix applies the HasSuffix predicate on the "attachment_name" field.
+func AttachmentNameHasSuffix(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldHasSuffix(FieldAttachmentName, v))
+}
+
+// AttachmentNameIsNil applies the IsNil predicate on the "attachment_name" field.
+func AttachmentNameIsNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIsNull(FieldAttachmentName))
+}
+
+// AttachmentNameNotNil applies the NotNil predicate on the "attachment_name" field.
+func AttachmentNameNotNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotNull(FieldAttachmentName))
+}
+
+// AttachmentNameEqualFold applies the EqualFold predicate on the "attachment_name" field.
+func AttachmentNameEqualFold(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEqualFold(FieldAttachmentName, v))
+}
+
+// AttachmentNameContainsFold applies the ContainsFold predicate on the "attachment_name" field.
+func AttachmentNameContainsFold(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldContainsFold(FieldAttachmentName, v))
+}
+
+// AttachmentContentTypeEQ applies the EQ predicate on the "attachment_content_type" field.
+func AttachmentContentTypeEQ(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeNEQ applies the NEQ predicate on the "attachment_content_type" field.
+func AttachmentContentTypeNEQ(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNEQ(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeIn applies the In predicate on the "attachment_content_type" field.
+func AttachmentContentTypeIn(vs ...string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIn(FieldAttachmentContentType, vs...))
+}
+
+// AttachmentContentTypeNotIn applies the NotIn predicate on the "attachment_content_type" field.
+func AttachmentContentTypeNotIn(vs ...string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotIn(FieldAttachmentContentType, vs...))
+}
+
+// AttachmentContentTypeGT applies the GT predicate on the "attachment_content_type" field.
+func AttachmentContentTypeGT(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGT(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeGTE applies the GTE predicate on the "attachment_content_type" field.
+func AttachmentContentTypeGTE(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGTE(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeLT applies the LT predicate on the "attachment_content_type" field.
+func AttachmentContentTypeLT(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLT(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeLTE applies the LTE predicate on the "attachment_content_type" field.
+func AttachmentContentTypeLTE(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLTE(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeContains applies the Contains predicate on the "attachment_content_type" field.
+func AttachmentContentTypeContains(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldContains(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeHasPrefix applies the HasPrefix predicate on the "attachment_content_type" field.
+func AttachmentContentTypeHasPrefix(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldHasPrefix(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeHasSuffix applies the HasSuffix predicate on the "attachment_content_type" field.
+func AttachmentContentTypeHasSuffix(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldHasSuffix(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeIsNil applies the IsNil predicate on the "attachment_content_type" field.
+func AttachmentContentTypeIsNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIsNull(FieldAttachmentContentType))
+}
+
+// AttachmentContentTypeNotNil applies the NotNil predicate on the "attachment_content_type" field.
+func AttachmentContentTypeNotNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotNull(FieldAttachmentContentType))
+}
+
+// AttachmentContentTypeEqualFold applies the EqualFold predicate on the "attachment_content_type" field.
+func AttachmentContentTypeEqualFold(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEqualFold(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeContainsFold applies the ContainsFold predicate on the "attachment_content_type" field.
+func AttachmentContentTypeContainsFold(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldContainsFold(FieldAttachmentContentType, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ReviewedByIDEQ applies the EQ predicate on the "reviewed_by_id" field.
+func ReviewedByIDEQ(v int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldReviewedByID, v))
+}
+
+// ReviewedByIDNEQ applies the NEQ predicate on the "reviewed_by_id" field.
+func ReviewedByIDNEQ(v int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNEQ(FieldReviewedByID, v))
+}
+
+// ReviewedByIDIn applies the In predicate on the "reviewed_by_id" field.
+func ReviewedByIDIn(vs ...int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIn(FieldReviewedByID, vs...))
+}
+
+// ReviewedByIDNotIn applies the NotIn predicate on the "reviewed_by_id" field.
+func ReviewedByIDNotIn(vs ...int) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotIn(FieldReviewedByID, vs...))
+}
+
+// ReviewedByIDIsNil applies the IsNil predicate on the "reviewed_by_id" field.
+func ReviewedByIDIsNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIsNull(FieldReviewedByID))
+}
+
+// ReviewedByIDNotNil applies the NotNil predicate on the "reviewed_by_id" field.
+func ReviewedByIDNotNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotNull(FieldReviewedByID))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// ReviewNotesEQ applies the EQ predicate on the "review_notes" field.
+func ReviewNotesEQ(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldReviewNotes, v))
+}
+
+// ReviewNotesNEQ applies the NEQ predicate on the "review_notes" field.
+func ReviewNotesNEQ(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNEQ(FieldReviewNotes, v))
+}
+
+// ReviewNotesIn applies the In predicate on the "review_notes" field.
+func ReviewNotesIn(vs ...string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIn(FieldReviewNotes, vs...))
+}
+
+// ReviewNotesNotIn applies the NotIn predicate on the "review_notes" field.
+func ReviewNotesNotIn(vs ...string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotIn(FieldReviewNotes, vs...))
+}
+
+// ReviewNotesGT applies the GT predicate on the "review_notes" field.
+func ReviewNotesGT(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGT(FieldReviewNotes, v))
+}
+
+// ReviewNotesGTE applies the GTE predicate on the "review_notes" field.
+func ReviewNotesGTE(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGTE(FieldReviewNotes, v))
+}
+
+// ReviewNotesLT applies the LT predicate on the "review_notes" field.
+func ReviewNotesLT(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLT(FieldReviewNotes, v))
+}
+
+// ReviewNotesLTE applies the LTE predicate on the "review_notes" field.
+func ReviewNotesLTE(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLTE(FieldReviewNotes, v))
+}
+
+// ReviewNotesContains applies the Contains predicate on the "review_notes" field.
+func ReviewNotesContains(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldContains(FieldReviewNotes, v))
+}
+
+// ReviewNotesHasPrefix applies the HasPrefix predicate on the "review_notes" field.
+func ReviewNotesHasPrefix(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldHasPrefix(FieldReviewNotes, v))
+}
+
+// ReviewNotesHasSuffix applies the HasSuffix predicate on the "review_notes" field.
+func ReviewNotesHasSuffix(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldHasSuffix(FieldReviewNotes, v))
+}
+
+// ReviewNotesIsNil applies the IsNil predicate on the "review_notes" field.
+func ReviewNotesIsNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIsNull(FieldReviewNotes))
+}
+
+// ReviewNotesNotNil applies the NotNil predicate on the "review_notes" field.
+func ReviewNotesNotNil() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotNull(FieldReviewNotes))
+}
+
+// ReviewNotesEqualFold applies the EqualFold predicate on the "review_notes" field.
+func ReviewNotesEqualFold(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEqualFold(FieldReviewNotes, v))
+}
+
+// ReviewNotesContainsFold applies the ContainsFold predicate on the "review_notes" field.
+func ReviewNotesContainsFold(v string) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldContainsFold(FieldReviewNotes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttendanceDay applies the HasEdge predicate on the "attendance_day" edge.
+func HasAttendanceDay() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AttendanceDayTable, AttendanceDayColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttendanceDayWith applies the HasEdge predicate on the "attendance_day" edge with a given conditions (other predicates).
+func HasAttendanceDayWith(preds ...predicate.AttendanceDay) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(func(s *sql.Selector) {
+		step := newAttendanceDayStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReviewedBy applies the HasEdge predicate on the "reviewed_by" edge.
+func HasReviewedBy() predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ReviewedByTable, ReviewedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReviewedByWith applies the HasEdge predicate on the "reviewed_by" edge with a given conditions (other predicates).
+func HasReviewedByWith(preds ...predicate.User) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(func(s *sql.Selector) {
+		step := newReviewedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MarkCorrectionRequest) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MarkCorrectionRequest) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MarkCorrectionRequest) predicate.MarkCorrectionRequest {
+	return predicate.MarkCorrectionRequest(sql.NotPredicates(p))
+}
@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/attendanceday"
+	"back/internal/ent/markcorrectionrequest"
+	"back/internal/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MarkCorrectionRequestCreate is the builder for creating a MarkCorrectionRequest entity.
+type MarkCorrectionRequestCreate struct {
+	config
+	mutation *MarkCorrectionRequestMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *MarkCorrectionRequestCreate) SetUserID(v int) *MarkCorrectionRequestCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetAttendanceDayID sets the "attendance_day_id" field.
+func (_c *MarkCorrectionRequestCreate) SetAttendanceDayID(v int) *MarkCorrectionRequestCreate {
+	_c.mutation.SetAttendanceDayID(v)
+	return _c
+}
+
+// SetWorkDate sets the "work_date" field.
+func (_c *MarkCorrectionRequestCreate) SetWorkDate(v time.Time) *MarkCorrectionRequestCreate {
+	_c.mutation.SetWorkDate(v)
+	return _c
+}
+
+// SetWorkInAt sets the "work_in_at" field.
+func (_c *MarkCorrectionRequestCreate) SetWorkInAt(v string) *MarkCorrectionRequestCreate {
+	_c.mutation.SetWorkInAt(v)
+	return _c
+}
+
+// SetNillableWorkInAt sets the "work_in_at" field if the given value is not nil.
+func (_c *MarkCorrectionRequestCreate) SetNillableWorkInAt(v *string) *MarkCorrectionRequestCreate {
+	if v != nil {
+		_c.SetWorkInAt(*v)
+	}
+	return _c
+}
+
+// SetBreakOutAt sets the "break_out_at" field.
+func (_c *MarkCorrectionRequestCreate) SetBreakOutAt(v string) *MarkCorrectionRequestCreate {
+	_c.mutation.SetBreakOutAt(v)
+	return _c
+}
+
+// SetNillableBreakOutAt sets the "break_out_at" field if the given value is not nil.
+func (_c *MarkCorrectionRequestCreate) SetNillableBreakOutAt(v *string) *MarkCorrectionRequestCreate {
+	if v != nil {
+		_c.SetBreakOutAt(*v)
+	}
+	return _c
+}
+
+// SetBreakInAt sets the "break_in_at" field.
+func (_c *MarkCorrectionRequestCreate) SetBreakInAt(v string) *MarkCorrectionRequestCreate {
+	_c.mutation.SetBreakInAt(v)
+	return _c
+}
+
+// SetNillableBreakInAt sets the "break_in_at" field if the given value is not nil.
+func (_c *MarkCorrectionRequestCreate) SetNillableBreakInAt(v *string) *MarkCorrectionRequestCreate {
+	if v != nil {
+		_c.SetBreakInAt(*v)
+	}
+	return _c
+}
+
+// SetWorkOutAt sets the "work_out_at" field.
+func (_c *MarkCorrectionRequestCreate) SetWorkOutAt(v string) *MarkCorrectionRequestCreate {
+	_c.mutation.SetWorkOutAt(v)
+	return _c
+}
+
+// SetNillableWorkOutAt sets the "work_out_at" field if the given value is not nil.
+func (_c *MarkCorrectionRequestCreate) SetNillableWorkOutAt(v *string) *MarkCorrectionRequestCreate {
+	if v != nil {
+		_c.SetWorkOutAt(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *MarkCorrectionRequestCreate) SetReason(v string) *MarkCorrectionRequestCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetAttachment sets the "attachment" field.
+func (_c *MarkCorrectionRequestCreate) SetAttachment(v []byte) *MarkCorrectionRequestCreate {
+	_c.mutation.SetAttachment(v)
+	return _c
+}
+
+// SetAttachmentName sets the "attachment_name" field.
+func (_c *MarkCorrectionRequestCreate) SetAttachmentName(v string) *MarkCorrectionRequestCreate {
+	_c.mutation.SetAttachmentName(v)
+	return _c
+}
+
+// SetNillableAttachmentName sets the "attachment_name" field if the given value is not nil.
+func (_c *MarkCorrectionRequestCreate) SetNillableAttachmentName(v *string) *MarkCorrectionRequestCreate {
+	if v != nil {
+		_c.SetAttachmentName(*v)
+	}
+	return _c
+}
+
+// SetAttachmentContentType sets the "attachment_content_type" field.
+func (_c *MarkCorrectionRequestCreate) SetAttachmentContentType(v string) *MarkCorrectionRequestCreate {
+	_c.mutation.SetAttachmentContentType(v)
+	return _c
+}
+
+// SetNillableAttachmentContentType sets the "attachment_content_type" field if the given value is not nil.
+func (_c *MarkCorrectionRequestCreate) SetNillableAttachmentContentType(v *string) *MarkCorrectionRequestCreate {
+	if v != nil {
+		_c.SetAttachmentContentType(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *MarkCorrectionRequestCreate) SetStatus(v string) *MarkCorrectionRequestCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *MarkCorrectionRequestCreate) SetNillableStatus(v *string) *MarkCorrectionRequestCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetReviewedByID sets the "reviewed_by_id" field.
+func (_c *MarkCorrectionRequestCreate) SetReviewedByID(v int) *MarkCorrectionRequestCreate {
+	_c.mutation.SetReviewedByID(v)
+	return _c
+}
+
+// SetNillableReviewedByID sets the "reviewed_by_id" field if the given value is not nil.
+func (_c *MarkCorrectionRequestCreate) SetNillableReviewedByID(v *int) *MarkCorrectionRequestCreate {
+	if v != nil {
+		_c.SetReviewedByID(*v)
+	}
+	return _c
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_c *MarkCorrectionRequestCreate) SetReviewedAt(v time.Time) *MarkCorrectionRequestCreate {
+	_c.mutation.SetReviewedAt(v)
+	return _c
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_c *MarkCorrectionRequestCreate) SetNillableReviewedAt(v *time.Time) *MarkCorrectionRequestCreate {
+	if v != nil {
+		_c.SetReviewedAt(*v)
+	}
+	return _c
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (_c *MarkCorrectionRequestCreate) SetReviewNotes(v string) *MarkCorrectionRequestCreate {
+	_c.mutation.SetReviewNotes(v)
+	return _c
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (_c *MarkCorrectionRequestCreate) SetNillableReviewNotes(v *string) *MarkCorrectionRequestCreate {
+	if v != nil {
+		_c.SetReviewNotes(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MarkCorrectionRequestCreate) SetCreatedAt(v time.Time) *MarkCorrectionRequestCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MarkCorrectionRequestCreate) SetNillableCreatedAt(v *time.Time) *MarkCorrectionRequestCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *MarkCorrectionRequestCreate) SetUpdatedAt(v time.Time) *MarkCorrectionRequestCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *MarkCorrectionRequestCreate) SetNillableUpdatedAt(v *time.Time) *MarkCorrectionRequestCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *MarkCorrectionRequestCreate) SetUser(v *User) *MarkCorrectionRequestCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetAttendanceDay sets the "attendance_day" edge to the AttendanceDay entity.
+func (_c *MarkCorrectionRequestCreate) SetAttendanceDay(v *AttendanceDay) *MarkCorrectionRequestCreate {
+	return _c.SetAttendanceDayID(v.ID)
+}
+
+// SetReviewedBy sets the "reviewed_by" edge to the User entity.
+func (_c *MarkCorrectionRequestCreate) SetReviewedBy(v *User) *MarkCorrectionRequestCreate {
+	return _c.SetReviewedByID(v.ID)
+}
+
+// Mutation returns the MarkCorrectionRequestMutation object of the builder.
+func (_c *MarkCorrectionRequestCreate) Mutation() *MarkCorrectionRequestMutation {
+	return _c.mutation
+}
+
+// Save creates the MarkCorrectionRequest in the database.
+func (_c *MarkCorrectionRequestCreate) Save(ctx context.Context) (*MarkCorrectionRequest, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MarkCorrectionRequestCreate) SaveX(ctx context.Context) *MarkCorrectionRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MarkCorrectionRequestCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MarkCorrectionRequestCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MarkCorrectionRequestCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := markcorrectionrequest.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := markcorrectionrequest.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := markcorrectionrequest.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MarkCorrectionRequestCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "MarkCorrectionRequest.user_id"`)}
+	}
+	if _, ok := _c.mutation.AttendanceDayID(); !ok {
+		return &ValidationError{Name: "attendance_day_id", err: errors.New(`ent: missing required field "MarkCorrectionRequest.attendance_day_id"`)}
+	}
+	if _, ok := _c.mutation.WorkDate(); !ok {
+		return &ValidationError{Name: "work_date", err: errors.New(`ent: missing required field "MarkCorrectionRequest.work_date"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "MarkCorrectionRequest.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := markcorrectionrequest.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "MarkCorrectionRequest.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "MarkCorrectionRequest.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := markcorrectionrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MarkCorrectionRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MarkCorrectionRequest.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MarkCorrectionRequest.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "MarkCorrectionRequest.user"`)}
+	}
+	if len(_c.mutation.AttendanceDayIDs()) == 0 {
+		return &ValidationError{Name: "attendance_day", err: errors.New(`ent: missing required edge "MarkCorrectionRequest.attendance_day"`)}
+	}
+	return nil
+}
+
+func (_c *MarkCorrectionRequestCreate) sqlSave(ctx context.Context) (*MarkCorrectionRequest, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MarkCorrectionRequestCreate) createSpec() (*MarkCorrectionRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MarkCorrectionRequest{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(markcorrectionrequest.Table, sqlgraph.NewFieldSpec(markcorrectionrequest.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.WorkDate(); ok {
+		_spec.SetField(markcorrectionrequest.FieldWorkDate, field.TypeTime, value)
+		_node.WorkDate = value
+	}
+	if value, ok := _c.mutation.WorkInAt(); ok {
+		_spec.SetField(markcorrectionrequest.FieldWorkInAt, field.TypeString, value)
+		_node.WorkInAt = &value
+	}
+	if value, ok := _c.mutation.BreakOutAt(); ok {
+		_spec.SetField(markcorrectionrequest.FieldBreakOutAt, field.TypeString, value)
+		_node.BreakOutAt = &value
+	}
+	if value, ok := _c.mutation.BreakInAt(); ok {
+		_spec.SetField(markcorrectionrequest.FieldBreakInAt, field.TypeString, value)
+		_node.BreakInAt = &value
+	}
+	if value, ok := _c.mutation.WorkOutAt(); ok {
+		_spec.SetField(markcorrectionrequest.FieldWorkOutAt, field.TypeString, value)
+		_node.WorkOutAt = &value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(markcorrectionrequest.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Attachment(); ok {
+		_spec.SetField(markcorrectionrequest.FieldAttachment, field.TypeBytes, value)
+		_node.Attachment = &value
+	}
+	if value, ok := _c.mutation.AttachmentName(); ok {
+		_spec.SetField(markcorrectionrequest.FieldAttachmentName, field.TypeString, value)
+		_node.AttachmentName = &value
+	}
+	if value, ok := _c.mutation.AttachmentContentType(); ok {
+		_spec.SetField(markcorrectionrequest.FieldAttachmentContentType, field.TypeString, value)
+		_node.AttachmentContentType = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(markcorrectionrequest.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ReviewedAt(); ok {
+		_spec.SetField(markcorrectionrequest.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := _c.mutation.ReviewNotes(); ok {
+		_spec.SetField(markcorrectionrequest.FieldReviewNotes, field.TypeString, value)
+		_node.ReviewNotes = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(markcorrectionrequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(markcorrectionrequest.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   markcorrectionrequest.UserTable,
+			Columns: []string{markcorrectionrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AttendanceDayIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   markcorrectionrequest.AttendanceDayTable,
+			Columns: []string{markcorrectionrequest.AttendanceDayColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AttendanceDayID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReviewedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   markcorrectionrequest.ReviewedByTable,
+			Columns: []string{markcorrectionrequest.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReviewedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MarkCorrectionRequestCreateBulk is the builder for creating many MarkCorrectionRequest entities in bulk.
+type MarkCorrectionRequestCreateBulk struct {
+	config
+	err      error
+	builders []*MarkCorrectionRequestCreate
+}
+
+// Save creates the MarkCorrectionRequest entities in the database.
+func (_c *MarkCorrectionRequestCreateBulk) Save(ctx context.Context) ([]*MarkCorrectionRequest, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MarkCorrectionRequest, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MarkCorrectionRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MarkCorrectionRequestCreateBulk) SaveX(ctx context.Context) []*MarkCorrectionRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MarkCorrectionRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MarkCorrectionRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/markcorrectionrequest"
+	"back/internal/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MarkCorrectionRequestDelete is the builder for deleting a MarkCorrectionRequest entity.
+type MarkCorrectionRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *MarkCorrectionRequestMutation
+}
+
+// Where appends a list predicates to the MarkCorrectionRequestDelete builder.
+func (_d *MarkCorrectionRequestDelete) Where(ps ...predicate.MarkCorrectionRequest) *MarkCorrectionRequestDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *MarkCorrectionRequestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MarkCorrectionRequestDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *MarkCorrectionRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(markcorrectionrequest.Table, sqlgraph.NewFieldSpec(markcorrectionrequest.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// MarkCorrectionRequestDeleteOne is the builder for deleting a single MarkCorrectionRequest entity.
+type MarkCorrectionRequestDeleteOne struct {
+	_d *MarkCorrectionRequestDelete
+}
+
+// Where appends a list predicates to the MarkCorrectionRequestDelete builder.
+func (_d *MarkCorrectionRequestDeleteOne) Where(ps ...predicate.MarkCorrectionRequest) *MarkCorrectionRequestDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *MarkCorrectionRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{markcorrectionrequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MarkCorrectionRequestDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
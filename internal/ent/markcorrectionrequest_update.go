@@ -0,0 +1,1152 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/attendanceday"
+	"back/internal/ent/markcorrectionrequest"
+	"back/internal/ent/predicate"
+	"back/internal/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MarkCorrectionRequestUpdate is the builder for updating MarkCorrectionRequest entities.
+type MarkCorrectionRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MarkCorrectionRequestMutation
+}
+
+// Where appends a list predicates to the MarkCorrectionRequestUpdate builder.
+func (_u *MarkCorrectionRequestUpdate) Where(ps ...predicate.MarkCorrectionRequest) *MarkCorrectionRequestUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *MarkCorrectionRequestUpdate) SetUserID(v int) *MarkCorrectionRequestUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdate) SetNillableUserID(v *int) *MarkCorrectionRequestUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetAttendanceDayID sets the "attendance_day_id" field.
+func (_u *MarkCorrectionRequestUpdate) SetAttendanceDayID(v int) *MarkCorrectionRequestUpdate {
+	_u.mutation.SetAttendanceDayID(v)
+	return _u
+}
+
+// SetNillableAttendanceDayID sets the "attendance_day_id" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdate) SetNillableAttendanceDayID(v *int) *MarkCorrectionRequestUpdate {
+	if v != nil {
+		_u.SetAttendanceDayID(*v)
+	}
+	return _u
+}
+
+// SetWorkDate sets the "work_date" field.
+func (_u *MarkCorrectionRequestUpdate) SetWorkDate(v time.Time) *MarkCorrectionRequestUpdate {
+	_u.mutation.SetWorkDate(v)
+	return _u
+}
+
+// SetNillableWorkDate sets the "work_date" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdate) SetNillableWorkDate(v *time.Time) *MarkCorrectionRequestUpdate {
+	if v != nil {
+		_u.SetWorkDate(*v)
+	}
+	return _u
+}
+
+// SetWorkInAt sets the "work_in_at" field.
+func (_u *MarkCorrectionRequestUpdate) SetWorkInAt(v string) *MarkCorrectionRequestUpdate {
+	_u.mutation.SetWorkInAt(v)
+	return _u
+}
+
+// SetNillableWorkInAt sets the "work_in_at" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdate) SetNillableWorkInAt(v *string) *MarkCorrectionRequestUpdate {
+	if v != nil {
+		_u.SetWorkInAt(*v)
+	}
+	return _u
+}
+
+// ClearWorkInAt clears the value of the "work_in_at" field.
+func (_u *MarkCorrectionRequestUpdate) ClearWorkInAt() *MarkCorrectionRequestUpdate {
+	_u.mutation.ClearWorkInAt()
+	return _u
+}
+
+// SetBreakOutAt sets the "break_out_at" field.
+func (_u *MarkCorrectionRequestUpdate) SetBreakOutAt(v string) *MarkCorrectionRequestUpdate {
+	_u.mutation.SetBreakOutAt(v)
+	return _u
+}
+
+// SetNillableBreakOutAt sets the "break_out_at" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdate) SetNillableBreakOutAt(v *string) *MarkCorrectionRequestUpdate {
+	if v != nil {
+		_u.SetBreakOutAt(*v)
+	}
+	return _u
+}
+
+// ClearBreakOutAt clears the value of the "break_out_at" field.
+func (_u *MarkCorrectionRequestUpdate) ClearBreakOutAt() *MarkCorrectionRequestUpdate {
+	_u.mutation.ClearBreakOutAt()
+	return _u
+}
+
+// SetBreakInAt sets the "break_in_at" field.
+func (_u *MarkCorrectionRequestUpdate) SetBreakInAt(v string) *MarkCorrectionRequestUpdate {
+	_u.mutation.SetBreakInAt(v)
+	return _u
+}
+
+// SetNillableBreakInAt sets the "break_in_at" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdate) SetNillableBreakInAt(v *string) *MarkCorrectionRequestUpdate {
+	if v != nil {
+		_u.SetBreakInAt(*v)
+	}
+	return _u
+}
+
+// ClearBreakInAt clears the value of the "break_in_at" field.
+func (_u *MarkCorrectionRequestUpdate) ClearBreakInAt() *MarkCorrectionRequestUpdate {
+	_u.mutation.ClearBreakInAt()
+	return _u
+}
+
+// SetWorkOutAt sets the "work_out_at" field.
+func (_u *MarkCorrectionRequestUpdate) SetWorkOutAt(v string) *MarkCorrectionRequestUpdate {
+	_u.mutation.SetWorkOutAt(v)
+	return _u
+}
+
+// SetNillableWorkOutAt sets the "work_out_at" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdate) SetNillableWorkOutAt(v *string) *MarkCorrectionRequestUpdate {
+	if v != nil {
+		_u.SetWorkOutAt(*v)
+	}
+	return _u
+}
+
+// ClearWorkOutAt clears the value of the "work_out_at" field.
+func (_u *MarkCorrectionRequestUpdate) ClearWorkOutAt() *MarkCorrectionRequestUpdate {
+	_u.mutation.ClearWorkOutAt()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *MarkCorrectionRequestUpdate) SetReason(v string) *MarkCorrectionRequestUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdate) SetNillableReason(v *string) *MarkCorrectionRequestUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetAttachment sets the "attachment" field.
+func (_u *MarkCorrectionRequestUpdate) SetAttachment(v []byte) *MarkCorrectionRequestUpdate {
+	_u.mutation.SetAttachment(v)
+	return _u
+}
+
+// ClearAttachment clears the value of the "attachment" field.
+func (_u *MarkCorrectionRequestUpdate) ClearAttachment() *MarkCorrectionRequestUpdate {
+	_u.mutation.ClearAttachment()
+	return _u
+}
+
+// SetAttachmentName sets the "attachment_name" field.
+func (_u *MarkCorrectionRequestUpdate) SetAttachmentName(v string) *MarkCorrectionRequestUpdate {
+	_u.mutation.SetAttachmentName(v)
+	return _u
+}
+
+// SetNillableAttachmentName sets the "attachment_name" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdate) SetNillableAttachmentName(v *string) *MarkCorrectionRequestUpdate {
+	if v != nil {
+		_u.SetAttachmentName(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentName clears the value of the "attachment_name" field.
+func (_u *MarkCorrectionRequestUpdate) ClearAttachmentName() *MarkCorrectionRequestUpdate {
+	_u.mutation.ClearAttachmentName()
+	return _u
+}
+
+// SetAttachmentContentType sets the "attachment_content_type" field.
+func (_u *MarkCorrectionRequestUpdate) SetAttachmentContentType(v string) *MarkCorrectionRequestUpdate {
+	_u.mutation.SetAttachmentContentType(v)
+	return _u
+}
+
+// SetNillableAttachmentContentType sets the "attachment_content_type" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdate) SetNillableAttachmentContentType(v *string) *MarkCorrectionRequestUpdate {
+	if v != nil {
+		_u.SetAttachmentContentType(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentContentType clears the value of the "attachment_content_type" field.
+func (_u *MarkCorrectionRequestUpdate) ClearAttachmentContentType() *MarkCorrectionRequestUpdate {
+	_u.mutation.ClearAttachmentContentType()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *MarkCorrectionRequestUpdate) SetStatus(v string) *MarkCorrectionRequestUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdate) SetNillableStatus(v *string) *MarkCorrectionRequestUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewedByID sets the "reviewed_by_id" field.
+func (_u *MarkCorrectionRequestUpdate) SetReviewedByID(v int) *MarkCorrectionRequestUpdate {
+	_u.mutation.SetReviewedByID(v)
+	return _u
+}
+
+// SetNillableReviewedByID sets the "reviewed_by_id" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdate) SetNillableReviewedByID(v *int) *MarkCorrectionRequestUpdate {
+	if v != nil {
+		_u.SetReviewedByID(*v)
+	}
+	return _u
+}
+
+// ClearReviewedByID clears the value of the "reviewed_by_id" field.
+func (_u *MarkCorrectionRequestUpdate) ClearReviewedByID() *MarkCorrectionRequestUpdate {
+	_u.mutation.ClearReviewedByID()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *MarkCorrectionRequestUpdate) SetReviewedAt(v time.Time) *MarkCorrectionRequestUpdate {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdate) SetNillableReviewedAt(v *time.Time) *MarkCorrectionRequestUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *MarkCorrectionRequestUpdate) ClearReviewedAt() *MarkCorrectionRequestUpdate {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (_u *MarkCorrectionRequestUpdate) SetReviewNotes(v string) *MarkCorrectionRequestUpdate {
+	_u.mutation.SetReviewNotes(v)
+	return _u
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdate) SetNillableReviewNotes(v *string) *MarkCorrectionRequestUpdate {
+	if v != nil {
+		_u.SetReviewNotes(*v)
+	}
+	return _u
+}
+
+// ClearReviewNotes clears the value of the "review_notes" field.
+func (_u *MarkCorrectionRequestUpdate) ClearReviewNotes() *MarkCorrectionRequestUpdate {
+	_u.mutation.ClearReviewNotes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MarkCorrectionRequestUpdate) SetUpdatedAt(v time.Time) *MarkCorrectionRequestUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *MarkCorrectionRequestUpdate) SetUser(v *User) *MarkCorrectionRequestUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetAttendanceDay sets the "attendance_day" edge to the AttendanceDay entity.
+func (_u *MarkCorrectionRequestUpdate) SetAttendanceDay(v *AttendanceDay) *MarkCorrectionRequestUpdate {
+	return _u.SetAttendanceDayID(v.ID)
+}
+
+// SetReviewedBy sets the "reviewed_by" edge to the User entity.
+func (_u *MarkCorrectionRequestUpdate) SetReviewedBy(v *User) *MarkCorrectionRequestUpdate {
+	return _u.SetReviewedByID(v.ID)
+}
+
+// Mutation returns the MarkCorrectionRequestMutation object of the builder.
+func (_u *MarkCorrectionRequestUpdate) Mutation() *MarkCorrectionRequestMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *MarkCorrectionRequestUpdate) ClearUser() *MarkCorrectionRequestUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearAttendanceDay clears the "attendance_day" edge to the AttendanceDay entity.
+func (_u *MarkCorrectionRequestUpdate) ClearAttendanceDay() *MarkCorrectionRequestUpdate {
+	_u.mutation.ClearAttendanceDay()
+	return _u
+}
+
+// ClearReviewedBy clears the "reviewed_by" edge to the User entity.
+func (_u *MarkCorrectionRequestUpdate) ClearReviewedBy() *MarkCorrectionRequestUpdate {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MarkCorrectionRequestUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MarkCorrectionRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MarkCorrectionRequestUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MarkCorrectionRequestUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MarkCorrectionRequestUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := markcorrectionrequest.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MarkCorrectionRequestUpdate) check() error {
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := markcorrectionrequest.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "MarkCorrectionRequest.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := markcorrectionrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MarkCorrectionRequest.status": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MarkCorrectionRequest.user"`)
+	}
+	if _u.mutation.AttendanceDayCleared() && len(_u.mutation.AttendanceDayIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MarkCorrectionRequest.attendance_day"`)
+	}
+	return nil
+}
+
+func (_u *MarkCorrectionRequestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(markcorrectionrequest.Table, markcorrectionrequest.Columns, sqlgraph.NewFieldSpec(markcorrectionrequest.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkDate(); ok {
+		_spec.SetField(markcorrectionrequest.FieldWorkDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.WorkInAt(); ok {
+		_spec.SetField(markcorrectionrequest.FieldWorkInAt, field.TypeString, value)
+	}
+	if _u.mutation.WorkInAtCleared() {
+		_spec.ClearField(markcorrectionrequest.FieldWorkInAt, field.TypeString)
+	}
+	if value, ok := _u.mutation.BreakOutAt(); ok {
+		_spec.SetField(markcorrectionrequest.FieldBreakOutAt, field.TypeString, value)
+	}
+	if _u.mutation.BreakOutAtCleared() {
+		_spec.ClearField(markcorrectionrequest.FieldBreakOutAt, field.TypeString)
+	}
+	if value, ok := _u.mutation.BreakInAt(); ok {
+		_spec.SetField(markcorrectionrequest.FieldBreakInAt, field.TypeString, value)
+	}
+	if _u.mutation.BreakInAtCleared() {
+		_spec.ClearField(markcorrectionrequest.FieldBreakInAt, field.TypeString)
+	}
+	if value, ok := _u.mutation.WorkOutAt(); ok {
+		_spec.SetField(markcorrectionrequest.FieldWorkOutAt, field.TypeString, value)
+	}
+	if _u.mutation.WorkOutAtCleared() {
+		_spec.ClearField(markcorrectionrequest.FieldWorkOutAt, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(markcorrectionrequest.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attachment(); ok {
+		_spec.SetField(markcorrectionrequest.FieldAttachment, field.TypeBytes, value)
+	}
+	if _u.mutation.AttachmentCleared() {
+		_spec.ClearField(markcorrectionrequest.FieldAttachment, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.AttachmentName(); ok {
+		_spec.SetField(markcorrectionrequest.FieldAttachmentName, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentNameCleared() {
+		_spec.ClearField(markcorrectionrequest.FieldAttachmentName, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentContentType(); ok {
+		_spec.SetField(markcorrectionrequest.FieldAttachmentContentType, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentContentTypeCleared() {
+		_spec.ClearField(markcorrectionrequest.FieldAttachmentContentType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(markcorrectionrequest.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(markcorrectionrequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(markcorrectionrequest.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReviewNotes(); ok {
+		_spec.SetField(markcorrectionrequest.FieldReviewNotes, field.TypeString, value)
+	}
+	if _u.mutation.ReviewNotesCleared() {
+		_spec.ClearField(markcorrectionrequest.FieldReviewNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(markcorrectionrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   markcorrectionrequest.UserTable,
+			Columns: []string{markcorrectionrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   markcorrectionrequest.UserTable,
+			Columns: []string{markcorrectionrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AttendanceDayCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   markcorrectionrequest.AttendanceDayTable,
+			Columns: []string{markcorrectionrequest.AttendanceDayColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceday.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttendanceDayIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   markcorrectionrequest.AttendanceDayTable,
+			Columns: []string{markcorrectionrequest.AttendanceDayColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   markcorrectionrequest.ReviewedByTable,
+			Columns: []string{markcorrectionrequest.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   markcorrectionrequest.ReviewedByTable,
+			Columns: []string{markcorrectionrequest.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{markcorrectionrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MarkCorrectionRequestUpdateOne is the builder for updating a single MarkCorrectionRequest entity.
+type MarkCorrectionRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MarkCorrectionRequestMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *MarkCorrectionRequestUpdateOne) SetUserID(v int) *MarkCorrectionRequestUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdateOne) SetNillableUserID(v *int) *MarkCorrectionRequestUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetAttendanceDayID sets the "attendance_day_id" field.
+func (_u *MarkCorrectionRequestUpdateOne) SetAttendanceDayID(v int) *MarkCorrectionRequestUpdateOne {
+	_u.mutation.SetAttendanceDayID(v)
+	return _u
+}
+
+// SetNillableAttendanceDayID sets the "attendance_day_id" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdateOne) SetNillableAttendanceDayID(v *int) *MarkCorrectionRequestUpdateOne {
+	if v != nil {
+		_u.SetAttendanceDayID(*v)
+	}
+	return _u
+}
+
+// SetWorkDate sets the "work_date" field.
+func (_u *MarkCorrectionRequestUpdateOne) SetWorkDate(v time.Time) *MarkCorrectionRequestUpdateOne {
+	_u.mutation.SetWorkDate(v)
+	return _u
+}
+
+// SetNillableWorkDate sets the "work_date" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdateOne) SetNillableWorkDate(v *time.Time) *MarkCorrectionRequestUpdateOne {
+	if v != nil {
+		_u.SetWorkDate(*v)
+	}
+	return _u
+}
+
+// SetWorkInAt sets the "work_in_at" field.
+func (_u *MarkCorrectionRequestUpdateOne) SetWorkInAt(v string) *MarkCorrectionRequestUpdateOne {
+	_u.mutation.SetWorkInAt(v)
+	return _u
+}
+
+// SetNillableWorkInAt sets the "work_in_at" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdateOne) SetNillableWorkInAt(v *string) *MarkCorrectionRequestUpdateOne {
+	if v != nil {
+		_u.SetWorkInAt(*v)
+	}
+	return _u
+}
+
+// ClearWorkInAt clears the value of the "work_in_at" field.
+func (_u *MarkCorrectionRequestUpdateOne) ClearWorkInAt() *MarkCorrectionRequestUpdateOne {
+	_u.mutation.ClearWorkInAt()
+	return _u
+}
+
+// SetBreakOutAt sets the "break_out_at" field.
+func (_u *MarkCorrectionRequestUpdateOne) SetBreakOutAt(v string) *MarkCorrectionRequestUpdateOne {
+	_u.mutation.SetBreakOutAt(v)
+	return _u
+}
+
+// SetNillableBreakOutAt sets the "break_out_at" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdateOne) SetNillableBreakOutAt(v *string) *MarkCorrectionRequestUpdateOne {
+	if v != nil {
+		_u.SetBreakOutAt(*v)
+	}
+	return _u
+}
+
+// ClearBreakOutAt clears the value of the "break_out_at" field.
+func (_u *MarkCorrectionRequestUpdateOne) ClearBreakOutAt() *MarkCorrectionRequestUpdateOne {
+	_u.mutation.ClearBreakOutAt()
+	return _u
+}
+
+// SetBreakInAt sets the "break_in_at" field.
+func (_u *MarkCorrectionRequestUpdateOne) SetBreakInAt(v string) *MarkCorrectionRequestUpdateOne {
+	_u.mutation.SetBreakInAt(v)
+	return _u
+}
+
+// SetNillableBreakInAt sets the "break_in_at" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdateOne) SetNillableBreakInAt(v *string) *MarkCorrectionRequestUpdateOne {
+	if v != nil {
+		_u.SetBreakInAt(*v)
+	}
+	return _u
+}
+
+// ClearBreakInAt clears the value of the "break_in_at" field.
+func (_u *MarkCorrectionRequestUpdateOne) ClearBreakInAt() *MarkCorrectionRequestUpdateOne {
+	_u.mutation.ClearBreakInAt()
+	return _u
+}
+
+// SetWorkOutAt sets the "work_out_at" field.
+func (_u *MarkCorrectionRequestUpdateOne) SetWorkOutAt(v string) *MarkCorrectionRequestUpdateOne {
+	_u.mutation.SetWorkOutAt(v)
+	return _u
+}
+
+// SetNillableWorkOutAt sets the "work_out_at" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdateOne) SetNillableWorkOutAt(v *string) *MarkCorrectionRequestUpdateOne {
+	if v != nil {
+		_u.SetWorkOutAt(*v)
+	}
+	return _u
+}
+
+// ClearWorkOutAt clears the value of the "work_out_at" field.
+func (_u *MarkCorrectionRequestUpdateOne) ClearWorkOutAt() *MarkCorrectionRequestUpdateOne {
+	_u.mutation.ClearWorkOutAt()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *MarkCorrectionRequestUpdateOne) SetReason(v string) *MarkCorrectionRequestUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdateOne) SetNillableReason(v *string) *MarkCorrectionRequestUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetAttachment sets the "attachment" field.
+func (_u *MarkCorrectionRequestUpdateOne) SetAttachment(v []byte) *MarkCorrectionRequestUpdateOne {
+	_u.mutation.SetAttachment(v)
+	return _u
+}
+
+// ClearAttachment clears the value of the "attachment" field.
+func (_u *MarkCorrectionRequestUpdateOne) ClearAttachment() *MarkCorrectionRequestUpdateOne {
+	_u.mutation.ClearAttachment()
+	return _u
+}
+
+// SetAttachmentName sets the "attachment_name" field.
+func (_u *MarkCorrectionRequestUpdateOne) SetAttachmentName(v string) *MarkCorrectionRequestUpdateOne {
+	_u.mutation.SetAttachmentName(v)
+	return _u
+}
+
+// SetNillableAttachmentName sets the "attachment_name" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdateOne) SetNillableAttachmentName(v *string) *MarkCorrectionRequestUpdateOne {
+	if v != nil {
+		_u.SetAttachmentName(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentName clears the value of the "attachment_name" field.
+func (_u *MarkCorrectionRequestUpdateOne) ClearAttachmentName() *MarkCorrectionRequestUpdateOne {
+	_u.mutation.ClearAttachmentName()
+	return _u
+}
+
+// SetAttachmentContentType sets the "attachment_content_type" field.
+func (_u *MarkCorrectionRequestUpdateOne) SetAttachmentContentType(v string) *MarkCorrectionRequestUpdateOne {
+	_u.mutation.SetAttachmentContentType(v)
+	return _u
+}
+
+// SetNillableAttachmentContentType sets the "attachment_content_type" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdateOne) SetNillableAttachmentContentType(v *string) *MarkCorrectionRequestUpdateOne {
+	if v != nil {
+		_u.SetAttachmentContentType(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentContentType clears the value of the "attachment_content_type" field.
+func (_u *MarkCorrectionRequestUpdateOne) ClearAttachmentContentType() *MarkCorrectionRequestUpdateOne {
+	_u.mutation.ClearAttachmentContentType()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *MarkCorrectionRequestUpdateOne) SetStatus(v string) *MarkCorrectionRequestUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdateOne) SetNillableStatus(v *string) *MarkCorrectionRequestUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewedByID sets the "reviewed_by_id" field.
+func (_u *MarkCorrectionRequestUpdateOne) SetReviewedByID(v int) *MarkCorrectionRequestUpdateOne {
+	_u.mutation.SetReviewedByID(v)
+	return _u
+}
+
+// SetNillableReviewedByID sets the "reviewed_by_id" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdateOne) SetNillableReviewedByID(v *int) *MarkCorrectionRequestUpdateOne {
+	if v != nil {
+		_u.SetReviewedByID(*v)
+	}
+	return _u
+}
+
+// ClearReviewedByID clears the value of the "reviewed_by_id" field.
+func (_u *MarkCorrectionRequestUpdateOne) ClearReviewedByID() *MarkCorrectionRequestUpdateOne {
+	_u.mutation.ClearReviewedByID()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *MarkCorrectionRequestUpdateOne) SetReviewedAt(v time.Time) *MarkCorrectionRequestUpdateOne {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdateOne) SetNillableReviewedAt(v *time.Time) *MarkCorrectionRequestUpdateOne {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *MarkCorrectionRequestUpdateOne) ClearReviewedAt() *MarkCorrectionRequestUpdateOne {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (_u *MarkCorrectionRequestUpdateOne) SetReviewNotes(v string) *MarkCorrectionRequestUpdateOne {
+	_u.mutation.SetReviewNotes(v)
+	return _u
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (_u *MarkCorrectionRequestUpdateOne) SetNillableReviewNotes(v *string) *MarkCorrectionRequestUpdateOne {
+	if v != nil {
+		_u.SetReviewNotes(*v)
+	}
+	return _u
+}
+
+// ClearReviewNotes clears the value of the "review_notes" field.
+func (_u *MarkCorrectionRequestUpdateOne) ClearReviewNotes() *MarkCorrectionRequestUpdateOne {
+	_u.mutation.ClearReviewNotes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MarkCorrectionRequestUpdateOne) SetUpdatedAt(v time.Time) *MarkCorrectionRequestUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *MarkCorrectionRequestUpdateOne) SetUser(v *User) *MarkCorrectionRequestUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetAttendanceDay sets the "attendance_day" edge to the AttendanceDay entity.
+func (_u *MarkCorrectionRequestUpdateOne) SetAttendanceDay(v *AttendanceDay) *MarkCorrectionRequestUpdateOne {
+	return _u.SetAttendanceDayID(v.ID)
+}
+
+// SetReviewedBy sets the "reviewed_by" edge to the User entity.
+func (_u *MarkCorrectionRequestUpdateOne) SetReviewedBy(v *User) *MarkCorrectionRequestUpdateOne {
+	return _u.SetReviewedByID(v.ID)
+}
+
+// Mutation returns the MarkCorrectionRequestMutation object of the builder.
+func (_u *MarkCorrectionRequestUpdateOne) Mutation() *MarkCorrectionRequestMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *MarkCorrectionRequestUpdateOne) ClearUser() *MarkCorrectionRequestUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearAttendanceDay clears the "attendance_day" edge to the AttendanceDay entity.
+func (_u *MarkCorrectionRequestUpdateOne) ClearAttendanceDay() *MarkCorrectionRequestUpdateOne {
+	_u.mutation.ClearAttendanceDay()
+	return _u
+}
+
+// ClearReviewedBy clears the "reviewed_by" edge to the User entity.
+func (_u *MarkCorrectionRequestUpdateOne) ClearReviewedBy() *MarkCorrectionRequestUpdateOne {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// Where appends a list predicates to the MarkCorrectionRequestUpdate builder.
+func (_u *MarkCorrectionRequestUpdateOne) Where(ps ...predicate.MarkCorrectionRequest) *MarkCorrectionRequestUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MarkCorrectionRequestUpdateOne) Select(field string, fields ...string) *MarkCorrectionRequestUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MarkCorrectionRequest entity.
+func (_u *MarkCorrectionRequestUpdateOne) Save(ctx context.Context) (*MarkCorrectionRequest, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MarkCorrectionRequestUpdateOne) SaveX(ctx context.Context) *MarkCorrectionRequest {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MarkCorrectionRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MarkCorrectionRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MarkCorrectionRequestUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := markcorrectionrequest.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MarkCorrectionRequestUpdateOne) check() error {
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := markcorrectionrequest.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "MarkCorrectionRequest.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := markcorrectionrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MarkCorrectionRequest.status": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MarkCorrectionRequest.user"`)
+	}
+	if _u.mutation.AttendanceDayCleared() && len(_u.mutation.AttendanceDayIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MarkCorrectionRequest.attendance_day"`)
+	}
+	return nil
+}
+
+func (_u *MarkCorrectionRequestUpdateOne) sqlSave(ctx context.Context) (_node *MarkCorrectionRequest, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(markcorrectionrequest.Table, markcorrectionrequest.Columns, sqlgraph.NewFieldSpec(markcorrectionrequest.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MarkCorrectionRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, markcorrectionrequest.FieldID)
+		for _, f := range fields {
+			if !markcorrectionrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != markcorrectionrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WorkDate(); ok {
+		_spec.SetField(markcorrectionrequest.FieldWorkDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.WorkInAt(); ok {
+		_spec.SetField(markcorrectionrequest.FieldWorkInAt, field.TypeString, value)
+	}
+	if _u.mutation.WorkInAtCleared() {
+		_spec.ClearField(markcorrectionrequest.FieldWorkInAt, field.TypeString)
+	}
+	if value, ok := _u.mutation.BreakOutAt(); ok {
+		_spec.SetField(markcorrectionrequest.FieldBreakOutAt, field.TypeString, value)
+	}
+	if _u.mutation.BreakOutAtCleared() {
+		_spec.ClearField(markcorrectionrequest.FieldBreakOutAt, field.TypeString)
+	}
+	if value, ok := _u.mutation.BreakInAt(); ok {
+		_spec.SetField(markcorrectionrequest.FieldBreakInAt, field.TypeString, value)
+	}
+	if _u.mutation.BreakInAtCleared() {
+		_spec.ClearField(markcorrectionrequest.FieldBreakInAt, field.TypeString)
+	}
+	if value, ok := _u.mutation.WorkOutAt(); ok {
+		_spec.SetField(markcorrectionrequest.FieldWorkOutAt, field.TypeString, value)
+	}
+	if _u.mutation.WorkOutAtCleared() {
+		_spec.ClearField(markcorrectionrequest.FieldWorkOutAt, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(markcorrectionrequest.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attachment(); ok {
+		_spec.SetField(markcorrectionrequest.FieldAttachment, field.TypeBytes, value)
+	}
+	if _u.mutation.AttachmentCleared() {
+		_spec.ClearField(markcorrectionrequest.FieldAttachment, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.AttachmentName(); ok {
+		_spec.SetField(markcorrectionrequest.FieldAttachmentName, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentNameCleared() {
+		_spec.ClearField(markcorrectionrequest.FieldAttachmentName, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentContentType(); ok {
+		_spec.SetField(markcorrectionrequest.FieldAttachmentContentType, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentContentTypeCleared() {
+		_spec.ClearField(markcorrectionrequest.FieldAttachmentContentType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(markcorrectionrequest.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(markcorrectionrequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(markcorrectionrequest.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReviewNotes(); ok {
+		_spec.SetField(markcorrectionrequest.FieldReviewNotes, field.TypeString, value)
+	}
+	if _u.mutation.ReviewNotesCleared() {
+		_spec.ClearField(markcorrectionrequest.FieldReviewNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(markcorrectionrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   markcorrectionrequest.UserTable,
+			Columns: []string{markcorrectionrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   markcorrectionrequest.UserTable,
+			Columns: []string{markcorrectionrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AttendanceDayCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   markcorrectionrequest.AttendanceDayTable,
+			Columns: []string{markcorrectionrequest.AttendanceDayColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceday.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttendanceDayIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   markcorrectionrequest.AttendanceDayTable,
+			Columns: []string{markcorrectionrequest.AttendanceDayColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   markcorrectionrequest.ReviewedByTable,
+			Columns: []string{markcorrectionrequest.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   markcorrectionrequest.ReviewedByTable,
+			Columns: []string{markcorrectionrequest.ReviewedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MarkCorrectionRequest{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{markcorrectionrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
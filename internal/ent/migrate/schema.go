@@ -78,18 +78,22 @@ var (
 	AttendanceDaysColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "work_date", Type: field.TypeTime},
+		{Name: "is_holiday", Type: field.TypeBool, Default: false},
 		{Name: "work_in_at", Type: field.TypeTime, Nullable: true},
 		{Name: "break_out_at", Type: field.TypeTime, Nullable: true},
 		{Name: "break_in_at", Type: field.TypeTime, Nullable: true},
 		{Name: "work_out_at", Type: field.TypeTime, Nullable: true},
 		{Name: "late_minutes", Type: field.TypeInt, Nullable: true},
 		{Name: "overtime_minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "approved_overtime_minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "unapproved_overtime_minutes", Type: field.TypeInt, Nullable: true},
 		{Name: "early_exit_minutes", Type: field.TypeInt, Nullable: true},
 		{Name: "break_diff_minutes", Type: field.TypeInt, Nullable: true},
 		{Name: "net_minutes_balance", Type: field.TypeInt, Nullable: true},
 		{Name: "edited", Type: field.TypeBool, Default: false},
 		{Name: "last_edit_reason", Type: field.TypeString, Nullable: true},
 		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_correction_request_id", Type: field.TypeInt, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "access_point_attendance_days", Type: field.TypeInt, Nullable: true},
@@ -106,31 +110,31 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "attendance_days_access_points_attendance_days",
-				Columns:    []*schema.Column{AttendanceDaysColumns[16]},
+				Columns:    []*schema.Column{AttendanceDaysColumns[20]},
 				RefColumns: []*schema.Column{AccessPointsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "attendance_days_users_user",
-				Columns:    []*schema.Column{AttendanceDaysColumns[17]},
+				Columns:    []*schema.Column{AttendanceDaysColumns[21]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "attendance_days_branches_branch",
-				Columns:    []*schema.Column{AttendanceDaysColumns[18]},
+				Columns:    []*schema.Column{AttendanceDaysColumns[22]},
 				RefColumns: []*schema.Column{BranchesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "attendance_days_access_points_access_point",
-				Columns:    []*schema.Column{AttendanceDaysColumns[19]},
+				Columns:    []*schema.Column{AttendanceDaysColumns[23]},
 				RefColumns: []*schema.Column{AccessPointsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "attendance_days_users_attendance_days",
-				Columns:    []*schema.Column{AttendanceDaysColumns[20]},
+				Columns:    []*schema.Column{AttendanceDaysColumns[24]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -139,7 +143,92 @@ var (
 			{
 				Name:    "ux_attendance_day",
 				Unique:  true,
-				Columns: []*schema.Column{AttendanceDaysColumns[17], AttendanceDaysColumns[18], AttendanceDaysColumns[1]},
+				Columns: []*schema.Column{AttendanceDaysColumns[21], AttendanceDaysColumns[22], AttendanceDaysColumns[1]},
+			},
+		},
+	}
+	// AttendanceEditsColumns holds the columns for the "attendance_edits" table.
+	AttendanceEditsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "work_in_at_before", Type: field.TypeTime, Nullable: true},
+		{Name: "work_in_at_after", Type: field.TypeTime, Nullable: true},
+		{Name: "break_out_at_before", Type: field.TypeTime, Nullable: true},
+		{Name: "break_out_at_after", Type: field.TypeTime, Nullable: true},
+		{Name: "break_in_at_before", Type: field.TypeTime, Nullable: true},
+		{Name: "break_in_at_after", Type: field.TypeTime, Nullable: true},
+		{Name: "work_out_at_before", Type: field.TypeTime, Nullable: true},
+		{Name: "work_out_at_after", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "attendance_day_id", Type: field.TypeInt},
+		{Name: "editor_id", Type: field.TypeInt},
+		{Name: "correction_request_id", Type: field.TypeInt, Nullable: true},
+	}
+	// AttendanceEditsTable holds the schema information for the "attendance_edits" table.
+	AttendanceEditsTable = &schema.Table{
+		Name:       "attendance_edits",
+		Columns:    AttendanceEditsColumns,
+		PrimaryKey: []*schema.Column{AttendanceEditsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attendance_edits_attendance_days_attendance_day",
+				Columns:    []*schema.Column{AttendanceEditsColumns[11]},
+				RefColumns: []*schema.Column{AttendanceDaysColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "attendance_edits_users_editor",
+				Columns:    []*schema.Column{AttendanceEditsColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "attendance_edits_mark_correction_requests_correction_request",
+				Columns:    []*schema.Column{AttendanceEditsColumns[13]},
+				RefColumns: []*schema.Column{MarkCorrectionRequestsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ix_attendance_edit_day_created",
+				Unique:  false,
+				Columns: []*schema.Column{AttendanceEditsColumns[11], AttendanceEditsColumns[10]},
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "actor_id", Type: field.TypeInt, Nullable: true},
+		{Name: "actor_device_id", Type: field.TypeInt, Nullable: true},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "entity_type", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeInt, Nullable: true},
+		{Name: "operation", Type: field.TypeString},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ix_audit_log_entity",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[4], AuditLogsColumns[5]},
+			},
+			{
+				Name:    "ix_audit_log_actor",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+			{
+				Name:    "ix_audit_log_created",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[8]},
 			},
 		},
 	}
@@ -304,6 +393,335 @@ var (
 			},
 		},
 	}
+	// HolidaysColumns holds the columns for the "holidays" table.
+	HolidaysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeString, Default: "national"},
+		{Name: "irrenunciable", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "region_id", Type: field.TypeInt, Nullable: true},
+		{Name: "branch_id", Type: field.TypeInt, Nullable: true},
+	}
+	// HolidaysTable holds the schema information for the "holidays" table.
+	HolidaysTable = &schema.Table{
+		Name:       "holidays",
+		Columns:    HolidaysColumns,
+		PrimaryKey: []*schema.Column{HolidaysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "holidays_regions_region",
+				Columns:    []*schema.Column{HolidaysColumns[7]},
+				RefColumns: []*schema.Column{RegionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "holidays_branches_branch",
+				Columns:    []*schema.Column{HolidaysColumns[8]},
+				RefColumns: []*schema.Column{BranchesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ix_holiday_date",
+				Unique:  false,
+				Columns: []*schema.Column{HolidaysColumns[1]},
+			},
+		},
+	}
+	// HourBankMovementsColumns holds the columns for the "hour_bank_movements" table.
+	HourBankMovementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "minutes", Type: field.TypeInt},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "attendance_day_id", Type: field.TypeInt, Nullable: true},
+		{Name: "created_by_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// HourBankMovementsTable holds the schema information for the "hour_bank_movements" table.
+	HourBankMovementsTable = &schema.Table{
+		Name:       "hour_bank_movements",
+		Columns:    HourBankMovementsColumns,
+		PrimaryKey: []*schema.Column{HourBankMovementsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "hour_bank_movements_attendance_days_attendance_day",
+				Columns:    []*schema.Column{HourBankMovementsColumns[7]},
+				RefColumns: []*schema.Column{AttendanceDaysColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "hour_bank_movements_users_created_by",
+				Columns:    []*schema.Column{HourBankMovementsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "hour_bank_movements_users_hour_bank_movements",
+				Columns:    []*schema.Column{HourBankMovementsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ix_hour_bank_movement_user_date",
+				Unique:  false,
+				Columns: []*schema.Column{HourBankMovementsColumns[9], HourBankMovementsColumns[1]},
+			},
+		},
+	}
+	// LeaveRequestsColumns holds the columns for the "leave_requests" table.
+	LeaveRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeString},
+		{Name: "start_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "end_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "status", Type: field.TypeString, Default: "requested"},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "review_notes", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "reviewed_by_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// LeaveRequestsTable holds the schema information for the "leave_requests" table.
+	LeaveRequestsTable = &schema.Table{
+		Name:       "leave_requests",
+		Columns:    LeaveRequestsColumns,
+		PrimaryKey: []*schema.Column{LeaveRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "leave_requests_users_reviewed_by",
+				Columns:    []*schema.Column{LeaveRequestsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "leave_requests_users_leave_requests",
+				Columns:    []*schema.Column{LeaveRequestsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ix_leave_request_user_start",
+				Unique:  false,
+				Columns: []*schema.Column{LeaveRequestsColumns[11], LeaveRequestsColumns[2]},
+			},
+			{
+				Name:    "ix_leave_request_status",
+				Unique:  false,
+				Columns: []*schema.Column{LeaveRequestsColumns[4]},
+			},
+		},
+	}
+	// MarkCorrectionRequestsColumns holds the columns for the "mark_correction_requests" table.
+	MarkCorrectionRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "work_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "work_in_at", Type: field.TypeString, Nullable: true},
+		{Name: "break_out_at", Type: field.TypeString, Nullable: true},
+		{Name: "break_in_at", Type: field.TypeString, Nullable: true},
+		{Name: "work_out_at", Type: field.TypeString, Nullable: true},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "attachment", Type: field.TypeBytes, Nullable: true},
+		{Name: "attachment_name", Type: field.TypeString, Nullable: true},
+		{Name: "attachment_content_type", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "requested"},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "review_notes", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "attendance_day_id", Type: field.TypeInt},
+		{Name: "reviewed_by_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// MarkCorrectionRequestsTable holds the schema information for the "mark_correction_requests" table.
+	MarkCorrectionRequestsTable = &schema.Table{
+		Name:       "mark_correction_requests",
+		Columns:    MarkCorrectionRequestsColumns,
+		PrimaryKey: []*schema.Column{MarkCorrectionRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "mark_correction_requests_attendance_days_attendance_day",
+				Columns:    []*schema.Column{MarkCorrectionRequestsColumns[15]},
+				RefColumns: []*schema.Column{AttendanceDaysColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "mark_correction_requests_users_reviewed_by",
+				Columns:    []*schema.Column{MarkCorrectionRequestsColumns[16]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "mark_correction_requests_users_mark_correction_requests",
+				Columns:    []*schema.Column{MarkCorrectionRequestsColumns[17]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ix_mark_correction_user_date",
+				Unique:  false,
+				Columns: []*schema.Column{MarkCorrectionRequestsColumns[17], MarkCorrectionRequestsColumns[1]},
+			},
+			{
+				Name:    "ix_mark_correction_status",
+				Unique:  false,
+				Columns: []*schema.Column{MarkCorrectionRequestsColumns[10]},
+			},
+		},
+	}
+	// OvertimeAuthorizationsColumns holds the columns for the "overtime_authorizations" table.
+	OvertimeAuthorizationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "max_minutes", Type: field.TypeInt},
+		{Name: "status", Type: field.TypeString, Default: "requested"},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "review_notes", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "requested_by_id", Type: field.TypeInt, Nullable: true},
+		{Name: "approved_by_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// OvertimeAuthorizationsTable holds the schema information for the "overtime_authorizations" table.
+	OvertimeAuthorizationsTable = &schema.Table{
+		Name:       "overtime_authorizations",
+		Columns:    OvertimeAuthorizationsColumns,
+		PrimaryKey: []*schema.Column{OvertimeAuthorizationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "overtime_authorizations_users_requested_by",
+				Columns:    []*schema.Column{OvertimeAuthorizationsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "overtime_authorizations_users_approved_by",
+				Columns:    []*schema.Column{OvertimeAuthorizationsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "overtime_authorizations_users_overtime_authorizations",
+				Columns:    []*schema.Column{OvertimeAuthorizationsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ix_overtime_authorization_user_date",
+				Unique:  false,
+				Columns: []*schema.Column{OvertimeAuthorizationsColumns[11], OvertimeAuthorizationsColumns[1]},
+			},
+			{
+				Name:    "ix_overtime_authorization_status",
+				Unique:  false,
+				Columns: []*schema.Column{OvertimeAuthorizationsColumns[3]},
+			},
+		},
+	}
+	// PayrollExportProfilesColumns holds the columns for the "payroll_export_profiles" table.
+	PayrollExportProfilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "separator", Type: field.TypeString, Default: ";"},
+		{Name: "date_format", Type: field.TypeString, Default: "YYYY-MM-DD"},
+		{Name: "decimal_separator", Type: field.TypeString, Default: ","},
+		{Name: "period", Type: field.TypeString, Default: "month"},
+		{Name: "include_header", Type: field.TypeBool, Default: true},
+		{Name: "columns", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// PayrollExportProfilesTable holds the schema information for the "payroll_export_profiles" table.
+	PayrollExportProfilesTable = &schema.Table{
+		Name:       "payroll_export_profiles",
+		Columns:    PayrollExportProfilesColumns,
+		PrimaryKey: []*schema.Column{PayrollExportProfilesColumns[0]},
+	}
+	// PunchesColumns holds the columns for the "punches" table.
+	PunchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "branch_id", Type: field.TypeInt},
+		{Name: "punched_at", Type: field.TypeTime},
+		{Name: "method", Type: field.TypeString},
+		{Name: "direction", Type: field.TypeString, Nullable: true},
+		{Name: "raw_payload", Type: field.TypeString, Nullable: true},
+		{Name: "rejected_reason", Type: field.TypeString, Nullable: true},
+		{Name: "client_id", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "access_point_id", Type: field.TypeInt, Nullable: true},
+		{Name: "attendance_day_id", Type: field.TypeInt, Nullable: true},
+		{Name: "device_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// PunchesTable holds the schema information for the "punches" table.
+	PunchesTable = &schema.Table{
+		Name:       "punches",
+		Columns:    PunchesColumns,
+		PrimaryKey: []*schema.Column{PunchesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "punches_access_points_punches",
+				Columns:    []*schema.Column{PunchesColumns[9]},
+				RefColumns: []*schema.Column{AccessPointsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "punches_attendance_days_punches",
+				Columns:    []*schema.Column{PunchesColumns[10]},
+				RefColumns: []*schema.Column{AttendanceDaysColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "punches_devices_punches",
+				Columns:    []*schema.Column{PunchesColumns[11]},
+				RefColumns: []*schema.Column{DevicesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "punches_users_punches",
+				Columns:    []*schema.Column{PunchesColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ix_punch_user_time",
+				Unique:  false,
+				Columns: []*schema.Column{PunchesColumns[12], PunchesColumns[2]},
+			},
+			{
+				Name:    "ix_punch_attendance_day",
+				Unique:  false,
+				Columns: []*schema.Column{PunchesColumns[10]},
+			},
+			{
+				Name:    "ux_punch_client_id",
+				Unique:  true,
+				Columns: []*schema.Column{PunchesColumns[7]},
+			},
+		},
+	}
 	// RefreshTokensColumns holds the columns for the "refresh_tokens" table.
 	RefreshTokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -356,6 +774,22 @@ var (
 			},
 		},
 	}
+	// RolesColumns holds the columns for the "roles" table.
+	RolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "permissions", Type: field.TypeJSON},
+		{Name: "is_system", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RolesTable holds the schema information for the "roles" table.
+	RolesTable = &schema.Table{
+		Name:       "roles",
+		Columns:    RolesColumns,
+		PrimaryKey: []*schema.Column{RolesColumns[0]},
+	}
 	// ShiftsColumns holds the columns for the "shifts" table.
 	ShiftsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -365,8 +799,14 @@ var (
 		{Name: "start_time", Type: field.TypeString},
 		{Name: "end_time", Type: field.TypeString},
 		{Name: "break_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "entry_tolerance_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "exit_tolerance_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "min_overtime_minutes", Type: field.TypeInt, Default: 0},
 		{Name: "crosses_midnight", Type: field.TypeBool, Default: false},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "pattern_type", Type: field.TypeString, Default: "weekly"},
+		{Name: "cycle_length", Type: field.TypeInt, Nullable: true},
+		{Name: "cycle_anchor_date", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "date"}},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -383,6 +823,36 @@ var (
 			},
 		},
 	}
+	// ShiftCycleDaysColumns holds the columns for the "shift_cycle_days" table.
+	ShiftCycleDaysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "day_index", Type: field.TypeInt},
+		{Name: "is_working_day", Type: field.TypeBool, Default: true},
+		{Name: "mode", Type: field.TypeString, Default: "onsite"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "shift_id", Type: field.TypeInt},
+	}
+	// ShiftCycleDaysTable holds the schema information for the "shift_cycle_days" table.
+	ShiftCycleDaysTable = &schema.Table{
+		Name:       "shift_cycle_days",
+		Columns:    ShiftCycleDaysColumns,
+		PrimaryKey: []*schema.Column{ShiftCycleDaysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "shift_cycle_days_shifts_cycle_days",
+				Columns:    []*schema.Column{ShiftCycleDaysColumns[5]},
+				RefColumns: []*schema.Column{ShiftsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "shiftcycleday_shift_id_day_index",
+				Unique:  true,
+				Columns: []*schema.Column{ShiftCycleDaysColumns[5], ShiftCycleDaysColumns[1]},
+			},
+		},
+	}
 	// ShiftDaysColumns holds the columns for the "shift_days" table.
 	ShiftDaysColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -456,6 +926,8 @@ var (
 		{Name: "email", Type: field.TypeString, Nullable: true},
 		{Name: "employee_code", Type: field.TypeString, Nullable: true},
 		{Name: "access_code", Type: field.TypeString, Nullable: true},
+		{Name: "hire_date", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "prior_service_years", Type: field.TypeInt, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -598,6 +1070,7 @@ var (
 		{Name: "issued_at", Type: field.TypeTime},
 		{Name: "expires_at", Type: field.TypeTime},
 		{Name: "is_revoked", Type: field.TypeBool, Default: false},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "user_id", Type: field.TypeInt},
 	}
@@ -609,7 +1082,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "user_qr_sessions_users_qr_sessions",
-				Columns:    []*schema.Column{UserQrSessionsColumns[6]},
+				Columns:    []*schema.Column{UserQrSessionsColumns[7]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -623,7 +1096,7 @@ var (
 			{
 				Name:    "userqrsession_user_id_expires_at",
 				Unique:  false,
-				Columns: []*schema.Column{UserQrSessionsColumns[6], UserQrSessionsColumns[3]},
+				Columns: []*schema.Column{UserQrSessionsColumns[7], UserQrSessionsColumns[3]},
 			},
 		},
 	}
@@ -664,19 +1137,67 @@ var (
 			},
 		},
 	}
+	// VacationAdjustmentsColumns holds the columns for the "vacation_adjustments" table.
+	VacationAdjustmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "days", Type: field.TypeFloat64},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "created_by_id", Type: field.TypeInt, Nullable: true},
+	}
+	// VacationAdjustmentsTable holds the schema information for the "vacation_adjustments" table.
+	VacationAdjustmentsTable = &schema.Table{
+		Name:       "vacation_adjustments",
+		Columns:    VacationAdjustmentsColumns,
+		PrimaryKey: []*schema.Column{VacationAdjustmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "vacation_adjustments_users_vacation_adjustments",
+				Columns:    []*schema.Column{VacationAdjustmentsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "vacation_adjustments_users_created_by",
+				Columns:    []*schema.Column{VacationAdjustmentsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ix_vacation_adjustment_user_date",
+				Unique:  false,
+				Columns: []*schema.Column{VacationAdjustmentsColumns[5], VacationAdjustmentsColumns[1]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AccessPointsTable,
 		AddressesTable,
 		AttendanceDaysTable,
+		AttendanceEditsTable,
+		AuditLogsTable,
 		BranchesTable,
 		BranchAddressesTable,
 		CitiesTable,
 		CommunesTable,
 		DevicesTable,
+		HolidaysTable,
+		HourBankMovementsTable,
+		LeaveRequestsTable,
+		MarkCorrectionRequestsTable,
+		OvertimeAuthorizationsTable,
+		PayrollExportProfilesTable,
+		PunchesTable,
 		RefreshTokensTable,
 		RegionsTable,
+		RolesTable,
 		ShiftsTable,
+		ShiftCycleDaysTable,
 		ShiftDaysTable,
 		ShiftInstancesTable,
 		UsersTable,
@@ -685,6 +1206,7 @@ var (
 		UserDayOverridesTable,
 		UserQrSessionsTable,
 		UserShiftAssignmentsTable,
+		VacationAdjustmentsTable,
 	}
 )
 
@@ -697,12 +1219,33 @@ func init() {
 	AttendanceDaysTable.ForeignKeys[2].RefTable = BranchesTable
 	AttendanceDaysTable.ForeignKeys[3].RefTable = AccessPointsTable
 	AttendanceDaysTable.ForeignKeys[4].RefTable = UsersTable
+	AttendanceEditsTable.ForeignKeys[0].RefTable = AttendanceDaysTable
+	AttendanceEditsTable.ForeignKeys[1].RefTable = UsersTable
+	AttendanceEditsTable.ForeignKeys[2].RefTable = MarkCorrectionRequestsTable
 	BranchAddressesTable.ForeignKeys[0].RefTable = BranchesTable
 	BranchAddressesTable.ForeignKeys[1].RefTable = CommunesTable
 	CitiesTable.ForeignKeys[0].RefTable = RegionsTable
 	CommunesTable.ForeignKeys[0].RefTable = CitiesTable
 	DevicesTable.ForeignKeys[0].RefTable = AccessPointsTable
+	HolidaysTable.ForeignKeys[0].RefTable = RegionsTable
+	HolidaysTable.ForeignKeys[1].RefTable = BranchesTable
+	HourBankMovementsTable.ForeignKeys[0].RefTable = AttendanceDaysTable
+	HourBankMovementsTable.ForeignKeys[1].RefTable = UsersTable
+	HourBankMovementsTable.ForeignKeys[2].RefTable = UsersTable
+	LeaveRequestsTable.ForeignKeys[0].RefTable = UsersTable
+	LeaveRequestsTable.ForeignKeys[1].RefTable = UsersTable
+	MarkCorrectionRequestsTable.ForeignKeys[0].RefTable = AttendanceDaysTable
+	MarkCorrectionRequestsTable.ForeignKeys[1].RefTable = UsersTable
+	MarkCorrectionRequestsTable.ForeignKeys[2].RefTable = UsersTable
+	OvertimeAuthorizationsTable.ForeignKeys[0].RefTable = UsersTable
+	OvertimeAuthorizationsTable.ForeignKeys[1].RefTable = UsersTable
+	OvertimeAuthorizationsTable.ForeignKeys[2].RefTable = UsersTable
+	PunchesTable.ForeignKeys[0].RefTable = AccessPointsTable
+	PunchesTable.ForeignKeys[1].RefTable = AttendanceDaysTable
+	PunchesTable.ForeignKeys[2].RefTable = DevicesTable
+	PunchesTable.ForeignKeys[3].RefTable = UsersTable
 	RefreshTokensTable.ForeignKeys[0].RefTable = UsersTable
+	ShiftCycleDaysTable.ForeignKeys[0].RefTable = ShiftsTable
 	ShiftDaysTable.ForeignKeys[0].RefTable = ShiftsTable
 	ShiftInstancesTable.ForeignKeys[0].RefTable = ShiftsTable
 	UserAccessPointsTable.ForeignKeys[0].RefTable = AccessPointsTable
@@ -714,4 +1257,6 @@ func init() {
 	UserQrSessionsTable.ForeignKeys[0].RefTable = UsersTable
 	UserShiftAssignmentsTable.ForeignKeys[0].RefTable = ShiftsTable
 	UserShiftAssignmentsTable.ForeignKeys[1].RefTable = UsersTable
+	VacationAdjustmentsTable.ForeignKeys[0].RefTable = UsersTable
+	VacationAdjustmentsTable.ForeignKeys[1].RefTable = UsersTable
 }
@@ -0,0 +1,296 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/overtimeauthorization"
+	"back/internal/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// OvertimeAuthorization is the model entity for the OvertimeAuthorization schema.
+type OvertimeAuthorization struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Date holds the value of the "date" field.
+	Date time.Time `json:"date,omitempty"`
+	// MaxMinutes holds the value of the "max_minutes" field.
+	MaxMinutes int `json:"max_minutes,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason *string `json:"reason,omitempty"`
+	// RequestedByID holds the value of the "requested_by_id" field.
+	RequestedByID *int `json:"requested_by_id,omitempty"`
+	// ApprovedByID holds the value of the "approved_by_id" field.
+	ApprovedByID *int `json:"approved_by_id,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// ReviewNotes holds the value of the "review_notes" field.
+	ReviewNotes *string `json:"review_notes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OvertimeAuthorizationQuery when eager-loading is set.
+	Edges        OvertimeAuthorizationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OvertimeAuthorizationEdges holds the relations/edges for other nodes in the graph.
+type OvertimeAuthorizationEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// RequestedBy holds the value of the requested_by edge.
+	RequestedBy *User `json:"requested_by,omitempty"`
+	// ApprovedBy holds the value of the approved_by edge.
+	ApprovedBy *User `json:"approved_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OvertimeAuthorizationEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// RequestedByOrErr returns the RequestedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OvertimeAuthorizationEdges) RequestedByOrErr() (*User, error) {
+	if e.RequestedBy != nil {
+		return e.RequestedBy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "requested_by"}
+}
+
+// ApprovedByOrErr returns the ApprovedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OvertimeAuthorizationEdges) ApprovedByOrErr() (*User, error) {
+	if e.ApprovedBy != nil {
+		return e.ApprovedBy, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "approved_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OvertimeAuthorization) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case overtimeauthorization.FieldID, overtimeauthorization.FieldUserID, overtimeauthorization.FieldMaxMinutes, overtimeauthorization.FieldRequestedByID, overtimeauthorization.FieldApprovedByID:
+			values[i] = new(sql.NullInt64)
+		case overtimeauthorization.FieldStatus, overtimeauthorization.FieldReason, overtimeauthorization.FieldReviewNotes:
+			values[i] = new(sql.NullString)
+		case overtimeauthorization.FieldDate, overtimeauthorization.FieldReviewedAt, overtimeauthorization.FieldCreatedAt, overtimeauthorization.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OvertimeAuthorization fields.
+func (_m *OvertimeAuthorization) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case overtimeauthorization.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case overtimeauthorization.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case overtimeauthorization.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				_m.Date = value.Time
+			}
+		case overtimeauthorization.FieldMaxMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_minutes", values[i])
+			} else if value.Valid {
+				_m.MaxMinutes = int(value.Int64)
+			}
+		case overtimeauthorization.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case overtimeauthorization.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = new(string)
+				*_m.Reason = value.String
+			}
+		case overtimeauthorization.FieldRequestedByID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_by_id", values[i])
+			} else if value.Valid {
+				_m.RequestedByID = new(int)
+				*_m.RequestedByID = int(value.Int64)
+			}
+		case overtimeauthorization.FieldApprovedByID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_by_id", values[i])
+			} else if value.Valid {
+				_m.ApprovedByID = new(int)
+				*_m.ApprovedByID = int(value.Int64)
+			}
+		case overtimeauthorization.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				_m.ReviewedAt = new(time.Time)
+				*_m.ReviewedAt = value.Time
+			}
+		case overtimeauthorization.FieldReviewNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_notes", values[i])
+			} else if value.Valid {
+				_m.ReviewNotes = new(string)
+				*_m.ReviewNotes = value.String
+			}
+		case overtimeauthorization.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case overtimeauthorization.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OvertimeAuthorization.
+// This includes values selected through modifiers, order, etc.
+func (_m *OvertimeAuthorization) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the OvertimeAuthorization entity.
+func (_m *OvertimeAuthorization) QueryUser() *UserQuery {
+	return NewOvertimeAuthorizationClient(_m.config).QueryUser(_m)
+}
+
+// QueryRequestedBy queries the "requested_by" edge of the OvertimeAuthorization entity.
+func (_m *OvertimeAuthorization) QueryRequestedBy() *UserQuery {
+	return NewOvertimeAuthorizationClient(_m.config).QueryRequestedBy(_m)
+}
+
+// QueryApprovedBy queries the "approved_by" edge of the OvertimeAuthorization entity.
+func (_m *OvertimeAuthorization) QueryApprovedBy() *UserQuery {
+	return NewOvertimeAuthorizationClient(_m.config).QueryApprovedBy(_m)
+}
+
+// Update returns a builder for updating this OvertimeAuthorization.
+// Note that you need to call OvertimeAuthorization.Unwrap() before calling this method if this OvertimeAuthorization
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OvertimeAuthorization) Update() *OvertimeAuthorizationUpdateOne {
+	return NewOvertimeAuthorizationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OvertimeAuthorization entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OvertimeAuthorization) Unwrap() *OvertimeAuthorization {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OvertimeAuthorization is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OvertimeAuthorization) String() string {
+	var builder strings.Builder
+	builder.WriteString("OvertimeAuthorization(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(_m.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("max_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.Reason; v != nil {
+		builder.WriteString("reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.RequestedByID; v != nil {
+		builder.WriteString("requested_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ApprovedByID; v != nil {
+		builder.WriteString("approved_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReviewNotes; v != nil {
+		builder.WriteString("review_notes=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OvertimeAuthorizations is a parsable slice of OvertimeAuthorization.
+type OvertimeAuthorizations []*OvertimeAuthorization
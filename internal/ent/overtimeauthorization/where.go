@@ -0,0 +1,700 @@
+// Code generated by ent, DO NOT EDIT.
+
+package overtimeauthorization
+
+import (
+	"back/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldUserID, v))
+}
+
+// Date applies equality check predicate on the "date" field. It's identical to DateEQ.
+func Date(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldDate, v))
+}
+
+// MaxMinutes applies equality check predicate on the "max_minutes" field. It's identical to MaxMinutesEQ.
+func MaxMinutes(v int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldMaxMinutes, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldStatus, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldReason, v))
+}
+
+// RequestedByID applies equality check predicate on the "requested_by_id" field. It's identical to RequestedByIDEQ.
+func RequestedByID(v int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldRequestedByID, v))
+}
+
+// ApprovedByID applies equality check predicate on the "approved_by_id" field. It's identical to ApprovedByIDEQ.
+func ApprovedByID(v int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldApprovedByID, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewNotes applies equality check predicate on the "review_notes" field. It's identical to ReviewNotesEQ.
+func ReviewNotes(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldReviewNotes, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// DateEQ applies the EQ predicate on the "date" field.
+func DateEQ(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldDate, v))
+}
+
+// DateNEQ applies the NEQ predicate on the "date" field.
+func DateNEQ(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNEQ(FieldDate, v))
+}
+
+// DateIn applies the In predicate on the "date" field.
+func DateIn(vs ...time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldIn(FieldDate, vs...))
+}
+
+// DateNotIn applies the NotIn predicate on the "date" field.
+func DateNotIn(vs ...time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNotIn(FieldDate, vs...))
+}
+
+// DateGT applies the GT predicate on the "date" field.
+func DateGT(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldGT(FieldDate, v))
+}
+
+// DateGTE applies the GTE predicate on the "date" field.
+func DateGTE(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldGTE(FieldDate, v))
+}
+
+// DateLT applies the LT predicate on the "date" field.
+func DateLT(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldLT(FieldDate, v))
+}
+
+// DateLTE applies the LTE predicate on the "date" field.
+func DateLTE(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldLTE(FieldDate, v))
+}
+
+// MaxMinutesEQ applies the EQ predicate on the "max_minutes" field.
+func MaxMinutesEQ(v int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldMaxMinutes, v))
+}
+
+// MaxMinutesNEQ applies the NEQ predicate on the "max_minutes" field.
+func MaxMinutesNEQ(v int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNEQ(FieldMaxMinutes, v))
+}
+
+// MaxMinutesIn applies the In predicate on the "max_minutes" field.
+func MaxMinutesIn(vs ...int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldIn(FieldMaxMinutes, vs...))
+}
+
+// MaxMinutesNotIn applies the NotIn predicate on the "max_minutes" field.
+func MaxMinutesNotIn(vs ...int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNotIn(FieldMaxMinutes, vs...))
+}
+
+// MaxMinutesGT applies the GT predicate on the "max_minutes" field.
+func MaxMinutesGT(v int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldGT(FieldMaxMinutes, v))
+}
+
+// MaxMinutesGTE applies the GTE predicate on the "max_minutes" field.
+func MaxMinutesGTE(v int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldGTE(FieldMaxMinutes, v))
+}
+
+// MaxMinutesLT applies the LT predicate on the "max_minutes" field.
+func MaxMinutesLT(v int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldLT(FieldMaxMinutes, v))
+}
+
+// MaxMinutesLTE applies the LTE predicate on the "max_minutes" field.
+func MaxMinutesLTE(v int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldLTE(FieldMaxMinutes, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldContainsFold(FieldReason, v))
+}
+
+// RequestedByIDEQ applies the EQ predicate on the "requested_by_id" field.
+func RequestedByIDEQ(v int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldRequestedByID, v))
+}
+
+// RequestedByIDNEQ applies the NEQ predicate on the "requested_by_id" field.
+func RequestedByIDNEQ(v int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNEQ(FieldRequestedByID, v))
+}
+
+// RequestedByIDIn applies the In predicate on the "requested_by_id" field.
+func RequestedByIDIn(vs ...int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldIn(FieldRequestedByID, vs...))
+}
+
+// RequestedByIDNotIn applies the NotIn predicate on the "requested_by_id" field.
+func RequestedByIDNotIn(vs ...int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNotIn(FieldRequestedByID, vs...))
+}
+
+// RequestedByIDIsNil applies the IsNil predicate on the "requested_by_id" field.
+func RequestedByIDIsNil() predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldIsNull(FieldRequestedByID))
+}
+
+// RequestedByIDNotNil applies the NotNil predicate on the "requested_by_id" field.
+func RequestedByIDNotNil() predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNotNull(FieldRequestedByID))
+}
+
+// ApprovedByIDEQ applies the EQ predicate on the "approved_by_id" field.
+func ApprovedByIDEQ(v int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldApprovedByID, v))
+}
+
+// ApprovedByIDNEQ applies the NEQ predicate on the "approved_by_id" field.
+func ApprovedByIDNEQ(v int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNEQ(FieldApprovedByID, v))
+}
+
+// ApprovedByIDIn applies the In predicate on the "approved_by_id" field.
+func ApprovedByIDIn(vs ...int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldIn(FieldApprovedByID, vs...))
+}
+
+// ApprovedByIDNotIn applies the NotIn predicate on the "approved_by_id" field.
+func ApprovedByIDNotIn(vs ...int) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNotIn(FieldApprovedByID, vs...))
+}
+
+// ApprovedByIDIsNil applies the IsNil predicate on the "approved_by_id" field.
+func ApprovedByIDIsNil() predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldIsNull(FieldApprovedByID))
+}
+
+// ApprovedByIDNotNil applies the NotNil predicate on the "approved_by_id" field.
+func ApprovedByIDNotNil() predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNotNull(FieldApprovedByID))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// ReviewNotesEQ applies the EQ predicate on the "review_notes" field.
+func ReviewNotesEQ(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldReviewNotes, v))
+}
+
+// ReviewNotesNEQ applies the NEQ predicate on the "review_notes" field.
+func ReviewNotesNEQ(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNEQ(FieldReviewNotes, v))
+}
+
+// ReviewNotesIn applies the In predicate on the "review_notes" field.
+func ReviewNotesIn(vs ...string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldIn(FieldReviewNotes, vs...))
+}
+
+// ReviewNotesNotIn applies the NotIn predicate on the "review_notes" field.
+func ReviewNotesNotIn(vs ...string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNotIn(FieldReviewNotes, vs...))
+}
+
+// ReviewNotesGT applies the GT predicate on the "review_notes" field.
+func ReviewNotesGT(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldGT(FieldReviewNotes, v))
+}
+
+// ReviewNotesGTE applies the GTE predicate on the "review_notes" field.
+func ReviewNotesGTE(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldGTE(FieldReviewNotes, v))
+}
+
+// ReviewNotesLT applies the LT predicate on the "review_notes" field.
+func ReviewNotesLT(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldLT(FieldReviewNotes, v))
+}
+
+// ReviewNotesLTE applies the LTE predicate on the "review_notes" field.
+func ReviewNotesLTE(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldLTE(FieldReviewNotes, v))
+}
+
+// ReviewNotesContains applies the Contains predicate on the "review_notes" field.
+func ReviewNotesContains(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldContains(FieldReviewNotes, v))
+}
+
+// ReviewNotesHasPrefix applies the HasPrefix predicate on the "review_notes" field.
+func ReviewNotesHasPrefix(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldHasPrefix(FieldReviewNotes, v))
+}
+
+// ReviewNotesHasSuffix applies the HasSuffix predicate on the "review_notes" field.
+func ReviewNotesHasSuffix(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldHasSuffix(FieldReviewNotes, v))
+}
+
+// ReviewNotesIsNil applies the IsNil predicate on the "review_notes" field.
+func ReviewNotesIsNil() predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldIsNull(FieldReviewNotes))
+}
+
+// ReviewNotesNotNil applies the NotNil predicate on the "review_notes" field.
+func ReviewNotesNotNil() predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNotNull(FieldReviewNotes))
+}
+
+// ReviewNotesEqualFold applies the EqualFold predicate on the "review_notes" field.
+func ReviewNotesEqualFold(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEqualFold(FieldReviewNotes, v))
+}
+
+// ReviewNotesContainsFold applies the ContainsFold predicate on the "review_notes" field.
+func ReviewNotesContainsFold(v string) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldContainsFold(FieldReviewNotes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRequestedBy applies the HasEdge predicate on the "requested_by" edge.
+func HasRequestedBy() predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RequestedByTable, RequestedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRequestedByWith applies the HasEdge predicate on the "requested_by" edge with a given conditions (other predicates).
+func HasRequestedByWith(preds ...predicate.User) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(func(s *sql.Selector) {
+		step := newRequestedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasApprovedBy applies the HasEdge predicate on the "approved_by" edge.
+func HasApprovedBy() predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ApprovedByTable, ApprovedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasApprovedByWith applies the HasEdge predicate on the "approved_by" edge with a given conditions (other predicates).
+func HasApprovedByWith(preds ...predicate.User) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(func(s *sql.Selector) {
+		step := newApprovedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OvertimeAuthorization) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OvertimeAuthorization) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OvertimeAuthorization) predicate.OvertimeAuthorization {
+	return predicate.OvertimeAuthorization(sql.NotPredicates(p))
+}
@@ -0,0 +1,449 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/overtimeauthorization"
+	"back/internal/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OvertimeAuthorizationCreate is the builder for creating a OvertimeAuthorization entity.
+type OvertimeAuthorizationCreate struct {
+	config
+	mutation *OvertimeAuthorizationMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *OvertimeAuthorizationCreate) SetUserID(v int) *OvertimeAuthorizationCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetDate sets the "date" field.
+func (_c *OvertimeAuthorizationCreate) SetDate(v time.Time) *OvertimeAuthorizationCreate {
+	_c.mutation.SetDate(v)
+	return _c
+}
+
+// SetMaxMinutes sets the "max_minutes" field.
+func (_c *OvertimeAuthorizationCreate) SetMaxMinutes(v int) *OvertimeAuthorizationCreate {
+	_c.mutation.SetMaxMinutes(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *OvertimeAuthorizationCreate) SetStatus(v string) *OvertimeAuthorizationCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *OvertimeAuthorizationCreate) SetNillableStatus(v *string) *OvertimeAuthorizationCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *OvertimeAuthorizationCreate) SetReason(v string) *OvertimeAuthorizationCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *OvertimeAuthorizationCreate) SetNillableReason(v *string) *OvertimeAuthorizationCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetRequestedByID sets the "requested_by_id" field.
+func (_c *OvertimeAuthorizationCreate) SetRequestedByID(v int) *OvertimeAuthorizationCreate {
+	_c.mutation.SetRequestedByID(v)
+	return _c
+}
+
+// SetNillableRequestedByID sets the "requested_by_id" field if the given value is not nil.
+func (_c *OvertimeAuthorizationCreate) SetNillableRequestedByID(v *int) *OvertimeAuthorizationCreate {
+	if v != nil {
+		_c.SetRequestedByID(*v)
+	}
+	return _c
+}
+
+// SetApprovedByID sets the "approved_by_id" field.
+func (_c *OvertimeAuthorizationCreate) SetApprovedByID(v int) *OvertimeAuthorizationCreate {
+	_c.mutation.SetApprovedByID(v)
+	return _c
+}
+
+// SetNillableApprovedByID sets the "approved_by_id" field if the given value is not nil.
+func (_c *OvertimeAuthorizationCreate) SetNillableApprovedByID(v *int) *OvertimeAuthorizationCreate {
+	if v != nil {
+		_c.SetApprovedByID(*v)
+	}
+	return _c
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_c *OvertimeAuthorizationCreate) SetReviewedAt(v time.Time) *OvertimeAuthorizationCreate {
+	_c.mutation.SetReviewedAt(v)
+	return _c
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_c *OvertimeAuthorizationCreate) SetNillableReviewedAt(v *time.Time) *OvertimeAuthorizationCreate {
+	if v != nil {
+		_c.SetReviewedAt(*v)
+	}
+	return _c
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (_c *OvertimeAuthorizationCreate) SetReviewNotes(v string) *OvertimeAuthorizationCreate {
+	_c.mutation.SetReviewNotes(v)
+	return _c
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (_c *OvertimeAuthorizationCreate) SetNillableReviewNotes(v *string) *OvertimeAuthorizationCreate {
+	if v != nil {
+		_c.SetReviewNotes(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OvertimeAuthorizationCreate) SetCreatedAt(v time.Time) *OvertimeAuthorizationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OvertimeAuthorizationCreate) SetNillableCreatedAt(v *time.Time) *OvertimeAuthorizationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OvertimeAuthorizationCreate) SetUpdatedAt(v time.Time) *OvertimeAuthorizationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OvertimeAuthorizationCreate) SetNillableUpdatedAt(v *time.Time) *OvertimeAuthorizationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *OvertimeAuthorizationCreate) SetUser(v *User) *OvertimeAuthorizationCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetRequestedBy sets the "requested_by" edge to the User entity.
+func (_c *OvertimeAuthorizationCreate) SetRequestedBy(v *User) *OvertimeAuthorizationCreate {
+	return _c.SetRequestedByID(v.ID)
+}
+
+// SetApprovedBy sets the "approved_by" edge to the User entity.
+func (_c *OvertimeAuthorizationCreate) SetApprovedBy(v *User) *OvertimeAuthorizationCreate {
+	return _c.SetApprovedByID(v.ID)
+}
+
+// Mutation returns the OvertimeAuthorizationMutation object of the builder.
+func (_c *OvertimeAuthorizationCreate) Mutation() *OvertimeAuthorizationMutation {
+	return _c.mutation
+}
+
+// Save creates the OvertimeAuthorization in the database.
+func (_c *OvertimeAuthorizationCreate) Save(ctx context.Context) (*OvertimeAuthorization, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OvertimeAuthorizationCreate) SaveX(ctx context.Context) *OvertimeAuthorization {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OvertimeAuthorizationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OvertimeAuthorizationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OvertimeAuthorizationCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := overtimeauthorization.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := overtimeauthorization.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := overtimeauthorization.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OvertimeAuthorizationCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "OvertimeAuthorization.user_id"`)}
+	}
+	if _, ok := _c.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "OvertimeAuthorization.date"`)}
+	}
+	if _, ok := _c.mutation.MaxMinutes(); !ok {
+		return &ValidationError{Name: "max_minutes", err: errors.New(`ent: missing required field "OvertimeAuthorization.max_minutes"`)}
+	}
+	if v, ok := _c.mutation.MaxMinutes(); ok {
+		if err := overtimeauthorization.MaxMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "max_minutes", err: fmt.Errorf(`ent: validator failed for field "OvertimeAuthorization.max_minutes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "OvertimeAuthorization.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := overtimeauthorization.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OvertimeAuthorization.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OvertimeAuthorization.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OvertimeAuthorization.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "OvertimeAuthorization.user"`)}
+	}
+	return nil
+}
+
+func (_c *OvertimeAuthorizationCreate) sqlSave(ctx context.Context) (*OvertimeAuthorization, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OvertimeAuthorizationCreate) createSpec() (*OvertimeAuthorization, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OvertimeAuthorization{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(overtimeauthorization.Table, sqlgraph.NewFieldSpec(overtimeauthorization.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Date(); ok {
+		_spec.SetField(overtimeauthorization.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	if value, ok := _c.mutation.MaxMinutes(); ok {
+		_spec.SetField(overtimeauthorization.FieldMaxMinutes, field.TypeInt, value)
+		_node.MaxMinutes = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(overtimeauthorization.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(overtimeauthorization.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	if value, ok := _c.mutation.ReviewedAt(); ok {
+		_spec.SetField(overtimeauthorization.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := _c.mutation.ReviewNotes(); ok {
+		_spec.SetField(overtimeauthorization.FieldReviewNotes, field.TypeString, value)
+		_node.ReviewNotes = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(overtimeauthorization.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(overtimeauthorization.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimeauthorization.UserTable,
+			Columns: []string{overtimeauthorization.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RequestedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   overtimeauthorization.RequestedByTable,
+			Columns: []string{overtimeauthorization.RequestedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RequestedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ApprovedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   overtimeauthorization.ApprovedByTable,
+			Columns: []string{overtimeauthorization.ApprovedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ApprovedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OvertimeAuthorizationCreateBulk is the builder for creating many OvertimeAuthorization entities in bulk.
+type OvertimeAuthorizationCreateBulk struct {
+	config
+	err      error
+	builders []*OvertimeAuthorizationCreate
+}
+
+// Save creates the OvertimeAuthorization entities in the database.
+func (_c *OvertimeAuthorizationCreateBulk) Save(ctx context.Context) ([]*OvertimeAuthorization, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OvertimeAuthorization, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OvertimeAuthorizationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OvertimeAuthorizationCreateBulk) SaveX(ctx context.Context) []*OvertimeAuthorization {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OvertimeAuthorizationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OvertimeAuthorizationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
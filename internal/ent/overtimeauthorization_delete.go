@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/overtimeauthorization"
+	"back/internal/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OvertimeAuthorizationDelete is the builder for deleting a OvertimeAuthorization entity.
+type OvertimeAuthorizationDelete struct {
+	config
+	hooks    []Hook
+	mutation *OvertimeAuthorizationMutation
+}
+
+// Where appends a list predicates to the OvertimeAuthorizationDelete builder.
+func (_d *OvertimeAuthorizationDelete) Where(ps ...predicate.OvertimeAuthorization) *OvertimeAuthorizationDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *OvertimeAuthorizationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *OvertimeAuthorizationDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *OvertimeAuthorizationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(overtimeauthorization.Table, sqlgraph.NewFieldSpec(overtimeauthorization.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// OvertimeAuthorizationDeleteOne is the builder for deleting a single OvertimeAuthorization entity.
+type OvertimeAuthorizationDeleteOne struct {
+	_d *OvertimeAuthorizationDelete
+}
+
+// Where appends a list predicates to the OvertimeAuthorizationDelete builder.
+func (_d *OvertimeAuthorizationDeleteOne) Where(ps ...predicate.OvertimeAuthorization) *OvertimeAuthorizationDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *OvertimeAuthorizationDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{overtimeauthorization.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *OvertimeAuthorizationDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
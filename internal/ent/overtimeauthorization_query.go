@@ -0,0 +1,760 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/overtimeauthorization"
+	"back/internal/ent/predicate"
+	"back/internal/ent/user"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OvertimeAuthorizationQuery is the builder for querying OvertimeAuthorization entities.
+type OvertimeAuthorizationQuery struct {
+	config
+	ctx             *QueryContext
+	order           []overtimeauthorization.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.OvertimeAuthorization
+	withUser        *UserQuery
+	withRequestedBy *UserQuery
+	withApprovedBy  *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OvertimeAuthorizationQuery builder.
+func (_q *OvertimeAuthorizationQuery) Where(ps ...predicate.OvertimeAuthorization) *OvertimeAuthorizationQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *OvertimeAuthorizationQuery) Limit(limit int) *OvertimeAuthorizationQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *OvertimeAuthorizationQuery) Offset(offset int) *OvertimeAuthorizationQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *OvertimeAuthorizationQuery) Unique(unique bool) *OvertimeAuthorizationQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *OvertimeAuthorizationQuery) Order(o ...overtimeauthorization.OrderOption) *OvertimeAuthorizationQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (_q *OvertimeAuthorizationQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(overtimeauthorization.Table, overtimeauthorization.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, overtimeauthorization.UserTable, overtimeauthorization.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRequestedBy chains the current query on the "requested_by" edge.
+func (_q *OvertimeAuthorizationQuery) QueryRequestedBy() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(overtimeauthorization.Table, overtimeauthorization.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, overtimeauthorization.RequestedByTable, overtimeauthorization.RequestedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryApprovedBy chains the current query on the "approved_by" edge.
+func (_q *OvertimeAuthorizationQuery) QueryApprovedBy() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(overtimeauthorization.Table, overtimeauthorization.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, overtimeauthorization.ApprovedByTable, overtimeauthorization.ApprovedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first OvertimeAuthorization entity from the query.
+// Returns a *NotFoundError when no OvertimeAuthorization was found.
+func (_q *OvertimeAuthorizationQuery) First(ctx context.Context) (*OvertimeAuthorization, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{overtimeauthorization.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *OvertimeAuthorizationQuery) FirstX(ctx context.Context) *OvertimeAuthorization {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OvertimeAuthorization ID from the query.
+// Returns a *NotFoundError when no OvertimeAuthorization ID was found.
+func (_q *OvertimeAuthorizationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{overtimeauthorization.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *OvertimeAuthorizationQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OvertimeAuthorization entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OvertimeAuthorization entity is found.
+// Returns a *NotFoundError when no OvertimeAuthorization entities are found.
+func (_q *OvertimeAuthorizationQuery) Only(ctx context.Context) (*OvertimeAuthorization, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{overtimeauthorization.Label}
+	default:
+		return nil, &NotSingularError{overtimeauthorization.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *OvertimeAuthorizationQuery) OnlyX(ctx context.Context) *OvertimeAuthorization {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OvertimeAuthorization ID in the query.
+// Returns a *NotSingularError when more than one OvertimeAuthorization ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *OvertimeAuthorizationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{overtimeauthorization.Label}
+	default:
+		err = &NotSingularError{overtimeauthorization.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *OvertimeAuthorizationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OvertimeAuthorizations.
+func (_q *OvertimeAuthorizationQuery) All(ctx context.Context) ([]*OvertimeAuthorization, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OvertimeAuthorization, *OvertimeAuthorizationQuery]()
+	return withInterceptors[[]*OvertimeAuthorization](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *OvertimeAuthorizationQuery) AllX(ctx context.Context) []*OvertimeAuthorization {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OvertimeAuthorization IDs.
+func (_q *OvertimeAuthorizationQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(overtimeauthorization.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *OvertimeAuthorizationQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *OvertimeAuthorizationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*OvertimeAuthorizationQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *OvertimeAuthorizationQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *OvertimeAuthorizationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *OvertimeAuthorizationQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OvertimeAuthorizationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *OvertimeAuthorizationQuery) Clone() *OvertimeAuthorizationQuery {
+	if _q == nil {
+		return nil
+	}
+	return &OvertimeAuthorizationQuery{
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]overtimeauthorization.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.OvertimeAuthorization{}, _q.predicates...),
+		withUser:        _q.withUser.Clone(),
+		withRequestedBy: _q.withRequestedBy.Clone(),
+		withApprovedBy:  _q.withApprovedBy.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OvertimeAuthorizationQuery) WithUser(opts ...func(*UserQuery)) *OvertimeAuthorizationQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUser = query
+	return _q
+}
+
+// WithRequestedBy tells the query-builder to eager-load the nodes that are connected to
+// the "requested_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OvertimeAuthorizationQuery) WithRequestedBy(opts ...func(*UserQuery)) *OvertimeAuthorizationQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRequestedBy = query
+	return _q
+}
+
+// WithApprovedBy tells the query-builder to eager-load the nodes that are connected to
+// the "approved_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OvertimeAuthorizationQuery) WithApprovedBy(opts ...func(*UserQuery)) *OvertimeAuthorizationQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withApprovedBy = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OvertimeAuthorization.Query().
+//		GroupBy(overtimeauthorization.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *OvertimeAuthorizationQuery) GroupBy(field string, fields ...string) *OvertimeAuthorizationGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OvertimeAuthorizationGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = overtimeauthorization.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//	}
+//
+//	client.OvertimeAuthorization.Query().
+//		Select(overtimeauthorization.FieldUserID).
+//		Scan(ctx, &v)
+func (_q *OvertimeAuthorizationQuery) Select(fields ...string) *OvertimeAuthorizationSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &OvertimeAuthorizationSelect{OvertimeAuthorizationQuery: _q}
+	sbuild.label = overtimeauthorization.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OvertimeAuthorizationSelect configured with the given aggregations.
+func (_q *OvertimeAuthorizationQuery) Aggregate(fns ...AggregateFunc) *OvertimeAuthorizationSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *OvertimeAuthorizationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !overtimeauthorization.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *OvertimeAuthorizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OvertimeAuthorization, error) {
+	var (
+		nodes       = []*OvertimeAuthorization{}
+		_spec       = _q.querySpec()
+		loadedTypes = [3]bool{
+			_q.withUser != nil,
+			_q.withRequestedBy != nil,
+			_q.withApprovedBy != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OvertimeAuthorization).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OvertimeAuthorization{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withUser; query != nil {
+		if err := _q.loadUser(ctx, query, nodes, nil,
+			func(n *OvertimeAuthorization, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRequestedBy; query != nil {
+		if err := _q.loadRequestedBy(ctx, query, nodes, nil,
+			func(n *OvertimeAuthorization, e *User) { n.Edges.RequestedBy = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withApprovedBy; query != nil {
+		if err := _q.loadApprovedBy(ctx, query, nodes, nil,
+			func(n *OvertimeAuthorization, e *User) { n.Edges.ApprovedBy = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *OvertimeAuthorizationQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*OvertimeAuthorization, init func(*OvertimeAuthorization), assign func(*OvertimeAuthorization, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*OvertimeAuthorization)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *OvertimeAuthorizationQuery) loadRequestedBy(ctx context.Context, query *UserQuery, nodes []*OvertimeAuthorization, init func(*OvertimeAuthorization), assign func(*OvertimeAuthorization, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*OvertimeAuthorization)
+	for i := range nodes {
+		if nodes[i].RequestedByID == nil {
+			continue
+		}
+		fk := *nodes[i].RequestedByID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "requested_by_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *OvertimeAuthorizationQuery) loadApprovedBy(ctx context.Context, query *UserQuery, nodes []*OvertimeAuthorization, init func(*OvertimeAuthorization), assign func(*OvertimeAuthorization, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*OvertimeAuthorization)
+	for i := range nodes {
+		if nodes[i].ApprovedByID == nil {
+			continue
+		}
+		fk := *nodes[i].ApprovedByID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "approved_by_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *OvertimeAuthorizationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *OvertimeAuthorizationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(overtimeauthorization.Table, overtimeauthorization.Columns, sqlgraph.NewFieldSpec(overtimeauthorization.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, overtimeauthorization.FieldID)
+		for i := range fields {
+			if fields[i] != overtimeauthorization.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withUser != nil {
+			_spec.Node.AddColumnOnce(overtimeauthorization.FieldUserID)
+		}
+		if _q.withRequestedBy != nil {
+			_spec.Node.AddColumnOnce(overtimeauthorization.FieldRequestedByID)
+		}
+		if _q.withApprovedBy != nil {
+			_spec.Node.AddColumnOnce(overtimeauthorization.FieldApprovedByID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *OvertimeAuthorizationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(overtimeauthorization.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = overtimeauthorization.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// OvertimeAuthorizationGroupBy is the group-by builder for OvertimeAuthorization entities.
+type OvertimeAuthorizationGroupBy struct {
+	selector
+	build *OvertimeAuthorizationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *OvertimeAuthorizationGroupBy) Aggregate(fns ...AggregateFunc) *OvertimeAuthorizationGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *OvertimeAuthorizationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OvertimeAuthorizationQuery, *OvertimeAuthorizationGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *OvertimeAuthorizationGroupBy) sqlScan(ctx context.Context, root *OvertimeAuthorizationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OvertimeAuthorizationSelect is the builder for selecting fields of OvertimeAuthorization entities.
+type OvertimeAuthorizationSelect struct {
+	*OvertimeAuthorizationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *OvertimeAuthorizationSelect) Aggregate(fns ...AggregateFunc) *OvertimeAuthorizationSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *OvertimeAuthorizationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OvertimeAuthorizationQuery, *OvertimeAuthorizationSelect](ctx, _s.OvertimeAuthorizationQuery, _s, _s.inters, v)
+}
+
+func (_s *OvertimeAuthorizationSelect) sqlScan(ctx context.Context, root *OvertimeAuthorizationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,881 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/overtimeauthorization"
+	"back/internal/ent/predicate"
+	"back/internal/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OvertimeAuthorizationUpdate is the builder for updating OvertimeAuthorization entities.
+type OvertimeAuthorizationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OvertimeAuthorizationMutation
+}
+
+// Where appends a list predicates to the OvertimeAuthorizationUpdate builder.
+func (_u *OvertimeAuthorizationUpdate) Where(ps ...predicate.OvertimeAuthorization) *OvertimeAuthorizationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *OvertimeAuthorizationUpdate) SetUserID(v int) *OvertimeAuthorizationUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *OvertimeAuthorizationUpdate) SetNillableUserID(v *int) *OvertimeAuthorizationUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *OvertimeAuthorizationUpdate) SetDate(v time.Time) *OvertimeAuthorizationUpdate {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *OvertimeAuthorizationUpdate) SetNillableDate(v *time.Time) *OvertimeAuthorizationUpdate {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetMaxMinutes sets the "max_minutes" field.
+func (_u *OvertimeAuthorizationUpdate) SetMaxMinutes(v int) *OvertimeAuthorizationUpdate {
+	_u.mutation.ResetMaxMinutes()
+	_u.mutation.SetMaxMinutes(v)
+	return _u
+}
+
+// SetNillableMaxMinutes sets the "max_minutes" field if the given value is not nil.
+func (_u *OvertimeAuthorizationUpdate) SetNillableMaxMinutes(v *int) *OvertimeAuthorizationUpdate {
+	if v != nil {
+		_u.SetMaxMinutes(*v)
+	}
+	return _u
+}
+
+// AddMaxMinutes adds value to the "max_minutes" field.
+func (_u *OvertimeAuthorizationUpdate) AddMaxMinutes(v int) *OvertimeAuthorizationUpdate {
+	_u.mutation.AddMaxMinutes(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OvertimeAuthorizationUpdate) SetStatus(v string) *OvertimeAuthorizationUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OvertimeAuthorizationUpdate) SetNillableStatus(v *string) *OvertimeAuthorizationUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *OvertimeAuthorizationUpdate) SetReason(v string) *OvertimeAuthorizationUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *OvertimeAuthorizationUpdate) SetNillableReason(v *string) *OvertimeAuthorizationUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *OvertimeAuthorizationUpdate) ClearReason() *OvertimeAuthorizationUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetRequestedByID sets the "requested_by_id" field.
+func (_u *OvertimeAuthorizationUpdate) SetRequestedByID(v int) *OvertimeAuthorizationUpdate {
+	_u.mutation.SetRequestedByID(v)
+	return _u
+}
+
+// SetNillableRequestedByID sets the "requested_by_id" field if the given value is not nil.
+func (_u *OvertimeAuthorizationUpdate) SetNillableRequestedByID(v *int) *OvertimeAuthorizationUpdate {
+	if v != nil {
+		_u.SetRequestedByID(*v)
+	}
+	return _u
+}
+
+// ClearRequestedByID clears the value of the "requested_by_id" field.
+func (_u *OvertimeAuthorizationUpdate) ClearRequestedByID() *OvertimeAuthorizationUpdate {
+	_u.mutation.ClearRequestedByID()
+	return _u
+}
+
+// SetApprovedByID sets the "approved_by_id" field.
+func (_u *OvertimeAuthorizationUpdate) SetApprovedByID(v int) *OvertimeAuthorizationUpdate {
+	_u.mutation.SetApprovedByID(v)
+	return _u
+}
+
+// SetNillableApprovedByID sets the "approved_by_id" field if the given value is not nil.
+func (_u *OvertimeAuthorizationUpdate) SetNillableApprovedByID(v *int) *OvertimeAuthorizationUpdate {
+	if v != nil {
+		_u.SetApprovedByID(*v)
+	}
+	return _u
+}
+
+// ClearApprovedByID clears the value of the "approved_by_id" field.
+func (_u *OvertimeAuthorizationUpdate) ClearApprovedByID() *OvertimeAuthorizationUpdate {
+	_u.mutation.ClearApprovedByID()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *OvertimeAuthorizationUpdate) SetReviewedAt(v time.Time) *OvertimeAuthorizationUpdate {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *OvertimeAuthorizationUpdate) SetNillableReviewedAt(v *time.Time) *OvertimeAuthorizationUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *OvertimeAuthorizationUpdate) ClearReviewedAt() *OvertimeAuthorizationUpdate {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (_u *OvertimeAuthorizationUpdate) SetReviewNotes(v string) *OvertimeAuthorizationUpdate {
+	_u.mutation.SetReviewNotes(v)
+	return _u
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (_u *OvertimeAuthorizationUpdate) SetNillableReviewNotes(v *string) *OvertimeAuthorizationUpdate {
+	if v != nil {
+		_u.SetReviewNotes(*v)
+	}
+	return _u
+}
+
+// ClearReviewNotes clears the value of the "review_notes" field.
+func (_u *OvertimeAuthorizationUpdate) ClearReviewNotes() *OvertimeAuthorizationUpdate {
+	_u.mutation.ClearReviewNotes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OvertimeAuthorizationUpdate) SetUpdatedAt(v time.Time) *OvertimeAuthorizationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *OvertimeAuthorizationUpdate) SetUser(v *User) *OvertimeAuthorizationUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetRequestedBy sets the "requested_by" edge to the User entity.
+func (_u *OvertimeAuthorizationUpdate) SetRequestedBy(v *User) *OvertimeAuthorizationUpdate {
+	return _u.SetRequestedByID(v.ID)
+}
+
+// SetApprovedBy sets the "approved_by" edge to the User entity.
+func (_u *OvertimeAuthorizationUpdate) SetApprovedBy(v *User) *OvertimeAuthorizationUpdate {
+	return _u.SetApprovedByID(v.ID)
+}
+
+// Mutation returns the OvertimeAuthorizationMutation object of the builder.
+func (_u *OvertimeAuthorizationUpdate) Mutation() *OvertimeAuthorizationMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *OvertimeAuthorizationUpdate) ClearUser() *OvertimeAuthorizationUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearRequestedBy clears the "requested_by" edge to the User entity.
+func (_u *OvertimeAuthorizationUpdate) ClearRequestedBy() *OvertimeAuthorizationUpdate {
+	_u.mutation.ClearRequestedBy()
+	return _u
+}
+
+// ClearApprovedBy clears the "approved_by" edge to the User entity.
+func (_u *OvertimeAuthorizationUpdate) ClearApprovedBy() *OvertimeAuthorizationUpdate {
+	_u.mutation.ClearApprovedBy()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OvertimeAuthorizationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OvertimeAuthorizationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OvertimeAuthorizationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OvertimeAuthorizationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OvertimeAuthorizationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := overtimeauthorization.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OvertimeAuthorizationUpdate) check() error {
+	if v, ok := _u.mutation.MaxMinutes(); ok {
+		if err := overtimeauthorization.MaxMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "max_minutes", err: fmt.Errorf(`ent: validator failed for field "OvertimeAuthorization.max_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := overtimeauthorization.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OvertimeAuthorization.status": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OvertimeAuthorization.user"`)
+	}
+	return nil
+}
+
+func (_u *OvertimeAuthorizationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(overtimeauthorization.Table, overtimeauthorization.Columns, sqlgraph.NewFieldSpec(overtimeauthorization.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(overtimeauthorization.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.MaxMinutes(); ok {
+		_spec.SetField(overtimeauthorization.FieldMaxMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxMinutes(); ok {
+		_spec.AddField(overtimeauthorization.FieldMaxMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(overtimeauthorization.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(overtimeauthorization.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(overtimeauthorization.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(overtimeauthorization.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(overtimeauthorization.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReviewNotes(); ok {
+		_spec.SetField(overtimeauthorization.FieldReviewNotes, field.TypeString, value)
+	}
+	if _u.mutation.ReviewNotesCleared() {
+		_spec.ClearField(overtimeauthorization.FieldReviewNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(overtimeauthorization.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimeauthorization.UserTable,
+			Columns: []string{overtimeauthorization.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimeauthorization.UserTable,
+			Columns: []string{overtimeauthorization.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RequestedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   overtimeauthorization.RequestedByTable,
+			Columns: []string{overtimeauthorization.RequestedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RequestedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   overtimeauthorization.RequestedByTable,
+			Columns: []string{overtimeauthorization.RequestedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ApprovedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   overtimeauthorization.ApprovedByTable,
+			Columns: []string{overtimeauthorization.ApprovedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ApprovedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   overtimeauthorization.ApprovedByTable,
+			Columns: []string{overtimeauthorization.ApprovedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{overtimeauthorization.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OvertimeAuthorizationUpdateOne is the builder for updating a single OvertimeAuthorization entity.
+type OvertimeAuthorizationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OvertimeAuthorizationMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *OvertimeAuthorizationUpdateOne) SetUserID(v int) *OvertimeAuthorizationUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *OvertimeAuthorizationUpdateOne) SetNillableUserID(v *int) *OvertimeAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *OvertimeAuthorizationUpdateOne) SetDate(v time.Time) *OvertimeAuthorizationUpdateOne {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *OvertimeAuthorizationUpdateOne) SetNillableDate(v *time.Time) *OvertimeAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetMaxMinutes sets the "max_minutes" field.
+func (_u *OvertimeAuthorizationUpdateOne) SetMaxMinutes(v int) *OvertimeAuthorizationUpdateOne {
+	_u.mutation.ResetMaxMinutes()
+	_u.mutation.SetMaxMinutes(v)
+	return _u
+}
+
+// SetNillableMaxMinutes sets the "max_minutes" field if the given value is not nil.
+func (_u *OvertimeAuthorizationUpdateOne) SetNillableMaxMinutes(v *int) *OvertimeAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetMaxMinutes(*v)
+	}
+	return _u
+}
+
+// AddMaxMinutes adds value to the "max_minutes" field.
+func (_u *OvertimeAuthorizationUpdateOne) AddMaxMinutes(v int) *OvertimeAuthorizationUpdateOne {
+	_u.mutation.AddMaxMinutes(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OvertimeAuthorizationUpdateOne) SetStatus(v string) *OvertimeAuthorizationUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OvertimeAuthorizationUpdateOne) SetNillableStatus(v *string) *OvertimeAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *OvertimeAuthorizationUpdateOne) SetReason(v string) *OvertimeAuthorizationUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *OvertimeAuthorizationUpdateOne) SetNillableReason(v *string) *OvertimeAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *OvertimeAuthorizationUpdateOne) ClearReason() *OvertimeAuthorizationUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetRequestedByID sets the "requested_by_id" field.
+func (_u *OvertimeAuthorizationUpdateOne) SetRequestedByID(v int) *OvertimeAuthorizationUpdateOne {
+	_u.mutation.SetRequestedByID(v)
+	return _u
+}
+
+// SetNillableRequestedByID sets the "requested_by_id" field if the given value is not nil.
+func (_u *OvertimeAuthorizationUpdateOne) SetNillableRequestedByID(v *int) *OvertimeAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetRequestedByID(*v)
+	}
+	return _u
+}
+
+// ClearRequestedByID clears the value of the "requested_by_id" field.
+func (_u *OvertimeAuthorizationUpdateOne) ClearRequestedByID() *OvertimeAuthorizationUpdateOne {
+	_u.mutation.ClearRequestedByID()
+	return _u
+}
+
+// SetApprovedByID sets the "approved_by_id" field.
+func (_u *OvertimeAuthorizationUpdateOne) SetApprovedByID(v int) *OvertimeAuthorizationUpdateOne {
+	_u.mutation.SetApprovedByID(v)
+	return _u
+}
+
+// SetNillableApprovedByID sets the "approved_by_id" field if the given value is not nil.
+func (_u *OvertimeAuthorizationUpdateOne) SetNillableApprovedByID(v *int) *OvertimeAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetApprovedByID(*v)
+	}
+	return _u
+}
+
+// ClearApprovedByID clears the value of the "approved_by_id" field.
+func (_u *OvertimeAuthorizationUpdateOne) ClearApprovedByID() *OvertimeAuthorizationUpdateOne {
+	_u.mutation.ClearApprovedByID()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *OvertimeAuthorizationUpdateOne) SetReviewedAt(v time.Time) *OvertimeAuthorizationUpdateOne {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *OvertimeAuthorizationUpdateOne) SetNillableReviewedAt(v *time.Time) *OvertimeAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *OvertimeAuthorizationUpdateOne) ClearReviewedAt() *OvertimeAuthorizationUpdateOne {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (_u *OvertimeAuthorizationUpdateOne) SetReviewNotes(v string) *OvertimeAuthorizationUpdateOne {
+	_u.mutation.SetReviewNotes(v)
+	return _u
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (_u *OvertimeAuthorizationUpdateOne) SetNillableReviewNotes(v *string) *OvertimeAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetReviewNotes(*v)
+	}
+	return _u
+}
+
+// ClearReviewNotes clears the value of the "review_notes" field.
+func (_u *OvertimeAuthorizationUpdateOne) ClearReviewNotes() *OvertimeAuthorizationUpdateOne {
+	_u.mutation.ClearReviewNotes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OvertimeAuthorizationUpdateOne) SetUpdatedAt(v time.Time) *OvertimeAuthorizationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *OvertimeAuthorizationUpdateOne) SetUser(v *User) *OvertimeAuthorizationUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetRequestedBy sets the "requested_by" edge to the User entity.
+func (_u *OvertimeAuthorizationUpdateOne) SetRequestedBy(v *User) *OvertimeAuthorizationUpdateOne {
+	return _u.SetRequestedByID(v.ID)
+}
+
+// SetApprovedBy sets the "approved_by" edge to the User entity.
+func (_u *OvertimeAuthorizationUpdateOne) SetApprovedBy(v *User) *OvertimeAuthorizationUpdateOne {
+	return _u.SetApprovedByID(v.ID)
+}
+
+// Mutation returns the OvertimeAuthorizationMutation object of the builder.
+func (_u *OvertimeAuthorizationUpdateOne) Mutation() *OvertimeAuthorizationMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *OvertimeAuthorizationUpdateOne) ClearUser() *OvertimeAuthorizationUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearRequestedBy clears the "requested_by" edge to the User entity.
+func (_u *OvertimeAuthorizationUpdateOne) ClearRequestedBy() *OvertimeAuthorizationUpdateOne {
+	_u.mutation.ClearRequestedBy()
+	return _u
+}
+
+// ClearApprovedBy clears the "approved_by" edge to the User entity.
+func (_u *OvertimeAuthorizationUpdateOne) ClearApprovedBy() *OvertimeAuthorizationUpdateOne {
+	_u.mutation.ClearApprovedBy()
+	return _u
+}
+
+// Where appends a list predicates to the OvertimeAuthorizationUpdate builder.
+func (_u *OvertimeAuthorizationUpdateOne) Where(ps ...predicate.OvertimeAuthorization) *OvertimeAuthorizationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OvertimeAuthorizationUpdateOne) Select(field string, fields ...string) *OvertimeAuthorizationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OvertimeAuthorization entity.
+func (_u *OvertimeAuthorizationUpdateOne) Save(ctx context.Context) (*OvertimeAuthorization, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OvertimeAuthorizationUpdateOne) SaveX(ctx context.Context) *OvertimeAuthorization {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OvertimeAuthorizationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OvertimeAuthorizationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OvertimeAuthorizationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := overtimeauthorization.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OvertimeAuthorizationUpdateOne) check() error {
+	if v, ok := _u.mutation.MaxMinutes(); ok {
+		if err := overtimeauthorization.MaxMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "max_minutes", err: fmt.Errorf(`ent: validator failed for field "OvertimeAuthorization.max_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := overtimeauthorization.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OvertimeAuthorization.status": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OvertimeAuthorization.user"`)
+	}
+	return nil
+}
+
+func (_u *OvertimeAuthorizationUpdateOne) sqlSave(ctx context.Context) (_node *OvertimeAuthorization, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(overtimeauthorization.Table, overtimeauthorization.Columns, sqlgraph.NewFieldSpec(overtimeauthorization.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OvertimeAuthorization.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, overtimeauthorization.FieldID)
+		for _, f := range fields {
+			if !overtimeauthorization.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != overtimeauthorization.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(overtimeauthorization.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.MaxMinutes(); ok {
+		_spec.SetField(overtimeauthorization.FieldMaxMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxMinutes(); ok {
+		_spec.AddField(overtimeauthorization.FieldMaxMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(overtimeauthorization.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(overtimeauthorization.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(overtimeauthorization.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(overtimeauthorization.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(overtimeauthorization.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReviewNotes(); ok {
+		_spec.SetField(overtimeauthorization.FieldReviewNotes, field.TypeString, value)
+	}
+	if _u.mutation.ReviewNotesCleared() {
+		_spec.ClearField(overtimeauthorization.FieldReviewNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(overtimeauthorization.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimeauthorization.UserTable,
+			Columns: []string{overtimeauthorization.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimeauthorization.UserTable,
+			Columns: []string{overtimeauthorization.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RequestedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   overtimeauthorization.RequestedByTable,
+			Columns: []string{overtimeauthorization.RequestedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RequestedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   overtimeauthorization.RequestedByTable,
+			Columns: []string{overtimeauthorization.RequestedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ApprovedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   overtimeauthorization.ApprovedByTable,
+			Columns: []string{overtimeauthorization.ApprovedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ApprovedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   overtimeauthorization.ApprovedByTable,
+			Columns: []string{overtimeauthorization.ApprovedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OvertimeAuthorization{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{overtimeauthorization.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/payrollexportprofile"
+	"back/internal/ent/schema"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PayrollExportProfile is the model entity for the PayrollExportProfile schema.
+type PayrollExportProfile struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// Separator holds the value of the "separator" field.
+	Separator string `json:"separator,omitempty"`
+	// DateFormat holds the value of the "date_format" field.
+	DateFormat string `json:"date_format,omitempty"`
+	// DecimalSeparator holds the value of the "decimal_separator" field.
+	DecimalSeparator string `json:"decimal_separator,omitempty"`
+	// Period holds the value of the "period" field.
+	Period string `json:"period,omitempty"`
+	// IncludeHeader holds the value of the "include_header" field.
+	IncludeHeader bool `json:"include_header,omitempty"`
+	// Columns holds the value of the "columns" field.
+	Columns []schema.PayrollColumn `json:"columns,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PayrollExportProfile) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case payrollexportprofile.FieldColumns:
+			values[i] = new([]byte)
+		case payrollexportprofile.FieldIncludeHeader:
+			values[i] = new(sql.NullBool)
+		case payrollexportprofile.FieldID:
+			values[i] = new(sql.NullInt64)
+		case payrollexportprofile.FieldName, payrollexportprofile.FieldDescription, payrollexportprofile.FieldSeparator, payrollexportprofile.FieldDateFormat, payrollexportprofile.FieldDecimalSeparator, payrollexportprofile.FieldPeriod:
+			values[i] = new(sql.NullString)
+		case payrollexportprofile.FieldCreatedAt, payrollexportprofile.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PayrollExportProfile fields.
+func (_m *PayrollExportProfile) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case payrollexportprofile.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case payrollexportprofile.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case payrollexportprofile.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
+		case payrollexportprofile.FieldSeparator:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field separator", values[i])
+			} else if value.Valid {
+				_m.Separator = value.String
+			}
+		case payrollexportprofile.FieldDateFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field date_format", values[i])
+			} else if value.Valid {
+				_m.DateFormat = value.String
+			}
+		case payrollexportprofile.FieldDecimalSeparator:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field decimal_separator", values[i])
+			} else if value.Valid {
+				_m.DecimalSeparator = value.String
+			}
+		case payrollexportprofile.FieldPeriod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field period", values[i])
+			} else if value.Valid {
+				_m.Period = value.String
+			}
+		case payrollexportprofile.FieldIncludeHeader:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field include_header", values[i])
+			} else if value.Valid {
+				_m.IncludeHeader = value.Bool
+			}
+		case payrollexportprofile.FieldColumns:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field columns", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Columns); err != nil {
+					return fmt.Errorf("unmarshal field columns: %w", err)
+				}
+			}
+		case payrollexportprofile.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case payrollexportprofile.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PayrollExportProfile.
+// This includes values selected through modifiers, order, etc.
+func (_m *PayrollExportProfile) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PayrollExportProfile.
+// Note that you need to call PayrollExportProfile.Unwrap() before calling this method if this PayrollExportProfile
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PayrollExportProfile) Update() *PayrollExportProfileUpdateOne {
+	return NewPayrollExportProfileClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PayrollExportProfile entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PayrollExportProfile) Unwrap() *PayrollExportProfile {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PayrollExportProfile is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PayrollExportProfile) String() string {
+	var builder strings.Builder
+	builder.WriteString("PayrollExportProfile(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("separator=")
+	builder.WriteString(_m.Separator)
+	builder.WriteString(", ")
+	builder.WriteString("date_format=")
+	builder.WriteString(_m.DateFormat)
+	builder.WriteString(", ")
+	builder.WriteString("decimal_separator=")
+	builder.WriteString(_m.DecimalSeparator)
+	builder.WriteString(", ")
+	builder.WriteString("period=")
+	builder.WriteString(_m.Period)
+	builder.WriteString(", ")
+	builder.WriteString("include_header=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IncludeHeader))
+	builder.WriteString(", ")
+	builder.WriteString("columns=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Columns))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PayrollExportProfiles is a parsable slice of PayrollExportProfile.
+type PayrollExportProfiles []*PayrollExportProfile
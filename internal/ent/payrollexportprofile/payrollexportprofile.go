@@ -0,0 +1,137 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payrollexportprofile
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the payrollexportprofile type in the database.
+	Label = "payroll_export_profile"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldSeparator holds the string denoting the separator field in the database.
+	FieldSeparator = "separator"
+	// FieldDateFormat holds the string denoting the date_format field in the database.
+	FieldDateFormat = "date_format"
+	// FieldDecimalSeparator holds the string denoting the decimal_separator field in the database.
+	FieldDecimalSeparator = "decimal_separator"
+	// FieldPeriod holds the string denoting the period field in the database.
+	FieldPeriod = "period"
+	// FieldIncludeHeader holds the string denoting the include_header field in the database.
+	FieldIncludeHeader = "include_header"
+	// FieldColumns holds the string denoting the columns field in the database.
+	FieldColumns = "columns"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the payrollexportprofile in the database.
+	Table = "payroll_export_profiles"
+)
+
+// Columns holds all SQL columns for payrollexportprofile fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldDescription,
+	FieldSeparator,
+	FieldDateFormat,
+	FieldDecimalSeparator,
+	FieldPeriod,
+	FieldIncludeHeader,
+	FieldColumns,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultSeparator holds the default value on creation for the "separator" field.
+	DefaultSeparator string
+	// DefaultDateFormat holds the default value on creation for the "date_format" field.
+	DefaultDateFormat string
+	// DefaultDecimalSeparator holds the default value on creation for the "decimal_separator" field.
+	DefaultDecimalSeparator string
+	// DefaultPeriod holds the default value on creation for the "period" field.
+	DefaultPeriod string
+	// DefaultIncludeHeader holds the default value on creation for the "include_header" field.
+	DefaultIncludeHeader bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the PayrollExportProfile queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// BySeparator orders the results by the separator field.
+func BySeparator(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeparator, opts...).ToFunc()
+}
+
+// ByDateFormat orders the results by the date_format field.
+func ByDateFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDateFormat, opts...).ToFunc()
+}
+
+// ByDecimalSeparator orders the results by the decimal_separator field.
+func ByDecimalSeparator(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDecimalSeparator, opts...).ToFunc()
+}
+
+// ByPeriod orders the results by the period field.
+func ByPeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriod, opts...).ToFunc()
+}
+
+// ByIncludeHeader orders the results by the include_header field.
+func ByIncludeHeader(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncludeHeader, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payrollexportprofile
+
+import (
+	"back/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldDescription, v))
+}
+
+// Separator applies equality check predicate on the "separator" field. It's identical to SeparatorEQ.
+func Separator(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldSeparator, v))
+}
+
+// DateFormat applies equality check predicate on the "date_format" field. It's identical to DateFormatEQ.
+func DateFormat(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldDateFormat, v))
+}
+
+// DecimalSeparator applies equality check predicate on the "decimal_separator" field. It's identical to DecimalSeparatorEQ.
+func DecimalSeparator(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldDecimalSeparator, v))
+}
+
+// Period applies equality check predicate on the "period" field. It's identical to PeriodEQ.
+func Period(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldPeriod, v))
+}
+
+// IncludeHeader applies equality check predicate on the "include_header" field. It's identical to IncludeHeaderEQ.
+func IncludeHeader(v bool) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldIncludeHeader, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// SeparatorEQ applies the EQ predicate on the "separator" field.
+func SeparatorEQ(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldSeparator, v))
+}
+
+// SeparatorNEQ applies the NEQ predicate on the "separator" field.
+func SeparatorNEQ(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNEQ(FieldSeparator, v))
+}
+
+// SeparatorIn applies the In predicate on the "separator" field.
+func SeparatorIn(vs ...string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldIn(FieldSeparator, vs...))
+}
+
+// SeparatorNotIn applies the NotIn predicate on the "separator" field.
+func SeparatorNotIn(vs ...string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNotIn(FieldSeparator, vs...))
+}
+
+// SeparatorGT applies the GT predicate on the "separator" field.
+func SeparatorGT(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldGT(FieldSeparator, v))
+}
+
+// SeparatorGTE applies the GTE predicate on the "separator" field.
+func SeparatorGTE(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldGTE(FieldSeparator, v))
+}
+
+// SeparatorLT applies the LT predicate on the "separator" field.
+func SeparatorLT(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldLT(FieldSeparator, v))
+}
+
+// SeparatorLTE applies the LTE predicate on the "separator" field.
+func SeparatorLTE(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldLTE(FieldSeparator, v))
+}
+
+// SeparatorContains applies the Contains predicate on the "separator" field.
+func SeparatorContains(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldContains(FieldSeparator, v))
+}
+
+// SeparatorHasPrefix applies the HasPrefix predicate on the "separator" field.
+func SeparatorHasPrefix(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldHasPrefix(FieldSeparator, v))
+}
+
+// SeparatorHasSuffix applies the HasSuffix predicate on the "separator" field.
+func SeparatorHasSuffix(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldHasSuffix(FieldSeparator, v))
+}
+
+// SeparatorEqualFold applies the EqualFold predicate on the "separator" field.
+func SeparatorEqualFold(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEqualFold(FieldSeparator, v))
+}
+
+// SeparatorContainsFold applies the ContainsFold predicate on the "separator" field.
+func SeparatorContainsFold(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldContainsFold(FieldSeparator, v))
+}
+
+// DateFormatEQ applies the EQ predicate on the "date_format" field.
+func DateFormatEQ(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldDateFormat, v))
+}
+
+// DateFormatNEQ applies the NEQ predicate on the "date_format" field.
+func DateFormatNEQ(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNEQ(FieldDateFormat, v))
+}
+
+// DateFormatIn applies the In predicate on the "date_format" field.
+func DateFormatIn(vs ...string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldIn(FieldDateFormat, vs...))
+}
+
+// DateFormatNotIn applies the NotIn predicate on the "date_format" field.
+func DateFormatNotIn(vs ...string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNotIn(FieldDateFormat, vs...))
+}
+
+// DateFormatGT applies the GT predicate on the "date_format" field.
+func DateFormatGT(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldGT(FieldDateFormat, v))
+}
+
+// DateFormatGTE applies the GTE predicate on the "date_format" field.
+func DateFormatGTE(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldGTE(FieldDateFormat, v))
+}
+
+// DateFormatLT applies the LT predicate on the "date_format" field.
+func DateFormatLT(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldLT(FieldDateFormat, v))
+}
+
+// DateFormatLTE applies the LTE predicate on the "date_format" field.
+func DateFormatLTE(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldLTE(FieldDateFormat, v))
+}
+
+// DateFormatContains applies the Contains predicate on the "date_format" field.
+func DateFormatContains(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldContains(FieldDateFormat, v))
+}
+
+// DateFormatHasPrefix applies the HasPrefix predicate on the "date_format" field.
+func DateFormatHasPrefix(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldHasPrefix(FieldDateFormat, v))
+}
+
+// DateFormatHasSuffix applies the HasSuffix predicate on the "date_format" field.
+func DateFormatHasSuffix(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldHasSuffix(FieldDateFormat, v))
+}
+
+// DateFormatEqualFold applies the EqualFold predicate on the "date_format" field.
+func DateFormatEqualFold(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEqualFold(FieldDateFormat, v))
+}
+
+// DateFormatContainsFold applies the ContainsFold predicate on the "date_format" field.
+func DateFormatContainsFold(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldContainsFold(FieldDateFormat, v))
+}
+
+// DecimalSeparatorEQ applies the EQ predicate on the "decimal_separator" field.
+func DecimalSeparatorEQ(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldDecimalSeparator, v))
+}
+
+// DecimalSeparatorNEQ applies the NEQ predicate on the "decimal_separator" field.
+func DecimalSeparatorNEQ(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNEQ(FieldDecimalSeparator, v))
+}
+
+// DecimalSeparatorIn applies the In predicate on the "decimal_separator" field.
+func DecimalSeparatorIn(vs ...string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldIn(FieldDecimalSeparator, vs...))
+}
+
+// DecimalSeparatorNotIn applies the NotIn predicate on the "decimal_separator" field.
+func DecimalSeparatorNotIn(vs ...string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNotIn(FieldDecimalSeparator, vs...))
+}
+
+// DecimalSeparatorGT applies the GT predicate on the "decimal_separator" field.
+func DecimalSeparatorGT(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldGT(FieldDecimalSeparator, v))
+}
+
+// DecimalSeparatorGTE applies the GTE predicate on the "decimal_separator" field.
+func DecimalSeparatorGTE(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldGTE(FieldDecimalSeparator, v))
+}
+
+// DecimalSeparatorLT applies the LT predicate on the "decimal_separator" field.
+func DecimalSeparatorLT(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldLT(FieldDecimalSeparator, v))
+}
+
+// DecimalSeparatorLTE applies the LTE predicate on the "decimal_separator" field.
+func DecimalSeparatorLTE(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldLTE(FieldDecimalSeparator, v))
+}
+
+// DecimalSeparatorContains applies the Contains predicate on the "decimal_separator" field.
+func DecimalSeparatorContains(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldContains(FieldDecimalSeparator, v))
+}
+
+// DecimalSeparatorHasPrefix applies the HasPrefix predicate on the "decimal_separator" field.
+func DecimalSeparatorHasPrefix(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldHasPrefix(FieldDecimalSeparator, v))
+}
+
+// DecimalSeparatorHasSuffix applies the HasSuffix predicate on the "decimal_separator" field.
+func DecimalSeparatorHasSuffix(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldHasSuffix(FieldDecimalSeparator, v))
+}
+
+// DecimalSeparatorEqualFold applies the EqualFold predicate on the "decimal_separator" field.
+func DecimalSeparatorEqualFold(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEqualFold(FieldDecimalSeparator, v))
+}
+
+// DecimalSeparatorContainsFold applies the ContainsFold predicate on the "decimal_separator" field.
+func DecimalSeparatorContainsFold(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldContainsFold(FieldDecimalSeparator, v))
+}
+
+// PeriodEQ applies the EQ predicate on the "period" field.
+func PeriodEQ(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldPeriod, v))
+}
+
+// PeriodNEQ applies the NEQ predicate on the "period" field.
+func PeriodNEQ(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNEQ(FieldPeriod, v))
+}
+
+// PeriodIn applies the In predicate on the "period" field.
+func PeriodIn(vs ...string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldIn(FieldPeriod, vs...))
+}
+
+// PeriodNotIn applies the NotIn predicate on the "period" field.
+func PeriodNotIn(vs ...string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNotIn(FieldPeriod, vs...))
+}
+
+// PeriodGT applies the GT predicate on the "period" field.
+func PeriodGT(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldGT(FieldPeriod, v))
+}
+
+// PeriodGTE applies the GTE predicate on the "period" field.
+func PeriodGTE(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldGTE(FieldPeriod, v))
+}
+
+// PeriodLT applies the LT predicate on the "period" field.
+func PeriodLT(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldLT(FieldPeriod, v))
+}
+
+// PeriodLTE applies the LTE predicate on the "period" field.
+func PeriodLTE(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldLTE(FieldPeriod, v))
+}
+
+// PeriodContains applies the Contains predicate on the "period" field.
+func PeriodContains(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldContains(FieldPeriod, v))
+}
+
+// PeriodHasPrefix applies the HasPrefix predicate on the "period" field.
+func PeriodHasPrefix(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldHasPrefix(FieldPeriod, v))
+}
+
+// PeriodHasSuffix applies the HasSuffix predicate on the "period" field.
+func PeriodHasSuffix(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldHasSuffix(FieldPeriod, v))
+}
+
+// PeriodEqualFold applies the EqualFold predicate on the "period" field.
+func PeriodEqualFold(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEqualFold(FieldPeriod, v))
+}
+
+// PeriodContainsFold applies the ContainsFold predicate on the "period" field.
+func PeriodContainsFold(v string) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldContainsFold(FieldPeriod, v))
+}
+
+// IncludeHeaderEQ applies the EQ predicate on the "include_header" field.
+func IncludeHeaderEQ(v bool) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldIncludeHeader, v))
+}
+
+// IncludeHeaderNEQ applies the NEQ predicate on the "include_header" field.
+func IncludeHeaderNEQ(v bool) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNEQ(FieldIncludeHeader, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PayrollExportProfile) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PayrollExportProfile) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PayrollExportProfile) predicate.PayrollExportProfile {
+	return predicate.PayrollExportProfile(sql.NotPredicates(p))
+}
@@ -0,0 +1,402 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/payrollexportprofile"
+	"back/internal/ent/schema"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayrollExportProfileCreate is the builder for creating a PayrollExportProfile entity.
+type PayrollExportProfileCreate struct {
+	config
+	mutation *PayrollExportProfileMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *PayrollExportProfileCreate) SetName(v string) *PayrollExportProfileCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *PayrollExportProfileCreate) SetDescription(v string) *PayrollExportProfileCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *PayrollExportProfileCreate) SetNillableDescription(v *string) *PayrollExportProfileCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetSeparator sets the "separator" field.
+func (_c *PayrollExportProfileCreate) SetSeparator(v string) *PayrollExportProfileCreate {
+	_c.mutation.SetSeparator(v)
+	return _c
+}
+
+// SetNillableSeparator sets the "separator" field if the given value is not nil.
+func (_c *PayrollExportProfileCreate) SetNillableSeparator(v *string) *PayrollExportProfileCreate {
+	if v != nil {
+		_c.SetSeparator(*v)
+	}
+	return _c
+}
+
+// SetDateFormat sets the "date_format" field.
+func (_c *PayrollExportProfileCreate) SetDateFormat(v string) *PayrollExportProfileCreate {
+	_c.mutation.SetDateFormat(v)
+	return _c
+}
+
+// SetNillableDateFormat sets the "date_format" field if the given value is not nil.
+func (_c *PayrollExportProfileCreate) SetNillableDateFormat(v *string) *PayrollExportProfileCreate {
+	if v != nil {
+		_c.SetDateFormat(*v)
+	}
+	return _c
+}
+
+// SetDecimalSeparator sets the "decimal_separator" field.
+func (_c *PayrollExportProfileCreate) SetDecimalSeparator(v string) *PayrollExportProfileCreate {
+	_c.mutation.SetDecimalSeparator(v)
+	return _c
+}
+
+// SetNillableDecimalSeparator sets the "decimal_separator" field if the given value is not nil.
+func (_c *PayrollExportProfileCreate) SetNillableDecimalSeparator(v *string) *PayrollExportProfileCreate {
+	if v != nil {
+		_c.SetDecimalSeparator(*v)
+	}
+	return _c
+}
+
+// SetPeriod sets the "period" field.
+func (_c *PayrollExportProfileCreate) SetPeriod(v string) *PayrollExportProfileCreate {
+	_c.mutation.SetPeriod(v)
+	return _c
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (_c *PayrollExportProfileCreate) SetNillablePeriod(v *string) *PayrollExportProfileCreate {
+	if v != nil {
+		_c.SetPeriod(*v)
+	}
+	return _c
+}
+
+// SetIncludeHeader sets the "include_header" field.
+func (_c *PayrollExportProfileCreate) SetIncludeHeader(v bool) *PayrollExportProfileCreate {
+	_c.mutation.SetIncludeHeader(v)
+	return _c
+}
+
+// SetNillableIncludeHeader sets the "include_header" field if the given value is not nil.
+func (_c *PayrollExportProfileCreate) SetNillableIncludeHeader(v *bool) *PayrollExportProfileCreate {
+	if v != nil {
+		_c.SetIncludeHeader(*v)
+	}
+	return _c
+}
+
+// SetColumns sets the "columns" field.
+func (_c *PayrollExportProfileCreate) SetColumns(v []schema.PayrollColumn) *PayrollExportProfileCreate {
+	_c.mutation.SetColumns(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PayrollExportProfileCreate) SetCreatedAt(v time.Time) *PayrollExportProfileCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PayrollExportProfileCreate) SetNillableCreatedAt(v *time.Time) *PayrollExportProfileCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PayrollExportProfileCreate) SetUpdatedAt(v time.Time) *PayrollExportProfileCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PayrollExportProfileCreate) SetNillableUpdatedAt(v *time.Time) *PayrollExportProfileCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PayrollExportProfileMutation object of the builder.
+func (_c *PayrollExportProfileCreate) Mutation() *PayrollExportProfileMutation {
+	return _c.mutation
+}
+
+// Save creates the PayrollExportProfile in the database.
+func (_c *PayrollExportProfileCreate) Save(ctx context.Context) (*PayrollExportProfile, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PayrollExportProfileCreate) SaveX(ctx context.Context) *PayrollExportProfile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PayrollExportProfileCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PayrollExportProfileCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PayrollExportProfileCreate) defaults() {
+	if _, ok := _c.mutation.Separator(); !ok {
+		v := payrollexportprofile.DefaultSeparator
+		_c.mutation.SetSeparator(v)
+	}
+	if _, ok := _c.mutation.DateFormat(); !ok {
+		v := payrollexportprofile.DefaultDateFormat
+		_c.mutation.SetDateFormat(v)
+	}
+	if _, ok := _c.mutation.DecimalSeparator(); !ok {
+		v := payrollexportprofile.DefaultDecimalSeparator
+		_c.mutation.SetDecimalSeparator(v)
+	}
+	if _, ok := _c.mutation.Period(); !ok {
+		v := payrollexportprofile.DefaultPeriod
+		_c.mutation.SetPeriod(v)
+	}
+	if _, ok := _c.mutation.IncludeHeader(); !ok {
+		v := payrollexportprofile.DefaultIncludeHeader
+		_c.mutation.SetIncludeHeader(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := payrollexportprofile.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := payrollexportprofile.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PayrollExportProfileCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PayrollExportProfile.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := payrollexportprofile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PayrollExportProfile.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Separator(); !ok {
+		return &ValidationError{Name: "separator", err: errors.New(`ent: missing required field "PayrollExportProfile.separator"`)}
+	}
+	if _, ok := _c.mutation.DateFormat(); !ok {
+		return &ValidationError{Name: "date_format", err: errors.New(`ent: missing required field "PayrollExportProfile.date_format"`)}
+	}
+	if _, ok := _c.mutation.DecimalSeparator(); !ok {
+		return &ValidationError{Name: "decimal_separator", err: errors.New(`ent: missing required field "PayrollExportProfile.decimal_separator"`)}
+	}
+	if _, ok := _c.mutation.Period(); !ok {
+		return &ValidationError{Name: "period", err: errors.New(`ent: missing required field "PayrollExportProfile.period"`)}
+	}
+	if _, ok := _c.mutation.IncludeHeader(); !ok {
+		return &ValidationError{Name: "include_header", err: errors.New(`ent: missing required field "PayrollExportProfile.include_header"`)}
+	}
+	if _, ok := _c.mutation.Columns(); !ok {
+		return &ValidationError{Name: "columns", err: errors.New(`ent: missing required field "PayrollExportProfile.columns"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PayrollExportProfile.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PayrollExportProfile.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *PayrollExportProfileCreate) sqlSave(ctx context.Context) (*PayrollExportProfile, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PayrollExportProfileCreate) createSpec() (*PayrollExportProfile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PayrollExportProfile{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(payrollexportprofile.Table, sqlgraph.NewFieldSpec(payrollexportprofile.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(payrollexportprofile.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(payrollexportprofile.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := _c.mutation.Separator(); ok {
+		_spec.SetField(payrollexportprofile.FieldSeparator, field.TypeString, value)
+		_node.Separator = value
+	}
+	if value, ok := _c.mutation.DateFormat(); ok {
+		_spec.SetField(payrollexportprofile.FieldDateFormat, field.TypeString, value)
+		_node.DateFormat = value
+	}
+	if value, ok := _c.mutation.DecimalSeparator(); ok {
+		_spec.SetField(payrollexportprofile.FieldDecimalSeparator, field.TypeString, value)
+		_node.DecimalSeparator = value
+	}
+	if value, ok := _c.mutation.Period(); ok {
+		_spec.SetField(payrollexportprofile.FieldPeriod, field.TypeString, value)
+		_node.Period = value
+	}
+	if value, ok := _c.mutation.IncludeHeader(); ok {
+		_spec.SetField(payrollexportprofile.FieldIncludeHeader, field.TypeBool, value)
+		_node.IncludeHeader = value
+	}
+	if value, ok := _c.mutation.Columns(); ok {
+		_spec.SetField(payrollexportprofile.FieldColumns, field.TypeJSON, value)
+		_node.Columns = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(payrollexportprofile.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(payrollexportprofile.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// PayrollExportProfileCreateBulk is the builder for creating many PayrollExportProfile entities in bulk.
+type PayrollExportProfileCreateBulk struct {
+	config
+	err      error
+	builders []*PayrollExportProfileCreate
+}
+
+// Save creates the PayrollExportProfile entities in the database.
+func (_c *PayrollExportProfileCreateBulk) Save(ctx context.Context) ([]*PayrollExportProfile, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PayrollExportProfile, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PayrollExportProfileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PayrollExportProfileCreateBulk) SaveX(ctx context.Context) []*PayrollExportProfile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PayrollExportProfileCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PayrollExportProfileCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
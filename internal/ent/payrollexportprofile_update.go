@@ -0,0 +1,536 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/payrollexportprofile"
+	"back/internal/ent/predicate"
+	"back/internal/ent/schema"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// PayrollExportProfileUpdate is the builder for updating PayrollExportProfile entities.
+type PayrollExportProfileUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PayrollExportProfileMutation
+}
+
+// Where appends a list predicates to the PayrollExportProfileUpdate builder.
+func (_u *PayrollExportProfileUpdate) Where(ps ...predicate.PayrollExportProfile) *PayrollExportProfileUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PayrollExportProfileUpdate) SetName(v string) *PayrollExportProfileUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PayrollExportProfileUpdate) SetNillableName(v *string) *PayrollExportProfileUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *PayrollExportProfileUpdate) SetDescription(v string) *PayrollExportProfileUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *PayrollExportProfileUpdate) SetNillableDescription(v *string) *PayrollExportProfileUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *PayrollExportProfileUpdate) ClearDescription() *PayrollExportProfileUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSeparator sets the "separator" field.
+func (_u *PayrollExportProfileUpdate) SetSeparator(v string) *PayrollExportProfileUpdate {
+	_u.mutation.SetSeparator(v)
+	return _u
+}
+
+// SetNillableSeparator sets the "separator" field if the given value is not nil.
+func (_u *PayrollExportProfileUpdate) SetNillableSeparator(v *string) *PayrollExportProfileUpdate {
+	if v != nil {
+		_u.SetSeparator(*v)
+	}
+	return _u
+}
+
+// SetDateFormat sets the "date_format" field.
+func (_u *PayrollExportProfileUpdate) SetDateFormat(v string) *PayrollExportProfileUpdate {
+	_u.mutation.SetDateFormat(v)
+	return _u
+}
+
+// SetNillableDateFormat sets the "date_format" field if the given value is not nil.
+func (_u *PayrollExportProfileUpdate) SetNillableDateFormat(v *string) *PayrollExportProfileUpdate {
+	if v != nil {
+		_u.SetDateFormat(*v)
+	}
+	return _u
+}
+
+// SetDecimalSeparator sets the "decimal_separator" field.
+func (_u *PayrollExportProfileUpdate) SetDecimalSeparator(v string) *PayrollExportProfileUpdate {
+	_u.mutation.SetDecimalSeparator(v)
+	return _u
+}
+
+// SetNillableDecimalSeparator sets the "decimal_separator" field if the given value is not nil.
+func (_u *PayrollExportProfileUpdate) SetNillableDecimalSeparator(v *string) *PayrollExportProfileUpdate {
+	if v != nil {
+		_u.SetDecimalSeparator(*v)
+	}
+	return _u
+}
+
+// SetPeriod sets the "period" field.
+func (_u *PayrollExportProfileUpdate) SetPeriod(v string) *PayrollExportProfileUpdate {
+	_u.mutation.SetPeriod(v)
+	return _u
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (_u *PayrollExportProfileUpdate) SetNillablePeriod(v *string) *PayrollExportProfileUpdate {
+	if v != nil {
+		_u.SetPeriod(*v)
+	}
+	return _u
+}
+
+// SetIncludeHeader sets the "include_header" field.
+func (_u *PayrollExportProfileUpdate) SetIncludeHeader(v bool) *PayrollExportProfileUpdate {
+	_u.mutation.SetIncludeHeader(v)
+	return _u
+}
+
+// SetNillableIncludeHeader sets the "include_header" field if the given value is not nil.
+func (_u *PayrollExportProfileUpdate) SetNillableIncludeHeader(v *bool) *PayrollExportProfileUpdate {
+	if v != nil {
+		_u.SetIncludeHeader(*v)
+	}
+	return _u
+}
+
+// SetColumns sets the "columns" field.
+func (_u *PayrollExportProfileUpdate) SetColumns(v []schema.PayrollColumn) *PayrollExportProfileUpdate {
+	_u.mutation.SetColumns(v)
+	return _u
+}
+
+// AppendColumns appends value to the "columns" field.
+func (_u *PayrollExportProfileUpdate) AppendColumns(v []schema.PayrollColumn) *PayrollExportProfileUpdate {
+	_u.mutation.AppendColumns(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PayrollExportProfileUpdate) SetUpdatedAt(v time.Time) *PayrollExportProfileUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the PayrollExportProfileMutation object of the builder.
+func (_u *PayrollExportProfileUpdate) Mutation() *PayrollExportProfileMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PayrollExportProfileUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PayrollExportProfileUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PayrollExportProfileUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PayrollExportProfileUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PayrollExportProfileUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := payrollexportprofile.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PayrollExportProfileUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := payrollexportprofile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PayrollExportProfile.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PayrollExportProfileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payrollexportprofile.Table, payrollexportprofile.Columns, sqlgraph.NewFieldSpec(payrollexportprofile.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(payrollexportprofile.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(payrollexportprofile.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(payrollexportprofile.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Separator(); ok {
+		_spec.SetField(payrollexportprofile.FieldSeparator, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DateFormat(); ok {
+		_spec.SetField(payrollexportprofile.FieldDateFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DecimalSeparator(); ok {
+		_spec.SetField(payrollexportprofile.FieldDecimalSeparator, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Period(); ok {
+		_spec.SetField(payrollexportprofile.FieldPeriod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IncludeHeader(); ok {
+		_spec.SetField(payrollexportprofile.FieldIncludeHeader, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Columns(); ok {
+		_spec.SetField(payrollexportprofile.FieldColumns, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedColumns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, payrollexportprofile.FieldColumns, value)
+		})
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(payrollexportprofile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payrollexportprofile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PayrollExportProfileUpdateOne is the builder for updating a single PayrollExportProfile entity.
+type PayrollExportProfileUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PayrollExportProfileMutation
+}
+
+// SetName sets the "name" field.
+func (_u *PayrollExportProfileUpdateOne) SetName(v string) *PayrollExportProfileUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PayrollExportProfileUpdateOne) SetNillableName(v *string) *PayrollExportProfileUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *PayrollExportProfileUpdateOne) SetDescription(v string) *PayrollExportProfileUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *PayrollExportProfileUpdateOne) SetNillableDescription(v *string) *PayrollExportProfileUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *PayrollExportProfileUpdateOne) ClearDescription() *PayrollExportProfileUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSeparator sets the "separator" field.
+func (_u *PayrollExportProfileUpdateOne) SetSeparator(v string) *PayrollExportProfileUpdateOne {
+	_u.mutation.SetSeparator(v)
+	return _u
+}
+
+// SetNillableSeparator sets the "separator" field if the given value is not nil.
+func (_u *PayrollExportProfileUpdateOne) SetNillableSeparator(v *string) *PayrollExportProfileUpdateOne {
+	if v != nil {
+		_u.SetSeparator(*v)
+	}
+	return _u
+}
+
+// SetDateFormat sets the "date_format" field.
+func (_u *PayrollExportProfileUpdateOne) SetDateFormat(v string) *PayrollExportProfileUpdateOne {
+	_u.mutation.SetDateFormat(v)
+	return _u
+}
+
+// SetNillableDateFormat sets the "date_format" field if the given value is not nil.
+func (_u *PayrollExportProfileUpdateOne) SetNillableDateFormat(v *string) *PayrollExportProfileUpdateOne {
+	if v != nil {
+		_u.SetDateFormat(*v)
+	}
+	return _u
+}
+
+// SetDecimalSeparator sets the "decimal_separator" field.
+func (_u *PayrollExportProfileUpdateOne) SetDecimalSeparator(v string) *PayrollExportProfileUpdateOne {
+	_u.mutation.SetDecimalSeparator(v)
+	return _u
+}
+
+// SetNillableDecimalSeparator sets the "decimal_separator" field if the given value is not nil.
+func (_u *PayrollExportProfileUpdateOne) SetNillableDecimalSeparator(v *string) *PayrollExportProfileUpdateOne {
+	if v != nil {
+		_u.SetDecimalSeparator(*v)
+	}
+	return _u
+}
+
+// SetPeriod sets the "period" field.
+func (_u *PayrollExportProfileUpdateOne) SetPeriod(v string) *PayrollExportProfileUpdateOne {
+	_u.mutation.SetPeriod(v)
+	return _u
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (_u *PayrollExportProfileUpdateOne) SetNillablePeriod(v *string) *PayrollExportProfileUpdateOne {
+	if v != nil {
+		_u.SetPeriod(*v)
+	}
+	return _u
+}
+
+// SetIncludeHeader sets the "include_header" field.
+func (_u *PayrollExportProfileUpdateOne) SetIncludeHeader(v bool) *PayrollExportProfileUpdateOne {
+	_u.mutation.SetIncludeHeader(v)
+	return _u
+}
+
+// SetNillableIncludeHeader sets the "include_header" field if the given value is not nil.
+func (_u *PayrollExportProfileUpdateOne) SetNillableIncludeHeader(v *bool) *PayrollExportProfileUpdateOne {
+	if v != nil {
+		_u.SetIncludeHeader(*v)
+	}
+	return _u
+}
+
+// SetColumns sets the "columns" field.
+func (_u *PayrollExportProfileUpdateOne) SetColumns(v []schema.PayrollColumn) *PayrollExportProfileUpdateOne {
+	_u.mutation.SetColumns(v)
+	return _u
+}
+
+// AppendColumns appends value to the "columns" field.
+func (_u *PayrollExportProfileUpdateOne) AppendColumns(v []schema.PayrollColumn) *PayrollExportProfileUpdateOne {
+	_u.mutation.AppendColumns(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PayrollExportProfileUpdateOne) SetUpdatedAt(v time.Time) *PayrollExportProfileUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the PayrollExportProfileMutation object of the builder.
+func (_u *PayrollExportProfileUpdateOne) Mutation() *PayrollExportProfileMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PayrollExportProfileUpdate builder.
+func (_u *PayrollExportProfileUpdateOne) Where(ps ...predicate.PayrollExportProfile) *PayrollExportProfileUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PayrollExportProfileUpdateOne) Select(field string, fields ...string) *PayrollExportProfileUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PayrollExportProfile entity.
+func (_u *PayrollExportProfileUpdateOne) Save(ctx context.Context) (*PayrollExportProfile, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PayrollExportProfileUpdateOne) SaveX(ctx context.Context) *PayrollExportProfile {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PayrollExportProfileUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PayrollExportProfileUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PayrollExportProfileUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := payrollexportprofile.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PayrollExportProfileUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := payrollexportprofile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PayrollExportProfile.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PayrollExportProfileUpdateOne) sqlSave(ctx context.Context) (_node *PayrollExportProfile, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payrollexportprofile.Table, payrollexportprofile.Columns, sqlgraph.NewFieldSpec(payrollexportprofile.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PayrollExportProfile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payrollexportprofile.FieldID)
+		for _, f := range fields {
+			if !payrollexportprofile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != payrollexportprofile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(payrollexportprofile.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(payrollexportprofile.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(payrollexportprofile.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Separator(); ok {
+		_spec.SetField(payrollexportprofile.FieldSeparator, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DateFormat(); ok {
+		_spec.SetField(payrollexportprofile.FieldDateFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DecimalSeparator(); ok {
+		_spec.SetField(payrollexportprofile.FieldDecimalSeparator, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Period(); ok {
+		_spec.SetField(payrollexportprofile.FieldPeriod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IncludeHeader(); ok {
+		_spec.SetField(payrollexportprofile.FieldIncludeHeader, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Columns(); ok {
+		_spec.SetField(payrollexportprofile.FieldColumns, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedColumns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, payrollexportprofile.FieldColumns, value)
+		})
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(payrollexportprofile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &PayrollExportProfile{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payrollexportprofile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
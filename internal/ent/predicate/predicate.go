@@ -15,6 +15,12 @@ type Address func(*sql.Selector)
 // AttendanceDay is the predicate function for attendanceday builders.
 type AttendanceDay func(*sql.Selector)
 
+// AttendanceEdit is the predicate function for attendanceedit builders.
+type AttendanceEdit func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
 // Branch is the predicate function for branch builders.
 type Branch func(*sql.Selector)
 
@@ -30,15 +36,42 @@ type Commune func(*sql.Selector)
 // Device is the predicate function for device builders.
 type Device func(*sql.Selector)
 
+// Holiday is the predicate function for holiday builders.
+type Holiday func(*sql.Selector)
+
+// HourBankMovement is the predicate function for hourbankmovement builders.
+type HourBankMovement func(*sql.Selector)
+
+// LeaveRequest is the predicate function for leaverequest builders.
+type LeaveRequest func(*sql.Selector)
+
+// MarkCorrectionRequest is the predicate function for markcorrectionrequest builders.
+type MarkCorrectionRequest func(*sql.Selector)
+
+// OvertimeAuthorization is the predicate function for overtimeauthorization builders.
+type OvertimeAuthorization func(*sql.Selector)
+
+// PayrollExportProfile is the predicate function for payrollexportprofile builders.
+type PayrollExportProfile func(*sql.Selector)
+
+// Punch is the predicate function for punch builders.
+type Punch func(*sql.Selector)
+
 // RefreshToken is the predicate function for refreshtoken builders.
 type RefreshToken func(*sql.Selector)
 
 // Region is the predicate function for region builders.
 type Region func(*sql.Selector)
 
+// Role is the predicate function for role builders.
+type Role func(*sql.Selector)
+
 // Shift is the predicate function for shift builders.
 type Shift func(*sql.Selector)
 
+// ShiftCycleDay is the predicate function for shiftcycleday builders.
+type ShiftCycleDay func(*sql.Selector)
+
 // ShiftDay is the predicate function for shiftday builders.
 type ShiftDay func(*sql.Selector)
 
@@ -62,3 +95,6 @@ type UserQRSession func(*sql.Selector)
 
 // UserShiftAssignment is the predicate function for usershiftassignment builders.
 type UserShiftAssignment func(*sql.Selector)
+
+// VacationAdjustment is the predicate function for vacationadjustment builders.
+type VacationAdjustment func(*sql.Selector)
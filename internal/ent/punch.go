@@ -0,0 +1,334 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/accesspoint"
+	"back/internal/ent/attendanceday"
+	"back/internal/ent/device"
+	"back/internal/ent/punch"
+	"back/internal/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Punch is the model entity for the Punch schema.
+type Punch struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// BranchID holds the value of the "branch_id" field.
+	BranchID int `json:"branch_id,omitempty"`
+	// AccessPointID holds the value of the "access_point_id" field.
+	AccessPointID *int `json:"access_point_id,omitempty"`
+	// DeviceID holds the value of the "device_id" field.
+	DeviceID *int `json:"device_id,omitempty"`
+	// AttendanceDayID holds the value of the "attendance_day_id" field.
+	AttendanceDayID *int `json:"attendance_day_id,omitempty"`
+	// PunchedAt holds the value of the "punched_at" field.
+	PunchedAt time.Time `json:"punched_at,omitempty"`
+	// Method holds the value of the "method" field.
+	Method string `json:"method,omitempty"`
+	// Direction holds the value of the "direction" field.
+	Direction *string `json:"direction,omitempty"`
+	// RawPayload holds the value of the "raw_payload" field.
+	RawPayload *string `json:"raw_payload,omitempty"`
+	// RejectedReason holds the value of the "rejected_reason" field.
+	RejectedReason *string `json:"rejected_reason,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID *string `json:"client_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PunchQuery when eager-loading is set.
+	Edges        PunchEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PunchEdges holds the relations/edges for other nodes in the graph.
+type PunchEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// AccessPoint holds the value of the access_point edge.
+	AccessPoint *AccessPoint `json:"access_point,omitempty"`
+	// Device holds the value of the device edge.
+	Device *Device `json:"device,omitempty"`
+	// AttendanceDay holds the value of the attendance_day edge.
+	AttendanceDay *AttendanceDay `json:"attendance_day,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PunchEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// AccessPointOrErr returns the AccessPoint value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PunchEdges) AccessPointOrErr() (*AccessPoint, error) {
+	if e.AccessPoint != nil {
+		return e.AccessPoint, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: accesspoint.Label}
+	}
+	return nil, &NotLoadedError{edge: "access_point"}
+}
+
+// DeviceOrErr returns the Device value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PunchEdges) DeviceOrErr() (*Device, error) {
+	if e.Device != nil {
+		return e.Device, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: device.Label}
+	}
+	return nil, &NotLoadedError{edge: "device"}
+}
+
+// AttendanceDayOrErr returns the AttendanceDay value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PunchEdges) AttendanceDayOrErr() (*AttendanceDay, error) {
+	if e.AttendanceDay != nil {
+		return e.AttendanceDay, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: attendanceday.Label}
+	}
+	return nil, &NotLoadedError{edge: "attendance_day"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Punch) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case punch.FieldID, punch.FieldUserID, punch.FieldBranchID, punch.FieldAccessPointID, punch.FieldDeviceID, punch.FieldAttendanceDayID:
+			values[i] = new(sql.NullInt64)
+		case punch.FieldMethod, punch.FieldDirection, punch.FieldRawPayload, punch.FieldRejectedReason, punch.FieldClientID:
+			values[i] = new(sql.NullString)
+		case punch.FieldPunchedAt, punch.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Punch fields.
+func (_m *Punch) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case punch.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case punch.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case punch.FieldBranchID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field branch_id", values[i])
+			} else if value.Valid {
+				_m.BranchID = int(value.Int64)
+			}
+		case punch.FieldAccessPointID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field access_point_id", values[i])
+			} else if value.Valid {
+				_m.AccessPointID = new(int)
+				*_m.AccessPointID = int(value.Int64)
+			}
+		case punch.FieldDeviceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field device_id", values[i])
+			} else if value.Valid {
+				_m.DeviceID = new(int)
+				*_m.DeviceID = int(value.Int64)
+			}
+		case punch.FieldAttendanceDayID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attendance_day_id", values[i])
+			} else if value.Valid {
+				_m.AttendanceDayID = new(int)
+				*_m.AttendanceDayID = int(value.Int64)
+			}
+		case punch.FieldPunchedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field punched_at", values[i])
+			} else if value.Valid {
+				_m.PunchedAt = value.Time
+			}
+		case punch.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				_m.Method = value.String
+			}
+		case punch.FieldDirection:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field direction", values[i])
+			} else if value.Valid {
+				_m.Direction = new(string)
+				*_m.Direction = value.String
+			}
+		case punch.FieldRawPayload:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field raw_payload", values[i])
+			} else if value.Valid {
+				_m.RawPayload = new(string)
+				*_m.RawPayload = value.String
+			}
+		case punch.FieldRejectedReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rejected_reason", values[i])
+			} else if value.Valid {
+				_m.RejectedReason = new(string)
+				*_m.RejectedReason = value.String
+			}
+		case punch.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				_m.ClientID = new(string)
+				*_m.ClientID = value.String
+			}
+		case punch.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Punch.
+// This includes values selected through modifiers, order, etc.
+func (_m *Punch) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Punch entity.
+func (_m *Punch) QueryUser() *UserQuery {
+	return NewPunchClient(_m.config).QueryUser(_m)
+}
+
+// QueryAccessPoint queries the "access_point" edge of the Punch entity.
+func (_m *Punch) QueryAccessPoint() *AccessPointQuery {
+	return NewPunchClient(_m.config).QueryAccessPoint(_m)
+}
+
+// QueryDevice queries the "device" edge of the Punch entity.
+func (_m *Punch) QueryDevice() *DeviceQuery {
+	return NewPunchClient(_m.config).QueryDevice(_m)
+}
+
+// QueryAttendanceDay queries the "attendance_day" edge of the Punch entity.
+func (_m *Punch) QueryAttendanceDay() *AttendanceDayQuery {
+	return NewPunchClient(_m.config).QueryAttendanceDay(_m)
+}
+
+// Update returns a builder for updating this Punch.
+// Note that you need to call Punch.Unwrap() before calling this method if this Punch
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Punch) Update() *PunchUpdateOne {
+	return NewPunchClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Punch entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Punch) Unwrap() *Punch {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Punch is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Punch) String() string {
+	var builder strings.Builder
+	builder.WriteString("Punch(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("branch_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BranchID))
+	builder.WriteString(", ")
+	if v := _m.AccessPointID; v != nil {
+		builder.WriteString("access_point_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeviceID; v != nil {
+		builder.WriteString("device_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AttendanceDayID; v != nil {
+		builder.WriteString("attendance_day_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("punched_at=")
+	builder.WriteString(_m.PunchedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("method=")
+	builder.WriteString(_m.Method)
+	builder.WriteString(", ")
+	if v := _m.Direction; v != nil {
+		builder.WriteString("direction=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.RawPayload; v != nil {
+		builder.WriteString("raw_payload=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.RejectedReason; v != nil {
+		builder.WriteString("rejected_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ClientID; v != nil {
+		builder.WriteString("client_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Punches is a parsable slice of Punch.
+type Punches []*Punch
@@ -0,0 +1,239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package punch
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the punch type in the database.
+	Label = "punch"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldBranchID holds the string denoting the branch_id field in the database.
+	FieldBranchID = "branch_id"
+	// FieldAccessPointID holds the string denoting the access_point_id field in the database.
+	FieldAccessPointID = "access_point_id"
+	// FieldDeviceID holds the string denoting the device_id field in the database.
+	FieldDeviceID = "device_id"
+	// FieldAttendanceDayID holds the string denoting the attendance_day_id field in the database.
+	FieldAttendanceDayID = "attendance_day_id"
+	// FieldPunchedAt holds the string denoting the punched_at field in the database.
+	FieldPunchedAt = "punched_at"
+	// FieldMethod holds the string denoting the method field in the database.
+	FieldMethod = "method"
+	// FieldDirection holds the string denoting the direction field in the database.
+	FieldDirection = "direction"
+	// FieldRawPayload holds the string denoting the raw_payload field in the database.
+	FieldRawPayload = "raw_payload"
+	// FieldRejectedReason holds the string denoting the rejected_reason field in the database.
+	FieldRejectedReason = "rejected_reason"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeAccessPoint holds the string denoting the access_point edge name in mutations.
+	EdgeAccessPoint = "access_point"
+	// EdgeDevice holds the string denoting the device edge name in mutations.
+	EdgeDevice = "device"
+	// EdgeAttendanceDay holds the string denoting the attendance_day edge name in mutations.
+	EdgeAttendanceDay = "attendance_day"
+	// Table holds the table name of the punch in the database.
+	Table = "punches"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "punches"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+	// AccessPointTable is the table that holds the access_point relation/edge.
+	AccessPointTable = "punches"
+	// AccessPointInverseTable is the table name for the AccessPoint entity.
+	// It exists in this package in order to avoid circular dependency with the "accesspoint" package.
+	AccessPointInverseTable = "access_points"
+	// AccessPointColumn is the table column denoting the access_point relation/edge.
+	AccessPointColumn = "access_point_id"
+	// DeviceTable is the table that holds the device relation/edge.
+	DeviceTable = "punches"
+	// DeviceInverseTable is the table name for the Device entity.
+	// It exists in this package in order to avoid circular dependency with the "device" package.
+	DeviceInverseTable = "devices"
+	// DeviceColumn is the table column denoting the device relation/edge.
+	DeviceColumn = "device_id"
+	// AttendanceDayTable is the table that holds the attendance_day relation/edge.
+	AttendanceDayTable = "punches"
+	// AttendanceDayInverseTable is the table name for the AttendanceDay entity.
+	// It exists in this package in order to avoid circular dependency with the "attendanceday" package.
+	AttendanceDayInverseTable = "attendance_days"
+	// AttendanceDayColumn is the table column denoting the attendance_day relation/edge.
+	AttendanceDayColumn = "attendance_day_id"
+)
+
+// Columns holds all SQL columns for punch fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldBranchID,
+	FieldAccessPointID,
+	FieldDeviceID,
+	FieldAttendanceDayID,
+	FieldPunchedAt,
+	FieldMethod,
+	FieldDirection,
+	FieldRawPayload,
+	FieldRejectedReason,
+	FieldClientID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// MethodValidator is a validator for the "method" field. It is called by the builders before save.
+	MethodValidator func(string) error
+	// DirectionValidator is a validator for the "direction" field. It is called by the builders before save.
+	DirectionValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Punch queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByBranchID orders the results by the branch_id field.
+func ByBranchID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBranchID, opts...).ToFunc()
+}
+
+// ByAccessPointID orders the results by the access_point_id field.
+func ByAccessPointID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessPointID, opts...).ToFunc()
+}
+
+// ByDeviceID orders the results by the device_id field.
+func ByDeviceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviceID, opts...).ToFunc()
+}
+
+// ByAttendanceDayID orders the results by the attendance_day_id field.
+func ByAttendanceDayID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttendanceDayID, opts...).ToFunc()
+}
+
+// ByPunchedAt orders the results by the punched_at field.
+func ByPunchedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPunchedAt, opts...).ToFunc()
+}
+
+// ByMethod orders the results by the method field.
+func ByMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMethod, opts...).ToFunc()
+}
+
+// ByDirection orders the results by the direction field.
+func ByDirection(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDirection, opts...).ToFunc()
+}
+
+// ByRawPayload orders the results by the raw_payload field.
+func ByRawPayload(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRawPayload, opts...).ToFunc()
+}
+
+// ByRejectedReason orders the results by the rejected_reason field.
+func ByRejectedReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRejectedReason, opts...).ToFunc()
+}
+
+// ByClientID orders the results by the client_id field.
+func ByClientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAccessPointField orders the results by access_point field.
+func ByAccessPointField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccessPointStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDeviceField orders the results by device field.
+func ByDeviceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeviceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAttendanceDayField orders the results by attendance_day field.
+func ByAttendanceDayField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttendanceDayStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+func newAccessPointStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccessPointInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AccessPointTable, AccessPointColumn),
+	)
+}
+func newDeviceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeviceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DeviceTable, DeviceColumn),
+	)
+}
+func newAttendanceDayStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttendanceDayInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AttendanceDayTable, AttendanceDayColumn),
+	)
+}
@@ -0,0 +1,818 @@
+// Code generated by ent, DO NOT EDIT.
+
+package punch
+
+import (
+	"back/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Punch {
+	return predicate.Punch(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Punch {
+	return predicate.Punch(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Punch {
+	return predicate.Punch(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Punch {
+	return predicate.Punch(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Punch {
+	return predicate.Punch(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Punch {
+	return predicate.Punch(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Punch {
+	return predicate.Punch(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldUserID, v))
+}
+
+// BranchID applies equality check predicate on the "branch_id" field. It's identical to BranchIDEQ.
+func BranchID(v int) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldBranchID, v))
+}
+
+// AccessPointID applies equality check predicate on the "access_point_id" field. It's identical to AccessPointIDEQ.
+func AccessPointID(v int) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldAccessPointID, v))
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v int) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// AttendanceDayID applies equality check predicate on the "attendance_day_id" field. It's identical to AttendanceDayIDEQ.
+func AttendanceDayID(v int) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldAttendanceDayID, v))
+}
+
+// PunchedAt applies equality check predicate on the "punched_at" field. It's identical to PunchedAtEQ.
+func PunchedAt(v time.Time) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldPunchedAt, v))
+}
+
+// Method applies equality check predicate on the "method" field. It's identical to MethodEQ.
+func Method(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldMethod, v))
+}
+
+// Direction applies equality check predicate on the "direction" field. It's identical to DirectionEQ.
+func Direction(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldDirection, v))
+}
+
+// RawPayload applies equality check predicate on the "raw_payload" field. It's identical to RawPayloadEQ.
+func RawPayload(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldRawPayload, v))
+}
+
+// RejectedReason applies equality check predicate on the "rejected_reason" field. It's identical to RejectedReasonEQ.
+func RejectedReason(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldRejectedReason, v))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldClientID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Punch {
+	return predicate.Punch(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Punch {
+	return predicate.Punch(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Punch {
+	return predicate.Punch(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// BranchIDEQ applies the EQ predicate on the "branch_id" field.
+func BranchIDEQ(v int) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldBranchID, v))
+}
+
+// BranchIDNEQ applies the NEQ predicate on the "branch_id" field.
+func BranchIDNEQ(v int) predicate.Punch {
+	return predicate.Punch(sql.FieldNEQ(FieldBranchID, v))
+}
+
+// BranchIDIn applies the In predicate on the "branch_id" field.
+func BranchIDIn(vs ...int) predicate.Punch {
+	return predicate.Punch(sql.FieldIn(FieldBranchID, vs...))
+}
+
+// BranchIDNotIn applies the NotIn predicate on the "branch_id" field.
+func BranchIDNotIn(vs ...int) predicate.Punch {
+	return predicate.Punch(sql.FieldNotIn(FieldBranchID, vs...))
+}
+
+// BranchIDGT applies the GT predicate on the "branch_id" field.
+func BranchIDGT(v int) predicate.Punch {
+	return predicate.Punch(sql.FieldGT(FieldBranchID, v))
+}
+
+// BranchIDGTE applies the GTE predicate on the "branch_id" field.
+func BranchIDGTE(v int) predicate.Punch {
+	return predicate.Punch(sql.FieldGTE(FieldBranchID, v))
+}
+
+// BranchIDLT applies the LT predicate on the "branch_id" field.
+func BranchIDLT(v int) predicate.Punch {
+	return predicate.Punch(sql.FieldLT(FieldBranchID, v))
+}
+
+// BranchIDLTE applies the LTE predicate on the "branch_id" field.
+func BranchIDLTE(v int) predicate.Punch {
+	return predicate.Punch(sql.FieldLTE(FieldBranchID, v))
+}
+
+// AccessPointIDEQ applies the EQ predicate on the "access_point_id" field.
+func AccessPointIDEQ(v int) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldAccessPointID, v))
+}
+
+// AccessPointIDNEQ applies the NEQ predicate on the "access_point_id" field.
+func AccessPointIDNEQ(v int) predicate.Punch {
+	return predicate.Punch(sql.FieldNEQ(FieldAccessPointID, v))
+}
+
+// AccessPointIDIn applies the In predicate on the "access_point_id" field.
+func AccessPointIDIn(vs ...int) predicate.Punch {
+	return predicate.Punch(sql.FieldIn(FieldAccessPointID, vs...))
+}
+
+// AccessPointIDNotIn applies the NotIn predicate on the "access_point_id" field.
+func AccessPointIDNotIn(vs ...int) predicate.Punch {
+	return predicate.Punch(sql.FieldNotIn(FieldAccessPointID, vs...))
+}
+
+// AccessPointIDIsNil applies the IsNil predicate on the "access_point_id" field.
+func AccessPointIDIsNil() predicate.Punch {
+	return predicate.Punch(sql.FieldIsNull(FieldAccessPointID))
+}
+
+// AccessPointIDNotNil applies the NotNil predicate on the "access_point_id" field.
+func AccessPointIDNotNil() predicate.Punch {
+	return predicate.Punch(sql.FieldNotNull(FieldAccessPointID))
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v int) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v int) predicate.Punch {
+	return predicate.Punch(sql.FieldNEQ(FieldDeviceID, v))
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...int) predicate.Punch {
+	return predicate.Punch(sql.FieldIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...int) predicate.Punch {
+	return predicate.Punch(sql.FieldNotIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDIsNil applies the IsNil predicate on the "device_id" field.
+func DeviceIDIsNil() predicate.Punch {
+	return predicate.Punch(sql.FieldIsNull(FieldDeviceID))
+}
+
+// DeviceIDNotNil applies the NotNil predicate on the "device_id" field.
+func DeviceIDNotNil() predicate.Punch {
+	return predicate.Punch(sql.FieldNotNull(FieldDeviceID))
+}
+
+// AttendanceDayIDEQ applies the EQ predicate on the "attendance_day_id" field.
+func AttendanceDayIDEQ(v int) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldAttendanceDayID, v))
+}
+
+// AttendanceDayIDNEQ applies the NEQ predicate on the "attendance_day_id" field.
+func AttendanceDayIDNEQ(v int) predicate.Punch {
+	return predicate.Punch(sql.FieldNEQ(FieldAttendanceDayID, v))
+}
+
+// AttendanceDayIDIn applies the In predicate on the "attendance_day_id" field.
+func AttendanceDayIDIn(vs ...int) predicate.Punch {
+	return predicate.Punch(sql.FieldIn(FieldAttendanceDayID, vs...))
+}
+
+// AttendanceDayIDNotIn applies the NotIn predicate on the "attendance_day_id" field.
+func AttendanceDayIDNotIn(vs ...int) predicate.Punch {
+	return predicate.Punch(sql.FieldNotIn(FieldAttendanceDayID, vs...))
+}
+
+// AttendanceDayIDIsNil applies the IsNil predicate on the "attendance_day_id" field.
+func AttendanceDayIDIsNil() predicate.Punch {
+	return predicate.Punch(sql.FieldIsNull(FieldAttendanceDayID))
+}
+
+// AttendanceDayIDNotNil applies the NotNil predicate on the "attendance_day_id" field.
+func AttendanceDayIDNotNil() predicate.Punch {
+	return predicate.Punch(sql.FieldNotNull(FieldAttendanceDayID))
+}
+
+// PunchedAtEQ applies the EQ predicate on the "punched_at" field.
+func PunchedAtEQ(v time.Time) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldPunchedAt, v))
+}
+
+// PunchedAtNEQ applies the NEQ predicate on the "punched_at" field.
+func PunchedAtNEQ(v time.Time) predicate.Punch {
+	return predicate.Punch(sql.FieldNEQ(FieldPunchedAt, v))
+}
+
+// PunchedAtIn applies the In predicate on the "punched_at" field.
+func PunchedAtIn(vs ...time.Time) predicate.Punch {
+	return predicate.Punch(sql.FieldIn(FieldPunchedAt, vs...))
+}
+
+// PunchedAtNotIn applies the NotIn predicate on the "punched_at" field.
+func PunchedAtNotIn(vs ...time.Time) predicate.Punch {
+	return predicate.Punch(sql.FieldNotIn(FieldPunchedAt, vs...))
+}
+
+// PunchedAtGT applies the GT predicate on the "punched_at" field.
+func PunchedAtGT(v time.Time) predicate.Punch {
+	return predicate.Punch(sql.FieldGT(FieldPunchedAt, v))
+}
+
+// PunchedAtGTE applies the GTE predicate on the "punched_at" field.
+func PunchedAtGTE(v time.Time) predicate.Punch {
+	return predicate.Punch(sql.FieldGTE(FieldPunchedAt, v))
+}
+
+// PunchedAtLT applies the LT predicate on the "punched_at" field.
+func PunchedAtLT(v time.Time) predicate.Punch {
+	return predicate.Punch(sql.FieldLT(FieldPunchedAt, v))
+}
+
+// PunchedAtLTE applies the LTE predicate on the "punched_at" field.
+func PunchedAtLTE(v time.Time) predicate.Punch {
+	return predicate.Punch(sql.FieldLTE(FieldPunchedAt, v))
+}
+
+// MethodEQ applies the EQ predicate on the "method" field.
+func MethodEQ(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldMethod, v))
+}
+
+// MethodNEQ applies the NEQ predicate on the "method" field.
+func MethodNEQ(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldNEQ(FieldMethod, v))
+}
+
+// MethodIn applies the In predicate on the "method" field.
+func MethodIn(vs ...string) predicate.Punch {
+	return predicate.Punch(sql.FieldIn(FieldMethod, vs...))
+}
+
+// MethodNotIn applies the NotIn predicate on the "method" field.
+func MethodNotIn(vs ...string) predicate.Punch {
+	return predicate.Punch(sql.FieldNotIn(FieldMethod, vs...))
+}
+
+// MethodGT applies the GT predicate on the "method" field.
+func MethodGT(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldGT(FieldMethod, v))
+}
+
+// MethodGTE applies the GTE predicate on the "method" field.
+func MethodGTE(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldGTE(FieldMethod, v))
+}
+
+// MethodLT applies the LT predicate on the "method" field.
+func MethodLT(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldLT(FieldMethod, v))
+}
+
+// MethodLTE applies the LTE predicate on the "method" field.
+func MethodLTE(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldLTE(FieldMethod, v))
+}
+
+// MethodContains applies the Contains predicate on the "method" field.
+func MethodContains(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldContains(FieldMethod, v))
+}
+
+// MethodHasPrefix applies the HasPrefix predicate on the "method" field.
+func MethodHasPrefix(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldHasPrefix(FieldMethod, v))
+}
+
+// MethodHasSuffix applies the HasSuffix predicate on the "method" field.
+func MethodHasSuffix(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldHasSuffix(FieldMethod, v))
+}
+
+// MethodEqualFold applies the EqualFold predicate on the "method" field.
+func MethodEqualFold(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldEqualFold(FieldMethod, v))
+}
+
+// MethodContainsFold applies the ContainsFold predicate on the "method" field.
+func MethodContainsFold(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldContainsFold(FieldMethod, v))
+}
+
+// DirectionEQ applies the EQ predicate on the "direction" field.
+func DirectionEQ(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldDirection, v))
+}
+
+// DirectionNEQ applies the NEQ predicate on the "direction" field.
+func DirectionNEQ(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldNEQ(FieldDirection, v))
+}
+
+// DirectionIn applies the In predicate on the "direction" field.
+func DirectionIn(vs ...string) predicate.Punch {
+	return predicate.Punch(sql.FieldIn(FieldDirection, vs...))
+}
+
+// DirectionNotIn applies the NotIn predicate on the "direction" field.
+func DirectionNotIn(vs ...string) predicate.Punch {
+	return predicate.Punch(sql.FieldNotIn(FieldDirection, vs...))
+}
+
+// DirectionGT applies the GT predicate on the "direction" field.
+func DirectionGT(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldGT(FieldDirection, v))
+}
+
+// DirectionGTE applies the GTE predicate on the "direction" field.
+func DirectionGTE(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldGTE(FieldDirection, v))
+}
+
+// DirectionLT applies the LT predicate on the "direction" field.
+func DirectionLT(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldLT(FieldDirection, v))
+}
+
+// DirectionLTE applies the LTE predicate on the "direction" field.
+func DirectionLTE(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldLTE(FieldDirection, v))
+}
+
+// DirectionContains applies the Contains predicate on the "direction" field.
+func DirectionContains(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldContains(FieldDirection, v))
+}
+
+// DirectionHasPrefix applies the HasPrefix predicate on the "direction" field.
+func DirectionHasPrefix(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldHasPrefix(FieldDirection, v))
+}
+
+// DirectionHasSuffix applies the HasSuffix predicate on the "direction" field.
+func DirectionHasSuffix(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldHasSuffix(FieldDirection, v))
+}
+
+// DirectionIsNil applies the IsNil predicate on the "direction" field.
+func DirectionIsNil() predicate.Punch {
+	return predicate.Punch(sql.FieldIsNull(FieldDirection))
+}
+
+// DirectionNotNil applies the NotNil predicate on the "direction" field.
+func DirectionNotNil() predicate.Punch {
+	return predicate.Punch(sql.FieldNotNull(FieldDirection))
+}
+
+// DirectionEqualFold applies the EqualFold predicate on the "direction" field.
+func DirectionEqualFold(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldEqualFold(FieldDirection, v))
+}
+
+// DirectionContainsFold applies the ContainsFold predicate on the "direction" field.
+func DirectionContainsFold(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldContainsFold(FieldDirection, v))
+}
+
+// RawPayloadEQ applies the EQ predicate on the "raw_payload" field.
+func RawPayloadEQ(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldRawPayload, v))
+}
+
+// RawPayloadNEQ applies the NEQ predicate on the "raw_payload" field.
+func RawPayloadNEQ(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldNEQ(FieldRawPayload, v))
+}
+
+// RawPayloadIn applies the In predicate on the "raw_payload" field.
+func RawPayloadIn(vs ...string) predicate.Punch {
+	return predicate.Punch(sql.FieldIn(FieldRawPayload, vs...))
+}
+
+// RawPayloadNotIn applies the NotIn predicate on the "raw_payload" field.
+func RawPayloadNotIn(vs ...string) predicate.Punch {
+	return predicate.Punch(sql.FieldNotIn(FieldRawPayload, vs...))
+}
+
+// RawPayloadGT applies the GT predicate on the "raw_payload" field.
+func RawPayloadGT(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldGT(FieldRawPayload, v))
+}
+
+// RawPayloadGTE applies the GTE predicate on the "raw_payload" field.
+func RawPayloadGTE(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldGTE(FieldRawPayload, v))
+}
+
+// RawPayloadLT applies the LT predicate on the "raw_payload" field.
+func RawPayloadLT(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldLT(FieldRawPayload, v))
+}
+
+// RawPayloadLTE applies the LTE predicate on the "raw_payload" field.
+func RawPayloadLTE(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldLTE(FieldRawPayload, v))
+}
+
+// RawPayloadContains applies the Contains predicate on the "raw_payload" field.
+func RawPayloadContains(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldContains(FieldRawPayload, v))
+}
+
+// RawPayloadHasPrefix applies the HasPrefix predicate on the "raw_payload" field.
+func RawPayloadHasPrefix(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldHasPrefix(FieldRawPayload, v))
+}
+
+// RawPayloadHasSuffix applies the HasSuffix predicate on the "raw_payload" field.
+func RawPayloadHasSuffix(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldHasSuffix(FieldRawPayload, v))
+}
+
+// RawPayloadIsNil applies the IsNil predicate on the "raw_payload" field.
+func RawPayloadIsNil() predicate.Punch {
+	return predicate.Punch(sql.FieldIsNull(FieldRawPayload))
+}
+
+// RawPayloadNotNil applies the NotNil predicate on the "raw_payload" field.
+func RawPayloadNotNil() predicate.Punch {
+	return predicate.Punch(sql.FieldNotNull(FieldRawPayload))
+}
+
+// RawPayloadEqualFold applies the EqualFold predicate on the "raw_payload" field.
+func RawPayloadEqualFold(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldEqualFold(FieldRawPayload, v))
+}
+
+// RawPayloadContainsFold applies the ContainsFold predicate on the "raw_payload" field.
+func RawPayloadContainsFold(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldContainsFold(FieldRawPayload, v))
+}
+
+// RejectedReasonEQ applies the EQ predicate on the "rejected_reason" field.
+func RejectedReasonEQ(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldRejectedReason, v))
+}
+
+// RejectedReasonNEQ applies the NEQ predicate on the "rejected_reason" field.
+func RejectedReasonNEQ(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldNEQ(FieldRejectedReason, v))
+}
+
+// RejectedReasonIn applies the In predicate on the "rejected_reason" field.
+func RejectedReasonIn(vs ...string) predicate.Punch {
+	return predicate.Punch(sql.FieldIn(FieldRejectedReason, vs...))
+}
+
+// RejectedReasonNotIn applies the NotIn predicate on the "rejected_reason" field.
+func RejectedReasonNotIn(vs ...string) predicate.Punch {
+	return predicate.Punch(sql.FieldNotIn(FieldRejectedReason, vs...))
+}
+
+// RejectedReasonGT applies the GT predicate on the "rejected_reason" field.
+func RejectedReasonGT(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldGT(FieldRejectedReason, v))
+}
+
+// RejectedReasonGTE applies the GTE predicate on the "rejected_reason" field.
+func RejectedReasonGTE(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldGTE(FieldRejectedReason, v))
+}
+
+// RejectedReasonLT applies the LT predicate on the "rejected_reason" field.
+func RejectedReasonLT(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldLT(FieldRejectedReason, v))
+}
+
+// RejectedReasonLTE applies the LTE predicate on the "rejected_reason" field.
+func RejectedReasonLTE(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldLTE(FieldRejectedReason, v))
+}
+
+// RejectedReasonContains applies the Contains predicate on the "rejected_reason" field.
+func RejectedReasonContains(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldContains(FieldRejectedReason, v))
+}
+
+// RejectedReasonHasPrefix applies the HasPrefix predicate on the "rejected_reason" field.
+func RejectedReasonHasPrefix(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldHasPrefix(FieldRejectedReason, v))
+}
+
+// RejectedReasonHasSuffix applies the HasSuffix predicate on the "rejected_reason" field.
+func RejectedReasonHasSuffix(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldHasSuffix(FieldRejectedReason, v))
+}
+
+// RejectedReasonIsNil applies the IsNil predicate on the "rejected_reason" field.
+func RejectedReasonIsNil() predicate.Punch {
+	return predicate.Punch(sql.FieldIsNull(FieldRejectedReason))
+}
+
+// RejectedReasonNotNil applies the NotNil predicate on the "rejected_reason" field.
+func RejectedReasonNotNil() predicate.Punch {
+	return predicate.Punch(sql.FieldNotNull(FieldRejectedReason))
+}
+
+// RejectedReasonEqualFold applies the EqualFold predicate on the "rejected_reason" field.
+func RejectedReasonEqualFold(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldEqualFold(FieldRejectedReason, v))
+}
+
+// RejectedReasonContainsFold applies the ContainsFold predicate on the "rejected_reason" field.
+func RejectedReasonContainsFold(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldContainsFold(FieldRejectedReason, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.Punch {
+	return predicate.Punch(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.Punch {
+	return predicate.Punch(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldContains(FieldClientID, v))
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldHasPrefix(FieldClientID, v))
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldHasSuffix(FieldClientID, v))
+}
+
+// ClientIDIsNil applies the IsNil predicate on the "client_id" field.
+func ClientIDIsNil() predicate.Punch {
+	return predicate.Punch(sql.FieldIsNull(FieldClientID))
+}
+
+// ClientIDNotNil applies the NotNil predicate on the "client_id" field.
+func ClientIDNotNil() predicate.Punch {
+	return predicate.Punch(sql.FieldNotNull(FieldClientID))
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldEqualFold(FieldClientID, v))
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.Punch {
+	return predicate.Punch(sql.FieldContainsFold(FieldClientID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Punch {
+	return predicate.Punch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Punch {
+	return predicate.Punch(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Punch {
+	return predicate.Punch(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Punch {
+	return predicate.Punch(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Punch {
+	return predicate.Punch(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Punch {
+	return predicate.Punch(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Punch {
+	return predicate.Punch(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Punch {
+	return predicate.Punch(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Punch {
+	return predicate.Punch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Punch {
+	return predicate.Punch(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccessPoint applies the HasEdge predicate on the "access_point" edge.
+func HasAccessPoint() predicate.Punch {
+	return predicate.Punch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccessPointTable, AccessPointColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccessPointWith applies the HasEdge predicate on the "access_point" edge with a given conditions (other predicates).
+func HasAccessPointWith(preds ...predicate.AccessPoint) predicate.Punch {
+	return predicate.Punch(func(s *sql.Selector) {
+		step := newAccessPointStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDevice applies the HasEdge predicate on the "device" edge.
+func HasDevice() predicate.Punch {
+	return predicate.Punch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DeviceTable, DeviceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeviceWith applies the HasEdge predicate on the "device" edge with a given conditions (other predicates).
+func HasDeviceWith(preds ...predicate.Device) predicate.Punch {
+	return predicate.Punch(func(s *sql.Selector) {
+		step := newDeviceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttendanceDay applies the HasEdge predicate on the "attendance_day" edge.
+func HasAttendanceDay() predicate.Punch {
+	return predicate.Punch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AttendanceDayTable, AttendanceDayColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttendanceDayWith applies the HasEdge predicate on the "attendance_day" edge with a given conditions (other predicates).
+func HasAttendanceDayWith(preds ...predicate.AttendanceDay) predicate.Punch {
+	return predicate.Punch(func(s *sql.Selector) {
+		step := newAttendanceDayStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Punch) predicate.Punch {
+	return predicate.Punch(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Punch) predicate.Punch {
+	return predicate.Punch(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Punch) predicate.Punch {
+	return predicate.Punch(sql.NotPredicates(p))
+}
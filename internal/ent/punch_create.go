@@ -0,0 +1,469 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/accesspoint"
+	"back/internal/ent/attendanceday"
+	"back/internal/ent/device"
+	"back/internal/ent/punch"
+	"back/internal/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PunchCreate is the builder for creating a Punch entity.
+type PunchCreate struct {
+	config
+	mutation *PunchMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PunchCreate) SetUserID(v int) *PunchCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetBranchID sets the "branch_id" field.
+func (_c *PunchCreate) SetBranchID(v int) *PunchCreate {
+	_c.mutation.SetBranchID(v)
+	return _c
+}
+
+// SetAccessPointID sets the "access_point_id" field.
+func (_c *PunchCreate) SetAccessPointID(v int) *PunchCreate {
+	_c.mutation.SetAccessPointID(v)
+	return _c
+}
+
+// SetNillableAccessPointID sets the "access_point_id" field if the given value is not nil.
+func (_c *PunchCreate) SetNillableAccessPointID(v *int) *PunchCreate {
+	if v != nil {
+		_c.SetAccessPointID(*v)
+	}
+	return _c
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_c *PunchCreate) SetDeviceID(v int) *PunchCreate {
+	_c.mutation.SetDeviceID(v)
+	return _c
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (_c *PunchCreate) SetNillableDeviceID(v *int) *PunchCreate {
+	if v != nil {
+		_c.SetDeviceID(*v)
+	}
+	return _c
+}
+
+// SetAttendanceDayID sets the "attendance_day_id" field.
+func (_c *PunchCreate) SetAttendanceDayID(v int) *PunchCreate {
+	_c.mutation.SetAttendanceDayID(v)
+	return _c
+}
+
+// SetNillableAttendanceDayID sets the "attendance_day_id" field if the given value is not nil.
+func (_c *PunchCreate) SetNillableAttendanceDayID(v *int) *PunchCreate {
+	if v != nil {
+		_c.SetAttendanceDayID(*v)
+	}
+	return _c
+}
+
+// SetPunchedAt sets the "punched_at" field.
+func (_c *PunchCreate) SetPunchedAt(v time.Time) *PunchCreate {
+	_c.mutation.SetPunchedAt(v)
+	return _c
+}
+
+// SetMethod sets the "method" field.
+func (_c *PunchCreate) SetMethod(v string) *PunchCreate {
+	_c.mutation.SetMethod(v)
+	return _c
+}
+
+// SetDirection sets the "direction" field.
+func (_c *PunchCreate) SetDirection(v string) *PunchCreate {
+	_c.mutation.SetDirection(v)
+	return _c
+}
+
+// SetNillableDirection sets the "direction" field if the given value is not nil.
+func (_c *PunchCreate) SetNillableDirection(v *string) *PunchCreate {
+	if v != nil {
+		_c.SetDirection(*v)
+	}
+	return _c
+}
+
+// SetRawPayload sets the "raw_payload" field.
+func (_c *PunchCreate) SetRawPayload(v string) *PunchCreate {
+	_c.mutation.SetRawPayload(v)
+	return _c
+}
+
+// SetNillableRawPayload sets the "raw_payload" field if the given value is not nil.
+func (_c *PunchCreate) SetNillableRawPayload(v *string) *PunchCreate {
+	if v != nil {
+		_c.SetRawPayload(*v)
+	}
+	return _c
+}
+
+// SetRejectedReason sets the "rejected_reason" field.
+func (_c *PunchCreate) SetRejectedReason(v string) *PunchCreate {
+	_c.mutation.SetRejectedReason(v)
+	return _c
+}
+
+// SetNillableRejectedReason sets the "rejected_reason" field if the given value is not nil.
+func (_c *PunchCreate) SetNillableRejectedReason(v *string) *PunchCreate {
+	if v != nil {
+		_c.SetRejectedReason(*v)
+	}
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *PunchCreate) SetClientID(v string) *PunchCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_c *PunchCreate) SetNillableClientID(v *string) *PunchCreate {
+	if v != nil {
+		_c.SetClientID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PunchCreate) SetCreatedAt(v time.Time) *PunchCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PunchCreate) SetNillableCreatedAt(v *time.Time) *PunchCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *PunchCreate) SetUser(v *User) *PunchCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetAccessPoint sets the "access_point" edge to the AccessPoint entity.
+func (_c *PunchCreate) SetAccessPoint(v *AccessPoint) *PunchCreate {
+	return _c.SetAccessPointID(v.ID)
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (_c *PunchCreate) SetDevice(v *Device) *PunchCreate {
+	return _c.SetDeviceID(v.ID)
+}
+
+// SetAttendanceDay sets the "attendance_day" edge to the AttendanceDay entity.
+func (_c *PunchCreate) SetAttendanceDay(v *AttendanceDay) *PunchCreate {
+	return _c.SetAttendanceDayID(v.ID)
+}
+
+// Mutation returns the PunchMutation object of the builder.
+func (_c *PunchCreate) Mutation() *PunchMutation {
+	return _c.mutation
+}
+
+// Save creates the Punch in the database.
+func (_c *PunchCreate) Save(ctx context.Context) (*Punch, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PunchCreate) SaveX(ctx context.Context) *Punch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PunchCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PunchCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PunchCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := punch.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PunchCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Punch.user_id"`)}
+	}
+	if _, ok := _c.mutation.BranchID(); !ok {
+		return &ValidationError{Name: "branch_id", err: errors.New(`ent: missing required field "Punch.branch_id"`)}
+	}
+	if _, ok := _c.mutation.PunchedAt(); !ok {
+		return &ValidationError{Name: "punched_at", err: errors.New(`ent: missing required field "Punch.punched_at"`)}
+	}
+	if _, ok := _c.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`ent: missing required field "Punch.method"`)}
+	}
+	if v, ok := _c.mutation.Method(); ok {
+		if err := punch.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "Punch.method": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Direction(); ok {
+		if err := punch.DirectionValidator(v); err != nil {
+			return &ValidationError{Name: "direction", err: fmt.Errorf(`ent: validator failed for field "Punch.direction": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Punch.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Punch.user"`)}
+	}
+	return nil
+}
+
+func (_c *PunchCreate) sqlSave(ctx context.Context) (*Punch, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PunchCreate) createSpec() (*Punch, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Punch{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(punch.Table, sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.BranchID(); ok {
+		_spec.SetField(punch.FieldBranchID, field.TypeInt, value)
+		_node.BranchID = value
+	}
+	if value, ok := _c.mutation.PunchedAt(); ok {
+		_spec.SetField(punch.FieldPunchedAt, field.TypeTime, value)
+		_node.PunchedAt = value
+	}
+	if value, ok := _c.mutation.Method(); ok {
+		_spec.SetField(punch.FieldMethod, field.TypeString, value)
+		_node.Method = value
+	}
+	if value, ok := _c.mutation.Direction(); ok {
+		_spec.SetField(punch.FieldDirection, field.TypeString, value)
+		_node.Direction = &value
+	}
+	if value, ok := _c.mutation.RawPayload(); ok {
+		_spec.SetField(punch.FieldRawPayload, field.TypeString, value)
+		_node.RawPayload = &value
+	}
+	if value, ok := _c.mutation.RejectedReason(); ok {
+		_spec.SetField(punch.FieldRejectedReason, field.TypeString, value)
+		_node.RejectedReason = &value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(punch.FieldClientID, field.TypeString, value)
+		_node.ClientID = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(punch.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   punch.UserTable,
+			Columns: []string{punch.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccessPointIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   punch.AccessPointTable,
+			Columns: []string{punch.AccessPointColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesspoint.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccessPointID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   punch.DeviceTable,
+			Columns: []string{punch.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DeviceID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AttendanceDayIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   punch.AttendanceDayTable,
+			Columns: []string{punch.AttendanceDayColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendanceday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AttendanceDayID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PunchCreateBulk is the builder for creating many Punch entities in bulk.
+type PunchCreateBulk struct {
+	config
+	err      error
+	builders []*PunchCreate
+}
+
+// Save creates the Punch entities in the database.
+func (_c *PunchCreateBulk) Save(ctx context.Context) ([]*Punch, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Punch, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PunchMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PunchCreateBulk) SaveX(ctx context.Context) []*Punch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PunchCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PunchCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
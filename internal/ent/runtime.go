@@ -6,15 +6,26 @@ import (
 	"back/internal/ent/accesspoint"
 	"back/internal/ent/address"
 	"back/internal/ent/attendanceday"
+	"back/internal/ent/attendanceedit"
+	"back/internal/ent/auditlog"
 	"back/internal/ent/branch"
 	"back/internal/ent/branchaddress"
 	"back/internal/ent/city"
 	"back/internal/ent/commune"
 	"back/internal/ent/device"
+	"back/internal/ent/holiday"
+	"back/internal/ent/hourbankmovement"
+	"back/internal/ent/leaverequest"
+	"back/internal/ent/markcorrectionrequest"
+	"back/internal/ent/overtimeauthorization"
+	"back/internal/ent/payrollexportprofile"
+	"back/internal/ent/punch"
 	"back/internal/ent/refreshtoken"
 	"back/internal/ent/region"
+	"back/internal/ent/role"
 	"back/internal/ent/schema"
 	"back/internal/ent/shift"
+	"back/internal/ent/shiftcycleday"
 	"back/internal/ent/shiftday"
 	"back/internal/ent/shiftinstance"
 	"back/internal/ent/user"
@@ -23,6 +34,7 @@ import (
 	"back/internal/ent/userdayoverride"
 	"back/internal/ent/userqrsession"
 	"back/internal/ent/usershiftassignment"
+	"back/internal/ent/vacationadjustment"
 	"time"
 )
 
@@ -72,20 +84,48 @@ func init() {
 	address.UpdateDefaultUpdatedAt = addressDescUpdatedAt.UpdateDefault.(func() time.Time)
 	attendancedayFields := schema.AttendanceDay{}.Fields()
 	_ = attendancedayFields
+	// attendancedayDescIsHoliday is the schema descriptor for is_holiday field.
+	attendancedayDescIsHoliday := attendancedayFields[4].Descriptor()
+	// attendanceday.DefaultIsHoliday holds the default value on creation for the is_holiday field.
+	attendanceday.DefaultIsHoliday = attendancedayDescIsHoliday.Default.(bool)
 	// attendancedayDescEdited is the schema descriptor for edited field.
-	attendancedayDescEdited := attendancedayFields[13].Descriptor()
+	attendancedayDescEdited := attendancedayFields[16].Descriptor()
 	// attendanceday.DefaultEdited holds the default value on creation for the edited field.
 	attendanceday.DefaultEdited = attendancedayDescEdited.Default.(bool)
 	// attendancedayDescCreatedAt is the schema descriptor for created_at field.
-	attendancedayDescCreatedAt := attendancedayFields[16].Descriptor()
+	attendancedayDescCreatedAt := attendancedayFields[20].Descriptor()
 	// attendanceday.DefaultCreatedAt holds the default value on creation for the created_at field.
 	attendanceday.DefaultCreatedAt = attendancedayDescCreatedAt.Default.(func() time.Time)
 	// attendancedayDescUpdatedAt is the schema descriptor for updated_at field.
-	attendancedayDescUpdatedAt := attendancedayFields[17].Descriptor()
+	attendancedayDescUpdatedAt := attendancedayFields[21].Descriptor()
 	// attendanceday.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	attendanceday.DefaultUpdatedAt = attendancedayDescUpdatedAt.Default.(func() time.Time)
 	// attendanceday.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	attendanceday.UpdateDefaultUpdatedAt = attendancedayDescUpdatedAt.UpdateDefault.(func() time.Time)
+	attendanceeditFields := schema.AttendanceEdit{}.Fields()
+	_ = attendanceeditFields
+	// attendanceeditDescReason is the schema descriptor for reason field.
+	attendanceeditDescReason := attendanceeditFields[3].Descriptor()
+	// attendanceedit.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	attendanceedit.ReasonValidator = attendanceeditDescReason.Validators[0].(func(string) error)
+	// attendanceeditDescCreatedAt is the schema descriptor for created_at field.
+	attendanceeditDescCreatedAt := attendanceeditFields[12].Descriptor()
+	// attendanceedit.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attendanceedit.DefaultCreatedAt = attendanceeditDescCreatedAt.Default.(func() time.Time)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescEntityType is the schema descriptor for entity_type field.
+	auditlogDescEntityType := auditlogFields[3].Descriptor()
+	// auditlog.EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
+	auditlog.EntityTypeValidator = auditlogDescEntityType.Validators[0].(func(string) error)
+	// auditlogDescOperation is the schema descriptor for operation field.
+	auditlogDescOperation := auditlogFields[5].Descriptor()
+	// auditlog.OperationValidator is a validator for the "operation" field. It is called by the builders before save.
+	auditlog.OperationValidator = auditlogDescOperation.Validators[0].(func(string) error)
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[7].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
 	branchFields := schema.Branch{}.Fields()
 	_ = branchFields
 	// branchDescName is the schema descriptor for name field.
@@ -188,6 +228,178 @@ func init() {
 	device.DefaultUpdatedAt = deviceDescUpdatedAt.Default.(func() time.Time)
 	// device.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	device.UpdateDefaultUpdatedAt = deviceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	holidayFields := schema.Holiday{}.Fields()
+	_ = holidayFields
+	// holidayDescName is the schema descriptor for name field.
+	holidayDescName := holidayFields[1].Descriptor()
+	// holiday.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	holiday.NameValidator = holidayDescName.Validators[0].(func(string) error)
+	// holidayDescScope is the schema descriptor for scope field.
+	holidayDescScope := holidayFields[2].Descriptor()
+	// holiday.DefaultScope holds the default value on creation for the scope field.
+	holiday.DefaultScope = holidayDescScope.Default.(string)
+	// holiday.ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	holiday.ScopeValidator = holidayDescScope.Validators[0].(func(string) error)
+	// holidayDescIrrenunciable is the schema descriptor for irrenunciable field.
+	holidayDescIrrenunciable := holidayFields[5].Descriptor()
+	// holiday.DefaultIrrenunciable holds the default value on creation for the irrenunciable field.
+	holiday.DefaultIrrenunciable = holidayDescIrrenunciable.Default.(bool)
+	// holidayDescCreatedAt is the schema descriptor for created_at field.
+	holidayDescCreatedAt := holidayFields[6].Descriptor()
+	// holiday.DefaultCreatedAt holds the default value on creation for the created_at field.
+	holiday.DefaultCreatedAt = holidayDescCreatedAt.Default.(func() time.Time)
+	// holidayDescUpdatedAt is the schema descriptor for updated_at field.
+	holidayDescUpdatedAt := holidayFields[7].Descriptor()
+	// holiday.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	holiday.DefaultUpdatedAt = holidayDescUpdatedAt.Default.(func() time.Time)
+	// holiday.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	holiday.UpdateDefaultUpdatedAt = holidayDescUpdatedAt.UpdateDefault.(func() time.Time)
+	hourbankmovementFields := schema.HourBankMovement{}.Fields()
+	_ = hourbankmovementFields
+	// hourbankmovementDescKind is the schema descriptor for kind field.
+	hourbankmovementDescKind := hourbankmovementFields[2].Descriptor()
+	// hourbankmovement.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	hourbankmovement.KindValidator = hourbankmovementDescKind.Validators[0].(func(string) error)
+	// hourbankmovementDescCreatedAt is the schema descriptor for created_at field.
+	hourbankmovementDescCreatedAt := hourbankmovementFields[7].Descriptor()
+	// hourbankmovement.DefaultCreatedAt holds the default value on creation for the created_at field.
+	hourbankmovement.DefaultCreatedAt = hourbankmovementDescCreatedAt.Default.(func() time.Time)
+	// hourbankmovementDescUpdatedAt is the schema descriptor for updated_at field.
+	hourbankmovementDescUpdatedAt := hourbankmovementFields[8].Descriptor()
+	// hourbankmovement.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	hourbankmovement.DefaultUpdatedAt = hourbankmovementDescUpdatedAt.Default.(func() time.Time)
+	// hourbankmovement.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	hourbankmovement.UpdateDefaultUpdatedAt = hourbankmovementDescUpdatedAt.UpdateDefault.(func() time.Time)
+	leaverequestFields := schema.LeaveRequest{}.Fields()
+	_ = leaverequestFields
+	// leaverequestDescType is the schema descriptor for type field.
+	leaverequestDescType := leaverequestFields[1].Descriptor()
+	// leaverequest.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	leaverequest.TypeValidator = leaverequestDescType.Validators[0].(func(string) error)
+	// leaverequestDescStatus is the schema descriptor for status field.
+	leaverequestDescStatus := leaverequestFields[4].Descriptor()
+	// leaverequest.DefaultStatus holds the default value on creation for the status field.
+	leaverequest.DefaultStatus = leaverequestDescStatus.Default.(string)
+	// leaverequest.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	leaverequest.StatusValidator = leaverequestDescStatus.Validators[0].(func(string) error)
+	// leaverequestDescCreatedAt is the schema descriptor for created_at field.
+	leaverequestDescCreatedAt := leaverequestFields[9].Descriptor()
+	// leaverequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	leaverequest.DefaultCreatedAt = leaverequestDescCreatedAt.Default.(func() time.Time)
+	// leaverequestDescUpdatedAt is the schema descriptor for updated_at field.
+	leaverequestDescUpdatedAt := leaverequestFields[10].Descriptor()
+	// leaverequest.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	leaverequest.DefaultUpdatedAt = leaverequestDescUpdatedAt.Default.(func() time.Time)
+	// leaverequest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	leaverequest.UpdateDefaultUpdatedAt = leaverequestDescUpdatedAt.UpdateDefault.(func() time.Time)
+	markcorrectionrequestFields := schema.MarkCorrectionRequest{}.Fields()
+	_ = markcorrectionrequestFields
+	// markcorrectionrequestDescReason is the schema descriptor for reason field.
+	markcorrectionrequestDescReason := markcorrectionrequestFields[7].Descriptor()
+	// markcorrectionrequest.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	markcorrectionrequest.ReasonValidator = markcorrectionrequestDescReason.Validators[0].(func(string) error)
+	// markcorrectionrequestDescStatus is the schema descriptor for status field.
+	markcorrectionrequestDescStatus := markcorrectionrequestFields[11].Descriptor()
+	// markcorrectionrequest.DefaultStatus holds the default value on creation for the status field.
+	markcorrectionrequest.DefaultStatus = markcorrectionrequestDescStatus.Default.(string)
+	// markcorrectionrequest.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	markcorrectionrequest.StatusValidator = markcorrectionrequestDescStatus.Validators[0].(func(string) error)
+	// markcorrectionrequestDescCreatedAt is the schema descriptor for created_at field.
+	markcorrectionrequestDescCreatedAt := markcorrectionrequestFields[15].Descriptor()
+	// markcorrectionrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	markcorrectionrequest.DefaultCreatedAt = markcorrectionrequestDescCreatedAt.Default.(func() time.Time)
+	// markcorrectionrequestDescUpdatedAt is the schema descriptor for updated_at field.
+	markcorrectionrequestDescUpdatedAt := markcorrectionrequestFields[16].Descriptor()
+	// markcorrectionrequest.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	markcorrectionrequest.DefaultUpdatedAt = markcorrectionrequestDescUpdatedAt.Default.(func() time.Time)
+	// markcorrectionrequest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	markcorrectionrequest.UpdateDefaultUpdatedAt = markcorrectionrequestDescUpdatedAt.UpdateDefault.(func() time.Time)
+	overtimeauthorizationFields := schema.OvertimeAuthorization{}.Fields()
+	_ = overtimeauthorizationFields
+	// overtimeauthorizationDescMaxMinutes is the schema descriptor for max_minutes field.
+	overtimeauthorizationDescMaxMinutes := overtimeauthorizationFields[2].Descriptor()
+	// overtimeauthorization.MaxMinutesValidator is a validator for the "max_minutes" field. It is called by the builders before save.
+	overtimeauthorization.MaxMinutesValidator = overtimeauthorizationDescMaxMinutes.Validators[0].(func(int) error)
+	// overtimeauthorizationDescStatus is the schema descriptor for status field.
+	overtimeauthorizationDescStatus := overtimeauthorizationFields[3].Descriptor()
+	// overtimeauthorization.DefaultStatus holds the default value on creation for the status field.
+	overtimeauthorization.DefaultStatus = overtimeauthorizationDescStatus.Default.(string)
+	// overtimeauthorization.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	overtimeauthorization.StatusValidator = overtimeauthorizationDescStatus.Validators[0].(func(string) error)
+	// overtimeauthorizationDescCreatedAt is the schema descriptor for created_at field.
+	overtimeauthorizationDescCreatedAt := overtimeauthorizationFields[9].Descriptor()
+	// overtimeauthorization.DefaultCreatedAt holds the default value on creation for the created_at field.
+	overtimeauthorization.DefaultCreatedAt = overtimeauthorizationDescCreatedAt.Default.(func() time.Time)
+	// overtimeauthorizationDescUpdatedAt is the schema descriptor for updated_at field.
+	overtimeauthorizationDescUpdatedAt := overtimeauthorizationFields[10].Descriptor()
+	// overtimeauthorization.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	overtimeauthorization.DefaultUpdatedAt = overtimeauthorizationDescUpdatedAt.Default.(func() time.Time)
+	// overtimeauthorization.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	overtimeauthorization.UpdateDefaultUpdatedAt = overtimeauthorizationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	payrollexportprofileFields := schema.PayrollExportProfile{}.Fields()
+	_ = payrollexportprofileFields
+	// payrollexportprofileDescName is the schema descriptor for name field.
+	payrollexportprofileDescName := payrollexportprofileFields[0].Descriptor()
+	// payrollexportprofile.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	payrollexportprofile.NameValidator = payrollexportprofileDescName.Validators[0].(func(string) error)
+	// payrollexportprofileDescSeparator is the schema descriptor for separator field.
+	payrollexportprofileDescSeparator := payrollexportprofileFields[2].Descriptor()
+	// payrollexportprofile.DefaultSeparator holds the default value on creation for the separator field.
+	payrollexportprofile.DefaultSeparator = payrollexportprofileDescSeparator.Default.(string)
+	// payrollexportprofileDescDateFormat is the schema descriptor for date_format field.
+	payrollexportprofileDescDateFormat := payrollexportprofileFields[3].Descriptor()
+	// payrollexportprofile.DefaultDateFormat holds the default value on creation for the date_format field.
+	payrollexportprofile.DefaultDateFormat = payrollexportprofileDescDateFormat.Default.(string)
+	// payrollexportprofileDescDecimalSeparator is the schema descriptor for decimal_separator field.
+	payrollexportprofileDescDecimalSeparator := payrollexportprofileFields[4].Descriptor()
+	// payrollexportprofile.DefaultDecimalSeparator holds the default value on creation for the decimal_separator field.
+	payrollexportprofile.DefaultDecimalSeparator = payrollexportprofileDescDecimalSeparator.Default.(string)
+	// payrollexportprofileDescPeriod is the schema descriptor for period field.
+	payrollexportprofileDescPeriod := payrollexportprofileFields[5].Descriptor()
+	// payrollexportprofile.DefaultPeriod holds the default value on creation for the period field.
+	payrollexportprofile.DefaultPeriod = payrollexportprofileDescPeriod.Default.(string)
+	// payrollexportprofileDescIncludeHeader is the schema descriptor for include_header field.
+	payrollexportprofileDescIncludeHeader := payrollexportprofileFields[6].Descriptor()
+	// payrollexportprofile.DefaultIncludeHeader holds the default value on creation for the include_header field.
+	payrollexportprofile.DefaultIncludeHeader = payrollexportprofileDescIncludeHeader.Default.(bool)
+	// payrollexportprofileDescCreatedAt is the schema descriptor for created_at field.
+	payrollexportprofileDescCreatedAt := payrollexportprofileFields[8].Descriptor()
+	// payrollexportprofile.DefaultCreatedAt holds the default value on creation for the created_at field.
+	payrollexportprofile.DefaultCreatedAt = payrollexportprofileDescCreatedAt.Default.(func() time.Time)
+	// payrollexportprofileDescUpdatedAt is the schema descriptor for updated_at field.
+	payrollexportprofileDescUpdatedAt := payrollexportprofileFields[9].Descriptor()
+	// payrollexportprofile.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	payrollexportprofile.DefaultUpdatedAt = payrollexportprofileDescUpdatedAt.Default.(func() time.Time)
+	// payrollexportprofile.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	payrollexportprofile.UpdateDefaultUpdatedAt = payrollexportprofileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	punchFields := schema.Punch{}.Fields()
+	_ = punchFields
+	// punchDescMethod is the schema descriptor for method field.
+	punchDescMethod := punchFields[6].Descriptor()
+	// punch.MethodValidator is a validator for the "method" field. It is called by the builders before save.
+	punch.MethodValidator = func() func(string) error {
+		validators := punchDescMethod.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(method string) error {
+			for _, fn := range fns {
+				if err := fn(method); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// punchDescDirection is the schema descriptor for direction field.
+	punchDescDirection := punchFields[7].Descriptor()
+	// punch.DirectionValidator is a validator for the "direction" field. It is called by the builders before save.
+	punch.DirectionValidator = punchDescDirection.Validators[0].(func(string) error)
+	// punchDescCreatedAt is the schema descriptor for created_at field.
+	punchDescCreatedAt := punchFields[11].Descriptor()
+	// punch.DefaultCreatedAt holds the default value on creation for the created_at field.
+	punch.DefaultCreatedAt = punchDescCreatedAt.Default.(func() time.Time)
 	refreshtokenFields := schema.RefreshToken{}.Fields()
 	_ = refreshtokenFields
 	// refreshtokenDescTokenHash is the schema descriptor for token_hash field.
@@ -222,6 +434,30 @@ func init() {
 	region.DefaultUpdatedAt = regionDescUpdatedAt.Default.(func() time.Time)
 	// region.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	region.UpdateDefaultUpdatedAt = regionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	roleFields := schema.Role{}.Fields()
+	_ = roleFields
+	// roleDescName is the schema descriptor for name field.
+	roleDescName := roleFields[0].Descriptor()
+	// role.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	role.NameValidator = roleDescName.Validators[0].(func(string) error)
+	// roleDescPermissions is the schema descriptor for permissions field.
+	roleDescPermissions := roleFields[2].Descriptor()
+	// role.DefaultPermissions holds the default value on creation for the permissions field.
+	role.DefaultPermissions = roleDescPermissions.Default.([]string)
+	// roleDescIsSystem is the schema descriptor for is_system field.
+	roleDescIsSystem := roleFields[3].Descriptor()
+	// role.DefaultIsSystem holds the default value on creation for the is_system field.
+	role.DefaultIsSystem = roleDescIsSystem.Default.(bool)
+	// roleDescCreatedAt is the schema descriptor for created_at field.
+	roleDescCreatedAt := roleFields[4].Descriptor()
+	// role.DefaultCreatedAt holds the default value on creation for the created_at field.
+	role.DefaultCreatedAt = roleDescCreatedAt.Default.(func() time.Time)
+	// roleDescUpdatedAt is the schema descriptor for updated_at field.
+	roleDescUpdatedAt := roleFields[5].Descriptor()
+	// role.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	role.DefaultUpdatedAt = roleDescUpdatedAt.Default.(func() time.Time)
+	// role.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	role.UpdateDefaultUpdatedAt = roleDescUpdatedAt.UpdateDefault.(func() time.Time)
 	shiftFields := schema.Shift{}.Fields()
 	_ = shiftFields
 	// shiftDescName is the schema descriptor for name field.
@@ -240,24 +476,70 @@ func init() {
 	shiftDescBreakMinutes := shiftFields[5].Descriptor()
 	// shift.DefaultBreakMinutes holds the default value on creation for the break_minutes field.
 	shift.DefaultBreakMinutes = shiftDescBreakMinutes.Default.(int)
+	// shiftDescEntryToleranceMinutes is the schema descriptor for entry_tolerance_minutes field.
+	shiftDescEntryToleranceMinutes := shiftFields[6].Descriptor()
+	// shift.DefaultEntryToleranceMinutes holds the default value on creation for the entry_tolerance_minutes field.
+	shift.DefaultEntryToleranceMinutes = shiftDescEntryToleranceMinutes.Default.(int)
+	// shift.EntryToleranceMinutesValidator is a validator for the "entry_tolerance_minutes" field. It is called by the builders before save.
+	shift.EntryToleranceMinutesValidator = shiftDescEntryToleranceMinutes.Validators[0].(func(int) error)
+	// shiftDescExitToleranceMinutes is the schema descriptor for exit_tolerance_minutes field.
+	shiftDescExitToleranceMinutes := shiftFields[7].Descriptor()
+	// shift.DefaultExitToleranceMinutes holds the default value on creation for the exit_tolerance_minutes field.
+	shift.DefaultExitToleranceMinutes = shiftDescExitToleranceMinutes.Default.(int)
+	// shift.ExitToleranceMinutesValidator is a validator for the "exit_tolerance_minutes" field. It is called by the builders before save.
+	shift.ExitToleranceMinutesValidator = shiftDescExitToleranceMinutes.Validators[0].(func(int) error)
+	// shiftDescMinOvertimeMinutes is the schema descriptor for min_overtime_minutes field.
+	shiftDescMinOvertimeMinutes := shiftFields[8].Descriptor()
+	// shift.DefaultMinOvertimeMinutes holds the default value on creation for the min_overtime_minutes field.
+	shift.DefaultMinOvertimeMinutes = shiftDescMinOvertimeMinutes.Default.(int)
+	// shift.MinOvertimeMinutesValidator is a validator for the "min_overtime_minutes" field. It is called by the builders before save.
+	shift.MinOvertimeMinutesValidator = shiftDescMinOvertimeMinutes.Validators[0].(func(int) error)
 	// shiftDescCrossesMidnight is the schema descriptor for crosses_midnight field.
-	shiftDescCrossesMidnight := shiftFields[6].Descriptor()
+	shiftDescCrossesMidnight := shiftFields[9].Descriptor()
 	// shift.DefaultCrossesMidnight holds the default value on creation for the crosses_midnight field.
 	shift.DefaultCrossesMidnight = shiftDescCrossesMidnight.Default.(bool)
 	// shiftDescIsActive is the schema descriptor for is_active field.
-	shiftDescIsActive := shiftFields[7].Descriptor()
+	shiftDescIsActive := shiftFields[10].Descriptor()
 	// shift.DefaultIsActive holds the default value on creation for the is_active field.
 	shift.DefaultIsActive = shiftDescIsActive.Default.(bool)
+	// shiftDescPatternType is the schema descriptor for pattern_type field.
+	shiftDescPatternType := shiftFields[11].Descriptor()
+	// shift.DefaultPatternType holds the default value on creation for the pattern_type field.
+	shift.DefaultPatternType = shiftDescPatternType.Default.(string)
+	// shift.PatternTypeValidator is a validator for the "pattern_type" field. It is called by the builders before save.
+	shift.PatternTypeValidator = shiftDescPatternType.Validators[0].(func(string) error)
+	// shiftDescCycleLength is the schema descriptor for cycle_length field.
+	shiftDescCycleLength := shiftFields[12].Descriptor()
+	// shift.CycleLengthValidator is a validator for the "cycle_length" field. It is called by the builders before save.
+	shift.CycleLengthValidator = shiftDescCycleLength.Validators[0].(func(int) error)
 	// shiftDescCreatedAt is the schema descriptor for created_at field.
-	shiftDescCreatedAt := shiftFields[8].Descriptor()
+	shiftDescCreatedAt := shiftFields[14].Descriptor()
 	// shift.DefaultCreatedAt holds the default value on creation for the created_at field.
 	shift.DefaultCreatedAt = shiftDescCreatedAt.Default.(func() time.Time)
 	// shiftDescUpdatedAt is the schema descriptor for updated_at field.
-	shiftDescUpdatedAt := shiftFields[9].Descriptor()
+	shiftDescUpdatedAt := shiftFields[15].Descriptor()
 	// shift.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	shift.DefaultUpdatedAt = shiftDescUpdatedAt.Default.(func() time.Time)
 	// shift.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	shift.UpdateDefaultUpdatedAt = shiftDescUpdatedAt.UpdateDefault.(func() time.Time)
+	shiftcycledayFields := schema.ShiftCycleDay{}.Fields()
+	_ = shiftcycledayFields
+	// shiftcycledayDescDayIndex is the schema descriptor for day_index field.
+	shiftcycledayDescDayIndex := shiftcycledayFields[1].Descriptor()
+	// shiftcycleday.DayIndexValidator is a validator for the "day_index" field. It is called by the builders before save.
+	shiftcycleday.DayIndexValidator = shiftcycledayDescDayIndex.Validators[0].(func(int) error)
+	// shiftcycledayDescIsWorkingDay is the schema descriptor for is_working_day field.
+	shiftcycledayDescIsWorkingDay := shiftcycledayFields[2].Descriptor()
+	// shiftcycleday.DefaultIsWorkingDay holds the default value on creation for the is_working_day field.
+	shiftcycleday.DefaultIsWorkingDay = shiftcycledayDescIsWorkingDay.Default.(bool)
+	// shiftcycledayDescMode is the schema descriptor for mode field.
+	shiftcycledayDescMode := shiftcycledayFields[3].Descriptor()
+	// shiftcycleday.DefaultMode holds the default value on creation for the mode field.
+	shiftcycleday.DefaultMode = shiftcycledayDescMode.Default.(string)
+	// shiftcycledayDescCreatedAt is the schema descriptor for created_at field.
+	shiftcycledayDescCreatedAt := shiftcycledayFields[4].Descriptor()
+	// shiftcycleday.DefaultCreatedAt holds the default value on creation for the created_at field.
+	shiftcycleday.DefaultCreatedAt = shiftcycledayDescCreatedAt.Default.(func() time.Time)
 	shiftdayFields := schema.ShiftDay{}.Fields()
 	_ = shiftdayFields
 	// shiftdayDescWeekday is the schema descriptor for weekday field.
@@ -310,12 +592,16 @@ func init() {
 	userDescIsActive := userFields[3].Descriptor()
 	// user.DefaultIsActive holds the default value on creation for the is_active field.
 	user.DefaultIsActive = userDescIsActive.Default.(bool)
+	// userDescPriorServiceYears is the schema descriptor for prior_service_years field.
+	userDescPriorServiceYears := userFields[11].Descriptor()
+	// user.PriorServiceYearsValidator is a validator for the "prior_service_years" field. It is called by the builders before save.
+	user.PriorServiceYearsValidator = userDescPriorServiceYears.Validators[0].(func(int) error)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[10].Descriptor()
+	userDescCreatedAt := userFields[12].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[11].Descriptor()
+	userDescUpdatedAt := userFields[13].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -365,7 +651,7 @@ func init() {
 	// userqrsession.DefaultIsRevoked holds the default value on creation for the is_revoked field.
 	userqrsession.DefaultIsRevoked = userqrsessionDescIsRevoked.Default.(bool)
 	// userqrsessionDescCreatedAt is the schema descriptor for created_at field.
-	userqrsessionDescCreatedAt := userqrsessionFields[5].Descriptor()
+	userqrsessionDescCreatedAt := userqrsessionFields[6].Descriptor()
 	// userqrsession.DefaultCreatedAt holds the default value on creation for the created_at field.
 	userqrsession.DefaultCreatedAt = userqrsessionDescCreatedAt.Default.(func() time.Time)
 	usershiftassignmentFields := schema.UserShiftAssignment{}.Fields()
@@ -378,4 +664,14 @@ func init() {
 	usershiftassignmentDescCreatedAt := usershiftassignmentFields[5].Descriptor()
 	// usershiftassignment.DefaultCreatedAt holds the default value on creation for the created_at field.
 	usershiftassignment.DefaultCreatedAt = usershiftassignmentDescCreatedAt.Default.(func() time.Time)
+	vacationadjustmentFields := schema.VacationAdjustment{}.Fields()
+	_ = vacationadjustmentFields
+	// vacationadjustmentDescReason is the schema descriptor for reason field.
+	vacationadjustmentDescReason := vacationadjustmentFields[3].Descriptor()
+	// vacationadjustment.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	vacationadjustment.ReasonValidator = vacationadjustmentDescReason.Validators[0].(func(string) error)
+	// vacationadjustmentDescCreatedAt is the schema descriptor for created_at field.
+	vacationadjustmentDescCreatedAt := vacationadjustmentFields[5].Descriptor()
+	// vacationadjustment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	vacationadjustment.DefaultCreatedAt = vacationadjustmentDescCreatedAt.Default.(func() time.Time)
 }
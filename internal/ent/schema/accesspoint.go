@@ -50,6 +50,9 @@ func (AccessPoint) Edges() []ent.Edge {
 
 		// NUEVO: dispositivos asociados a esta entrada (1:N)
 		edge.To("devices", Device.Type),
+
+		// Marcaciones crudas registradas en esta entrada
+		edge.To("punches", Punch.Type),
 	}
 }
 
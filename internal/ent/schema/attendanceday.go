@@ -24,6 +24,10 @@ func (AttendanceDay) Fields() []ent.Field {
 		// día laboral (sin hora)
 		field.Time("work_date"),
 
+		// feriado de la sucursal trabajado sin turno especial: la jornada no estaba
+		// programada (todo lo trabajado es sobretiempo, sin atraso ni salida anticipada)
+		field.Bool("is_holiday").Default(false),
+
 		// marcaciones
 		field.Time("work_in_at").Optional().Nillable(),
 		field.Time("break_out_at").Optional().Nillable(),
@@ -34,6 +38,10 @@ func (AttendanceDay) Fields() []ent.Field {
 		field.Int("late_minutes").Optional().Nillable(),
 		// minutos de horas extra al salir (positivo = salió después del horario)
 		field.Int("overtime_minutes").Optional().Nillable(),
+		// horas extra cubiertas por una autorización aprobada (pagables)
+		field.Int("approved_overtime_minutes").Optional().Nillable(),
+		// horas extra sin autorización (o sobre el máximo autorizado)
+		field.Int("unapproved_overtime_minutes").Optional().Nillable(),
 		// minutos de salida anticipada (positivo = salió antes del horario)
 		field.Int("early_exit_minutes").Optional().Nillable(),
 		// diferencia de colación respecto al turno (positivo = se pasó, negativo = tomó menos)
@@ -45,6 +53,8 @@ func (AttendanceDay) Fields() []ent.Field {
 		field.Bool("edited").Default(false),
 		field.String("last_edit_reason").Optional().Nillable(),
 		field.Time("edited_at").Optional().Nillable(),
+		// solicitud de corrección (MarkCorrectionRequest) que originó la última edición
+		field.Int("last_correction_request_id").Optional().Nillable(),
 
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
@@ -59,6 +69,9 @@ func (AttendanceDay) Edges() []ent.Edge {
 		edge.To("access_point", AccessPoint.Type).
 			Field("access_point_id").
 			Unique(),
+
+		// marcaciones crudas que originan este día
+		edge.To("punches", Punch.Type),
 	}
 }
 
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AttendanceEdit es el historial de ediciones manuales de un día de asistencia: una fila
+// por edición con los valores antes/después de cada slot. Es de solo inserción (todos
+// los campos son inmutables y no se exponen borrados).
+type AttendanceEdit struct {
+	ent.Schema
+}
+
+func (AttendanceEdit) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("attendance_day_id").
+			Immutable(),
+
+		// usuario que editó (subject del JWT)
+		field.Int("editor_id").
+			Immutable(),
+
+		// solicitud de corrección aprobada que originó la edición
+		field.Int("correction_request_id").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.String("reason").
+			NotEmpty().
+			Immutable(),
+
+		// valores de cada slot antes y después de la edición
+		field.Time("work_in_at_before").Optional().Nillable().Immutable(),
+		field.Time("work_in_at_after").Optional().Nillable().Immutable(),
+		field.Time("break_out_at_before").Optional().Nillable().Immutable(),
+		field.Time("break_out_at_after").Optional().Nillable().Immutable(),
+		field.Time("break_in_at_before").Optional().Nillable().Immutable(),
+		field.Time("break_in_at_after").Optional().Nillable().Immutable(),
+		field.Time("work_out_at_before").Optional().Nillable().Immutable(),
+		field.Time("work_out_at_after").Optional().Nillable().Immutable(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (AttendanceEdit) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("attendance_day", AttendanceDay.Type).
+			Field("attendance_day_id").
+			Unique().
+			Required().
+			Immutable(),
+
+		edge.To("editor", User.Type).
+			Field("editor_id").
+			Unique().
+			Required().
+			Immutable(),
+
+		edge.To("correction_request", MarkCorrectionRequest.Type).
+			Field("correction_request_id").
+			Unique().
+			Immutable(),
+	}
+}
+
+func (AttendanceEdit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("attendance_day_id", "created_at").StorageKey("ix_attendance_edit_day_created"),
+	}
+}
@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog registra cada mutación administrativa (usuarios, sucursales, accesos,
+// dispositivos, turnos y asignaciones). Lo escribe un hook de Ent (ver
+// services.AuditHook); es de solo inserción.
+type AuditLog struct {
+	ent.Schema
+}
+
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		// usuario autenticado que hizo el cambio (nil = proceso interno o dispositivo)
+		field.Int("actor_id").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// dispositivo autenticado que hizo el cambio (token de /device-auth/login)
+		field.Int("actor_device_id").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// X-Request-Id de la petición (middleware.RequestID)
+		field.String("request_id").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// tipo de entidad de Ent ("User", "Branch", ...)
+		field.String("entity_type").
+			NotEmpty().
+			Immutable(),
+
+		field.Int("entity_id").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// "create" | "update" | "delete"
+		field.String("operation").
+			Immutable().
+			Validate(func(s string) error {
+				switch s {
+				case "create", "update", "delete":
+					return nil
+				}
+				return fmt.Errorf("operation must be 'create', 'update' or 'delete'")
+			}),
+
+		// campo -> {"old": ..., "new": ...}; los campos sensibles van redactados
+		field.JSON("changes", map[string]any{}).
+			Optional().
+			Immutable(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_type", "entity_id").StorageKey("ix_audit_log_entity"),
+		index.Fields("actor_id").StorageKey("ix_audit_log_actor"),
+		index.Fields("created_at").StorageKey("ix_audit_log_created"),
+	}
+}
@@ -51,6 +51,8 @@ func (Device) Edges() []ent.Edge {
 			Field("access_point_id").
 			Unique().
 			Required(),
+
+		edge.To("punches", Punch.Type),
 	}
 }
 
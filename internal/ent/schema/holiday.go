@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Holiday es un feriado no laborable. Puede ser nacional, regional o de una sucursal.
+type Holiday struct {
+	ent.Schema
+}
+
+func (Holiday) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("date").
+			SchemaType(map[string]string{"postgres": "date"}),
+
+		field.String("name").
+			NotEmpty(), // "Fiestas Patrias", "Navidad", etc.
+
+		// "national" | "region" | "branch"
+		field.String("scope").
+			Default("national").
+			Validate(func(s string) error {
+				if s != "national" && s != "region" && s != "branch" {
+					return fmt.Errorf("scope must be 'national', 'region' or 'branch'")
+				}
+				return nil
+			}),
+
+		// requerido cuando scope = "region"
+		field.Int("region_id").
+			Optional().
+			Nillable(),
+
+		// requerido cuando scope = "branch"
+		field.Int("branch_id").
+			Optional().
+			Nillable(),
+
+		// feriado irrenunciable (Ley 19.973): el comercio no puede abrir
+		field.Bool("irrenunciable").
+			Default(false),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (Holiday) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("region", Region.Type).
+			Field("region_id").
+			Unique(),
+
+		edge.To("branch", Branch.Type).
+			Field("branch_id").
+			Unique(),
+	}
+}
+
+func (Holiday) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("date").StorageKey("ix_holiday_date"),
+	}
+}
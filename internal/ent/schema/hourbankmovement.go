@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// HourBankMovement es un movimiento de la bolsa de horas del usuario:
+// el balance neto de un día cerrado o un abono/cargo manual.
+type HourBankMovement struct {
+	ent.Schema
+}
+
+func (HourBankMovement) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+
+		field.Time("date").
+			SchemaType(map[string]string{"postgres": "date"}),
+
+		// daily | credit | debit
+		field.String("kind").
+			NotEmpty(),
+
+		// minutos a sumar (positivo) o descontar (negativo)
+		field.Int("minutes"),
+
+		// día de asistencia que originó el movimiento (solo kind=daily)
+		field.Int("attendance_day_id").
+			Optional().
+			Nillable().
+			Unique(),
+
+		field.String("reason").
+			Optional().
+			Nillable(),
+
+		// usuario que registró el movimiento manual
+		field.Int("created_by_id").
+			Optional().
+			Nillable(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (HourBankMovement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("hour_bank_movements").
+			Field("user_id").
+			Unique().
+			Required(),
+
+		edge.To("attendance_day", AttendanceDay.Type).
+			Field("attendance_day_id").
+			Unique(),
+
+		edge.To("created_by", User.Type).
+			Field("created_by_id").
+			Unique(),
+	}
+}
+
+func (HourBankMovement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "date").StorageKey("ix_hour_bank_movement_user_date"),
+	}
+}
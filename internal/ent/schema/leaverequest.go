@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LeaveRequest es una solicitud de ausencia (vacaciones, licencia, permiso) sujeta a aprobación.
+type LeaveRequest struct {
+	ent.Schema
+}
+
+func (LeaveRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+
+		// "vacation" | "medical_license" | "administrative_day" | "unpaid"
+		field.String("type").
+			Validate(func(s string) error {
+				switch s {
+				case "vacation", "medical_license", "administrative_day", "unpaid":
+					return nil
+				}
+				return fmt.Errorf("type must be 'vacation', 'medical_license', 'administrative_day' or 'unpaid'")
+			}),
+
+		// rango de días (ambos inclusive)
+		field.Time("start_date").
+			SchemaType(map[string]string{"postgres": "date"}),
+		field.Time("end_date").
+			SchemaType(map[string]string{"postgres": "date"}),
+
+		// "requested" | "approved" | "rejected" | "cancelled"
+		field.String("status").
+			Default("requested").
+			Validate(func(s string) error {
+				switch s {
+				case "requested", "approved", "rejected", "cancelled":
+					return nil
+				}
+				return fmt.Errorf("status must be 'requested', 'approved', 'rejected' or 'cancelled'")
+			}),
+
+		field.String("reason").
+			Optional().
+			Nillable(),
+
+		// revisión del supervisor
+		field.Int("reviewed_by_id").
+			Optional().
+			Nillable(),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable(),
+		field.String("review_notes").
+			Optional().
+			Nillable(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (LeaveRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("leave_requests").
+			Field("user_id").
+			Unique().
+			Required(),
+
+		edge.To("reviewed_by", User.Type).
+			Field("reviewed_by_id").
+			Unique(),
+	}
+}
+
+func (LeaveRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "start_date").StorageKey("ix_leave_request_user_start"),
+		index.Fields("status").StorageKey("ix_leave_request_status"),
+	}
+}
@@ -0,0 +1,114 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MarkCorrectionRequest es una solicitud del trabajador para corregir una marcación
+// (por ejemplo, una salida no registrada). Los horarios propuestos van en HH:MM, igual
+// que la edición manual de marcaciones.
+type MarkCorrectionRequest struct {
+	ent.Schema
+}
+
+func (MarkCorrectionRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+
+		// día de asistencia a corregir
+		field.Int("attendance_day_id"),
+
+		field.Time("work_date").
+			SchemaType(map[string]string{"postgres": "date"}),
+
+		// horarios propuestos (HH:MM); nil = sin cambios en ese slot
+		field.String("work_in_at").
+			Optional().
+			Nillable(),
+		field.String("break_out_at").
+			Optional().
+			Nillable(),
+		field.String("break_in_at").
+			Optional().
+			Nillable(),
+		field.String("work_out_at").
+			Optional().
+			Nillable(),
+
+		field.String("reason").
+			NotEmpty(),
+
+		// respaldo opcional (foto o PDF); se excluye de los listados
+		field.Bytes("attachment").
+			Optional().
+			Nillable(),
+		field.String("attachment_name").
+			Optional().
+			Nillable(),
+		field.String("attachment_content_type").
+			Optional().
+			Nillable(),
+
+		// "requested" | "approved" | "rejected" | "cancelled"
+		field.String("status").
+			Default("requested").
+			Validate(func(s string) error {
+				switch s {
+				case "requested", "approved", "rejected", "cancelled":
+					return nil
+				}
+				return fmt.Errorf("status must be 'requested', 'approved', 'rejected' or 'cancelled'")
+			}),
+
+		// revisión del supervisor
+		field.Int("reviewed_by_id").
+			Optional().
+			Nillable(),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable(),
+		field.String("review_notes").
+			Optional().
+			Nillable(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (MarkCorrectionRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("mark_correction_requests").
+			Field("user_id").
+			Unique().
+			Required(),
+
+		edge.To("attendance_day", AttendanceDay.Type).
+			Field("attendance_day_id").
+			Unique().
+			Required(),
+
+		edge.To("reviewed_by", User.Type).
+			Field("reviewed_by_id").
+			Unique(),
+	}
+}
+
+func (MarkCorrectionRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "work_date").StorageKey("ix_mark_correction_user_date"),
+		index.Fields("status").StorageKey("ix_mark_correction_status"),
+	}
+}
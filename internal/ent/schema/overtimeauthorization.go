@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OvertimeAuthorization es el pacto previo de horas extra de un usuario para un día,
+// con el máximo de minutos autorizados y el supervisor que lo aprueba.
+type OvertimeAuthorization struct {
+	ent.Schema
+}
+
+func (OvertimeAuthorization) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+
+		// día laboral autorizado
+		field.Time("date").
+			SchemaType(map[string]string{"postgres": "date"}),
+
+		// máximo de minutos extra pagables ese día
+		field.Int("max_minutes").
+			Min(1),
+
+		// "requested" | "approved" | "rejected" | "cancelled"
+		field.String("status").
+			Default("requested").
+			Validate(func(s string) error {
+				switch s {
+				case "requested", "approved", "rejected", "cancelled":
+					return nil
+				}
+				return fmt.Errorf("status must be 'requested', 'approved', 'rejected' or 'cancelled'")
+			}),
+
+		field.String("reason").
+			Optional().
+			Nillable(),
+
+		// quién lo solicitó (usuario o supervisor)
+		field.Int("requested_by_id").
+			Optional().
+			Nillable(),
+
+		// aprobación / rechazo del supervisor
+		field.Int("approved_by_id").
+			Optional().
+			Nillable(),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable(),
+		field.String("review_notes").
+			Optional().
+			Nillable(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (OvertimeAuthorization) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("overtime_authorizations").
+			Field("user_id").
+			Unique().
+			Required(),
+
+		edge.To("requested_by", User.Type).
+			Field("requested_by_id").
+			Unique(),
+
+		edge.To("approved_by", User.Type).
+			Field("approved_by_id").
+			Unique(),
+	}
+}
+
+func (OvertimeAuthorization) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "date").StorageKey("ix_overtime_authorization_user_date"),
+		index.Fields("status").StorageKey("ix_overtime_authorization_status"),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// PayrollColumn es una columna del archivo de remuneraciones: el dato a exportar
+// (field) y el título con que el proveedor lo espera.
+type PayrollColumn struct {
+	Field  string `json:"field"`
+	Header string `json:"header,omitempty"`
+	// valor fijo, solo para field = "constant"
+	Value string `json:"value,omitempty"`
+}
+
+// PayrollExportProfile es un perfil de exportación para un proveedor de remuneraciones:
+// columnas y su orden, separador, formato de fechas y agregación por período.
+type PayrollExportProfile struct {
+	ent.Schema
+}
+
+func (PayrollExportProfile) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Unique(),
+
+		field.String("description").
+			Optional().
+			Nillable(),
+
+		// separador de columnas (un carácter: ";", ",", "|", "\t")
+		field.String("separator").
+			Default(";"),
+
+		// formato de fechas con tokens YYYY, YY, MM, DD (ej: "DD/MM/YYYY")
+		field.String("date_format").
+			Default("YYYY-MM-DD"),
+
+		// separador decimal para las horas ("," o ".")
+		field.String("decimal_separator").
+			Default(","),
+
+		// agregación: "month" (una fila por trabajador) | "week" | "day"
+		field.String("period").
+			Default("month"),
+
+		field.Bool("include_header").
+			Default(true),
+
+		field.JSON("columns", []PayrollColumn{}),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (PayrollExportProfile) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,137 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Punch es el registro crudo e inmutable de cada marcación física.
+// AttendanceDay se deriva a partir de la secuencia de punches del día.
+type Punch struct {
+	ent.Schema
+}
+
+func (Punch) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Immutable(),
+
+		field.Int("branch_id").
+			Immutable(),
+
+		// acceso donde se marcó; queda en nil si el acceso se elimina (la marcación se conserva)
+		field.Int("access_point_id").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// dispositivo que registró la marcación (nil si fue manual)
+		field.Int("device_id").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// día de asistencia al que quedó asociada la marcación
+		field.Int("attendance_day_id").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// instante de la marcación
+		field.Time("punched_at").
+			Immutable(),
+
+		// "qr" | "access_code" | "manual"
+		field.String("method").
+			NotEmpty().
+			Immutable().
+			Validate(func(s string) error {
+				if s != "qr" && s != "access_code" && s != "manual" {
+					return fmt.Errorf("method must be 'qr', 'access_code' or 'manual'")
+				}
+				return nil
+			}),
+
+		// sentido de la marcación: "in" | "out" (según el lector o la secuencia del día)
+		field.String("direction").
+			Optional().
+			Nillable().
+			Immutable().
+			Validate(func(s string) error {
+				if s != "in" && s != "out" {
+					return fmt.Errorf("direction must be 'in' or 'out'")
+				}
+				return nil
+			}),
+
+		// dato leído por el dispositivo (para QR el hash del token; el código de acceso va enmascarado)
+		field.String("raw_payload").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// motivo por el que la marcación no se aplicó a ningún día (nil = aplicada):
+		// "wrong_direction" | "already_completed" | "not_work_day" | "no_shift".
+		// Una marcación rechazada se guarda igual, sin attendance_day_id.
+		field.String("rejected_reason").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// UUID generado por el dispositivo (marcaciones offline); garantiza idempotencia
+		field.String("client_id").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (Punch) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("punches").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+
+		edge.From("access_point", AccessPoint.Type).
+			Ref("punches").
+			Field("access_point_id").
+			Unique().
+			Immutable().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.SetNull,
+			}),
+
+		edge.From("device", Device.Type).
+			Ref("punches").
+			Field("device_id").
+			Unique().
+			Immutable(),
+
+		edge.From("attendance_day", AttendanceDay.Type).
+			Ref("punches").
+			Field("attendance_day_id").
+			Unique().
+			Immutable(),
+	}
+}
+
+func (Punch) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "punched_at").StorageKey("ix_punch_user_time"),
+		index.Fields("attendance_day_id").StorageKey("ix_punch_attendance_day"),
+		index.Fields("client_id").Unique().StorageKey("ux_punch_client_id"),
+	}
+}
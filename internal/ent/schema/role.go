@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Role agrupa permisos del catálogo (auth.Permissions). User.role guarda el nombre del rol.
+type Role struct {
+	ent.Schema
+}
+
+func (Role) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Unique(), // "admin", "supervisor", "user", ...
+
+		field.String("description").
+			Optional().
+			Nillable(),
+
+		// claves del catálogo: "markings:edit", "users:write", ...
+		field.JSON("permissions", []string{}).
+			Default([]string{}),
+
+		// roles de sistema: no se pueden renombrar ni eliminar
+		field.Bool("is_system").
+			Default(false),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
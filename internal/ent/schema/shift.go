@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent"
@@ -24,9 +25,40 @@ func (Shift) Fields() []ent.Field {
 
 		field.Int("break_minutes").Default(0),
 
+		// Tolerancias (minutos): atraso hasta entry_tolerance no cuenta como atraso,
+		// salida anticipada hasta exit_tolerance no cuenta como salida anticipada,
+		// y el sobretiempo solo se reconoce desde min_overtime minutos.
+		field.Int("entry_tolerance_minutes").Default(0).Min(0),
+		field.Int("exit_tolerance_minutes").Default(0).Min(0),
+		field.Int("min_overtime_minutes").Default(0).Min(0),
+
 		field.Bool("crosses_midnight").Default(false),
 		field.Bool("is_active").Default(true),
 
+		// "weekly": días laborables por día de semana (shift_days)
+		// "cycle": rotación de N días (shift_cycle_days) contada desde cycle_anchor_date
+		field.String("pattern_type").
+			Default("weekly").
+			Validate(func(s string) error {
+				switch s {
+				case "weekly", "cycle":
+					return nil
+				}
+				return fmt.Errorf("pattern_type must be 'weekly' or 'cycle'")
+			}),
+
+		// largo del ciclo en días (ej: 8 para un 4x4, 14 para un 7x7)
+		field.Int("cycle_length").
+			Optional().
+			Nillable().
+			Min(1),
+
+		// fecha que corresponde al día 1 del ciclo
+		field.Time("cycle_anchor_date").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{"postgres": "date"}),
+
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -46,6 +78,7 @@ func (Shift) Indexes() []ent.Index {
 func (Shift) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("days", ShiftDay.Type),
+		edge.To("cycle_days", ShiftCycleDay.Type),
 		edge.To("instances", ShiftInstance.Type),
 		edge.To("user_assignments", UserShiftAssignment.Type),
 		edge.To("day_overrides", UserDayOverride.Type),
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ShiftCycleDay es la configuración de un día dentro del ciclo de un turno rotativo.
+type ShiftCycleDay struct {
+	ent.Schema
+}
+
+func (ShiftCycleDay) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("shift_id"),
+
+		// 1 ... cycle_length del turno
+		field.Int("day_index").
+			Min(1),
+
+		field.Bool("is_working_day").Default(true),
+
+		// onsite, remote, hybrid_office, hybrid_home, off
+		field.String("mode").
+			Default("onsite"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (ShiftCycleDay) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("shift", Shift.Type).
+			Ref("cycle_days").
+			Field("shift_id").
+			Unique().
+			Required().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
+
+func (ShiftCycleDay) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("shift_id", "day_index").Unique(),
+	}
+}
@@ -55,6 +55,18 @@ func (User) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		// Datos laborales (base para el cálculo de vacaciones)
+		field.Time("hire_date").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{"postgres": "date"}),
+
+		// años cotizados con empleadores anteriores (feriado progresivo, máx. 10 computables)
+		field.Int("prior_service_years").
+			Optional().
+			Nillable().
+			Min(0),
+
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -85,5 +97,11 @@ func (User) Edges() []ent.Edge {
 		edge.To("shift_assignments", UserShiftAssignment.Type),
 		edge.To("day_overrides", UserDayOverride.Type),
 		edge.To("qr_sessions", UserQRSession.Type),
+		edge.To("punches", Punch.Type),
+		edge.To("leave_requests", LeaveRequest.Type),
+		edge.To("vacation_adjustments", VacationAdjustment.Type),
+		edge.To("overtime_authorizations", OvertimeAuthorization.Type),
+		edge.To("hour_bank_movements", HourBankMovement.Type),
+		edge.To("mark_correction_requests", MarkCorrectionRequest.Type),
 	}
 }
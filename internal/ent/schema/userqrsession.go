@@ -24,6 +24,9 @@ func (UserQRSession) Fields() []ent.Field {
 
 		field.Bool("is_revoked").Default(false),
 
+		// momento de la revocación: los escaneos offline anteriores siguen siendo válidos
+		field.Time("revoked_at").Optional().Nillable(),
+
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// VacationAdjustment es un ajuste manual (positivo o negativo) al saldo de vacaciones.
+type VacationAdjustment struct {
+	ent.Schema
+}
+
+func (VacationAdjustment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+
+		field.Time("date").
+			SchemaType(map[string]string{"postgres": "date"}),
+
+		// días hábiles a sumar (positivo) o descontar (negativo)
+		field.Float("days"),
+
+		field.String("reason").
+			NotEmpty(),
+
+		// admin que registró el ajuste
+		field.Int("created_by_id").
+			Optional().
+			Nillable(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (VacationAdjustment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("vacation_adjustments").
+			Field("user_id").
+			Unique().
+			Required(),
+
+		edge.To("created_by", User.Type).
+			Field("created_by_id").
+			Unique(),
+	}
+}
+
+func (VacationAdjustment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "date").StorageKey("ix_vacation_adjustment_user_date"),
+	}
+}
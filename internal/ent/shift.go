@@ -29,10 +29,22 @@ type Shift struct {
 	EndTime string `json:"end_time,omitempty"`
 	// BreakMinutes holds the value of the "break_minutes" field.
 	BreakMinutes int `json:"break_minutes,omitempty"`
+	// EntryToleranceMinutes holds the value of the "entry_tolerance_minutes" field.
+	EntryToleranceMinutes int `json:"entry_tolerance_minutes,omitempty"`
+	// ExitToleranceMinutes holds the value of the "exit_tolerance_minutes" field.
+	ExitToleranceMinutes int `json:"exit_tolerance_minutes,omitempty"`
+	// MinOvertimeMinutes holds the value of the "min_overtime_minutes" field.
+	MinOvertimeMinutes int `json:"min_overtime_minutes,omitempty"`
 	// CrossesMidnight holds the value of the "crosses_midnight" field.
 	CrossesMidnight bool `json:"crosses_midnight,omitempty"`
 	// IsActive holds the value of the "is_active" field.
 	IsActive bool `json:"is_active,omitempty"`
+	// PatternType holds the value of the "pattern_type" field.
+	PatternType string `json:"pattern_type,omitempty"`
+	// CycleLength holds the value of the "cycle_length" field.
+	CycleLength *int `json:"cycle_length,omitempty"`
+	// CycleAnchorDate holds the value of the "cycle_anchor_date" field.
+	CycleAnchorDate *time.Time `json:"cycle_anchor_date,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -47,6 +59,8 @@ type Shift struct {
 type ShiftEdges struct {
 	// Days holds the value of the days edge.
 	Days []*ShiftDay `json:"days,omitempty"`
+	// CycleDays holds the value of the cycle_days edge.
+	CycleDays []*ShiftCycleDay `json:"cycle_days,omitempty"`
 	// Instances holds the value of the instances edge.
 	Instances []*ShiftInstance `json:"instances,omitempty"`
 	// UserAssignments holds the value of the user_assignments edge.
@@ -55,7 +69,7 @@ type ShiftEdges struct {
 	DayOverrides []*UserDayOverride `json:"day_overrides,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [5]bool
 }
 
 // DaysOrErr returns the Days value or an error if the edge
@@ -67,10 +81,19 @@ func (e ShiftEdges) DaysOrErr() ([]*ShiftDay, error) {
 	return nil, &NotLoadedError{edge: "days"}
 }
 
+// CycleDaysOrErr returns the CycleDays value or an error if the edge
+// was not loaded in eager-loading.
+func (e ShiftEdges) CycleDaysOrErr() ([]*ShiftCycleDay, error) {
+	if e.loadedTypes[1] {
+		return e.CycleDays, nil
+	}
+	return nil, &NotLoadedError{edge: "cycle_days"}
+}
+
 // InstancesOrErr returns the Instances value or an error if the edge
 // was not loaded in eager-loading.
 func (e ShiftEdges) InstancesOrErr() ([]*ShiftInstance, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Instances, nil
 	}
 	return nil, &NotLoadedError{edge: "instances"}
@@ -79,7 +102,7 @@ func (e ShiftEdges) InstancesOrErr() ([]*ShiftInstance, error) {
 // UserAssignmentsOrErr returns the UserAssignments value or an error if the edge
 // was not loaded in eager-loading.
 func (e ShiftEdges) UserAssignmentsOrErr() ([]*UserShiftAssignment, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.UserAssignments, nil
 	}
 	return nil, &NotLoadedError{edge: "user_assignments"}
@@ -88,7 +111,7 @@ func (e ShiftEdges) UserAssignmentsOrErr() ([]*UserShiftAssignment, error) {
 // DayOverridesOrErr returns the DayOverrides value or an error if the edge
 // was not loaded in eager-loading.
 func (e ShiftEdges) DayOverridesOrErr() ([]*UserDayOverride, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.DayOverrides, nil
 	}
 	return nil, &NotLoadedError{edge: "day_overrides"}
@@ -101,11 +124,11 @@ func (*Shift) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case shift.FieldCrossesMidnight, shift.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case shift.FieldID, shift.FieldBreakMinutes:
+		case shift.FieldID, shift.FieldBreakMinutes, shift.FieldEntryToleranceMinutes, shift.FieldExitToleranceMinutes, shift.FieldMinOvertimeMinutes, shift.FieldCycleLength:
 			values[i] = new(sql.NullInt64)
-		case shift.FieldName, shift.FieldDescription, shift.FieldStartTime, shift.FieldEndTime:
+		case shift.FieldName, shift.FieldDescription, shift.FieldStartTime, shift.FieldEndTime, shift.FieldPatternType:
 			values[i] = new(sql.NullString)
-		case shift.FieldDate, shift.FieldCreatedAt, shift.FieldUpdatedAt:
+		case shift.FieldDate, shift.FieldCycleAnchorDate, shift.FieldCreatedAt, shift.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -166,6 +189,24 @@ func (_m *Shift) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.BreakMinutes = int(value.Int64)
 			}
+		case shift.FieldEntryToleranceMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entry_tolerance_minutes", values[i])
+			} else if value.Valid {
+				_m.EntryToleranceMinutes = int(value.Int64)
+			}
+		case shift.FieldExitToleranceMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field exit_tolerance_minutes", values[i])
+			} else if value.Valid {
+				_m.ExitToleranceMinutes = int(value.Int64)
+			}
+		case shift.FieldMinOvertimeMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_overtime_minutes", values[i])
+			} else if value.Valid {
+				_m.MinOvertimeMinutes = int(value.Int64)
+			}
 		case shift.FieldCrossesMidnight:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field crosses_midnight", values[i])
@@ -178,6 +219,26 @@ func (_m *Shift) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsActive = value.Bool
 			}
+		case shift.FieldPatternType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pattern_type", values[i])
+			} else if value.Valid {
+				_m.PatternType = value.String
+			}
+		case shift.FieldCycleLength:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cycle_length", values[i])
+			} else if value.Valid {
+				_m.CycleLength = new(int)
+				*_m.CycleLength = int(value.Int64)
+			}
+		case shift.FieldCycleAnchorDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cycle_anchor_date", values[i])
+			} else if value.Valid {
+				_m.CycleAnchorDate = new(time.Time)
+				*_m.CycleAnchorDate = value.Time
+			}
 		case shift.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -208,6 +269,11 @@ func (_m *Shift) QueryDays() *ShiftDayQuery {
 	return NewShiftClient(_m.config).QueryDays(_m)
 }
 
+// QueryCycleDays queries the "cycle_days" edge of the Shift entity.
+func (_m *Shift) QueryCycleDays() *ShiftCycleDayQuery {
+	return NewShiftClient(_m.config).QueryCycleDays(_m)
+}
+
 // QueryInstances queries the "instances" edge of the Shift entity.
 func (_m *Shift) QueryInstances() *ShiftInstanceQuery {
 	return NewShiftClient(_m.config).QueryInstances(_m)
@@ -268,12 +334,34 @@ func (_m *Shift) String() string {
 	builder.WriteString("break_minutes=")
 	builder.WriteString(fmt.Sprintf("%v", _m.BreakMinutes))
 	builder.WriteString(", ")
+	builder.WriteString("entry_tolerance_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EntryToleranceMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("exit_tolerance_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExitToleranceMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("min_overtime_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinOvertimeMinutes))
+	builder.WriteString(", ")
 	builder.WriteString("crosses_midnight=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CrossesMidnight))
 	builder.WriteString(", ")
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
 	builder.WriteString(", ")
+	builder.WriteString("pattern_type=")
+	builder.WriteString(_m.PatternType)
+	builder.WriteString(", ")
+	if v := _m.CycleLength; v != nil {
+		builder.WriteString("cycle_length=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CycleAnchorDate; v != nil {
+		builder.WriteString("cycle_anchor_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
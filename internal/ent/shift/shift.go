@@ -26,16 +26,30 @@ const (
 	FieldEndTime = "end_time"
 	// FieldBreakMinutes holds the string denoting the break_minutes field in the database.
 	FieldBreakMinutes = "break_minutes"
+	// FieldEntryToleranceMinutes holds the string denoting the entry_tolerance_minutes field in the database.
+	FieldEntryToleranceMinutes = "entry_tolerance_minutes"
+	// FieldExitToleranceMinutes holds the string denoting the exit_tolerance_minutes field in the database.
+	FieldExitToleranceMinutes = "exit_tolerance_minutes"
+	// FieldMinOvertimeMinutes holds the string denoting the min_overtime_minutes field in the database.
+	FieldMinOvertimeMinutes = "min_overtime_minutes"
 	// FieldCrossesMidnight holds the string denoting the crosses_midnight field in the database.
 	FieldCrossesMidnight = "crosses_midnight"
 	// FieldIsActive holds the string denoting the is_active field in the database.
 	FieldIsActive = "is_active"
+	// FieldPatternType holds the string denoting the pattern_type field in the database.
+	FieldPatternType = "pattern_type"
+	// FieldCycleLength holds the string denoting the cycle_length field in the database.
+	FieldCycleLength = "cycle_length"
+	// FieldCycleAnchorDate holds the string denoting the cycle_anchor_date field in the database.
+	FieldCycleAnchorDate = "cycle_anchor_date"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeDays holds the string denoting the days edge name in mutations.
 	EdgeDays = "days"
+	// EdgeCycleDays holds the string denoting the cycle_days edge name in mutations.
+	EdgeCycleDays = "cycle_days"
 	// EdgeInstances holds the string denoting the instances edge name in mutations.
 	EdgeInstances = "instances"
 	// EdgeUserAssignments holds the string denoting the user_assignments edge name in mutations.
@@ -51,6 +65,13 @@ const (
 	DaysInverseTable = "shift_days"
 	// DaysColumn is the table column denoting the days relation/edge.
 	DaysColumn = "shift_id"
+	// CycleDaysTable is the table that holds the cycle_days relation/edge.
+	CycleDaysTable = "shift_cycle_days"
+	// CycleDaysInverseTable is the table name for the ShiftCycleDay entity.
+	// It exists in this package in order to avoid circular dependency with the "shiftcycleday" package.
+	CycleDaysInverseTable = "shift_cycle_days"
+	// CycleDaysColumn is the table column denoting the cycle_days relation/edge.
+	CycleDaysColumn = "shift_id"
 	// InstancesTable is the table that holds the instances relation/edge.
 	InstancesTable = "shift_instances"
 	// InstancesInverseTable is the table name for the ShiftInstance entity.
@@ -83,8 +104,14 @@ var Columns = []string{
 	FieldStartTime,
 	FieldEndTime,
 	FieldBreakMinutes,
+	FieldEntryToleranceMinutes,
+	FieldExitToleranceMinutes,
+	FieldMinOvertimeMinutes,
 	FieldCrossesMidnight,
 	FieldIsActive,
+	FieldPatternType,
+	FieldCycleLength,
+	FieldCycleAnchorDate,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -108,10 +135,28 @@ var (
 	EndTimeValidator func(string) error
 	// DefaultBreakMinutes holds the default value on creation for the "break_minutes" field.
 	DefaultBreakMinutes int
+	// DefaultEntryToleranceMinutes holds the default value on creation for the "entry_tolerance_minutes" field.
+	DefaultEntryToleranceMinutes int
+	// EntryToleranceMinutesValidator is a validator for the "entry_tolerance_minutes" field. It is called by the builders before save.
+	EntryToleranceMinutesValidator func(int) error
+	// DefaultExitToleranceMinutes holds the default value on creation for the "exit_tolerance_minutes" field.
+	DefaultExitToleranceMinutes int
+	// ExitToleranceMinutesValidator is a validator for the "exit_tolerance_minutes" field. It is called by the builders before save.
+	ExitToleranceMinutesValidator func(int) error
+	// DefaultMinOvertimeMinutes holds the default value on creation for the "min_overtime_minutes" field.
+	DefaultMinOvertimeMinutes int
+	// MinOvertimeMinutesValidator is a validator for the "min_overtime_minutes" field. It is called by the builders before save.
+	MinOvertimeMinutesValidator func(int) error
 	// DefaultCrossesMidnight holds the default value on creation for the "crosses_midnight" field.
 	DefaultCrossesMidnight bool
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
+	// DefaultPatternType holds the default value on creation for the "pattern_type" field.
+	DefaultPatternType string
+	// PatternTypeValidator is a validator for the "pattern_type" field. It is called by the builders before save.
+	PatternTypeValidator func(string) error
+	// CycleLengthValidator is a validator for the "cycle_length" field. It is called by the builders before save.
+	CycleLengthValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -158,6 +203,21 @@ func ByBreakMinutes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBreakMinutes, opts...).ToFunc()
 }
 
+// ByEntryToleranceMinutes orders the results by the entry_tolerance_minutes field.
+func ByEntryToleranceMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntryToleranceMinutes, opts...).ToFunc()
+}
+
+// ByExitToleranceMinutes orders the results by the exit_tolerance_minutes field.
+func ByExitToleranceMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExitToleranceMinutes, opts...).ToFunc()
+}
+
+// ByMinOvertimeMinutes orders the results by the min_overtime_minutes field.
+func ByMinOvertimeMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinOvertimeMinutes, opts...).ToFunc()
+}
+
 // ByCrossesMidnight orders the results by the crosses_midnight field.
 func ByCrossesMidnight(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCrossesMidnight, opts...).ToFunc()
@@ -168,6 +228,21 @@ func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
 }
 
+// ByPatternType orders the results by the pattern_type field.
+func ByPatternType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPatternType, opts...).ToFunc()
+}
+
+// ByCycleLength orders the results by the cycle_length field.
+func ByCycleLength(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCycleLength, opts...).ToFunc()
+}
+
+// ByCycleAnchorDate orders the results by the cycle_anchor_date field.
+func ByCycleAnchorDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCycleAnchorDate, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -192,6 +267,20 @@ func ByDays(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByCycleDaysCount orders the results by cycle_days count.
+func ByCycleDaysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCycleDaysStep(), opts...)
+	}
+}
+
+// ByCycleDays orders the results by cycle_days terms.
+func ByCycleDays(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCycleDaysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByInstancesCount orders the results by instances count.
 func ByInstancesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -240,6 +329,13 @@ func newDaysStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, DaysTable, DaysColumn),
 	)
 }
+func newCycleDaysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CycleDaysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CycleDaysTable, CycleDaysColumn),
+	)
+}
 func newInstancesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -85,6 +85,21 @@ func BreakMinutes(v int) predicate.Shift {
 	return predicate.Shift(sql.FieldEQ(FieldBreakMinutes, v))
 }
 
+// EntryToleranceMinutes applies equality check predicate on the "entry_tolerance_minutes" field. It's identical to EntryToleranceMinutesEQ.
+func EntryToleranceMinutes(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldEQ(FieldEntryToleranceMinutes, v))
+}
+
+// ExitToleranceMinutes applies equality check predicate on the "exit_tolerance_minutes" field. It's identical to ExitToleranceMinutesEQ.
+func ExitToleranceMinutes(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldEQ(FieldExitToleranceMinutes, v))
+}
+
+// MinOvertimeMinutes applies equality check predicate on the "min_overtime_minutes" field. It's identical to MinOvertimeMinutesEQ.
+func MinOvertimeMinutes(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldEQ(FieldMinOvertimeMinutes, v))
+}
+
 // CrossesMidnight applies equality check predicate on the "crosses_midnight" field. It's identical to CrossesMidnightEQ.
 func CrossesMidnight(v bool) predicate.Shift {
 	return predicate.Shift(sql.FieldEQ(FieldCrossesMidnight, v))
@@ -95,6 +110,21 @@ func IsActive(v bool) predicate.Shift {
 	return predicate.Shift(sql.FieldEQ(FieldIsActive, v))
 }
 
+// PatternType applies equality check predicate on the "pattern_type" field. It's identical to PatternTypeEQ.
+func PatternType(v string) predicate.Shift {
+	return predicate.Shift(sql.FieldEQ(FieldPatternType, v))
+}
+
+// CycleLength applies equality check predicate on the "cycle_length" field. It's identical to CycleLengthEQ.
+func CycleLength(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldEQ(FieldCycleLength, v))
+}
+
+// CycleAnchorDate applies equality check predicate on the "cycle_anchor_date" field. It's identical to CycleAnchorDateEQ.
+func CycleAnchorDate(v time.Time) predicate.Shift {
+	return predicate.Shift(sql.FieldEQ(FieldCycleAnchorDate, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Shift {
 	return predicate.Shift(sql.FieldEQ(FieldCreatedAt, v))
@@ -465,6 +495,126 @@ func BreakMinutesLTE(v int) predicate.Shift {
 	return predicate.Shift(sql.FieldLTE(FieldBreakMinutes, v))
 }
 
+// EntryToleranceMinutesEQ applies the EQ predicate on the "entry_tolerance_minutes" field.
+func EntryToleranceMinutesEQ(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldEQ(FieldEntryToleranceMinutes, v))
+}
+
+// EntryToleranceMinutesNEQ applies the NEQ predicate on the "entry_tolerance_minutes" field.
+func EntryToleranceMinutesNEQ(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldNEQ(FieldEntryToleranceMinutes, v))
+}
+
+// EntryToleranceMinutesIn applies the In predicate on the "entry_tolerance_minutes" field.
+func EntryToleranceMinutesIn(vs ...int) predicate.Shift {
+	return predicate.Shift(sql.FieldIn(FieldEntryToleranceMinutes, vs...))
+}
+
+// EntryToleranceMinutesNotIn applies the NotIn predicate on the "entry_tolerance_minutes" field.
+func EntryToleranceMinutesNotIn(vs ...int) predicate.Shift {
+	return predicate.Shift(sql.FieldNotIn(FieldEntryToleranceMinutes, vs...))
+}
+
+// EntryToleranceMinutesGT applies the GT predicate on the "entry_tolerance_minutes" field.
+func EntryToleranceMinutesGT(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldGT(FieldEntryToleranceMinutes, v))
+}
+
+// EntryToleranceMinutesGTE applies the GTE predicate on the "entry_tolerance_minutes" field.
+func EntryToleranceMinutesGTE(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldGTE(FieldEntryToleranceMinutes, v))
+}
+
+// EntryToleranceMinutesLT applies the LT predicate on the "entry_tolerance_minutes" field.
+func EntryToleranceMinutesLT(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldLT(FieldEntryToleranceMinutes, v))
+}
+
+// EntryToleranceMinutesLTE applies the LTE predicate on the "entry_tolerance_minutes" field.
+func EntryToleranceMinutesLTE(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldLTE(FieldEntryToleranceMinutes, v))
+}
+
+// ExitToleranceMinutesEQ applies the EQ predicate on the "exit_tolerance_minutes" field.
+func ExitToleranceMinutesEQ(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldEQ(FieldExitToleranceMinutes, v))
+}
+
+// ExitToleranceMinutesNEQ applies the NEQ predicate on the "exit_tolerance_minutes" field.
+func ExitToleranceMinutesNEQ(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldNEQ(FieldExitToleranceMinutes, v))
+}
+
+// ExitToleranceMinutesIn applies the In predicate on the "exit_tolerance_minutes" field.
+func ExitToleranceMinutesIn(vs ...int) predicate.Shift {
+	return predicate.Shift(sql.FieldIn(FieldExitToleranceMinutes, vs...))
+}
+
+// ExitToleranceMinutesNotIn applies the NotIn predicate on the "exit_tolerance_minutes" field.
+func ExitToleranceMinutesNotIn(vs ...int) predicate.Shift {
+	return predicate.Shift(sql.FieldNotIn(FieldExitToleranceMinutes, vs...))
+}
+
+// ExitToleranceMinutesGT applies the GT predicate on the "exit_tolerance_minutes" field.
+func ExitToleranceMinutesGT(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldGT(FieldExitToleranceMinutes, v))
+}
+
+// ExitToleranceMinutesGTE applies the GTE predicate on the "exit_tolerance_minutes" field.
+func ExitToleranceMinutesGTE(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldGTE(FieldExitToleranceMinutes, v))
+}
+
+// ExitToleranceMinutesLT applies the LT predicate on the "exit_tolerance_minutes" field.
+func ExitToleranceMinutesLT(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldLT(FieldExitToleranceMinutes, v))
+}
+
+// ExitToleranceMinutesLTE applies the LTE predicate on the "exit_tolerance_minutes" field.
+func ExitToleranceMinutesLTE(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldLTE(FieldExitToleranceMinutes, v))
+}
+
+// MinOvertimeMinutesEQ applies the EQ predicate on the "min_overtime_minutes" field.
+func MinOvertimeMinutesEQ(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldEQ(FieldMinOvertimeMinutes, v))
+}
+
+// MinOvertimeMinutesNEQ applies the NEQ predicate on the "min_overtime_minutes" field.
+func MinOvertimeMinutesNEQ(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldNEQ(FieldMinOvertimeMinutes, v))
+}
+
+// MinOvertimeMinutesIn applies the In predicate on the "min_overtime_minutes" field.
+func MinOvertimeMinutesIn(vs ...int) predicate.Shift {
+	return predicate.Shift(sql.FieldIn(FieldMinOvertimeMinutes, vs...))
+}
+
+// MinOvertimeMinutesNotIn applies the NotIn predicate on the "min_overtime_minutes" field.
+func MinOvertimeMinutesNotIn(vs ...int) predicate.Shift {
+	return predicate.Shift(sql.FieldNotIn(FieldMinOvertimeMinutes, vs...))
+}
+
+// MinOvertimeMinutesGT applies the GT predicate on the "min_overtime_minutes" field.
+func MinOvertimeMinutesGT(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldGT(FieldMinOvertimeMinutes, v))
+}
+
+// MinOvertimeMinutesGTE applies the GTE predicate on the "min_overtime_minutes" field.
+func MinOvertimeMinutesGTE(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldGTE(FieldMinOvertimeMinutes, v))
+}
+
+// MinOvertimeMinutesLT applies the LT predicate on the "min_overtime_minutes" field.
+func MinOvertimeMinutesLT(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldLT(FieldMinOvertimeMinutes, v))
+}
+
+// MinOvertimeMinutesLTE applies the LTE predicate on the "min_overtime_minutes" field.
+func MinOvertimeMinutesLTE(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldLTE(FieldMinOvertimeMinutes, v))
+}
+
 // CrossesMidnightEQ applies the EQ predicate on the "crosses_midnight" field.
 func CrossesMidnightEQ(v bool) predicate.Shift {
 	return predicate.Shift(sql.FieldEQ(FieldCrossesMidnight, v))
@@ -485,6 +635,171 @@ func IsActiveNEQ(v bool) predicate.Shift {
 	return predicate.Shift(sql.FieldNEQ(FieldIsActive, v))
 }
 
+// PatternTypeEQ applies the EQ predicate on the "pattern_type" field.
+func PatternTypeEQ(v string) predicate.Shift {
+	return predicate.Shift(sql.FieldEQ(FieldPatternType, v))
+}
+
+// PatternTypeNEQ applies the NEQ predicate on the "pattern_type" field.
+func PatternTypeNEQ(v string) predicate.Shift {
+	return predicate.Shift(sql.FieldNEQ(FieldPatternType, v))
+}
+
+// PatternTypeIn applies the In predicate on the "pattern_type" field.
+func PatternTypeIn(vs ...string) predicate.Shift {
+	return predicate.Shift(sql.FieldIn(FieldPatternType, vs...))
+}
+
+// PatternTypeNotIn applies the NotIn predicate on the "pattern_type" field.
+func PatternTypeNotIn(vs ...string) predicate.Shift {
+	return predicate.Shift(sql.FieldNotIn(FieldPatternType, vs...))
+}
+
+// PatternTypeGT applies the GT predicate on the "pattern_type" field.
+func PatternTypeGT(v string) predicate.Shift {
+	return predicate.Shift(sql.FieldGT(FieldPatternType, v))
+}
+
+// PatternTypeGTE applies the GTE predicate on the "pattern_type" field.
+func PatternTypeGTE(v string) predicate.Shift {
+	return predicate.Shift(sql.FieldGTE(FieldPatternType, v))
+}
+
+// PatternTypeLT applies the LT predicate on the "pattern_type" field.
+func PatternTypeLT(v string) predicate.Shift {
+	return predicate.Shift(sql.FieldLT(FieldPatternType, v))
+}
+
+// PatternTypeLTE applies the LTE predicate on the "pattern_type" field.
+func PatternTypeLTE(v string) predicate.Shift {
+	return predicate.Shift(sql.FieldLTE(FieldPatternType, v))
+}
+
+// PatternTypeContains applies the Contains predicate on the "pattern_type" field.
+func PatternTypeContains(v string) predicate.Shift {
+	return predicate.Shift(sql.FieldContains(FieldPatternType, v))
+}
+
+// PatternTypeHasPrefix applies the HasPrefix predicate on the "pattern_type" field.
+func PatternTypeHasPrefix(v string) predicate.Shift {
+	return predicate.Shift(sql.FieldHasPrefix(FieldPatternType, v))
+}
+
+// PatternTypeHasSuffix applies the HasSuffix predicate on the "pattern_type" field.
+func PatternTypeHasSuffix(v string) predicate.Shift {
+	return predicate.Shift(sql.FieldHasSuffix(FieldPatternType, v))
+}
+
+// PatternTypeEqualFold applies the EqualFold predicate on the "pattern_type" field.
+func PatternTypeEqualFold(v string) predicate.Shift {
+	return predicate.Shift(sql.FieldEqualFold(FieldPatternType, v))
+}
+
+// PatternTypeContainsFold applies the ContainsFold predicate on the "pattern_type" field.
+func PatternTypeContainsFold(v string) predicate.Shift {
+	return predicate.Shift(sql.FieldContainsFold(FieldPatternType, v))
+}
+
+// CycleLengthEQ applies the EQ predicate on the "cycle_length" field.
+func CycleLengthEQ(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldEQ(FieldCycleLength, v))
+}
+
+// CycleLengthNEQ applies the NEQ predicate on the "cycle_length" field.
+func CycleLengthNEQ(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldNEQ(FieldCycleLength, v))
+}
+
+// CycleLengthIn applies the In predicate on the "cycle_length" field.
+func CycleLengthIn(vs ...int) predicate.Shift {
+	return predicate.Shift(sql.FieldIn(FieldCycleLength, vs...))
+}
+
+// CycleLengthNotIn applies the NotIn predicate on the "cycle_length" field.
+func CycleLengthNotIn(vs ...int) predicate.Shift {
+	return predicate.Shift(sql.FieldNotIn(FieldCycleLength, vs...))
+}
+
+// CycleLengthGT applies the GT predicate on the "cycle_length" field.
+func CycleLengthGT(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldGT(FieldCycleLength, v))
+}
+
+// CycleLengthGTE applies the GTE predicate on the "cycle_length" field.
+func CycleLengthGTE(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldGTE(FieldCycleLength, v))
+}
+
+// CycleLengthLT applies the LT predicate on the "cycle_length" field.
+func CycleLengthLT(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldLT(FieldCycleLength, v))
+}
+
+// CycleLengthLTE applies the LTE predicate on the "cycle_length" field.
+func CycleLengthLTE(v int) predicate.Shift {
+	return predicate.Shift(sql.FieldLTE(FieldCycleLength, v))
+}
+
+// CycleLengthIsNil applies the IsNil predicate on the "cycle_length" field.
+func CycleLengthIsNil() predicate.Shift {
+	return predicate.Shift(sql.FieldIsNull(FieldCycleLength))
+}
+
+// CycleLengthNotNil applies the NotNil predicate on the "cycle_length" field.
+func CycleLengthNotNil() predicate.Shift {
+	return predicate.Shift(sql.FieldNotNull(FieldCycleLength))
+}
+
+// CycleAnchorDateEQ applies the EQ predicate on the "cycle_anchor_date" field.
+func CycleAnchorDateEQ(v time.Time) predicate.Shift {
+	return predicate.Shift(sql.FieldEQ(FieldCycleAnchorDate, v))
+}
+
+// CycleAnchorDateNEQ applies the NEQ predicate on the "cycle_anchor_date" field.
+func CycleAnchorDateNEQ(v time.Time) predicate.Shift {
+	return predicate.Shift(sql.FieldNEQ(FieldCycleAnchorDate, v))
+}
+
+// CycleAnchorDateIn applies the In predicate on the "cycle_anchor_date" field.
+func CycleAnchorDateIn(vs ...time.Time) predicate.Shift {
+	return predicate.Shift(sql.FieldIn(FieldCycleAnchorDate, vs...))
+}
+
+// CycleAnchorDateNotIn applies the NotIn predicate on the "cycle_anchor_date" field.
+func CycleAnchorDateNotIn(vs ...time.Time) predicate.Shift {
+	return predicate.Shift(sql.FieldNotIn(FieldCycleAnchorDate, vs...))
+}
+
+// CycleAnchorDateGT applies the GT predicate on the "cycle_anchor_date" field.
+func CycleAnchorDateGT(v time.Time) predicate.Shift {
+	return predicate.Shift(sql.FieldGT(FieldCycleAnchorDate, v))
+}
+
+// CycleAnchorDateGTE applies the GTE predicate on the "cycle_anchor_date" field.
+func CycleAnchorDateGTE(v time.Time) predicate.Shift {
+	return predicate.Shift(sql.FieldGTE(FieldCycleAnchorDate, v))
+}
+
+// CycleAnchorDateLT applies the LT predicate on the "cycle_anchor_date" field.
+func CycleAnchorDateLT(v time.Time) predicate.Shift {
+	return predicate.Shift(sql.FieldLT(FieldCycleAnchorDate, v))
+}
+
+// CycleAnchorDateLTE applies the LTE predicate on the "cycle_anchor_date" field.
+func CycleAnchorDateLTE(v time.Time) predicate.Shift {
+	return predicate.Shift(sql.FieldLTE(FieldCycleAnchorDate, v))
+}
+
+// CycleAnchorDateIsNil applies the IsNil predicate on the "cycle_anchor_date" field.
+func CycleAnchorDateIsNil() predicate.Shift {
+	return predicate.Shift(sql.FieldIsNull(FieldCycleAnchorDate))
+}
+
+// CycleAnchorDateNotNil applies the NotNil predicate on the "cycle_anchor_date" field.
+func CycleAnchorDateNotNil() predicate.Shift {
+	return predicate.Shift(sql.FieldNotNull(FieldCycleAnchorDate))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Shift {
 	return predicate.Shift(sql.FieldEQ(FieldCreatedAt, v))
@@ -588,6 +903,29 @@ func HasDaysWith(preds ...predicate.ShiftDay) predicate.Shift {
 	})
 }
 
+// HasCycleDays applies the HasEdge predicate on the "cycle_days" edge.
+func HasCycleDays() predicate.Shift {
+	return predicate.Shift(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CycleDaysTable, CycleDaysColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCycleDaysWith applies the HasEdge predicate on the "cycle_days" edge with a given conditions (other predicates).
+func HasCycleDaysWith(preds ...predicate.ShiftCycleDay) predicate.Shift {
+	return predicate.Shift(func(s *sql.Selector) {
+		step := newCycleDaysStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasInstances applies the HasEdge predicate on the "instances" edge.
 func HasInstances() predicate.Shift {
 	return predicate.Shift(func(s *sql.Selector) {
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"back/internal/ent/shift"
+	"back/internal/ent/shiftcycleday"
 	"back/internal/ent/shiftday"
 	"back/internal/ent/shiftinstance"
 	"back/internal/ent/userdayoverride"
@@ -84,6 +85,48 @@ func (_c *ShiftCreate) SetNillableBreakMinutes(v *int) *ShiftCreate {
 	return _c
 }
 
+// SetEntryToleranceMinutes sets the "entry_tolerance_minutes" field.
+func (_c *ShiftCreate) SetEntryToleranceMinutes(v int) *ShiftCreate {
+	_c.mutation.SetEntryToleranceMinutes(v)
+	return _c
+}
+
+// SetNillableEntryToleranceMinutes sets the "entry_tolerance_minutes" field if the given value is not nil.
+func (_c *ShiftCreate) SetNillableEntryToleranceMinutes(v *int) *ShiftCreate {
+	if v != nil {
+		_c.SetEntryToleranceMinutes(*v)
+	}
+	return _c
+}
+
+// SetExitToleranceMinutes sets the "exit_tolerance_minutes" field.
+func (_c *ShiftCreate) SetExitToleranceMinutes(v int) *ShiftCreate {
+	_c.mutation.SetExitToleranceMinutes(v)
+	return _c
+}
+
+// SetNillableExitToleranceMinutes sets the "exit_tolerance_minutes" field if the given value is not nil.
+func (_c *ShiftCreate) SetNillableExitToleranceMinutes(v *int) *ShiftCreate {
+	if v != nil {
+		_c.SetExitToleranceMinutes(*v)
+	}
+	return _c
+}
+
+// SetMinOvertimeMinutes sets the "min_overtime_minutes" field.
+func (_c *ShiftCreate) SetMinOvertimeMinutes(v int) *ShiftCreate {
+	_c.mutation.SetMinOvertimeMinutes(v)
+	return _c
+}
+
+// SetNillableMinOvertimeMinutes sets the "min_overtime_minutes" field if the given value is not nil.
+func (_c *ShiftCreate) SetNillableMinOvertimeMinutes(v *int) *ShiftCreate {
+	if v != nil {
+		_c.SetMinOvertimeMinutes(*v)
+	}
+	return _c
+}
+
 // SetCrossesMidnight sets the "crosses_midnight" field.
 func (_c *ShiftCreate) SetCrossesMidnight(v bool) *ShiftCreate {
 	_c.mutation.SetCrossesMidnight(v)
@@ -112,6 +155,48 @@ func (_c *ShiftCreate) SetNillableIsActive(v *bool) *ShiftCreate {
 	return _c
 }
 
+// SetPatternType sets the "pattern_type" field.
+func (_c *ShiftCreate) SetPatternType(v string) *ShiftCreate {
+	_c.mutation.SetPatternType(v)
+	return _c
+}
+
+// SetNillablePatternType sets the "pattern_type" field if the given value is not nil.
+func (_c *ShiftCreate) SetNillablePatternType(v *string) *ShiftCreate {
+	if v != nil {
+		_c.SetPatternType(*v)
+	}
+	return _c
+}
+
+// SetCycleLength sets the "cycle_length" field.
+func (_c *ShiftCreate) SetCycleLength(v int) *ShiftCreate {
+	_c.mutation.SetCycleLength(v)
+	return _c
+}
+
+// SetNillableCycleLength sets the "cycle_length" field if the given value is not nil.
+func (_c *ShiftCreate) SetNillableCycleLength(v *int) *ShiftCreate {
+	if v != nil {
+		_c.SetCycleLength(*v)
+	}
+	return _c
+}
+
+// SetCycleAnchorDate sets the "cycle_anchor_date" field.
+func (_c *ShiftCreate) SetCycleAnchorDate(v time.Time) *ShiftCreate {
+	_c.mutation.SetCycleAnchorDate(v)
+	return _c
+}
+
+// SetNillableCycleAnchorDate sets the "cycle_anchor_date" field if the given value is not nil.
+func (_c *ShiftCreate) SetNillableCycleAnchorDate(v *time.Time) *ShiftCreate {
+	if v != nil {
+		_c.SetCycleAnchorDate(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *ShiftCreate) SetCreatedAt(v time.Time) *ShiftCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -155,6 +240,21 @@ func (_c *ShiftCreate) AddDays(v ...*ShiftDay) *ShiftCreate {
 	return _c.AddDayIDs(ids...)
 }
 
+// AddCycleDayIDs adds the "cycle_days" edge to the ShiftCycleDay entity by IDs.
+func (_c *ShiftCreate) AddCycleDayIDs(ids ...int) *ShiftCreate {
+	_c.mutation.AddCycleDayIDs(ids...)
+	return _c
+}
+
+// AddCycleDays adds the "cycle_days" edges to the ShiftCycleDay entity.
+func (_c *ShiftCreate) AddCycleDays(v ...*ShiftCycleDay) *ShiftCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCycleDayIDs(ids...)
+}
+
 // AddInstanceIDs adds the "instances" edge to the ShiftInstance entity by IDs.
 func (_c *ShiftCreate) AddInstanceIDs(ids ...int) *ShiftCreate {
 	_c.mutation.AddInstanceIDs(ids...)
@@ -239,6 +339,18 @@ func (_c *ShiftCreate) defaults() {
 		v := shift.DefaultBreakMinutes
 		_c.mutation.SetBreakMinutes(v)
 	}
+	if _, ok := _c.mutation.EntryToleranceMinutes(); !ok {
+		v := shift.DefaultEntryToleranceMinutes
+		_c.mutation.SetEntryToleranceMinutes(v)
+	}
+	if _, ok := _c.mutation.ExitToleranceMinutes(); !ok {
+		v := shift.DefaultExitToleranceMinutes
+		_c.mutation.SetExitToleranceMinutes(v)
+	}
+	if _, ok := _c.mutation.MinOvertimeMinutes(); !ok {
+		v := shift.DefaultMinOvertimeMinutes
+		_c.mutation.SetMinOvertimeMinutes(v)
+	}
 	if _, ok := _c.mutation.CrossesMidnight(); !ok {
 		v := shift.DefaultCrossesMidnight
 		_c.mutation.SetCrossesMidnight(v)
@@ -247,6 +359,10 @@ func (_c *ShiftCreate) defaults() {
 		v := shift.DefaultIsActive
 		_c.mutation.SetIsActive(v)
 	}
+	if _, ok := _c.mutation.PatternType(); !ok {
+		v := shift.DefaultPatternType
+		_c.mutation.SetPatternType(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := shift.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -286,12 +402,49 @@ func (_c *ShiftCreate) check() error {
 	if _, ok := _c.mutation.BreakMinutes(); !ok {
 		return &ValidationError{Name: "break_minutes", err: errors.New(`ent: missing required field "Shift.break_minutes"`)}
 	}
+	if _, ok := _c.mutation.EntryToleranceMinutes(); !ok {
+		return &ValidationError{Name: "entry_tolerance_minutes", err: errors.New(`ent: missing required field "Shift.entry_tolerance_minutes"`)}
+	}
+	if v, ok := _c.mutation.EntryToleranceMinutes(); ok {
+		if err := shift.EntryToleranceMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "entry_tolerance_minutes", err: fmt.Errorf(`ent: validator failed for field "Shift.entry_tolerance_minutes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExitToleranceMinutes(); !ok {
+		return &ValidationError{Name: "exit_tolerance_minutes", err: errors.New(`ent: missing required field "Shift.exit_tolerance_minutes"`)}
+	}
+	if v, ok := _c.mutation.ExitToleranceMinutes(); ok {
+		if err := shift.ExitToleranceMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "exit_tolerance_minutes", err: fmt.Errorf(`ent: validator failed for field "Shift.exit_tolerance_minutes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MinOvertimeMinutes(); !ok {
+		return &ValidationError{Name: "min_overtime_minutes", err: errors.New(`ent: missing required field "Shift.min_overtime_minutes"`)}
+	}
+	if v, ok := _c.mutation.MinOvertimeMinutes(); ok {
+		if err := shift.MinOvertimeMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "min_overtime_minutes", err: fmt.Errorf(`ent: validator failed for field "Shift.min_overtime_minutes": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CrossesMidnight(); !ok {
 		return &ValidationError{Name: "crosses_midnight", err: errors.New(`ent: missing required field "Shift.crosses_midnight"`)}
 	}
 	if _, ok := _c.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "Shift.is_active"`)}
 	}
+	if _, ok := _c.mutation.PatternType(); !ok {
+		return &ValidationError{Name: "pattern_type", err: errors.New(`ent: missing required field "Shift.pattern_type"`)}
+	}
+	if v, ok := _c.mutation.PatternType(); ok {
+		if err := shift.PatternTypeValidator(v); err != nil {
+			return &ValidationError{Name: "pattern_type", err: fmt.Errorf(`ent: validator failed for field "Shift.pattern_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.CycleLength(); ok {
+		if err := shift.CycleLengthValidator(v); err != nil {
+			return &ValidationError{Name: "cycle_length", err: fmt.Errorf(`ent: validator failed for field "Shift.cycle_length": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Shift.created_at"`)}
 	}
@@ -348,6 +501,18 @@ func (_c *ShiftCreate) createSpec() (*Shift, *sqlgraph.CreateSpec) {
 		_spec.SetField(shift.FieldBreakMinutes, field.TypeInt, value)
 		_node.BreakMinutes = value
 	}
+	if value, ok := _c.mutation.EntryToleranceMinutes(); ok {
+		_spec.SetField(shift.FieldEntryToleranceMinutes, field.TypeInt, value)
+		_node.EntryToleranceMinutes = value
+	}
+	if value, ok := _c.mutation.ExitToleranceMinutes(); ok {
+		_spec.SetField(shift.FieldExitToleranceMinutes, field.TypeInt, value)
+		_node.ExitToleranceMinutes = value
+	}
+	if value, ok := _c.mutation.MinOvertimeMinutes(); ok {
+		_spec.SetField(shift.FieldMinOvertimeMinutes, field.TypeInt, value)
+		_node.MinOvertimeMinutes = value
+	}
 	if value, ok := _c.mutation.CrossesMidnight(); ok {
 		_spec.SetField(shift.FieldCrossesMidnight, field.TypeBool, value)
 		_node.CrossesMidnight = value
@@ -356,6 +521,18 @@ func (_c *ShiftCreate) createSpec() (*Shift, *sqlgraph.CreateSpec) {
 		_spec.SetField(shift.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
 	}
+	if value, ok := _c.mutation.PatternType(); ok {
+		_spec.SetField(shift.FieldPatternType, field.TypeString, value)
+		_node.PatternType = value
+	}
+	if value, ok := _c.mutation.CycleLength(); ok {
+		_spec.SetField(shift.FieldCycleLength, field.TypeInt, value)
+		_node.CycleLength = &value
+	}
+	if value, ok := _c.mutation.CycleAnchorDate(); ok {
+		_spec.SetField(shift.FieldCycleAnchorDate, field.TypeTime, value)
+		_node.CycleAnchorDate = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(shift.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -380,6 +557,22 @@ func (_c *ShiftCreate) createSpec() (*Shift, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.CycleDaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shift.CycleDaysTable,
+			Columns: []string{shift.CycleDaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftcycleday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.InstancesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
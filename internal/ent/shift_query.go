@@ -5,6 +5,7 @@ package ent
 import (
 	"back/internal/ent/predicate"
 	"back/internal/ent/shift"
+	"back/internal/ent/shiftcycleday"
 	"back/internal/ent/shiftday"
 	"back/internal/ent/shiftinstance"
 	"back/internal/ent/userdayoverride"
@@ -28,6 +29,7 @@ type ShiftQuery struct {
 	inters              []Interceptor
 	predicates          []predicate.Shift
 	withDays            *ShiftDayQuery
+	withCycleDays       *ShiftCycleDayQuery
 	withInstances       *ShiftInstanceQuery
 	withUserAssignments *UserShiftAssignmentQuery
 	withDayOverrides    *UserDayOverrideQuery
@@ -89,6 +91,28 @@ func (_q *ShiftQuery) QueryDays() *ShiftDayQuery {
 	return query
 }
 
+// QueryCycleDays chains the current query on the "cycle_days" edge.
+func (_q *ShiftQuery) QueryCycleDays() *ShiftCycleDayQuery {
+	query := (&ShiftCycleDayClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shift.Table, shift.FieldID, selector),
+			sqlgraph.To(shiftcycleday.Table, shiftcycleday.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, shift.CycleDaysTable, shift.CycleDaysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryInstances chains the current query on the "instances" edge.
 func (_q *ShiftQuery) QueryInstances() *ShiftInstanceQuery {
 	query := (&ShiftInstanceClient{config: _q.config}).Query()
@@ -348,6 +372,7 @@ func (_q *ShiftQuery) Clone() *ShiftQuery {
 		inters:              append([]Interceptor{}, _q.inters...),
 		predicates:          append([]predicate.Shift{}, _q.predicates...),
 		withDays:            _q.withDays.Clone(),
+		withCycleDays:       _q.withCycleDays.Clone(),
 		withInstances:       _q.withInstances.Clone(),
 		withUserAssignments: _q.withUserAssignments.Clone(),
 		withDayOverrides:    _q.withDayOverrides.Clone(),
@@ -368,6 +393,17 @@ func (_q *ShiftQuery) WithDays(opts ...func(*ShiftDayQuery)) *ShiftQuery {
 	return _q
 }
 
+// WithCycleDays tells the query-builder to eager-load the nodes that are connected to
+// the "cycle_days" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ShiftQuery) WithCycleDays(opts ...func(*ShiftCycleDayQuery)) *ShiftQuery {
+	query := (&ShiftCycleDayClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCycleDays = query
+	return _q
+}
+
 // WithInstances tells the query-builder to eager-load the nodes that are connected to
 // the "instances" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *ShiftQuery) WithInstances(opts ...func(*ShiftInstanceQuery)) *ShiftQuery {
@@ -479,8 +515,9 @@ func (_q *ShiftQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Shift,
 	var (
 		nodes       = []*Shift{}
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [5]bool{
 			_q.withDays != nil,
+			_q.withCycleDays != nil,
 			_q.withInstances != nil,
 			_q.withUserAssignments != nil,
 			_q.withDayOverrides != nil,
@@ -511,6 +548,13 @@ func (_q *ShiftQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Shift,
 			return nil, err
 		}
 	}
+	if query := _q.withCycleDays; query != nil {
+		if err := _q.loadCycleDays(ctx, query, nodes,
+			func(n *Shift) { n.Edges.CycleDays = []*ShiftCycleDay{} },
+			func(n *Shift, e *ShiftCycleDay) { n.Edges.CycleDays = append(n.Edges.CycleDays, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withInstances; query != nil {
 		if err := _q.loadInstances(ctx, query, nodes,
 			func(n *Shift) { n.Edges.Instances = []*ShiftInstance{} },
@@ -565,6 +609,36 @@ func (_q *ShiftQuery) loadDays(ctx context.Context, query *ShiftDayQuery, nodes
 	}
 	return nil
 }
+func (_q *ShiftQuery) loadCycleDays(ctx context.Context, query *ShiftCycleDayQuery, nodes []*Shift, init func(*Shift), assign func(*Shift, *ShiftCycleDay)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Shift)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(shiftcycleday.FieldShiftID)
+	}
+	query.Where(predicate.ShiftCycleDay(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(shift.CycleDaysColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ShiftID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "shift_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *ShiftQuery) loadInstances(ctx context.Context, query *ShiftInstanceQuery, nodes []*Shift, init func(*Shift), assign func(*Shift, *ShiftInstance)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Shift)
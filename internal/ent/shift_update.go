@@ -5,6 +5,7 @@ package ent
 import (
 	"back/internal/ent/predicate"
 	"back/internal/ent/shift"
+	"back/internal/ent/shiftcycleday"
 	"back/internal/ent/shiftday"
 	"back/internal/ent/shiftinstance"
 	"back/internal/ent/userdayoverride"
@@ -135,6 +136,69 @@ func (_u *ShiftUpdate) AddBreakMinutes(v int) *ShiftUpdate {
 	return _u
 }
 
+// SetEntryToleranceMinutes sets the "entry_tolerance_minutes" field.
+func (_u *ShiftUpdate) SetEntryToleranceMinutes(v int) *ShiftUpdate {
+	_u.mutation.ResetEntryToleranceMinutes()
+	_u.mutation.SetEntryToleranceMinutes(v)
+	return _u
+}
+
+// SetNillableEntryToleranceMinutes sets the "entry_tolerance_minutes" field if the given value is not nil.
+func (_u *ShiftUpdate) SetNillableEntryToleranceMinutes(v *int) *ShiftUpdate {
+	if v != nil {
+		_u.SetEntryToleranceMinutes(*v)
+	}
+	return _u
+}
+
+// AddEntryToleranceMinutes adds value to the "entry_tolerance_minutes" field.
+func (_u *ShiftUpdate) AddEntryToleranceMinutes(v int) *ShiftUpdate {
+	_u.mutation.AddEntryToleranceMinutes(v)
+	return _u
+}
+
+// SetExitToleranceMinutes sets the "exit_tolerance_minutes" field.
+func (_u *ShiftUpdate) SetExitToleranceMinutes(v int) *ShiftUpdate {
+	_u.mutation.ResetExitToleranceMinutes()
+	_u.mutation.SetExitToleranceMinutes(v)
+	return _u
+}
+
+// SetNillableExitToleranceMinutes sets the "exit_tolerance_minutes" field if the given value is not nil.
+func (_u *ShiftUpdate) SetNillableExitToleranceMinutes(v *int) *ShiftUpdate {
+	if v != nil {
+		_u.SetExitToleranceMinutes(*v)
+	}
+	return _u
+}
+
+// AddExitToleranceMinutes adds value to the "exit_tolerance_minutes" field.
+func (_u *ShiftUpdate) AddExitToleranceMinutes(v int) *ShiftUpdate {
+	_u.mutation.AddExitToleranceMinutes(v)
+	return _u
+}
+
+// SetMinOvertimeMinutes sets the "min_overtime_minutes" field.
+func (_u *ShiftUpdate) SetMinOvertimeMinutes(v int) *ShiftUpdate {
+	_u.mutation.ResetMinOvertimeMinutes()
+	_u.mutation.SetMinOvertimeMinutes(v)
+	return _u
+}
+
+// SetNillableMinOvertimeMinutes sets the "min_overtime_minutes" field if the given value is not nil.
+func (_u *ShiftUpdate) SetNillableMinOvertimeMinutes(v *int) *ShiftUpdate {
+	if v != nil {
+		_u.SetMinOvertimeMinutes(*v)
+	}
+	return _u
+}
+
+// AddMinOvertimeMinutes adds value to the "min_overtime_minutes" field.
+func (_u *ShiftUpdate) AddMinOvertimeMinutes(v int) *ShiftUpdate {
+	_u.mutation.AddMinOvertimeMinutes(v)
+	return _u
+}
+
 // SetCrossesMidnight sets the "crosses_midnight" field.
 func (_u *ShiftUpdate) SetCrossesMidnight(v bool) *ShiftUpdate {
 	_u.mutation.SetCrossesMidnight(v)
@@ -163,6 +227,67 @@ func (_u *ShiftUpdate) SetNillableIsActive(v *bool) *ShiftUpdate {
 	return _u
 }
 
+// SetPatternType sets the "pattern_type" field.
+func (_u *ShiftUpdate) SetPatternType(v string) *ShiftUpdate {
+	_u.mutation.SetPatternType(v)
+	return _u
+}
+
+// SetNillablePatternType sets the "pattern_type" field if the given value is not nil.
+func (_u *ShiftUpdate) SetNillablePatternType(v *string) *ShiftUpdate {
+	if v != nil {
+		_u.SetPatternType(*v)
+	}
+	return _u
+}
+
+// SetCycleLength sets the "cycle_length" field.
+func (_u *ShiftUpdate) SetCycleLength(v int) *ShiftUpdate {
+	_u.mutation.ResetCycleLength()
+	_u.mutation.SetCycleLength(v)
+	return _u
+}
+
+// SetNillableCycleLength sets the "cycle_length" field if the given value is not nil.
+func (_u *ShiftUpdate) SetNillableCycleLength(v *int) *ShiftUpdate {
+	if v != nil {
+		_u.SetCycleLength(*v)
+	}
+	return _u
+}
+
+// AddCycleLength adds value to the "cycle_length" field.
+func (_u *ShiftUpdate) AddCycleLength(v int) *ShiftUpdate {
+	_u.mutation.AddCycleLength(v)
+	return _u
+}
+
+// ClearCycleLength clears the value of the "cycle_length" field.
+func (_u *ShiftUpdate) ClearCycleLength() *ShiftUpdate {
+	_u.mutation.ClearCycleLength()
+	return _u
+}
+
+// SetCycleAnchorDate sets the "cycle_anchor_date" field.
+func (_u *ShiftUpdate) SetCycleAnchorDate(v time.Time) *ShiftUpdate {
+	_u.mutation.SetCycleAnchorDate(v)
+	return _u
+}
+
+// SetNillableCycleAnchorDate sets the "cycle_anchor_date" field if the given value is not nil.
+func (_u *ShiftUpdate) SetNillableCycleAnchorDate(v *time.Time) *ShiftUpdate {
+	if v != nil {
+		_u.SetCycleAnchorDate(*v)
+	}
+	return _u
+}
+
+// ClearCycleAnchorDate clears the value of the "cycle_anchor_date" field.
+func (_u *ShiftUpdate) ClearCycleAnchorDate() *ShiftUpdate {
+	_u.mutation.ClearCycleAnchorDate()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *ShiftUpdate) SetUpdatedAt(v time.Time) *ShiftUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -184,6 +309,21 @@ func (_u *ShiftUpdate) AddDays(v ...*ShiftDay) *ShiftUpdate {
 	return _u.AddDayIDs(ids...)
 }
 
+// AddCycleDayIDs adds the "cycle_days" edge to the ShiftCycleDay entity by IDs.
+func (_u *ShiftUpdate) AddCycleDayIDs(ids ...int) *ShiftUpdate {
+	_u.mutation.AddCycleDayIDs(ids...)
+	return _u
+}
+
+// AddCycleDays adds the "cycle_days" edges to the ShiftCycleDay entity.
+func (_u *ShiftUpdate) AddCycleDays(v ...*ShiftCycleDay) *ShiftUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCycleDayIDs(ids...)
+}
+
 // AddInstanceIDs adds the "instances" edge to the ShiftInstance entity by IDs.
 func (_u *ShiftUpdate) AddInstanceIDs(ids ...int) *ShiftUpdate {
 	_u.mutation.AddInstanceIDs(ids...)
@@ -255,6 +395,27 @@ func (_u *ShiftUpdate) RemoveDays(v ...*ShiftDay) *ShiftUpdate {
 	return _u.RemoveDayIDs(ids...)
 }
 
+// ClearCycleDays clears all "cycle_days" edges to the ShiftCycleDay entity.
+func (_u *ShiftUpdate) ClearCycleDays() *ShiftUpdate {
+	_u.mutation.ClearCycleDays()
+	return _u
+}
+
+// RemoveCycleDayIDs removes the "cycle_days" edge to ShiftCycleDay entities by IDs.
+func (_u *ShiftUpdate) RemoveCycleDayIDs(ids ...int) *ShiftUpdate {
+	_u.mutation.RemoveCycleDayIDs(ids...)
+	return _u
+}
+
+// RemoveCycleDays removes "cycle_days" edges to ShiftCycleDay entities.
+func (_u *ShiftUpdate) RemoveCycleDays(v ...*ShiftCycleDay) *ShiftUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCycleDayIDs(ids...)
+}
+
 // ClearInstances clears all "instances" edges to the ShiftInstance entity.
 func (_u *ShiftUpdate) ClearInstances() *ShiftUpdate {
 	_u.mutation.ClearInstances()
@@ -371,6 +532,31 @@ func (_u *ShiftUpdate) check() error {
 			return &ValidationError{Name: "end_time", err: fmt.Errorf(`ent: validator failed for field "Shift.end_time": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.EntryToleranceMinutes(); ok {
+		if err := shift.EntryToleranceMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "entry_tolerance_minutes", err: fmt.Errorf(`ent: validator failed for field "Shift.entry_tolerance_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExitToleranceMinutes(); ok {
+		if err := shift.ExitToleranceMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "exit_tolerance_minutes", err: fmt.Errorf(`ent: validator failed for field "Shift.exit_tolerance_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MinOvertimeMinutes(); ok {
+		if err := shift.MinOvertimeMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "min_overtime_minutes", err: fmt.Errorf(`ent: validator failed for field "Shift.min_overtime_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PatternType(); ok {
+		if err := shift.PatternTypeValidator(v); err != nil {
+			return &ValidationError{Name: "pattern_type", err: fmt.Errorf(`ent: validator failed for field "Shift.pattern_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CycleLength(); ok {
+		if err := shift.CycleLengthValidator(v); err != nil {
+			return &ValidationError{Name: "cycle_length", err: fmt.Errorf(`ent: validator failed for field "Shift.cycle_length": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -413,12 +599,48 @@ func (_u *ShiftUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedBreakMinutes(); ok {
 		_spec.AddField(shift.FieldBreakMinutes, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.EntryToleranceMinutes(); ok {
+		_spec.SetField(shift.FieldEntryToleranceMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntryToleranceMinutes(); ok {
+		_spec.AddField(shift.FieldEntryToleranceMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExitToleranceMinutes(); ok {
+		_spec.SetField(shift.FieldExitToleranceMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExitToleranceMinutes(); ok {
+		_spec.AddField(shift.FieldExitToleranceMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MinOvertimeMinutes(); ok {
+		_spec.SetField(shift.FieldMinOvertimeMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinOvertimeMinutes(); ok {
+		_spec.AddField(shift.FieldMinOvertimeMinutes, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.CrossesMidnight(); ok {
 		_spec.SetField(shift.FieldCrossesMidnight, field.TypeBool, value)
 	}
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(shift.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.PatternType(); ok {
+		_spec.SetField(shift.FieldPatternType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CycleLength(); ok {
+		_spec.SetField(shift.FieldCycleLength, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCycleLength(); ok {
+		_spec.AddField(shift.FieldCycleLength, field.TypeInt, value)
+	}
+	if _u.mutation.CycleLengthCleared() {
+		_spec.ClearField(shift.FieldCycleLength, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CycleAnchorDate(); ok {
+		_spec.SetField(shift.FieldCycleAnchorDate, field.TypeTime, value)
+	}
+	if _u.mutation.CycleAnchorDateCleared() {
+		_spec.ClearField(shift.FieldCycleAnchorDate, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(shift.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -467,6 +689,51 @@ func (_u *ShiftUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CycleDaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shift.CycleDaysTable,
+			Columns: []string{shift.CycleDaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftcycleday.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCycleDaysIDs(); len(nodes) > 0 && !_u.mutation.CycleDaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shift.CycleDaysTable,
+			Columns: []string{shift.CycleDaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftcycleday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CycleDaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shift.CycleDaysTable,
+			Columns: []string{shift.CycleDaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftcycleday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.InstancesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -725,6 +992,69 @@ func (_u *ShiftUpdateOne) AddBreakMinutes(v int) *ShiftUpdateOne {
 	return _u
 }
 
+// SetEntryToleranceMinutes sets the "entry_tolerance_minutes" field.
+func (_u *ShiftUpdateOne) SetEntryToleranceMinutes(v int) *ShiftUpdateOne {
+	_u.mutation.ResetEntryToleranceMinutes()
+	_u.mutation.SetEntryToleranceMinutes(v)
+	return _u
+}
+
+// SetNillableEntryToleranceMinutes sets the "entry_tolerance_minutes" field if the given value is not nil.
+func (_u *ShiftUpdateOne) SetNillableEntryToleranceMinutes(v *int) *ShiftUpdateOne {
+	if v != nil {
+		_u.SetEntryToleranceMinutes(*v)
+	}
+	return _u
+}
+
+// AddEntryToleranceMinutes adds value to the "entry_tolerance_minutes" field.
+func (_u *ShiftUpdateOne) AddEntryToleranceMinutes(v int) *ShiftUpdateOne {
+	_u.mutation.AddEntryToleranceMinutes(v)
+	return _u
+}
+
+// SetExitToleranceMinutes sets the "exit_tolerance_minutes" field.
+func (_u *ShiftUpdateOne) SetExitToleranceMinutes(v int) *ShiftUpdateOne {
+	_u.mutation.ResetExitToleranceMinutes()
+	_u.mutation.SetExitToleranceMinutes(v)
+	return _u
+}
+
+// SetNillableExitToleranceMinutes sets the "exit_tolerance_minutes" field if the given value is not nil.
+func (_u *ShiftUpdateOne) SetNillableExitToleranceMinutes(v *int) *ShiftUpdateOne {
+	if v != nil {
+		_u.SetExitToleranceMinutes(*v)
+	}
+	return _u
+}
+
+// AddExitToleranceMinutes adds value to the "exit_tolerance_minutes" field.
+func (_u *ShiftUpdateOne) AddExitToleranceMinutes(v int) *ShiftUpdateOne {
+	_u.mutation.AddExitToleranceMinutes(v)
+	return _u
+}
+
+// SetMinOvertimeMinutes sets the "min_overtime_minutes" field.
+func (_u *ShiftUpdateOne) SetMinOvertimeMinutes(v int) *ShiftUpdateOne {
+	_u.mutation.ResetMinOvertimeMinutes()
+	_u.mutation.SetMinOvertimeMinutes(v)
+	return _u
+}
+
+// SetNillableMinOvertimeMinutes sets the "min_overtime_minutes" field if the given value is not nil.
+func (_u *ShiftUpdateOne) SetNillableMinOvertimeMinutes(v *int) *ShiftUpdateOne {
+	if v != nil {
+		_u.SetMinOvertimeMinutes(*v)
+	}
+	return _u
+}
+
+// AddMinOvertimeMinutes adds value to the "min_overtime_minutes" field.
+func (_u *ShiftUpdateOne) AddMinOvertimeMinutes(v int) *ShiftUpdateOne {
+	_u.mutation.AddMinOvertimeMinutes(v)
+	return _u
+}
+
 // SetCrossesMidnight sets the "crosses_midnight" field.
 func (_u *ShiftUpdateOne) SetCrossesMidnight(v bool) *ShiftUpdateOne {
 	_u.mutation.SetCrossesMidnight(v)
@@ -753,6 +1083,67 @@ func (_u *ShiftUpdateOne) SetNillableIsActive(v *bool) *ShiftUpdateOne {
 	return _u
 }
 
+// SetPatternType sets the "pattern_type" field.
+func (_u *ShiftUpdateOne) SetPatternType(v string) *ShiftUpdateOne {
+	_u.mutation.SetPatternType(v)
+	return _u
+}
+
+// SetNillablePatternType sets the "pattern_type" field if the given value is not nil.
+func (_u *ShiftUpdateOne) SetNillablePatternType(v *string) *ShiftUpdateOne {
+	if v != nil {
+		_u.SetPatternType(*v)
+	}
+	return _u
+}
+
+// SetCycleLength sets the "cycle_length" field.
+func (_u *ShiftUpdateOne) SetCycleLength(v int) *ShiftUpdateOne {
+	_u.mutation.ResetCycleLength()
+	_u.mutation.SetCycleLength(v)
+	return _u
+}
+
+// SetNillableCycleLength sets the "cycle_length" field if the given value is not nil.
+func (_u *ShiftUpdateOne) SetNillableCycleLength(v *int) *ShiftUpdateOne {
+	if v != nil {
+		_u.SetCycleLength(*v)
+	}
+	return _u
+}
+
+// AddCycleLength adds value to the "cycle_length" field.
+func (_u *ShiftUpdateOne) AddCycleLength(v int) *ShiftUpdateOne {
+	_u.mutation.AddCycleLength(v)
+	return _u
+}
+
+// ClearCycleLength clears the value of the "cycle_length" field.
+func (_u *ShiftUpdateOne) ClearCycleLength() *ShiftUpdateOne {
+	_u.mutation.ClearCycleLength()
+	return _u
+}
+
+// SetCycleAnchorDate sets the "cycle_anchor_date" field.
+func (_u *ShiftUpdateOne) SetCycleAnchorDate(v time.Time) *ShiftUpdateOne {
+	_u.mutation.SetCycleAnchorDate(v)
+	return _u
+}
+
+// SetNillableCycleAnchorDate sets the "cycle_anchor_date" field if the given value is not nil.
+func (_u *ShiftUpdateOne) SetNillableCycleAnchorDate(v *time.Time) *ShiftUpdateOne {
+	if v != nil {
+		_u.SetCycleAnchorDate(*v)
+	}
+	return _u
+}
+
+// ClearCycleAnchorDate clears the value of the "cycle_anchor_date" field.
+func (_u *ShiftUpdateOne) ClearCycleAnchorDate() *ShiftUpdateOne {
+	_u.mutation.ClearCycleAnchorDate()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *ShiftUpdateOne) SetUpdatedAt(v time.Time) *ShiftUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -774,6 +1165,21 @@ func (_u *ShiftUpdateOne) AddDays(v ...*ShiftDay) *ShiftUpdateOne {
 	return _u.AddDayIDs(ids...)
 }
 
+// AddCycleDayIDs adds the "cycle_days" edge to the ShiftCycleDay entity by IDs.
+func (_u *ShiftUpdateOne) AddCycleDayIDs(ids ...int) *ShiftUpdateOne {
+	_u.mutation.AddCycleDayIDs(ids...)
+	return _u
+}
+
+// AddCycleDays adds the "cycle_days" edges to the ShiftCycleDay entity.
+func (_u *ShiftUpdateOne) AddCycleDays(v ...*ShiftCycleDay) *ShiftUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCycleDayIDs(ids...)
+}
+
 // AddInstanceIDs adds the "instances" edge to the ShiftInstance entity by IDs.
 func (_u *ShiftUpdateOne) AddInstanceIDs(ids ...int) *ShiftUpdateOne {
 	_u.mutation.AddInstanceIDs(ids...)
@@ -845,6 +1251,27 @@ func (_u *ShiftUpdateOne) RemoveDays(v ...*ShiftDay) *ShiftUpdateOne {
 	return _u.RemoveDayIDs(ids...)
 }
 
+// ClearCycleDays clears all "cycle_days" edges to the ShiftCycleDay entity.
+func (_u *ShiftUpdateOne) ClearCycleDays() *ShiftUpdateOne {
+	_u.mutation.ClearCycleDays()
+	return _u
+}
+
+// RemoveCycleDayIDs removes the "cycle_days" edge to ShiftCycleDay entities by IDs.
+func (_u *ShiftUpdateOne) RemoveCycleDayIDs(ids ...int) *ShiftUpdateOne {
+	_u.mutation.RemoveCycleDayIDs(ids...)
+	return _u
+}
+
+// RemoveCycleDays removes "cycle_days" edges to ShiftCycleDay entities.
+func (_u *ShiftUpdateOne) RemoveCycleDays(v ...*ShiftCycleDay) *ShiftUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCycleDayIDs(ids...)
+}
+
 // ClearInstances clears all "instances" edges to the ShiftInstance entity.
 func (_u *ShiftUpdateOne) ClearInstances() *ShiftUpdateOne {
 	_u.mutation.ClearInstances()
@@ -974,6 +1401,31 @@ func (_u *ShiftUpdateOne) check() error {
 			return &ValidationError{Name: "end_time", err: fmt.Errorf(`ent: validator failed for field "Shift.end_time": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.EntryToleranceMinutes(); ok {
+		if err := shift.EntryToleranceMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "entry_tolerance_minutes", err: fmt.Errorf(`ent: validator failed for field "Shift.entry_tolerance_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExitToleranceMinutes(); ok {
+		if err := shift.ExitToleranceMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "exit_tolerance_minutes", err: fmt.Errorf(`ent: validator failed for field "Shift.exit_tolerance_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MinOvertimeMinutes(); ok {
+		if err := shift.MinOvertimeMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "min_overtime_minutes", err: fmt.Errorf(`ent: validator failed for field "Shift.min_overtime_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PatternType(); ok {
+		if err := shift.PatternTypeValidator(v); err != nil {
+			return &ValidationError{Name: "pattern_type", err: fmt.Errorf(`ent: validator failed for field "Shift.pattern_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CycleLength(); ok {
+		if err := shift.CycleLengthValidator(v); err != nil {
+			return &ValidationError{Name: "cycle_length", err: fmt.Errorf(`ent: validator failed for field "Shift.cycle_length": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1033,12 +1485,48 @@ func (_u *ShiftUpdateOne) sqlSave(ctx context.Context) (_node *Shift, err error)
 	if value, ok := _u.mutation.AddedBreakMinutes(); ok {
 		_spec.AddField(shift.FieldBreakMinutes, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.EntryToleranceMinutes(); ok {
+		_spec.SetField(shift.FieldEntryToleranceMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntryToleranceMinutes(); ok {
+		_spec.AddField(shift.FieldEntryToleranceMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExitToleranceMinutes(); ok {
+		_spec.SetField(shift.FieldExitToleranceMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExitToleranceMinutes(); ok {
+		_spec.AddField(shift.FieldExitToleranceMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MinOvertimeMinutes(); ok {
+		_spec.SetField(shift.FieldMinOvertimeMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinOvertimeMinutes(); ok {
+		_spec.AddField(shift.FieldMinOvertimeMinutes, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.CrossesMidnight(); ok {
 		_spec.SetField(shift.FieldCrossesMidnight, field.TypeBool, value)
 	}
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(shift.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.PatternType(); ok {
+		_spec.SetField(shift.FieldPatternType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CycleLength(); ok {
+		_spec.SetField(shift.FieldCycleLength, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCycleLength(); ok {
+		_spec.AddField(shift.FieldCycleLength, field.TypeInt, value)
+	}
+	if _u.mutation.CycleLengthCleared() {
+		_spec.ClearField(shift.FieldCycleLength, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CycleAnchorDate(); ok {
+		_spec.SetField(shift.FieldCycleAnchorDate, field.TypeTime, value)
+	}
+	if _u.mutation.CycleAnchorDateCleared() {
+		_spec.ClearField(shift.FieldCycleAnchorDate, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(shift.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -1087,6 +1575,51 @@ func (_u *ShiftUpdateOne) sqlSave(ctx context.Context) (_node *Shift, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CycleDaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shift.CycleDaysTable,
+			Columns: []string{shift.CycleDaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftcycleday.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCycleDaysIDs(); len(nodes) > 0 && !_u.mutation.CycleDaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shift.CycleDaysTable,
+			Columns: []string{shift.CycleDaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftcycleday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CycleDaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shift.CycleDaysTable,
+			Columns: []string{shift.CycleDaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftcycleday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.InstancesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/shift"
+	"back/internal/ent/shiftcycleday"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ShiftCycleDay is the model entity for the ShiftCycleDay schema.
+type ShiftCycleDay struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ShiftID holds the value of the "shift_id" field.
+	ShiftID int `json:"shift_id,omitempty"`
+	// DayIndex holds the value of the "day_index" field.
+	DayIndex int `json:"day_index,omitempty"`
+	// IsWorkingDay holds the value of the "is_working_day" field.
+	IsWorkingDay bool `json:"is_working_day,omitempty"`
+	// Mode holds the value of the "mode" field.
+	Mode string `json:"mode,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShiftCycleDayQuery when eager-loading is set.
+	Edges        ShiftCycleDayEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ShiftCycleDayEdges holds the relations/edges for other nodes in the graph.
+type ShiftCycleDayEdges struct {
+	// Shift holds the value of the shift edge.
+	Shift *Shift `json:"shift,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ShiftOrErr returns the Shift value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShiftCycleDayEdges) ShiftOrErr() (*Shift, error) {
+	if e.Shift != nil {
+		return e.Shift, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: shift.Label}
+	}
+	return nil, &NotLoadedError{edge: "shift"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ShiftCycleDay) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case shiftcycleday.FieldIsWorkingDay:
+			values[i] = new(sql.NullBool)
+		case shiftcycleday.FieldID, shiftcycleday.FieldShiftID, shiftcycleday.FieldDayIndex:
+			values[i] = new(sql.NullInt64)
+		case shiftcycleday.FieldMode:
+			values[i] = new(sql.NullString)
+		case shiftcycleday.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ShiftCycleDay fields.
+func (_m *ShiftCycleDay) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case shiftcycleday.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case shiftcycleday.FieldShiftID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field shift_id", values[i])
+			} else if value.Valid {
+				_m.ShiftID = int(value.Int64)
+			}
+		case shiftcycleday.FieldDayIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field day_index", values[i])
+			} else if value.Valid {
+				_m.DayIndex = int(value.Int64)
+			}
+		case shiftcycleday.FieldIsWorkingDay:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_working_day", values[i])
+			} else if value.Valid {
+				_m.IsWorkingDay = value.Bool
+			}
+		case shiftcycleday.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				_m.Mode = value.String
+			}
+		case shiftcycleday.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ShiftCycleDay.
+// This includes values selected through modifiers, order, etc.
+func (_m *ShiftCycleDay) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryShift queries the "shift" edge of the ShiftCycleDay entity.
+func (_m *ShiftCycleDay) QueryShift() *ShiftQuery {
+	return NewShiftCycleDayClient(_m.config).QueryShift(_m)
+}
+
+// Update returns a builder for updating this ShiftCycleDay.
+// Note that you need to call ShiftCycleDay.Unwrap() before calling this method if this ShiftCycleDay
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ShiftCycleDay) Update() *ShiftCycleDayUpdateOne {
+	return NewShiftCycleDayClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ShiftCycleDay entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ShiftCycleDay) Unwrap() *ShiftCycleDay {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ShiftCycleDay is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ShiftCycleDay) String() string {
+	var builder strings.Builder
+	builder.WriteString("ShiftCycleDay(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("shift_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ShiftID))
+	builder.WriteString(", ")
+	builder.WriteString("day_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DayIndex))
+	builder.WriteString(", ")
+	builder.WriteString("is_working_day=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsWorkingDay))
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(_m.Mode)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ShiftCycleDays is a parsable slice of ShiftCycleDay.
+type ShiftCycleDays []*ShiftCycleDay
@@ -0,0 +1,116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package shiftcycleday
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the shiftcycleday type in the database.
+	Label = "shift_cycle_day"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldShiftID holds the string denoting the shift_id field in the database.
+	FieldShiftID = "shift_id"
+	// FieldDayIndex holds the string denoting the day_index field in the database.
+	FieldDayIndex = "day_index"
+	// FieldIsWorkingDay holds the string denoting the is_working_day field in the database.
+	FieldIsWorkingDay = "is_working_day"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeShift holds the string denoting the shift edge name in mutations.
+	EdgeShift = "shift"
+	// Table holds the table name of the shiftcycleday in the database.
+	Table = "shift_cycle_days"
+	// ShiftTable is the table that holds the shift relation/edge.
+	ShiftTable = "shift_cycle_days"
+	// ShiftInverseTable is the table name for the Shift entity.
+	// It exists in this package in order to avoid circular dependency with the "shift" package.
+	ShiftInverseTable = "shifts"
+	// ShiftColumn is the table column denoting the shift relation/edge.
+	ShiftColumn = "shift_id"
+)
+
+// Columns holds all SQL columns for shiftcycleday fields.
+var Columns = []string{
+	FieldID,
+	FieldShiftID,
+	FieldDayIndex,
+	FieldIsWorkingDay,
+	FieldMode,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DayIndexValidator is a validator for the "day_index" field. It is called by the builders before save.
+	DayIndexValidator func(int) error
+	// DefaultIsWorkingDay holds the default value on creation for the "is_working_day" field.
+	DefaultIsWorkingDay bool
+	// DefaultMode holds the default value on creation for the "mode" field.
+	DefaultMode string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ShiftCycleDay queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByShiftID orders the results by the shift_id field.
+func ByShiftID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShiftID, opts...).ToFunc()
+}
+
+// ByDayIndex orders the results by the day_index field.
+func ByDayIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDayIndex, opts...).ToFunc()
+}
+
+// ByIsWorkingDay orders the results by the is_working_day field.
+func ByIsWorkingDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsWorkingDay, opts...).ToFunc()
+}
+
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByShiftField orders the results by shift field.
+func ByShiftField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShiftStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newShiftStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShiftInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ShiftTable, ShiftColumn),
+	)
+}
@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package shiftcycleday
+
+import (
+	"back/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldLTE(FieldID, id))
+}
+
+// ShiftID applies equality check predicate on the "shift_id" field. It's identical to ShiftIDEQ.
+func ShiftID(v int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldEQ(FieldShiftID, v))
+}
+
+// DayIndex applies equality check predicate on the "day_index" field. It's identical to DayIndexEQ.
+func DayIndex(v int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldEQ(FieldDayIndex, v))
+}
+
+// IsWorkingDay applies equality check predicate on the "is_working_day" field. It's identical to IsWorkingDayEQ.
+func IsWorkingDay(v bool) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldEQ(FieldIsWorkingDay, v))
+}
+
+// Mode applies equality check predicate on the "mode" field. It's identical to ModeEQ.
+func Mode(v string) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldEQ(FieldMode, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ShiftIDEQ applies the EQ predicate on the "shift_id" field.
+func ShiftIDEQ(v int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldEQ(FieldShiftID, v))
+}
+
+// ShiftIDNEQ applies the NEQ predicate on the "shift_id" field.
+func ShiftIDNEQ(v int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldNEQ(FieldShiftID, v))
+}
+
+// ShiftIDIn applies the In predicate on the "shift_id" field.
+func ShiftIDIn(vs ...int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldIn(FieldShiftID, vs...))
+}
+
+// ShiftIDNotIn applies the NotIn predicate on the "shift_id" field.
+func ShiftIDNotIn(vs ...int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldNotIn(FieldShiftID, vs...))
+}
+
+// DayIndexEQ applies the EQ predicate on the "day_index" field.
+func DayIndexEQ(v int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldEQ(FieldDayIndex, v))
+}
+
+// DayIndexNEQ applies the NEQ predicate on the "day_index" field.
+func DayIndexNEQ(v int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldNEQ(FieldDayIndex, v))
+}
+
+// DayIndexIn applies the In predicate on the "day_index" field.
+func DayIndexIn(vs ...int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldIn(FieldDayIndex, vs...))
+}
+
+// DayIndexNotIn applies the NotIn predicate on the "day_index" field.
+func DayIndexNotIn(vs ...int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldNotIn(FieldDayIndex, vs...))
+}
+
+// DayIndexGT applies the GT predicate on the "day_index" field.
+func DayIndexGT(v int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldGT(FieldDayIndex, v))
+}
+
+// DayIndexGTE applies the GTE predicate on the "day_index" field.
+func DayIndexGTE(v int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldGTE(FieldDayIndex, v))
+}
+
+// DayIndexLT applies the LT predicate on the "day_index" field.
+func DayIndexLT(v int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldLT(FieldDayIndex, v))
+}
+
+// DayIndexLTE applies the LTE predicate on the "day_index" field.
+func DayIndexLTE(v int) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldLTE(FieldDayIndex, v))
+}
+
+// IsWorkingDayEQ applies the EQ predicate on the "is_working_day" field.
+func IsWorkingDayEQ(v bool) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldEQ(FieldIsWorkingDay, v))
+}
+
+// IsWorkingDayNEQ applies the NEQ predicate on the "is_working_day" field.
+func IsWorkingDayNEQ(v bool) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldNEQ(FieldIsWorkingDay, v))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v string) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v string) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...string) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...string) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// ModeGT applies the GT predicate on the "mode" field.
+func ModeGT(v string) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldGT(FieldMode, v))
+}
+
+// ModeGTE applies the GTE predicate on the "mode" field.
+func ModeGTE(v string) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldGTE(FieldMode, v))
+}
+
+// ModeLT applies the LT predicate on the "mode" field.
+func ModeLT(v string) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldLT(FieldMode, v))
+}
+
+// ModeLTE applies the LTE predicate on the "mode" field.
+func ModeLTE(v string) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldLTE(FieldMode, v))
+}
+
+// ModeContains applies the Contains predicate on the "mode" field.
+func ModeContains(v string) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldContains(FieldMode, v))
+}
+
+// ModeHasPrefix applies the HasPrefix predicate on the "mode" field.
+func ModeHasPrefix(v string) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldHasPrefix(FieldMode, v))
+}
+
+// ModeHasSuffix applies the HasSuffix predicate on the "mode" field.
+func ModeHasSuffix(v string) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldHasSuffix(FieldMode, v))
+}
+
+// ModeEqualFold applies the EqualFold predicate on the "mode" field.
+func ModeEqualFold(v string) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldEqualFold(FieldMode, v))
+}
+
+// ModeContainsFold applies the ContainsFold predicate on the "mode" field.
+func ModeContainsFold(v string) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldContainsFold(FieldMode, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasShift applies the HasEdge predicate on the "shift" edge.
+func HasShift() predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ShiftTable, ShiftColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShiftWith applies the HasEdge predicate on the "shift" edge with a given conditions (other predicates).
+func HasShiftWith(preds ...predicate.Shift) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(func(s *sql.Selector) {
+		step := newShiftStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ShiftCycleDay) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ShiftCycleDay) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ShiftCycleDay) predicate.ShiftCycleDay {
+	return predicate.ShiftCycleDay(sql.NotPredicates(p))
+}
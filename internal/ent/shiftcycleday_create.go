@@ -0,0 +1,305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/shift"
+	"back/internal/ent/shiftcycleday"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShiftCycleDayCreate is the builder for creating a ShiftCycleDay entity.
+type ShiftCycleDayCreate struct {
+	config
+	mutation *ShiftCycleDayMutation
+	hooks    []Hook
+}
+
+// SetShiftID sets the "shift_id" field.
+func (_c *ShiftCycleDayCreate) SetShiftID(v int) *ShiftCycleDayCreate {
+	_c.mutation.SetShiftID(v)
+	return _c
+}
+
+// SetDayIndex sets the "day_index" field.
+func (_c *ShiftCycleDayCreate) SetDayIndex(v int) *ShiftCycleDayCreate {
+	_c.mutation.SetDayIndex(v)
+	return _c
+}
+
+// SetIsWorkingDay sets the "is_working_day" field.
+func (_c *ShiftCycleDayCreate) SetIsWorkingDay(v bool) *ShiftCycleDayCreate {
+	_c.mutation.SetIsWorkingDay(v)
+	return _c
+}
+
+// SetNillableIsWorkingDay sets the "is_working_day" field if the given value is not nil.
+func (_c *ShiftCycleDayCreate) SetNillableIsWorkingDay(v *bool) *ShiftCycleDayCreate {
+	if v != nil {
+		_c.SetIsWorkingDay(*v)
+	}
+	return _c
+}
+
+// SetMode sets the "mode" field.
+func (_c *ShiftCycleDayCreate) SetMode(v string) *ShiftCycleDayCreate {
+	_c.mutation.SetMode(v)
+	return _c
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_c *ShiftCycleDayCreate) SetNillableMode(v *string) *ShiftCycleDayCreate {
+	if v != nil {
+		_c.SetMode(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ShiftCycleDayCreate) SetCreatedAt(v time.Time) *ShiftCycleDayCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ShiftCycleDayCreate) SetNillableCreatedAt(v *time.Time) *ShiftCycleDayCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetShift sets the "shift" edge to the Shift entity.
+func (_c *ShiftCycleDayCreate) SetShift(v *Shift) *ShiftCycleDayCreate {
+	return _c.SetShiftID(v.ID)
+}
+
+// Mutation returns the ShiftCycleDayMutation object of the builder.
+func (_c *ShiftCycleDayCreate) Mutation() *ShiftCycleDayMutation {
+	return _c.mutation
+}
+
+// Save creates the ShiftCycleDay in the database.
+func (_c *ShiftCycleDayCreate) Save(ctx context.Context) (*ShiftCycleDay, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ShiftCycleDayCreate) SaveX(ctx context.Context) *ShiftCycleDay {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ShiftCycleDayCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ShiftCycleDayCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ShiftCycleDayCreate) defaults() {
+	if _, ok := _c.mutation.IsWorkingDay(); !ok {
+		v := shiftcycleday.DefaultIsWorkingDay
+		_c.mutation.SetIsWorkingDay(v)
+	}
+	if _, ok := _c.mutation.Mode(); !ok {
+		v := shiftcycleday.DefaultMode
+		_c.mutation.SetMode(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := shiftcycleday.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ShiftCycleDayCreate) check() error {
+	if _, ok := _c.mutation.ShiftID(); !ok {
+		return &ValidationError{Name: "shift_id", err: errors.New(`ent: missing required field "ShiftCycleDay.shift_id"`)}
+	}
+	if _, ok := _c.mutation.DayIndex(); !ok {
+		return &ValidationError{Name: "day_index", err: errors.New(`ent: missing required field "ShiftCycleDay.day_index"`)}
+	}
+	if v, ok := _c.mutation.DayIndex(); ok {
+		if err := shiftcycleday.DayIndexValidator(v); err != nil {
+			return &ValidationError{Name: "day_index", err: fmt.Errorf(`ent: validator failed for field "ShiftCycleDay.day_index": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsWorkingDay(); !ok {
+		return &ValidationError{Name: "is_working_day", err: errors.New(`ent: missing required field "ShiftCycleDay.is_working_day"`)}
+	}
+	if _, ok := _c.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "ShiftCycleDay.mode"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ShiftCycleDay.created_at"`)}
+	}
+	if len(_c.mutation.ShiftIDs()) == 0 {
+		return &ValidationError{Name: "shift", err: errors.New(`ent: missing required edge "ShiftCycleDay.shift"`)}
+	}
+	return nil
+}
+
+func (_c *ShiftCycleDayCreate) sqlSave(ctx context.Context) (*ShiftCycleDay, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ShiftCycleDayCreate) createSpec() (*ShiftCycleDay, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShiftCycleDay{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(shiftcycleday.Table, sqlgraph.NewFieldSpec(shiftcycleday.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.DayIndex(); ok {
+		_spec.SetField(shiftcycleday.FieldDayIndex, field.TypeInt, value)
+		_node.DayIndex = value
+	}
+	if value, ok := _c.mutation.IsWorkingDay(); ok {
+		_spec.SetField(shiftcycleday.FieldIsWorkingDay, field.TypeBool, value)
+		_node.IsWorkingDay = value
+	}
+	if value, ok := _c.mutation.Mode(); ok {
+		_spec.SetField(shiftcycleday.FieldMode, field.TypeString, value)
+		_node.Mode = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(shiftcycleday.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ShiftIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftcycleday.ShiftTable,
+			Columns: []string{shiftcycleday.ShiftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ShiftID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ShiftCycleDayCreateBulk is the builder for creating many ShiftCycleDay entities in bulk.
+type ShiftCycleDayCreateBulk struct {
+	config
+	err      error
+	builders []*ShiftCycleDayCreate
+}
+
+// Save creates the ShiftCycleDay entities in the database.
+func (_c *ShiftCycleDayCreateBulk) Save(ctx context.Context) ([]*ShiftCycleDay, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ShiftCycleDay, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShiftCycleDayMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ShiftCycleDayCreateBulk) SaveX(ctx context.Context) []*ShiftCycleDay {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ShiftCycleDayCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ShiftCycleDayCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/predicate"
+	"back/internal/ent/shiftcycleday"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShiftCycleDayDelete is the builder for deleting a ShiftCycleDay entity.
+type ShiftCycleDayDelete struct {
+	config
+	hooks    []Hook
+	mutation *ShiftCycleDayMutation
+}
+
+// Where appends a list predicates to the ShiftCycleDayDelete builder.
+func (_d *ShiftCycleDayDelete) Where(ps ...predicate.ShiftCycleDay) *ShiftCycleDayDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ShiftCycleDayDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ShiftCycleDayDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ShiftCycleDayDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(shiftcycleday.Table, sqlgraph.NewFieldSpec(shiftcycleday.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ShiftCycleDayDeleteOne is the builder for deleting a single ShiftCycleDay entity.
+type ShiftCycleDayDeleteOne struct {
+	_d *ShiftCycleDayDelete
+}
+
+// Where appends a list predicates to the ShiftCycleDayDelete builder.
+func (_d *ShiftCycleDayDeleteOne) Where(ps ...predicate.ShiftCycleDay) *ShiftCycleDayDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ShiftCycleDayDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{shiftcycleday.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ShiftCycleDayDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/predicate"
+	"back/internal/ent/shift"
+	"back/internal/ent/shiftcycleday"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShiftCycleDayQuery is the builder for querying ShiftCycleDay entities.
+type ShiftCycleDayQuery struct {
+	config
+	ctx        *QueryContext
+	order      []shiftcycleday.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ShiftCycleDay
+	withShift  *ShiftQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ShiftCycleDayQuery builder.
+func (_q *ShiftCycleDayQuery) Where(ps ...predicate.ShiftCycleDay) *ShiftCycleDayQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *ShiftCycleDayQuery) Limit(limit int) *ShiftCycleDayQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *ShiftCycleDayQuery) Offset(offset int) *ShiftCycleDayQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *ShiftCycleDayQuery) Unique(unique bool) *ShiftCycleDayQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *ShiftCycleDayQuery) Order(o ...shiftcycleday.OrderOption) *ShiftCycleDayQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryShift chains the current query on the "shift" edge.
+func (_q *ShiftCycleDayQuery) QueryShift() *ShiftQuery {
+	query := (&ShiftClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shiftcycleday.Table, shiftcycleday.FieldID, selector),
+			sqlgraph.To(shift.Table, shift.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shiftcycleday.ShiftTable, shiftcycleday.ShiftColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ShiftCycleDay entity from the query.
+// Returns a *NotFoundError when no ShiftCycleDay was found.
+func (_q *ShiftCycleDayQuery) First(ctx context.Context) (*ShiftCycleDay, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{shiftcycleday.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *ShiftCycleDayQuery) FirstX(ctx context.Context) *ShiftCycleDay {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ShiftCycleDay ID from the query.
+// Returns a *NotFoundError when no ShiftCycleDay ID was found.
+func (_q *ShiftCycleDayQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{shiftcycleday.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *ShiftCycleDayQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ShiftCycleDay entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ShiftCycleDay entity is found.
+// Returns a *NotFoundError when no ShiftCycleDay entities are found.
+func (_q *ShiftCycleDayQuery) Only(ctx context.Context) (*ShiftCycleDay, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{shiftcycleday.Label}
+	default:
+		return nil, &NotSingularError{shiftcycleday.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *ShiftCycleDayQuery) OnlyX(ctx context.Context) *ShiftCycleDay {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ShiftCycleDay ID in the query.
+// Returns a *NotSingularError when more than one ShiftCycleDay ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *ShiftCycleDayQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{shiftcycleday.Label}
+	default:
+		err = &NotSingularError{shiftcycleday.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *ShiftCycleDayQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ShiftCycleDays.
+func (_q *ShiftCycleDayQuery) All(ctx context.Context) ([]*ShiftCycleDay, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ShiftCycleDay, *ShiftCycleDayQuery]()
+	return withInterceptors[[]*ShiftCycleDay](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *ShiftCycleDayQuery) AllX(ctx context.Context) []*ShiftCycleDay {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ShiftCycleDay IDs.
+func (_q *ShiftCycleDayQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(shiftcycleday.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *ShiftCycleDayQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *ShiftCycleDayQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*ShiftCycleDayQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *ShiftCycleDayQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *ShiftCycleDayQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *ShiftCycleDayQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ShiftCycleDayQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *ShiftCycleDayQuery) Clone() *ShiftCycleDayQuery {
+	if _q == nil {
+		return nil
+	}
+	return &ShiftCycleDayQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]shiftcycleday.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.ShiftCycleDay{}, _q.predicates...),
+		withShift:  _q.withShift.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithShift tells the query-builder to eager-load the nodes that are connected to
+// the "shift" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ShiftCycleDayQuery) WithShift(opts ...func(*ShiftQuery)) *ShiftCycleDayQuery {
+	query := (&ShiftClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withShift = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ShiftID int `json:"shift_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ShiftCycleDay.Query().
+//		GroupBy(shiftcycleday.FieldShiftID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *ShiftCycleDayQuery) GroupBy(field string, fields ...string) *ShiftCycleDayGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ShiftCycleDayGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = shiftcycleday.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ShiftID int `json:"shift_id,omitempty"`
+//	}
+//
+//	client.ShiftCycleDay.Query().
+//		Select(shiftcycleday.FieldShiftID).
+//		Scan(ctx, &v)
+func (_q *ShiftCycleDayQuery) Select(fields ...string) *ShiftCycleDaySelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &ShiftCycleDaySelect{ShiftCycleDayQuery: _q}
+	sbuild.label = shiftcycleday.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ShiftCycleDaySelect configured with the given aggregations.
+func (_q *ShiftCycleDayQuery) Aggregate(fns ...AggregateFunc) *ShiftCycleDaySelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *ShiftCycleDayQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !shiftcycleday.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *ShiftCycleDayQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ShiftCycleDay, error) {
+	var (
+		nodes       = []*ShiftCycleDay{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withShift != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ShiftCycleDay).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ShiftCycleDay{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withShift; query != nil {
+		if err := _q.loadShift(ctx, query, nodes, nil,
+			func(n *ShiftCycleDay, e *Shift) { n.Edges.Shift = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *ShiftCycleDayQuery) loadShift(ctx context.Context, query *ShiftQuery, nodes []*ShiftCycleDay, init func(*ShiftCycleDay), assign func(*ShiftCycleDay, *Shift)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ShiftCycleDay)
+	for i := range nodes {
+		fk := nodes[i].ShiftID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(shift.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "shift_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *ShiftCycleDayQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *ShiftCycleDayQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(shiftcycleday.Table, shiftcycleday.Columns, sqlgraph.NewFieldSpec(shiftcycleday.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shiftcycleday.FieldID)
+		for i := range fields {
+			if fields[i] != shiftcycleday.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withShift != nil {
+			_spec.Node.AddColumnOnce(shiftcycleday.FieldShiftID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *ShiftCycleDayQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(shiftcycleday.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = shiftcycleday.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ShiftCycleDayGroupBy is the group-by builder for ShiftCycleDay entities.
+type ShiftCycleDayGroupBy struct {
+	selector
+	build *ShiftCycleDayQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *ShiftCycleDayGroupBy) Aggregate(fns ...AggregateFunc) *ShiftCycleDayGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *ShiftCycleDayGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShiftCycleDayQuery, *ShiftCycleDayGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *ShiftCycleDayGroupBy) sqlScan(ctx context.Context, root *ShiftCycleDayQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ShiftCycleDaySelect is the builder for selecting fields of ShiftCycleDay entities.
+type ShiftCycleDaySelect struct {
+	*ShiftCycleDayQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *ShiftCycleDaySelect) Aggregate(fns ...AggregateFunc) *ShiftCycleDaySelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *ShiftCycleDaySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShiftCycleDayQuery, *ShiftCycleDaySelect](ctx, _s.ShiftCycleDayQuery, _s, _s.inters, v)
+}
+
+func (_s *ShiftCycleDaySelect) sqlScan(ctx context.Context, root *ShiftCycleDayQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/predicate"
+	"back/internal/ent/shift"
+	"back/internal/ent/shiftcycleday"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShiftCycleDayUpdate is the builder for updating ShiftCycleDay entities.
+type ShiftCycleDayUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShiftCycleDayMutation
+}
+
+// Where appends a list predicates to the ShiftCycleDayUpdate builder.
+func (_u *ShiftCycleDayUpdate) Where(ps ...predicate.ShiftCycleDay) *ShiftCycleDayUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetShiftID sets the "shift_id" field.
+func (_u *ShiftCycleDayUpdate) SetShiftID(v int) *ShiftCycleDayUpdate {
+	_u.mutation.SetShiftID(v)
+	return _u
+}
+
+// SetNillableShiftID sets the "shift_id" field if the given value is not nil.
+func (_u *ShiftCycleDayUpdate) SetNillableShiftID(v *int) *ShiftCycleDayUpdate {
+	if v != nil {
+		_u.SetShiftID(*v)
+	}
+	return _u
+}
+
+// SetDayIndex sets the "day_index" field.
+func (_u *ShiftCycleDayUpdate) SetDayIndex(v int) *ShiftCycleDayUpdate {
+	_u.mutation.ResetDayIndex()
+	_u.mutation.SetDayIndex(v)
+	return _u
+}
+
+// SetNillableDayIndex sets the "day_index" field if the given value is not nil.
+func (_u *ShiftCycleDayUpdate) SetNillableDayIndex(v *int) *ShiftCycleDayUpdate {
+	if v != nil {
+		_u.SetDayIndex(*v)
+	}
+	return _u
+}
+
+// AddDayIndex adds value to the "day_index" field.
+func (_u *ShiftCycleDayUpdate) AddDayIndex(v int) *ShiftCycleDayUpdate {
+	_u.mutation.AddDayIndex(v)
+	return _u
+}
+
+// SetIsWorkingDay sets the "is_working_day" field.
+func (_u *ShiftCycleDayUpdate) SetIsWorkingDay(v bool) *ShiftCycleDayUpdate {
+	_u.mutation.SetIsWorkingDay(v)
+	return _u
+}
+
+// SetNillableIsWorkingDay sets the "is_working_day" field if the given value is not nil.
+func (_u *ShiftCycleDayUpdate) SetNillableIsWorkingDay(v *bool) *ShiftCycleDayUpdate {
+	if v != nil {
+		_u.SetIsWorkingDay(*v)
+	}
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *ShiftCycleDayUpdate) SetMode(v string) *ShiftCycleDayUpdate {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *ShiftCycleDayUpdate) SetNillableMode(v *string) *ShiftCycleDayUpdate {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetShift sets the "shift" edge to the Shift entity.
+func (_u *ShiftCycleDayUpdate) SetShift(v *Shift) *ShiftCycleDayUpdate {
+	return _u.SetShiftID(v.ID)
+}
+
+// Mutation returns the ShiftCycleDayMutation object of the builder.
+func (_u *ShiftCycleDayUpdate) Mutation() *ShiftCycleDayMutation {
+	return _u.mutation
+}
+
+// ClearShift clears the "shift" edge to the Shift entity.
+func (_u *ShiftCycleDayUpdate) ClearShift() *ShiftCycleDayUpdate {
+	_u.mutation.ClearShift()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ShiftCycleDayUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ShiftCycleDayUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ShiftCycleDayUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ShiftCycleDayUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ShiftCycleDayUpdate) check() error {
+	if v, ok := _u.mutation.DayIndex(); ok {
+		if err := shiftcycleday.DayIndexValidator(v); err != nil {
+			return &ValidationError{Name: "day_index", err: fmt.Errorf(`ent: validator failed for field "ShiftCycleDay.day_index": %w`, err)}
+		}
+	}
+	if _u.mutation.ShiftCleared() && len(_u.mutation.ShiftIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ShiftCycleDay.shift"`)
+	}
+	return nil
+}
+
+func (_u *ShiftCycleDayUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shiftcycleday.Table, shiftcycleday.Columns, sqlgraph.NewFieldSpec(shiftcycleday.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DayIndex(); ok {
+		_spec.SetField(shiftcycleday.FieldDayIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDayIndex(); ok {
+		_spec.AddField(shiftcycleday.FieldDayIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsWorkingDay(); ok {
+		_spec.SetField(shiftcycleday.FieldIsWorkingDay, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(shiftcycleday.FieldMode, field.TypeString, value)
+	}
+	if _u.mutation.ShiftCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftcycleday.ShiftTable,
+			Columns: []string{shiftcycleday.ShiftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShiftIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftcycleday.ShiftTable,
+			Columns: []string{shiftcycleday.ShiftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shiftcycleday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ShiftCycleDayUpdateOne is the builder for updating a single ShiftCycleDay entity.
+type ShiftCycleDayUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShiftCycleDayMutation
+}
+
+// SetShiftID sets the "shift_id" field.
+func (_u *ShiftCycleDayUpdateOne) SetShiftID(v int) *ShiftCycleDayUpdateOne {
+	_u.mutation.SetShiftID(v)
+	return _u
+}
+
+// SetNillableShiftID sets the "shift_id" field if the given value is not nil.
+func (_u *ShiftCycleDayUpdateOne) SetNillableShiftID(v *int) *ShiftCycleDayUpdateOne {
+	if v != nil {
+		_u.SetShiftID(*v)
+	}
+	return _u
+}
+
+// SetDayIndex sets the "day_index" field.
+func (_u *ShiftCycleDayUpdateOne) SetDayIndex(v int) *ShiftCycleDayUpdateOne {
+	_u.mutation.ResetDayIndex()
+	_u.mutation.SetDayIndex(v)
+	return _u
+}
+
+// SetNillableDayIndex sets the "day_index" field if the given value is not nil.
+func (_u *ShiftCycleDayUpdateOne) SetNillableDayIndex(v *int) *ShiftCycleDayUpdateOne {
+	if v != nil {
+		_u.SetDayIndex(*v)
+	}
+	return _u
+}
+
+// AddDayIndex adds value to the "day_index" field.
+func (_u *ShiftCycleDayUpdateOne) AddDayIndex(v int) *ShiftCycleDayUpdateOne {
+	_u.mutation.AddDayIndex(v)
+	return _u
+}
+
+// SetIsWorkingDay sets the "is_working_day" field.
+func (_u *ShiftCycleDayUpdateOne) SetIsWorkingDay(v bool) *ShiftCycleDayUpdateOne {
+	_u.mutation.SetIsWorkingDay(v)
+	return _u
+}
+
+// SetNillableIsWorkingDay sets the "is_working_day" field if the given value is not nil.
+func (_u *ShiftCycleDayUpdateOne) SetNillableIsWorkingDay(v *bool) *ShiftCycleDayUpdateOne {
+	if v != nil {
+		_u.SetIsWorkingDay(*v)
+	}
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *ShiftCycleDayUpdateOne) SetMode(v string) *ShiftCycleDayUpdateOne {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *ShiftCycleDayUpdateOne) SetNillableMode(v *string) *ShiftCycleDayUpdateOne {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetShift sets the "shift" edge to the Shift entity.
+func (_u *ShiftCycleDayUpdateOne) SetShift(v *Shift) *ShiftCycleDayUpdateOne {
+	return _u.SetShiftID(v.ID)
+}
+
+// Mutation returns the ShiftCycleDayMutation object of the builder.
+func (_u *ShiftCycleDayUpdateOne) Mutation() *ShiftCycleDayMutation {
+	return _u.mutation
+}
+
+// ClearShift clears the "shift" edge to the Shift entity.
+func (_u *ShiftCycleDayUpdateOne) ClearShift() *ShiftCycleDayUpdateOne {
+	_u.mutation.ClearShift()
+	return _u
+}
+
+// Where appends a list predicates to the ShiftCycleDayUpdate builder.
+func (_u *ShiftCycleDayUpdateOne) Where(ps ...predicate.ShiftCycleDay) *ShiftCycleDayUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ShiftCycleDayUpdateOne) Select(field string, fields ...string) *ShiftCycleDayUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ShiftCycleDay entity.
+func (_u *ShiftCycleDayUpdateOne) Save(ctx context.Context) (*ShiftCycleDay, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ShiftCycleDayUpdateOne) SaveX(ctx context.Context) *ShiftCycleDay {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ShiftCycleDayUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ShiftCycleDayUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ShiftCycleDayUpdateOne) check() error {
+	if v, ok := _u.mutation.DayIndex(); ok {
+		if err := shiftcycleday.DayIndexValidator(v); err != nil {
+			return &ValidationError{Name: "day_index", err: fmt.Errorf(`ent: validator failed for field "ShiftCycleDay.day_index": %w`, err)}
+		}
+	}
+	if _u.mutation.ShiftCleared() && len(_u.mutation.ShiftIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ShiftCycleDay.shift"`)
+	}
+	return nil
+}
+
+func (_u *ShiftCycleDayUpdateOne) sqlSave(ctx context.Context) (_node *ShiftCycleDay, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shiftcycleday.Table, shiftcycleday.Columns, sqlgraph.NewFieldSpec(shiftcycleday.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ShiftCycleDay.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shiftcycleday.FieldID)
+		for _, f := range fields {
+			if !shiftcycleday.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != shiftcycleday.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DayIndex(); ok {
+		_spec.SetField(shiftcycleday.FieldDayIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDayIndex(); ok {
+		_spec.AddField(shiftcycleday.FieldDayIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsWorkingDay(); ok {
+		_spec.SetField(shiftcycleday.FieldIsWorkingDay, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(shiftcycleday.FieldMode, field.TypeString, value)
+	}
+	if _u.mutation.ShiftCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftcycleday.ShiftTable,
+			Columns: []string{shiftcycleday.ShiftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShiftIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftcycleday.ShiftTable,
+			Columns: []string{shiftcycleday.ShiftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ShiftCycleDay{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shiftcycleday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
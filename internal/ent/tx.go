@@ -18,6 +18,10 @@ type Tx struct {
 	Address *AddressClient
 	// AttendanceDay is the client for interacting with the AttendanceDay builders.
 	AttendanceDay *AttendanceDayClient
+	// AttendanceEdit is the client for interacting with the AttendanceEdit builders.
+	AttendanceEdit *AttendanceEditClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
 	// Branch is the client for interacting with the Branch builders.
 	Branch *BranchClient
 	// BranchAddress is the client for interacting with the BranchAddress builders.
@@ -28,12 +32,30 @@ type Tx struct {
 	Commune *CommuneClient
 	// Device is the client for interacting with the Device builders.
 	Device *DeviceClient
+	// Holiday is the client for interacting with the Holiday builders.
+	Holiday *HolidayClient
+	// HourBankMovement is the client for interacting with the HourBankMovement builders.
+	HourBankMovement *HourBankMovementClient
+	// LeaveRequest is the client for interacting with the LeaveRequest builders.
+	LeaveRequest *LeaveRequestClient
+	// MarkCorrectionRequest is the client for interacting with the MarkCorrectionRequest builders.
+	MarkCorrectionRequest *MarkCorrectionRequestClient
+	// OvertimeAuthorization is the client for interacting with the OvertimeAuthorization builders.
+	OvertimeAuthorization *OvertimeAuthorizationClient
+	// PayrollExportProfile is the client for interacting with the PayrollExportProfile builders.
+	PayrollExportProfile *PayrollExportProfileClient
+	// Punch is the client for interacting with the Punch builders.
+	Punch *PunchClient
 	// RefreshToken is the client for interacting with the RefreshToken builders.
 	RefreshToken *RefreshTokenClient
 	// Region is the client for interacting with the Region builders.
 	Region *RegionClient
+	// Role is the client for interacting with the Role builders.
+	Role *RoleClient
 	// Shift is the client for interacting with the Shift builders.
 	Shift *ShiftClient
+	// ShiftCycleDay is the client for interacting with the ShiftCycleDay builders.
+	ShiftCycleDay *ShiftCycleDayClient
 	// ShiftDay is the client for interacting with the ShiftDay builders.
 	ShiftDay *ShiftDayClient
 	// ShiftInstance is the client for interacting with the ShiftInstance builders.
@@ -50,6 +72,8 @@ type Tx struct {
 	UserQRSession *UserQRSessionClient
 	// UserShiftAssignment is the client for interacting with the UserShiftAssignment builders.
 	UserShiftAssignment *UserShiftAssignmentClient
+	// VacationAdjustment is the client for interacting with the VacationAdjustment builders.
+	VacationAdjustment *VacationAdjustmentClient
 
 	// lazily loaded.
 	client     *Client
@@ -184,14 +208,25 @@ func (tx *Tx) init() {
 	tx.AccessPoint = NewAccessPointClient(tx.config)
 	tx.Address = NewAddressClient(tx.config)
 	tx.AttendanceDay = NewAttendanceDayClient(tx.config)
+	tx.AttendanceEdit = NewAttendanceEditClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
 	tx.Branch = NewBranchClient(tx.config)
 	tx.BranchAddress = NewBranchAddressClient(tx.config)
 	tx.City = NewCityClient(tx.config)
 	tx.Commune = NewCommuneClient(tx.config)
 	tx.Device = NewDeviceClient(tx.config)
+	tx.Holiday = NewHolidayClient(tx.config)
+	tx.HourBankMovement = NewHourBankMovementClient(tx.config)
+	tx.LeaveRequest = NewLeaveRequestClient(tx.config)
+	tx.MarkCorrectionRequest = NewMarkCorrectionRequestClient(tx.config)
+	tx.OvertimeAuthorization = NewOvertimeAuthorizationClient(tx.config)
+	tx.PayrollExportProfile = NewPayrollExportProfileClient(tx.config)
+	tx.Punch = NewPunchClient(tx.config)
 	tx.RefreshToken = NewRefreshTokenClient(tx.config)
 	tx.Region = NewRegionClient(tx.config)
+	tx.Role = NewRoleClient(tx.config)
 	tx.Shift = NewShiftClient(tx.config)
+	tx.ShiftCycleDay = NewShiftCycleDayClient(tx.config)
 	tx.ShiftDay = NewShiftDayClient(tx.config)
 	tx.ShiftInstance = NewShiftInstanceClient(tx.config)
 	tx.User = NewUserClient(tx.config)
@@ -200,6 +235,7 @@ func (tx *Tx) init() {
 	tx.UserDayOverride = NewUserDayOverrideClient(tx.config)
 	tx.UserQRSession = NewUserQRSessionClient(tx.config)
 	tx.UserShiftAssignment = NewUserShiftAssignmentClient(tx.config)
+	tx.VacationAdjustment = NewVacationAdjustmentClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -37,6 +37,10 @@ type User struct {
 	EmployeeCode *string `json:"employee_code,omitempty"`
 	// AccessCode holds the value of the "access_code" field.
 	AccessCode *string `json:"access_code,omitempty"`
+	// HireDate holds the value of the "hire_date" field.
+	HireDate *time.Time `json:"hire_date,omitempty"`
+	// PriorServiceYears holds the value of the "prior_service_years" field.
+	PriorServiceYears *int `json:"prior_service_years,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -65,9 +69,21 @@ type UserEdges struct {
 	DayOverrides []*UserDayOverride `json:"day_overrides,omitempty"`
 	// QrSessions holds the value of the qr_sessions edge.
 	QrSessions []*UserQRSession `json:"qr_sessions,omitempty"`
+	// Punches holds the value of the punches edge.
+	Punches []*Punch `json:"punches,omitempty"`
+	// LeaveRequests holds the value of the leave_requests edge.
+	LeaveRequests []*LeaveRequest `json:"leave_requests,omitempty"`
+	// VacationAdjustments holds the value of the vacation_adjustments edge.
+	VacationAdjustments []*VacationAdjustment `json:"vacation_adjustments,omitempty"`
+	// OvertimeAuthorizations holds the value of the overtime_authorizations edge.
+	OvertimeAuthorizations []*OvertimeAuthorization `json:"overtime_authorizations,omitempty"`
+	// HourBankMovements holds the value of the hour_bank_movements edge.
+	HourBankMovements []*HourBankMovement `json:"hour_bank_movements,omitempty"`
+	// MarkCorrectionRequests holds the value of the mark_correction_requests edge.
+	MarkCorrectionRequests []*MarkCorrectionRequest `json:"mark_correction_requests,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [8]bool
+	loadedTypes [14]bool
 }
 
 // RefreshTokensOrErr returns the RefreshTokens value or an error if the edge
@@ -142,6 +158,60 @@ func (e UserEdges) QrSessionsOrErr() ([]*UserQRSession, error) {
 	return nil, &NotLoadedError{edge: "qr_sessions"}
 }
 
+// PunchesOrErr returns the Punches value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PunchesOrErr() ([]*Punch, error) {
+	if e.loadedTypes[8] {
+		return e.Punches, nil
+	}
+	return nil, &NotLoadedError{edge: "punches"}
+}
+
+// LeaveRequestsOrErr returns the LeaveRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LeaveRequestsOrErr() ([]*LeaveRequest, error) {
+	if e.loadedTypes[9] {
+		return e.LeaveRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "leave_requests"}
+}
+
+// VacationAdjustmentsOrErr returns the VacationAdjustments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) VacationAdjustmentsOrErr() ([]*VacationAdjustment, error) {
+	if e.loadedTypes[10] {
+		return e.VacationAdjustments, nil
+	}
+	return nil, &NotLoadedError{edge: "vacation_adjustments"}
+}
+
+// OvertimeAuthorizationsOrErr returns the OvertimeAuthorizations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) OvertimeAuthorizationsOrErr() ([]*OvertimeAuthorization, error) {
+	if e.loadedTypes[11] {
+		return e.OvertimeAuthorizations, nil
+	}
+	return nil, &NotLoadedError{edge: "overtime_authorizations"}
+}
+
+// HourBankMovementsOrErr returns the HourBankMovements value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) HourBankMovementsOrErr() ([]*HourBankMovement, error) {
+	if e.loadedTypes[12] {
+		return e.HourBankMovements, nil
+	}
+	return nil, &NotLoadedError{edge: "hour_bank_movements"}
+}
+
+// MarkCorrectionRequestsOrErr returns the MarkCorrectionRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MarkCorrectionRequestsOrErr() ([]*MarkCorrectionRequest, error) {
+	if e.loadedTypes[13] {
+		return e.MarkCorrectionRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "mark_correction_requests"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -149,11 +219,11 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case user.FieldID:
+		case user.FieldID, user.FieldPriorServiceYears:
 			values[i] = new(sql.NullInt64)
 		case user.FieldUsername, user.FieldPasswordHash, user.FieldRole, user.FieldFirstName, user.FieldLastName, user.FieldMiddleName, user.FieldEmail, user.FieldEmployeeCode, user.FieldAccessCode:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldHireDate, user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -242,6 +312,20 @@ func (_m *User) assignValues(columns []string, values []any) error {
 				_m.AccessCode = new(string)
 				*_m.AccessCode = value.String
 			}
+		case user.FieldHireDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field hire_date", values[i])
+			} else if value.Valid {
+				_m.HireDate = new(time.Time)
+				*_m.HireDate = value.Time
+			}
+		case user.FieldPriorServiceYears:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field prior_service_years", values[i])
+			} else if value.Valid {
+				_m.PriorServiceYears = new(int)
+				*_m.PriorServiceYears = int(value.Int64)
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -307,6 +391,36 @@ func (_m *User) QueryQrSessions() *UserQRSessionQuery {
 	return NewUserClient(_m.config).QueryQrSessions(_m)
 }
 
+// QueryPunches queries the "punches" edge of the User entity.
+func (_m *User) QueryPunches() *PunchQuery {
+	return NewUserClient(_m.config).QueryPunches(_m)
+}
+
+// QueryLeaveRequests queries the "leave_requests" edge of the User entity.
+func (_m *User) QueryLeaveRequests() *LeaveRequestQuery {
+	return NewUserClient(_m.config).QueryLeaveRequests(_m)
+}
+
+// QueryVacationAdjustments queries the "vacation_adjustments" edge of the User entity.
+func (_m *User) QueryVacationAdjustments() *VacationAdjustmentQuery {
+	return NewUserClient(_m.config).QueryVacationAdjustments(_m)
+}
+
+// QueryOvertimeAuthorizations queries the "overtime_authorizations" edge of the User entity.
+func (_m *User) QueryOvertimeAuthorizations() *OvertimeAuthorizationQuery {
+	return NewUserClient(_m.config).QueryOvertimeAuthorizations(_m)
+}
+
+// QueryHourBankMovements queries the "hour_bank_movements" edge of the User entity.
+func (_m *User) QueryHourBankMovements() *HourBankMovementQuery {
+	return NewUserClient(_m.config).QueryHourBankMovements(_m)
+}
+
+// QueryMarkCorrectionRequests queries the "mark_correction_requests" edge of the User entity.
+func (_m *User) QueryMarkCorrectionRequests() *MarkCorrectionRequestQuery {
+	return NewUserClient(_m.config).QueryMarkCorrectionRequests(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -371,6 +485,16 @@ func (_m *User) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.HireDate; v != nil {
+		builder.WriteString("hire_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.PriorServiceYears; v != nil {
+		builder.WriteString("prior_service_years=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -34,6 +34,10 @@ const (
 	FieldEmployeeCode = "employee_code"
 	// FieldAccessCode holds the string denoting the access_code field in the database.
 	FieldAccessCode = "access_code"
+	// FieldHireDate holds the string denoting the hire_date field in the database.
+	FieldHireDate = "hire_date"
+	// FieldPriorServiceYears holds the string denoting the prior_service_years field in the database.
+	FieldPriorServiceYears = "prior_service_years"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -54,6 +58,18 @@ const (
 	EdgeDayOverrides = "day_overrides"
 	// EdgeQrSessions holds the string denoting the qr_sessions edge name in mutations.
 	EdgeQrSessions = "qr_sessions"
+	// EdgePunches holds the string denoting the punches edge name in mutations.
+	EdgePunches = "punches"
+	// EdgeLeaveRequests holds the string denoting the leave_requests edge name in mutations.
+	EdgeLeaveRequests = "leave_requests"
+	// EdgeVacationAdjustments holds the string denoting the vacation_adjustments edge name in mutations.
+	EdgeVacationAdjustments = "vacation_adjustments"
+	// EdgeOvertimeAuthorizations holds the string denoting the overtime_authorizations edge name in mutations.
+	EdgeOvertimeAuthorizations = "overtime_authorizations"
+	// EdgeHourBankMovements holds the string denoting the hour_bank_movements edge name in mutations.
+	EdgeHourBankMovements = "hour_bank_movements"
+	// EdgeMarkCorrectionRequests holds the string denoting the mark_correction_requests edge name in mutations.
+	EdgeMarkCorrectionRequests = "mark_correction_requests"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// RefreshTokensTable is the table that holds the refresh_tokens relation/edge.
@@ -112,6 +128,48 @@ const (
 	QrSessionsInverseTable = "user_qr_sessions"
 	// QrSessionsColumn is the table column denoting the qr_sessions relation/edge.
 	QrSessionsColumn = "user_id"
+	// PunchesTable is the table that holds the punches relation/edge.
+	PunchesTable = "punches"
+	// PunchesInverseTable is the table name for the Punch entity.
+	// It exists in this package in order to avoid circular dependency with the "punch" package.
+	PunchesInverseTable = "punches"
+	// PunchesColumn is the table column denoting the punches relation/edge.
+	PunchesColumn = "user_id"
+	// LeaveRequestsTable is the table that holds the leave_requests relation/edge.
+	LeaveRequestsTable = "leave_requests"
+	// LeaveRequestsInverseTable is the table name for the LeaveRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "leaverequest" package.
+	LeaveRequestsInverseTable = "leave_requests"
+	// LeaveRequestsColumn is the table column denoting the leave_requests relation/edge.
+	LeaveRequestsColumn = "user_id"
+	// VacationAdjustmentsTable is the table that holds the vacation_adjustments relation/edge.
+	VacationAdjustmentsTable = "vacation_adjustments"
+	// VacationAdjustmentsInverseTable is the table name for the VacationAdjustment entity.
+	// It exists in this package in order to avoid circular dependency with the "vacationadjustment" package.
+	VacationAdjustmentsInverseTable = "vacation_adjustments"
+	// VacationAdjustmentsColumn is the table column denoting the vacation_adjustments relation/edge.
+	VacationAdjustmentsColumn = "user_id"
+	// OvertimeAuthorizationsTable is the table that holds the overtime_authorizations relation/edge.
+	OvertimeAuthorizationsTable = "overtime_authorizations"
+	// OvertimeAuthorizationsInverseTable is the table name for the OvertimeAuthorization entity.
+	// It exists in this package in order to avoid circular dependency with the "overtimeauthorization" package.
+	OvertimeAuthorizationsInverseTable = "overtime_authorizations"
+	// OvertimeAuthorizationsColumn is the table column denoting the overtime_authorizations relation/edge.
+	OvertimeAuthorizationsColumn = "user_id"
+	// HourBankMovementsTable is the table that holds the hour_bank_movements relation/edge.
+	HourBankMovementsTable = "hour_bank_movements"
+	// HourBankMovementsInverseTable is the table name for the HourBankMovement entity.
+	// It exists in this package in order to avoid circular dependency with the "hourbankmovement" package.
+	HourBankMovementsInverseTable = "hour_bank_movements"
+	// HourBankMovementsColumn is the table column denoting the hour_bank_movements relation/edge.
+	HourBankMovementsColumn = "user_id"
+	// MarkCorrectionRequestsTable is the table that holds the mark_correction_requests relation/edge.
+	MarkCorrectionRequestsTable = "mark_correction_requests"
+	// MarkCorrectionRequestsInverseTable is the table name for the MarkCorrectionRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "markcorrectionrequest" package.
+	MarkCorrectionRequestsInverseTable = "mark_correction_requests"
+	// MarkCorrectionRequestsColumn is the table column denoting the mark_correction_requests relation/edge.
+	MarkCorrectionRequestsColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -127,6 +185,8 @@ var Columns = []string{
 	FieldEmail,
 	FieldEmployeeCode,
 	FieldAccessCode,
+	FieldHireDate,
+	FieldPriorServiceYears,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -152,6 +212,8 @@ var (
 	RoleValidator func(string) error
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
+	// PriorServiceYearsValidator is a validator for the "prior_service_years" field. It is called by the builders before save.
+	PriorServiceYearsValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -218,6 +280,16 @@ func ByAccessCode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAccessCode, opts...).ToFunc()
 }
 
+// ByHireDate orders the results by the hire_date field.
+func ByHireDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHireDate, opts...).ToFunc()
+}
+
+// ByPriorServiceYears orders the results by the prior_service_years field.
+func ByPriorServiceYears(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriorServiceYears, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -339,6 +411,90 @@ func ByQrSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newQrSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPunchesCount orders the results by punches count.
+func ByPunchesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPunchesStep(), opts...)
+	}
+}
+
+// ByPunches orders the results by punches terms.
+func ByPunches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPunchesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLeaveRequestsCount orders the results by leave_requests count.
+func ByLeaveRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLeaveRequestsStep(), opts...)
+	}
+}
+
+// ByLeaveRequests orders the results by leave_requests terms.
+func ByLeaveRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLeaveRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByVacationAdjustmentsCount orders the results by vacation_adjustments count.
+func ByVacationAdjustmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVacationAdjustmentsStep(), opts...)
+	}
+}
+
+// ByVacationAdjustments orders the results by vacation_adjustments terms.
+func ByVacationAdjustments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVacationAdjustmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOvertimeAuthorizationsCount orders the results by overtime_authorizations count.
+func ByOvertimeAuthorizationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOvertimeAuthorizationsStep(), opts...)
+	}
+}
+
+// ByOvertimeAuthorizations orders the results by overtime_authorizations terms.
+func ByOvertimeAuthorizations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOvertimeAuthorizationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByHourBankMovementsCount orders the results by hour_bank_movements count.
+func ByHourBankMovementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHourBankMovementsStep(), opts...)
+	}
+}
+
+// ByHourBankMovements orders the results by hour_bank_movements terms.
+func ByHourBankMovements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHourBankMovementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMarkCorrectionRequestsCount orders the results by mark_correction_requests count.
+func ByMarkCorrectionRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMarkCorrectionRequestsStep(), opts...)
+	}
+}
+
+// ByMarkCorrectionRequests orders the results by mark_correction_requests terms.
+func ByMarkCorrectionRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMarkCorrectionRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newRefreshTokensStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -395,3 +551,45 @@ func newQrSessionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, QrSessionsTable, QrSessionsColumn),
 	)
 }
+func newPunchesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PunchesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PunchesTable, PunchesColumn),
+	)
+}
+func newLeaveRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LeaveRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LeaveRequestsTable, LeaveRequestsColumn),
+	)
+}
+func newVacationAdjustmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VacationAdjustmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VacationAdjustmentsTable, VacationAdjustmentsColumn),
+	)
+}
+func newOvertimeAuthorizationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OvertimeAuthorizationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OvertimeAuthorizationsTable, OvertimeAuthorizationsColumn),
+	)
+}
+func newHourBankMovementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HourBankMovementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HourBankMovementsTable, HourBankMovementsColumn),
+	)
+}
+func newMarkCorrectionRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MarkCorrectionRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MarkCorrectionRequestsTable, MarkCorrectionRequestsColumn),
+	)
+}
@@ -105,6 +105,16 @@ func AccessCode(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldAccessCode, v))
 }
 
+// HireDate applies equality check predicate on the "hire_date" field. It's identical to HireDateEQ.
+func HireDate(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldHireDate, v))
+}
+
+// PriorServiceYears applies equality check predicate on the "prior_service_years" field. It's identical to PriorServiceYearsEQ.
+func PriorServiceYears(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPriorServiceYears, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -770,6 +780,106 @@ func AccessCodeContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldAccessCode, v))
 }
 
+// HireDateEQ applies the EQ predicate on the "hire_date" field.
+func HireDateEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldHireDate, v))
+}
+
+// HireDateNEQ applies the NEQ predicate on the "hire_date" field.
+func HireDateNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldHireDate, v))
+}
+
+// HireDateIn applies the In predicate on the "hire_date" field.
+func HireDateIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldHireDate, vs...))
+}
+
+// HireDateNotIn applies the NotIn predicate on the "hire_date" field.
+func HireDateNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldHireDate, vs...))
+}
+
+// HireDateGT applies the GT predicate on the "hire_date" field.
+func HireDateGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldHireDate, v))
+}
+
+// HireDateGTE applies the GTE predicate on the "hire_date" field.
+func HireDateGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldHireDate, v))
+}
+
+// HireDateLT applies the LT predicate on the "hire_date" field.
+func HireDateLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldHireDate, v))
+}
+
+// HireDateLTE applies the LTE predicate on the "hire_date" field.
+func HireDateLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldHireDate, v))
+}
+
+// HireDateIsNil applies the IsNil predicate on the "hire_date" field.
+func HireDateIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldHireDate))
+}
+
+// HireDateNotNil applies the NotNil predicate on the "hire_date" field.
+func HireDateNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldHireDate))
+}
+
+// PriorServiceYearsEQ applies the EQ predicate on the "prior_service_years" field.
+func PriorServiceYearsEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPriorServiceYears, v))
+}
+
+// PriorServiceYearsNEQ applies the NEQ predicate on the "prior_service_years" field.
+func PriorServiceYearsNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPriorServiceYears, v))
+}
+
+// PriorServiceYearsIn applies the In predicate on the "prior_service_years" field.
+func PriorServiceYearsIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPriorServiceYears, vs...))
+}
+
+// PriorServiceYearsNotIn applies the NotIn predicate on the "prior_service_years" field.
+func PriorServiceYearsNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPriorServiceYears, vs...))
+}
+
+// PriorServiceYearsGT applies the GT predicate on the "prior_service_years" field.
+func PriorServiceYearsGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPriorServiceYears, v))
+}
+
+// PriorServiceYearsGTE applies the GTE predicate on the "prior_service_years" field.
+func PriorServiceYearsGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPriorServiceYears, v))
+}
+
+// PriorServiceYearsLT applies the LT predicate on the "prior_service_years" field.
+func PriorServiceYearsLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPriorServiceYears, v))
+}
+
+// PriorServiceYearsLTE applies the LTE predicate on the "prior_service_years" field.
+func PriorServiceYearsLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPriorServiceYears, v))
+}
+
+// PriorServiceYearsIsNil applies the IsNil predicate on the "prior_service_years" field.
+func PriorServiceYearsIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPriorServiceYears))
+}
+
+// PriorServiceYearsNotNil applies the NotNil predicate on the "prior_service_years" field.
+func PriorServiceYearsNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPriorServiceYears))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -1034,6 +1144,144 @@ func HasQrSessionsWith(preds ...predicate.UserQRSession) predicate.User {
 	})
 }
 
+// HasPunches applies the HasEdge predicate on the "punches" edge.
+func HasPunches() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PunchesTable, PunchesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPunchesWith applies the HasEdge predicate on the "punches" edge with a given conditions (other predicates).
+func HasPunchesWith(preds ...predicate.Punch) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newPunchesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLeaveRequests applies the HasEdge predicate on the "leave_requests" edge.
+func HasLeaveRequests() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LeaveRequestsTable, LeaveRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLeaveRequestsWith applies the HasEdge predicate on the "leave_requests" edge with a given conditions (other predicates).
+func HasLeaveRequestsWith(preds ...predicate.LeaveRequest) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newLeaveRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVacationAdjustments applies the HasEdge predicate on the "vacation_adjustments" edge.
+func HasVacationAdjustments() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VacationAdjustmentsTable, VacationAdjustmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVacationAdjustmentsWith applies the HasEdge predicate on the "vacation_adjustments" edge with a given conditions (other predicates).
+func HasVacationAdjustmentsWith(preds ...predicate.VacationAdjustment) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newVacationAdjustmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOvertimeAuthorizations applies the HasEdge predicate on the "overtime_authorizations" edge.
+func HasOvertimeAuthorizations() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OvertimeAuthorizationsTable, OvertimeAuthorizationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOvertimeAuthorizationsWith applies the HasEdge predicate on the "overtime_authorizations" edge with a given conditions (other predicates).
+func HasOvertimeAuthorizationsWith(preds ...predicate.OvertimeAuthorization) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newOvertimeAuthorizationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHourBankMovements applies the HasEdge predicate on the "hour_bank_movements" edge.
+func HasHourBankMovements() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, HourBankMovementsTable, HourBankMovementsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHourBankMovementsWith applies the HasEdge predicate on the "hour_bank_movements" edge with a given conditions (other predicates).
+func HasHourBankMovementsWith(preds ...predicate.HourBankMovement) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newHourBankMovementsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMarkCorrectionRequests applies the HasEdge predicate on the "mark_correction_requests" edge.
+func HasMarkCorrectionRequests() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MarkCorrectionRequestsTable, MarkCorrectionRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMarkCorrectionRequestsWith applies the HasEdge predicate on the "mark_correction_requests" edge with a given conditions (other predicates).
+func HasMarkCorrectionRequestsWith(preds ...predicate.MarkCorrectionRequest) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMarkCorrectionRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
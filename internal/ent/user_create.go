@@ -5,6 +5,11 @@ package ent
 import (
 	"back/internal/ent/address"
 	"back/internal/ent/attendanceday"
+	"back/internal/ent/hourbankmovement"
+	"back/internal/ent/leaverequest"
+	"back/internal/ent/markcorrectionrequest"
+	"back/internal/ent/overtimeauthorization"
+	"back/internal/ent/punch"
 	"back/internal/ent/refreshtoken"
 	"back/internal/ent/user"
 	"back/internal/ent/useraccesspoint"
@@ -12,6 +17,7 @@ import (
 	"back/internal/ent/userdayoverride"
 	"back/internal/ent/userqrsession"
 	"back/internal/ent/usershiftassignment"
+	"back/internal/ent/vacationadjustment"
 	"context"
 	"errors"
 	"fmt"
@@ -152,6 +158,34 @@ func (_c *UserCreate) SetNillableAccessCode(v *string) *UserCreate {
 	return _c
 }
 
+// SetHireDate sets the "hire_date" field.
+func (_c *UserCreate) SetHireDate(v time.Time) *UserCreate {
+	_c.mutation.SetHireDate(v)
+	return _c
+}
+
+// SetNillableHireDate sets the "hire_date" field if the given value is not nil.
+func (_c *UserCreate) SetNillableHireDate(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetHireDate(*v)
+	}
+	return _c
+}
+
+// SetPriorServiceYears sets the "prior_service_years" field.
+func (_c *UserCreate) SetPriorServiceYears(v int) *UserCreate {
+	_c.mutation.SetPriorServiceYears(v)
+	return _c
+}
+
+// SetNillablePriorServiceYears sets the "prior_service_years" field if the given value is not nil.
+func (_c *UserCreate) SetNillablePriorServiceYears(v *int) *UserCreate {
+	if v != nil {
+		_c.SetPriorServiceYears(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *UserCreate) SetCreatedAt(v time.Time) *UserCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -300,6 +334,96 @@ func (_c *UserCreate) AddQrSessions(v ...*UserQRSession) *UserCreate {
 	return _c.AddQrSessionIDs(ids...)
 }
 
+// AddPunchIDs adds the "punches" edge to the Punch entity by IDs.
+func (_c *UserCreate) AddPunchIDs(ids ...int) *UserCreate {
+	_c.mutation.AddPunchIDs(ids...)
+	return _c
+}
+
+// AddPunches adds the "punches" edges to the Punch entity.
+func (_c *UserCreate) AddPunches(v ...*Punch) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPunchIDs(ids...)
+}
+
+// AddLeaveRequestIDs adds the "leave_requests" edge to the LeaveRequest entity by IDs.
+func (_c *UserCreate) AddLeaveRequestIDs(ids ...int) *UserCreate {
+	_c.mutation.AddLeaveRequestIDs(ids...)
+	return _c
+}
+
+// AddLeaveRequests adds the "leave_requests" edges to the LeaveRequest entity.
+func (_c *UserCreate) AddLeaveRequests(v ...*LeaveRequest) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLeaveRequestIDs(ids...)
+}
+
+// AddVacationAdjustmentIDs adds the "vacation_adjustments" edge to the VacationAdjustment entity by IDs.
+func (_c *UserCreate) AddVacationAdjustmentIDs(ids ...int) *UserCreate {
+	_c.mutation.AddVacationAdjustmentIDs(ids...)
+	return _c
+}
+
+// AddVacationAdjustments adds the "vacation_adjustments" edges to the VacationAdjustment entity.
+func (_c *UserCreate) AddVacationAdjustments(v ...*VacationAdjustment) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVacationAdjustmentIDs(ids...)
+}
+
+// AddOvertimeAuthorizationIDs adds the "overtime_authorizations" edge to the OvertimeAuthorization entity by IDs.
+func (_c *UserCreate) AddOvertimeAuthorizationIDs(ids ...int) *UserCreate {
+	_c.mutation.AddOvertimeAuthorizationIDs(ids...)
+	return _c
+}
+
+// AddOvertimeAuthorizations adds the "overtime_authorizations" edges to the OvertimeAuthorization entity.
+func (_c *UserCreate) AddOvertimeAuthorizations(v ...*OvertimeAuthorization) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOvertimeAuthorizationIDs(ids...)
+}
+
+// AddHourBankMovementIDs adds the "hour_bank_movements" edge to the HourBankMovement entity by IDs.
+func (_c *UserCreate) AddHourBankMovementIDs(ids ...int) *UserCreate {
+	_c.mutation.AddHourBankMovementIDs(ids...)
+	return _c
+}
+
+// AddHourBankMovements adds the "hour_bank_movements" edges to the HourBankMovement entity.
+func (_c *UserCreate) AddHourBankMovements(v ...*HourBankMovement) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddHourBankMovementIDs(ids...)
+}
+
+// AddMarkCorrectionRequestIDs adds the "mark_correction_requests" edge to the MarkCorrectionRequest entity by IDs.
+func (_c *UserCreate) AddMarkCorrectionRequestIDs(ids ...int) *UserCreate {
+	_c.mutation.AddMarkCorrectionRequestIDs(ids...)
+	return _c
+}
+
+// AddMarkCorrectionRequests adds the "mark_correction_requests" edges to the MarkCorrectionRequest entity.
+func (_c *UserCreate) AddMarkCorrectionRequests(v ...*MarkCorrectionRequest) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMarkCorrectionRequestIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -382,6 +506,11 @@ func (_c *UserCreate) check() error {
 	if _, ok := _c.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "User.is_active"`)}
 	}
+	if v, ok := _c.mutation.PriorServiceYears(); ok {
+		if err := user.PriorServiceYearsValidator(v); err != nil {
+			return &ValidationError{Name: "prior_service_years", err: fmt.Errorf(`ent: validator failed for field "User.prior_service_years": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
@@ -454,6 +583,14 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldAccessCode, field.TypeString, value)
 		_node.AccessCode = &value
 	}
+	if value, ok := _c.mutation.HireDate(); ok {
+		_spec.SetField(user.FieldHireDate, field.TypeTime, value)
+		_node.HireDate = &value
+	}
+	if value, ok := _c.mutation.PriorServiceYears(); ok {
+		_spec.SetField(user.FieldPriorServiceYears, field.TypeInt, value)
+		_node.PriorServiceYears = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -590,6 +727,102 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PunchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PunchesTable,
+			Columns: []string{user.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LeaveRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeaveRequestsTable,
+			Columns: []string{user.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VacationAdjustmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.VacationAdjustmentsTable,
+			Columns: []string{user.VacationAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vacationadjustment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OvertimeAuthorizationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OvertimeAuthorizationsTable,
+			Columns: []string{user.OvertimeAuthorizationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimeauthorization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.HourBankMovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HourBankMovementsTable,
+			Columns: []string{user.HourBankMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hourbankmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MarkCorrectionRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MarkCorrectionRequestsTable,
+			Columns: []string{user.MarkCorrectionRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(markcorrectionrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -5,7 +5,12 @@ package ent
 import (
 	"back/internal/ent/address"
 	"back/internal/ent/attendanceday"
+	"back/internal/ent/hourbankmovement"
+	"back/internal/ent/leaverequest"
+	"back/internal/ent/markcorrectionrequest"
+	"back/internal/ent/overtimeauthorization"
 	"back/internal/ent/predicate"
+	"back/internal/ent/punch"
 	"back/internal/ent/refreshtoken"
 	"back/internal/ent/user"
 	"back/internal/ent/useraccesspoint"
@@ -13,6 +18,7 @@ import (
 	"back/internal/ent/userdayoverride"
 	"back/internal/ent/userqrsession"
 	"back/internal/ent/usershiftassignment"
+	"back/internal/ent/vacationadjustment"
 	"context"
 	"database/sql/driver"
 	"fmt"
@@ -27,18 +33,24 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx                  *QueryContext
-	order                []user.OrderOption
-	inters               []Interceptor
-	predicates           []predicate.User
-	withRefreshTokens    *RefreshTokenQuery
-	withAddresses        *AddressQuery
-	withUserBranches     *UserBranchQuery
-	withUserAccessPoints *UserAccessPointQuery
-	withAttendanceDays   *AttendanceDayQuery
-	withShiftAssignments *UserShiftAssignmentQuery
-	withDayOverrides     *UserDayOverrideQuery
-	withQrSessions       *UserQRSessionQuery
+	ctx                        *QueryContext
+	order                      []user.OrderOption
+	inters                     []Interceptor
+	predicates                 []predicate.User
+	withRefreshTokens          *RefreshTokenQuery
+	withAddresses              *AddressQuery
+	withUserBranches           *UserBranchQuery
+	withUserAccessPoints       *UserAccessPointQuery
+	withAttendanceDays         *AttendanceDayQuery
+	withShiftAssignments       *UserShiftAssignmentQuery
+	withDayOverrides           *UserDayOverrideQuery
+	withQrSessions             *UserQRSessionQuery
+	withPunches                *PunchQuery
+	withLeaveRequests          *LeaveRequestQuery
+	withVacationAdjustments    *VacationAdjustmentQuery
+	withOvertimeAuthorizations *OvertimeAuthorizationQuery
+	withHourBankMovements      *HourBankMovementQuery
+	withMarkCorrectionRequests *MarkCorrectionRequestQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -251,6 +263,138 @@ func (_q *UserQuery) QueryQrSessions() *UserQRSessionQuery {
 	return query
 }
 
+// QueryPunches chains the current query on the "punches" edge.
+func (_q *UserQuery) QueryPunches() *PunchQuery {
+	query := (&PunchClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(punch.Table, punch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PunchesTable, user.PunchesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLeaveRequests chains the current query on the "leave_requests" edge.
+func (_q *UserQuery) QueryLeaveRequests() *LeaveRequestQuery {
+	query := (&LeaveRequestClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(leaverequest.Table, leaverequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LeaveRequestsTable, user.LeaveRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVacationAdjustments chains the current query on the "vacation_adjustments" edge.
+func (_q *UserQuery) QueryVacationAdjustments() *VacationAdjustmentQuery {
+	query := (&VacationAdjustmentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(vacationadjustment.Table, vacationadjustment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.VacationAdjustmentsTable, user.VacationAdjustmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOvertimeAuthorizations chains the current query on the "overtime_authorizations" edge.
+func (_q *UserQuery) QueryOvertimeAuthorizations() *OvertimeAuthorizationQuery {
+	query := (&OvertimeAuthorizationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(overtimeauthorization.Table, overtimeauthorization.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.OvertimeAuthorizationsTable, user.OvertimeAuthorizationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHourBankMovements chains the current query on the "hour_bank_movements" edge.
+func (_q *UserQuery) QueryHourBankMovements() *HourBankMovementQuery {
+	query := (&HourBankMovementClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(hourbankmovement.Table, hourbankmovement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.HourBankMovementsTable, user.HourBankMovementsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMarkCorrectionRequests chains the current query on the "mark_correction_requests" edge.
+func (_q *UserQuery) QueryMarkCorrectionRequests() *MarkCorrectionRequestQuery {
+	query := (&MarkCorrectionRequestClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(markcorrectionrequest.Table, markcorrectionrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MarkCorrectionRequestsTable, user.MarkCorrectionRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (_q *UserQuery) First(ctx context.Context) (*User, error) {
@@ -438,19 +582,25 @@ func (_q *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:               _q.config,
-		ctx:                  _q.ctx.Clone(),
-		order:                append([]user.OrderOption{}, _q.order...),
-		inters:               append([]Interceptor{}, _q.inters...),
-		predicates:           append([]predicate.User{}, _q.predicates...),
-		withRefreshTokens:    _q.withRefreshTokens.Clone(),
-		withAddresses:        _q.withAddresses.Clone(),
-		withUserBranches:     _q.withUserBranches.Clone(),
-		withUserAccessPoints: _q.withUserAccessPoints.Clone(),
-		withAttendanceDays:   _q.withAttendanceDays.Clone(),
-		withShiftAssignments: _q.withShiftAssignments.Clone(),
-		withDayOverrides:     _q.withDayOverrides.Clone(),
-		withQrSessions:       _q.withQrSessions.Clone(),
+		config:                     _q.config,
+		ctx:                        _q.ctx.Clone(),
+		order:                      append([]user.OrderOption{}, _q.order...),
+		inters:                     append([]Interceptor{}, _q.inters...),
+		predicates:                 append([]predicate.User{}, _q.predicates...),
+		withRefreshTokens:          _q.withRefreshTokens.Clone(),
+		withAddresses:              _q.withAddresses.Clone(),
+		withUserBranches:           _q.withUserBranches.Clone(),
+		withUserAccessPoints:       _q.withUserAccessPoints.Clone(),
+		withAttendanceDays:         _q.withAttendanceDays.Clone(),
+		withShiftAssignments:       _q.withShiftAssignments.Clone(),
+		withDayOverrides:           _q.withDayOverrides.Clone(),
+		withQrSessions:             _q.withQrSessions.Clone(),
+		withPunches:                _q.withPunches.Clone(),
+		withLeaveRequests:          _q.withLeaveRequests.Clone(),
+		withVacationAdjustments:    _q.withVacationAdjustments.Clone(),
+		withOvertimeAuthorizations: _q.withOvertimeAuthorizations.Clone(),
+		withHourBankMovements:      _q.withHourBankMovements.Clone(),
+		withMarkCorrectionRequests: _q.withMarkCorrectionRequests.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -545,6 +695,72 @@ func (_q *UserQuery) WithQrSessions(opts ...func(*UserQRSessionQuery)) *UserQuer
 	return _q
 }
 
+// WithPunches tells the query-builder to eager-load the nodes that are connected to
+// the "punches" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithPunches(opts ...func(*PunchQuery)) *UserQuery {
+	query := (&PunchClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPunches = query
+	return _q
+}
+
+// WithLeaveRequests tells the query-builder to eager-load the nodes that are connected to
+// the "leave_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithLeaveRequests(opts ...func(*LeaveRequestQuery)) *UserQuery {
+	query := (&LeaveRequestClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLeaveRequests = query
+	return _q
+}
+
+// WithVacationAdjustments tells the query-builder to eager-load the nodes that are connected to
+// the "vacation_adjustments" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithVacationAdjustments(opts ...func(*VacationAdjustmentQuery)) *UserQuery {
+	query := (&VacationAdjustmentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVacationAdjustments = query
+	return _q
+}
+
+// WithOvertimeAuthorizations tells the query-builder to eager-load the nodes that are connected to
+// the "overtime_authorizations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithOvertimeAuthorizations(opts ...func(*OvertimeAuthorizationQuery)) *UserQuery {
+	query := (&OvertimeAuthorizationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOvertimeAuthorizations = query
+	return _q
+}
+
+// WithHourBankMovements tells the query-builder to eager-load the nodes that are connected to
+// the "hour_bank_movements" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithHourBankMovements(opts ...func(*HourBankMovementQuery)) *UserQuery {
+	query := (&HourBankMovementClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withHourBankMovements = query
+	return _q
+}
+
+// WithMarkCorrectionRequests tells the query-builder to eager-load the nodes that are connected to
+// the "mark_correction_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithMarkCorrectionRequests(opts ...func(*MarkCorrectionRequestQuery)) *UserQuery {
+	query := (&MarkCorrectionRequestClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMarkCorrectionRequests = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -623,7 +839,7 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [8]bool{
+		loadedTypes = [14]bool{
 			_q.withRefreshTokens != nil,
 			_q.withAddresses != nil,
 			_q.withUserBranches != nil,
@@ -632,6 +848,12 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			_q.withShiftAssignments != nil,
 			_q.withDayOverrides != nil,
 			_q.withQrSessions != nil,
+			_q.withPunches != nil,
+			_q.withLeaveRequests != nil,
+			_q.withVacationAdjustments != nil,
+			_q.withOvertimeAuthorizations != nil,
+			_q.withHourBankMovements != nil,
+			_q.withMarkCorrectionRequests != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -708,6 +930,54 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withPunches; query != nil {
+		if err := _q.loadPunches(ctx, query, nodes,
+			func(n *User) { n.Edges.Punches = []*Punch{} },
+			func(n *User, e *Punch) { n.Edges.Punches = append(n.Edges.Punches, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLeaveRequests; query != nil {
+		if err := _q.loadLeaveRequests(ctx, query, nodes,
+			func(n *User) { n.Edges.LeaveRequests = []*LeaveRequest{} },
+			func(n *User, e *LeaveRequest) { n.Edges.LeaveRequests = append(n.Edges.LeaveRequests, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withVacationAdjustments; query != nil {
+		if err := _q.loadVacationAdjustments(ctx, query, nodes,
+			func(n *User) { n.Edges.VacationAdjustments = []*VacationAdjustment{} },
+			func(n *User, e *VacationAdjustment) {
+				n.Edges.VacationAdjustments = append(n.Edges.VacationAdjustments, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOvertimeAuthorizations; query != nil {
+		if err := _q.loadOvertimeAuthorizations(ctx, query, nodes,
+			func(n *User) { n.Edges.OvertimeAuthorizations = []*OvertimeAuthorization{} },
+			func(n *User, e *OvertimeAuthorization) {
+				n.Edges.OvertimeAuthorizations = append(n.Edges.OvertimeAuthorizations, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withHourBankMovements; query != nil {
+		if err := _q.loadHourBankMovements(ctx, query, nodes,
+			func(n *User) { n.Edges.HourBankMovements = []*HourBankMovement{} },
+			func(n *User, e *HourBankMovement) { n.Edges.HourBankMovements = append(n.Edges.HourBankMovements, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMarkCorrectionRequests; query != nil {
+		if err := _q.loadMarkCorrectionRequests(ctx, query, nodes,
+			func(n *User) { n.Edges.MarkCorrectionRequests = []*MarkCorrectionRequest{} },
+			func(n *User, e *MarkCorrectionRequest) {
+				n.Edges.MarkCorrectionRequests = append(n.Edges.MarkCorrectionRequests, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -954,6 +1224,186 @@ func (_q *UserQuery) loadQrSessions(ctx context.Context, query *UserQRSessionQue
 	}
 	return nil
 }
+func (_q *UserQuery) loadPunches(ctx context.Context, query *PunchQuery, nodes []*User, init func(*User), assign func(*User, *Punch)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(punch.FieldUserID)
+	}
+	query.Where(predicate.Punch(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.PunchesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadLeaveRequests(ctx context.Context, query *LeaveRequestQuery, nodes []*User, init func(*User), assign func(*User, *LeaveRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(leaverequest.FieldUserID)
+	}
+	query.Where(predicate.LeaveRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.LeaveRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadVacationAdjustments(ctx context.Context, query *VacationAdjustmentQuery, nodes []*User, init func(*User), assign func(*User, *VacationAdjustment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(vacationadjustment.FieldUserID)
+	}
+	query.Where(predicate.VacationAdjustment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.VacationAdjustmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadOvertimeAuthorizations(ctx context.Context, query *OvertimeAuthorizationQuery, nodes []*User, init func(*User), assign func(*User, *OvertimeAuthorization)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(overtimeauthorization.FieldUserID)
+	}
+	query.Where(predicate.OvertimeAuthorization(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.OvertimeAuthorizationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadHourBankMovements(ctx context.Context, query *HourBankMovementQuery, nodes []*User, init func(*User), assign func(*User, *HourBankMovement)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(hourbankmovement.FieldUserID)
+	}
+	query.Where(predicate.HourBankMovement(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.HourBankMovementsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadMarkCorrectionRequests(ctx context.Context, query *MarkCorrectionRequestQuery, nodes []*User, init func(*User), assign func(*User, *MarkCorrectionRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(markcorrectionrequest.FieldUserID)
+	}
+	query.Where(predicate.MarkCorrectionRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.MarkCorrectionRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -5,7 +5,12 @@ package ent
 import (
 	"back/internal/ent/address"
 	"back/internal/ent/attendanceday"
+	"back/internal/ent/hourbankmovement"
+	"back/internal/ent/leaverequest"
+	"back/internal/ent/markcorrectionrequest"
+	"back/internal/ent/overtimeauthorization"
 	"back/internal/ent/predicate"
+	"back/internal/ent/punch"
 	"back/internal/ent/refreshtoken"
 	"back/internal/ent/user"
 	"back/internal/ent/useraccesspoint"
@@ -13,6 +18,7 @@ import (
 	"back/internal/ent/userdayoverride"
 	"back/internal/ent/userqrsession"
 	"back/internal/ent/usershiftassignment"
+	"back/internal/ent/vacationadjustment"
 	"context"
 	"errors"
 	"fmt"
@@ -212,6 +218,53 @@ func (_u *UserUpdate) ClearAccessCode() *UserUpdate {
 	return _u
 }
 
+// SetHireDate sets the "hire_date" field.
+func (_u *UserUpdate) SetHireDate(v time.Time) *UserUpdate {
+	_u.mutation.SetHireDate(v)
+	return _u
+}
+
+// SetNillableHireDate sets the "hire_date" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableHireDate(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetHireDate(*v)
+	}
+	return _u
+}
+
+// ClearHireDate clears the value of the "hire_date" field.
+func (_u *UserUpdate) ClearHireDate() *UserUpdate {
+	_u.mutation.ClearHireDate()
+	return _u
+}
+
+// SetPriorServiceYears sets the "prior_service_years" field.
+func (_u *UserUpdate) SetPriorServiceYears(v int) *UserUpdate {
+	_u.mutation.ResetPriorServiceYears()
+	_u.mutation.SetPriorServiceYears(v)
+	return _u
+}
+
+// SetNillablePriorServiceYears sets the "prior_service_years" field if the given value is not nil.
+func (_u *UserUpdate) SetNillablePriorServiceYears(v *int) *UserUpdate {
+	if v != nil {
+		_u.SetPriorServiceYears(*v)
+	}
+	return _u
+}
+
+// AddPriorServiceYears adds value to the "prior_service_years" field.
+func (_u *UserUpdate) AddPriorServiceYears(v int) *UserUpdate {
+	_u.mutation.AddPriorServiceYears(v)
+	return _u
+}
+
+// ClearPriorServiceYears clears the value of the "prior_service_years" field.
+func (_u *UserUpdate) ClearPriorServiceYears() *UserUpdate {
+	_u.mutation.ClearPriorServiceYears()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserUpdate) SetUpdatedAt(v time.Time) *UserUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -338,6 +391,96 @@ func (_u *UserUpdate) AddQrSessions(v ...*UserQRSession) *UserUpdate {
 	return _u.AddQrSessionIDs(ids...)
 }
 
+// AddPunchIDs adds the "punches" edge to the Punch entity by IDs.
+func (_u *UserUpdate) AddPunchIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddPunchIDs(ids...)
+	return _u
+}
+
+// AddPunches adds the "punches" edges to the Punch entity.
+func (_u *UserUpdate) AddPunches(v ...*Punch) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPunchIDs(ids...)
+}
+
+// AddLeaveRequestIDs adds the "leave_requests" edge to the LeaveRequest entity by IDs.
+func (_u *UserUpdate) AddLeaveRequestIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddLeaveRequestIDs(ids...)
+	return _u
+}
+
+// AddLeaveRequests adds the "leave_requests" edges to the LeaveRequest entity.
+func (_u *UserUpdate) AddLeaveRequests(v ...*LeaveRequest) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLeaveRequestIDs(ids...)
+}
+
+// AddVacationAdjustmentIDs adds the "vacation_adjustments" edge to the VacationAdjustment entity by IDs.
+func (_u *UserUpdate) AddVacationAdjustmentIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddVacationAdjustmentIDs(ids...)
+	return _u
+}
+
+// AddVacationAdjustments adds the "vacation_adjustments" edges to the VacationAdjustment entity.
+func (_u *UserUpdate) AddVacationAdjustments(v ...*VacationAdjustment) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVacationAdjustmentIDs(ids...)
+}
+
+// AddOvertimeAuthorizationIDs adds the "overtime_authorizations" edge to the OvertimeAuthorization entity by IDs.
+func (_u *UserUpdate) AddOvertimeAuthorizationIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddOvertimeAuthorizationIDs(ids...)
+	return _u
+}
+
+// AddOvertimeAuthorizations adds the "overtime_authorizations" edges to the OvertimeAuthorization entity.
+func (_u *UserUpdate) AddOvertimeAuthorizations(v ...*OvertimeAuthorization) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOvertimeAuthorizationIDs(ids...)
+}
+
+// AddHourBankMovementIDs adds the "hour_bank_movements" edge to the HourBankMovement entity by IDs.
+func (_u *UserUpdate) AddHourBankMovementIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddHourBankMovementIDs(ids...)
+	return _u
+}
+
+// AddHourBankMovements adds the "hour_bank_movements" edges to the HourBankMovement entity.
+func (_u *UserUpdate) AddHourBankMovements(v ...*HourBankMovement) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHourBankMovementIDs(ids...)
+}
+
+// AddMarkCorrectionRequestIDs adds the "mark_correction_requests" edge to the MarkCorrectionRequest entity by IDs.
+func (_u *UserUpdate) AddMarkCorrectionRequestIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddMarkCorrectionRequestIDs(ids...)
+	return _u
+}
+
+// AddMarkCorrectionRequests adds the "mark_correction_requests" edges to the MarkCorrectionRequest entity.
+func (_u *UserUpdate) AddMarkCorrectionRequests(v ...*MarkCorrectionRequest) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMarkCorrectionRequestIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -511,6 +654,132 @@ func (_u *UserUpdate) RemoveQrSessions(v ...*UserQRSession) *UserUpdate {
 	return _u.RemoveQrSessionIDs(ids...)
 }
 
+// ClearPunches clears all "punches" edges to the Punch entity.
+func (_u *UserUpdate) ClearPunches() *UserUpdate {
+	_u.mutation.ClearPunches()
+	return _u
+}
+
+// RemovePunchIDs removes the "punches" edge to Punch entities by IDs.
+func (_u *UserUpdate) RemovePunchIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemovePunchIDs(ids...)
+	return _u
+}
+
+// RemovePunches removes "punches" edges to Punch entities.
+func (_u *UserUpdate) RemovePunches(v ...*Punch) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePunchIDs(ids...)
+}
+
+// ClearLeaveRequests clears all "leave_requests" edges to the LeaveRequest entity.
+func (_u *UserUpdate) ClearLeaveRequests() *UserUpdate {
+	_u.mutation.ClearLeaveRequests()
+	return _u
+}
+
+// RemoveLeaveRequestIDs removes the "leave_requests" edge to LeaveRequest entities by IDs.
+func (_u *UserUpdate) RemoveLeaveRequestIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveLeaveRequestIDs(ids...)
+	return _u
+}
+
+// RemoveLeaveRequests removes "leave_requests" edges to LeaveRequest entities.
+func (_u *UserUpdate) RemoveLeaveRequests(v ...*LeaveRequest) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLeaveRequestIDs(ids...)
+}
+
+// ClearVacationAdjustments clears all "vacation_adjustments" edges to the VacationAdjustment entity.
+func (_u *UserUpdate) ClearVacationAdjustments() *UserUpdate {
+	_u.mutation.ClearVacationAdjustments()
+	return _u
+}
+
+// RemoveVacationAdjustmentIDs removes the "vacation_adjustments" edge to VacationAdjustment entities by IDs.
+func (_u *UserUpdate) RemoveVacationAdjustmentIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveVacationAdjustmentIDs(ids...)
+	return _u
+}
+
+// RemoveVacationAdjustments removes "vacation_adjustments" edges to VacationAdjustment entities.
+func (_u *UserUpdate) RemoveVacationAdjustments(v ...*VacationAdjustment) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVacationAdjustmentIDs(ids...)
+}
+
+// ClearOvertimeAuthorizations clears all "overtime_authorizations" edges to the OvertimeAuthorization entity.
+func (_u *UserUpdate) ClearOvertimeAuthorizations() *UserUpdate {
+	_u.mutation.ClearOvertimeAuthorizations()
+	return _u
+}
+
+// RemoveOvertimeAuthorizationIDs removes the "overtime_authorizations" edge to OvertimeAuthorization entities by IDs.
+func (_u *UserUpdate) RemoveOvertimeAuthorizationIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveOvertimeAuthorizationIDs(ids...)
+	return _u
+}
+
+// RemoveOvertimeAuthorizations removes "overtime_authorizations" edges to OvertimeAuthorization entities.
+func (_u *UserUpdate) RemoveOvertimeAuthorizations(v ...*OvertimeAuthorization) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOvertimeAuthorizationIDs(ids...)
+}
+
+// ClearHourBankMovements clears all "hour_bank_movements" edges to the HourBankMovement entity.
+func (_u *UserUpdate) ClearHourBankMovements() *UserUpdate {
+	_u.mutation.ClearHourBankMovements()
+	return _u
+}
+
+// RemoveHourBankMovementIDs removes the "hour_bank_movements" edge to HourBankMovement entities by IDs.
+func (_u *UserUpdate) RemoveHourBankMovementIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveHourBankMovementIDs(ids...)
+	return _u
+}
+
+// RemoveHourBankMovements removes "hour_bank_movements" edges to HourBankMovement entities.
+func (_u *UserUpdate) RemoveHourBankMovements(v ...*HourBankMovement) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHourBankMovementIDs(ids...)
+}
+
+// ClearMarkCorrectionRequests clears all "mark_correction_requests" edges to the MarkCorrectionRequest entity.
+func (_u *UserUpdate) ClearMarkCorrectionRequests() *UserUpdate {
+	_u.mutation.ClearMarkCorrectionRequests()
+	return _u
+}
+
+// RemoveMarkCorrectionRequestIDs removes the "mark_correction_requests" edge to MarkCorrectionRequest entities by IDs.
+func (_u *UserUpdate) RemoveMarkCorrectionRequestIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveMarkCorrectionRequestIDs(ids...)
+	return _u
+}
+
+// RemoveMarkCorrectionRequests removes "mark_correction_requests" edges to MarkCorrectionRequest entities.
+func (_u *UserUpdate) RemoveMarkCorrectionRequests(v ...*MarkCorrectionRequest) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMarkCorrectionRequestIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -564,6 +833,11 @@ func (_u *UserUpdate) check() error {
 			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.PriorServiceYears(); ok {
+		if err := user.PriorServiceYearsValidator(v); err != nil {
+			return &ValidationError{Name: "prior_service_years", err: fmt.Errorf(`ent: validator failed for field "User.prior_service_years": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -627,6 +901,21 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.AccessCodeCleared() {
 		_spec.ClearField(user.FieldAccessCode, field.TypeString)
 	}
+	if value, ok := _u.mutation.HireDate(); ok {
+		_spec.SetField(user.FieldHireDate, field.TypeTime, value)
+	}
+	if _u.mutation.HireDateCleared() {
+		_spec.ClearField(user.FieldHireDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PriorServiceYears(); ok {
+		_spec.SetField(user.FieldPriorServiceYears, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriorServiceYears(); ok {
+		_spec.AddField(user.FieldPriorServiceYears, field.TypeInt, value)
+	}
+	if _u.mutation.PriorServiceYearsCleared() {
+		_spec.ClearField(user.FieldPriorServiceYears, field.TypeInt)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -990,72 +1279,342 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PunchesTable,
+			Columns: []string{user.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetUsername sets the "username" field.
-func (_u *UserUpdateOne) SetUsername(v string) *UserUpdateOne {
-	_u.mutation.SetUsername(v)
-	return _u
-}
-
-// SetNillableUsername sets the "username" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableUsername(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetUsername(*v)
+	if nodes := _u.mutation.RemovedPunchesIDs(); len(nodes) > 0 && !_u.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PunchesTable,
+			Columns: []string{user.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetPasswordHash sets the "password_hash" field.
-func (_u *UserUpdateOne) SetPasswordHash(v string) *UserUpdateOne {
-	_u.mutation.SetPasswordHash(v)
-	return _u
-}
-
-// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillablePasswordHash(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetPasswordHash(*v)
+	if nodes := _u.mutation.PunchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PunchesTable,
+			Columns: []string{user.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetRole sets the "role" field.
-func (_u *UserUpdateOne) SetRole(v string) *UserUpdateOne {
-	_u.mutation.SetRole(v)
-	return _u
-}
-
-// SetNillableRole sets the "role" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableRole(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetRole(*v)
+	if _u.mutation.LeaveRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeaveRequestsTable,
+			Columns: []string{user.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetIsActive sets the "is_active" field.
-func (_u *UserUpdateOne) SetIsActive(v bool) *UserUpdateOne {
-	_u.mutation.SetIsActive(v)
-	return _u
+	if nodes := _u.mutation.RemovedLeaveRequestsIDs(); len(nodes) > 0 && !_u.mutation.LeaveRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeaveRequestsTable,
+			Columns: []string{user.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeaveRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeaveRequestsTable,
+			Columns: []string{user.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VacationAdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.VacationAdjustmentsTable,
+			Columns: []string{user.VacationAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vacationadjustment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVacationAdjustmentsIDs(); len(nodes) > 0 && !_u.mutation.VacationAdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.VacationAdjustmentsTable,
+			Columns: []string{user.VacationAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vacationadjustment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VacationAdjustmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.VacationAdjustmentsTable,
+			Columns: []string{user.VacationAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vacationadjustment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OvertimeAuthorizationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OvertimeAuthorizationsTable,
+			Columns: []string{user.OvertimeAuthorizationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimeauthorization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOvertimeAuthorizationsIDs(); len(nodes) > 0 && !_u.mutation.OvertimeAuthorizationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OvertimeAuthorizationsTable,
+			Columns: []string{user.OvertimeAuthorizationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimeauthorization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OvertimeAuthorizationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OvertimeAuthorizationsTable,
+			Columns: []string{user.OvertimeAuthorizationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimeauthorization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HourBankMovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HourBankMovementsTable,
+			Columns: []string{user.HourBankMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hourbankmovement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHourBankMovementsIDs(); len(nodes) > 0 && !_u.mutation.HourBankMovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HourBankMovementsTable,
+			Columns: []string{user.HourBankMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hourbankmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HourBankMovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HourBankMovementsTable,
+			Columns: []string{user.HourBankMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hourbankmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MarkCorrectionRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MarkCorrectionRequestsTable,
+			Columns: []string{user.MarkCorrectionRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(markcorrectionrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMarkCorrectionRequestsIDs(); len(nodes) > 0 && !_u.mutation.MarkCorrectionRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MarkCorrectionRequestsTable,
+			Columns: []string{user.MarkCorrectionRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(markcorrectionrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MarkCorrectionRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MarkCorrectionRequestsTable,
+			Columns: []string{user.MarkCorrectionRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(markcorrectionrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetUsername sets the "username" field.
+func (_u *UserUpdateOne) SetUsername(v string) *UserUpdateOne {
+	_u.mutation.SetUsername(v)
+	return _u
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableUsername(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetUsername(*v)
+	}
+	return _u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (_u *UserUpdateOne) SetPasswordHash(v string) *UserUpdateOne {
+	_u.mutation.SetPasswordHash(v)
+	return _u
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePasswordHash(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetPasswordHash(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *UserUpdateOne) SetRole(v string) *UserUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableRole(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *UserUpdateOne) SetIsActive(v bool) *UserUpdateOne {
+	_u.mutation.SetIsActive(v)
+	return _u
 }
 
 // SetNillableIsActive sets the "is_active" field if the given value is not nil.
@@ -1186,6 +1745,53 @@ func (_u *UserUpdateOne) ClearAccessCode() *UserUpdateOne {
 	return _u
 }
 
+// SetHireDate sets the "hire_date" field.
+func (_u *UserUpdateOne) SetHireDate(v time.Time) *UserUpdateOne {
+	_u.mutation.SetHireDate(v)
+	return _u
+}
+
+// SetNillableHireDate sets the "hire_date" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableHireDate(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetHireDate(*v)
+	}
+	return _u
+}
+
+// ClearHireDate clears the value of the "hire_date" field.
+func (_u *UserUpdateOne) ClearHireDate() *UserUpdateOne {
+	_u.mutation.ClearHireDate()
+	return _u
+}
+
+// SetPriorServiceYears sets the "prior_service_years" field.
+func (_u *UserUpdateOne) SetPriorServiceYears(v int) *UserUpdateOne {
+	_u.mutation.ResetPriorServiceYears()
+	_u.mutation.SetPriorServiceYears(v)
+	return _u
+}
+
+// SetNillablePriorServiceYears sets the "prior_service_years" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePriorServiceYears(v *int) *UserUpdateOne {
+	if v != nil {
+		_u.SetPriorServiceYears(*v)
+	}
+	return _u
+}
+
+// AddPriorServiceYears adds value to the "prior_service_years" field.
+func (_u *UserUpdateOne) AddPriorServiceYears(v int) *UserUpdateOne {
+	_u.mutation.AddPriorServiceYears(v)
+	return _u
+}
+
+// ClearPriorServiceYears clears the value of the "prior_service_years" field.
+func (_u *UserUpdateOne) ClearPriorServiceYears() *UserUpdateOne {
+	_u.mutation.ClearPriorServiceYears()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -1312,6 +1918,96 @@ func (_u *UserUpdateOne) AddQrSessions(v ...*UserQRSession) *UserUpdateOne {
 	return _u.AddQrSessionIDs(ids...)
 }
 
+// AddPunchIDs adds the "punches" edge to the Punch entity by IDs.
+func (_u *UserUpdateOne) AddPunchIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddPunchIDs(ids...)
+	return _u
+}
+
+// AddPunches adds the "punches" edges to the Punch entity.
+func (_u *UserUpdateOne) AddPunches(v ...*Punch) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPunchIDs(ids...)
+}
+
+// AddLeaveRequestIDs adds the "leave_requests" edge to the LeaveRequest entity by IDs.
+func (_u *UserUpdateOne) AddLeaveRequestIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddLeaveRequestIDs(ids...)
+	return _u
+}
+
+// AddLeaveRequests adds the "leave_requests" edges to the LeaveRequest entity.
+func (_u *UserUpdateOne) AddLeaveRequests(v ...*LeaveRequest) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLeaveRequestIDs(ids...)
+}
+
+// AddVacationAdjustmentIDs adds the "vacation_adjustments" edge to the VacationAdjustment entity by IDs.
+func (_u *UserUpdateOne) AddVacationAdjustmentIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddVacationAdjustmentIDs(ids...)
+	return _u
+}
+
+// AddVacationAdjustments adds the "vacation_adjustments" edges to the VacationAdjustment entity.
+func (_u *UserUpdateOne) AddVacationAdjustments(v ...*VacationAdjustment) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVacationAdjustmentIDs(ids...)
+}
+
+// AddOvertimeAuthorizationIDs adds the "overtime_authorizations" edge to the OvertimeAuthorization entity by IDs.
+func (_u *UserUpdateOne) AddOvertimeAuthorizationIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddOvertimeAuthorizationIDs(ids...)
+	return _u
+}
+
+// AddOvertimeAuthorizations adds the "overtime_authorizations" edges to the OvertimeAuthorization entity.
+func (_u *UserUpdateOne) AddOvertimeAuthorizations(v ...*OvertimeAuthorization) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOvertimeAuthorizationIDs(ids...)
+}
+
+// AddHourBankMovementIDs adds the "hour_bank_movements" edge to the HourBankMovement entity by IDs.
+func (_u *UserUpdateOne) AddHourBankMovementIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddHourBankMovementIDs(ids...)
+	return _u
+}
+
+// AddHourBankMovements adds the "hour_bank_movements" edges to the HourBankMovement entity.
+func (_u *UserUpdateOne) AddHourBankMovements(v ...*HourBankMovement) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHourBankMovementIDs(ids...)
+}
+
+// AddMarkCorrectionRequestIDs adds the "mark_correction_requests" edge to the MarkCorrectionRequest entity by IDs.
+func (_u *UserUpdateOne) AddMarkCorrectionRequestIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddMarkCorrectionRequestIDs(ids...)
+	return _u
+}
+
+// AddMarkCorrectionRequests adds the "mark_correction_requests" edges to the MarkCorrectionRequest entity.
+func (_u *UserUpdateOne) AddMarkCorrectionRequests(v ...*MarkCorrectionRequest) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMarkCorrectionRequestIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
@@ -1365,124 +2061,250 @@ func (_u *UserUpdateOne) ClearUserBranches() *UserUpdateOne {
 	return _u
 }
 
-// RemoveUserBranchIDs removes the "user_branches" edge to UserBranch entities by IDs.
-func (_u *UserUpdateOne) RemoveUserBranchIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveUserBranchIDs(ids...)
+// RemoveUserBranchIDs removes the "user_branches" edge to UserBranch entities by IDs.
+func (_u *UserUpdateOne) RemoveUserBranchIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveUserBranchIDs(ids...)
+	return _u
+}
+
+// RemoveUserBranches removes "user_branches" edges to UserBranch entities.
+func (_u *UserUpdateOne) RemoveUserBranches(v ...*UserBranch) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUserBranchIDs(ids...)
+}
+
+// ClearUserAccessPoints clears all "user_access_points" edges to the UserAccessPoint entity.
+func (_u *UserUpdateOne) ClearUserAccessPoints() *UserUpdateOne {
+	_u.mutation.ClearUserAccessPoints()
+	return _u
+}
+
+// RemoveUserAccessPointIDs removes the "user_access_points" edge to UserAccessPoint entities by IDs.
+func (_u *UserUpdateOne) RemoveUserAccessPointIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveUserAccessPointIDs(ids...)
+	return _u
+}
+
+// RemoveUserAccessPoints removes "user_access_points" edges to UserAccessPoint entities.
+func (_u *UserUpdateOne) RemoveUserAccessPoints(v ...*UserAccessPoint) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUserAccessPointIDs(ids...)
+}
+
+// ClearAttendanceDays clears all "attendance_days" edges to the AttendanceDay entity.
+func (_u *UserUpdateOne) ClearAttendanceDays() *UserUpdateOne {
+	_u.mutation.ClearAttendanceDays()
+	return _u
+}
+
+// RemoveAttendanceDayIDs removes the "attendance_days" edge to AttendanceDay entities by IDs.
+func (_u *UserUpdateOne) RemoveAttendanceDayIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveAttendanceDayIDs(ids...)
+	return _u
+}
+
+// RemoveAttendanceDays removes "attendance_days" edges to AttendanceDay entities.
+func (_u *UserUpdateOne) RemoveAttendanceDays(v ...*AttendanceDay) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttendanceDayIDs(ids...)
+}
+
+// ClearShiftAssignments clears all "shift_assignments" edges to the UserShiftAssignment entity.
+func (_u *UserUpdateOne) ClearShiftAssignments() *UserUpdateOne {
+	_u.mutation.ClearShiftAssignments()
+	return _u
+}
+
+// RemoveShiftAssignmentIDs removes the "shift_assignments" edge to UserShiftAssignment entities by IDs.
+func (_u *UserUpdateOne) RemoveShiftAssignmentIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveShiftAssignmentIDs(ids...)
+	return _u
+}
+
+// RemoveShiftAssignments removes "shift_assignments" edges to UserShiftAssignment entities.
+func (_u *UserUpdateOne) RemoveShiftAssignments(v ...*UserShiftAssignment) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShiftAssignmentIDs(ids...)
+}
+
+// ClearDayOverrides clears all "day_overrides" edges to the UserDayOverride entity.
+func (_u *UserUpdateOne) ClearDayOverrides() *UserUpdateOne {
+	_u.mutation.ClearDayOverrides()
+	return _u
+}
+
+// RemoveDayOverrideIDs removes the "day_overrides" edge to UserDayOverride entities by IDs.
+func (_u *UserUpdateOne) RemoveDayOverrideIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveDayOverrideIDs(ids...)
+	return _u
+}
+
+// RemoveDayOverrides removes "day_overrides" edges to UserDayOverride entities.
+func (_u *UserUpdateOne) RemoveDayOverrides(v ...*UserDayOverride) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDayOverrideIDs(ids...)
+}
+
+// ClearQrSessions clears all "qr_sessions" edges to the UserQRSession entity.
+func (_u *UserUpdateOne) ClearQrSessions() *UserUpdateOne {
+	_u.mutation.ClearQrSessions()
+	return _u
+}
+
+// RemoveQrSessionIDs removes the "qr_sessions" edge to UserQRSession entities by IDs.
+func (_u *UserUpdateOne) RemoveQrSessionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveQrSessionIDs(ids...)
+	return _u
+}
+
+// RemoveQrSessions removes "qr_sessions" edges to UserQRSession entities.
+func (_u *UserUpdateOne) RemoveQrSessions(v ...*UserQRSession) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveQrSessionIDs(ids...)
+}
+
+// ClearPunches clears all "punches" edges to the Punch entity.
+func (_u *UserUpdateOne) ClearPunches() *UserUpdateOne {
+	_u.mutation.ClearPunches()
+	return _u
+}
+
+// RemovePunchIDs removes the "punches" edge to Punch entities by IDs.
+func (_u *UserUpdateOne) RemovePunchIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemovePunchIDs(ids...)
 	return _u
 }
 
-// RemoveUserBranches removes "user_branches" edges to UserBranch entities.
-func (_u *UserUpdateOne) RemoveUserBranches(v ...*UserBranch) *UserUpdateOne {
+// RemovePunches removes "punches" edges to Punch entities.
+func (_u *UserUpdateOne) RemovePunches(v ...*Punch) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveUserBranchIDs(ids...)
+	return _u.RemovePunchIDs(ids...)
 }
 
-// ClearUserAccessPoints clears all "user_access_points" edges to the UserAccessPoint entity.
-func (_u *UserUpdateOne) ClearUserAccessPoints() *UserUpdateOne {
-	_u.mutation.ClearUserAccessPoints()
+// ClearLeaveRequests clears all "leave_requests" edges to the LeaveRequest entity.
+func (_u *UserUpdateOne) ClearLeaveRequests() *UserUpdateOne {
+	_u.mutation.ClearLeaveRequests()
 	return _u
 }
 
-// RemoveUserAccessPointIDs removes the "user_access_points" edge to UserAccessPoint entities by IDs.
-func (_u *UserUpdateOne) RemoveUserAccessPointIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveUserAccessPointIDs(ids...)
+// RemoveLeaveRequestIDs removes the "leave_requests" edge to LeaveRequest entities by IDs.
+func (_u *UserUpdateOne) RemoveLeaveRequestIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveLeaveRequestIDs(ids...)
 	return _u
 }
 
-// RemoveUserAccessPoints removes "user_access_points" edges to UserAccessPoint entities.
-func (_u *UserUpdateOne) RemoveUserAccessPoints(v ...*UserAccessPoint) *UserUpdateOne {
+// RemoveLeaveRequests removes "leave_requests" edges to LeaveRequest entities.
+func (_u *UserUpdateOne) RemoveLeaveRequests(v ...*LeaveRequest) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveUserAccessPointIDs(ids...)
+	return _u.RemoveLeaveRequestIDs(ids...)
 }
 
-// ClearAttendanceDays clears all "attendance_days" edges to the AttendanceDay entity.
-func (_u *UserUpdateOne) ClearAttendanceDays() *UserUpdateOne {
-	_u.mutation.ClearAttendanceDays()
+// ClearVacationAdjustments clears all "vacation_adjustments" edges to the VacationAdjustment entity.
+func (_u *UserUpdateOne) ClearVacationAdjustments() *UserUpdateOne {
+	_u.mutation.ClearVacationAdjustments()
 	return _u
 }
 
-// RemoveAttendanceDayIDs removes the "attendance_days" edge to AttendanceDay entities by IDs.
-func (_u *UserUpdateOne) RemoveAttendanceDayIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveAttendanceDayIDs(ids...)
+// RemoveVacationAdjustmentIDs removes the "vacation_adjustments" edge to VacationAdjustment entities by IDs.
+func (_u *UserUpdateOne) RemoveVacationAdjustmentIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveVacationAdjustmentIDs(ids...)
 	return _u
 }
 
-// RemoveAttendanceDays removes "attendance_days" edges to AttendanceDay entities.
-func (_u *UserUpdateOne) RemoveAttendanceDays(v ...*AttendanceDay) *UserUpdateOne {
+// RemoveVacationAdjustments removes "vacation_adjustments" edges to VacationAdjustment entities.
+func (_u *UserUpdateOne) RemoveVacationAdjustments(v ...*VacationAdjustment) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAttendanceDayIDs(ids...)
+	return _u.RemoveVacationAdjustmentIDs(ids...)
 }
 
-// ClearShiftAssignments clears all "shift_assignments" edges to the UserShiftAssignment entity.
-func (_u *UserUpdateOne) ClearShiftAssignments() *UserUpdateOne {
-	_u.mutation.ClearShiftAssignments()
+// ClearOvertimeAuthorizations clears all "overtime_authorizations" edges to the OvertimeAuthorization entity.
+func (_u *UserUpdateOne) ClearOvertimeAuthorizations() *UserUpdateOne {
+	_u.mutation.ClearOvertimeAuthorizations()
 	return _u
 }
 
-// RemoveShiftAssignmentIDs removes the "shift_assignments" edge to UserShiftAssignment entities by IDs.
-func (_u *UserUpdateOne) RemoveShiftAssignmentIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveShiftAssignmentIDs(ids...)
+// RemoveOvertimeAuthorizationIDs removes the "overtime_authorizations" edge to OvertimeAuthorization entities by IDs.
+func (_u *UserUpdateOne) RemoveOvertimeAuthorizationIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveOvertimeAuthorizationIDs(ids...)
 	return _u
 }
 
-// RemoveShiftAssignments removes "shift_assignments" edges to UserShiftAssignment entities.
-func (_u *UserUpdateOne) RemoveShiftAssignments(v ...*UserShiftAssignment) *UserUpdateOne {
+// RemoveOvertimeAuthorizations removes "overtime_authorizations" edges to OvertimeAuthorization entities.
+func (_u *UserUpdateOne) RemoveOvertimeAuthorizations(v ...*OvertimeAuthorization) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveShiftAssignmentIDs(ids...)
+	return _u.RemoveOvertimeAuthorizationIDs(ids...)
 }
 
-// ClearDayOverrides clears all "day_overrides" edges to the UserDayOverride entity.
-func (_u *UserUpdateOne) ClearDayOverrides() *UserUpdateOne {
-	_u.mutation.ClearDayOverrides()
+// ClearHourBankMovements clears all "hour_bank_movements" edges to the HourBankMovement entity.
+func (_u *UserUpdateOne) ClearHourBankMovements() *UserUpdateOne {
+	_u.mutation.ClearHourBankMovements()
 	return _u
 }
 
-// RemoveDayOverrideIDs removes the "day_overrides" edge to UserDayOverride entities by IDs.
-func (_u *UserUpdateOne) RemoveDayOverrideIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveDayOverrideIDs(ids...)
+// RemoveHourBankMovementIDs removes the "hour_bank_movements" edge to HourBankMovement entities by IDs.
+func (_u *UserUpdateOne) RemoveHourBankMovementIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveHourBankMovementIDs(ids...)
 	return _u
 }
 
-// RemoveDayOverrides removes "day_overrides" edges to UserDayOverride entities.
-func (_u *UserUpdateOne) RemoveDayOverrides(v ...*UserDayOverride) *UserUpdateOne {
+// RemoveHourBankMovements removes "hour_bank_movements" edges to HourBankMovement entities.
+func (_u *UserUpdateOne) RemoveHourBankMovements(v ...*HourBankMovement) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveDayOverrideIDs(ids...)
+	return _u.RemoveHourBankMovementIDs(ids...)
 }
 
-// ClearQrSessions clears all "qr_sessions" edges to the UserQRSession entity.
-func (_u *UserUpdateOne) ClearQrSessions() *UserUpdateOne {
-	_u.mutation.ClearQrSessions()
+// ClearMarkCorrectionRequests clears all "mark_correction_requests" edges to the MarkCorrectionRequest entity.
+func (_u *UserUpdateOne) ClearMarkCorrectionRequests() *UserUpdateOne {
+	_u.mutation.ClearMarkCorrectionRequests()
 	return _u
 }
 
-// RemoveQrSessionIDs removes the "qr_sessions" edge to UserQRSession entities by IDs.
-func (_u *UserUpdateOne) RemoveQrSessionIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveQrSessionIDs(ids...)
+// RemoveMarkCorrectionRequestIDs removes the "mark_correction_requests" edge to MarkCorrectionRequest entities by IDs.
+func (_u *UserUpdateOne) RemoveMarkCorrectionRequestIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveMarkCorrectionRequestIDs(ids...)
 	return _u
 }
 
-// RemoveQrSessions removes "qr_sessions" edges to UserQRSession entities.
-func (_u *UserUpdateOne) RemoveQrSessions(v ...*UserQRSession) *UserUpdateOne {
+// RemoveMarkCorrectionRequests removes "mark_correction_requests" edges to MarkCorrectionRequest entities.
+func (_u *UserUpdateOne) RemoveMarkCorrectionRequests(v ...*MarkCorrectionRequest) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveQrSessionIDs(ids...)
+	return _u.RemoveMarkCorrectionRequestIDs(ids...)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -1551,6 +2373,11 @@ func (_u *UserUpdateOne) check() error {
 			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.PriorServiceYears(); ok {
+		if err := user.PriorServiceYearsValidator(v); err != nil {
+			return &ValidationError{Name: "prior_service_years", err: fmt.Errorf(`ent: validator failed for field "User.prior_service_years": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1631,6 +2458,21 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.AccessCodeCleared() {
 		_spec.ClearField(user.FieldAccessCode, field.TypeString)
 	}
+	if value, ok := _u.mutation.HireDate(); ok {
+		_spec.SetField(user.FieldHireDate, field.TypeTime, value)
+	}
+	if _u.mutation.HireDateCleared() {
+		_spec.ClearField(user.FieldHireDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PriorServiceYears(); ok {
+		_spec.SetField(user.FieldPriorServiceYears, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriorServiceYears(); ok {
+		_spec.AddField(user.FieldPriorServiceYears, field.TypeInt, value)
+	}
+	if _u.mutation.PriorServiceYearsCleared() {
+		_spec.ClearField(user.FieldPriorServiceYears, field.TypeInt)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -1994,6 +2836,276 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PunchesTable,
+			Columns: []string{user.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPunchesIDs(); len(nodes) > 0 && !_u.mutation.PunchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PunchesTable,
+			Columns: []string{user.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PunchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PunchesTable,
+			Columns: []string{user.PunchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(punch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LeaveRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeaveRequestsTable,
+			Columns: []string{user.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLeaveRequestsIDs(); len(nodes) > 0 && !_u.mutation.LeaveRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeaveRequestsTable,
+			Columns: []string{user.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LeaveRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LeaveRequestsTable,
+			Columns: []string{user.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VacationAdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.VacationAdjustmentsTable,
+			Columns: []string{user.VacationAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vacationadjustment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVacationAdjustmentsIDs(); len(nodes) > 0 && !_u.mutation.VacationAdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.VacationAdjustmentsTable,
+			Columns: []string{user.VacationAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vacationadjustment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VacationAdjustmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.VacationAdjustmentsTable,
+			Columns: []string{user.VacationAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vacationadjustment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OvertimeAuthorizationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OvertimeAuthorizationsTable,
+			Columns: []string{user.OvertimeAuthorizationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimeauthorization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOvertimeAuthorizationsIDs(); len(nodes) > 0 && !_u.mutation.OvertimeAuthorizationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OvertimeAuthorizationsTable,
+			Columns: []string{user.OvertimeAuthorizationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimeauthorization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OvertimeAuthorizationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OvertimeAuthorizationsTable,
+			Columns: []string{user.OvertimeAuthorizationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimeauthorization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HourBankMovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HourBankMovementsTable,
+			Columns: []string{user.HourBankMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hourbankmovement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHourBankMovementsIDs(); len(nodes) > 0 && !_u.mutation.HourBankMovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HourBankMovementsTable,
+			Columns: []string{user.HourBankMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hourbankmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HourBankMovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HourBankMovementsTable,
+			Columns: []string{user.HourBankMovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hourbankmovement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MarkCorrectionRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MarkCorrectionRequestsTable,
+			Columns: []string{user.MarkCorrectionRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(markcorrectionrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMarkCorrectionRequestsIDs(); len(nodes) > 0 && !_u.mutation.MarkCorrectionRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MarkCorrectionRequestsTable,
+			Columns: []string{user.MarkCorrectionRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(markcorrectionrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MarkCorrectionRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MarkCorrectionRequestsTable,
+			Columns: []string{user.MarkCorrectionRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(markcorrectionrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
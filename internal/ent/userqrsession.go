@@ -28,6 +28,8 @@ type UserQRSession struct {
 	ExpiresAt time.Time `json:"expires_at,omitempty"`
 	// IsRevoked holds the value of the "is_revoked" field.
 	IsRevoked bool `json:"is_revoked,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -67,7 +69,7 @@ func (*UserQRSession) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case userqrsession.FieldTokenHash:
 			values[i] = new(sql.NullString)
-		case userqrsession.FieldIssuedAt, userqrsession.FieldExpiresAt, userqrsession.FieldCreatedAt:
+		case userqrsession.FieldIssuedAt, userqrsession.FieldExpiresAt, userqrsession.FieldRevokedAt, userqrsession.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -120,6 +122,13 @@ func (_m *UserQRSession) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsRevoked = value.Bool
 			}
+		case userqrsession.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				_m.RevokedAt = new(time.Time)
+				*_m.RevokedAt = value.Time
+			}
 		case userqrsession.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -181,6 +190,11 @@ func (_m *UserQRSession) String() string {
 	builder.WriteString("is_revoked=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsRevoked))
 	builder.WriteString(", ")
+	if v := _m.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
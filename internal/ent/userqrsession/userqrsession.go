@@ -24,6 +24,8 @@ const (
 	FieldExpiresAt = "expires_at"
 	// FieldIsRevoked holds the string denoting the is_revoked field in the database.
 	FieldIsRevoked = "is_revoked"
+	// FieldRevokedAt holds the string denoting the revoked_at field in the database.
+	FieldRevokedAt = "revoked_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -47,6 +49,7 @@ var Columns = []string{
 	FieldIssuedAt,
 	FieldExpiresAt,
 	FieldIsRevoked,
+	FieldRevokedAt,
 	FieldCreatedAt,
 }
 
@@ -104,6 +107,11 @@ func ByIsRevoked(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsRevoked, opts...).ToFunc()
 }
 
+// ByRevokedAt orders the results by the revoked_at field.
+func ByRevokedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevokedAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
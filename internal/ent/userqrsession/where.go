@@ -80,6 +80,11 @@ func IsRevoked(v bool) predicate.UserQRSession {
 	return predicate.UserQRSession(sql.FieldEQ(FieldIsRevoked, v))
 }
 
+// RevokedAt applies equality check predicate on the "revoked_at" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.UserQRSession {
+	return predicate.UserQRSession(sql.FieldEQ(FieldRevokedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.UserQRSession {
 	return predicate.UserQRSession(sql.FieldEQ(FieldCreatedAt, v))
@@ -260,6 +265,56 @@ func IsRevokedNEQ(v bool) predicate.UserQRSession {
 	return predicate.UserQRSession(sql.FieldNEQ(FieldIsRevoked, v))
 }
 
+// RevokedAtEQ applies the EQ predicate on the "revoked_at" field.
+func RevokedAtEQ(v time.Time) predicate.UserQRSession {
+	return predicate.UserQRSession(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revoked_at" field.
+func RevokedAtNEQ(v time.Time) predicate.UserQRSession {
+	return predicate.UserQRSession(sql.FieldNEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtIn applies the In predicate on the "revoked_at" field.
+func RevokedAtIn(vs ...time.Time) predicate.UserQRSession {
+	return predicate.UserQRSession(sql.FieldIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revoked_at" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.UserQRSession {
+	return predicate.UserQRSession(sql.FieldNotIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtGT applies the GT predicate on the "revoked_at" field.
+func RevokedAtGT(v time.Time) predicate.UserQRSession {
+	return predicate.UserQRSession(sql.FieldGT(FieldRevokedAt, v))
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revoked_at" field.
+func RevokedAtGTE(v time.Time) predicate.UserQRSession {
+	return predicate.UserQRSession(sql.FieldGTE(FieldRevokedAt, v))
+}
+
+// RevokedAtLT applies the LT predicate on the "revoked_at" field.
+func RevokedAtLT(v time.Time) predicate.UserQRSession {
+	return predicate.UserQRSession(sql.FieldLT(FieldRevokedAt, v))
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revoked_at" field.
+func RevokedAtLTE(v time.Time) predicate.UserQRSession {
+	return predicate.UserQRSession(sql.FieldLTE(FieldRevokedAt, v))
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revoked_at" field.
+func RevokedAtIsNil() predicate.UserQRSession {
+	return predicate.UserQRSession(sql.FieldIsNull(FieldRevokedAt))
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revoked_at" field.
+func RevokedAtNotNil() predicate.UserQRSession {
+	return predicate.UserQRSession(sql.FieldNotNull(FieldRevokedAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.UserQRSession {
 	return predicate.UserQRSession(sql.FieldEQ(FieldCreatedAt, v))
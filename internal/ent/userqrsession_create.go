@@ -67,6 +67,20 @@ func (_c *UserQRSessionCreate) SetNillableIsRevoked(v *bool) *UserQRSessionCreat
 	return _c
 }
 
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *UserQRSessionCreate) SetRevokedAt(v time.Time) *UserQRSessionCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *UserQRSessionCreate) SetNillableRevokedAt(v *time.Time) *UserQRSessionCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *UserQRSessionCreate) SetCreatedAt(v time.Time) *UserQRSessionCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -205,6 +219,10 @@ func (_c *UserQRSessionCreate) createSpec() (*UserQRSession, *sqlgraph.CreateSpe
 		_spec.SetField(userqrsession.FieldIsRevoked, field.TypeBool, value)
 		_node.IsRevoked = value
 	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(userqrsession.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(userqrsession.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
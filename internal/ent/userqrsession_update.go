@@ -99,6 +99,26 @@ func (_u *UserQRSessionUpdate) SetNillableIsRevoked(v *bool) *UserQRSessionUpdat
 	return _u
 }
 
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *UserQRSessionUpdate) SetRevokedAt(v time.Time) *UserQRSessionUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *UserQRSessionUpdate) SetNillableRevokedAt(v *time.Time) *UserQRSessionUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *UserQRSessionUpdate) ClearRevokedAt() *UserQRSessionUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *UserQRSessionUpdate) SetUser(v *User) *UserQRSessionUpdate {
 	return _u.SetUserID(v.ID)
@@ -179,6 +199,12 @@ func (_u *UserQRSessionUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.IsRevoked(); ok {
 		_spec.SetField(userqrsession.FieldIsRevoked, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(userqrsession.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(userqrsession.FieldRevokedAt, field.TypeTime)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -298,6 +324,26 @@ func (_u *UserQRSessionUpdateOne) SetNillableIsRevoked(v *bool) *UserQRSessionUp
 	return _u
 }
 
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *UserQRSessionUpdateOne) SetRevokedAt(v time.Time) *UserQRSessionUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *UserQRSessionUpdateOne) SetNillableRevokedAt(v *time.Time) *UserQRSessionUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *UserQRSessionUpdateOne) ClearRevokedAt() *UserQRSessionUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *UserQRSessionUpdateOne) SetUser(v *User) *UserQRSessionUpdateOne {
 	return _u.SetUserID(v.ID)
@@ -408,6 +454,12 @@ func (_u *UserQRSessionUpdateOne) sqlSave(ctx context.Context) (_node *UserQRSes
 	if value, ok := _u.mutation.IsRevoked(); ok {
 		_spec.SetField(userqrsession.FieldIsRevoked, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(userqrsession.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(userqrsession.FieldRevokedAt, field.TypeTime)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
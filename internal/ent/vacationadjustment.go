@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/user"
+	"back/internal/ent/vacationadjustment"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// VacationAdjustment is the model entity for the VacationAdjustment schema.
+type VacationAdjustment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Date holds the value of the "date" field.
+	Date time.Time `json:"date,omitempty"`
+	// Days holds the value of the "days" field.
+	Days float64 `json:"days,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// CreatedByID holds the value of the "created_by_id" field.
+	CreatedByID *int `json:"created_by_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the VacationAdjustmentQuery when eager-loading is set.
+	Edges        VacationAdjustmentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// VacationAdjustmentEdges holds the relations/edges for other nodes in the graph.
+type VacationAdjustmentEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// CreatedBy holds the value of the created_by edge.
+	CreatedBy *User `json:"created_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VacationAdjustmentEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// CreatedByOrErr returns the CreatedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VacationAdjustmentEdges) CreatedByOrErr() (*User, error) {
+	if e.CreatedBy != nil {
+		return e.CreatedBy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "created_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VacationAdjustment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vacationadjustment.FieldDays:
+			values[i] = new(sql.NullFloat64)
+		case vacationadjustment.FieldID, vacationadjustment.FieldUserID, vacationadjustment.FieldCreatedByID:
+			values[i] = new(sql.NullInt64)
+		case vacationadjustment.FieldReason:
+			values[i] = new(sql.NullString)
+		case vacationadjustment.FieldDate, vacationadjustment.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VacationAdjustment fields.
+func (_m *VacationAdjustment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vacationadjustment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case vacationadjustment.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case vacationadjustment.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				_m.Date = value.Time
+			}
+		case vacationadjustment.FieldDays:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field days", values[i])
+			} else if value.Valid {
+				_m.Days = value.Float64
+			}
+		case vacationadjustment.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case vacationadjustment.FieldCreatedByID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by_id", values[i])
+			} else if value.Valid {
+				_m.CreatedByID = new(int)
+				*_m.CreatedByID = int(value.Int64)
+			}
+		case vacationadjustment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VacationAdjustment.
+// This includes values selected through modifiers, order, etc.
+func (_m *VacationAdjustment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the VacationAdjustment entity.
+func (_m *VacationAdjustment) QueryUser() *UserQuery {
+	return NewVacationAdjustmentClient(_m.config).QueryUser(_m)
+}
+
+// QueryCreatedBy queries the "created_by" edge of the VacationAdjustment entity.
+func (_m *VacationAdjustment) QueryCreatedBy() *UserQuery {
+	return NewVacationAdjustmentClient(_m.config).QueryCreatedBy(_m)
+}
+
+// Update returns a builder for updating this VacationAdjustment.
+// Note that you need to call VacationAdjustment.Unwrap() before calling this method if this VacationAdjustment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *VacationAdjustment) Update() *VacationAdjustmentUpdateOne {
+	return NewVacationAdjustmentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the VacationAdjustment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *VacationAdjustment) Unwrap() *VacationAdjustment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VacationAdjustment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *VacationAdjustment) String() string {
+	var builder strings.Builder
+	builder.WriteString("VacationAdjustment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(_m.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Days))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	if v := _m.CreatedByID; v != nil {
+		builder.WriteString("created_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VacationAdjustments is a parsable slice of VacationAdjustment.
+type VacationAdjustments []*VacationAdjustment
@@ -0,0 +1,382 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vacationadjustment
+
+import (
+	"back/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldEQ(FieldUserID, v))
+}
+
+// Date applies equality check predicate on the "date" field. It's identical to DateEQ.
+func Date(v time.Time) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldEQ(FieldDate, v))
+}
+
+// Days applies equality check predicate on the "days" field. It's identical to DaysEQ.
+func Days(v float64) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldEQ(FieldDays, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldEQ(FieldReason, v))
+}
+
+// CreatedByID applies equality check predicate on the "created_by_id" field. It's identical to CreatedByIDEQ.
+func CreatedByID(v int) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldEQ(FieldCreatedByID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// DateEQ applies the EQ predicate on the "date" field.
+func DateEQ(v time.Time) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldEQ(FieldDate, v))
+}
+
+// DateNEQ applies the NEQ predicate on the "date" field.
+func DateNEQ(v time.Time) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldNEQ(FieldDate, v))
+}
+
+// DateIn applies the In predicate on the "date" field.
+func DateIn(vs ...time.Time) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldIn(FieldDate, vs...))
+}
+
+// DateNotIn applies the NotIn predicate on the "date" field.
+func DateNotIn(vs ...time.Time) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldNotIn(FieldDate, vs...))
+}
+
+// DateGT applies the GT predicate on the "date" field.
+func DateGT(v time.Time) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldGT(FieldDate, v))
+}
+
+// DateGTE applies the GTE predicate on the "date" field.
+func DateGTE(v time.Time) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldGTE(FieldDate, v))
+}
+
+// DateLT applies the LT predicate on the "date" field.
+func DateLT(v time.Time) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldLT(FieldDate, v))
+}
+
+// DateLTE applies the LTE predicate on the "date" field.
+func DateLTE(v time.Time) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldLTE(FieldDate, v))
+}
+
+// DaysEQ applies the EQ predicate on the "days" field.
+func DaysEQ(v float64) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldEQ(FieldDays, v))
+}
+
+// DaysNEQ applies the NEQ predicate on the "days" field.
+func DaysNEQ(v float64) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldNEQ(FieldDays, v))
+}
+
+// DaysIn applies the In predicate on the "days" field.
+func DaysIn(vs ...float64) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldIn(FieldDays, vs...))
+}
+
+// DaysNotIn applies the NotIn predicate on the "days" field.
+func DaysNotIn(vs ...float64) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldNotIn(FieldDays, vs...))
+}
+
+// DaysGT applies the GT predicate on the "days" field.
+func DaysGT(v float64) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldGT(FieldDays, v))
+}
+
+// DaysGTE applies the GTE predicate on the "days" field.
+func DaysGTE(v float64) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldGTE(FieldDays, v))
+}
+
+// DaysLT applies the LT predicate on the "days" field.
+func DaysLT(v float64) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldLT(FieldDays, v))
+}
+
+// DaysLTE applies the LTE predicate on the "days" field.
+func DaysLTE(v float64) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldLTE(FieldDays, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldContainsFold(FieldReason, v))
+}
+
+// CreatedByIDEQ applies the EQ predicate on the "created_by_id" field.
+func CreatedByIDEQ(v int) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldEQ(FieldCreatedByID, v))
+}
+
+// CreatedByIDNEQ applies the NEQ predicate on the "created_by_id" field.
+func CreatedByIDNEQ(v int) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldNEQ(FieldCreatedByID, v))
+}
+
+// CreatedByIDIn applies the In predicate on the "created_by_id" field.
+func CreatedByIDIn(vs ...int) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldIn(FieldCreatedByID, vs...))
+}
+
+// CreatedByIDNotIn applies the NotIn predicate on the "created_by_id" field.
+func CreatedByIDNotIn(vs ...int) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldNotIn(FieldCreatedByID, vs...))
+}
+
+// CreatedByIDIsNil applies the IsNil predicate on the "created_by_id" field.
+func CreatedByIDIsNil() predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldIsNull(FieldCreatedByID))
+}
+
+// CreatedByIDNotNil applies the NotNil predicate on the "created_by_id" field.
+func CreatedByIDNotNil() predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldNotNull(FieldCreatedByID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCreatedBy applies the HasEdge predicate on the "created_by" edge.
+func HasCreatedBy() predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CreatedByTable, CreatedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreatedByWith applies the HasEdge predicate on the "created_by" edge with a given conditions (other predicates).
+func HasCreatedByWith(preds ...predicate.User) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(func(s *sql.Selector) {
+		step := newCreatedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VacationAdjustment) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VacationAdjustment) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VacationAdjustment) predicate.VacationAdjustment {
+	return predicate.VacationAdjustment(sql.NotPredicates(p))
+}
@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/user"
+	"back/internal/ent/vacationadjustment"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VacationAdjustmentCreate is the builder for creating a VacationAdjustment entity.
+type VacationAdjustmentCreate struct {
+	config
+	mutation *VacationAdjustmentMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *VacationAdjustmentCreate) SetUserID(v int) *VacationAdjustmentCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetDate sets the "date" field.
+func (_c *VacationAdjustmentCreate) SetDate(v time.Time) *VacationAdjustmentCreate {
+	_c.mutation.SetDate(v)
+	return _c
+}
+
+// SetDays sets the "days" field.
+func (_c *VacationAdjustmentCreate) SetDays(v float64) *VacationAdjustmentCreate {
+	_c.mutation.SetDays(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *VacationAdjustmentCreate) SetReason(v string) *VacationAdjustmentCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (_c *VacationAdjustmentCreate) SetCreatedByID(v int) *VacationAdjustmentCreate {
+	_c.mutation.SetCreatedByID(v)
+	return _c
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (_c *VacationAdjustmentCreate) SetNillableCreatedByID(v *int) *VacationAdjustmentCreate {
+	if v != nil {
+		_c.SetCreatedByID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *VacationAdjustmentCreate) SetCreatedAt(v time.Time) *VacationAdjustmentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *VacationAdjustmentCreate) SetNillableCreatedAt(v *time.Time) *VacationAdjustmentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *VacationAdjustmentCreate) SetUser(v *User) *VacationAdjustmentCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (_c *VacationAdjustmentCreate) SetCreatedBy(v *User) *VacationAdjustmentCreate {
+	return _c.SetCreatedByID(v.ID)
+}
+
+// Mutation returns the VacationAdjustmentMutation object of the builder.
+func (_c *VacationAdjustmentCreate) Mutation() *VacationAdjustmentMutation {
+	return _c.mutation
+}
+
+// Save creates the VacationAdjustment in the database.
+func (_c *VacationAdjustmentCreate) Save(ctx context.Context) (*VacationAdjustment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VacationAdjustmentCreate) SaveX(ctx context.Context) *VacationAdjustment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VacationAdjustmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VacationAdjustmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VacationAdjustmentCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := vacationadjustment.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VacationAdjustmentCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "VacationAdjustment.user_id"`)}
+	}
+	if _, ok := _c.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "VacationAdjustment.date"`)}
+	}
+	if _, ok := _c.mutation.Days(); !ok {
+		return &ValidationError{Name: "days", err: errors.New(`ent: missing required field "VacationAdjustment.days"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "VacationAdjustment.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := vacationadjustment.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "VacationAdjustment.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VacationAdjustment.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "VacationAdjustment.user"`)}
+	}
+	return nil
+}
+
+func (_c *VacationAdjustmentCreate) sqlSave(ctx context.Context) (*VacationAdjustment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VacationAdjustmentCreate) createSpec() (*VacationAdjustment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VacationAdjustment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(vacationadjustment.Table, sqlgraph.NewFieldSpec(vacationadjustment.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Date(); ok {
+		_spec.SetField(vacationadjustment.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	if value, ok := _c.mutation.Days(); ok {
+		_spec.SetField(vacationadjustment.FieldDays, field.TypeFloat64, value)
+		_node.Days = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(vacationadjustment.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(vacationadjustment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vacationadjustment.UserTable,
+			Columns: []string{vacationadjustment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vacationadjustment.CreatedByTable,
+			Columns: []string{vacationadjustment.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreatedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// VacationAdjustmentCreateBulk is the builder for creating many VacationAdjustment entities in bulk.
+type VacationAdjustmentCreateBulk struct {
+	config
+	err      error
+	builders []*VacationAdjustmentCreate
+}
+
+// Save creates the VacationAdjustment entities in the database.
+func (_c *VacationAdjustmentCreateBulk) Save(ctx context.Context) ([]*VacationAdjustment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*VacationAdjustment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VacationAdjustmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VacationAdjustmentCreateBulk) SaveX(ctx context.Context) []*VacationAdjustment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VacationAdjustmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VacationAdjustmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
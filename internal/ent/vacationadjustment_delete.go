@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/predicate"
+	"back/internal/ent/vacationadjustment"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VacationAdjustmentDelete is the builder for deleting a VacationAdjustment entity.
+type VacationAdjustmentDelete struct {
+	config
+	hooks    []Hook
+	mutation *VacationAdjustmentMutation
+}
+
+// Where appends a list predicates to the VacationAdjustmentDelete builder.
+func (_d *VacationAdjustmentDelete) Where(ps ...predicate.VacationAdjustment) *VacationAdjustmentDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *VacationAdjustmentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *VacationAdjustmentDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *VacationAdjustmentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(vacationadjustment.Table, sqlgraph.NewFieldSpec(vacationadjustment.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// VacationAdjustmentDeleteOne is the builder for deleting a single VacationAdjustment entity.
+type VacationAdjustmentDeleteOne struct {
+	_d *VacationAdjustmentDelete
+}
+
+// Where appends a list predicates to the VacationAdjustmentDelete builder.
+func (_d *VacationAdjustmentDeleteOne) Where(ps ...predicate.VacationAdjustment) *VacationAdjustmentDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *VacationAdjustmentDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{vacationadjustment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *VacationAdjustmentDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,559 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"back/internal/ent/predicate"
+	"back/internal/ent/user"
+	"back/internal/ent/vacationadjustment"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VacationAdjustmentUpdate is the builder for updating VacationAdjustment entities.
+type VacationAdjustmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VacationAdjustmentMutation
+}
+
+// Where appends a list predicates to the VacationAdjustmentUpdate builder.
+func (_u *VacationAdjustmentUpdate) Where(ps ...predicate.VacationAdjustment) *VacationAdjustmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *VacationAdjustmentUpdate) SetUserID(v int) *VacationAdjustmentUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *VacationAdjustmentUpdate) SetNillableUserID(v *int) *VacationAdjustmentUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *VacationAdjustmentUpdate) SetDate(v time.Time) *VacationAdjustmentUpdate {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *VacationAdjustmentUpdate) SetNillableDate(v *time.Time) *VacationAdjustmentUpdate {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetDays sets the "days" field.
+func (_u *VacationAdjustmentUpdate) SetDays(v float64) *VacationAdjustmentUpdate {
+	_u.mutation.ResetDays()
+	_u.mutation.SetDays(v)
+	return _u
+}
+
+// SetNillableDays sets the "days" field if the given value is not nil.
+func (_u *VacationAdjustmentUpdate) SetNillableDays(v *float64) *VacationAdjustmentUpdate {
+	if v != nil {
+		_u.SetDays(*v)
+	}
+	return _u
+}
+
+// AddDays adds value to the "days" field.
+func (_u *VacationAdjustmentUpdate) AddDays(v float64) *VacationAdjustmentUpdate {
+	_u.mutation.AddDays(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *VacationAdjustmentUpdate) SetReason(v string) *VacationAdjustmentUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *VacationAdjustmentUpdate) SetNillableReason(v *string) *VacationAdjustmentUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (_u *VacationAdjustmentUpdate) SetCreatedByID(v int) *VacationAdjustmentUpdate {
+	_u.mutation.SetCreatedByID(v)
+	return _u
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (_u *VacationAdjustmentUpdate) SetNillableCreatedByID(v *int) *VacationAdjustmentUpdate {
+	if v != nil {
+		_u.SetCreatedByID(*v)
+	}
+	return _u
+}
+
+// ClearCreatedByID clears the value of the "created_by_id" field.
+func (_u *VacationAdjustmentUpdate) ClearCreatedByID() *VacationAdjustmentUpdate {
+	_u.mutation.ClearCreatedByID()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *VacationAdjustmentUpdate) SetUser(v *User) *VacationAdjustmentUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (_u *VacationAdjustmentUpdate) SetCreatedBy(v *User) *VacationAdjustmentUpdate {
+	return _u.SetCreatedByID(v.ID)
+}
+
+// Mutation returns the VacationAdjustmentMutation object of the builder.
+func (_u *VacationAdjustmentUpdate) Mutation() *VacationAdjustmentMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *VacationAdjustmentUpdate) ClearUser() *VacationAdjustmentUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearCreatedBy clears the "created_by" edge to the User entity.
+func (_u *VacationAdjustmentUpdate) ClearCreatedBy() *VacationAdjustmentUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VacationAdjustmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VacationAdjustmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VacationAdjustmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VacationAdjustmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VacationAdjustmentUpdate) check() error {
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := vacationadjustment.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "VacationAdjustment.reason": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "VacationAdjustment.user"`)
+	}
+	return nil
+}
+
+func (_u *VacationAdjustmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vacationadjustment.Table, vacationadjustment.Columns, sqlgraph.NewFieldSpec(vacationadjustment.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(vacationadjustment.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Days(); ok {
+		_spec.SetField(vacationadjustment.FieldDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDays(); ok {
+		_spec.AddField(vacationadjustment.FieldDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(vacationadjustment.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vacationadjustment.UserTable,
+			Columns: []string{vacationadjustment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vacationadjustment.UserTable,
+			Columns: []string{vacationadjustment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CreatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vacationadjustment.CreatedByTable,
+			Columns: []string{vacationadjustment.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vacationadjustment.CreatedByTable,
+			Columns: []string{vacationadjustment.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vacationadjustment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VacationAdjustmentUpdateOne is the builder for updating a single VacationAdjustment entity.
+type VacationAdjustmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VacationAdjustmentMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *VacationAdjustmentUpdateOne) SetUserID(v int) *VacationAdjustmentUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *VacationAdjustmentUpdateOne) SetNillableUserID(v *int) *VacationAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *VacationAdjustmentUpdateOne) SetDate(v time.Time) *VacationAdjustmentUpdateOne {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *VacationAdjustmentUpdateOne) SetNillableDate(v *time.Time) *VacationAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetDays sets the "days" field.
+func (_u *VacationAdjustmentUpdateOne) SetDays(v float64) *VacationAdjustmentUpdateOne {
+	_u.mutation.ResetDays()
+	_u.mutation.SetDays(v)
+	return _u
+}
+
+// SetNillableDays sets the "days" field if the given value is not nil.
+func (_u *VacationAdjustmentUpdateOne) SetNillableDays(v *float64) *VacationAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetDays(*v)
+	}
+	return _u
+}
+
+// AddDays adds value to the "days" field.
+func (_u *VacationAdjustmentUpdateOne) AddDays(v float64) *VacationAdjustmentUpdateOne {
+	_u.mutation.AddDays(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *VacationAdjustmentUpdateOne) SetReason(v string) *VacationAdjustmentUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *VacationAdjustmentUpdateOne) SetNillableReason(v *string) *VacationAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (_u *VacationAdjustmentUpdateOne) SetCreatedByID(v int) *VacationAdjustmentUpdateOne {
+	_u.mutation.SetCreatedByID(v)
+	return _u
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (_u *VacationAdjustmentUpdateOne) SetNillableCreatedByID(v *int) *VacationAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetCreatedByID(*v)
+	}
+	return _u
+}
+
+// ClearCreatedByID clears the value of the "created_by_id" field.
+func (_u *VacationAdjustmentUpdateOne) ClearCreatedByID() *VacationAdjustmentUpdateOne {
+	_u.mutation.ClearCreatedByID()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *VacationAdjustmentUpdateOne) SetUser(v *User) *VacationAdjustmentUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (_u *VacationAdjustmentUpdateOne) SetCreatedBy(v *User) *VacationAdjustmentUpdateOne {
+	return _u.SetCreatedByID(v.ID)
+}
+
+// Mutation returns the VacationAdjustmentMutation object of the builder.
+func (_u *VacationAdjustmentUpdateOne) Mutation() *VacationAdjustmentMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *VacationAdjustmentUpdateOne) ClearUser() *VacationAdjustmentUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearCreatedBy clears the "created_by" edge to the User entity.
+func (_u *VacationAdjustmentUpdateOne) ClearCreatedBy() *VacationAdjustmentUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// Where appends a list predicates to the VacationAdjustmentUpdate builder.
+func (_u *VacationAdjustmentUpdateOne) Where(ps ...predicate.VacationAdjustment) *VacationAdjustmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VacationAdjustmentUpdateOne) Select(field string, fields ...string) *VacationAdjustmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated VacationAdjustment entity.
+func (_u *VacationAdjustmentUpdateOne) Save(ctx context.Context) (*VacationAdjustment, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VacationAdjustmentUpdateOne) SaveX(ctx context.Context) *VacationAdjustment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VacationAdjustmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VacationAdjustmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VacationAdjustmentUpdateOne) check() error {
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := vacationadjustment.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "VacationAdjustment.reason": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "VacationAdjustment.user"`)
+	}
+	return nil
+}
+
+func (_u *VacationAdjustmentUpdateOne) sqlSave(ctx context.Context) (_node *VacationAdjustment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vacationadjustment.Table, vacationadjustment.Columns, sqlgraph.NewFieldSpec(vacationadjustment.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VacationAdjustment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vacationadjustment.FieldID)
+		for _, f := range fields {
+			if !vacationadjustment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vacationadjustment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(vacationadjustment.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Days(); ok {
+		_spec.SetField(vacationadjustment.FieldDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDays(); ok {
+		_spec.AddField(vacationadjustment.FieldDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(vacationadjustment.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vacationadjustment.UserTable,
+			Columns: []string{vacationadjustment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vacationadjustment.UserTable,
+			Columns: []string{vacationadjustment.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CreatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vacationadjustment.CreatedByTable,
+			Columns: []string{vacationadjustment.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vacationadjustment.CreatedByTable,
+			Columns: []string{vacationadjustment.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &VacationAdjustment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vacationadjustment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"back/internal/auth"
 	"back/internal/ent"
 	"back/internal/services"
 )
@@ -153,7 +154,7 @@ func (h *AccessPointHandler) listForBranch(w http.ResponseWriter, r *http.Reques
 }
 
 func (h *AccessPointHandler) createForBranch(w http.ResponseWriter, r *http.Request, branchID int) {
-	if !isAdmin(r) {
+	if !hasPermission(r, auth.PermBranchesManage) {
 		http.Error(w, "Forbidden", http.StatusForbidden)
 		return
 	}
@@ -198,7 +199,7 @@ func (h *AccessPointHandler) createForBranch(w http.ResponseWriter, r *http.Requ
 }
 
 func (h *AccessPointHandler) patch(w http.ResponseWriter, r *http.Request, accessPointID int) {
-	if !isAdmin(r) {
+	if !hasPermission(r, auth.PermBranchesManage) {
 		http.Error(w, "Forbidden", http.StatusForbidden)
 		return
 	}
@@ -242,7 +243,7 @@ func (h *AccessPointHandler) patch(w http.ResponseWriter, r *http.Request, acces
 }
 
 func (h *AccessPointHandler) delete(w http.ResponseWriter, r *http.Request, accessPointID int) {
-	if !isAdmin(r) {
+	if !hasPermission(r, auth.PermBranchesManage) {
 		http.Error(w, "Forbidden", http.StatusForbidden)
 		return
 	}
@@ -4,8 +4,12 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
+	"back/internal/auth"
+	"back/internal/middleware"
 	"back/internal/services"
 )
 
@@ -17,14 +21,13 @@ func NewAttendanceHandler(svc *services.AttendanceService) *AttendanceHandler {
 	return &AttendanceHandler{Svc: svc}
 }
 
+// El access point y la dirección se toman del JWT del dispositivo, no del body.
 type validateQRRequest struct {
-	Token         string `json:"token"`
-	AccessPointID int    `json:"access_point_id"`
+	Token string `json:"token"`
 }
 
 type validateAccessCodeRequest struct {
-	AccessCode    string `json:"access_code"`
-	AccessPointID int    `json:"access_point_id"`
+	AccessCode string `json:"access_code"`
 }
 
 type validateQRResponse struct {
@@ -41,25 +44,89 @@ type validateQRResponse struct {
 	EarlyExitMinutes  *int    `json:"early_exit_minutes,omitempty"`
 }
 
+type syncPunchItemRequest struct {
+	ClientID   string `json:"client_id" example:"3f1c2b9e-8a4d-4c1e-9b7a-2d5e6f7a8b9c"`
+	Method     string `json:"method" example:"qr"` // "qr" | "access_code"
+	Token      string `json:"token,omitempty"`
+	AccessCode string `json:"access_code,omitempty" example:"123456"`
+	PunchedAt  string `json:"punched_at" example:"2026-03-20T08:02:11-03:00"`
+}
+
+type syncPunchesRequest struct {
+	Punches []syncPunchItemRequest `json:"punches"`
+}
+
+type SyncPunchResultDTO struct {
+	ClientID        string `json:"client_id" example:"3f1c2b9e-8a4d-4c1e-9b7a-2d5e6f7a8b9c"`
+	Status          string `json:"status" example:"accepted"` // accepted | duplicate | rejected
+	Reason          string `json:"reason,omitempty" example:"qr session expired"`
+	AttendanceDayID *int   `json:"attendance_day_id,omitempty" example:"120"`
+}
+
+type SyncPunchesResponse struct {
+	Accepted  int                  `json:"accepted" example:"10"`
+	Duplicate int                  `json:"duplicate" example:"2"`
+	Rejected  int                  `json:"rejected" example:"1"`
+	Results   []SyncPunchResultDTO `json:"results"`
+}
+
+// wrongDirectionResponse se devuelve (409) cuando se marca en el lector equivocado,
+// para que el kiosko pueda indicar qué lector usar.
+type wrongDirectionResponse struct {
+	Error             string `json:"error" example:"wrong_direction"`
+	Message           string `json:"message"`
+	DeviceDirection   string `json:"device_direction" example:"in"`
+	ExpectedDirection string `json:"expected_direction" example:"out"`
+	ExpectedSlot      string `json:"expected_slot" example:"break_out"`
+}
+
+type PunchDTO struct {
+	ID              int       `json:"id" example:"1"`
+	UserID          int       `json:"user_id" example:"10"`
+	BranchID        int       `json:"branch_id" example:"1"`
+	AccessPointID   *int      `json:"access_point_id,omitempty" example:"3"`
+	DeviceID        *int      `json:"device_id,omitempty" example:"2"`
+	AttendanceDayID *int      `json:"attendance_day_id,omitempty" example:"120"`
+	PunchedAt       time.Time `json:"punched_at"`
+	Method          string    `json:"method" example:"qr"`
+	Direction       *string   `json:"direction,omitempty" example:"in"`
+	RawPayload      *string   `json:"raw_payload,omitempty"`
+	// motivo si la marcación se rechazó (wrong_direction | already_completed | not_work_day | no_shift)
+	RejectedReason  *string   `json:"rejected_reason,omitempty" example:"wrong_direction"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
 func (h *AttendanceHandler) ValidateQR(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
 		return
 	}
 
+	dev, ok := attendanceDeviceFromRequest(r)
+	if !ok {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
 	var req validateQRRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "Bad Request", http.StatusBadRequest)
 		return
 	}
-	if req.Token == "" || req.AccessPointID <= 0 {
-		http.Error(w, "token and access_point_id are required", http.StatusBadRequest)
+	if req.Token == "" {
+		http.Error(w, "token is required", http.StatusBadRequest)
 		return
 	}
 
-	attendance, err := h.Svc.ValidateAndRecordAttendance(r.Context(), req.Token, req.AccessPointID)
+	attendance, err := h.Svc.ValidateAndRecordAttendance(r.Context(), req.Token, dev)
 	if err != nil {
 		switch {
+		case errors.Is(err, services.ErrAttendanceDeviceNotAllowed):
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		case errors.Is(err, services.ErrAttendanceWrongDirection):
+			writeWrongDirection(w, err)
+			return
 		case errors.Is(err, services.ErrAttendanceInvalidInput),
 			errors.Is(err, services.ErrAttendanceAlreadyCompleted),
 			errors.Is(err, services.ErrAttendanceNotWorkDay),
@@ -120,19 +187,31 @@ func (h *AttendanceHandler) ValidateAccessCode(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	dev, ok := attendanceDeviceFromRequest(r)
+	if !ok {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
 	var req validateAccessCodeRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "Bad Request", http.StatusBadRequest)
 		return
 	}
-	if req.AccessCode == "" || req.AccessPointID <= 0 {
-		http.Error(w, "access_code and access_point_id are required", http.StatusBadRequest)
+	if req.AccessCode == "" {
+		http.Error(w, "access_code is required", http.StatusBadRequest)
 		return
 	}
 
-	attendance, err := h.Svc.ValidateAndRecordAttendanceByAccessCode(r.Context(), req.AccessCode, req.AccessPointID)
+	attendance, err := h.Svc.ValidateAndRecordAttendanceByAccessCode(r.Context(), req.AccessCode, dev)
 	if err != nil {
 		switch {
+		case errors.Is(err, services.ErrAttendanceDeviceNotAllowed):
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		case errors.Is(err, services.ErrAttendanceWrongDirection):
+			writeWrongDirection(w, err)
+			return
 		case errors.Is(err, services.ErrAttendanceInvalidInput),
 			errors.Is(err, services.ErrAttendanceAlreadyCompleted),
 			errors.Is(err, services.ErrAttendanceNotWorkDay),
@@ -183,3 +262,202 @@ func (h *AttendanceHandler) ValidateAccessCode(w http.ResponseWriter, r *http.Re
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(resp)
 }
+
+func writeWrongDirection(w http.ResponseWriter, err error) {
+	resp := wrongDirectionResponse{
+		Error:   "wrong_direction",
+		Message: err.Error(),
+	}
+	var dirErr *services.AttendanceDirectionError
+	if errors.As(err, &dirErr) {
+		resp.DeviceDirection = dirErr.DeviceDirection
+		resp.ExpectedDirection = dirErr.ExpectedDirection
+		resp.ExpectedSlot = dirErr.ExpectedSlot
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusConflict)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// attendanceDeviceFromRequest arma la identidad del dispositivo desde los claims del JWT.
+// Solo tokens emitidos por /device-auth/login (role "device" con access point) son válidos.
+func attendanceDeviceFromRequest(r *http.Request) (services.AttendanceDevice, bool) {
+	claims, ok := middleware.GetClaims(r)
+	if !ok || claims == nil {
+		return services.AttendanceDevice{}, false
+	}
+	if strings.ToLower(strings.TrimSpace(claims.Role)) != "device" || claims.AccessPointID <= 0 {
+		return services.AttendanceDevice{}, false
+	}
+
+	deviceID, err := strconv.Atoi(claims.Subject)
+	if err != nil || deviceID <= 0 {
+		return services.AttendanceDevice{}, false
+	}
+
+	return services.AttendanceDevice{
+		DeviceID:      deviceID,
+		AccessPointID: claims.AccessPointID,
+		Direction:     claims.Direction,
+	}, true
+}
+
+// SyncPunches godoc
+// @Summary      Sincronizar marcaciones offline
+// @Description  El dispositivo envía las marcaciones encoladas sin conexión con su hora original y un UUID propio. Se aplican en orden cronológico e idempotentes por client_id.
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      syncPunchesRequest  true  "Lote de marcaciones"
+// @Success      200   {object}  SyncPunchesResponse
+// @Failure      400   {object}  ErrorResponse
+// @Failure      401   {object}  ErrorResponse
+// @Failure      403   {object}  ErrorResponse
+// @Failure      500   {object}  ErrorResponse
+// @Router       /api/v1/attendance/sync [post]
+func (h *AttendanceHandler) SyncPunches(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	dev, ok := attendanceDeviceFromRequest(r)
+	if !ok {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	var req syncPunchesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+	if len(req.Punches) == 0 {
+		http.Error(w, "punches is required", http.StatusBadRequest)
+		return
+	}
+
+	items := make([]services.SyncPunchInput, 0, len(req.Punches))
+	for _, p := range req.Punches {
+		// una fecha inválida se informa como rechazo del ítem (PunchedAt queda en cero)
+		punchedAt, _ := time.Parse(time.RFC3339, p.PunchedAt)
+		items = append(items, services.SyncPunchInput{
+			ClientID:   p.ClientID,
+			Method:     p.Method,
+			Token:      p.Token,
+			AccessCode: p.AccessCode,
+			PunchedAt:  punchedAt,
+		})
+	}
+
+	results, err := h.Svc.SyncDevicePunches(r.Context(), dev, items)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrAttendanceDeviceNotAllowed):
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		case errors.Is(err, services.ErrAttendanceInvalidInput),
+			errors.Is(err, services.ErrAttendanceSyncBatchTooLarge):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		default:
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	resp := SyncPunchesResponse{Results: make([]SyncPunchResultDTO, 0, len(results))}
+	for _, res := range results {
+		switch res.Status {
+		case services.SyncStatusAccepted:
+			resp.Accepted++
+		case services.SyncStatusDuplicate:
+			resp.Duplicate++
+		default:
+			resp.Rejected++
+		}
+		resp.Results = append(resp.Results, SyncPunchResultDTO{
+			ClientID:        res.ClientID,
+			Status:          res.Status,
+			Reason:          res.Reason,
+			AttendanceDayID: res.AttendanceDayID,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// UserPunches godoc
+// @Summary      Marcaciones crudas del usuario
+// @Description  Lista cada marcación física registrada (incluidas las que exceden los 4 slots del día y las rechazadas, con rejected_reason y sin attendance_day_id). Un usuario con sucursales asignadas solo ve a los usuarios de sus sucursales.
+// @Tags         Attendance
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id          path   int     true   "ID del usuario"
+// @Param        start_date  query  string  false  "Desde (YYYY-MM-DD)"
+// @Param        end_date    query  string  false  "Hasta (YYYY-MM-DD)"
+// @Success      200  {array}   PunchDTO
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/{id}/punches [get]
+func (h *AttendanceHandler) UserPunches(w http.ResponseWriter, r *http.Request, userID int) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !hasPermission(r, auth.PermMarkingsRead) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	from, err := parseOptionalDate(r.URL.Query().Get("start_date"))
+	if err != nil {
+		http.Error(w, "start_date invalido", http.StatusBadRequest)
+		return
+	}
+	to, err := parseOptionalDate(r.URL.Query().Get("end_date"))
+	if err != nil {
+		http.Error(w, "end_date invalido", http.StatusBadRequest)
+		return
+	}
+
+	items, err := h.Svc.ListPunchesForUser(r.Context(), userID, from, to)
+	if err != nil {
+		if errors.Is(err, services.ErrAttendanceInvalidInput) {
+			http.Error(w, "Bad Request", http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, services.ErrOutOfScope) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]PunchDTO, 0, len(items))
+	for _, p := range items {
+		resp = append(resp, PunchDTO{
+			ID:              p.ID,
+			UserID:          p.UserID,
+			BranchID:        p.BranchID,
+			AccessPointID:   p.AccessPointID,
+			DeviceID:        p.DeviceID,
+			AttendanceDayID: p.AttendanceDayID,
+			PunchedAt:       p.PunchedAt,
+			Method:          p.Method,
+			Direction:       p.Direction,
+			RawPayload:      p.RawPayload,
+			RejectedReason:  p.RejectedReason,
+			CreatedAt:       p.CreatedAt,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"back/internal/services"
+)
+
+type AuditHandler struct {
+	Svc *services.AuditService
+}
+
+func NewAuditHandler(svc *services.AuditService) *AuditHandler {
+	return &AuditHandler{Svc: svc}
+}
+
+/* =========================
+   DTOs
+   ========================= */
+
+type AuditLogDTO struct {
+	ID            int            `json:"id" example:"1"`
+	ActorID       *int           `json:"actor_id,omitempty" example:"1"`
+	ActorDeviceID *int           `json:"actor_device_id,omitempty" example:"2"`
+	RequestID     *string        `json:"request_id,omitempty" example:"9f1c2b7a4d5e6f708192a3b4c5d6e7f8"`
+	EntityType    string         `json:"entity_type" example:"User"`
+	EntityID      *int           `json:"entity_id,omitempty" example:"10"`
+	Operation     string         `json:"operation" example:"update"`
+	Changes       map[string]any `json:"changes,omitempty" swaggertype:"object"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
+type AuditLogPagination struct {
+	Page       int `json:"page" example:"1"`
+	Limit      int `json:"limit" example:"50"`
+	Total      int `json:"total" example:"120"`
+	TotalPages int `json:"total_pages" example:"3"`
+}
+
+type AuditLogListResponse struct {
+	Pagination AuditLogPagination `json:"pagination"`
+	Items      []AuditLogDTO      `json:"items"`
+}
+
+/* =========================
+   ROUTES
+   ========================= */
+
+// AuditLogs godoc
+// @Summary      Registro de auditoría
+// @Description  Cambios administrativos (creación, edición y eliminación de usuarios, sucursales, accesos, dispositivos, turnos, asignaciones y roles) con el actor, el request ID y el diff de campos; los campos sensibles (password_hash, access_code) aparecen redactados. Más recientes primero. Requiere audit:read.
+// @Tags         Audit
+// @Produce      json
+// @Security     BearerAuth
+// @Param        entity_type  query    string  false "User | Branch | AccessPoint | Device | Shift | ShiftDay | ShiftCycleDay | UserShiftAssignment | UserBranch | UserAccessPoint | Role"
+// @Param        entity_id    query    int     false "ID de la entidad"
+// @Param        actor_id     query    int     false "Usuario que hizo el cambio"
+// @Param        actor_device_id query int   false "Dispositivo que hizo el cambio"
+// @Param        operation    query    string  false "create | update | delete"
+// @Param        request_id   query    string  false "X-Request-Id de la petición"
+// @Param        start_date   query    string  false "Desde (YYYY-MM-DD)"
+// @Param        end_date     query    string  false "Hasta (YYYY-MM-DD)"
+// @Param        page         query    int     false "Página"
+// @Param        limit        query    int     false "Tamaño de página (máx. 200)"
+// @Success      200          {object} AuditLogListResponse
+// @Failure      400          {object} ErrorResponse
+// @Failure      401          {object} ErrorResponse
+// @Failure      403          {object} ErrorResponse
+// @Failure      500          {object} ErrorResponse
+// @Router       /api/v1/audit-logs [get]
+func (h *AuditHandler) AuditLogs(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	q := r.URL.Query()
+	f := services.AuditLogFilters{Page: 1, Limit: 50}
+	var err error
+
+	if v := q.Get("entity_type"); v != "" {
+		f.EntityType = &v
+	}
+	if f.EntityID, err = parseOptionalPositiveInt(q.Get("entity_id")); err != nil {
+		http.Error(w, "entity_id invalido", http.StatusBadRequest)
+		return
+	}
+	if f.ActorID, err = parseOptionalPositiveInt(q.Get("actor_id")); err != nil {
+		http.Error(w, "actor_id invalido", http.StatusBadRequest)
+		return
+	}
+	if f.ActorDeviceID, err = parseOptionalPositiveInt(q.Get("actor_device_id")); err != nil {
+		http.Error(w, "actor_device_id invalido", http.StatusBadRequest)
+		return
+	}
+	if v := q.Get("operation"); v != "" {
+		f.Operation = &v
+	}
+	if v := q.Get("request_id"); v != "" {
+		f.RequestID = &v
+	}
+	if f.StartDate, err = parseOptionalDate(q.Get("start_date")); err != nil {
+		http.Error(w, "start_date invalido (formato: YYYY-MM-DD)", http.StatusBadRequest)
+		return
+	}
+	if f.EndDate, err = parseOptionalDate(q.Get("end_date")); err != nil {
+		http.Error(w, "end_date invalido (formato: YYYY-MM-DD)", http.StatusBadRequest)
+		return
+	}
+	if v := q.Get("page"); v != "" {
+		n, convErr := strconv.Atoi(v)
+		if convErr != nil || n <= 0 {
+			http.Error(w, "page invalido", http.StatusBadRequest)
+			return
+		}
+		f.Page = n
+	}
+	if v := q.Get("limit"); v != "" {
+		n, convErr := strconv.Atoi(v)
+		if convErr != nil || n <= 0 {
+			http.Error(w, "limit invalido", http.StatusBadRequest)
+			return
+		}
+		f.Limit = min(n, 200)
+	}
+
+	items, total, err := h.Svc.List(r.Context(), f)
+	if err != nil {
+		if errors.Is(err, services.ErrAuditInvalidInput) {
+			http.Error(w, "end_date debe ser posterior a start_date", http.StatusBadRequest)
+			return
+		}
+		log.Printf("[audit] error listing audit logs: %v", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	resp := AuditLogListResponse{
+		Pagination: AuditLogPagination{
+			Page:       f.Page,
+			Limit:      f.Limit,
+			Total:      total,
+			TotalPages: int(math.Ceil(float64(total) / float64(f.Limit))),
+		},
+		Items: make([]AuditLogDTO, 0, len(items)),
+	}
+	for _, it := range items {
+		resp.Items = append(resp.Items, AuditLogDTO{
+			ID:            it.ID,
+			ActorID:       it.ActorID,
+			ActorDeviceID: it.ActorDeviceID,
+			RequestID:     it.RequestID,
+			EntityType:    it.EntityType,
+			EntityID:      it.EntityID,
+			Operation:     it.Operation,
+			Changes:       it.Changes,
+			CreatedAt:     it.CreatedAt,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
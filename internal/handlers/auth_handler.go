@@ -119,6 +119,8 @@ type MeCurrentShift struct {
 	BreakMinutes  int      `json:"break_minutes"`
 	CrossesMidnight bool   `json:"crosses_midnight"`
 	WorkDays      []string `json:"work_days"`
+	PatternType   string   `json:"pattern_type"`
+	CycleLength   *int     `json:"cycle_length,omitempty"`
 }
 
 type MeTodaySummary struct {
@@ -154,6 +156,7 @@ type MeResponse struct {
 	ID               int                  `json:"id" example:"1"`
 	Username         string               `json:"username" example:"admin"`
 	Role             string               `json:"role" example:"admin"`
+	Permissions      []string             `json:"permissions" example:"self,users:read"`
 	IsActive         bool                 `json:"is_active" example:"true"`
 	Issuer           string               `json:"issuer" example:"dominio-api-development"`
 	Audience         []string             `json:"audience" example:"web,ios,android"`
@@ -420,6 +423,7 @@ func (h *AuthHandler) Me(w http.ResponseWriter, r *http.Request) {
 		ID:                u.ID,
 		Username:          u.Username,
 		Role:              u.Role,
+		Permissions:       claims.PermissionList(),
 		IsActive:          u.IsActive,
 		Issuer:            claims.Issuer,
 		Audience:          []string(claims.Audience),
@@ -509,6 +513,8 @@ func mapMeCurrentShift(shift *ent.Shift) *MeCurrentShift {
 		BreakMinutes:     shift.BreakMinutes,
 		CrossesMidnight:  shift.CrossesMidnight,
 		WorkDays:         workDays,
+		PatternType:      shift.PatternType,
+		CycleLength:      shift.CycleLength,
 	}
 }
 
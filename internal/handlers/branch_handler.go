@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"back/internal/auth"
 	"back/internal/ent"
 	"back/internal/middleware"
 	"back/internal/services"
@@ -211,7 +212,7 @@ func (h *BranchHandler) Detail(w http.ResponseWriter, r *http.Request, branchID
 }
 
 func (h *BranchHandler) Create(w http.ResponseWriter, r *http.Request) {
-	if !isAdmin(r) {
+	if !hasPermission(r, auth.PermBranchesManage) {
 		http.Error(w, "Forbidden", http.StatusForbidden)
 		return
 	}
@@ -252,7 +253,7 @@ func (h *BranchHandler) Create(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *BranchHandler) Patch(w http.ResponseWriter, r *http.Request, branchID int) {
-	if !isAdmin(r) {
+	if !hasPermission(r, auth.PermBranchesManage) {
 		http.Error(w, "Forbidden", http.StatusForbidden)
 		return
 	}
@@ -300,7 +301,7 @@ func (h *BranchHandler) Patch(w http.ResponseWriter, r *http.Request, branchID i
 }
 
 func (h *BranchHandler) Delete(w http.ResponseWriter, r *http.Request, branchID int) {
-	if !isAdmin(r) {
+	if !hasPermission(r, auth.PermBranchesManage) {
 		http.Error(w, "Forbidden", http.StatusForbidden)
 		return
 	}
@@ -337,12 +338,32 @@ func parseBranchIDFromPath(path string) (int, bool) {
 	return id, true
 }
 
-func isAdmin(r *http.Request) bool {
+// hasPermission indica si el token del usuario incluye el permiso (auth.Perm*).
+func hasPermission(r *http.Request, perm string) bool {
 	claims, ok := middleware.GetClaims(r)
-	if !ok {
-		return false
+	return ok && claims.HasPermission(perm)
+}
+
+// isSelfOr: el propio usuario o quien tenga el permiso.
+func isSelfOr(r *http.Request, userID int, perm string) bool {
+	if hasPermission(r, perm) {
+		return true
+	}
+	callerID, ok := callerUserID(r)
+	return ok && callerID == userID
+}
+
+// callerUserID devuelve el ID del usuario autenticado (subject del JWT).
+func callerUserID(r *http.Request) (int, bool) {
+	claims, ok := middleware.GetClaims(r)
+	if !ok || claims.Subject == "" {
+		return 0, false
+	}
+	userID, err := strconv.Atoi(claims.Subject)
+	if err != nil || userID <= 0 {
+		return 0, false
 	}
-	return strings.ToLower(claims.Role) == "admin"
+	return userID, true
 }
 
 func mapBranchAddress(a *ent.BranchAddress) *BranchAddressDTO {
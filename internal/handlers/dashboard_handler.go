@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"back/internal/services"
+	"back/internal/xlsx"
 )
 
 type DashboardHandler struct {
@@ -75,6 +76,10 @@ func (h *DashboardHandler) Stats(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, "range invalido", http.StatusBadRequest)
 			return
 		}
+		if errors.Is(err, services.ErrOutOfScope) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
 
 		log.Printf(
 			"[dashboard] error getting stats: range=%s branch_id=%v start=%v end=%v err=%v",
@@ -169,6 +174,10 @@ func (h *DashboardHandler) Live(w http.ResponseWriter, r *http.Request) {
 		EndDate:   endDate,
 	})
 	if err != nil {
+		if errors.Is(err, services.ErrOutOfScope) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
 		log.Printf("[dashboard] error getting live data: branch_id=%v limit=%d err=%v", branchID, limit, err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -201,6 +210,10 @@ func (h *DashboardHandler) Punctuality(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.Svc.GetPunctuality(r.Context(), branchID)
 	if err != nil {
+		if errors.Is(err, services.ErrOutOfScope) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
 		log.Printf("[dashboard] error getting punctuality: branch_id=%v err=%v", branchID, err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -270,16 +283,171 @@ func (h *DashboardHandler) Export(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, "range invalido", http.StatusBadRequest)
 			return
 		}
+		if errors.Is(err, services.ErrOutOfScope) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
 		log.Printf("[dashboard] error getting export: range=%s branch_id=%v start=%v end=%v err=%v",
 			rangeValue, branchID, startDate, endDate, err)
 		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
 		return
 	}
 
+	if exportFormat(r) == exportFormatXLSX {
+		if err := writeDashboardXLSX(w, resp); err != nil {
+			log.Printf("[dashboard] error writing xlsx export: %v", err)
+		}
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	if err := json.NewEncoder(w).Encode(resp); err != nil {
 		log.Printf("[dashboard] error encoding export response: %v", err)
 		http.Error(w, "error serializando respuesta", http.StatusInternalServerError)
 		return
 	}
-}
\ No newline at end of file
+}
+
+// writeDashboardXLSX genera el Excel del dashboard con las hojas Resumen,
+// Marcaciones, Dentro ahora y Puntualidad.
+func writeDashboardXLSX(w http.ResponseWriter, resp *services.DashboardExportResponse) error {
+	xw := startXLSXDownload(w, "dashboard.xlsx")
+
+	// Resumen: filtros, indicadores y datos de los gráficos
+	if err := xw.AddSheet("Resumen"); err != nil {
+		return err
+	}
+	branch := "Todas"
+	if resp.Filters.BranchID != nil {
+		branch = strconv.Itoa(*resp.Filters.BranchID)
+	}
+	var startDate, endDate any
+	if resp.Filters.StartDate != nil {
+		startDate = xlsx.Date(*resp.Filters.StartDate)
+	}
+	if resp.Filters.EndDate != nil {
+		endDate = xlsx.Date(*resp.Filters.EndDate)
+	}
+
+	sections := [][][]any{
+		{
+			{"Filtros"},
+			{"Rango", resp.Filters.Range},
+			{"Sucursal", branch},
+			{"Desde", startDate},
+			{"Hasta", endDate},
+		},
+		{
+			{"Resumen del día"},
+			{"Marcaciones hoy", resp.Summary.MarkingsToday},
+			{"Personas dentro", resp.Summary.PeopleInside},
+			{"Atrasos", resp.Summary.LateArrivals},
+			{"Alertas", resp.Summary.Alerts},
+			{"Ausencias justificadas", resp.Summary.JustifiedAbsences},
+			{"Variación vs ayer (%)", resp.Summary.MarkingsVsYesterdayPct},
+		},
+		{
+			{"Entradas vs salidas"},
+			{"Entradas", resp.Charts.EntriesVsExits.Entries},
+			{"Salidas", resp.Charts.EntriesVsExits.Exits},
+		},
+		{
+			{"Estado actual"},
+			{"Dentro", resp.Charts.CurrentStatus.Inside},
+			{"Fuera", resp.Charts.CurrentStatus.Outside},
+			{"Sin marcación", resp.Charts.CurrentStatus.NoMark},
+		},
+	}
+
+	byDay := [][]any{{"Marcaciones por día"}}
+	for _, p := range resp.Charts.MarkingsLast7Days {
+		byDay = append(byDay, []any{p.Label, p.Value})
+	}
+	byHour := [][]any{{"Actividad por hora"}}
+	for _, p := range resp.Charts.ActivityByHour {
+		byHour = append(byHour, []any{p.Label, p.Value})
+	}
+	topLates := [][]any{{"Top atrasos", "Sucursal", "Minutos"}}
+	for _, t := range resp.Charts.TopLates {
+		topLates = append(topLates, []any{t.Name, t.Branch, t.MinutesLate})
+	}
+	topBranches := [][]any{{"Top sucursales", "Movimientos"}}
+	for _, t := range resp.Charts.TopBranches {
+		topBranches = append(topBranches, []any{t.Name, t.Count})
+	}
+	sections = append(sections, byDay, byHour, topLates, topBranches)
+
+	for i, section := range sections {
+		if i > 0 {
+			if err := xw.WriteRow(); err != nil {
+				return err
+			}
+		}
+		for j, row := range section {
+			var err error
+			if j == 0 {
+				header := make([]string, len(row))
+				for k, c := range row {
+					header[k], _ = c.(string)
+				}
+				err = xw.WriteHeader(header...)
+			} else {
+				err = xw.WriteRow(row...)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Marcaciones del rango
+	if err := xw.AddSheet("Marcaciones"); err != nil {
+		return err
+	}
+	if err := xw.WriteHeader("ID", "Fecha y hora", "Usuario ID", "Nombre", "Tipo", "Sucursal"); err != nil {
+		return err
+	}
+	for _, m := range resp.Markings {
+		if err := xw.WriteRow(m.MarkingID, m.MarkedAt, m.UserID, m.Name, m.Type, m.BranchName); err != nil {
+			return err
+		}
+	}
+
+	// Personas dentro ahora
+	if err := xw.AddSheet("Dentro ahora"); err != nil {
+		return err
+	}
+	if err := xw.WriteHeader("Usuario ID", "Nombre", "Sucursal", "Entrada"); err != nil {
+		return err
+	}
+	for _, in := range resp.InsideNow {
+		if err := xw.WriteRow(in.UserID, in.Name, in.BranchName, in.EnteredAt); err != nil {
+			return err
+		}
+	}
+
+	// Puntualidad
+	if err := xw.AddSheet("Puntualidad"); err != nil {
+		return err
+	}
+	if err := xw.WriteHeader(
+		"Usuario ID", "Nombre", "Sucursal", "Turno", "Inicio", "Término", "Colación (min)",
+		"Entrada", "Salida colación", "Regreso colación", "Salida",
+		"Dif. entrada (min)", "Estado entrada", "Dif. colación (min)", "Estado colación",
+		"Dif. salida (min)", "Estado salida",
+	); err != nil {
+		return err
+	}
+	for _, p := range resp.Punctuality {
+		if err := xw.WriteRow(
+			p.UserID, p.Name, p.BranchName, p.ShiftName, p.StartTime, p.EndTime, p.BreakMinutes,
+			p.WorkInAt, p.BreakOutAt, p.BreakInAt, p.WorkOutAt,
+			p.EntryDiffMinutes, p.EntryStatus, p.BreakDiffMinutes, p.BreakStatus,
+			p.ExitDiffMinutes, p.ExitStatus,
+		); err != nil {
+			return err
+		}
+	}
+
+	return xw.Close()
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"back/internal/auth"
 	"back/internal/ent"
 	"back/internal/services"
 )
@@ -150,7 +151,7 @@ func (h *DeviceHandler) listForAccessPoint(w http.ResponseWriter, r *http.Reques
 }
 
 func (h *DeviceHandler) createForAccessPoint(w http.ResponseWriter, r *http.Request, apID int) {
-	if !isAdmin(r) {
+	if !hasPermission(r, auth.PermDevicesManage) {
 		http.Error(w, "Forbidden", http.StatusForbidden)
 		return
 	}
@@ -206,7 +207,7 @@ func (h *DeviceHandler) createForAccessPoint(w http.ResponseWriter, r *http.Requ
 }
 
 func (h *DeviceHandler) patch(w http.ResponseWriter, r *http.Request, devID int) {
-	if !isAdmin(r) {
+	if !hasPermission(r, auth.PermDevicesManage) {
 		http.Error(w, "Forbidden", http.StatusForbidden)
 		return
 	}
@@ -261,7 +262,7 @@ func (h *DeviceHandler) patch(w http.ResponseWriter, r *http.Request, devID int)
 }
 
 func (h *DeviceHandler) delete(w http.ResponseWriter, r *http.Request, devID int) {
-	if !isAdmin(r) {
+	if !hasPermission(r, auth.PermDevicesManage) {
 		http.Error(w, "Forbidden", http.StatusForbidden)
 		return
 	}
@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"back/internal/auth"
+	"back/internal/ent"
+	"back/internal/pdf"
+	"back/internal/services"
+)
+
+type DTReportHandler struct {
+	Svc *services.DTReportService
+}
+
+func NewDTReportHandler(svc *services.DTReportService) *DTReportHandler {
+	return &DTReportHandler{Svc: svc}
+}
+
+/* =========================
+   ROUTES
+   ========================= */
+
+// DTReport godoc
+// @Summary      Reportes Dirección del Trabajo
+// @Description  Genera los reportes exigidos a los sistemas electrónicos de registro de asistencia: asistencia diaria, domingos y festivos, modificaciones de marcaciones y alteraciones de turnos. Por trabajador (user_id) o por sucursal (branch_id). Supervisor/admin; un usuario solo puede descargar sus propios reportes.
+// @Tags         Reports
+// @Produce      application/pdf
+// @Produce      text/csv
+// @Security     BearerAuth
+// @Param        type        query    string  true  "attendance | sunday_holiday | modifications | shift_changes"
+// @Param        format      query    string  false "pdf | csv (por defecto pdf)"
+// @Param        user_id     query    int     false "Trabajador"
+// @Param        branch_id   query    int     false "Sucursal"
+// @Param        start_date  query    string  true  "Desde (YYYY-MM-DD)"
+// @Param        end_date    query    string  true  "Hasta (YYYY-MM-DD)"
+// @Success      200         {file}   file
+// @Failure      400         {object} ErrorResponse
+// @Failure      401         {object} ErrorResponse
+// @Failure      403         {object} ErrorResponse
+// @Failure      404         {object} ErrorResponse
+// @Failure      500         {object} ErrorResponse
+// @Router       /api/v1/reports/dt [get]
+func (h *DTReportHandler) DTReport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	callerID, ok := callerUserID(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	q := r.URL.Query()
+
+	userID, err := parseOptionalPositiveInt(q.Get("user_id"))
+	if err != nil {
+		http.Error(w, "user_id invalido", http.StatusBadRequest)
+		return
+	}
+	branchID, err := parseOptionalPositiveInt(q.Get("branch_id"))
+	if err != nil {
+		http.Error(w, "branch_id invalido", http.StatusBadRequest)
+		return
+	}
+	if !hasPermission(r, auth.PermReportsExport) {
+		// usuario normal: solo su propio reporte
+		if branchID != nil || (userID != nil && *userID != callerID) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		userID = &callerID
+	}
+
+	start, err := time.Parse("2006-01-02", q.Get("start_date"))
+	if err != nil {
+		http.Error(w, "start_date invalido", http.StatusBadRequest)
+		return
+	}
+	end, err := time.Parse("2006-01-02", q.Get("end_date"))
+	if err != nil {
+		http.Error(w, "end_date invalido", http.StatusBadRequest)
+		return
+	}
+
+	format := strings.ToLower(strings.TrimSpace(q.Get("format")))
+	if format == "" {
+		format = "pdf"
+	}
+	if format != "pdf" && format != "csv" {
+		http.Error(w, "format must be 'pdf' or 'csv'", http.StatusBadRequest)
+		return
+	}
+
+	report, err := h.Svc.Generate(r.Context(), services.DTReportFilters{
+		Type:      strings.ToLower(strings.TrimSpace(q.Get("type"))),
+		UserID:    userID,
+		BranchID:  branchID,
+		StartDate: start,
+		EndDate:   end,
+	})
+	if err != nil {
+		writeDTReportError(w, err)
+		return
+	}
+
+	filename := fmt.Sprintf("reporte_dt_%s_%s_%s.%s",
+		report.Type, report.From.Format("20060102"), report.To.Format("20060102"), format)
+
+	var body []byte
+	if format == "csv" {
+		body, err = renderDTReportCSV(report)
+		if err != nil {
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	} else {
+		body = renderDTReportPDF(report)
+		w.Header().Set("Content-Type", "application/pdf")
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	_, _ = w.Write(body)
+}
+
+/* =========================
+   INTERNAL
+   ========================= */
+
+func writeDTReportError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrDTReportInvalidInput):
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+	case errors.Is(err, services.ErrOutOfScope):
+		http.Error(w, "Forbidden", http.StatusForbidden)
+	case ent.IsNotFound(err):
+		http.Error(w, "Not Found", http.StatusNotFound)
+	default:
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
+
+// renderDTReportCSV genera un CSV plano (una fila por registro, con el trabajador
+// en las primeras columnas). Incluye BOM para que Excel respete los acentos.
+func renderDTReportCSV(report *services.DTReport) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("\xEF\xBB\xBF")
+
+	cw := csv.NewWriter(&buf)
+	header := append([]string{"Trabajador", "Código"}, report.Columns...)
+	if err := cw.Write(header); err != nil {
+		return nil, err
+	}
+
+	for _, wk := range report.Workers {
+		for _, row := range wk.Rows {
+			if err := cw.Write(append([]string{wk.Name, wk.EmployeeCode}, row...)); err != nil {
+				return nil, err
+			}
+		}
+		if wk.Totals != nil {
+			if err := cw.Write(append([]string{wk.Name, wk.EmployeeCode}, wk.Totals...)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// anchos relativos de columnas por tipo de reporte (PDF)
+var dtReportColumnWidths = map[string][]float64{
+	services.DTReportAttendance:    {1.4, 1.2, 1.6, 1, 1.1, 1.1, 1, 1, 1.3, 1.1, 1.1, 3.5},
+	services.DTReportSundayHoliday: {1.2, 2.5, 2, 1, 1, 1.2, 2},
+	services.DTReportModifications: {1.2, 1.8, 1, 1.1, 1.1, 1, 1.6, 4},
+	services.DTReportShiftChanges:  {1.2, 1.6, 2, 1.2, 4, 1.6},
+}
+
+// renderDTReportPDF genera el PDF apaisado con una sección (encabezado + tabla) por trabajador.
+func renderDTReportPDF(report *services.DTReport) []byte {
+	doc := pdf.New(true)
+	doc.Footer = fmt.Sprintf("%s - generado el %s", report.Title, report.GeneratedAt.Format("2006-01-02 15:04"))
+
+	doc.Heading(report.Title)
+	doc.Text(fmt.Sprintf("Período: %s al %s", report.From.Format("2006-01-02"), report.To.Format("2006-01-02")))
+	if report.BranchName != nil {
+		doc.Text("Sucursal: " + *report.BranchName)
+	}
+
+	if len(report.Workers) == 0 {
+		doc.Spacer(8)
+		doc.Text("Sin trabajadores para los filtros indicados.")
+		return doc.Bytes()
+	}
+
+	for i, wk := range report.Workers {
+		if i > 0 {
+			doc.AddPage()
+		} else {
+			doc.Spacer(8)
+		}
+
+		title := "Trabajador: " + wk.Name
+		if wk.EmployeeCode != "" {
+			title += " (código " + wk.EmployeeCode + ")"
+		}
+		doc.BoldText(title)
+		doc.Spacer(2)
+
+		rows := wk.Rows
+		bold := map[int]bool{}
+		if wk.Totals != nil {
+			rows = append(append([][]string{}, rows...), wk.Totals)
+			bold[len(rows)-1] = true
+		}
+		if len(rows) == 0 {
+			doc.Text("Sin registros en el período.")
+			continue
+		}
+		doc.Table(report.Columns, dtReportColumnWidths[report.Type], rows, bold)
+	}
+
+	return doc.Bytes()
+}
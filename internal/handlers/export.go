@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"back/internal/xlsx"
+)
+
+const (
+	exportFormatJSON = "json"
+	exportFormatXLSX = "xlsx"
+	exportFormatCSV  = "csv"
+)
+
+// exportFormat resuelve el formato de salida pedido: ?format= tiene prioridad y, si no
+// viene, se usa el header Accept. Por defecto JSON.
+func exportFormat(r *http.Request) string {
+	switch strings.ToLower(strings.TrimSpace(r.URL.Query().Get("format"))) {
+	case exportFormatXLSX:
+		return exportFormatXLSX
+	case exportFormatCSV:
+		return exportFormatCSV
+	case exportFormatJSON:
+		return exportFormatJSON
+	}
+
+	accept := r.Header.Get("Accept")
+	if strings.Contains(accept, xlsx.ContentType) {
+		return exportFormatXLSX
+	}
+	if strings.Contains(accept, "text/csv") {
+		return exportFormatCSV
+	}
+	return exportFormatJSON
+}
+
+// startXLSXDownload escribe los headers de descarga y devuelve el writer del libro.
+func startXLSXDownload(w http.ResponseWriter, filename string) *xlsx.Writer {
+	w.Header().Set("Content-Type", xlsx.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	return xlsx.NewWriter(w)
+}
+
+// startCSVDownload escribe los headers de descarga y el BOM (para que Excel respete
+// los acentos) y devuelve el writer CSV.
+func startCSVDownload(w http.ResponseWriter, filename string) *csv.Writer {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	_, _ = w.Write([]byte("\xEF\xBB\xBF"))
+	return csv.NewWriter(w)
+}
+
+// flushCSV vacía el buffer del writer CSV y lo envía al cliente.
+func flushCSV(w http.ResponseWriter, cw *csv.Writer) error {
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return err
+	}
+	if fl, ok := w.(http.Flusher); ok {
+		fl.Flush()
+	}
+	return nil
+}
+
+// csvRecord convierte una fila de exportación (los mismos valores que se escriben
+// en XLSX) a texto.
+func csvRecord(values []any) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = csvCell(v)
+	}
+	return out
+}
+
+func csvCell(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return x
+	case *string:
+		if x == nil {
+			return ""
+		}
+		return *x
+	case int:
+		return strconv.Itoa(x)
+	case *int:
+		if x == nil {
+			return ""
+		}
+		return strconv.Itoa(*x)
+	case bool:
+		if x {
+			return "Sí"
+		}
+		return "No"
+	case time.Time:
+		return x.Format("2006-01-02 15:04:05")
+	case *time.Time:
+		if x == nil {
+			return ""
+		}
+		return x.Format("2006-01-02 15:04:05")
+	case xlsx.Date:
+		return time.Time(x).Format("2006-01-02")
+	default:
+		return fmt.Sprint(x)
+	}
+}
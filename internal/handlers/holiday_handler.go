@@ -0,0 +1,379 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"back/internal/auth"
+	"back/internal/ent"
+	"back/internal/services"
+)
+
+type HolidayHandler struct {
+	Svc *services.HolidayService
+}
+
+func NewHolidayHandler(svc *services.HolidayService) *HolidayHandler {
+	return &HolidayHandler{Svc: svc}
+}
+
+/* =========================
+   REQUESTS
+   ========================= */
+
+type createHolidayRequest struct {
+	Date          string `json:"date" example:"2026-09-18"`
+	Name          string `json:"name" example:"Independencia Nacional"`
+	Scope         string `json:"scope" example:"national"` // "national" | "region" | "branch"
+	RegionID      *int   `json:"region_id,omitempty" example:"13"`
+	BranchID      *int   `json:"branch_id,omitempty" example:"1"`
+	Irrenunciable *bool  `json:"irrenunciable,omitempty" example:"true"`
+}
+
+type patchHolidayRequest struct {
+	Date          *string `json:"date,omitempty" example:"2026-09-18"`
+	Name          *string `json:"name,omitempty" example:"Independencia Nacional"`
+	Scope         *string `json:"scope,omitempty" example:"branch"`
+	RegionID      *int    `json:"region_id,omitempty" example:"13"`
+	BranchID      *int    `json:"branch_id,omitempty" example:"1"`
+	Irrenunciable *bool   `json:"irrenunciable,omitempty" example:"false"`
+}
+
+type importHolidaysRequest struct {
+	Year int `json:"year" example:"2026"`
+}
+
+/* =========================
+   DTOs
+   ========================= */
+
+type HolidayDTO struct {
+	ID            int    `json:"id" example:"1"`
+	Date          string `json:"date" example:"2026-09-18"`
+	Name          string `json:"name" example:"Independencia Nacional"`
+	Scope         string `json:"scope" example:"national"`
+	RegionID      *int   `json:"region_id,omitempty" example:"13"`
+	BranchID      *int   `json:"branch_id,omitempty" example:"1"`
+	Irrenunciable bool   `json:"irrenunciable" example:"true"`
+}
+
+type HolidayImportResponse struct {
+	Year     int          `json:"year" example:"2026"`
+	Created  int          `json:"created" example:"16"`
+	Skipped  int          `json:"skipped" example:"0"`
+	Holidays []HolidayDTO `json:"holidays"`
+}
+
+/* =========================
+   ROUTES
+   ========================= */
+
+// Holidays godoc
+// @Summary      Feriados
+// @Description  GET lista feriados (filtros opcionales year, scope, region_id, branch_id). POST crea un feriado (solo admin).
+// @Tags         Holidays
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        year       query    int                   false "Año"
+// @Param        scope      query    string                false "national | region | branch"
+// @Param        region_id  query    int                   false "ID región"
+// @Param        branch_id  query    int                   false "ID sucursal"
+// @Param        body       body     createHolidayRequest  false "Crear feriado (solo POST, admin)"
+// @Success      200        {array}  HolidayDTO
+// @Success      201        {object} HolidayDTO
+// @Failure      400        {object} ErrorResponse
+// @Failure      401        {object} ErrorResponse
+// @Failure      403        {object} ErrorResponse
+// @Failure      500        {object} ErrorResponse
+// @Router       /api/v1/holidays [get]
+// @Router       /api/v1/holidays [post]
+func (h *HolidayHandler) Holidays(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.list(w, r)
+	case http.MethodPost:
+		h.create(w, r)
+	default:
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// HolidayByID godoc
+// @Summary      Editar o eliminar feriado
+// @Description  PATCH edita parcialmente. DELETE elimina. (solo admin)
+// @Tags         Holidays
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path     int                  true  "ID del feriado"
+// @Param        body  body     patchHolidayRequest  false "Patch feriado (solo PATCH)"
+// @Success      200   {object} HolidayDTO
+// @Success      204   "No Content"
+// @Failure      400   {object} ErrorResponse
+// @Failure      401   {object} ErrorResponse
+// @Failure      403   {object} ErrorResponse
+// @Failure      404   {object} ErrorResponse
+// @Failure      500   {object} ErrorResponse
+// @Router       /api/v1/holidays/{id} [patch]
+// @Router       /api/v1/holidays/{id} [delete]
+func (h *HolidayHandler) HolidayByID(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseHolidayIDFromPath(r.URL.Path)
+	if !ok {
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPatch:
+		h.patch(w, r, id)
+	case http.MethodDelete:
+		h.delete(w, r, id)
+	default:
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// Import godoc
+// @Summary      Importar feriados de Chile
+// @Description  Crea los feriados nacionales de Chile del año indicado. Las fechas ya cargadas se omiten. (solo admin)
+// @Tags         Holidays
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      importHolidaysRequest  true  "Año a importar"
+// @Success      200   {object}  HolidayImportResponse
+// @Failure      400   {object}  ErrorResponse
+// @Failure      401   {object}  ErrorResponse
+// @Failure      403   {object}  ErrorResponse
+// @Failure      500   {object}  ErrorResponse
+// @Router       /api/v1/holidays/import [post]
+func (h *HolidayHandler) Import(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !hasPermission(r, auth.PermHolidaysManage) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	var req importHolidaysRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	res, err := h.Svc.ImportChileanHolidays(r.Context(), req.Year)
+	if err != nil {
+		if err == services.ErrHolidayInvalidInput {
+			http.Error(w, "Bad Request", http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	resp := HolidayImportResponse{
+		Year:     res.Year,
+		Created:  res.Created,
+		Skipped:  res.Skipped,
+		Holidays: make([]HolidayDTO, 0, len(res.Holidays)),
+	}
+	for _, hd := range res.Holidays {
+		resp.Holidays = append(resp.Holidays, mapHoliday(hd))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+/* =========================
+   INTERNAL
+   ========================= */
+
+func (h *HolidayHandler) list(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	var f services.HolidayFilters
+	if v := strings.TrimSpace(q.Get("year")); v != "" {
+		year, err := strconv.Atoi(v)
+		if err != nil {
+			http.Error(w, "year invalido", http.StatusBadRequest)
+			return
+		}
+		f.Year = &year
+	}
+	if v := strings.TrimSpace(q.Get("scope")); v != "" {
+		f.Scope = &v
+	}
+	regionID, err := parseOptionalPositiveInt(q.Get("region_id"))
+	if err != nil {
+		http.Error(w, "region_id invalido", http.StatusBadRequest)
+		return
+	}
+	f.RegionID = regionID
+	branchID, err := parseOptionalPositiveInt(q.Get("branch_id"))
+	if err != nil {
+		http.Error(w, "branch_id invalido", http.StatusBadRequest)
+		return
+	}
+	f.BranchID = branchID
+
+	items, err := h.Svc.List(r.Context(), f)
+	if err != nil {
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]HolidayDTO, 0, len(items))
+	for _, hd := range items {
+		resp = append(resp, mapHoliday(hd))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (h *HolidayHandler) create(w http.ResponseWriter, r *http.Request) {
+	if !hasPermission(r, auth.PermHolidaysManage) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	var req createHolidayRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		http.Error(w, "Invalid date format, expected YYYY-MM-DD", http.StatusBadRequest)
+		return
+	}
+
+	hd, err := h.Svc.Create(r.Context(), services.CreateHolidayInput{
+		Date:          date,
+		Name:          req.Name,
+		Scope:         req.Scope,
+		RegionID:      req.RegionID,
+		BranchID:      req.BranchID,
+		Irrenunciable: req.Irrenunciable,
+	})
+	if err != nil {
+		if err == services.ErrHolidayInvalidInput {
+			http.Error(w, "Bad Request", http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(mapHoliday(hd))
+}
+
+func (h *HolidayHandler) patch(w http.ResponseWriter, r *http.Request, id int) {
+	if !hasPermission(r, auth.PermHolidaysManage) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	var req patchHolidayRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	in := services.PatchHolidayInput{
+		Name:          req.Name,
+		Scope:         req.Scope,
+		RegionID:      req.RegionID,
+		BranchID:      req.BranchID,
+		Irrenunciable: req.Irrenunciable,
+	}
+	if req.Date != nil {
+		date, err := time.Parse("2006-01-02", *req.Date)
+		if err != nil {
+			http.Error(w, "Invalid date format, expected YYYY-MM-DD", http.StatusBadRequest)
+			return
+		}
+		in.Date = &date
+	}
+
+	hd, err := h.Svc.Patch(r.Context(), id, in)
+	if err != nil {
+		switch {
+		case err == services.ErrHolidayInvalidInput:
+			http.Error(w, "Bad Request", http.StatusBadRequest)
+		case ent.IsNotFound(err):
+			http.Error(w, "Not Found", http.StatusNotFound)
+		default:
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(mapHoliday(hd))
+}
+
+func (h *HolidayHandler) delete(w http.ResponseWriter, r *http.Request, id int) {
+	if !hasPermission(r, auth.PermHolidaysManage) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	if err := h.Svc.Delete(r.Context(), id); err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "Not Found", http.StatusNotFound)
+			return
+		}
+		if err == services.ErrHolidayInvalidInput {
+			http.Error(w, "Bad Request", http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func mapHoliday(hd *ent.Holiday) HolidayDTO {
+	return HolidayDTO{
+		ID:            hd.ID,
+		Date:          hd.Date.Format("2006-01-02"),
+		Name:          hd.Name,
+		Scope:         hd.Scope,
+		RegionID:      hd.RegionID,
+		BranchID:      hd.BranchID,
+		Irrenunciable: hd.Irrenunciable,
+	}
+}
+
+/* =========================
+   PATH PARSERS (ServeMux)
+   ========================= */
+
+// /api/v1/holidays/{id}
+func parseHolidayIDFromPath(path string) (int, bool) {
+	trimmed := strings.Trim(path, "/")
+	parts := strings.Split(trimmed, "/")
+	if len(parts) != 4 {
+		return 0, false
+	}
+	if parts[0] != "api" || parts[1] != "v1" || parts[2] != "holidays" {
+		return 0, false
+	}
+	id, err := strconv.Atoi(parts[3])
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+	return id, true
+}
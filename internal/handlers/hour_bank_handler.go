@@ -0,0 +1,311 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"back/internal/auth"
+	"back/internal/ent"
+	"back/internal/services"
+)
+
+type HourBankHandler struct {
+	Svc *services.HourBankService
+}
+
+func NewHourBankHandler(svc *services.HourBankService) *HourBankHandler {
+	return &HourBankHandler{Svc: svc}
+}
+
+/* =========================
+   REQUESTS
+   ========================= */
+
+type createHourBankMovementRequest struct {
+	Date    *string `json:"date,omitempty" example:"2026-03-01"` // por defecto hoy
+	Kind    string  `json:"kind" example:"credit"`               // credit | debit
+	Minutes int     `json:"minutes" example:"120"`               // siempre positivo
+	Reason  string  `json:"reason" example:"Compensación por jornada de inventario"`
+}
+
+/* =========================
+   DTOs
+   ========================= */
+
+type HourBankEntryDTO struct {
+	Date            string  `json:"date" example:"2026-03-02"`
+	Kind            string  `json:"kind" example:"daily"` // daily | credit | debit | expiry
+	Minutes         int     `json:"minutes" example:"35"`
+	Balance         int     `json:"balance" example:"410"`
+	Reason          *string `json:"reason,omitempty" example:"Compensación por jornada de inventario"`
+	MovementID      *int    `json:"movement_id,omitempty" example:"12"`
+	AttendanceDayID *int    `json:"attendance_day_id,omitempty" example:"55"`
+}
+
+type HourBankLedgerDTO struct {
+	UserID         int                `json:"user_id" example:"10"`
+	From           string             `json:"from" example:"2026-03-01"`
+	To             string             `json:"to" example:"2026-03-31"`
+	ExpiryDays     int                `json:"expiry_days" example:"90"`
+	OpeningBalance int                `json:"opening_balance" example:"375"`
+	ClosingBalance int                `json:"closing_balance" example:"410"`
+	Earned         int                `json:"earned" example:"95"`
+	Used           int                `json:"used" example:"60"`
+	Expired        int                `json:"expired" example:"0"`
+	Entries        []HourBankEntryDTO `json:"entries"`
+}
+
+type HourBankMovementDTO struct {
+	ID              int       `json:"id" example:"12"`
+	UserID          int       `json:"user_id" example:"10"`
+	Date            string    `json:"date" example:"2026-03-01"`
+	Kind            string    `json:"kind" example:"credit"`
+	Minutes         int       `json:"minutes" example:"120"`
+	AttendanceDayID *int      `json:"attendance_day_id,omitempty" example:"55"`
+	Reason          *string   `json:"reason,omitempty" example:"Compensación por jornada de inventario"`
+	CreatedByID     *int      `json:"created_by_id,omitempty" example:"1"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+/* =========================
+   ROUTES
+   ========================= */
+
+// HourBankLedger godoc
+// @Summary      Bolsa de horas
+// @Description  Saldo acumulado y movimientos de la bolsa de horas en el período: balance neto de cada día cerrado, abonos/cargos manuales y vencimientos. Admin o el propio usuario.
+// @Tags         Hour Bank
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id          path     int    true  "ID del usuario"
+// @Param        start_date  query    string false "Desde (YYYY-MM-DD), por defecto inicio del mes de end_date"
+// @Param        end_date    query    string false "Hasta (YYYY-MM-DD), por defecto hoy"
+// @Success      200         {object} HourBankLedgerDTO
+// @Failure      400         {object} ErrorResponse
+// @Failure      401         {object} ErrorResponse
+// @Failure      403         {object} ErrorResponse
+// @Failure      404         {object} ErrorResponse
+// @Failure      500         {object} ErrorResponse
+// @Router       /api/v1/users/{id}/hour-bank [get]
+func (h *HourBankHandler) HourBankLedger(w http.ResponseWriter, r *http.Request, userID int) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !canViewUserHourBank(r, userID) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	from, err := parseOptionalDate(r.URL.Query().Get("start_date"))
+	if err != nil {
+		http.Error(w, "start_date invalido", http.StatusBadRequest)
+		return
+	}
+	to, err := parseOptionalDate(r.URL.Query().Get("end_date"))
+	if err != nil {
+		http.Error(w, "end_date invalido", http.StatusBadRequest)
+		return
+	}
+
+	l, err := h.Svc.Ledger(r.Context(), userID, from, to)
+	if err != nil {
+		writeHourBankError(w, err)
+		return
+	}
+
+	entries := make([]HourBankEntryDTO, 0, len(l.Entries))
+	for _, e := range l.Entries {
+		entries = append(entries, HourBankEntryDTO{
+			Date:            e.Date.Format("2006-01-02"),
+			Kind:            e.Kind,
+			Minutes:         e.Minutes,
+			Balance:         e.Balance,
+			Reason:          e.Reason,
+			MovementID:      e.MovementID,
+			AttendanceDayID: e.AttendanceDayID,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(HourBankLedgerDTO{
+		UserID:         l.UserID,
+		From:           l.From.Format("2006-01-02"),
+		To:             l.To.Format("2006-01-02"),
+		ExpiryDays:     l.ExpiryDays,
+		OpeningBalance: l.OpeningBalance,
+		ClosingBalance: l.ClosingBalance,
+		Earned:         l.Earned,
+		Used:           l.Used,
+		Expired:        l.Expired,
+		Entries:        entries,
+	})
+}
+
+// HourBankMovements godoc
+// @Summary      Movimientos de la bolsa de horas
+// @Description  GET lista los movimientos registrados (admin o el propio usuario). POST registra un abono o cargo manual con motivo (solo admin).
+// @Tags         Hour Bank
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id          path     int                            true  "ID del usuario"
+// @Param        start_date  query    string                         false "Desde (YYYY-MM-DD)"
+// @Param        end_date    query    string                         false "Hasta (YYYY-MM-DD)"
+// @Param        body        body     createHourBankMovementRequest  false "Movimiento manual (solo POST)"
+// @Success      200         {array}  HourBankMovementDTO
+// @Success      201         {object} HourBankMovementDTO
+// @Failure      400         {object} ErrorResponse
+// @Failure      401         {object} ErrorResponse
+// @Failure      403         {object} ErrorResponse
+// @Failure      404         {object} ErrorResponse
+// @Failure      500         {object} ErrorResponse
+// @Router       /api/v1/users/{id}/hour-bank/movements [get]
+// @Router       /api/v1/users/{id}/hour-bank/movements [post]
+func (h *HourBankHandler) HourBankMovements(w http.ResponseWriter, r *http.Request, userID int) {
+	switch r.Method {
+	case http.MethodGet:
+		h.listMovements(w, r, userID)
+	case http.MethodPost:
+		h.createMovement(w, r, userID)
+	default:
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// HourBankMovementByID godoc
+// @Summary      Eliminar movimiento manual de la bolsa de horas
+// @Description  Elimina un abono o cargo manual (solo admin). Los movimientos diarios siguen a la asistencia y no se pueden eliminar.
+// @Tags         Hour Bank
+// @Security     BearerAuth
+// @Param        id           path  int  true  "ID del usuario"
+// @Param        movementId   path  int  true  "ID del movimiento"
+// @Success      204
+// @Failure      400  {object} ErrorResponse
+// @Failure      401  {object} ErrorResponse
+// @Failure      403  {object} ErrorResponse
+// @Failure      404  {object} ErrorResponse
+// @Failure      500  {object} ErrorResponse
+// @Router       /api/v1/users/{id}/hour-bank/movements/{movementId} [delete]
+func (h *HourBankHandler) HourBankMovementByID(w http.ResponseWriter, r *http.Request, userID, movementID int) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !hasPermission(r, auth.PermHourBankManage) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	if err := h.Svc.DeleteMovement(r.Context(), userID, movementID); err != nil {
+		writeHourBankError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+/* =========================
+   INTERNAL
+   ========================= */
+
+func (h *HourBankHandler) listMovements(w http.ResponseWriter, r *http.Request, userID int) {
+	if !canViewUserHourBank(r, userID) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	from, err := parseOptionalDate(r.URL.Query().Get("start_date"))
+	if err != nil {
+		http.Error(w, "start_date invalido", http.StatusBadRequest)
+		return
+	}
+	to, err := parseOptionalDate(r.URL.Query().Get("end_date"))
+	if err != nil {
+		http.Error(w, "end_date invalido", http.StatusBadRequest)
+		return
+	}
+
+	items, err := h.Svc.ListMovements(r.Context(), userID, from, to)
+	if err != nil {
+		writeHourBankError(w, err)
+		return
+	}
+
+	resp := make([]HourBankMovementDTO, 0, len(items))
+	for _, m := range items {
+		resp = append(resp, mapHourBankMovement(m))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (h *HourBankHandler) createMovement(w http.ResponseWriter, r *http.Request, userID int) {
+	if !hasPermission(r, auth.PermHourBankManage) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+	adminID, _ := callerUserID(r)
+
+	var req createHourBankMovementRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	in := services.CreateHourBankMovementInput{
+		Kind:    req.Kind,
+		Minutes: req.Minutes,
+		Reason:  req.Reason,
+	}
+	if req.Date != nil {
+		d, err := time.Parse("2006-01-02", *req.Date)
+		if err != nil {
+			http.Error(w, "date invalido", http.StatusBadRequest)
+			return
+		}
+		in.Date = &d
+	}
+
+	m, err := h.Svc.CreateMovement(r.Context(), userID, adminID, in)
+	if err != nil {
+		writeHourBankError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(mapHourBankMovement(m))
+}
+
+// canViewUserHourBank: quien tiene auth.PermHourBankManage ve cualquier usuario; el resto solo su propia bolsa.
+func canViewUserHourBank(r *http.Request, userID int) bool {
+	return isSelfOr(r, userID, auth.PermHourBankManage)
+}
+
+func writeHourBankError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrHourBankInvalidInput):
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+	case ent.IsNotFound(err):
+		http.Error(w, "Not Found", http.StatusNotFound)
+	default:
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
+
+func mapHourBankMovement(m *ent.HourBankMovement) HourBankMovementDTO {
+	return HourBankMovementDTO{
+		ID:              m.ID,
+		UserID:          m.UserID,
+		Date:            m.Date.Format("2006-01-02"),
+		Kind:            m.Kind,
+		Minutes:         m.Minutes,
+		AttendanceDayID: m.AttendanceDayID,
+		Reason:          m.Reason,
+		CreatedByID:     m.CreatedByID,
+		CreatedAt:       m.CreatedAt,
+	}
+}
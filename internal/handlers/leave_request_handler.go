@@ -0,0 +1,405 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"back/internal/auth"
+	"back/internal/ent"
+	"back/internal/services"
+)
+
+type LeaveRequestHandler struct {
+	Svc *services.LeaveRequestService
+}
+
+func NewLeaveRequestHandler(svc *services.LeaveRequestService) *LeaveRequestHandler {
+	return &LeaveRequestHandler{Svc: svc}
+}
+
+/* =========================
+   REQUESTS
+   ========================= */
+
+type createLeaveRequestRequest struct {
+	// solo admin puede crear solicitudes a nombre de otro usuario
+	UserID    *int    `json:"user_id,omitempty" example:"10"`
+	Type      string  `json:"type" example:"vacation"` // vacation | medical_license | administrative_day | unpaid
+	StartDate string  `json:"start_date" example:"2026-02-02"`
+	EndDate   string  `json:"end_date" example:"2026-02-13"`
+	Reason    *string `json:"reason,omitempty" example:"Vacaciones de verano"`
+}
+
+type reviewLeaveRequestRequest struct {
+	Action string  `json:"action" example:"approve"` // approve | reject
+	Notes  *string `json:"notes,omitempty" example:"Aprobado"`
+}
+
+/* =========================
+   DTOs
+   ========================= */
+
+type LeaveRequestDTO struct {
+	ID           int        `json:"id" example:"1"`
+	UserID       int        `json:"user_id" example:"10"`
+	Type         string     `json:"type" example:"vacation"`
+	StartDate    string     `json:"start_date" example:"2026-02-02"`
+	EndDate      string     `json:"end_date" example:"2026-02-13"`
+	Status       string     `json:"status" example:"requested"`
+	Reason       *string    `json:"reason,omitempty" example:"Vacaciones de verano"`
+	ReviewedByID *int       `json:"reviewed_by_id,omitempty" example:"1"`
+	ReviewedAt   *time.Time `json:"reviewed_at,omitempty"`
+	ReviewNotes  *string    `json:"review_notes,omitempty" example:"Aprobado"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+/* =========================
+   ROUTES
+   ========================= */
+
+// LeaveRequests godoc
+// @Summary      Solicitudes de ausencia
+// @Description  GET lista solicitudes (supervisor/admin: las de sus sucursales con filtros; usuario: solo las propias). POST crea una solicitud para el usuario autenticado.
+// @Tags         Leave Requests
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user_id     query    int                        false "Filtrar por usuario (supervisor/admin)"
+// @Param        branch_id   query    int                        false "Filtrar por sucursal (supervisor/admin)"
+// @Param        status      query    string                     false "requested | approved | rejected | cancelled"
+// @Param        type        query    string                     false "vacation | medical_license | administrative_day | unpaid"
+// @Param        start_date  query    string                     false "Desde (YYYY-MM-DD)"
+// @Param        end_date    query    string                     false "Hasta (YYYY-MM-DD)"
+// @Param        body        body     createLeaveRequestRequest  false "Crear solicitud (solo POST)"
+// @Success      200         {array}  LeaveRequestDTO
+// @Success      201         {object} LeaveRequestDTO
+// @Failure      400         {object} ErrorResponse
+// @Failure      401         {object} ErrorResponse
+// @Failure      403         {object} ErrorResponse
+// @Failure      409         {object} ErrorResponse
+// @Failure      500         {object} ErrorResponse
+// @Router       /api/v1/leave-requests [get]
+// @Router       /api/v1/leave-requests [post]
+func (h *LeaveRequestHandler) LeaveRequests(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.list(w, r)
+	case http.MethodPost:
+		h.create(w, r)
+	default:
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// LeaveRequestSubroutes godoc
+// @Summary      Detalle, revisión y cancelación de solicitud
+// @Description  GET /{id} detalle. POST /{id}/review aprueba o rechaza (supervisor/admin; nadie revisa sus propias solicitudes). POST /{id}/cancel cancela (dueño o admin); una ausencia aprobada que ya comenzó solo la cancela quien tiene leave:manage.
+// @Tags         Leave Requests
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path     int                        true  "ID de la solicitud"
+// @Param        body  body     reviewLeaveRequestRequest  false "Revisión (solo /review)"
+// @Success      200   {object} LeaveRequestDTO
+// @Failure      400   {object} ErrorResponse
+// @Failure      401   {object} ErrorResponse
+// @Failure      403   {object} ErrorResponse
+// @Failure      404   {object} ErrorResponse
+// @Failure      409   {object} ErrorResponse
+// @Failure      500   {object} ErrorResponse
+// @Router       /api/v1/leave-requests/{id} [get]
+// @Router       /api/v1/leave-requests/{id}/review [post]
+// @Router       /api/v1/leave-requests/{id}/cancel [post]
+func (h *LeaveRequestHandler) LeaveRequestSubroutes(w http.ResponseWriter, r *http.Request) {
+	id, action, ok := parseLeaveRequestPath(r.URL.Path)
+	if !ok {
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return
+	}
+
+	switch action {
+	case "":
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		h.get(w, r, id)
+	case "review":
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		h.review(w, r, id)
+	case "cancel":
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		h.cancel(w, r, id)
+	default:
+		http.Error(w, "Not Found", http.StatusNotFound)
+	}
+}
+
+/* =========================
+   INTERNAL
+   ========================= */
+
+func (h *LeaveRequestHandler) list(w http.ResponseWriter, r *http.Request) {
+	callerID, ok := callerUserID(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	q := r.URL.Query()
+	var f services.LeaveRequestFilters
+
+	if hasPermission(r, auth.PermLeaveReview) {
+		userID, err := parseOptionalPositiveInt(q.Get("user_id"))
+		if err != nil {
+			http.Error(w, "user_id invalido", http.StatusBadRequest)
+			return
+		}
+		branchID, err := parseOptionalPositiveInt(q.Get("branch_id"))
+		if err != nil {
+			http.Error(w, "branch_id invalido", http.StatusBadRequest)
+			return
+		}
+		f.UserID = userID
+		f.BranchID = branchID
+	} else {
+		f.UserID = &callerID
+	}
+
+	if v := strings.TrimSpace(q.Get("status")); v != "" {
+		f.Status = &v
+	}
+	if v := strings.TrimSpace(q.Get("type")); v != "" {
+		f.Type = &v
+	}
+	startDate, err := parseOptionalDate(q.Get("start_date"))
+	if err != nil {
+		http.Error(w, "start_date invalido", http.StatusBadRequest)
+		return
+	}
+	endDate, err := parseOptionalDate(q.Get("end_date"))
+	if err != nil {
+		http.Error(w, "end_date invalido", http.StatusBadRequest)
+		return
+	}
+	f.StartDate = startDate
+	f.EndDate = endDate
+
+	items, err := h.Svc.List(r.Context(), f)
+	if err != nil {
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]LeaveRequestDTO, 0, len(items))
+	for _, lr := range items {
+		resp = append(resp, mapLeaveRequest(lr))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (h *LeaveRequestHandler) create(w http.ResponseWriter, r *http.Request) {
+	callerID, ok := callerUserID(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req createLeaveRequestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	userID := callerID
+	if req.UserID != nil && *req.UserID != callerID {
+		if !hasPermission(r, auth.PermLeaveManage) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		userID = *req.UserID
+	}
+
+	start, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		http.Error(w, "Invalid start_date format, expected YYYY-MM-DD", http.StatusBadRequest)
+		return
+	}
+	end, err := time.Parse("2006-01-02", req.EndDate)
+	if err != nil {
+		http.Error(w, "Invalid end_date format, expected YYYY-MM-DD", http.StatusBadRequest)
+		return
+	}
+
+	lr, err := h.Svc.Create(r.Context(), userID, services.CreateLeaveRequestInput{
+		Type:      req.Type,
+		StartDate: start,
+		EndDate:   end,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		writeLeaveRequestError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(mapLeaveRequest(lr))
+}
+
+func (h *LeaveRequestHandler) get(w http.ResponseWriter, r *http.Request, id int) {
+	callerID, ok := callerUserID(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	lr, err := h.Svc.GetByID(r.Context(), id)
+	if err != nil {
+		writeLeaveRequestError(w, err)
+		return
+	}
+	if lr.UserID != callerID && !hasPermission(r, auth.PermLeaveReview) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(mapLeaveRequest(lr))
+}
+
+func (h *LeaveRequestHandler) review(w http.ResponseWriter, r *http.Request, id int) {
+	if !hasPermission(r, auth.PermLeaveReview) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+	reviewerID, ok := callerUserID(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req reviewLeaveRequestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	var approve bool
+	switch strings.ToLower(strings.TrimSpace(req.Action)) {
+	case "approve":
+		approve = true
+	case "reject":
+		approve = false
+	default:
+		http.Error(w, "action must be 'approve' or 'reject'", http.StatusBadRequest)
+		return
+	}
+
+	lr, err := h.Svc.Review(r.Context(), id, reviewerID, approve, req.Notes)
+	if err != nil {
+		writeLeaveRequestError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(mapLeaveRequest(lr))
+}
+
+func (h *LeaveRequestHandler) cancel(w http.ResponseWriter, r *http.Request, id int) {
+	callerID, ok := callerUserID(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	current, err := h.Svc.GetByID(r.Context(), id)
+	if err != nil {
+		writeLeaveRequestError(w, err)
+		return
+	}
+	if current.UserID != callerID && !hasPermission(r, auth.PermLeaveManage) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	lr, err := h.Svc.Cancel(r.Context(), id, hasPermission(r, auth.PermLeaveManage))
+	if err != nil {
+		writeLeaveRequestError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(mapLeaveRequest(lr))
+}
+
+func writeLeaveRequestError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrLeaveRequestInvalidInput):
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+	case errors.Is(err, services.ErrLeaveRequestSelfReview),
+		errors.Is(err, services.ErrOutOfScope):
+		http.Error(w, "Forbidden", http.StatusForbidden)
+	case errors.Is(err, services.ErrLeaveRequestOverlap),
+		errors.Is(err, services.ErrLeaveRequestInvalidState),
+		errors.Is(err, services.ErrLeaveRequestStarted):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case ent.IsNotFound(err):
+		http.Error(w, "Not Found", http.StatusNotFound)
+	default:
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
+
+func mapLeaveRequest(lr *ent.LeaveRequest) LeaveRequestDTO {
+	return LeaveRequestDTO{
+		ID:           lr.ID,
+		UserID:       lr.UserID,
+		Type:         lr.Type,
+		StartDate:    lr.StartDate.Format("2006-01-02"),
+		EndDate:      lr.EndDate.Format("2006-01-02"),
+		Status:       lr.Status,
+		Reason:       lr.Reason,
+		ReviewedByID: lr.ReviewedByID,
+		ReviewedAt:   lr.ReviewedAt,
+		ReviewNotes:  lr.ReviewNotes,
+		CreatedAt:    lr.CreatedAt,
+	}
+}
+
+/* =========================
+   PATH PARSERS (ServeMux)
+   ========================= */
+
+// /api/v1/leave-requests/{id}
+// /api/v1/leave-requests/{id}/{action}
+func parseLeaveRequestPath(path string) (int, string, bool) {
+	trimmed := strings.Trim(path, "/")
+	parts := strings.Split(trimmed, "/")
+	if len(parts) != 4 && len(parts) != 5 {
+		return 0, "", false
+	}
+	if parts[0] != "api" || parts[1] != "v1" || parts[2] != "leave-requests" {
+		return 0, "", false
+	}
+	id, err := strconv.Atoi(parts[3])
+	if err != nil || id <= 0 {
+		return 0, "", false
+	}
+	if len(parts) == 5 {
+		return id, parts[4], true
+	}
+	return id, "", true
+}
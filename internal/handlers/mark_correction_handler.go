@@ -0,0 +1,485 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"back/internal/auth"
+	"back/internal/ent"
+	"back/internal/services"
+)
+
+type MarkCorrectionHandler struct {
+	Svc *services.MarkCorrectionService
+}
+
+func NewMarkCorrectionHandler(svc *services.MarkCorrectionService) *MarkCorrectionHandler {
+	return &MarkCorrectionHandler{Svc: svc}
+}
+
+/* =========================
+   REQUESTS
+   ========================= */
+
+type createMarkCorrectionRequest struct {
+	AttendanceDayID int     `json:"attendance_day_id" example:"120"`
+	WorkInAt        *string `json:"work_in_at,omitempty" example:""`
+	BreakOutAt      *string `json:"break_out_at,omitempty" example:""`
+	BreakInAt       *string `json:"break_in_at,omitempty" example:""`
+	WorkOutAt       *string `json:"work_out_at,omitempty" example:"18:05"`
+	Reason          string  `json:"reason" example:"El reloj no registró mi salida"`
+}
+
+type reviewMarkCorrectionRequest struct {
+	Action string  `json:"action" example:"approve"` // approve | reject
+	Notes  *string `json:"notes,omitempty" example:"Revisado con cámaras"`
+}
+
+/* =========================
+   DTOs
+   ========================= */
+
+type MarkCorrectionDTO struct {
+	ID              int        `json:"id" example:"1"`
+	UserID          int        `json:"user_id" example:"10"`
+	AttendanceDayID int        `json:"attendance_day_id" example:"120"`
+	WorkDate        string     `json:"work_date" example:"2026-03-20"`
+	WorkInAt        *string    `json:"work_in_at,omitempty" example:"08:58"`
+	BreakOutAt      *string    `json:"break_out_at,omitempty" example:"13:00"`
+	BreakInAt       *string    `json:"break_in_at,omitempty" example:"14:00"`
+	WorkOutAt       *string    `json:"work_out_at,omitempty" example:"18:05"`
+	Reason          string     `json:"reason" example:"El reloj no registró mi salida"`
+	HasAttachment   bool       `json:"has_attachment" example:"true"`
+	AttachmentName  *string    `json:"attachment_name,omitempty" example:"licencia.pdf"`
+	Status          string     `json:"status" example:"requested"`
+	ReviewedByID    *int       `json:"reviewed_by_id,omitempty" example:"1"`
+	ReviewedAt      *time.Time `json:"reviewed_at,omitempty"`
+	ReviewNotes     *string    `json:"review_notes,omitempty" example:"Aprobado"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+/* =========================
+   ROUTES (por usuario)
+   ========================= */
+
+// UserCorrections: GET lista las solicitudes del usuario; POST crea una a su nombre.
+func (h *MarkCorrectionHandler) UserCorrections(w http.ResponseWriter, r *http.Request, userID int) {
+	switch r.Method {
+	case http.MethodGet:
+		f := services.MarkCorrectionFilters{UserID: &userID}
+		if v := r.URL.Query().Get("status"); v != "" {
+			f.Status = &v
+		}
+
+		items, err := h.Svc.List(r.Context(), f)
+		if err != nil {
+			writeMarkCorrectionError(w, err)
+			return
+		}
+
+		resp := make([]MarkCorrectionDTO, 0, len(items))
+		for _, it := range items {
+			resp = append(resp, mapMarkCorrection(it))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+
+	case http.MethodPost:
+		req, attachment, err := readMarkCorrectionRequest(w, r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		mc, err := h.Svc.Create(r.Context(), userID, services.CreateMarkCorrectionInput{
+			AttendanceDayID: req.AttendanceDayID,
+			WorkInAt:        req.WorkInAt,
+			BreakOutAt:      req.BreakOutAt,
+			BreakInAt:       req.BreakInAt,
+			WorkOutAt:       req.WorkOutAt,
+			Reason:          req.Reason,
+			Attachment:      attachment,
+		})
+		if err != nil {
+			writeMarkCorrectionError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(mapMarkCorrection(mc))
+
+	default:
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// UserCorrectionByID: GET detalle y DELETE cancela una solicitud pendiente del usuario.
+func (h *MarkCorrectionHandler) UserCorrectionByID(w http.ResponseWriter, r *http.Request, userID, id int) {
+	mc, err := h.Svc.GetByID(r.Context(), id)
+	if err != nil {
+		writeMarkCorrectionError(w, err)
+		return
+	}
+	// las solicitudes de otro usuario no existen para quien consulta
+	if mc.UserID != userID {
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodDelete:
+		mc, err = h.Svc.Cancel(r.Context(), id)
+		if err != nil {
+			writeMarkCorrectionError(w, err)
+			return
+		}
+	default:
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(mapMarkCorrection(mc))
+}
+
+// UserCorrectionAttachment descarga el respaldo de una solicitud del usuario.
+func (h *MarkCorrectionHandler) UserCorrectionAttachment(w http.ResponseWriter, r *http.Request, userID, id int) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	mc, err := h.Svc.Attachment(r.Context(), id)
+	if err != nil {
+		writeMarkCorrectionError(w, err)
+		return
+	}
+	if mc.UserID != userID {
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return
+	}
+
+	writeMarkCorrectionAttachment(w, mc)
+}
+
+/* =========================
+   ROUTES (revisión)
+   ========================= */
+
+// MarkCorrections godoc
+// @Summary      Solicitudes de corrección de marcación
+// @Description  Lista las solicitudes de corrección de los trabajadores dentro del alcance por sucursal de quien consulta. Requiere markings:edit.
+// @Tags         Mark Corrections
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user_id     query    int     false "Filtrar por usuario"
+// @Param        branch_id   query    int     false "Filtrar por sucursal"
+// @Param        status      query    string  false "requested | approved | rejected | cancelled"
+// @Param        start_date  query    string  false "Desde (YYYY-MM-DD)"
+// @Param        end_date    query    string  false "Hasta (YYYY-MM-DD)"
+// @Success      200         {array}  MarkCorrectionDTO
+// @Failure      400         {object} ErrorResponse
+// @Failure      401         {object} ErrorResponse
+// @Failure      403         {object} ErrorResponse
+// @Failure      500         {object} ErrorResponse
+// @Router       /api/v1/mark-corrections [get]
+func (h *MarkCorrectionHandler) MarkCorrections(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	q := r.URL.Query()
+	var f services.MarkCorrectionFilters
+	var err error
+
+	if f.UserID, err = parseOptionalPositiveInt(q.Get("user_id")); err != nil {
+		http.Error(w, "user_id invalido", http.StatusBadRequest)
+		return
+	}
+	if f.BranchID, err = parseOptionalPositiveInt(q.Get("branch_id")); err != nil {
+		http.Error(w, "branch_id invalido", http.StatusBadRequest)
+		return
+	}
+	if v := q.Get("status"); v != "" {
+		f.Status = &v
+	}
+	if f.StartDate, err = parseOptionalDate(q.Get("start_date")); err != nil {
+		http.Error(w, "start_date invalido", http.StatusBadRequest)
+		return
+	}
+	if f.EndDate, err = parseOptionalDate(q.Get("end_date")); err != nil {
+		http.Error(w, "end_date invalido", http.StatusBadRequest)
+		return
+	}
+
+	items, err := h.Svc.List(r.Context(), f)
+	if err != nil {
+		writeMarkCorrectionError(w, err)
+		return
+	}
+
+	resp := make([]MarkCorrectionDTO, 0, len(items))
+	for _, it := range items {
+		resp = append(resp, mapMarkCorrection(it))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// MarkCorrectionSubroutes godoc
+// @Summary      Detalle, revisión y respaldo de una solicitud de corrección
+// @Description  GET /{id} detalle. POST /{id}/review aprueba o rechaza (action=approve|reject): al aprobar se aplica la edición de la marcación con el ID de la solicitud en la auditoría. GET /{id}/attachment descarga el respaldo. Requiere markings:edit y gestionar la sucursal del día; nadie revisa sus propias solicitudes.
+// @Tags         Mark Corrections
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path     int                          true  "ID de la solicitud"
+// @Param        body  body     reviewMarkCorrectionRequest  false "Revisión (solo /review)"
+// @Success      200   {object} MarkCorrectionDTO
+// @Failure      400   {object} ErrorResponse
+// @Failure      401   {object} ErrorResponse
+// @Failure      403   {object} ErrorResponse
+// @Failure      404   {object} ErrorResponse
+// @Failure      409   {object} ErrorResponse
+// @Failure      500   {object} ErrorResponse
+// @Router       /api/v1/mark-corrections/{id} [get]
+// @Router       /api/v1/mark-corrections/{id}/review [post]
+// @Router       /api/v1/mark-corrections/{id}/attachment [get]
+func (h *MarkCorrectionHandler) MarkCorrectionSubroutes(w http.ResponseWriter, r *http.Request) {
+	id, action, ok := parseMarkCorrectionPath(r.URL.Path)
+	if !ok {
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return
+	}
+
+	switch action {
+	case "":
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		mc, err := h.Svc.GetByID(r.Context(), id)
+		if err != nil {
+			writeMarkCorrectionError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(mapMarkCorrection(mc))
+
+	case "review":
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		h.review(w, r, id)
+
+	case "attachment":
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		mc, err := h.Svc.Attachment(r.Context(), id)
+		if err != nil {
+			writeMarkCorrectionError(w, err)
+			return
+		}
+		writeMarkCorrectionAttachment(w, mc)
+
+	default:
+		http.Error(w, "Not Found", http.StatusNotFound)
+	}
+}
+
+/* =========================
+   INTERNAL
+   ========================= */
+
+func (h *MarkCorrectionHandler) review(w http.ResponseWriter, r *http.Request, id int) {
+	if !hasPermission(r, auth.PermMarkingsEdit) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+	reviewerID, ok := callerUserID(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req reviewMarkCorrectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	var approve bool
+	switch strings.ToLower(strings.TrimSpace(req.Action)) {
+	case "approve":
+		approve = true
+	case "reject":
+		approve = false
+	default:
+		http.Error(w, "action debe ser approve o reject", http.StatusBadRequest)
+		return
+	}
+
+	mc, err := h.Svc.Review(r.Context(), id, reviewerID, approve, req.Notes)
+	if err != nil {
+		writeMarkCorrectionError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(mapMarkCorrection(mc))
+}
+
+// readMarkCorrectionRequest lee la solicitud como JSON o como multipart (campos del
+// JSON como campos del formulario y el respaldo en "file"). El error trae el mensaje
+// para el cliente.
+func readMarkCorrectionRequest(w http.ResponseWriter, r *http.Request) (createMarkCorrectionRequest, *services.MarkCorrectionAttachment, error) {
+	var req createMarkCorrectionRequest
+
+	if !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return req, nil, errors.New("Bad Request")
+		}
+		return req, nil, nil
+	}
+
+	// margen para los campos del formulario
+	r.Body = http.MaxBytesReader(w, r.Body, services.MaxMarkCorrectionAttachmentBytes+(1<<20))
+	if err := r.ParseMultipartForm(services.MaxMarkCorrectionAttachmentBytes); err != nil {
+		return req, nil, errors.New("archivo invalido o demasiado grande")
+	}
+
+	id, err := strconv.Atoi(strings.TrimSpace(r.FormValue("attendance_day_id")))
+	if err != nil {
+		return req, nil, errors.New("attendance_day_id invalido")
+	}
+	req.AttendanceDayID = id
+	req.Reason = r.FormValue("reason")
+	for key, dst := range map[string]**string{
+		"work_in_at":   &req.WorkInAt,
+		"break_out_at": &req.BreakOutAt,
+		"break_in_at":  &req.BreakInAt,
+		"work_out_at":  &req.WorkOutAt,
+	} {
+		if v := r.FormValue(key); v != "" {
+			*dst = &v
+		}
+	}
+
+	f, fh, err := r.FormFile("file")
+	if errors.Is(err, http.ErrMissingFile) {
+		return req, nil, nil
+	}
+	if err != nil {
+		return req, nil, errors.New("archivo invalido o demasiado grande")
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return req, nil, errors.New("archivo invalido o demasiado grande")
+	}
+
+	// el tipo se detecta por contenido, no por lo que declare el cliente
+	return req, &services.MarkCorrectionAttachment{
+		Name:        fh.Filename,
+		ContentType: http.DetectContentType(data),
+		Data:        data,
+	}, nil
+}
+
+func writeMarkCorrectionAttachment(w http.ResponseWriter, mc *ent.MarkCorrectionRequest) {
+	name := "respaldo"
+	if mc.AttachmentName != nil {
+		name = *mc.AttachmentName
+	}
+	contentType := "application/octet-stream"
+	if mc.AttachmentContentType != nil {
+		contentType = *mc.AttachmentContentType
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename=%q`, name))
+	_, _ = w.Write(*mc.Attachment)
+}
+
+/* =========================
+   HELPERS
+   ========================= */
+
+func writeMarkCorrectionError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrMarkCorrectionInvalidInput):
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+	case errors.Is(err, services.ErrMarkCorrectionPending),
+		errors.Is(err, services.ErrMarkCorrectionInvalidState):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, services.ErrMarkCorrectionSelfReview),
+		errors.Is(err, services.ErrOutOfScope):
+		http.Error(w, "Forbidden", http.StatusForbidden)
+	case errors.Is(err, services.ErrInvalidMarkingUpdate):
+		http.Error(w, "horarios propuestos invalidos para la marcación", http.StatusBadRequest)
+	case ent.IsNotFound(err):
+		http.Error(w, "Not Found", http.StatusNotFound)
+	default:
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
+
+func mapMarkCorrection(mc *ent.MarkCorrectionRequest) MarkCorrectionDTO {
+	return MarkCorrectionDTO{
+		ID:              mc.ID,
+		UserID:          mc.UserID,
+		AttendanceDayID: mc.AttendanceDayID,
+		WorkDate:        mc.WorkDate.Format("2006-01-02"),
+		WorkInAt:        mc.WorkInAt,
+		BreakOutAt:      mc.BreakOutAt,
+		BreakInAt:       mc.BreakInAt,
+		WorkOutAt:       mc.WorkOutAt,
+		Reason:          mc.Reason,
+		HasAttachment:   mc.AttachmentName != nil,
+		AttachmentName:  mc.AttachmentName,
+		Status:          mc.Status,
+		ReviewedByID:    mc.ReviewedByID,
+		ReviewedAt:      mc.ReviewedAt,
+		ReviewNotes:     mc.ReviewNotes,
+		CreatedAt:       mc.CreatedAt,
+	}
+}
+
+/* =========================
+   PATH PARSERS (ServeMux)
+   ========================= */
+
+// /api/v1/mark-corrections/{id}
+// /api/v1/mark-corrections/{id}/{action}
+func parseMarkCorrectionPath(path string) (int, string, bool) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) != 4 && len(parts) != 5 {
+		return 0, "", false
+	}
+	if parts[0] != "api" || parts[1] != "v1" || parts[2] != "mark-corrections" {
+		return 0, "", false
+	}
+	id, err := strconv.Atoi(parts[3])
+	if err != nil || id <= 0 {
+		return 0, "", false
+	}
+	if len(parts) == 5 {
+		return id, parts[4], true
+	}
+	return id, "", true
+}
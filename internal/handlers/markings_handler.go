@@ -1,15 +1,18 @@
 package handlers
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"io"
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"back/internal/services"
+	"back/internal/xlsx"
 )
 
 type MarkingsHandler struct {
@@ -24,6 +27,25 @@ type updateMarkingRequest struct {
 	Justification string  `json:"justification"`
 }
 
+// AttendanceEditDTO es una fila del historial de ediciones de una marcación.
+type AttendanceEditDTO struct {
+	ID                  int        `json:"id" example:"1"`
+	AttendanceDayID     int        `json:"attendance_day_id" example:"120"`
+	EditorID            int        `json:"editor_id" example:"1"`
+	EditorName          string     `json:"editor_name" example:"Ana Pérez"`
+	CorrectionRequestID *int       `json:"correction_request_id,omitempty" example:"7"`
+	Reason              string     `json:"reason" example:"Olvidó marcar la salida"`
+	WorkInAtBefore      *time.Time `json:"work_in_at_before"`
+	WorkInAtAfter       *time.Time `json:"work_in_at_after"`
+	BreakOutAtBefore    *time.Time `json:"break_out_at_before"`
+	BreakOutAtAfter     *time.Time `json:"break_out_at_after"`
+	BreakInAtBefore     *time.Time `json:"break_in_at_before"`
+	BreakInAtAfter      *time.Time `json:"break_in_at_after"`
+	WorkOutAtBefore     *time.Time `json:"work_out_at_before"`
+	WorkOutAtAfter      *time.Time `json:"work_out_at_after"`
+	CreatedAt           time.Time  `json:"created_at"`
+}
+
 func NewMarkingsHandler(svc *services.MarkingsService) *MarkingsHandler {
 	return &MarkingsHandler{Svc: svc}
 }
@@ -50,37 +72,72 @@ func parseOptionalDate(v string) (*time.Time, error) {
 	return &t, nil
 }
 
-func (h *MarkingsHandler) List(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
+// parseMarkingsFilters lee los filtros comunes del listado y las exportaciones
+// (sucursal, acceso, búsqueda y rango). El error trae el mensaje para el cliente.
+func parseMarkingsFilters(r *http.Request) (services.MarkingsFilters, error) {
+	q := r.URL.Query()
 
-	branchID, err := parseOptionalPositiveInt(r.URL.Query().Get("branch_id"))
+	branchID, err := parseOptionalPositiveInt(q.Get("branch_id"))
 	if err != nil {
-		http.Error(w, "branch_id invalido", http.StatusBadRequest)
-		return
+		return services.MarkingsFilters{}, errors.New("branch_id invalido")
 	}
-	accessPointID, err := parseOptionalPositiveInt(r.URL.Query().Get("access_point_id"))
+	accessPointID, err := parseOptionalPositiveInt(q.Get("access_point_id"))
 	if err != nil {
-		http.Error(w, "access_point_id invalido", http.StatusBadRequest)
-		return
+		return services.MarkingsFilters{}, errors.New("access_point_id invalido")
 	}
 
-	startDate, err := parseOptionalDate(r.URL.Query().Get("start_date"))
+	startDate, err := parseOptionalDate(q.Get("start_date"))
 	if err != nil {
-		http.Error(w, "start_date invalido (formato: YYYY-MM-DD)", http.StatusBadRequest)
-		return
+		return services.MarkingsFilters{}, errors.New("start_date invalido (formato: YYYY-MM-DD)")
 	}
-	endDate, err := parseOptionalDate(r.URL.Query().Get("end_date"))
+	endDate, err := parseOptionalDate(q.Get("end_date"))
 	if err != nil {
-		http.Error(w, "end_date invalido (formato: YYYY-MM-DD)", http.StatusBadRequest)
-		return
+		return services.MarkingsFilters{}, errors.New("end_date invalido (formato: YYYY-MM-DD)")
 	}
 	if startDate != nil && endDate != nil && endDate.Before(*startDate) {
-		http.Error(w, "end_date debe ser posterior a start_date", http.StatusBadRequest)
+		return services.MarkingsFilters{}, errors.New("end_date debe ser posterior a start_date")
+	}
+
+	rangeValue := q.Get("range")
+	if rangeValue == "" {
+		rangeValue = "today"
+	}
+	if startDate != nil && endDate != nil {
+		rangeValue = "custom"
+	}
+
+	return services.MarkingsFilters{
+		Range:         rangeValue,
+		StartDate:     startDate,
+		EndDate:       endDate,
+		BranchID:      branchID,
+		AccessPointID: accessPointID,
+		Search:        q.Get("search"),
+	}, nil
+}
+
+func (h *MarkingsHandler) List(w http.ResponseWriter, r *http.Request) {
+	h.list(w, r, nil)
+}
+
+// UserMarkings lista (o exporta) solo las marcaciones del usuario, con los mismos
+// filtros, métricas y resumen que el listado general.
+func (h *MarkingsHandler) UserMarkings(w http.ResponseWriter, r *http.Request, userID int) {
+	h.list(w, r, &userID)
+}
+
+func (h *MarkingsHandler) list(w http.ResponseWriter, r *http.Request, userID *int) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	filters, err := parseMarkingsFilters(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	filters.UserID = userID
 
 	page := 1
 	if v := r.URL.Query().Get("page"); v != "" {
@@ -101,25 +158,20 @@ func (h *MarkingsHandler) List(w http.ResponseWriter, r *http.Request) {
 		}
 		limit = n
 	}
+	filters.Page = page
+	filters.Limit = limit
 
-	rangeValue := r.URL.Query().Get("range")
-	if rangeValue == "" {
-		rangeValue = "today"
-	}
-	if startDate != nil && endDate != nil {
-		rangeValue = "custom"
+	// exportación completa (sin paginación) en Excel o CSV
+	switch exportFormat(r) {
+	case exportFormatXLSX:
+		h.exportXLSX(w, r, filters)
+		return
+	case exportFormatCSV:
+		h.exportCSV(w, r, filters)
+		return
 	}
 
-	resp, err := h.Svc.List(r.Context(), services.MarkingsFilters{
-		Range:         rangeValue,
-		StartDate:     startDate,
-		EndDate:       endDate,
-		BranchID:      branchID,
-		AccessPointID: accessPointID,
-		Search:        r.URL.Query().Get("search"),
-		Page:          page,
-		Limit:         limit,
-	})
+	resp, err := h.Svc.List(r.Context(), filters)
 	if err != nil {
 		if errors.Is(err, services.ErrInvalidMarkingsRange) {
 			http.Error(w, "range invalido", http.StatusBadRequest)
@@ -138,6 +190,34 @@ func (h *MarkingsHandler) List(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Export descarga todas las marcaciones que cumplen los filtros (mismos filtros que el
+// listado, sin paginación). Por defecto CSV; format=xlsx para Excel.
+func (h *MarkingsHandler) Export(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	filters, err := parseMarkingsFilters(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	switch strings.ToLower(strings.TrimSpace(r.URL.Query().Get("format"))) {
+	case "", exportFormatCSV:
+		if exportFormat(r) == exportFormatXLSX {
+			h.exportXLSX(w, r, filters)
+			return
+		}
+		h.exportCSV(w, r, filters)
+	case exportFormatXLSX:
+		h.exportXLSX(w, r, filters)
+	default:
+		http.Error(w, "format must be 'csv' or 'xlsx'", http.StatusBadRequest)
+	}
+}
+
 func (h *MarkingsHandler) Filters(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
@@ -245,12 +325,19 @@ func (h *MarkingsHandler) Update(w http.ResponseWriter, r *http.Request, marking
 		}
 	}
 
+	editorID, ok := callerUserID(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
 	item, err := h.Svc.Update(r.Context(), markingID, services.UpdateMarkingInput{
 		WorkInAt:      req.WorkInAt,
 		WorkOutAt:     req.WorkOutAt,
 		BreakOutAt:    req.BreakOutAt,
 		BreakInAt:     req.BreakInAt,
 		Justification: req.Justification,
+		EditorID:      editorID,
 	})
 	if err != nil {
 		switch {
@@ -260,6 +347,9 @@ func (h *MarkingsHandler) Update(w http.ResponseWriter, r *http.Request, marking
 		case errors.Is(err, services.ErrMarkingNotFound):
 			http.Error(w, "Not Found", http.StatusNotFound)
 			return
+		case errors.Is(err, services.ErrOutOfScope):
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
 		default:
 			log.Printf("[markings] error updating marking %d: %v", markingID, err)
 			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
@@ -274,3 +364,209 @@ func (h *MarkingsHandler) Update(w http.ResponseWriter, r *http.Request, marking
 		return
 	}
 }
+
+// History godoc
+// @Summary      Historial de ediciones de una marcación
+// @Description  Todas las ediciones del día de asistencia en orden cronológico, con los valores antes/después de cada slot, quién editó, el motivo y la solicitud de corrección que la originó (si corresponde).
+// @Tags         Markings
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path     int  true  "ID del día de asistencia"
+// @Success      200  {array}  AttendanceEditDTO
+// @Failure      401  {object} ErrorResponse
+// @Failure      403  {object} ErrorResponse
+// @Failure      404  {object} ErrorResponse
+// @Failure      500  {object} ErrorResponse
+// @Router       /api/v1/markings/{id}/history [get]
+func (h *MarkingsHandler) History(w http.ResponseWriter, r *http.Request, markingID int) {
+	h.history(w, r, markingID, nil)
+}
+
+// UserHistory es el historial restringido a un día del usuario (autoservicio /me).
+func (h *MarkingsHandler) UserHistory(w http.ResponseWriter, r *http.Request, userID, markingID int) {
+	h.history(w, r, markingID, &userID)
+}
+
+func (h *MarkingsHandler) history(w http.ResponseWriter, r *http.Request, markingID int, userID *int) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	edits, err := h.Svc.History(r.Context(), markingID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrMarkingNotFound):
+			http.Error(w, "Not Found", http.StatusNotFound)
+		case errors.Is(err, services.ErrOutOfScope):
+			http.Error(w, "Forbidden", http.StatusForbidden)
+		default:
+			log.Printf("[markings] error loading history of marking %d: %v", markingID, err)
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	resp := make([]AttendanceEditDTO, 0, len(edits))
+	for _, e := range edits {
+		dto := AttendanceEditDTO{
+			ID:                  e.ID,
+			AttendanceDayID:     e.AttendanceDayID,
+			EditorID:            e.EditorID,
+			CorrectionRequestID: e.CorrectionRequestID,
+			Reason:              e.Reason,
+			WorkInAtBefore:      e.WorkInAtBefore,
+			WorkInAtAfter:       e.WorkInAtAfter,
+			BreakOutAtBefore:    e.BreakOutAtBefore,
+			BreakOutAtAfter:     e.BreakOutAtAfter,
+			BreakInAtBefore:     e.BreakInAtBefore,
+			BreakInAtAfter:      e.BreakInAtAfter,
+			WorkOutAtBefore:     e.WorkOutAtBefore,
+			WorkOutAtAfter:      e.WorkOutAtAfter,
+			CreatedAt:           e.CreatedAt,
+		}
+		if u := e.Edges.Editor; u != nil {
+			dto.EditorName = u.Username
+			if u.FirstName != nil || u.LastName != nil {
+				var first, last string
+				if u.FirstName != nil {
+					first = *u.FirstName
+				}
+				if u.LastName != nil {
+					last = *u.LastName
+				}
+				dto.EditorName = strings.TrimSpace(first + " " + last)
+			}
+		}
+		resp = append(resp, dto)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Printf("[markings] error encoding history response: %v", err)
+		http.Error(w, "error serializando respuesta", http.StatusInternalServerError)
+		return
+	}
+}
+
+var markingsExportColumns = []string{
+	"ID", "Fecha", "Usuario ID", "Nombre", "Sucursal", "Acceso",
+	"Entrada", "Salida colación", "Regreso colación", "Salida",
+	"Atraso (min)", "Estado entrada", "Dif. colación (min)", "Estado colación",
+	"Horas extra (min)", "HE autorizadas (min)", "HE no autorizadas (min)",
+	"Salida anticipada (min)", "Estado salida", "Balance neto (min)",
+	"Editado", "Motivo edición",
+}
+
+func markingsExportRow(it services.MarkingItem) []any {
+	var workDate any = it.WorkDate
+	if d, err := time.Parse("2006-01-02", it.WorkDate); err == nil {
+		workDate = xlsx.Date(d)
+	}
+	return []any{
+		it.ID, workDate, it.UserID, it.Name, it.BranchName, it.AccessPointName,
+		it.WorkInAt, it.BreakOutAt, it.BreakInAt, it.WorkOutAt,
+		it.LateMinutes, it.EntryStatus, it.BreakDiff, it.BreakStatus,
+		it.OvertimeMins, it.ApprovedOvertimeMins, it.UnapprovedOvertimeMins,
+		it.EarlyExitMins, it.ExitStatus, it.NetMinutes,
+		it.Edited, it.LastEditReason,
+	}
+}
+
+// exportXLSX genera el Excel con todas las marcaciones que cumplen los filtros.
+// Las filas se escriben a medida que se leen, sin tope de registros.
+func (h *MarkingsHandler) exportXLSX(w http.ResponseWriter, r *http.Request, f services.MarkingsFilters) {
+	var xw *xlsx.Writer
+	begin := func() error {
+		if xw != nil {
+			return nil
+		}
+		xw = startXLSXDownload(w, "marcaciones.xlsx")
+		if err := xw.AddSheet("Marcaciones"); err != nil {
+			return err
+		}
+		return xw.WriteHeader(markingsExportColumns...)
+	}
+
+	_, err := h.Svc.Export(r.Context(), f, func(it services.MarkingItem) error {
+		if err := begin(); err != nil {
+			return err
+		}
+		return xw.WriteRow(markingsExportRow(it)...)
+	})
+	if err != nil {
+		if xw != nil {
+			// la descarga ya comenzó: no se puede cambiar el status
+			log.Printf("[markings] error streaming xlsx export: %v", err)
+			return
+		}
+		if errors.Is(err, services.ErrInvalidMarkingsRange) {
+			http.Error(w, "range invalido", http.StatusBadRequest)
+			return
+		}
+		log.Printf("[markings] error exporting markings: %v", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	if err := begin(); err != nil {
+		log.Printf("[markings] error writing xlsx export: %v", err)
+		return
+	}
+	if err := xw.Close(); err != nil {
+		log.Printf("[markings] error closing xlsx export: %v", err)
+	}
+}
+
+// cada cuántas filas se envía el CSV acumulado al cliente
+const markingsCSVFlushEvery = 500
+
+// exportCSV escribe las marcaciones en CSV a medida que se leen del cursor, haciendo
+// flush cada markingsCSVFlushEvery filas para que la memoria se mantenga constante.
+func (h *MarkingsHandler) exportCSV(w http.ResponseWriter, r *http.Request, f services.MarkingsFilters) {
+	var cw *csv.Writer
+	rows := 0
+	begin := func() error {
+		if cw != nil {
+			return nil
+		}
+		cw = startCSVDownload(w, "marcaciones.csv")
+		return cw.Write(markingsExportColumns)
+	}
+
+	_, err := h.Svc.Export(r.Context(), f, func(it services.MarkingItem) error {
+		if err := begin(); err != nil {
+			return err
+		}
+		if err := cw.Write(csvRecord(markingsExportRow(it))); err != nil {
+			return err
+		}
+		rows++
+		if rows%markingsCSVFlushEvery == 0 {
+			return flushCSV(w, cw)
+		}
+		return nil
+	})
+	if err != nil {
+		if cw != nil {
+			// la descarga ya comenzó: no se puede cambiar el status
+			log.Printf("[markings] error streaming csv export: %v", err)
+			return
+		}
+		if errors.Is(err, services.ErrInvalidMarkingsRange) {
+			http.Error(w, "range invalido", http.StatusBadRequest)
+			return
+		}
+		log.Printf("[markings] error exporting markings: %v", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	if err := begin(); err != nil {
+		log.Printf("[markings] error writing csv export: %v", err)
+		return
+	}
+	if err := flushCSV(w, cw); err != nil {
+		log.Printf("[markings] error flushing csv export: %v", err)
+	}
+}
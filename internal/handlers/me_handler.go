@@ -0,0 +1,254 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// MeHandler agrupa el autoservicio del usuario autenticado (/api/v1/me/...). Cada ruta
+// resuelve el usuario desde el JWT y reutiliza el handler de la ruta por usuario, así
+// nadie puede consultar datos de otro cambiando un ID en la URL.
+type MeHandler struct {
+	Users        *UsersHandler
+	Markings     *MarkingsHandler
+	DayOverrides *UserDayOverrideHandler
+	HourBank     *HourBankHandler
+	Corrections  *MarkCorrectionHandler
+}
+
+func NewMeHandler(
+	users *UsersHandler,
+	markings *MarkingsHandler,
+	dayOverrides *UserDayOverrideHandler,
+	hourBank *HourBankHandler,
+	corrections *MarkCorrectionHandler,
+) *MeHandler {
+	return &MeHandler{
+		Users:        users,
+		Markings:     markings,
+		DayOverrides: dayOverrides,
+		HourBank:     hourBank,
+		Corrections:  corrections,
+	}
+}
+
+// MeSubroutes despacha /api/v1/me/{recurso}[/...].
+func (h *MeHandler) MeSubroutes(w http.ResponseWriter, r *http.Request) {
+	userID, ok := callerUserID(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) < 4 || parts[0] != "api" || parts[1] != "v1" || parts[2] != "me" {
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return
+	}
+
+	switch {
+	case len(parts) == 4 && parts[3] == "markings":
+		h.MyMarkings(w, r, userID)
+	case len(parts) == 6 && parts[3] == "markings" && parts[5] == "history":
+		id, err := strconv.Atoi(parts[4])
+		if err != nil || id <= 0 {
+			http.Error(w, "Not Found", http.StatusNotFound)
+			return
+		}
+		h.MyMarkingHistory(w, r, userID, id)
+	case len(parts) == 4 && parts[3] == "qr-session":
+		h.MyQRSession(w, r, userID)
+	case len(parts) == 4 && parts[3] == "day-overrides":
+		h.MyDayOverrides(w, r, userID)
+	case len(parts) == 4 && parts[3] == "hour-bank":
+		h.MyHourBank(w, r, userID)
+	case len(parts) == 5 && parts[3] == "hour-bank" && parts[4] == "movements":
+		h.MyHourBankMovements(w, r, userID)
+	case len(parts) == 4 && parts[3] == "correction-requests":
+		h.MyCorrectionRequests(w, r, userID)
+	case len(parts) == 5 && parts[3] == "correction-requests":
+		id, err := strconv.Atoi(parts[4])
+		if err != nil || id <= 0 {
+			http.Error(w, "Not Found", http.StatusNotFound)
+			return
+		}
+		h.MyCorrectionRequestByID(w, r, userID, id)
+	case len(parts) == 6 && parts[3] == "correction-requests" && parts[5] == "attachment":
+		id, err := strconv.Atoi(parts[4])
+		if err != nil || id <= 0 {
+			http.Error(w, "Not Found", http.StatusNotFound)
+			return
+		}
+		h.MyCorrectionRequestAttachment(w, r, userID, id)
+	default:
+		http.Error(w, "Not Found", http.StatusNotFound)
+	}
+}
+
+// MyMarkings godoc
+// @Summary      Mis marcaciones
+// @Description  Marcaciones del usuario autenticado con sus métricas (atraso, colación, salida anticipada, horas extra, balance neto) y el resumen del período. Mismos filtros que /api/v1/markings; format=xlsx|csv exporta.
+// @Tags         Me
+// @Produce      json
+// @Security     BearerAuth
+// @Param        range       query    string  false "today | week | month | custom"
+// @Param        start_date  query    string  false "Desde (YYYY-MM-DD)"
+// @Param        end_date    query    string  false "Hasta (YYYY-MM-DD)"
+// @Param        page        query    int     false "Página"
+// @Param        limit       query    int     false "Tamaño de página (máx. 200)"
+// @Param        format      query    string  false "json | xlsx | csv"
+// @Success      200         {object} services.MarkingsListResponse
+// @Failure      400         {object} ErrorResponse
+// @Failure      401         {object} ErrorResponse
+// @Failure      500         {object} ErrorResponse
+// @Router       /api/v1/me/markings [get]
+func (h *MeHandler) MyMarkings(w http.ResponseWriter, r *http.Request, userID int) {
+	h.Markings.UserMarkings(w, r, userID)
+}
+
+// MyMarkingHistory godoc
+// @Summary      Historial de ediciones de mi marcación
+// @Description  Ediciones de un día de asistencia del usuario autenticado, con los valores antes/después de cada slot, quién editó y el motivo.
+// @Tags         Me
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path     int  true  "ID del día de asistencia"
+// @Success      200  {array}  AttendanceEditDTO
+// @Failure      401  {object} ErrorResponse
+// @Failure      404  {object} ErrorResponse
+// @Failure      500  {object} ErrorResponse
+// @Router       /api/v1/me/markings/{id}/history [get]
+func (h *MeHandler) MyMarkingHistory(w http.ResponseWriter, r *http.Request, userID, id int) {
+	h.Markings.UserHistory(w, r, userID, id)
+}
+
+// MyQRSession godoc
+// @Summary      Generar mi QR de marcación
+// @Description  Genera un token QR para que el usuario autenticado marque asistencia desde la app.
+// @Tags         Me
+// @Produce      json
+// @Security     BearerAuth
+// @Success      201  {object} services.QRResponse
+// @Failure      401  {object} ErrorResponse
+// @Failure      404  {object} ErrorResponse
+// @Failure      500  {object} ErrorResponse
+// @Router       /api/v1/me/qr-session [post]
+func (h *MeHandler) MyQRSession(w http.ResponseWriter, r *http.Request, userID int) {
+	h.Users.GenerateQRSession(w, r, userID)
+}
+
+// MyDayOverrides godoc
+// @Summary      Mis cambios de jornada
+// @Description  Excepciones diarias (turno puntual, día libre, modalidad) del usuario autenticado.
+// @Tags         Me
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {array}  UserDayOverrideDTO
+// @Failure      401  {object} ErrorResponse
+// @Failure      500  {object} ErrorResponse
+// @Router       /api/v1/me/day-overrides [get]
+func (h *MeHandler) MyDayOverrides(w http.ResponseWriter, r *http.Request, userID int) {
+	// solo lectura: los cambios de jornada los asigna un supervisor
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	h.DayOverrides.Overrides(w, r, userID)
+}
+
+// MyHourBank godoc
+// @Summary      Mi bolsa de horas
+// @Description  Saldo acumulado y movimientos de la bolsa de horas del usuario autenticado en el período.
+// @Tags         Me
+// @Produce      json
+// @Security     BearerAuth
+// @Param        start_date  query    string false "Desde (YYYY-MM-DD), por defecto inicio del mes de end_date"
+// @Param        end_date    query    string false "Hasta (YYYY-MM-DD), por defecto hoy"
+// @Success      200         {object} HourBankLedgerDTO
+// @Failure      400         {object} ErrorResponse
+// @Failure      401         {object} ErrorResponse
+// @Failure      500         {object} ErrorResponse
+// @Router       /api/v1/me/hour-bank [get]
+func (h *MeHandler) MyHourBank(w http.ResponseWriter, r *http.Request, userID int) {
+	h.HourBank.HourBankLedger(w, r, userID)
+}
+
+// MyHourBankMovements godoc
+// @Summary      Movimientos de mi bolsa de horas
+// @Description  Movimientos (diarios, abonos y cargos) de la bolsa de horas del usuario autenticado.
+// @Tags         Me
+// @Produce      json
+// @Security     BearerAuth
+// @Param        start_date  query    string false "Desde (YYYY-MM-DD)"
+// @Param        end_date    query    string false "Hasta (YYYY-MM-DD)"
+// @Success      200         {array}  HourBankMovementDTO
+// @Failure      400         {object} ErrorResponse
+// @Failure      401         {object} ErrorResponse
+// @Failure      500         {object} ErrorResponse
+// @Router       /api/v1/me/hour-bank/movements [get]
+func (h *MeHandler) MyHourBankMovements(w http.ResponseWriter, r *http.Request, userID int) {
+	// los abonos/cargos manuales son del administrador
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	h.HourBank.HourBankMovements(w, r, userID)
+}
+
+// MyCorrectionRequests godoc
+// @Summary      Mis solicitudes de corrección de marcación
+// @Description  GET lista las solicitudes del usuario autenticado. POST solicita corregir una de sus marcaciones (por ejemplo, una salida no registrada) con los horarios propuestos en HH:MM y el motivo; queda pendiente de revisión. Como multipart se puede adjuntar un respaldo en el campo "file" (JPG, PNG o PDF, máx. 5 MB).
+// @Tags         Me
+// @Accept       json
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     BearerAuth
+// @Param        status  query    string                       false "requested | approved | rejected | cancelled"
+// @Param        body    body     createMarkCorrectionRequest  false "Crear solicitud (solo POST)"
+// @Success      200     {array}  MarkCorrectionDTO
+// @Success      201     {object} MarkCorrectionDTO
+// @Failure      400     {object} ErrorResponse
+// @Failure      401     {object} ErrorResponse
+// @Failure      404     {object} ErrorResponse
+// @Failure      409     {object} ErrorResponse
+// @Failure      500     {object} ErrorResponse
+// @Router       /api/v1/me/correction-requests [get]
+// @Router       /api/v1/me/correction-requests [post]
+func (h *MeHandler) MyCorrectionRequests(w http.ResponseWriter, r *http.Request, userID int) {
+	h.Corrections.UserCorrections(w, r, userID)
+}
+
+// MyCorrectionRequestByID godoc
+// @Summary      Detalle o cancelación de mi solicitud de corrección
+// @Description  GET detalle. DELETE cancela la solicitud si sigue pendiente.
+// @Tags         Me
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path     int  true  "ID de la solicitud"
+// @Success      200  {object} MarkCorrectionDTO
+// @Failure      401  {object} ErrorResponse
+// @Failure      404  {object} ErrorResponse
+// @Failure      409  {object} ErrorResponse
+// @Failure      500  {object} ErrorResponse
+// @Router       /api/v1/me/correction-requests/{id} [get]
+// @Router       /api/v1/me/correction-requests/{id} [delete]
+func (h *MeHandler) MyCorrectionRequestByID(w http.ResponseWriter, r *http.Request, userID, id int) {
+	h.Corrections.UserCorrectionByID(w, r, userID, id)
+}
+
+// MyCorrectionRequestAttachment godoc
+// @Summary      Respaldo de mi solicitud de corrección
+// @Description  Descarga el archivo adjunto a la solicitud.
+// @Tags         Me
+// @Produce      application/octet-stream
+// @Security     BearerAuth
+// @Param        id   path     int  true  "ID de la solicitud"
+// @Success      200  {file}   file
+// @Failure      401  {object} ErrorResponse
+// @Failure      404  {object} ErrorResponse
+// @Failure      500  {object} ErrorResponse
+// @Router       /api/v1/me/correction-requests/{id}/attachment [get]
+func (h *MeHandler) MyCorrectionRequestAttachment(w http.ResponseWriter, r *http.Request, userID, id int) {
+	h.Corrections.UserCorrectionAttachment(w, r, userID, id)
+}
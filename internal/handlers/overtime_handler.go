@@ -0,0 +1,514 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"back/internal/auth"
+	"back/internal/ent"
+	"back/internal/services"
+)
+
+type OvertimeHandler struct {
+	Svc *services.OvertimeService
+}
+
+func NewOvertimeHandler(svc *services.OvertimeService) *OvertimeHandler {
+	return &OvertimeHandler{Svc: svc}
+}
+
+/* =========================
+   REQUESTS
+   ========================= */
+
+type createOvertimeAuthorizationRequest struct {
+	// supervisor/admin puede autorizar a nombre de otro usuario (queda aprobada de inmediato)
+	UserID     *int    `json:"user_id,omitempty" example:"10"`
+	Date       string  `json:"date" example:"2026-02-02"`
+	MaxMinutes int     `json:"max_minutes" example:"120"`
+	Reason     *string `json:"reason,omitempty" example:"Cierre de inventario"`
+}
+
+type reviewOvertimeAuthorizationRequest struct {
+	Action string `json:"action" example:"approve"` // approve | reject
+	// opcional: ajusta el máximo autorizado al aprobar
+	MaxMinutes *int    `json:"max_minutes,omitempty" example:"90"`
+	Notes      *string `json:"notes,omitempty" example:"Aprobado"`
+}
+
+/* =========================
+   DTOs
+   ========================= */
+
+type OvertimeAuthorizationDTO struct {
+	ID            int        `json:"id" example:"1"`
+	UserID        int        `json:"user_id" example:"10"`
+	Date          string     `json:"date" example:"2026-02-02"`
+	MaxMinutes    int        `json:"max_minutes" example:"120"`
+	Status        string     `json:"status" example:"approved"`
+	Reason        *string    `json:"reason,omitempty" example:"Cierre de inventario"`
+	RequestedByID *int       `json:"requested_by_id,omitempty" example:"10"`
+	ApprovedByID  *int       `json:"approved_by_id,omitempty" example:"1"`
+	ReviewedAt    *time.Time `json:"reviewed_at,omitempty"`
+	ReviewNotes   *string    `json:"review_notes,omitempty" example:"Aprobado"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+type PendingOvertimeDTO struct {
+	AttendanceDayID           int        `json:"attendance_day_id" example:"55"`
+	UserID                    int        `json:"user_id" example:"10"`
+	UserName                  string     `json:"user_name" example:"Juan Pérez"`
+	BranchID                  int        `json:"branch_id" example:"2"`
+	BranchName                string     `json:"branch_name" example:"Casa Matriz"`
+	WorkDate                  string     `json:"work_date" example:"2026-02-02"`
+	WorkOutAt                 *time.Time `json:"work_out_at,omitempty"`
+	OvertimeMinutes           int        `json:"overtime_minutes" example:"75"`
+	ApprovedOvertimeMinutes   int        `json:"approved_overtime_minutes" example:"60"`
+	UnapprovedOvertimeMinutes int        `json:"unapproved_overtime_minutes" example:"15"`
+}
+
+/* =========================
+   ROUTES
+   ========================= */
+
+// OvertimeAuthorizations godoc
+// @Summary      Autorizaciones de horas extra
+// @Description  GET lista autorizaciones (supervisor/admin: las de sus sucursales con filtros; usuario: solo las propias). POST crea una autorización: si la crea un supervisor/admin queda aprobada; si la pide el trabajador queda pendiente.
+// @Tags         Overtime
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user_id     query    int                                  false "Filtrar por usuario (supervisor/admin)"
+// @Param        branch_id   query    int                                  false "Filtrar por sucursal (supervisor/admin)"
+// @Param        status      query    string                               false "requested | approved | rejected | cancelled"
+// @Param        start_date  query    string                               false "Desde (YYYY-MM-DD)"
+// @Param        end_date    query    string                               false "Hasta (YYYY-MM-DD)"
+// @Param        body        body     createOvertimeAuthorizationRequest  false "Crear autorización (solo POST)"
+// @Success      200         {array}  OvertimeAuthorizationDTO
+// @Success      201         {object} OvertimeAuthorizationDTO
+// @Failure      400         {object} ErrorResponse
+// @Failure      401         {object} ErrorResponse
+// @Failure      403         {object} ErrorResponse
+// @Failure      404         {object} ErrorResponse
+// @Failure      409         {object} ErrorResponse
+// @Failure      500         {object} ErrorResponse
+// @Router       /api/v1/overtime-authorizations [get]
+// @Router       /api/v1/overtime-authorizations [post]
+func (h *OvertimeHandler) OvertimeAuthorizations(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.list(w, r)
+	case http.MethodPost:
+		h.create(w, r)
+	default:
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// OvertimeAuthorizationSubroutes godoc
+// @Summary      Detalle, revisión y cancelación de autorización de horas extra
+// @Description  GET /{id} detalle. POST /{id}/review aprueba o rechaza (supervisor/admin). POST /{id}/cancel cancela (dueño o admin).
+// @Tags         Overtime
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path     int                                  true  "ID de la autorización"
+// @Param        body  body     reviewOvertimeAuthorizationRequest  false "Revisión (solo /review)"
+// @Success      200   {object} OvertimeAuthorizationDTO
+// @Failure      400   {object} ErrorResponse
+// @Failure      401   {object} ErrorResponse
+// @Failure      403   {object} ErrorResponse
+// @Failure      404   {object} ErrorResponse
+// @Failure      409   {object} ErrorResponse
+// @Failure      500   {object} ErrorResponse
+// @Router       /api/v1/overtime-authorizations/{id} [get]
+// @Router       /api/v1/overtime-authorizations/{id}/review [post]
+// @Router       /api/v1/overtime-authorizations/{id}/cancel [post]
+func (h *OvertimeHandler) OvertimeAuthorizationSubroutes(w http.ResponseWriter, r *http.Request) {
+	id, action, ok := parseOvertimeAuthorizationPath(r.URL.Path)
+	if !ok {
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return
+	}
+
+	switch action {
+	case "":
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		h.get(w, r, id)
+	case "review":
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		h.review(w, r, id)
+	case "cancel":
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		h.cancel(w, r, id)
+	default:
+		http.Error(w, "Not Found", http.StatusNotFound)
+	}
+}
+
+// OvertimePending godoc
+// @Summary      Cola de horas extra no autorizadas
+// @Description  Lista los días con horas extra sin autorización previa (o sobre el máximo autorizado) que aún no han sido rechazadas. Solo supervisor/admin.
+// @Tags         Overtime
+// @Produce      json
+// @Security     BearerAuth
+// @Param        branch_id   query    int     false "Filtrar por sucursal"
+// @Param        start_date  query    string  false "Desde (YYYY-MM-DD)"
+// @Param        end_date    query    string  false "Hasta (YYYY-MM-DD)"
+// @Success      200         {array}  PendingOvertimeDTO
+// @Failure      400         {object} ErrorResponse
+// @Failure      401         {object} ErrorResponse
+// @Failure      403         {object} ErrorResponse
+// @Failure      500         {object} ErrorResponse
+// @Router       /api/v1/overtime/pending [get]
+func (h *OvertimeHandler) OvertimePending(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !hasPermission(r, auth.PermOvertimeReview) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	q := r.URL.Query()
+	branchID, err := parseOptionalPositiveInt(q.Get("branch_id"))
+	if err != nil {
+		http.Error(w, "branch_id invalido", http.StatusBadRequest)
+		return
+	}
+	startDate, err := parseOptionalDate(q.Get("start_date"))
+	if err != nil {
+		http.Error(w, "start_date invalido", http.StatusBadRequest)
+		return
+	}
+	endDate, err := parseOptionalDate(q.Get("end_date"))
+	if err != nil {
+		http.Error(w, "end_date invalido", http.StatusBadRequest)
+		return
+	}
+
+	days, err := h.Svc.Pending(r.Context(), services.PendingOvertimeFilters{
+		BranchID:  branchID,
+		StartDate: startDate,
+		EndDate:   endDate,
+	})
+	if err != nil {
+		writeOvertimeError(w, err)
+		return
+	}
+
+	resp := make([]PendingOvertimeDTO, 0, len(days))
+	for _, ad := range days {
+		resp = append(resp, mapPendingOvertime(ad))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+/* =========================
+   INTERNAL
+   ========================= */
+
+func (h *OvertimeHandler) list(w http.ResponseWriter, r *http.Request) {
+	callerID, ok := callerUserID(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	q := r.URL.Query()
+	var f services.OvertimeAuthorizationFilters
+
+	if hasPermission(r, auth.PermOvertimeReview) {
+		userID, err := parseOptionalPositiveInt(q.Get("user_id"))
+		if err != nil {
+			http.Error(w, "user_id invalido", http.StatusBadRequest)
+			return
+		}
+		branchID, err := parseOptionalPositiveInt(q.Get("branch_id"))
+		if err != nil {
+			http.Error(w, "branch_id invalido", http.StatusBadRequest)
+			return
+		}
+		f.UserID = userID
+		f.BranchID = branchID
+	} else {
+		f.UserID = &callerID
+	}
+
+	if v := strings.TrimSpace(q.Get("status")); v != "" {
+		f.Status = &v
+	}
+	startDate, err := parseOptionalDate(q.Get("start_date"))
+	if err != nil {
+		http.Error(w, "start_date invalido", http.StatusBadRequest)
+		return
+	}
+	endDate, err := parseOptionalDate(q.Get("end_date"))
+	if err != nil {
+		http.Error(w, "end_date invalido", http.StatusBadRequest)
+		return
+	}
+	f.StartDate = startDate
+	f.EndDate = endDate
+
+	items, err := h.Svc.List(r.Context(), f)
+	if err != nil {
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]OvertimeAuthorizationDTO, 0, len(items))
+	for _, oa := range items {
+		resp = append(resp, mapOvertimeAuthorization(oa))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (h *OvertimeHandler) create(w http.ResponseWriter, r *http.Request) {
+	callerID, ok := callerUserID(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req createOvertimeAuthorizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	userID := callerID
+	if req.UserID != nil && *req.UserID != callerID {
+		if !hasPermission(r, auth.PermOvertimeReview) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		userID = *req.UserID
+	}
+
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		http.Error(w, "Invalid date format, expected YYYY-MM-DD", http.StatusBadRequest)
+		return
+	}
+
+	// el pacto lo aprueba quien lo registra si es supervisor/admin, salvo que sea para
+	// sí mismo: ese queda pendiente como cualquier solicitud del trabajador
+	approverID := 0
+	if userID != callerID && hasPermission(r, auth.PermOvertimeReview) {
+		approverID = callerID
+	}
+
+	oa, err := h.Svc.Create(r.Context(), callerID, approverID, services.CreateOvertimeAuthorizationInput{
+		UserID:     userID,
+		Date:       date,
+		MaxMinutes: req.MaxMinutes,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		writeOvertimeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(mapOvertimeAuthorization(oa))
+}
+
+func (h *OvertimeHandler) get(w http.ResponseWriter, r *http.Request, id int) {
+	callerID, ok := callerUserID(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	oa, err := h.Svc.GetByID(r.Context(), id)
+	if err != nil {
+		writeOvertimeError(w, err)
+		return
+	}
+	if oa.UserID != callerID && !hasPermission(r, auth.PermOvertimeReview) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(mapOvertimeAuthorization(oa))
+}
+
+func (h *OvertimeHandler) review(w http.ResponseWriter, r *http.Request, id int) {
+	if !hasPermission(r, auth.PermOvertimeReview) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+	reviewerID, ok := callerUserID(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req reviewOvertimeAuthorizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	var approve bool
+	switch strings.ToLower(strings.TrimSpace(req.Action)) {
+	case "approve":
+		approve = true
+	case "reject":
+		approve = false
+	default:
+		http.Error(w, "action must be 'approve' or 'reject'", http.StatusBadRequest)
+		return
+	}
+
+	oa, err := h.Svc.Review(r.Context(), id, reviewerID, approve, req.MaxMinutes, req.Notes)
+	if err != nil {
+		writeOvertimeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(mapOvertimeAuthorization(oa))
+}
+
+func (h *OvertimeHandler) cancel(w http.ResponseWriter, r *http.Request, id int) {
+	callerID, ok := callerUserID(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	current, err := h.Svc.GetByID(r.Context(), id)
+	if err != nil {
+		writeOvertimeError(w, err)
+		return
+	}
+	if current.UserID != callerID && !hasPermission(r, auth.PermOvertimeManage) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	oa, err := h.Svc.Cancel(r.Context(), id)
+	if err != nil {
+		writeOvertimeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(mapOvertimeAuthorization(oa))
+}
+
+func writeOvertimeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrOvertimeInvalidInput):
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+	case errors.Is(err, services.ErrOvertimeSelfReview),
+		errors.Is(err, services.ErrOutOfScope):
+		http.Error(w, "Forbidden", http.StatusForbidden)
+	case errors.Is(err, services.ErrOvertimeDuplicate),
+		errors.Is(err, services.ErrOvertimeInvalidState):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case ent.IsNotFound(err):
+		http.Error(w, "Not Found", http.StatusNotFound)
+	default:
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
+
+func mapOvertimeAuthorization(oa *ent.OvertimeAuthorization) OvertimeAuthorizationDTO {
+	return OvertimeAuthorizationDTO{
+		ID:            oa.ID,
+		UserID:        oa.UserID,
+		Date:          oa.Date.Format("2006-01-02"),
+		MaxMinutes:    oa.MaxMinutes,
+		Status:        oa.Status,
+		Reason:        oa.Reason,
+		RequestedByID: oa.RequestedByID,
+		ApprovedByID:  oa.ApprovedByID,
+		ReviewedAt:    oa.ReviewedAt,
+		ReviewNotes:   oa.ReviewNotes,
+		CreatedAt:     oa.CreatedAt,
+	}
+}
+
+func mapPendingOvertime(ad *ent.AttendanceDay) PendingOvertimeDTO {
+	dto := PendingOvertimeDTO{
+		AttendanceDayID: ad.ID,
+		UserID:          ad.UserID,
+		BranchID:        ad.BranchID,
+		WorkDate:        ad.WorkDate.Format("2006-01-02"),
+		WorkOutAt:       ad.WorkOutAt,
+	}
+	if ad.OvertimeMinutes != nil {
+		dto.OvertimeMinutes = *ad.OvertimeMinutes
+	}
+	if ad.ApprovedOvertimeMinutes != nil {
+		dto.ApprovedOvertimeMinutes = *ad.ApprovedOvertimeMinutes
+	}
+	if ad.UnapprovedOvertimeMinutes != nil {
+		dto.UnapprovedOvertimeMinutes = *ad.UnapprovedOvertimeMinutes
+	}
+	if u := ad.Edges.User; u != nil {
+		dto.UserName = u.Username
+		if u.FirstName != nil || u.LastName != nil {
+			var first, last string
+			if u.FirstName != nil {
+				first = *u.FirstName
+			}
+			if u.LastName != nil {
+				last = *u.LastName
+			}
+			dto.UserName = strings.TrimSpace(first + " " + last)
+		}
+	}
+	if b := ad.Edges.Branch; b != nil {
+		dto.BranchName = b.Name
+	}
+	return dto
+}
+
+/* =========================
+   PATH PARSERS (ServeMux)
+   ========================= */
+
+// /api/v1/overtime-authorizations/{id}
+// /api/v1/overtime-authorizations/{id}/{action}
+func parseOvertimeAuthorizationPath(path string) (int, string, bool) {
+	trimmed := strings.Trim(path, "/")
+	parts := strings.Split(trimmed, "/")
+	if len(parts) != 4 && len(parts) != 5 {
+		return 0, "", false
+	}
+	if parts[0] != "api" || parts[1] != "v1" || parts[2] != "overtime-authorizations" {
+		return 0, "", false
+	}
+	id, err := strconv.Atoi(parts[3])
+	if err != nil || id <= 0 {
+		return 0, "", false
+	}
+	if len(parts) == 5 {
+		return id, parts[4], true
+	}
+	return id, "", true
+}
@@ -0,0 +1,449 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"back/internal/auth"
+	"back/internal/ent"
+	"back/internal/ent/schema"
+	"back/internal/services"
+)
+
+type PayrollHandler struct {
+	Svc *services.PayrollExportService
+}
+
+func NewPayrollHandler(svc *services.PayrollExportService) *PayrollHandler {
+	return &PayrollHandler{Svc: svc}
+}
+
+/* =========================
+   REQUESTS
+   ========================= */
+
+type payrollColumnRequest struct {
+	Field  string `json:"field" example:"employee_code"`
+	Header string `json:"header,omitempty" example:"RUT"`
+	Value  string `json:"value,omitempty" example:""`
+}
+
+type payrollProfileRequest struct {
+	Name             *string                 `json:"name,omitempty" example:"Buk"`
+	Description      *string                 `json:"description,omitempty" example:"Archivo mensual de asistencia para Buk"`
+	Separator        *string                 `json:"separator,omitempty" example:";"`
+	DateFormat       *string                 `json:"date_format,omitempty" example:"DD/MM/YYYY"`
+	DecimalSeparator *string                 `json:"decimal_separator,omitempty" example:","`
+	Period           *string                 `json:"period,omitempty" example:"month"` // month | week | day
+	IncludeHeader    *bool                   `json:"include_header,omitempty" example:"true"`
+	Columns          *[]payrollColumnRequest `json:"columns,omitempty"`
+}
+
+/* =========================
+   DTOs
+   ========================= */
+
+type PayrollColumnDTO struct {
+	Field  string `json:"field" example:"worked_days"`
+	Header string `json:"header,omitempty" example:"DIAS_TRAB"`
+	Value  string `json:"value,omitempty" example:""`
+}
+
+type PayrollProfileDTO struct {
+	ID               int                `json:"id" example:"1"`
+	Name             string             `json:"name" example:"Buk"`
+	Description      *string            `json:"description,omitempty" example:"Archivo mensual de asistencia para Buk"`
+	Separator        string             `json:"separator" example:";"`
+	DateFormat       string             `json:"date_format" example:"DD/MM/YYYY"`
+	DecimalSeparator string             `json:"decimal_separator" example:","`
+	Period           string             `json:"period" example:"month"`
+	IncludeHeader    bool               `json:"include_header" example:"true"`
+	Columns          []PayrollColumnDTO `json:"columns"`
+	CreatedAt        time.Time          `json:"created_at"`
+	UpdatedAt        time.Time          `json:"updated_at"`
+}
+
+type PayrollFieldsResponse struct {
+	Fields []string `json:"fields"`
+}
+
+/* =========================
+   ROUTES
+   ========================= */
+
+// PayrollProfiles godoc
+// @Summary      Perfiles de exportación de remuneraciones
+// @Description  GET lista los perfiles (supervisor/admin). POST crea un perfil (solo admin): columnas y su orden, separador, formato de fecha y agregación (month | week | day).
+// @Tags         Payroll
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body     payrollProfileRequest  false "Crear perfil (solo POST)"
+// @Success      200   {array}  PayrollProfileDTO
+// @Success      201   {object} PayrollProfileDTO
+// @Failure      400   {object} ErrorResponse
+// @Failure      401   {object} ErrorResponse
+// @Failure      403   {object} ErrorResponse
+// @Failure      409   {object} ErrorResponse
+// @Failure      500   {object} ErrorResponse
+// @Router       /api/v1/payroll/profiles [get]
+// @Router       /api/v1/payroll/profiles [post]
+func (h *PayrollHandler) PayrollProfiles(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.list(w, r)
+	case http.MethodPost:
+		h.create(w, r)
+	default:
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// PayrollFields godoc
+// @Summary      Campos disponibles para perfiles de remuneraciones
+// @Description  Lista los datos que se pueden mapear a columnas (field). "constant" escribe el valor fijo de la columna.
+// @Tags         Payroll
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object} PayrollFieldsResponse
+// @Failure      401  {object} ErrorResponse
+// @Failure      403  {object} ErrorResponse
+// @Router       /api/v1/payroll/fields [get]
+func (h *PayrollHandler) PayrollFields(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !hasPermission(r, auth.PermReportsExport) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(PayrollFieldsResponse{Fields: services.PayrollFields})
+}
+
+// PayrollProfileSubroutes godoc
+// @Summary      Detalle, edición y exportación de un perfil de remuneraciones
+// @Description  GET /{id} detalle (supervisor/admin). PATCH /{id} edita y DELETE /{id} elimina (solo admin). GET /{id}/export?month=YYYY-MM genera el archivo del mes, una fila por employee_code y período (supervisor/admin). Los trabajadores sin employee_code se omiten y se informan en el header X-Payroll-Skipped-Users.
+// @Tags         Payroll
+// @Accept       json
+// @Produce      json
+// @Produce      text/csv
+// @Security     BearerAuth
+// @Param        id         path     int                    true  "ID del perfil"
+// @Param        month      query    string                 false "Mes a exportar YYYY-MM (solo /export)"
+// @Param        branch_id  query    int                    false "Sucursal (solo /export)"
+// @Param        body       body     payrollProfileRequest  false "Patch perfil (solo PATCH)"
+// @Success      200        {object} PayrollProfileDTO
+// @Success      204        "No Content"
+// @Failure      400        {object} ErrorResponse
+// @Failure      401        {object} ErrorResponse
+// @Failure      403        {object} ErrorResponse
+// @Failure      404        {object} ErrorResponse
+// @Failure      409        {object} ErrorResponse
+// @Failure      500        {object} ErrorResponse
+// @Router       /api/v1/payroll/profiles/{id} [get]
+// @Router       /api/v1/payroll/profiles/{id} [patch]
+// @Router       /api/v1/payroll/profiles/{id} [delete]
+// @Router       /api/v1/payroll/profiles/{id}/export [get]
+func (h *PayrollHandler) PayrollProfileSubroutes(w http.ResponseWriter, r *http.Request) {
+	id, action, ok := parsePayrollProfilePath(r.URL.Path)
+	if !ok {
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return
+	}
+
+	switch action {
+	case "":
+		switch r.Method {
+		case http.MethodGet:
+			h.get(w, r, id)
+		case http.MethodPatch:
+			h.patch(w, r, id)
+		case http.MethodDelete:
+			h.delete(w, r, id)
+		default:
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		}
+	case "export":
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		h.export(w, r, id)
+	default:
+		http.Error(w, "Not Found", http.StatusNotFound)
+	}
+}
+
+/* =========================
+   INTERNAL
+   ========================= */
+
+func (h *PayrollHandler) list(w http.ResponseWriter, r *http.Request) {
+	if !hasPermission(r, auth.PermReportsExport) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	items, err := h.Svc.List(r.Context())
+	if err != nil {
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]PayrollProfileDTO, 0, len(items))
+	for _, p := range items {
+		resp = append(resp, mapPayrollProfile(p))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (h *PayrollHandler) get(w http.ResponseWriter, r *http.Request, id int) {
+	if !hasPermission(r, auth.PermReportsExport) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	p, err := h.Svc.Get(r.Context(), id)
+	if err != nil {
+		writePayrollError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(mapPayrollProfile(p))
+}
+
+func (h *PayrollHandler) create(w http.ResponseWriter, r *http.Request) {
+	if !hasPermission(r, auth.PermPayrollManage) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	var req payrollProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	p, err := h.Svc.Create(r.Context(), req.toInput())
+	if err != nil {
+		writePayrollError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(mapPayrollProfile(p))
+}
+
+func (h *PayrollHandler) patch(w http.ResponseWriter, r *http.Request, id int) {
+	if !hasPermission(r, auth.PermPayrollManage) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	var req payrollProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	p, err := h.Svc.Patch(r.Context(), id, req.toInput())
+	if err != nil {
+		writePayrollError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(mapPayrollProfile(p))
+}
+
+func (h *PayrollHandler) delete(w http.ResponseWriter, r *http.Request, id int) {
+	if !hasPermission(r, auth.PermPayrollManage) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	if err := h.Svc.Delete(r.Context(), id); err != nil {
+		writePayrollError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *PayrollHandler) export(w http.ResponseWriter, r *http.Request, id int) {
+	if !hasPermission(r, auth.PermReportsExport) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	q := r.URL.Query()
+
+	month := time.Now()
+	if v := strings.TrimSpace(q.Get("month")); v != "" {
+		m, err := time.Parse("2006-01", v)
+		if err != nil {
+			http.Error(w, "month invalido (formato: YYYY-MM)", http.StatusBadRequest)
+			return
+		}
+		month = m
+	}
+	branchID, err := parseOptionalPositiveInt(q.Get("branch_id"))
+	if err != nil {
+		http.Error(w, "branch_id invalido", http.StatusBadRequest)
+		return
+	}
+
+	res, err := h.Svc.Render(r.Context(), id, month, branchID)
+	if err != nil {
+		writePayrollError(w, err)
+		return
+	}
+
+	body, err := renderPayrollFile(res)
+	if err != nil {
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	filename := fmt.Sprintf("remuneraciones_%s_%s.csv", payrollFileSlug(res.Profile.Name), res.Month.Format("200601"))
+	if len(res.SkippedUserIDs) > 0 {
+		ids := make([]string, 0, len(res.SkippedUserIDs))
+		for _, uID := range res.SkippedUserIDs {
+			ids = append(ids, strconv.Itoa(uID))
+		}
+		w.Header().Set("X-Payroll-Skipped-Users", strings.Join(ids, ","))
+	}
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	_, _ = w.Write(body)
+}
+
+func (req payrollProfileRequest) toInput() services.PayrollProfileInput {
+	in := services.PayrollProfileInput{
+		Name:             req.Name,
+		Description:      req.Description,
+		Separator:        req.Separator,
+		DateFormat:       req.DateFormat,
+		DecimalSeparator: req.DecimalSeparator,
+		Period:           req.Period,
+		IncludeHeader:    req.IncludeHeader,
+	}
+	if req.Columns != nil {
+		cols := make([]schema.PayrollColumn, 0, len(*req.Columns))
+		for _, c := range *req.Columns {
+			cols = append(cols, schema.PayrollColumn{Field: c.Field, Header: c.Header, Value: c.Value})
+		}
+		in.Columns = &cols
+	}
+	return in
+}
+
+// renderPayrollFile escribe el archivo plano con el separador del perfil.
+// Sin BOM: los sistemas de remuneraciones suelen rechazarlo.
+func renderPayrollFile(res *services.PayrollExport) ([]byte, error) {
+	var buf bytes.Buffer
+	cw := csv.NewWriter(&buf)
+	cw.Comma, _ = utf8.DecodeRuneInString(res.Profile.Separator)
+	cw.UseCRLF = true
+
+	if res.Header != nil {
+		if err := cw.Write(res.Header); err != nil {
+			return nil, err
+		}
+	}
+	if err := cw.WriteAll(res.Rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// payrollFileSlug deja solo letras, números y guiones para el nombre del archivo.
+func payrollFileSlug(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9'):
+			b.WriteRune(r)
+		case r == ' ' || r == '-' || r == '_':
+			b.WriteByte('_')
+		}
+	}
+	if b.Len() == 0 {
+		return "perfil"
+	}
+	return b.String()
+}
+
+func writePayrollError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrPayrollInvalidInput):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, services.ErrPayrollProfileNameTaken):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, services.ErrOutOfScope):
+		http.Error(w, "Forbidden", http.StatusForbidden)
+	case ent.IsNotFound(err):
+		http.Error(w, "Not Found", http.StatusNotFound)
+	default:
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
+
+func mapPayrollProfile(p *ent.PayrollExportProfile) PayrollProfileDTO {
+	cols := make([]PayrollColumnDTO, 0, len(p.Columns))
+	for _, c := range p.Columns {
+		cols = append(cols, PayrollColumnDTO{Field: c.Field, Header: c.Header, Value: c.Value})
+	}
+	return PayrollProfileDTO{
+		ID:               p.ID,
+		Name:             p.Name,
+		Description:      p.Description,
+		Separator:        p.Separator,
+		DateFormat:       p.DateFormat,
+		DecimalSeparator: p.DecimalSeparator,
+		Period:           p.Period,
+		IncludeHeader:    p.IncludeHeader,
+		Columns:          cols,
+		CreatedAt:        p.CreatedAt,
+		UpdatedAt:        p.UpdatedAt,
+	}
+}
+
+/* =========================
+   PATH PARSERS (ServeMux)
+   ========================= */
+
+// /api/v1/payroll/profiles/{id}[/export]
+func parsePayrollProfilePath(path string) (int, string, bool) {
+	trimmed := strings.Trim(path, "/")
+	parts := strings.Split(trimmed, "/")
+	if len(parts) != 5 && len(parts) != 6 {
+		return 0, "", false
+	}
+	if parts[0] != "api" || parts[1] != "v1" || parts[2] != "payroll" || parts[3] != "profiles" {
+		return 0, "", false
+	}
+	id, err := strconv.Atoi(parts[4])
+	if err != nil || id <= 0 {
+		return 0, "", false
+	}
+	if len(parts) == 6 {
+		return id, parts[5], true
+	}
+	return id, "", true
+}
@@ -0,0 +1,257 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"back/internal/auth"
+	"back/internal/ent"
+	"back/internal/services"
+)
+
+type RoleHandler struct {
+	Svc *services.RoleService
+}
+
+func NewRoleHandler(svc *services.RoleService) *RoleHandler {
+	return &RoleHandler{Svc: svc}
+}
+
+/* =========================
+   REQUESTS
+   ========================= */
+
+type roleRequest struct {
+	Name        *string   `json:"name,omitempty" example:"rrhh"`
+	Description *string   `json:"description,omitempty" example:"Recursos humanos"`
+	Permissions *[]string `json:"permissions,omitempty" example:"users:read,vacation:manage"`
+}
+
+/* =========================
+   DTOs
+   ========================= */
+
+type RoleDTO struct {
+	ID          int       `json:"id" example:"5"`
+	Name        string    `json:"name" example:"rrhh"`
+	Description *string   `json:"description,omitempty" example:"Recursos humanos"`
+	Permissions []string  `json:"permissions"`
+	IsSystem    bool      `json:"is_system" example:"false"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+/* =========================
+   ROUTES
+   ========================= */
+
+// Roles godoc
+// @Summary      Roles
+// @Description  GET lista los roles con sus permisos. POST crea un rol con permisos del catálogo (ver /api/v1/permissions). Requiere roles:manage.
+// @Tags         Roles
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body     roleRequest  false "Crear rol (solo POST)"
+// @Success      200   {array}  RoleDTO
+// @Success      201   {object} RoleDTO
+// @Failure      400   {object} ErrorResponse
+// @Failure      401   {object} ErrorResponse
+// @Failure      403   {object} ErrorResponse
+// @Failure      409   {object} ErrorResponse
+// @Failure      500   {object} ErrorResponse
+// @Router       /api/v1/roles [get]
+// @Router       /api/v1/roles [post]
+func (h *RoleHandler) Roles(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.list(w, r)
+	case http.MethodPost:
+		h.create(w, r)
+	default:
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// RoleSubroutes godoc
+// @Summary      Detalle, edición y eliminación de un rol
+// @Description  GET /{id} detalle. PATCH /{id} edita nombre, descripción o permisos (los roles de sistema no se renombran y admin conserva todos los permisos). DELETE /{id} elimina un rol que no sea de sistema ni esté asignado. Los cambios aplican a los tokens emitidos desde entonces.
+// @Tags         Roles
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path     int          true  "ID del rol"
+// @Param        body  body     roleRequest  false "Patch rol (solo PATCH)"
+// @Success      200   {object} RoleDTO
+// @Success      204   "No Content"
+// @Failure      400   {object} ErrorResponse
+// @Failure      401   {object} ErrorResponse
+// @Failure      403   {object} ErrorResponse
+// @Failure      404   {object} ErrorResponse
+// @Failure      409   {object} ErrorResponse
+// @Failure      500   {object} ErrorResponse
+// @Router       /api/v1/roles/{id} [get]
+// @Router       /api/v1/roles/{id} [patch]
+// @Router       /api/v1/roles/{id} [delete]
+func (h *RoleHandler) RoleSubroutes(w http.ResponseWriter, r *http.Request) {
+	id := parseID(r.URL.Path)
+	if id <= 0 {
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		h.get(w, r, id)
+	case http.MethodPatch:
+		h.patch(w, r, id)
+	case http.MethodDelete:
+		h.delete(w, r, id)
+	default:
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// Permissions godoc
+// @Summary      Catálogo de permisos
+// @Description  Lista los permisos que se pueden asignar a un rol.
+// @Tags         Roles
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {array}  auth.Permission
+// @Failure      401  {object} ErrorResponse
+// @Failure      403  {object} ErrorResponse
+// @Router       /api/v1/permissions [get]
+func (h *RoleHandler) Permissions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(auth.Permissions)
+}
+
+/* =========================
+   INTERNAL
+   ========================= */
+
+func (h *RoleHandler) list(w http.ResponseWriter, r *http.Request) {
+	items, err := h.Svc.List(r.Context())
+	if err != nil {
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]RoleDTO, 0, len(items))
+	for _, it := range items {
+		resp = append(resp, mapRole(it))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (h *RoleHandler) get(w http.ResponseWriter, r *http.Request, id int) {
+	it, err := h.Svc.Get(r.Context(), id)
+	if err != nil {
+		writeRoleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(mapRole(it))
+}
+
+func (h *RoleHandler) create(w http.ResponseWriter, r *http.Request) {
+	var req roleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+	if req.Name == nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	in := services.CreateRoleInput{
+		Name:        *req.Name,
+		Description: req.Description,
+	}
+	if req.Permissions != nil {
+		in.Permissions = *req.Permissions
+	}
+
+	it, err := h.Svc.Create(r.Context(), in)
+	if err != nil {
+		writeRoleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(mapRole(it))
+}
+
+func (h *RoleHandler) patch(w http.ResponseWriter, r *http.Request, id int) {
+	var req roleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	it, err := h.Svc.Patch(r.Context(), id, services.PatchRoleInput{
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: req.Permissions,
+	})
+	if err != nil {
+		writeRoleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(mapRole(it))
+}
+
+func (h *RoleHandler) delete(w http.ResponseWriter, r *http.Request, id int) {
+	if err := h.Svc.Delete(r.Context(), id); err != nil {
+		writeRoleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeRoleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrRoleInvalidInput):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, services.ErrRoleNameTaken),
+		errors.Is(err, services.ErrRoleSystem),
+		errors.Is(err, services.ErrRoleInUse):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case ent.IsNotFound(err):
+		http.Error(w, "Not Found", http.StatusNotFound)
+	default:
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
+
+func mapRole(it *ent.Role) RoleDTO {
+	perms := it.Permissions
+	if perms == nil {
+		perms = []string{}
+	}
+	return RoleDTO{
+		ID:          it.ID,
+		Name:        it.Name,
+		Description: it.Description,
+		Permissions: perms,
+		IsSystem:    it.IsSystem,
+		CreatedAt:   it.CreatedAt,
+		UpdatedAt:   it.UpdatedAt,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"back/internal/ent"
 	"back/internal/services"
 )
 
@@ -86,3 +87,70 @@ func (h *ShiftDayHandler) ShiftDays(w http.ResponseWriter, r *http.Request, shif
 		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
 	}
 }
+
+type shiftCycleDayInput struct {
+	Day          int    `json:"day" example:"1"`
+	IsWorkingDay bool   `json:"is_working_day" example:"true"`
+	Mode         string `json:"mode" example:"onsite"`
+}
+
+// ShiftCycleDays godoc
+// @Summary      Días del ciclo de un turno rotativo
+// @Description  GET lista la configuración del ciclo (1..cycle_length). PUT reemplaza toda la configuración del ciclo. Solo turnos con pattern_type=cycle.
+// @Tags         Shift Days
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path     int                   true  "ID del turno"
+// @Param        body  body     []shiftCycleDayInput  false "Reemplazar días del ciclo"
+// @Success      200   {array}  interface{}
+// @Success      204   "No Content"
+// @Failure      400   {object} ErrorResponse
+// @Failure      401   {object} ErrorResponse
+// @Failure      404   {object} ErrorResponse
+// @Failure      500   {object} ErrorResponse
+// @Router       /api/v1/shifts/{id}/cycle-days [get]
+// @Router       /api/v1/shifts/{id}/cycle-days [put]
+func (h *ShiftDayHandler) ShiftCycleDays(w http.ResponseWriter, r *http.Request, shiftID int) {
+	switch r.Method {
+
+	case http.MethodGet:
+		items, err := h.Svc.ListCycleForShift(r.Context(), shiftID)
+		if err != nil {
+			http.Error(w, "Error", 500)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(items)
+
+	case http.MethodPut:
+		var req []shiftCycleDayInput
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "Bad Request", 400)
+			return
+		}
+
+		input := make([]services.ShiftCycleDayInput, 0, len(req))
+		for _, d := range req {
+			input = append(input, services.ShiftCycleDayInput{
+				Day:          d.Day,
+				IsWorkingDay: d.IsWorkingDay,
+				Mode:         d.Mode,
+			})
+		}
+
+		if err := h.Svc.ReplaceCycleForShift(r.Context(), shiftID, input); err != nil {
+			if ent.IsNotFound(err) {
+				http.Error(w, "Not Found", http.StatusNotFound)
+				return
+			}
+			http.Error(w, err.Error(), 400)
+			return
+		}
+
+		w.WriteHeader(204)
+
+	default:
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
@@ -2,11 +2,13 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
+	"back/internal/auth"
 	"back/internal/ent"
 	"back/internal/middleware"
 	"back/internal/services"
@@ -31,6 +33,17 @@ type createShiftRequest struct {
 	ScheduleType    string                  `json:"schedule_type"`
 	WorkDays        []services.WorkDayInput `json:"work_days"`
 	StartDate       string                  `json:"start_date"` // Recibimos string "2026-04-10"
+
+	// Tolerancias en minutos
+	EntryToleranceMinutes *int `json:"entry_tolerance_minutes,omitempty" example:"5"`
+	ExitToleranceMinutes  *int `json:"exit_tolerance_minutes,omitempty" example:"5"`
+	MinOvertimeMinutes    *int `json:"min_overtime_minutes,omitempty" example:"30"`
+
+	// Turnos rotativos: pattern_type=cycle con un ciclo de N días desde cycle_anchor_date
+	PatternType     string                   `json:"pattern_type,omitempty" example:"cycle"`
+	CycleLength     *int                     `json:"cycle_length,omitempty" example:"8"`
+	CycleAnchorDate *string                  `json:"cycle_anchor_date,omitempty" example:"2026-04-06"`
+	CycleDays       []services.CycleDayInput `json:"cycle_days,omitempty"`
 }
 
 type patchShiftRequest struct {
@@ -41,20 +54,31 @@ type patchShiftRequest struct {
 	BreakMinutes    *int    `json:"break_minutes,omitempty" example:"60"`
 	CrossesMidnight *bool   `json:"crosses_midnight,omitempty" example:"false"`
 	IsActive        *bool   `json:"is_active,omitempty" example:"true"`
+
+	PatternType     *string `json:"pattern_type,omitempty" example:"cycle"`
+	CycleLength     *int    `json:"cycle_length,omitempty" example:"8"`
+	CycleAnchorDate *string `json:"cycle_anchor_date,omitempty" example:"2026-04-06"`
+
+	EntryToleranceMinutes *int `json:"entry_tolerance_minutes,omitempty" example:"5"`
+	ExitToleranceMinutes  *int `json:"exit_tolerance_minutes,omitempty" example:"5"`
+	MinOvertimeMinutes    *int `json:"min_overtime_minutes,omitempty" example:"30"`
 }
 
 type ShiftDTO struct {
-	ID              int          `json:"id" example:"1"`
-	Name            string       `json:"name" example:"Turno mañana"`
-	Description     *string      `json:"description,omitempty" example:"Lunes a viernes 08:00 a 17:00"`
-	StartTime       string       `json:"start_time" example:"08:00"`
-	EndTime         string       `json:"end_time" example:"17:00"`
-	BreakMinutes    int          `json:"break_minutes" example:"60"`
-	CrossesMidnight bool         `json:"crosses_midnight" example:"false"`
-	IsActive        bool         `json:"is_active" example:"true"`
-	CreatedAt       time.Time    `json:"created_at"`
-	UpdatedAt       time.Time    `json:"updated_at"`
-	WorkDays        []WorkDayDTO `json:"work_days,omitempty"`
+	ID                    int          `json:"id" example:"1"`
+	Name                  string       `json:"name" example:"Turno mañana"`
+	Description           *string      `json:"description,omitempty" example:"Lunes a viernes 08:00 a 17:00"`
+	StartTime             string       `json:"start_time" example:"08:00"`
+	EndTime               string       `json:"end_time" example:"17:00"`
+	BreakMinutes          int          `json:"break_minutes" example:"60"`
+	CrossesMidnight       bool         `json:"crosses_midnight" example:"false"`
+	IsActive              bool         `json:"is_active" example:"true"`
+	EntryToleranceMinutes int          `json:"entry_tolerance_minutes" example:"5"`
+	ExitToleranceMinutes  int          `json:"exit_tolerance_minutes" example:"5"`
+	MinOvertimeMinutes    int          `json:"min_overtime_minutes" example:"30"`
+	CreatedAt             time.Time    `json:"created_at"`
+	UpdatedAt             time.Time    `json:"updated_at"`
+	WorkDays              []WorkDayDTO `json:"work_days,omitempty"`
 }
 
 type WorkDayDTO struct {
@@ -99,6 +123,12 @@ func (h *ShiftHandler) Shifts(w http.ResponseWriter, r *http.Request) {
 
 		parsedDate, _ := time.Parse("2006-01-02", req.StartDate)
 
+		anchor, err := parseShiftAnchorDate(req.CycleAnchorDate)
+		if err != nil {
+			http.Error(w, "cycle_anchor_date invalido", 400)
+			return
+		}
+
 		s, err := h.Svc.Create(r.Context(), services.CreateShiftInput{
 			Name:            req.Name,
 			Description:     req.Description,
@@ -110,6 +140,14 @@ func (h *ShiftHandler) Shifts(w http.ResponseWriter, r *http.Request) {
 			ScheduleType:    req.ScheduleType, // <--- PASAR ESTO
 			WorkDays:        req.WorkDays,     // <--- PASAR ESTO
 			StartDate:       parsedDate,       // <--- PASAR ESTO
+			PatternType:     req.PatternType,
+			CycleLength:     req.CycleLength,
+			CycleAnchorDate: anchor,
+			CycleDays:       req.CycleDays,
+
+			EntryToleranceMinutes: req.EntryToleranceMinutes,
+			ExitToleranceMinutes:  req.ExitToleranceMinutes,
+			MinOvertimeMinutes:    req.MinOvertimeMinutes,
 		})
 		if err != nil {
 			http.Error(w, err.Error(), 400)
@@ -165,6 +203,12 @@ func (h *ShiftHandler) ShiftByID(w http.ResponseWriter, r *http.Request) {
 		var req patchShiftRequest
 		_ = json.NewDecoder(r.Body).Decode(&req)
 
+		anchor, err := parseShiftAnchorDate(req.CycleAnchorDate)
+		if err != nil {
+			http.Error(w, "cycle_anchor_date invalido", 400)
+			return
+		}
+
 		s, err := h.Svc.Patch(r.Context(), id, services.PatchShiftInput{
 			Name:            req.Name,
 			Description:     req.Description,
@@ -173,6 +217,13 @@ func (h *ShiftHandler) ShiftByID(w http.ResponseWriter, r *http.Request) {
 			BreakMinutes:    req.BreakMinutes,
 			CrossesMidnight: req.CrossesMidnight,
 			IsActive:        req.IsActive,
+			PatternType:     req.PatternType,
+			CycleLength:     req.CycleLength,
+			CycleAnchorDate: anchor,
+
+			EntryToleranceMinutes: req.EntryToleranceMinutes,
+			ExitToleranceMinutes:  req.ExitToleranceMinutes,
+			MinOvertimeMinutes:    req.MinOvertimeMinutes,
 		})
 		if err != nil {
 			http.Error(w, err.Error(), 400)
@@ -194,6 +245,13 @@ func (h *ShiftHandler) ShiftByID(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func parseShiftAnchorDate(v *string) (*time.Time, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return parseOptionalDate(strings.TrimSpace(*v))
+}
+
 func parseID(path string) int {
 	parts := strings.Split(strings.Trim(path, "/"), "/")
 	id, _ := strconv.Atoi(parts[len(parts)-1])
@@ -201,14 +259,17 @@ func parseID(path string) int {
 }
 
 // Calendar godoc
-// @Summary      Calendario de instancias
-// @Description  Obtiene las instancias de turnos generadas entre dos fechas
+// @Summary      Calendario del usuario autenticado
+// @Description  Resuelve día a día el horario del usuario: turno (asignación u override), modalidad, horas esperadas, feriados, ausencias aprobadas y asistencia real.
 // @Tags         Shifts
 // @Produce      json
 // @Security     BearerAuth
 // @Param        start  query     string  true  "Fecha inicio YYYY-MM-DD"
-// @Param        end    query     string  true  "Fecha fin YYYY-MM-DD"
-// @Success      200    {array}   interface{}
+// @Param        end    query     string  true  "Fecha fin YYYY-MM-DD (máx. 93 días)"
+// @Success      200    {array}   CalendarDayDTO
+// @Failure      400    {object}  ErrorResponse
+// @Failure      401    {object}  ErrorResponse
+// @Failure      500    {object}  ErrorResponse
 // @Router       /api/v1/calendar [get]
 func (h *ShiftHandler) Calendar(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
@@ -229,35 +290,212 @@ func (h *ShiftHandler) Calendar(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// 1. Obtener parámetros de la URL
+	startTime, endTime, ok := parseCalendarRange(w, r)
+	if !ok {
+		return
+	}
+
+	days, err := h.Svc.ResolveUserCalendar(r.Context(), userID, startTime, endTime)
+	if err != nil {
+		writeCalendarError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(mapCalendarDays(days))
+}
+
+// UserCalendar godoc
+// @Summary      Calendario de un usuario
+// @Description  Igual que /calendar pero para cualquier usuario (admin) o el propio usuario.
+// @Tags         Shifts
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id     path      int     true  "ID del usuario"
+// @Param        start  query     string  true  "Fecha inicio YYYY-MM-DD"
+// @Param        end    query     string  true  "Fecha fin YYYY-MM-DD (máx. 93 días)"
+// @Success      200    {array}   CalendarDayDTO
+// @Failure      400    {object}  ErrorResponse
+// @Failure      401    {object}  ErrorResponse
+// @Failure      403    {object}  ErrorResponse
+// @Failure      404    {object}  ErrorResponse
+// @Failure      500    {object}  ErrorResponse
+// @Router       /api/v1/users/{id}/calendar [get]
+func (h *ShiftHandler) UserCalendar(w http.ResponseWriter, r *http.Request, userID int) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", 405)
+		return
+	}
+	if !hasPermission(r, auth.PermCalendarRead) {
+		callerID, ok := callerUserID(r)
+		if !ok || callerID != userID {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+	}
+
+	startTime, endTime, ok := parseCalendarRange(w, r)
+	if !ok {
+		return
+	}
+
+	days, err := h.Svc.ResolveUserCalendar(r.Context(), userID, startTime, endTime)
+	if err != nil {
+		writeCalendarError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(mapCalendarDays(days))
+}
+
+// BranchCalendar godoc
+// @Summary      Calendario de una sucursal
+// @Description  Calendario resuelto de todos los usuarios activos de la sucursal (solo admin).
+// @Tags         Shifts
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id     path      int     true  "ID de la sucursal"
+// @Param        start  query     string  true  "Fecha inicio YYYY-MM-DD"
+// @Param        end    query     string  true  "Fecha fin YYYY-MM-DD (máx. 93 días)"
+// @Success      200    {array}   UserCalendarDTO
+// @Failure      400    {object}  ErrorResponse
+// @Failure      401    {object}  ErrorResponse
+// @Failure      403    {object}  ErrorResponse
+// @Failure      404    {object}  ErrorResponse
+// @Failure      500    {object}  ErrorResponse
+// @Router       /api/v1/branches/{id}/calendar [get]
+func (h *ShiftHandler) BranchCalendar(w http.ResponseWriter, r *http.Request, branchID int) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", 405)
+		return
+	}
+	if !hasPermission(r, auth.PermCalendarRead) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	startTime, endTime, ok := parseCalendarRange(w, r)
+	if !ok {
+		return
+	}
+
+	items, err := h.Svc.ResolveBranchCalendar(r.Context(), branchID, startTime, endTime)
+	if err != nil {
+		writeCalendarError(w, err)
+		return
+	}
+
+	resp := make([]UserCalendarDTO, 0, len(items))
+	for _, it := range items {
+		resp = append(resp, UserCalendarDTO{
+			UserID: it.UserID,
+			Days:   mapCalendarDays(it.Days),
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+type CalendarDayDTO struct {
+	Date            string                 `json:"date" example:"2026-04-10"`
+	Status          string                 `json:"status" example:"scheduled"` // scheduled | rest | day_off | holiday | leave | unassigned
+	Source          string                 `json:"source,omitempty" example:"assignment"`
+	ShiftID         *int                   `json:"shift_id,omitempty" example:"1"`
+	ShiftName       *string                `json:"shift_name,omitempty" example:"Turno mañana"`
+	StartTime       *string                `json:"start_time,omitempty" example:"08:00"`
+	EndTime         *string                `json:"end_time,omitempty" example:"17:00"`
+	Mode            string                 `json:"mode" example:"onsite"`
+	ExpectedMinutes int                    `json:"expected_minutes" example:"480"`
+	ExpectedHours   float64                `json:"expected_hours" example:"8"`
+	HolidayName     *string                `json:"holiday_name,omitempty" example:"Viernes Santo"`
+	LeaveType       *string                `json:"leave_type,omitempty" example:"vacation"`
+	Attendance      *CalendarAttendanceDTO `json:"attendance,omitempty"`
+}
+
+type CalendarAttendanceDTO struct {
+	AttendanceDayID int        `json:"attendance_day_id" example:"100"`
+	BranchID        int        `json:"branch_id" example:"1"`
+	WorkInAt        *time.Time `json:"work_in_at,omitempty"`
+	BreakOutAt      *time.Time `json:"break_out_at,omitempty"`
+	BreakInAt       *time.Time `json:"break_in_at,omitempty"`
+	WorkOutAt       *time.Time `json:"work_out_at,omitempty"`
+	WorkedMinutes   int        `json:"worked_minutes" example:"475"`
+}
+
+type UserCalendarDTO struct {
+	UserID int              `json:"user_id" example:"10"`
+	Days   []CalendarDayDTO `json:"days"`
+}
+
+// parseCalendarRange lee start/end (YYYY-MM-DD) y responde 400 si faltan o son inválidos.
+func parseCalendarRange(w http.ResponseWriter, r *http.Request) (time.Time, time.Time, bool) {
 	startQuery := r.URL.Query().Get("start")
 	endQuery := r.URL.Query().Get("end")
 
 	if startQuery == "" || endQuery == "" {
 		http.Error(w, "start and end dates are required", 400)
-		return
+		return time.Time{}, time.Time{}, false
 	}
 
-	// 2. Parsear fechas (Formato ISO YYYY-MM-DD)
 	const layout = "2006-01-02"
 	startTime, err := time.Parse(layout, startQuery)
 	if err != nil {
 		http.Error(w, "invalid start date format", 400)
-		return
+		return time.Time{}, time.Time{}, false
 	}
 	endTime, err := time.Parse(layout, endQuery)
 	if err != nil {
 		http.Error(w, "invalid end date format", 400)
-		return
+		return time.Time{}, time.Time{}, false
 	}
 
-	// 3. Llamar al servicio con userID
-	instances, err := h.Svc.GetCalendar(r.Context(), userID, startTime, endTime)
-	if err != nil {
-		http.Error(w, err.Error(), 500)
-		return
+	return startTime, endTime, true
+}
+
+func writeCalendarError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrShiftInvalidInput):
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+	case errors.Is(err, services.ErrOutOfScope):
+		http.Error(w, "Forbidden", http.StatusForbidden)
+	case ent.IsNotFound(err):
+		http.Error(w, "Not Found", http.StatusNotFound)
+	default:
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
 	}
+}
 
-	w.Header().Set("Content-Type", "application/json")
-	_ = json.NewEncoder(w).Encode(instances)
+func mapCalendarDays(days []services.CalendarDay) []CalendarDayDTO {
+	out := make([]CalendarDayDTO, 0, len(days))
+	for _, d := range days {
+		dto := CalendarDayDTO{
+			Date:            d.Date.Format("2006-01-02"),
+			Status:          d.Status,
+			Source:          d.Source,
+			ShiftID:         d.ShiftID,
+			ShiftName:       d.ShiftName,
+			StartTime:       d.StartTime,
+			EndTime:         d.EndTime,
+			Mode:            d.Mode,
+			ExpectedMinutes: d.ExpectedMinutes,
+			ExpectedHours:   float64(d.ExpectedMinutes) / 60,
+			HolidayName:     d.HolidayName,
+			LeaveType:       d.LeaveType,
+		}
+		if a := d.Attendance; a != nil {
+			dto.Attendance = &CalendarAttendanceDTO{
+				AttendanceDayID: a.AttendanceDayID,
+				BranchID:        a.BranchID,
+				WorkInAt:        a.WorkInAt,
+				BreakOutAt:      a.BreakOutAt,
+				BreakInAt:       a.BreakInAt,
+				WorkOutAt:       a.WorkOutAt,
+				WorkedMinutes:   a.WorkedMinutes,
+			}
+		}
+		out = append(out, dto)
+	}
+	return out
 }
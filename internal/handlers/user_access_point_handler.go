@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"back/internal/auth"
 	"back/internal/ent"
 	"back/internal/services"
 )
@@ -63,6 +64,11 @@ func (h *UserAccessPointHandler) UserAccessPointByID(w http.ResponseWriter, r *h
 }
 
 func (h *UserAccessPointHandler) list(w http.ResponseWriter, r *http.Request, userID int) {
+	if !isSelfOr(r, userID, auth.PermUsersRead) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
 	items, err := h.Svc.ListForUser(r.Context(), userID)
 	if err != nil {
 		if err == services.ErrUserAccessPointInvalidInput {
@@ -92,7 +98,7 @@ func (h *UserAccessPointHandler) list(w http.ResponseWriter, r *http.Request, us
 }
 
 func (h *UserAccessPointHandler) assignMany(w http.ResponseWriter, r *http.Request, userID int) {
-	if !isAdmin(r) {
+	if !hasPermission(r, auth.PermUsersWrite) {
 		http.Error(w, "Forbidden", http.StatusForbidden)
 		return
 	}
@@ -128,7 +134,7 @@ func (h *UserAccessPointHandler) assignMany(w http.ResponseWriter, r *http.Reque
 }
 
 func (h *UserAccessPointHandler) delete(w http.ResponseWriter, r *http.Request, userID, accessPointID int) {
-	if !isAdmin(r) {
+	if !hasPermission(r, auth.PermUsersWrite) {
 		http.Error(w, "Forbidden", http.StatusForbidden)
 		return
 	}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"back/internal/auth"
 	"back/internal/ent"
 	"back/internal/services"
 )
@@ -20,13 +21,20 @@ func NewUserBranchHandler(svc *services.UserBranchService) *UserBranchHandler {
 
 type assignUserBranchRequest struct {
 	BranchID int `json:"branch_id" example:"1"`
+	// supervisor | viewer; vacío = colaborador
+	RoleInBranch string `json:"role_in_branch,omitempty" example:"viewer"`
+}
+
+type patchUserBranchRequest struct {
+	RoleInBranch string `json:"role_in_branch" example:"supervisor"`
 }
 
 type UserBranchDTO struct {
-	ID       int    `json:"id"`
-	UserID   int    `json:"user_id"`
-	BranchID int    `json:"branch_id"`
-	Name     string `json:"branch_name,omitempty"`
+	ID           int     `json:"id"`
+	UserID       int     `json:"user_id"`
+	BranchID     int     `json:"branch_id"`
+	Name         string  `json:"branch_name,omitempty"`
+	RoleInBranch *string `json:"role_in_branch,omitempty"`
 }
 
 func (h *UserBranchHandler) UserBranches(w http.ResponseWriter, r *http.Request) {
@@ -54,6 +62,8 @@ func (h *UserBranchHandler) UserBranchByID(w http.ResponseWriter, r *http.Reques
 	}
 
 	switch r.Method {
+	case http.MethodPatch:
+		h.setRole(w, r, userID, branchID)
 	case http.MethodDelete:
 		h.delete(w, r, userID, branchID)
 	default:
@@ -62,6 +72,11 @@ func (h *UserBranchHandler) UserBranchByID(w http.ResponseWriter, r *http.Reques
 }
 
 func (h *UserBranchHandler) list(w http.ResponseWriter, r *http.Request, userID int) {
+	if !isSelfOr(r, userID, auth.PermUsersRead) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
 	items, err := h.Svc.ListForUser(r.Context(), userID)
 	if err != nil {
 		if err == services.ErrUserBranchInvalidInput {
@@ -75,9 +90,10 @@ func (h *UserBranchHandler) list(w http.ResponseWriter, r *http.Request, userID
 	resp := make([]UserBranchDTO, 0, len(items))
 	for _, item := range items {
 		dto := UserBranchDTO{
-			ID:       item.ID,
-			UserID:   item.UserID,
-			BranchID: item.BranchID,
+			ID:           item.ID,
+			UserID:       item.UserID,
+			BranchID:     item.BranchID,
+			RoleInBranch: item.RoleInBranch,
 		}
 		if item.Edges.Branch != nil {
 			dto.Name = item.Edges.Branch.Name
@@ -90,7 +106,7 @@ func (h *UserBranchHandler) list(w http.ResponseWriter, r *http.Request, userID
 }
 
 func (h *UserBranchHandler) assign(w http.ResponseWriter, r *http.Request, userID int) {
-	if !isAdmin(r) {
+	if !hasPermission(r, auth.PermUsersWrite) {
 		http.Error(w, "Forbidden", http.StatusForbidden)
 		return
 	}
@@ -102,7 +118,8 @@ func (h *UserBranchHandler) assign(w http.ResponseWriter, r *http.Request, userI
 	}
 
 	item, err := h.Svc.Assign(r.Context(), userID, services.AssignUserBranchInput{
-		BranchID: req.BranchID,
+		BranchID:     req.BranchID,
+		RoleInBranch: req.RoleInBranch,
 	})
 	if err != nil {
 		switch err {
@@ -123,9 +140,10 @@ func (h *UserBranchHandler) assign(w http.ResponseWriter, r *http.Request, userI
 	}
 
 	resp := UserBranchDTO{
-		ID:       item.ID,
-		UserID:   item.UserID,
-		BranchID: item.BranchID,
+		ID:           item.ID,
+		UserID:       item.UserID,
+		BranchID:     item.BranchID,
+		RoleInBranch: item.RoleInBranch,
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -133,8 +151,43 @@ func (h *UserBranchHandler) assign(w http.ResponseWriter, r *http.Request, userI
 	_ = json.NewEncoder(w).Encode(resp)
 }
 
+// setRole cambia el rol del usuario en la sucursal (supervisor | viewer | "").
+func (h *UserBranchHandler) setRole(w http.ResponseWriter, r *http.Request, userID, branchID int) {
+	if !hasPermission(r, auth.PermUsersWrite) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	var req patchUserBranchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	item, err := h.Svc.SetRole(r.Context(), userID, branchID, req.RoleInBranch)
+	if err != nil {
+		switch {
+		case err == services.ErrUserBranchInvalidInput:
+			http.Error(w, "Bad Request", http.StatusBadRequest)
+		case ent.IsNotFound(err):
+			http.Error(w, "Not Found", http.StatusNotFound)
+		default:
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(UserBranchDTO{
+		ID:           item.ID,
+		UserID:       item.UserID,
+		BranchID:     item.BranchID,
+		RoleInBranch: item.RoleInBranch,
+	})
+}
+
 func (h *UserBranchHandler) delete(w http.ResponseWriter, r *http.Request, userID, branchID int) {
-	if !isAdmin(r) {
+	if !hasPermission(r, auth.PermUsersWrite) {
 		http.Error(w, "Forbidden", http.StatusForbidden)
 		return
 	}
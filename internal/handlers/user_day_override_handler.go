@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"time"
 
+	"back/internal/auth"
 	"back/internal/services"
 )
 
@@ -56,6 +57,11 @@ type UserDayOverrideDTO struct {
 func (h *UserDayOverrideHandler) Overrides(w http.ResponseWriter, r *http.Request, userID int) {
 	switch r.Method {
 	case http.MethodGet:
+		if !isSelfOr(r, userID, auth.PermUsersRead) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+
 		items, err := h.Svc.ListForUser(r.Context(), userID)
 		if err != nil {
 			http.Error(w, "Error", http.StatusInternalServerError)
@@ -65,6 +71,11 @@ func (h *UserDayOverrideHandler) Overrides(w http.ResponseWriter, r *http.Reques
 		_ = json.NewEncoder(w).Encode(items)
 
 	case http.MethodPost:
+		if !hasPermission(r, auth.PermSchedulesManage) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+
 		var req createOverrideRequest
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 			http.Error(w, "Bad Request", http.StatusBadRequest)
@@ -97,3 +108,96 @@ func (h *UserDayOverrideHandler) Overrides(w http.ResponseWriter, r *http.Reques
 		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
 	}
 }
+
+type bulkOverrideRequest struct {
+	StartDate       string  `json:"start_date" example:"2026-09-17"`
+	EndDate         string  `json:"end_date" example:"2026-09-17"`
+	ShiftID         *int    `json:"shift_id,omitempty" example:"2"`
+	IsDayOff        *bool   `json:"is_day_off,omitempty" example:"true"`
+	Mode            string  `json:"mode" example:"off"`
+	Notes           *string `json:"notes,omitempty" example:"Cierre de sucursal por inventario"`
+	ReplaceExisting bool    `json:"replace_existing" example:"false"`
+	BranchID        *int    `json:"branch_id,omitempty" example:"1"`
+	UserIDs         []int   `json:"user_ids,omitempty"`
+}
+
+type BulkDayOverrideDTO struct {
+	StartDate string `json:"start_date" example:"2026-09-17"`
+	EndDate   string `json:"end_date" example:"2026-09-17"`
+	Users     int    `json:"users" example:"120"`
+	Days      int    `json:"days" example:"1"`
+	Created   int    `json:"created" example:"118"`
+	Replaced  int    `json:"replaced" example:"0"`
+	Skipped   int    `json:"skipped" example:"2"`
+}
+
+// BulkCreate godoc
+// @Summary      Overrides masivos
+// @Description  Crea el mismo override para todos los usuarios activos de la sucursal (branch_id) y/o la lista user_ids, para cada fecha del rango (máx. 93 días), en una sola transacción. Las fechas que ya tienen override se omiten, salvo replace_existing=true. (supervisor/admin)
+// @Tags         User Day Overrides
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body     bulkOverrideRequest  true  "Overrides masivos"
+// @Success      201   {object} BulkDayOverrideDTO
+// @Failure      400   {object} ErrorResponse
+// @Failure      401   {object} ErrorResponse
+// @Failure      403   {object} ErrorResponse
+// @Failure      404   {object} ErrorResponse
+// @Failure      500   {object} ErrorResponse
+// @Router       /api/v1/day-overrides/bulk [post]
+func (h *UserDayOverrideHandler) BulkCreate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !hasPermission(r, auth.PermSchedulesManage) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	var req bulkOverrideRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	start, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		http.Error(w, "Invalid start_date format, expected YYYY-MM-DD", http.StatusBadRequest)
+		return
+	}
+	end, err := time.Parse("2006-01-02", req.EndDate)
+	if err != nil {
+		http.Error(w, "Invalid end_date format, expected YYYY-MM-DD", http.StatusBadRequest)
+		return
+	}
+
+	res, err := h.Svc.BulkCreate(r.Context(), services.BulkDayOverrideInput{
+		StartDate:       start,
+		EndDate:         end,
+		ShiftID:         req.ShiftID,
+		IsDayOff:        req.IsDayOff,
+		Mode:            req.Mode,
+		Notes:           req.Notes,
+		ReplaceExisting: req.ReplaceExisting,
+		BranchID:        req.BranchID,
+		UserIDs:         req.UserIDs,
+	})
+	if err != nil {
+		writeBulkScheduleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(BulkDayOverrideDTO{
+		StartDate: res.StartDate.Format("2006-01-02"),
+		EndDate:   res.EndDate.Format("2006-01-02"),
+		Users:     res.Users,
+		Days:      res.Days,
+		Created:   res.Created,
+		Replaced:  res.Replaced,
+		Skipped:   res.Skipped,
+	})
+}
@@ -2,9 +2,11 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"time"
 
+	"back/internal/auth"
 	"back/internal/ent"
 	"back/internal/services"
 )
@@ -22,6 +24,17 @@ type createAssignmentRequest struct {
 	StartDate string  `json:"start_date" example:"2026-03-01"`
 	EndDate   *string `json:"end_date,omitempty" example:"2026-03-31"`
 	IsActive  *bool   `json:"is_active,omitempty" example:"true"`
+	// close (por defecto): cierra/recorta las asignaciones superpuestas; reject: responde 409
+	OnOverlap string `json:"on_overlap,omitempty" example:"close"`
+}
+
+type patchAssignmentRequest struct {
+	ShiftID   *int    `json:"shift_id,omitempty" example:"2"`
+	StartDate *string `json:"start_date,omitempty" example:"2026-03-01"`
+	// "" limpia la fecha de término (asignación indefinida)
+	EndDate   *string `json:"end_date,omitempty" example:"2026-03-31"`
+	IsActive  *bool   `json:"is_active,omitempty" example:"true"`
+	OnOverlap string  `json:"on_overlap,omitempty" example:"reject"`
 }
 
 type UserShiftAssignmentDTO struct {
@@ -36,7 +49,7 @@ type UserShiftAssignmentDTO struct {
 
 // UserShiftAssignments godoc
 // @Summary      Asignaciones de turno del usuario
-// @Description  GET lista las asignaciones de turno de un usuario. POST asigna un turno al usuario desde una fecha dada.
+// @Description  GET lista las asignaciones de turno de un usuario (la más reciente primero). POST asigna un turno al usuario desde una fecha dada; si se superpone con otra asignación activa, con on_overlap=close (por defecto) la anterior se cierra el día previo (si la nueva tiene end_date y la anterior seguía después, la anterior se retoma desde el día siguiente) y con on_overlap=reject se responde 409.
 // @Tags         User Shift Assignments
 // @Accept       json
 // @Produce      json
@@ -49,12 +62,18 @@ type UserShiftAssignmentDTO struct {
 // @Failure      401   {object} ErrorResponse
 // @Failure      403   {object} ErrorResponse
 // @Failure      404   {object} ErrorResponse
+// @Failure      409   {object} ErrorResponse
 // @Failure      500   {object} ErrorResponse
 // @Router       /api/v1/users/{id}/shift-assignments [get]
 // @Router       /api/v1/users/{id}/shift-assignments [post]
 func (h *UserShiftAssignmentHandler) Assignments(w http.ResponseWriter, r *http.Request, userID int) {
 	switch r.Method {
 	case http.MethodGet:
+		if !isSelfOr(r, userID, auth.PermUsersRead) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+
 		items, err := h.Svc.ListForUser(r.Context(), userID)
 		if err != nil {
 			http.Error(w, "Error", http.StatusInternalServerError)
@@ -70,6 +89,11 @@ func (h *UserShiftAssignmentHandler) Assignments(w http.ResponseWriter, r *http.
 		_ = json.NewEncoder(w).Encode(resp)
 
 	case http.MethodPost:
+		if !hasPermission(r, auth.PermSchedulesManage) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+
 		var req createAssignmentRequest
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
@@ -97,8 +121,13 @@ func (h *UserShiftAssignmentHandler) Assignments(w http.ResponseWriter, r *http.
 			StartDate: start,
 			EndDate:   end,
 			IsActive:  req.IsActive,
+			OnOverlap: req.OnOverlap,
 		})
 		if err != nil {
+			if errors.Is(err, services.ErrUserShiftAssignmentOverlap) {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			}
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
@@ -112,6 +141,100 @@ func (h *UserShiftAssignmentHandler) Assignments(w http.ResponseWriter, r *http.
 	}
 }
 
+// AssignmentByID godoc
+// @Summary      Editar / eliminar asignación de turno
+// @Description  PATCH edita turno, fechas o estado de la asignación aplicando las mismas reglas de superposición que el alta (on_overlap=close|reject). DELETE la elimina. (supervisor/admin)
+// @Tags         User Shift Assignments
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id             path     int                     true  "ID del usuario"
+// @Param        assignment_id  path     int                     true  "ID de la asignación"
+// @Param        body           body     patchAssignmentRequest  false "Cambios"
+// @Success      200            {object} UserShiftAssignmentDTO
+// @Success      204            "No Content"
+// @Failure      400            {object} ErrorResponse
+// @Failure      401            {object} ErrorResponse
+// @Failure      403            {object} ErrorResponse
+// @Failure      404            {object} ErrorResponse
+// @Failure      409            {object} ErrorResponse
+// @Failure      500            {object} ErrorResponse
+// @Router       /api/v1/users/{id}/shift-assignments/{assignment_id} [patch]
+// @Router       /api/v1/users/{id}/shift-assignments/{assignment_id} [delete]
+func (h *UserShiftAssignmentHandler) AssignmentByID(w http.ResponseWriter, r *http.Request, userID, assignmentID int) {
+	if !hasPermission(r, auth.PermSchedulesManage) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPatch:
+		var req patchAssignmentRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "Bad Request", http.StatusBadRequest)
+			return
+		}
+
+		in := services.PatchUserShiftAssignmentInput{
+			ShiftID:   req.ShiftID,
+			IsActive:  req.IsActive,
+			OnOverlap: req.OnOverlap,
+		}
+		if req.StartDate != nil {
+			t, err := time.Parse("2006-01-02", *req.StartDate)
+			if err != nil {
+				http.Error(w, "Invalid start_date format, expected YYYY-MM-DD", http.StatusBadRequest)
+				return
+			}
+			in.StartDate = &t
+		}
+		if req.EndDate != nil {
+			if *req.EndDate == "" {
+				in.ClearEndDate = true
+			} else {
+				t, err := time.Parse("2006-01-02", *req.EndDate)
+				if err != nil {
+					http.Error(w, "Invalid end_date format, expected YYYY-MM-DD", http.StatusBadRequest)
+					return
+				}
+				in.EndDate = &t
+			}
+		}
+
+		a, err := h.Svc.Patch(r.Context(), userID, assignmentID, in)
+		if err != nil {
+			writeAssignmentError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(mapAssignment(a))
+
+	case http.MethodDelete:
+		if err := h.Svc.Delete(r.Context(), userID, assignmentID); err != nil {
+			writeAssignmentError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func writeAssignmentError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrUserShiftAssignmentInvalidInput):
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+	case errors.Is(err, services.ErrUserShiftAssignmentOverlap):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case ent.IsNotFound(err):
+		http.Error(w, "Not Found", http.StatusNotFound)
+	default:
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
+
 func mapAssignment(a *ent.UserShiftAssignment) UserShiftAssignmentDTO {
 	return UserShiftAssignmentDTO{
 		ID:        a.ID,
@@ -123,3 +246,119 @@ func mapAssignment(a *ent.UserShiftAssignment) UserShiftAssignmentDTO {
 		CreatedAt: a.CreatedAt,
 	}
 }
+
+type bulkAssignmentRequest struct {
+	ShiftID   int     `json:"shift_id" example:"1"`
+	StartDate string  `json:"start_date" example:"2026-03-01"`
+	EndDate   *string `json:"end_date,omitempty" example:"2026-06-30"`
+	BranchID  *int    `json:"branch_id,omitempty" example:"1"`
+	UserIDs   []int   `json:"user_ids,omitempty"`
+}
+
+type BulkShiftAssignmentDTO struct {
+	ShiftID     int                      `json:"shift_id" example:"1"`
+	StartDate   string                   `json:"start_date" example:"2026-03-01"`
+	EndDate     *string                  `json:"end_date,omitempty" example:"2026-06-30"`
+	Users       int                      `json:"users" example:"120"`
+	Closed      int                      `json:"closed" example:"118"`
+	Deactivated int                      `json:"deactivated" example:"2"`
+	Split       int                      `json:"split" example:"0"`
+	Assignments []UserShiftAssignmentDTO `json:"assignments"`
+}
+
+// BulkAssign godoc
+// @Summary      Asignación masiva de turno
+// @Description  Asigna el turno desde start_date a todos los usuarios activos de la sucursal (branch_id) y/o a la lista user_ids, en una sola transacción. Las asignaciones previas vigentes se cierran el día anterior, las que comenzaban el mismo start_date se desactivan y, si alguna comienza después, la nueva termina el día anterior a esa. (supervisor/admin)
+// @Tags         User Shift Assignments
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body     bulkAssignmentRequest  true  "Asignación masiva"
+// @Success      201   {object} BulkShiftAssignmentDTO
+// @Failure      400   {object} ErrorResponse
+// @Failure      401   {object} ErrorResponse
+// @Failure      403   {object} ErrorResponse
+// @Failure      404   {object} ErrorResponse
+// @Failure      500   {object} ErrorResponse
+// @Router       /api/v1/shift-assignments/bulk [post]
+func (h *UserShiftAssignmentHandler) BulkAssign(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !hasPermission(r, auth.PermSchedulesManage) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	var req bulkAssignmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	start, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		http.Error(w, "Invalid start_date format, expected YYYY-MM-DD", http.StatusBadRequest)
+		return
+	}
+	var end *time.Time
+	if req.EndDate != nil && *req.EndDate != "" {
+		t, err := time.Parse("2006-01-02", *req.EndDate)
+		if err != nil {
+			http.Error(w, "Invalid end_date format, expected YYYY-MM-DD", http.StatusBadRequest)
+			return
+		}
+		end = &t
+	}
+
+	res, err := h.Svc.BulkAssign(r.Context(), services.BulkShiftAssignmentInput{
+		ShiftID:   req.ShiftID,
+		StartDate: start,
+		EndDate:   end,
+		BranchID:  req.BranchID,
+		UserIDs:   req.UserIDs,
+	})
+	if err != nil {
+		writeBulkScheduleError(w, err)
+		return
+	}
+
+	resp := BulkShiftAssignmentDTO{
+		ShiftID:     res.ShiftID,
+		StartDate:   res.StartDate.Format("2006-01-02"),
+		Users:       res.Users,
+		Closed:      res.Closed,
+		Deactivated: res.Deactivated,
+		Split:       res.Split,
+		Assignments: make([]UserShiftAssignmentDTO, 0, len(res.Assignments)),
+	}
+	if res.EndDate != nil {
+		v := res.EndDate.Format("2006-01-02")
+		resp.EndDate = &v
+	}
+	for _, a := range res.Assignments {
+		resp.Assignments = append(resp.Assignments, mapAssignment(a))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// writeBulkScheduleError mapea los errores de las operaciones masivas de turnos y overrides.
+func writeBulkScheduleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrUserShiftAssignmentInvalidInput),
+		errors.Is(err, services.ErrUserDayOverrideInvalidInput):
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+	case errors.Is(err, services.ErrBulkNoTargets):
+		http.Error(w, "branch_id or user_ids required (no target users)", http.StatusBadRequest)
+	case errors.Is(err, services.ErrOutOfScope):
+		http.Error(w, "Forbidden", http.StatusForbidden)
+	case ent.IsNotFound(err):
+		http.Error(w, "Not Found", http.StatusNotFound)
+	default:
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
@@ -2,10 +2,14 @@ package handlers
 
 import (
 	"encoding/json"
+	"fmt"
+	"log"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
+	"back/internal/auth"
 	"back/internal/ent"
 	"back/internal/services"
 )
@@ -34,6 +38,9 @@ type createUserRequest struct {
 	EmployeeCode *string `json:"employee_code,omitempty" example:"EMP-001"`
 	AccessCode   string  `json:"access_code" example:"ACC-001"`
 	IsActive     *bool   `json:"is_active,omitempty" example:"true"`
+
+	HireDate          *string `json:"hire_date,omitempty" example:"2022-03-01"`
+	PriorServiceYears *int    `json:"prior_service_years,omitempty" example:"4"`
 }
 
 type patchUserRequest struct {
@@ -47,6 +54,9 @@ type patchUserRequest struct {
 	EmployeeCode *string `json:"employee_code,omitempty" example:"EMP-001"`
 	AccessCode   *string `json:"access_code,omitempty" example:"ACC-001"`
 	IsActive     *bool   `json:"is_active,omitempty" example:"true"`
+
+	HireDate          *string `json:"hire_date,omitempty" example:"2022-03-01"`
+	PriorServiceYears *int    `json:"prior_service_years,omitempty" example:"4"`
 }
 
 /* =========================
@@ -81,6 +91,9 @@ type UserDTO struct {
 	EmployeeCode *string         `json:"employee_code,omitempty"`
 	AccessCode   *string         `json:"access_code,omitempty"`
 	Branches     []UsersBranchDTO `json:"branches"`
+
+	HireDate          *string `json:"hire_date,omitempty"`
+	PriorServiceYears *int    `json:"prior_service_years,omitempty"`
 }
 
 type BranchOverviewDTO struct {
@@ -306,6 +319,7 @@ func (h *UsersHandler) UserByID(w http.ResponseWriter, r *http.Request) {
 // @Param        id    path     int  true  "ID del usuario"
 // @Success      200   {object} UserOverviewDTO
 // @Failure      401   {object} ErrorResponse
+// @Failure      403   {object} ErrorResponse
 // @Failure      404   {object} ErrorResponse
 // @Failure      500   {object} ErrorResponse
 // @Router       /api/v1/users/{id}/overview [get]
@@ -320,6 +334,10 @@ func (h *UsersHandler) UserOverview(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Not Found", http.StatusNotFound)
 		return
 	}
+	if !isSelfOr(r, userID, auth.PermUsersRead) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
 
 	u, err := h.Svc.GetOverviewData(r.Context(), userID)
 	if err != nil {
@@ -369,7 +387,7 @@ func (h *UsersHandler) UsersOverview(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *UsersHandler) create(w http.ResponseWriter, r *http.Request) {
-	if !isAdmin(r) {
+	if !hasPermission(r, auth.PermUsersWrite) {
 		http.Error(w, "Forbidden", http.StatusForbidden)
 		return
 	}
@@ -380,6 +398,12 @@ func (h *UsersHandler) create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	hireDate, err := parseHireDate(req.HireDate)
+	if err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
 	u, err := h.Svc.Create(r.Context(), services.CreateUserInput{
 		Username:     req.Username,
 		Password:     req.Password,
@@ -391,6 +415,9 @@ func (h *UsersHandler) create(w http.ResponseWriter, r *http.Request) {
 		EmployeeCode: req.EmployeeCode,
 		AccessCode:   req.AccessCode,
 		IsActive:     req.IsActive,
+
+		HireDate:          hireDate,
+		PriorServiceYears: req.PriorServiceYears,
 	})
 	if err != nil {
 		switch err {
@@ -436,6 +463,11 @@ func (h *UsersHandler) list(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *UsersHandler) getByID(w http.ResponseWriter, r *http.Request, userID int) {
+	if !isSelfOr(r, userID, auth.PermUsersRead) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
 	u, err := h.Svc.GetByID(r.Context(), userID)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -457,6 +489,10 @@ func (h *UsersHandler) UserAccessCode(w http.ResponseWriter, r *http.Request, us
 		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
 		return
 	}
+	if !isSelfOr(r, userID, auth.PermUsersRead) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
 
 	u, err := h.Svc.GetByID(r.Context(), userID)
 	if err != nil {
@@ -476,7 +512,7 @@ func (h *UsersHandler) UserAccessCode(w http.ResponseWriter, r *http.Request, us
 }
 
 func (h *UsersHandler) patch(w http.ResponseWriter, r *http.Request, userID int) {
-	if !isAdmin(r) {
+	if !hasPermission(r, auth.PermUsersWrite) {
 		http.Error(w, "Forbidden", http.StatusForbidden)
 		return
 	}
@@ -487,6 +523,12 @@ func (h *UsersHandler) patch(w http.ResponseWriter, r *http.Request, userID int)
 		return
 	}
 
+	hireDate, err := parseHireDate(req.HireDate)
+	if err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
 	u, err := h.Svc.Patch(r.Context(), userID, services.PatchUserInput{
 		Username:     req.Username,
 		Password:     req.Password,
@@ -498,6 +540,9 @@ func (h *UsersHandler) patch(w http.ResponseWriter, r *http.Request, userID int)
 		EmployeeCode: req.EmployeeCode,
 		AccessCode:   req.AccessCode,
 		IsActive:     req.IsActive,
+
+		HireDate:          hireDate,
+		PriorServiceYears: req.PriorServiceYears,
 	})
 	if err != nil {
 		switch err {
@@ -530,7 +575,7 @@ func (h *UsersHandler) patch(w http.ResponseWriter, r *http.Request, userID int)
 }
 
 func (h *UsersHandler) delete(w http.ResponseWriter, r *http.Request, userID int) {
-	if !isAdmin(r) {
+	if !hasPermission(r, auth.PermUsersWrite) {
 		http.Error(w, "Forbidden", http.StatusForbidden)
 		return
 	}
@@ -571,6 +616,22 @@ func parseUserIDFromPath(path string) (int, bool) {
 	return id, true
 }
 
+// parseHireDate interpreta una fecha opcional YYYY-MM-DD del body.
+func parseHireDate(v *string) (*time.Time, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return parseOptionalDate(strings.TrimSpace(*v))
+}
+
+func formatOptionalDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	v := t.Format("2006-01-02")
+	return &v
+}
+
 func mapUserDTO(u *ent.User) UserDTO {
 	branches := make([]UsersBranchDTO, 0, len(u.Edges.UserBranches))
 	for _, ub := range u.Edges.UserBranches {
@@ -611,6 +672,9 @@ func mapUserDTO(u *ent.User) UserDTO {
 		EmployeeCode: u.EmployeeCode,
 		AccessCode:   u.AccessCode,
 		Branches:     branches,
+
+		HireDate:          formatOptionalDate(u.HireDate),
+		PriorServiceYears: u.PriorServiceYears,
 	}
 }
 
@@ -666,13 +730,16 @@ func mapUserOverviewDTO(u *ent.User) UserOverviewDTO {
 
 // UserExcelExport godoc
 // @Summary      Exportar usuario - Datos completos
-// @Description  GET retorna todos los datos del usuario incluyendo direcciones, sucursales, turnos, asistencia, etc. Para exportar a Excel
+// @Description  GET retorna todos los datos del usuario incluyendo direcciones, sucursales, turnos, asistencia, etc. Con format=xlsx (o Accept de Excel) descarga el archivo .xlsx con una hoja por sección.
 // @Tags         Users
 // @Produce      json
+// @Produce      application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
 // @Security     BearerAuth
-// @Param        id    path     int  true  "ID del usuario"
+// @Param        id      path     int     true  "ID del usuario"
+// @Param        format  query    string  false "json | xlsx"
 // @Success      200   {object} UserExcelExportDTO
 // @Failure      401   {object} ErrorResponse
+// @Failure      403   {object} ErrorResponse
 // @Failure      404   {object} ErrorResponse
 // @Failure      500   {object} ErrorResponse
 // @Router       /api/v1/users/{id}/export [get]
@@ -681,6 +748,10 @@ func (h *UsersHandler) UserExcelExport(w http.ResponseWriter, r *http.Request, u
 		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
 		return
 	}
+	if !isSelfOr(r, userID, auth.PermUsersRead) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
 
 	u, err := h.Svc.GetUserFullData(r.Context(), userID)
 	if err != nil {
@@ -694,6 +765,13 @@ func (h *UsersHandler) UserExcelExport(w http.ResponseWriter, r *http.Request, u
 
 	resp := mapUserExcelExportDTO(u)
 
+	if exportFormat(r) == exportFormatXLSX {
+		if err := writeUserXLSX(w, resp); err != nil {
+			log.Printf("[users] error writing xlsx export: user_id=%d err=%v", userID, err)
+		}
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	_ = json.NewEncoder(w).Encode(resp)
 }
@@ -934,6 +1012,7 @@ func mapUserExcelExportDTO(u *ent.User) UserExcelExportDTO {
 // @Param        id    path     int  true  "ID del usuario"
 // @Success      201   {object} services.QRResponse
 // @Failure      401   {object} ErrorResponse
+// @Failure      403   {object} ErrorResponse
 // @Failure      404   {object} ErrorResponse
 // @Failure      500   {object} ErrorResponse
 // @Router       /api/v1/users/{id}/qr-session [post]
@@ -942,6 +1021,10 @@ func (h *UsersHandler) GenerateQRSession(w http.ResponseWriter, r *http.Request,
 		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
 		return
 	}
+	if !isSelfOr(r, userID, auth.PermUsersWrite) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
 
 	// Verificar que el usuario existe
 	u, err := h.Svc.GetByID(r.Context(), userID)
@@ -965,3 +1048,98 @@ func (h *UsersHandler) GenerateQRSession(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusCreated)
 	_ = json.NewEncoder(w).Encode(qr)
 }
+
+// writeUserXLSX genera el Excel con los datos completos del usuario: una hoja por sección.
+func writeUserXLSX(w http.ResponseWriter, u UserExcelExportDTO) error {
+	xw := startXLSXDownload(w, fmt.Sprintf("usuario_%d.xlsx", u.ID))
+
+	sheet := func(name string, header []string, rows [][]any) error {
+		if err := xw.AddSheet(name); err != nil {
+			return err
+		}
+		if err := xw.WriteHeader(header...); err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if err := xw.WriteRow(row...); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := sheet("Usuario", []string{"Campo", "Valor"}, [][]any{
+		{"ID", u.ID},
+		{"Usuario", u.Username},
+		{"Nombre", u.FirstName},
+		{"Segundo nombre", u.MiddleName},
+		{"Apellido", u.LastName},
+		{"Email", u.Email},
+		{"Rol", u.Role},
+		{"Código empleado", u.EmployeeCode},
+		{"Código de acceso", u.AccessCode},
+		{"Activo", u.IsActive},
+		{"Creado", u.CreatedAt},
+		{"Actualizado", u.UpdatedAt},
+	}); err != nil {
+		return err
+	}
+
+	rows := make([][]any, 0, len(u.Addresses))
+	for _, a := range u.Addresses {
+		rows = append(rows, []any{a.ID, a.Street, a.Number, a.Apartment, a.CommuneName, a.CityName, a.RegionName})
+	}
+	if err := sheet("Direcciones", []string{"ID", "Calle", "Número", "Depto", "Comuna", "Ciudad", "Región"}, rows); err != nil {
+		return err
+	}
+
+	rows = make([][]any, 0, len(u.UserBranches))
+	for _, ub := range u.UserBranches {
+		rows = append(rows, []any{ub.ID, ub.Branch.ID, ub.Branch.Name, ub.Branch.Code, ub.RoleInBranch, ub.IsActive})
+	}
+	if err := sheet("Sucursales", []string{"ID", "Sucursal ID", "Sucursal", "Código", "Rol en sucursal", "Activa"}, rows); err != nil {
+		return err
+	}
+
+	rows = make([][]any, 0, len(u.UserAccessPoints))
+	for _, ap := range u.UserAccessPoints {
+		rows = append(rows, []any{ap.ID, ap.AccessPointID, ap.AccessPointName, ap.IsActive, ap.AssignedAt, ap.RevokedAt})
+	}
+	if err := sheet("Accesos", []string{"ID", "Acceso ID", "Acceso", "Activo", "Asignado", "Revocado"}, rows); err != nil {
+		return err
+	}
+
+	rows = make([][]any, 0, len(u.ShiftAssignments))
+	for _, sa := range u.ShiftAssignments {
+		rows = append(rows, []any{sa.ID, sa.ShiftID, sa.ShiftName, sa.StartTime, sa.EndTime, sa.StartDate, sa.EndDate, sa.IsActive})
+	}
+	if err := sheet("Turnos", []string{"ID", "Turno ID", "Turno", "Inicio", "Término", "Desde", "Hasta", "Activo"}, rows); err != nil {
+		return err
+	}
+
+	rows = make([][]any, 0, len(u.DayOverrides))
+	for _, o := range u.DayOverrides {
+		rows = append(rows, []any{o.ID, o.Date, o.IsDayOff, o.Mode, o.ShiftID, o.ShiftName, o.Notes})
+	}
+	if err := sheet("Días especiales", []string{"ID", "Fecha", "Día libre", "Modalidad", "Turno ID", "Turno", "Notas"}, rows); err != nil {
+		return err
+	}
+
+	rows = make([][]any, 0, len(u.AttendanceDays))
+	for _, ad := range u.AttendanceDays {
+		rows = append(rows, []any{ad.ID, ad.WorkDate, ad.BranchID, ad.AccessPointID, ad.WorkInAt, ad.BreakOutAt, ad.BreakInAt, ad.WorkOutAt})
+	}
+	if err := sheet("Asistencia", []string{"ID", "Fecha", "Sucursal ID", "Acceso ID", "Entrada", "Salida colación", "Regreso colación", "Salida"}, rows); err != nil {
+		return err
+	}
+
+	rows = make([][]any, 0, len(u.QRSessions))
+	for _, q := range u.QRSessions {
+		rows = append(rows, []any{q.ID, q.IssuedAt, q.ExpiresAt, q.IsRevoked})
+	}
+	if err := sheet("Sesiones QR", []string{"ID", "Emitida", "Expira", "Revocada"}, rows); err != nil {
+		return err
+	}
+
+	return xw.Close()
+}
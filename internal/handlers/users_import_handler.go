@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"back/internal/auth"
+	"back/internal/services"
+	"back/internal/xlsx"
+)
+
+// tamaño máximo del archivo de importación
+const maxUserImportBytes = 10 << 20
+
+/* =========================
+   DTOs
+   ========================= */
+
+type UserImportErrorDTO struct {
+	Row     int    `json:"row" example:"3"`
+	Column  string `json:"column" example:"email"`
+	Message string `json:"message" example:"ya existe"`
+}
+
+type UserImportUserDTO struct {
+	Row      int    `json:"row" example:"2"`
+	Username string `json:"username" example:"jperez"`
+	UserID   *int   `json:"user_id,omitempty" example:"25"`
+}
+
+type UserImportReportDTO struct {
+	DryRun    bool                 `json:"dry_run" example:"true"`
+	TotalRows int                  `json:"total_rows" example:"120"`
+	ValidRows int                  `json:"valid_rows" example:"118"`
+	Created   int                  `json:"created" example:"0"`
+	Errors    []UserImportErrorDTO `json:"errors"`
+	Users     []UserImportUserDTO  `json:"users"`
+}
+
+/* =========================
+   ROUTES
+   ========================= */
+
+// UsersImport godoc
+// @Summary      Importación masiva de usuarios (CSV / XLSX)
+// @Description  Recibe una planilla (multipart, campo "file"; o el archivo como body con Content-Type text/csv o xlsx). Columnas: username, password, role, first_name, middle_name, last_name, email, employee_code, access_code, is_active, hire_date, prior_service_years, branch_codes, access_points, shift, shift_start_date. Con dry_run=true (por defecto) solo valida y devuelve los errores por fila. Con dry_run=false crea todos los usuarios en una única transacción; si alguna fila tiene errores no se crea ninguno (422). Máximo 200 filas por archivo. (solo admin)
+// @Tags         Users
+// @Accept       multipart/form-data
+// @Accept       text/csv
+// @Accept       application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Produce      json
+// @Security     BearerAuth
+// @Param        file     formData  file  false "Planilla .csv o .xlsx"
+// @Param        dry_run  query     bool  false "Solo validar (por defecto true)"
+// @Success      200      {object}  UserImportReportDTO
+// @Success      201      {object}  UserImportReportDTO
+// @Failure      400      {object}  ErrorResponse
+// @Failure      401      {object}  ErrorResponse
+// @Failure      403      {object}  ErrorResponse
+// @Failure      409      {object}  ErrorResponse
+// @Failure      422      {object}  UserImportReportDTO
+// @Failure      500      {object}  ErrorResponse
+// @Router       /api/v1/users/import [post]
+func (h *UsersHandler) UsersImport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !hasPermission(r, auth.PermUsersWrite) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	dryRun := true
+	if v := strings.TrimSpace(r.URL.Query().Get("dry_run")); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			http.Error(w, "dry_run invalido", http.StatusBadRequest)
+			return
+		}
+		dryRun = b
+	}
+
+	rows, err := readUserImportRows(w, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	report, err := h.Svc.Import(r.Context(), rows, dryRun)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrUserImportInvalidFile):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case errors.Is(err, services.ErrUserAlreadyExists):
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			log.Printf("[users] error importing users: %v", err)
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	status := http.StatusOK
+	switch {
+	case len(report.Errors) > 0 && !dryRun:
+		status = http.StatusUnprocessableEntity
+	case report.Created > 0:
+		status = http.StatusCreated
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(mapUserImportReport(report))
+}
+
+/* =========================
+   INTERNAL
+   ========================= */
+
+// readUserImportRows lee la planilla del request (multipart o body directo) y la
+// convierte en filas de texto. El formato se detecta por extensión/Content-Type.
+func readUserImportRows(w http.ResponseWriter, r *http.Request) ([][]string, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxUserImportBytes)
+
+	var data []byte
+	var isXLSX bool
+
+	contentType := r.Header.Get("Content-Type")
+	if strings.HasPrefix(contentType, "multipart/form-data") {
+		if err := r.ParseMultipartForm(maxUserImportBytes); err != nil {
+			return nil, errors.New("archivo invalido o demasiado grande")
+		}
+		f, fh, err := r.FormFile("file")
+		if err != nil {
+			return nil, errors.New("falta el archivo (campo file)")
+		}
+		defer f.Close()
+
+		if data, err = io.ReadAll(f); err != nil {
+			return nil, errors.New("archivo invalido o demasiado grande")
+		}
+		ext := strings.ToLower(filepath.Ext(fh.Filename))
+		isXLSX = ext == ".xlsx" || strings.Contains(fh.Header.Get("Content-Type"), xlsx.ContentType)
+	} else {
+		var err error
+		if data, err = io.ReadAll(r.Body); err != nil {
+			return nil, errors.New("archivo invalido o demasiado grande")
+		}
+		isXLSX = strings.Contains(contentType, xlsx.ContentType)
+	}
+
+	// un .xlsx es un zip: se reconoce también por la firma
+	if !isXLSX && bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		isXLSX = true
+	}
+
+	if isXLSX {
+		rows, err := xlsx.ReadFirstSheet(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			return nil, errors.New("xlsx invalido")
+		}
+		return rows, nil
+	}
+	return parseImportCSV(data)
+}
+
+// parseImportCSV acepta "," o ";" como separador (se detecta en el encabezado) y
+// descarta el BOM que agrega Excel.
+func parseImportCSV(data []byte) ([][]string, error) {
+	data = bytes.TrimPrefix(data, []byte("\xEF\xBB\xBF"))
+
+	header := data
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		header = data[:i]
+	}
+
+	cr := csv.NewReader(bytes.NewReader(data))
+	if bytes.Count(header, []byte(";")) > bytes.Count(header, []byte(",")) {
+		cr.Comma = ';'
+	}
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, errors.New("csv invalido")
+	}
+
+	rows := make([][]string, 0, len(records))
+	for _, rec := range records {
+		empty := true
+		for _, v := range rec {
+			if strings.TrimSpace(v) != "" {
+				empty = false
+				break
+			}
+		}
+		if !empty {
+			rows = append(rows, rec)
+		}
+	}
+	return rows, nil
+}
+
+func mapUserImportReport(rep *services.UserImportReport) UserImportReportDTO {
+	out := UserImportReportDTO{
+		DryRun:    rep.DryRun,
+		TotalRows: rep.TotalRows,
+		ValidRows: rep.ValidRows,
+		Created:   rep.Created,
+		Errors:    make([]UserImportErrorDTO, 0, len(rep.Errors)),
+		Users:     make([]UserImportUserDTO, 0, len(rep.Users)),
+	}
+	for _, e := range rep.Errors {
+		out.Errors = append(out.Errors, UserImportErrorDTO{Row: e.Row, Column: e.Column, Message: e.Message})
+	}
+	for _, u := range rep.Users {
+		out.Users = append(out.Users, UserImportUserDTO{Row: u.Row, Username: u.Username, UserID: u.UserID})
+	}
+	return out
+}
@@ -0,0 +1,328 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"back/internal/auth"
+	"back/internal/ent"
+	"back/internal/services"
+)
+
+type VacationHandler struct {
+	Svc *services.VacationService
+}
+
+func NewVacationHandler(svc *services.VacationService) *VacationHandler {
+	return &VacationHandler{Svc: svc}
+}
+
+/* =========================
+   REQUESTS
+   ========================= */
+
+type createVacationAdjustmentRequest struct {
+	Date   *string `json:"date,omitempty" example:"2026-03-01"` // por defecto hoy
+	Days   float64 `json:"days" example:"-2"`                   // positivo suma, negativo descuenta
+	Reason string  `json:"reason" example:"Saldo migrado desde sistema anterior"`
+}
+
+/* =========================
+   DTOs
+   ========================= */
+
+type VacationBalanceDTO struct {
+	UserID            int     `json:"user_id" example:"10"`
+	HireDate          string  `json:"hire_date" example:"2022-03-01"`
+	AsOf              string  `json:"as_of" example:"2026-03-15"`
+	YearsOfService    int     `json:"years_of_service" example:"4"`
+	ProgressiveDays   int     `json:"progressive_days" example:"0"`
+	AnnualEntitlement float64 `json:"annual_entitlement" example:"15"`
+	Accrued           float64 `json:"accrued" example:"60"`
+	Taken             float64 `json:"taken" example:"40"`
+	Adjustments       float64 `json:"adjustments" example:"0"`
+	Balance           float64 `json:"balance" example:"20"`
+	Scheduled         float64 `json:"scheduled" example:"5"`
+	Available         float64 `json:"available" example:"15"`
+}
+
+type VacationEntryDTO struct {
+	Date        string  `json:"date" example:"2026-03-01"`
+	Type        string  `json:"type" example:"accrual"` // accrual | leave | adjustment
+	Days        float64 `json:"days" example:"1.25"`
+	Balance     float64 `json:"balance" example:"20"`
+	Description string  `json:"description" example:"Devengo mensual"`
+	ReferenceID *int    `json:"reference_id,omitempty" example:"3"`
+}
+
+type VacationLedgerDTO struct {
+	UserID         int                `json:"user_id" example:"10"`
+	From           string             `json:"from" example:"2026-01-01"`
+	To             string             `json:"to" example:"2026-03-15"`
+	OpeningBalance float64            `json:"opening_balance" example:"16.25"`
+	ClosingBalance float64            `json:"closing_balance" example:"20"`
+	Entries        []VacationEntryDTO `json:"entries"`
+}
+
+type VacationAdjustmentDTO struct {
+	ID          int       `json:"id" example:"3"`
+	UserID      int       `json:"user_id" example:"10"`
+	Date        string    `json:"date" example:"2026-03-01"`
+	Days        float64   `json:"days" example:"-2"`
+	Reason      string    `json:"reason" example:"Saldo migrado desde sistema anterior"`
+	CreatedByID *int      `json:"created_by_id,omitempty" example:"1"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+/* =========================
+   ROUTES
+   ========================= */
+
+// VacationBalance godoc
+// @Summary      Saldo de vacaciones
+// @Description  Devuelve el saldo de feriado legal + progresivo devengado desde la fecha de contratación. Admin o el propio usuario.
+// @Tags         Vacations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id     path     int    true  "ID del usuario"
+// @Param        as_of  query    string false "Fecha de corte (YYYY-MM-DD), por defecto hoy"
+// @Success      200    {object} VacationBalanceDTO
+// @Failure      400    {object} ErrorResponse
+// @Failure      401    {object} ErrorResponse
+// @Failure      403    {object} ErrorResponse
+// @Failure      404    {object} ErrorResponse
+// @Failure      409    {object} ErrorResponse
+// @Failure      500    {object} ErrorResponse
+// @Router       /api/v1/users/{id}/vacation [get]
+func (h *VacationHandler) VacationBalance(w http.ResponseWriter, r *http.Request, userID int) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !canViewUserVacation(r, userID) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	asOf, err := parseOptionalDate(r.URL.Query().Get("as_of"))
+	if err != nil {
+		http.Error(w, "as_of invalido", http.StatusBadRequest)
+		return
+	}
+	at := time.Now()
+	if asOf != nil {
+		at = *asOf
+	}
+
+	b, err := h.Svc.Balance(r.Context(), userID, at)
+	if err != nil {
+		writeVacationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(VacationBalanceDTO{
+		UserID:            b.UserID,
+		HireDate:          b.HireDate.Format("2006-01-02"),
+		AsOf:              b.AsOf.Format("2006-01-02"),
+		YearsOfService:    b.YearsOfService,
+		ProgressiveDays:   b.ProgressiveDays,
+		AnnualEntitlement: b.AnnualEntitlement,
+		Accrued:           b.Accrued,
+		Taken:             b.Taken,
+		Adjustments:       b.Adjustments,
+		Balance:           b.Balance,
+		Scheduled:         b.Scheduled,
+		Available:         b.Available,
+	})
+}
+
+// VacationLedger godoc
+// @Summary      Libro de vacaciones
+// @Description  Devengos mensuales, días tomados (días laborables del turno dentro de vacaciones aprobadas) y ajustes manuales con saldo acumulado. Admin o el propio usuario.
+// @Tags         Vacations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id          path     int    true  "ID del usuario"
+// @Param        start_date  query    string false "Desde (YYYY-MM-DD), por defecto fecha de contratación"
+// @Param        end_date    query    string false "Hasta (YYYY-MM-DD), por defecto hoy"
+// @Success      200         {object} VacationLedgerDTO
+// @Failure      400         {object} ErrorResponse
+// @Failure      401         {object} ErrorResponse
+// @Failure      403         {object} ErrorResponse
+// @Failure      404         {object} ErrorResponse
+// @Failure      409         {object} ErrorResponse
+// @Failure      500         {object} ErrorResponse
+// @Router       /api/v1/users/{id}/vacation/ledger [get]
+func (h *VacationHandler) VacationLedger(w http.ResponseWriter, r *http.Request, userID int) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !canViewUserVacation(r, userID) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	from, err := parseOptionalDate(r.URL.Query().Get("start_date"))
+	if err != nil {
+		http.Error(w, "start_date invalido", http.StatusBadRequest)
+		return
+	}
+	to, err := parseOptionalDate(r.URL.Query().Get("end_date"))
+	if err != nil {
+		http.Error(w, "end_date invalido", http.StatusBadRequest)
+		return
+	}
+
+	l, err := h.Svc.Ledger(r.Context(), userID, from, to)
+	if err != nil {
+		writeVacationError(w, err)
+		return
+	}
+
+	entries := make([]VacationEntryDTO, 0, len(l.Entries))
+	for _, e := range l.Entries {
+		entries = append(entries, VacationEntryDTO{
+			Date:        e.Date.Format("2006-01-02"),
+			Type:        e.Type,
+			Days:        e.Days,
+			Balance:     e.Balance,
+			Description: e.Description,
+			ReferenceID: e.ReferenceID,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(VacationLedgerDTO{
+		UserID:         l.UserID,
+		From:           l.From.Format("2006-01-02"),
+		To:             l.To.Format("2006-01-02"),
+		OpeningBalance: l.OpeningBalance,
+		ClosingBalance: l.ClosingBalance,
+		Entries:        entries,
+	})
+}
+
+// VacationAdjustments godoc
+// @Summary      Ajustes manuales de vacaciones
+// @Description  GET lista los ajustes (admin o el propio usuario). POST registra un ajuste con motivo (solo admin).
+// @Tags         Vacations
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path     int                              true  "ID del usuario"
+// @Param        body  body     createVacationAdjustmentRequest  false "Ajuste (solo POST)"
+// @Success      200   {array}  VacationAdjustmentDTO
+// @Success      201   {object} VacationAdjustmentDTO
+// @Failure      400   {object} ErrorResponse
+// @Failure      401   {object} ErrorResponse
+// @Failure      403   {object} ErrorResponse
+// @Failure      404   {object} ErrorResponse
+// @Failure      500   {object} ErrorResponse
+// @Router       /api/v1/users/{id}/vacation/adjustments [get]
+// @Router       /api/v1/users/{id}/vacation/adjustments [post]
+func (h *VacationHandler) VacationAdjustments(w http.ResponseWriter, r *http.Request, userID int) {
+	switch r.Method {
+	case http.MethodGet:
+		h.listAdjustments(w, r, userID)
+	case http.MethodPost:
+		h.createAdjustment(w, r, userID)
+	default:
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+/* =========================
+   INTERNAL
+   ========================= */
+
+func (h *VacationHandler) listAdjustments(w http.ResponseWriter, r *http.Request, userID int) {
+	if !canViewUserVacation(r, userID) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	items, err := h.Svc.ListAdjustments(r.Context(), userID)
+	if err != nil {
+		writeVacationError(w, err)
+		return
+	}
+
+	resp := make([]VacationAdjustmentDTO, 0, len(items))
+	for _, a := range items {
+		resp = append(resp, mapVacationAdjustment(a))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (h *VacationHandler) createAdjustment(w http.ResponseWriter, r *http.Request, userID int) {
+	if !hasPermission(r, auth.PermVacationManage) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+	adminID, _ := callerUserID(r)
+
+	var req createVacationAdjustmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	in := services.CreateVacationAdjustmentInput{
+		Days:   req.Days,
+		Reason: req.Reason,
+	}
+	if req.Date != nil {
+		d, err := time.Parse("2006-01-02", *req.Date)
+		if err != nil {
+			http.Error(w, "date invalido", http.StatusBadRequest)
+			return
+		}
+		in.Date = &d
+	}
+
+	a, err := h.Svc.CreateAdjustment(r.Context(), userID, adminID, in)
+	if err != nil {
+		writeVacationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(mapVacationAdjustment(a))
+}
+
+// canViewUserVacation: quien tiene auth.PermVacationManage ve cualquier usuario; el resto solo su propio saldo.
+func canViewUserVacation(r *http.Request, userID int) bool {
+	return isSelfOr(r, userID, auth.PermVacationManage)
+}
+
+func writeVacationError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrVacationInvalidInput):
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+	case errors.Is(err, services.ErrVacationHireDateMissing):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case ent.IsNotFound(err):
+		http.Error(w, "Not Found", http.StatusNotFound)
+	default:
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
+
+func mapVacationAdjustment(a *ent.VacationAdjustment) VacationAdjustmentDTO {
+	return VacationAdjustmentDTO{
+		ID:          a.ID,
+		UserID:      a.UserID,
+		Date:        a.Date.Format("2006-01-02"),
+		Days:        a.Days,
+		Reason:      a.Reason,
+		CreatedByID: a.CreatedByID,
+		CreatedAt:   a.CreatedAt,
+	}
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+
+	"back/internal/auth"
+)
+
+// ScopeResolver deja en el contexto el alcance por sucursal del usuario autenticado.
+// subjectID es el ID del usuario, o el del dispositivo cuando role es "device".
+// allBranches indica que el token trae el permiso branches:all.
+type ScopeResolver func(ctx context.Context, subjectID int, role string, allBranches bool) (context.Context, error)
+
+// BranchScope debe ir después de JWT: resuelve las sucursales que el usuario puede ver
+// para que los servicios filtren sus consultas automáticamente.
+func BranchScope(resolve ScopeResolver) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := GetClaims(r)
+			if !ok {
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			subjectID, err := strconv.Atoi(claims.Subject)
+			if err != nil || subjectID <= 0 {
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			ctx, err := resolve(r.Context(), subjectID, claims.Role, claims.HasPermission(auth.PermBranchesAll))
+			if err != nil {
+				log.Printf("rid=%s error resolving branch scope: %v", GetRequestID(r), err)
+				http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -10,7 +10,12 @@ import (
 type ctxKeyRequestID struct{}
 
 func GetRequestID(r *http.Request) string {
-	v := r.Context().Value(ctxKeyRequestID{})
+	return RequestIDFromContext(r.Context())
+}
+
+// RequestIDFromContext es GetRequestID para quien solo tiene el contexto.
+func RequestIDFromContext(ctx context.Context) string {
+	v := ctx.Value(ctxKeyRequestID{})
 	if s, ok := v.(string); ok {
 		return s
 	}
@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"net/http"
+)
+
+// RequirePermission exige que el token incluya el permiso del catálogo (auth.Perm*).
+func RequirePermission(perm string) func(http.Handler) http.Handler {
+	return RequirePermissionByMethod(perm, perm)
+}
+
+// RequirePermissionByMethod exige read para GET/HEAD y write para el resto de métodos,
+// para rutas que atienden lectura y escritura del mismo recurso.
+func RequirePermissionByMethod(read, write string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := GetClaims(r)
+			if !ok {
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			perm := write
+			if r.Method == http.MethodGet || r.Method == http.MethodHead {
+				perm = read
+			}
+			if !claims.HasPermission(perm) {
+				http.Error(w, "Forbidden", http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
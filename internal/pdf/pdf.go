@@ -0,0 +1,283 @@
+// Package pdf genera documentos PDF simples (texto y tablas) sin dependencias externas.
+// Usa las fuentes estándar Helvetica / Helvetica-Bold con WinAnsiEncoding, suficiente
+// para reportes en español.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	a4Width  = 595.28
+	a4Height = 841.89
+
+	margin = 36.0
+
+	fontRegular = "F1"
+	fontBold    = "F2"
+
+	headingSize = 13.0
+	textSize    = 9.0
+	tableSize   = 7.5
+	footerSize  = 7.0
+
+	rowHeight = 11.0
+)
+
+// Document es un documento de flujo: el contenido se agrega de arriba hacia abajo
+// y las páginas se crean automáticamente al llegar al margen inferior.
+type Document struct {
+	width  float64
+	height float64
+	pages  []*bytes.Buffer
+	cur    *bytes.Buffer
+	y      float64
+
+	// texto fijo del pie de página (se agrega "Página n de N")
+	Footer string
+}
+
+// New crea un documento A4 (apaisado si landscape es true) con una página en blanco.
+func New(landscape bool) *Document {
+	d := &Document{width: a4Width, height: a4Height}
+	if landscape {
+		d.width, d.height = a4Height, a4Width
+	}
+	d.AddPage()
+	return d
+}
+
+// AddPage inicia una nueva página.
+func (d *Document) AddPage() {
+	d.cur = &bytes.Buffer{}
+	d.pages = append(d.pages, d.cur)
+	d.y = d.height - margin
+}
+
+// Heading escribe un título en negrita.
+func (d *Document) Heading(text string) {
+	d.ensureSpace(headingSize + 6)
+	d.y -= headingSize
+	d.text(margin, d.y, fontBold, headingSize, text)
+	d.y -= 6
+}
+
+// Text escribe una línea de texto normal (se corta si excede el ancho útil).
+func (d *Document) Text(text string) {
+	d.ensureSpace(textSize + 4)
+	d.y -= textSize
+	d.text(margin, d.y, fontRegular, textSize, fitText(text, fontRegular, textSize, d.width-2*margin))
+	d.y -= 4
+}
+
+// BoldText escribe una línea de texto en negrita.
+func (d *Document) BoldText(text string) {
+	d.ensureSpace(textSize + 4)
+	d.y -= textSize
+	d.text(margin, d.y, fontBold, textSize, fitText(text, fontBold, textSize, d.width-2*margin))
+	d.y -= 4
+}
+
+// Spacer agrega espacio vertical.
+func (d *Document) Spacer(h float64) {
+	d.y -= h
+}
+
+// Table dibuja una tabla con encabezado. widths son pesos relativos por columna
+// (si es nil se reparte en partes iguales). El encabezado se repite en cada página.
+// Las filas marcadas en boldRows (por índice) se escriben en negrita (ej: totales).
+func (d *Document) Table(columns []string, widths []float64, rows [][]string, boldRows map[int]bool) {
+	if len(columns) == 0 {
+		return
+	}
+	colWidths := d.columnWidths(len(columns), widths)
+
+	header := func() {
+		d.ensureSpace(rowHeight * 2)
+		d.fillRect(margin, d.y-rowHeight, d.width-2*margin, rowHeight, 0.85)
+		d.row(columns, colWidths, fontBold)
+	}
+
+	header()
+	for i, r := range rows {
+		if d.y-rowHeight < margin+footerSize+4 {
+			d.AddPage()
+			header()
+		}
+		font := fontRegular
+		if boldRows[i] {
+			font = fontBold
+		}
+		d.row(r, colWidths, font)
+		d.line(margin, d.y, d.width-margin, d.y, 0.8)
+	}
+	d.y -= 4
+}
+
+// Bytes serializa el documento agregando el pie de página y la numeración.
+func (d *Document) Bytes() []byte {
+	var out bytes.Buffer
+	offsets := []int{}
+
+	writeObj := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xE2\xE3\xCF\xD3\n")
+
+	// 1 catálogo, 2 árbol de páginas, 3-4 fuentes, luego (página, contenido) por página
+	n := len(d.pages)
+	kids := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 5+i*2))
+	}
+
+	writeObj("<< /Type /Catalog /Pages 2 0 R >>")
+	writeObj(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), n))
+	writeObj("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	writeObj("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, page := range d.pages {
+		footer := fmt.Sprintf("Página %d de %d", i+1, n)
+		if d.Footer != "" {
+			footer = d.Footer + " - " + footer
+		}
+		var content bytes.Buffer
+		content.Write(page.Bytes())
+		writeText(&content, margin, margin/2, fontRegular, footerSize, footer)
+
+		writeObj(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /%s 3 0 R /%s 4 0 R >> >> /Contents %d 0 R >>",
+			d.width, d.height, fontRegular, fontBold, 6+i*2,
+		))
+		writeObj(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return out.Bytes()
+}
+
+/* =========================
+   INTERNAL
+   ========================= */
+
+func (d *Document) ensureSpace(h float64) {
+	if d.y-h < margin+footerSize+4 {
+		d.AddPage()
+	}
+}
+
+func (d *Document) columnWidths(n int, weights []float64) []float64 {
+	usable := d.width - 2*margin
+	out := make([]float64, n)
+	total := 0.0
+	for i := 0; i < n; i++ {
+		w := 1.0
+		if i < len(weights) && weights[i] > 0 {
+			w = weights[i]
+		}
+		out[i] = w
+		total += w
+	}
+	for i := range out {
+		out[i] = out[i] / total * usable
+	}
+	return out
+}
+
+func (d *Document) row(cells []string, widths []float64, font string) {
+	d.y -= rowHeight
+	x := margin
+	for i, w := range widths {
+		if i < len(cells) {
+			d.text(x+2, d.y+3, font, tableSize, fitText(cells[i], font, tableSize, w-4))
+		}
+		x += w
+	}
+}
+
+func (d *Document) text(x, y float64, font string, size float64, s string) {
+	writeText(d.cur, x, y, font, size, s)
+}
+
+func (d *Document) line(x1, y1, x2, y2, gray float64) {
+	fmt.Fprintf(d.cur, "%.2f G 0.5 w %.2f %.2f m %.2f %.2f l S 0 G\n", gray, x1, y1, x2, y2)
+}
+
+func (d *Document) fillRect(x, y, w, h, gray float64) {
+	fmt.Fprintf(d.cur, "%.2f g %.2f %.2f %.2f %.2f re f 0 g\n", gray, x, y, w, h)
+}
+
+func writeText(buf *bytes.Buffer, x, y float64, font string, size float64, s string) {
+	fmt.Fprintf(buf, "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, y, encode(s))
+}
+
+// encode convierte el texto a WinAnsi y escapa los caracteres especiales de PDF.
+func encode(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(byte(r))
+		case r == '\n' || r == '\r' || r == '\t':
+			b.WriteByte(' ')
+		case r < 128 || (r >= 160 && r <= 255):
+			b.WriteByte(byte(r))
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+// fitText recorta el texto con "..." para que quepa en el ancho dado.
+func fitText(s, font string, size, maxWidth float64) string {
+	if textWidth(s, font, size) <= maxWidth {
+		return s
+	}
+	runes := []rune(s)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		candidate := string(runes) + "..."
+		if textWidth(candidate, font, size) <= maxWidth {
+			return candidate
+		}
+	}
+	return ""
+}
+
+func textWidth(s, font string, size float64) float64 {
+	total := 0
+	for _, r := range s {
+		w := 556
+		if r >= 32 && r <= 126 {
+			w = helveticaWidths[r-32]
+		}
+		total += w
+	}
+	width := float64(total) * size / 1000
+	if font == fontBold {
+		width *= 1.06
+	}
+	return width
+}
+
+// anchos de Helvetica (unidades de 1/1000 em) para los caracteres 32..126
+var helveticaWidths = [...]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
@@ -1,10 +1,12 @@
 package server
 
 import (
+	"context"
 	"database/sql"
 	"net/http"
 	"strings"
 
+	"back/internal/auth"
 	"back/internal/config"
 	"back/internal/ent"
 	"back/internal/handlers"
@@ -50,9 +52,20 @@ func New(cfg *config.Config, client *ent.Client, db *sql.DB) *http.Server {
 	shiftService := services.NewShiftService(client)
 	shiftDayService := services.NewShiftDayService(client)
 	qrSessionService := services.NewQRSessionService(client)
-	attendanceService := services.NewAttendanceService(client, qrSessionService)
+	attendanceService := services.NewAttendanceService(client, qrSessionService, cfg.Attendance.SyncMaxBackfillDays)
 	dashboardService := services.NewDashboardService(client, db)
 	markingsService := services.NewMarkingsService(client, db)
+	holidayService := services.NewHolidayService(client)
+	leaveRequestService := services.NewLeaveRequestService(client)
+	vacationService := services.NewVacationService(client)
+	overtimeService := services.NewOvertimeService(client)
+	hourBankService := services.NewHourBankService(client, cfg.HourBank.ExpiryDays)
+	dtReportService := services.NewDTReportService(client)
+	payrollExportService := services.NewPayrollExportService(client)
+	accessService := services.NewAccessService(client)
+	roleService := services.NewRoleService(client)
+	auditService := services.NewAuditService(client)
+	markCorrectionService := services.NewMarkCorrectionService(client, markingsService)
 
 	// =========================
 	// Handlers
@@ -74,6 +87,23 @@ func New(cfg *config.Config, client *ent.Client, db *sql.DB) *http.Server {
 	attendanceHandler := handlers.NewAttendanceHandler(attendanceService)
 	dashboardHandler := handlers.NewDashboardHandler(dashboardService)
 	markingsHandler := handlers.NewMarkingsHandler(markingsService)
+	holidayHandler := handlers.NewHolidayHandler(holidayService)
+	leaveRequestHandler := handlers.NewLeaveRequestHandler(leaveRequestService)
+	vacationHandler := handlers.NewVacationHandler(vacationService)
+	overtimeHandler := handlers.NewOvertimeHandler(overtimeService)
+	hourBankHandler := handlers.NewHourBankHandler(hourBankService)
+	dtReportHandler := handlers.NewDTReportHandler(dtReportService)
+	payrollHandler := handlers.NewPayrollHandler(payrollExportService)
+	roleHandler := handlers.NewRoleHandler(roleService)
+	auditHandler := handlers.NewAuditHandler(auditService)
+	markCorrectionHandler := handlers.NewMarkCorrectionHandler(markCorrectionService)
+	meHandler := handlers.NewMeHandler(
+		usersHandler,
+		markingsHandler,
+		userDayOverrideHandler,
+		hourBankHandler,
+		markCorrectionHandler,
+	)
 
 	shiftHandler := handlers.NewShiftHandler(shiftService)
 	shiftDayHandler := handlers.NewShiftDayHandler(shiftDayService)
@@ -83,6 +113,20 @@ func New(cfg *config.Config, client *ent.Client, db *sql.DB) *http.Server {
 		cfg.Swagger.Pass,
 	)
 
+	// alcance por sucursal del usuario autenticado (va siempre después de JWT). También
+	// deja el actor y el request ID que registra el audit log; en un token de
+	// dispositivo el subject es el ID del dispositivo, no de un usuario.
+	branchScope := middleware.BranchScope(func(ctx context.Context, subjectID int, role string, allBranches bool) (context.Context, error) {
+		actor := services.AuditActor{RequestID: middleware.RequestIDFromContext(ctx)}
+		if strings.EqualFold(role, "device") {
+			actor.DeviceID = subjectID
+		} else {
+			actor.UserID = subjectID
+		}
+		ctx = services.WithAuditActor(ctx, actor)
+		return accessService.ScopeContext(ctx, subjectID, role, allBranches)
+	})
+
 	// =========================
 	// Swagger UI (LOGIN PROPIO)
 	// =========================
@@ -115,12 +159,6 @@ func New(cfg *config.Config, client *ent.Client, db *sql.DB) *http.Server {
 	// =========================
 	mux.HandleFunc("/api/v1/device-auth/login", deviceAuthHandler.Login)
 
-	// =========================
-	// Public routes (ATTENDANCE)
-	// =========================
-	mux.HandleFunc("/api/v1/attendance/validate-qr", attendanceHandler.ValidateQR)
-	mux.HandleFunc("/api/v1/attendance/validate-access-code", attendanceHandler.ValidateAccessCode)
-
 	// =========================
 	// Public routes (CATÁLOGO)
 	// =========================
@@ -134,43 +172,136 @@ func New(cfg *config.Config, client *ent.Client, db *sql.DB) *http.Server {
 	protectedRegister := middleware.Chain(
 		http.HandlerFunc(authHandler.Register),
 		middleware.JWT(cfg),
-		middleware.RequireRole("admin"),
+		branchScope,
+		middleware.RequirePermission(auth.PermUsersWrite),
 	)
 	mux.Handle("/api/v1/auth/register", protectedRegister)
 
 	protectedMe := middleware.Chain(
 		http.HandlerFunc(authHandler.Me),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermSelf),
 	)
 	mux.Handle("/api/v1/me", protectedMe)
 
+	// autoservicio: siempre sobre el usuario del token
+	protectedMeSubroutes := middleware.Chain(
+		http.HandlerFunc(meHandler.MeSubroutes),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermSelf),
+	)
+	mux.Handle("/api/v1/me/", protectedMeSubroutes)
+
 	protectedLogoutAll := middleware.Chain(
 		http.HandlerFunc(authHandler.LogoutAll),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermSelf),
 	)
 	mux.Handle("/api/v1/auth/logout-all", protectedLogoutAll)
 
 	protectedSessions := middleware.Chain(
 		http.HandlerFunc(authHandler.Sessions),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermSelf),
 	)
 	mux.Handle("/api/v1/auth/sessions", protectedSessions)
 
+	// =========================
+	// Protected routes (ATTENDANCE - solo dispositivos)
+	// =========================
+	protectedValidateQR := middleware.Chain(
+		http.HandlerFunc(attendanceHandler.ValidateQR),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermAttendance),
+	)
+	mux.Handle("/api/v1/attendance/validate-qr", protectedValidateQR)
+
+	protectedValidateAccessCode := middleware.Chain(
+		http.HandlerFunc(attendanceHandler.ValidateAccessCode),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermAttendance),
+	)
+	mux.Handle("/api/v1/attendance/validate-access-code", protectedValidateAccessCode)
+
+	protectedSyncPunches := middleware.Chain(
+		http.HandlerFunc(attendanceHandler.SyncPunches),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermAttendance),
+	)
+	mux.Handle("/api/v1/attendance/sync", protectedSyncPunches)
+
+	// =========================
+	// Protected routes (ROLES)
+	// =========================
+	protectedRoles := middleware.Chain(
+		http.HandlerFunc(roleHandler.Roles),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermRolesManage),
+	)
+	mux.Handle("/api/v1/roles", protectedRoles)
+
+	protectedRoleSubroutes := middleware.Chain(
+		http.HandlerFunc(roleHandler.RoleSubroutes),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermRolesManage),
+	)
+	mux.Handle("/api/v1/roles/", protectedRoleSubroutes)
+
+	protectedPermissions := middleware.Chain(
+		http.HandlerFunc(roleHandler.Permissions),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermRolesManage),
+	)
+	mux.Handle("/api/v1/permissions", protectedPermissions)
+
+	// =========================
+	// Protected routes (AUDIT)
+	// =========================
+	protectedAuditLogs := middleware.Chain(
+		http.HandlerFunc(auditHandler.AuditLogs),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermAuditRead),
+	)
+	mux.Handle("/api/v1/audit-logs", protectedAuditLogs)
+
 	// =========================
 	// Protected routes (USERS)
 	// =========================
 	protectedUsers := middleware.Chain(
 		http.HandlerFunc(usersHandler.Users),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermissionByMethod(auth.PermUsersRead, auth.PermUsersWrite),
 	)
 	mux.Handle("/api/v1/users", protectedUsers)
 
 	protectedUsersOverview := middleware.Chain(
 		http.HandlerFunc(usersHandler.UsersOverview),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermUsersRead),
 	)
 	mux.Handle("/api/v1/users/overview", protectedUsersOverview)
 
+	protectedUsersImport := middleware.Chain(
+		http.HandlerFunc(usersHandler.UsersImport),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermUsersWrite),
+	)
+	mux.Handle("/api/v1/users/import", protectedUsersImport)
+
 	protectedUserSubroutes := middleware.Chain(
 		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			path := strings.Trim(r.URL.Path, "/")
@@ -247,6 +378,22 @@ func New(cfg *config.Config, client *ent.Client, db *sql.DB) *http.Server {
 				return
 			}
 
+			// /api/v1/users/{id}/shift-assignments/{assignment_id}
+			if len(parts) == 6 &&
+				parts[0] == "api" &&
+				parts[1] == "v1" &&
+				parts[2] == "users" &&
+				parts[4] == "shift-assignments" {
+				userID := parseID(parts[3])
+				assignmentID := parseID(parts[5])
+				if userID <= 0 || assignmentID <= 0 {
+					http.Error(w, "Not Found", http.StatusNotFound)
+					return
+				}
+				userShiftAssignmentHandler.AssignmentByID(w, r, userID, assignmentID)
+				return
+			}
+
 			// /api/v1/users/{id}/day-overrides
 			if len(parts) == 5 &&
 				parts[0] == "api" &&
@@ -321,6 +468,95 @@ func New(cfg *config.Config, client *ent.Client, db *sql.DB) *http.Server {
 				return
 			}
 
+			// /api/v1/users/{id}/punches
+			if len(parts) == 5 &&
+				parts[0] == "api" &&
+				parts[1] == "v1" &&
+				parts[2] == "users" &&
+				parts[4] == "punches" {
+				userID := parseID(parts[3])
+				if userID <= 0 {
+					http.Error(w, "Not Found", http.StatusNotFound)
+					return
+				}
+				attendanceHandler.UserPunches(w, r, userID)
+				return
+			}
+
+			// /api/v1/users/{id}/vacation
+			// /api/v1/users/{id}/vacation/ledger
+			// /api/v1/users/{id}/vacation/adjustments
+			if (len(parts) == 5 || len(parts) == 6) &&
+				parts[0] == "api" &&
+				parts[1] == "v1" &&
+				parts[2] == "users" &&
+				parts[4] == "vacation" {
+				userID := parseID(parts[3])
+				if userID <= 0 {
+					http.Error(w, "Not Found", http.StatusNotFound)
+					return
+				}
+				if len(parts) == 5 {
+					vacationHandler.VacationBalance(w, r, userID)
+					return
+				}
+				switch parts[5] {
+				case "ledger":
+					vacationHandler.VacationLedger(w, r, userID)
+				case "adjustments":
+					vacationHandler.VacationAdjustments(w, r, userID)
+				default:
+					http.Error(w, "Not Found", http.StatusNotFound)
+				}
+				return
+			}
+
+			// /api/v1/users/{id}/hour-bank
+			// /api/v1/users/{id}/hour-bank/movements
+			// /api/v1/users/{id}/hour-bank/movements/{movementId}
+			if len(parts) >= 5 && len(parts) <= 7 &&
+				parts[0] == "api" &&
+				parts[1] == "v1" &&
+				parts[2] == "users" &&
+				parts[4] == "hour-bank" {
+				userID := parseID(parts[3])
+				if userID <= 0 {
+					http.Error(w, "Not Found", http.StatusNotFound)
+					return
+				}
+				switch {
+				case len(parts) == 5:
+					hourBankHandler.HourBankLedger(w, r, userID)
+				case parts[5] != "movements":
+					http.Error(w, "Not Found", http.StatusNotFound)
+				case len(parts) == 6:
+					hourBankHandler.HourBankMovements(w, r, userID)
+				default:
+					movementID := parseID(parts[6])
+					if movementID <= 0 {
+						http.Error(w, "Not Found", http.StatusNotFound)
+						return
+					}
+					hourBankHandler.HourBankMovementByID(w, r, userID, movementID)
+				}
+				return
+			}
+
+			// /api/v1/users/{id}/calendar
+			if len(parts) == 5 &&
+				parts[0] == "api" &&
+				parts[1] == "v1" &&
+				parts[2] == "users" &&
+				parts[4] == "calendar" {
+				userID := parseID(parts[3])
+				if userID <= 0 {
+					http.Error(w, "Not Found", http.StatusNotFound)
+					return
+				}
+				shiftHandler.UserCalendar(w, r, userID)
+				return
+			}
+
 			// /api/v1/users/{id}
 			if len(parts) == 4 &&
 				parts[0] == "api" &&
@@ -337,6 +573,8 @@ func New(cfg *config.Config, client *ent.Client, db *sql.DB) *http.Server {
 			http.NotFound(w, r)
 		}),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermSelf),
 	)
 	mux.Handle("/api/v1/users/", protectedUserSubroutes)
 
@@ -346,12 +584,16 @@ func New(cfg *config.Config, client *ent.Client, db *sql.DB) *http.Server {
 	protectedShifts := middleware.Chain(
 		http.HandlerFunc(shiftHandler.Shifts),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermissionByMethod(auth.PermShiftsRead, auth.PermShiftsManage),
 	)
 	mux.Handle("/api/v1/shifts", protectedShifts)
 
 	protectedCalendar := middleware.Chain(
 		http.HandlerFunc(shiftHandler.Calendar),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermSelf),
 	)
 	mux.Handle("/api/v1/calendar", protectedCalendar)
 	mux.Handle("/api/v1/calendar/", protectedCalendar)
@@ -376,6 +618,21 @@ func New(cfg *config.Config, client *ent.Client, db *sql.DB) *http.Server {
 				return
 			}
 
+			// /api/v1/shifts/{id}/cycle-days
+			if len(parts) == 5 &&
+				parts[0] == "api" &&
+				parts[1] == "v1" &&
+				parts[2] == "shifts" &&
+				parts[4] == "cycle-days" {
+				shiftID := parseID(parts[3])
+				if shiftID <= 0 {
+					http.Error(w, "Not Found", http.StatusNotFound)
+					return
+				}
+				shiftDayHandler.ShiftCycleDays(w, r, shiftID)
+				return
+			}
+
 			// /api/v1/shifts/{id}
 			if len(parts) == 4 &&
 				parts[0] == "api" &&
@@ -392,21 +649,176 @@ func New(cfg *config.Config, client *ent.Client, db *sql.DB) *http.Server {
 			http.NotFound(w, r)
 		}),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermissionByMethod(auth.PermShiftsRead, auth.PermShiftsManage),
 	)
 	mux.Handle("/api/v1/shifts/", protectedShiftSubroutes)
 
+	// =========================
+	// Protected routes (HOLIDAYS)
+	// =========================
+	protectedHolidays := middleware.Chain(
+		http.HandlerFunc(holidayHandler.Holidays),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermissionByMethod(auth.PermSelf, auth.PermHolidaysManage),
+	)
+	mux.Handle("/api/v1/holidays", protectedHolidays)
+
+	protectedHolidayImport := middleware.Chain(
+		http.HandlerFunc(holidayHandler.Import),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermHolidaysManage),
+	)
+	mux.Handle("/api/v1/holidays/import", protectedHolidayImport)
+
+	protectedHolidayByID := middleware.Chain(
+		http.HandlerFunc(holidayHandler.HolidayByID),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermissionByMethod(auth.PermSelf, auth.PermHolidaysManage),
+	)
+	mux.Handle("/api/v1/holidays/", protectedHolidayByID)
+
+	// =========================
+	// Protected routes (LEAVE REQUESTS)
+	// =========================
+	protectedLeaveRequests := middleware.Chain(
+		http.HandlerFunc(leaveRequestHandler.LeaveRequests),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermSelf),
+	)
+	mux.Handle("/api/v1/leave-requests", protectedLeaveRequests)
+
+	protectedLeaveRequestSubroutes := middleware.Chain(
+		http.HandlerFunc(leaveRequestHandler.LeaveRequestSubroutes),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermSelf),
+	)
+	mux.Handle("/api/v1/leave-requests/", protectedLeaveRequestSubroutes)
+
+	// =========================
+	// Protected routes (OVERTIME)
+	// =========================
+	protectedOvertimeAuthorizations := middleware.Chain(
+		http.HandlerFunc(overtimeHandler.OvertimeAuthorizations),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermSelf),
+	)
+	mux.Handle("/api/v1/overtime-authorizations", protectedOvertimeAuthorizations)
+
+	protectedOvertimeAuthorizationSubroutes := middleware.Chain(
+		http.HandlerFunc(overtimeHandler.OvertimeAuthorizationSubroutes),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermSelf),
+	)
+	mux.Handle("/api/v1/overtime-authorizations/", protectedOvertimeAuthorizationSubroutes)
+
+	protectedOvertimePending := middleware.Chain(
+		http.HandlerFunc(overtimeHandler.OvertimePending),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermOvertimeReview),
+	)
+	mux.Handle("/api/v1/overtime/pending", protectedOvertimePending)
+
+	// =========================
+	// Protected routes (BULK SCHEDULES)
+	// =========================
+	protectedBulkShiftAssignments := middleware.Chain(
+		http.HandlerFunc(userShiftAssignmentHandler.BulkAssign),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermSchedulesManage),
+	)
+	mux.Handle("/api/v1/shift-assignments/bulk", protectedBulkShiftAssignments)
+
+	protectedBulkDayOverrides := middleware.Chain(
+		http.HandlerFunc(userDayOverrideHandler.BulkCreate),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermSchedulesManage),
+	)
+	mux.Handle("/api/v1/day-overrides/bulk", protectedBulkDayOverrides)
+
+	// =========================
+	// Protected routes (REPORTS)
+	// =========================
+	protectedDTReport := middleware.Chain(
+		http.HandlerFunc(dtReportHandler.DTReport),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermReportsExport),
+	)
+	mux.Handle("/api/v1/reports/dt", protectedDTReport)
+
+	// =========================
+	// Protected routes (PAYROLL)
+	// =========================
+	protectedPayrollProfiles := middleware.Chain(
+		http.HandlerFunc(payrollHandler.PayrollProfiles),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermissionByMethod(auth.PermReportsExport, auth.PermPayrollManage),
+	)
+	mux.Handle("/api/v1/payroll/profiles", protectedPayrollProfiles)
+
+	protectedPayrollProfileSubroutes := middleware.Chain(
+		http.HandlerFunc(payrollHandler.PayrollProfileSubroutes),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermissionByMethod(auth.PermReportsExport, auth.PermPayrollManage),
+	)
+	mux.Handle("/api/v1/payroll/profiles/", protectedPayrollProfileSubroutes)
+
+	protectedPayrollFields := middleware.Chain(
+		http.HandlerFunc(payrollHandler.PayrollFields),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermReportsExport),
+	)
+	mux.Handle("/api/v1/payroll/fields", protectedPayrollFields)
+
+	// =========================
+	// Protected routes (MARK CORRECTIONS - revisión)
+	// =========================
+	protectedMarkCorrections := middleware.Chain(
+		http.HandlerFunc(markCorrectionHandler.MarkCorrections),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermMarkingsEdit),
+	)
+	mux.Handle("/api/v1/mark-corrections", protectedMarkCorrections)
+
+	protectedMarkCorrectionSubroutes := middleware.Chain(
+		http.HandlerFunc(markCorrectionHandler.MarkCorrectionSubroutes),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermMarkingsEdit),
+	)
+	mux.Handle("/api/v1/mark-corrections/", protectedMarkCorrectionSubroutes)
+
 	// =========================
 	// Protected routes (ADDRESSES)
 	// =========================
 	protectedAddresses := middleware.Chain(
 		http.HandlerFunc(addressHandler.Addresses),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermSelf),
 	)
 	mux.Handle("/api/v1/addresses", protectedAddresses)
 
 	protectedAddressByID := middleware.Chain(
 		http.HandlerFunc(addressHandler.AddressByID),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermSelf),
 	)
 	mux.Handle("/api/v1/addresses/", protectedAddressByID)
 
@@ -416,6 +828,8 @@ func New(cfg *config.Config, client *ent.Client, db *sql.DB) *http.Server {
 	protectedBranches := middleware.Chain(
 		http.HandlerFunc(branchHandler.Branches),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermissionByMethod(auth.PermBranchesRead, auth.PermBranchesManage),
 	)
 	mux.Handle("/api/v1/branches", protectedBranches)
 
@@ -438,6 +852,21 @@ func New(cfg *config.Config, client *ent.Client, db *sql.DB) *http.Server {
 				return
 			}
 
+			// /api/v1/branches/{id}/calendar
+			if len(parts) == 5 &&
+				parts[0] == "api" &&
+				parts[1] == "v1" &&
+				parts[2] == "branches" &&
+				parts[4] == "calendar" {
+				branchID := parseID(parts[3])
+				if branchID <= 0 {
+					http.Error(w, "Not Found", http.StatusNotFound)
+					return
+				}
+				shiftHandler.BranchCalendar(w, r, branchID)
+				return
+			}
+
 			// /api/v1/branches/{id}
 			if len(parts) == 4 &&
 				parts[0] == "api" &&
@@ -454,6 +883,8 @@ func New(cfg *config.Config, client *ent.Client, db *sql.DB) *http.Server {
 			http.NotFound(w, r)
 		}),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermissionByMethod(auth.PermBranchesRead, auth.PermBranchesManage),
 	)
 	mux.Handle("/api/v1/branches/", protectedBranchSubroutes)
 
@@ -495,6 +926,8 @@ func New(cfg *config.Config, client *ent.Client, db *sql.DB) *http.Server {
 			http.NotFound(w, r)
 		}),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermBranchesRead),
 	)
 	mux.Handle("/api/v1/access-points/", protectedAccessPointSubroutes)
 
@@ -504,6 +937,8 @@ func New(cfg *config.Config, client *ent.Client, db *sql.DB) *http.Server {
 	protectedDeviceByID := middleware.Chain(
 		http.HandlerFunc(deviceHandler.DeviceByID),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermDevicesManage),
 	)
 	mux.Handle("/api/v1/devices/", protectedDeviceByID)
 
@@ -513,42 +948,64 @@ func New(cfg *config.Config, client *ent.Client, db *sql.DB) *http.Server {
 	protectedDashboardStats := middleware.Chain(
 		http.HandlerFunc(dashboardHandler.Stats),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermDashboardRead),
 	)
 	mux.Handle("/api/v1/dashboard/stats", protectedDashboardStats)
 
 	protectedDashboardLive := middleware.Chain(
 		http.HandlerFunc(dashboardHandler.Live),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermDashboardRead),
 	)
 	mux.Handle("/api/v1/dashboard/live", protectedDashboardLive)
 
 	protectedDashboardPunctuality := middleware.Chain(
 		http.HandlerFunc(dashboardHandler.Punctuality),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermDashboardRead),
 	)
 	mux.Handle("/api/v1/dashboard/punctuality", protectedDashboardPunctuality)
 
 	protectedDashboardExport := middleware.Chain(
 		http.HandlerFunc(dashboardHandler.Export),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermReportsExport),
 	)
 	mux.Handle("/api/v1/dashboard/export", protectedDashboardExport)
 
 	protectedMarkings := middleware.Chain(
 		http.HandlerFunc(markingsHandler.List),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermMarkingsRead),
 	)
 	mux.Handle("/api/v1/markings", protectedMarkings)
 
 	protectedMarkingsFilters := middleware.Chain(
 		http.HandlerFunc(markingsHandler.Filters),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermMarkingsRead),
 	)
 	mux.Handle("/api/v1/markings/filters", protectedMarkingsFilters)
 
+	protectedMarkingsExport := middleware.Chain(
+		http.HandlerFunc(markingsHandler.Export),
+		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermReportsExport),
+	)
+	mux.Handle("/api/v1/markings/export", protectedMarkingsExport)
+
 	protectedMarkingsTopBranches := middleware.Chain(
 		http.HandlerFunc(markingsHandler.TopBranches),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermission(auth.PermMarkingsRead),
 	)
 	mux.Handle("/api/v1/markings/top-branches", protectedMarkingsTopBranches)
 
@@ -571,9 +1028,26 @@ func New(cfg *config.Config, client *ent.Client, db *sql.DB) *http.Server {
 				return
 			}
 
+			// /api/v1/markings/{id}/history
+			if len(parts) == 5 &&
+				parts[0] == "api" &&
+				parts[1] == "v1" &&
+				parts[2] == "markings" &&
+				parts[4] == "history" {
+				markingID := parseID(parts[3])
+				if markingID <= 0 {
+					http.Error(w, "Not Found", http.StatusNotFound)
+					return
+				}
+				markingsHandler.History(w, r, markingID)
+				return
+			}
+
 			http.NotFound(w, r)
 		}),
 		middleware.JWT(cfg),
+		branchScope,
+		middleware.RequirePermissionByMethod(auth.PermMarkingsRead, auth.PermMarkingsEdit),
 	)
 	mux.Handle("/api/v1/markings/", protectedMarkingsSubroutes)
 
@@ -0,0 +1,228 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"back/internal/ent"
+	"back/internal/ent/userbranch"
+
+	"github.com/lib/pq"
+)
+
+// Roles por sucursal (UserBranch.role_in_branch). Sin rol = colaborador: solo ve lo propio.
+const (
+	BranchRoleSupervisor = "supervisor" // ve y gestiona (corrige marcas, etc.) la sucursal
+	BranchRoleViewer     = "viewer"     // solo lectura de la sucursal
+)
+
+// ErrOutOfScope: el recurso pertenece a una sucursal fuera del alcance de quien consulta.
+var ErrOutOfScope = errors.New("resource outside caller branch scope")
+
+// AccessScope describe qué sucursales puede ver/gestionar el usuario autenticado.
+// Se resuelve una vez por request (ver AccessService.ScopeContext) y los servicios lo
+// leen desde el contexto para filtrar sus consultas.
+type AccessScope struct {
+	UserID int
+	// All: sin restricción (admin)
+	All bool
+	// branch_id -> rol en la sucursal (supervisor | viewer)
+	Branches map[int]string
+}
+
+type ctxKeyAccessScope struct{}
+
+// WithAccessScope deja el alcance en el contexto.
+func WithAccessScope(ctx context.Context, scope *AccessScope) context.Context {
+	return context.WithValue(ctx, ctxKeyAccessScope{}, scope)
+}
+
+// AccessScopeFrom devuelve el alcance del contexto. nil significa sin restricción
+// (procesos internos, dispositivos, jobs).
+func AccessScopeFrom(ctx context.Context) *AccessScope {
+	scope, _ := ctx.Value(ctxKeyAccessScope{}).(*AccessScope)
+	return scope
+}
+
+// Restricted indica si hay que filtrar por sucursal.
+func (s *AccessScope) Restricted() bool {
+	return s != nil && !s.All
+}
+
+// CanView: la sucursal es visible (supervisor o viewer).
+func (s *AccessScope) CanView(branchID int) bool {
+	if !s.Restricted() {
+		return true
+	}
+	_, ok := s.Branches[branchID]
+	return ok
+}
+
+// CanManage: puede modificar datos de la sucursal (solo supervisor).
+func (s *AccessScope) CanManage(branchID int) bool {
+	if !s.Restricted() {
+		return true
+	}
+	return s.Branches[branchID] == BranchRoleSupervisor
+}
+
+// BranchIDs devuelve las sucursales visibles ordenadas.
+func (s *AccessScope) BranchIDs() []int {
+	if s == nil {
+		return nil
+	}
+	ids := make([]int, 0, len(s.Branches))
+	for id := range s.Branches {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// ManagedBranchIDs devuelve las sucursales donde es supervisor, ordenadas.
+func (s *AccessScope) ManagedBranchIDs() []int {
+	if s == nil {
+		return nil
+	}
+	ids := make([]int, 0, len(s.Branches))
+	for id, role := range s.Branches {
+		if role == BranchRoleSupervisor {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// checkUserScope devuelve ErrOutOfScope si el usuario no tiene una sucursal activa
+// visible (o gestionable, con manage) para quien consulta. Ver datos propios siempre
+// está permitido; gestionarlos no (nadie se aprueba ni reasigna a sí mismo por fuera
+// de las sucursales que supervisa).
+func checkUserScope(ctx context.Context, client *ent.Client, userID int, manage bool) error {
+	scope := AccessScopeFrom(ctx)
+	if !scope.Restricted() {
+		return nil
+	}
+	if !manage && userID == scope.UserID {
+		return nil
+	}
+
+	branchIDs := scope.BranchIDs()
+	if manage {
+		branchIDs = scope.ManagedBranchIDs()
+	}
+	if len(branchIDs) == 0 {
+		return ErrOutOfScope
+	}
+
+	ok, err := client.UserBranch.Query().
+		Where(
+			userbranch.UserIDEQ(userID),
+			userbranch.IsActiveEQ(true),
+			userbranch.BranchIDIn(branchIDs...),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrOutOfScope
+	}
+	return nil
+}
+
+// key identifica el alcance (para claves de caché).
+func (s *AccessScope) key() string {
+	if !s.Restricted() {
+		return "all"
+	}
+	return fmt.Sprint(s.BranchIDs())
+}
+
+// sqlFilter agrega el filtro de alcance a una consulta SQL: la columna de sucursal debe
+// estar entre las visibles y, si se indica userColumn, también se permiten las filas
+// propias del usuario.
+func (s *AccessScope) sqlFilter(branchColumn, userColumn string, args *[]any) string {
+	if !s.Restricted() {
+		return ""
+	}
+	ids := make([]int64, 0, len(s.Branches))
+	for _, id := range s.BranchIDs() {
+		ids = append(ids, int64(id))
+	}
+	*args = append(*args, pq.Array(ids))
+	cond := fmt.Sprintf("%s = ANY($%d)", branchColumn, len(*args))
+	if userColumn != "" {
+		*args = append(*args, s.UserID)
+		cond = fmt.Sprintf("(%s OR %s = $%d)", cond, userColumn, len(*args))
+	}
+	return " AND " + cond
+}
+
+// NormalizeBranchRole valida el rol por sucursal ("" = sin rol).
+func NormalizeBranchRole(role string) (string, bool) {
+	switch r := strings.ToLower(strings.TrimSpace(role)); r {
+	case "", BranchRoleSupervisor, BranchRoleViewer:
+		return r, true
+	}
+	return "", false
+}
+
+type AccessService struct {
+	Client *ent.Client
+}
+
+func NewAccessService(client *ent.Client) *AccessService {
+	return &AccessService{Client: client}
+}
+
+// ResolveScope arma el alcance del usuario a partir de sus sucursales activas:
+//   - con el permiso branches:all (allBranches): todas las sucursales
+//   - role_in_branch supervisor / viewer: esa sucursal con ese rol
+//   - supervisor global sin rol explícito en la sucursal: supervisor de sus sucursales
+//   - resto: ninguna sucursal (solo sus propios datos)
+func (s *AccessService) ResolveScope(ctx context.Context, userID int, role string, allBranches bool) (*AccessScope, error) {
+	role = strings.ToLower(role)
+	scope := &AccessScope{UserID: userID, Branches: map[int]string{}}
+	if allBranches {
+		scope.All = true
+		return scope, nil
+	}
+
+	ubs, err := s.Client.UserBranch.Query().
+		Where(userbranch.UserIDEQ(userID), userbranch.IsActiveEQ(true)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ub := range ubs {
+		branchRole := ""
+		if ub.RoleInBranch != nil {
+			branchRole, _ = NormalizeBranchRole(*ub.RoleInBranch)
+		}
+		if branchRole == "" && role == "supervisor" {
+			branchRole = BranchRoleSupervisor
+		}
+		if branchRole != "" {
+			scope.Branches[ub.BranchID] = branchRole
+		}
+	}
+	return scope, nil
+}
+
+// ScopeContext resuelve el alcance del usuario autenticado y lo deja en el contexto.
+// Los dispositivos no tienen alcance por sucursal.
+func (s *AccessService) ScopeContext(ctx context.Context, userID int, role string, allBranches bool) (context.Context, error) {
+	if strings.ToLower(role) == "device" {
+		return ctx, nil
+	}
+	scope, err := s.ResolveScope(ctx, userID, role, allBranches)
+	if err != nil {
+		return nil, err
+	}
+	return WithAccessScope(ctx, scope), nil
+}
@@ -1,12 +1,53 @@
 package services
 
-import "time"
+import (
+	"time"
+
+	"back/internal/ent"
+)
 
 type attendanceMetricsSchedule struct {
 	StartTime       string
 	EndTime         string
 	CrossesMidnight bool
 	BreakMinutes    int
+	Tolerances      shiftTolerances
+	// jornada no programada (feriado sin turno especial): no hay horario contra el cual
+	// medir atraso o salida anticipada y todo lo trabajado es sobretiempo
+	Unscheduled     bool
+}
+
+// shiftTolerances son las ventanas de gracia configuradas en el turno (minutos).
+type shiftTolerances struct {
+	EntryMinutes       int
+	ExitMinutes        int
+	MinOvertimeMinutes int
+}
+
+func shiftTolerancesOf(sh *ent.Shift) shiftTolerances {
+	if sh == nil {
+		return shiftTolerances{}
+	}
+	return shiftTolerances{
+		EntryMinutes:       sh.EntryToleranceMinutes,
+		ExitMinutes:        sh.ExitToleranceMinutes,
+		MinOvertimeMinutes: sh.MinOvertimeMinutes,
+	}
+}
+
+// isLate: la entrada cuenta como atraso solo si supera la tolerancia de entrada.
+func (t shiftTolerances) isLate(entryDiff int) bool {
+	return entryDiff > t.EntryMinutes
+}
+
+// isEarlyExit: la salida anticipada cuenta solo si supera la tolerancia de salida.
+func (t shiftTolerances) isEarlyExit(exitDiff int) bool {
+	return exitDiff < 0 && -exitDiff > t.ExitMinutes
+}
+
+// isOvertime: el sobretiempo se reconoce desde el mínimo configurado.
+func (t shiftTolerances) isOvertime(exitDiff int) bool {
+	return exitDiff > 0 && exitDiff >= t.MinOvertimeMinutes
 }
 
 type attendanceMetrics struct {
@@ -20,12 +61,28 @@ type attendanceMetrics struct {
 func computeAttendanceMetrics(workDate time.Time, schedule attendanceMetricsSchedule, workIn, breakOut, breakIn, workOut *time.Time) attendanceMetrics {
 	metrics := attendanceMetrics{}
 
+	if schedule.Unscheduled {
+		if workIn != nil && workOut != nil {
+			worked := int(workOut.Sub(*workIn).Minutes())
+			if breakOut != nil && breakIn != nil {
+				worked -= int(breakIn.Sub(*breakOut).Minutes())
+			}
+			if worked < 0 {
+				worked = 0
+			}
+			metrics.OvertimeMinutes = intPtr(worked)
+			metrics.NetMinutes = intPtr(worked)
+		}
+		return metrics
+	}
+
 	startT, startErr := toShiftBoundary(workDate, schedule.StartTime, false)
 	endT, endErr := toShiftBoundary(workDate, schedule.EndTime, schedule.CrossesMidnight)
 
 	if startErr == nil && workIn != nil {
+		// dentro de la tolerancia no hay atraso; sobre ella se cuenta el atraso completo
 		late := int(workIn.Sub(startT).Minutes())
-		if late < 0 {
+		if !schedule.Tolerances.isLate(late) {
 			late = 0
 		}
 		metrics.LateMinutes = intPtr(late)
@@ -38,15 +95,12 @@ func computeAttendanceMetrics(workDate time.Time, schedule attendanceMetricsSche
 
 	if endErr == nil && workOut != nil {
 		diff := int(workOut.Sub(endT).Minutes())
-		if diff > 0 {
+		metrics.OvertimeMinutes = intPtr(0)
+		metrics.EarlyExitMinutes = intPtr(0)
+		if schedule.Tolerances.isOvertime(diff) {
 			metrics.OvertimeMinutes = intPtr(diff)
-			metrics.EarlyExitMinutes = intPtr(0)
-		} else if diff < 0 {
-			metrics.OvertimeMinutes = intPtr(0)
+		} else if schedule.Tolerances.isEarlyExit(diff) {
 			metrics.EarlyExitMinutes = intPtr(-diff)
-		} else {
-			metrics.OvertimeMinutes = intPtr(0)
-			metrics.EarlyExitMinutes = intPtr(0)
 		}
 	}
 
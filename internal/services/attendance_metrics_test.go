@@ -0,0 +1,56 @@
+package services
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestComputeAttendanceMetricsUnscheduled(t *testing.T) {
+	// feriado trabajado: el turno 08:00-17:00 no se usa para medir atraso ni salida
+	schedule := attendanceMetricsSchedule{
+		StartTime:    "08:00",
+		EndTime:      "17:00",
+		BreakMinutes: 60,
+		Unscheduled:  true,
+	}
+
+	tests := []struct {
+		name  string
+		slots string // entrada, salida colación, regreso colación, salida ("-" = sin marca)
+		want  int    // sobretiempo y balance neto; -1 = sin métricas
+	}{
+		{"jornada con colación", "10:00 13:00 13:30 15:00", 270},
+		{"jornada sin colación", "09:15 - - 12:15", 180},
+		{"colación sin regreso no se descuenta", "09:00 13:00 - 14:00", 300},
+		{"sin salida no hay sobretiempo", "09:00 - - -", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s [4]*time.Time
+			for i, v := range strings.Fields(tt.slots) {
+				if v != "-" {
+					s[i] = at(v)
+				}
+			}
+			m := computeAttendanceMetrics(punchDay, schedule, s[0], s[1], s[2], s[3])
+
+			if m.LateMinutes != nil || m.EarlyExitMinutes != nil || m.BreakDiffMinutes != nil {
+				t.Errorf("jornada no programada con atraso, salida anticipada o colación: %+v", m)
+			}
+			if tt.want < 0 {
+				if m.OvertimeMinutes != nil || m.NetMinutes != nil {
+					t.Errorf("métricas = %+v, want sin sobretiempo", m)
+				}
+				return
+			}
+			if m.OvertimeMinutes == nil || *m.OvertimeMinutes != tt.want {
+				t.Errorf("OvertimeMinutes = %v, want %d", m.OvertimeMinutes, tt.want)
+			}
+			if m.NetMinutes == nil || *m.NetMinutes != tt.want {
+				t.Errorf("NetMinutes = %v, want %d", m.NetMinutes, tt.want)
+			}
+		})
+	}
+}
@@ -2,14 +2,17 @@ package services
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"back/internal/ent"
-	"back/internal/ent/accesspoint"
 	"back/internal/ent/attendanceday"
-	"back/internal/ent/shiftday"
+	"back/internal/ent/attendanceedit"
+	"back/internal/ent/device"
+	"back/internal/ent/punch"
 	"back/internal/ent/user"
 	"back/internal/ent/userdayoverride"
 	"back/internal/ent/usershiftassignment"
@@ -20,41 +23,131 @@ var (
 	ErrAttendanceAlreadyCompleted = errors.New("attendance already fully recorded")
 	ErrAttendanceNotWorkDay       = errors.New("today is not a working day for this user's schedule")
 	ErrAttendanceNoShiftAssigned  = errors.New("user has no active shift assigned")
+	ErrAttendanceDeviceNotAllowed = errors.New("device is inactive or not allowed to record attendance")
+	ErrAttendanceWrongDirection   = errors.New("wrong reader direction for the next mark")
 )
 
+// AttendanceDirectionError se devuelve cuando se marca en un lector cuyo sentido
+// no corresponde a la siguiente marcación esperada (ej: salida en un lector de entrada).
+// errors.Is(err, ErrAttendanceWrongDirection) es true.
+type AttendanceDirectionError struct {
+	DeviceDirection   string // sentido del lector usado: "in" | "out"
+	ExpectedDirection string // sentido requerido por la siguiente marcación
+	ExpectedSlot      string // work_in | break_out | break_in | work_out
+}
+
+func (e *AttendanceDirectionError) Error() string {
+	return fmt.Sprintf("wrong reader: next mark is %s and requires an %q reader, got %q",
+		e.ExpectedSlot, e.ExpectedDirection, e.DeviceDirection)
+}
+
+func (e *AttendanceDirectionError) Is(target error) bool {
+	return target == ErrAttendanceWrongDirection
+}
+
+const (
+	PunchMethodQR         = "qr"
+	PunchMethodAccessCode = "access_code"
+	PunchMethodManual     = "manual"
+)
+
+// Motivos de rechazo con que se guarda una marcación que no se aplicó a un día.
+const (
+	PunchRejectedWrongDirection   = "wrong_direction"
+	PunchRejectedAlreadyCompleted = "already_completed"
+	PunchRejectedNotWorkDay       = "not_work_day"
+	PunchRejectedNoShift          = "no_shift"
+)
+
+// punchRejections asocia cada motivo de rechazo con el error que recibe el dispositivo.
+var punchRejections = map[string]error{
+	PunchRejectedWrongDirection:   ErrAttendanceWrongDirection,
+	PunchRejectedAlreadyCompleted: ErrAttendanceAlreadyCompleted,
+	PunchRejectedNotWorkDay:       ErrAttendanceNotWorkDay,
+	PunchRejectedNoShift:          ErrAttendanceNoShiftAssigned,
+}
+
 type AttendanceService struct {
 	Client *ent.Client
 	QR     *QRSessionService
+
+	// antigüedad máxima de una marcación offline sincronizada
+	MaxSyncBackfill time.Duration
 }
 
-func NewAttendanceService(client *ent.Client, qr *QRSessionService) *AttendanceService {
-	return &AttendanceService{Client: client, QR: qr}
+func NewAttendanceService(client *ent.Client, qr *QRSessionService, maxSyncBackfillDays int) *AttendanceService {
+	return &AttendanceService{
+		Client:          client,
+		QR:              qr,
+		MaxSyncBackfill: time.Duration(maxSyncBackfillDays) * 24 * time.Hour,
+	}
 }
 
-func (s *AttendanceService) ValidateAndRecordAttendance(ctx context.Context, tokenPlain string, accessPointID int) (*ent.AttendanceDay, error) {
-	if tokenPlain == "" || accessPointID <= 0 {
+// AttendanceDevice identifica al dispositivo que envía la marcación.
+// Se construye desde los claims del JWT del dispositivo.
+type AttendanceDevice struct {
+	DeviceID      int
+	AccessPointID int
+	Direction     string
+}
+
+// punchInput agrupa los datos de una marcación física a registrar
+type punchInput struct {
+	UserID        int
+	BranchID      int
+	AccessPointID int
+	DeviceID      *int
+	Direction     string // sentido del lector: "in" | "out" | "both"
+	Method        string
+	RawPayload    *string
+	PunchedAt     time.Time
+
+	// ClientID y Offline se usan para marcaciones sincronizadas desde el dispositivo
+	ClientID *string
+	Offline  bool
+}
+
+// attendanceSlots son las cuatro marcaciones derivadas de un día
+type attendanceSlots struct {
+	WorkIn   *time.Time
+	BreakOut *time.Time
+	BreakIn  *time.Time
+	WorkOut  *time.Time
+}
+
+// attendancePins son los slots fijados por ediciones manuales (supervisor o solicitud
+// de corrección aprobada). Al derivar el día desde los punches esos slots conservan el
+// valor editado (nil fijado = slot borrado a propósito) y las marcaciones nuevas solo
+// completan los demás.
+type attendancePins struct {
+	WorkIn   bool
+	BreakOut bool
+	BreakIn  bool
+	WorkOut  bool
+	Values   attendanceSlots
+}
+
+func (s *AttendanceService) ValidateAndRecordAttendance(ctx context.Context, tokenPlain string, dev AttendanceDevice) (*ent.AttendanceDay, error) {
+	if tokenPlain == "" {
 		return nil, ErrAttendanceInvalidInput
 	}
 
-	_, user, err := s.QR.ValidateAndGetQRSession(ctx, tokenPlain)
+	accessPoint, err := s.resolveDeviceAccessPoint(ctx, dev)
 	if err != nil {
 		return nil, err
 	}
-	if user == nil {
-		return nil, ErrAttendanceInvalidInput
-	}
 
-	accessPoint, err := s.Client.AccessPoint.Query().Where(accesspoint.IDEQ(accessPointID)).Only(ctx)
+	_, user, err := s.QR.ValidateAndGetQRSession(ctx, tokenPlain)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, ErrAttendanceInvalidInput
-		}
 		return nil, err
 	}
+	if user == nil {
+		return nil, ErrAttendanceInvalidInput
+	}
 
 	// Nota: Removiendo validación de asignación a sucursal para permitir
 	// que cualquier usuario con QR válido marque asistencia en cualquier access point.
-	// El QR ya valida el usuario, y el access_point_id viene del dispositivo logueado.
+	// El QR ya valida el usuario, y el access_point_id viene del token del dispositivo.
 	// assignedToBranch, err := s.Client.UserBranch.Query().
 	//     Where(userbranch.UserIDEQ(user.ID)).
 	//     Where(userbranch.BranchIDEQ(accessPoint.BranchID)).
@@ -66,34 +159,31 @@ func (s *AttendanceService) ValidateAndRecordAttendance(ctx context.Context, tok
 	//     return nil, ErrAttendanceUnauthorizedAccessPoint
 	// }
 
-	now := time.Now()
-	shift, workDate, err := s.resolveShiftAndWorkDate(ctx, user.ID, now)
-	if err != nil {
-		return nil, err
-	}
-
-	attendance, err := s.Client.AttendanceDay.Query().
-		Where(attendanceday.UserIDEQ(user.ID)).
-		Where(attendanceday.BranchIDEQ(accessPoint.BranchID)).
-		Where(attendanceday.WorkDateEQ(workDate)).
-		Only(ctx)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return s.createAttendance(ctx, shift, user.ID, accessPoint.BranchID, accessPointID, workDate, now)
-		}
-		return nil, err
-	}
-
-	return s.updateAttendance(ctx, shift, attendance, accessPointID, now)
+	tokenHash := HashQRToken(tokenPlain)
+	return s.recordPunch(ctx, punchInput{
+		UserID:        user.ID,
+		BranchID:      accessPoint.BranchID,
+		AccessPointID: accessPoint.ID,
+		DeviceID:      &dev.DeviceID,
+		Direction:     dev.Direction,
+		Method:        PunchMethodQR,
+		RawPayload:    &tokenHash,
+		PunchedAt:     time.Now(),
+	})
 }
 
 // ValidateAndRecordAttendanceByAccessCode valida un código de acceso y registra la asistencia
 // Funciona igual que ValidateAndRecordAttendance pero usando access_code en lugar de QR
-func (s *AttendanceService) ValidateAndRecordAttendanceByAccessCode(ctx context.Context, accessCode string, accessPointID int) (*ent.AttendanceDay, error) {
-	if accessCode == "" || accessPointID <= 0 {
+func (s *AttendanceService) ValidateAndRecordAttendanceByAccessCode(ctx context.Context, accessCode string, dev AttendanceDevice) (*ent.AttendanceDay, error) {
+	if accessCode == "" {
 		return nil, ErrAttendanceInvalidInput
 	}
 
+	accessPoint, err := s.resolveDeviceAccessPoint(ctx, dev)
+	if err != nil {
+		return nil, err
+	}
+
 	// Buscar el usuario por access_code
 	targetUser, err := s.Client.User.Query().
 		Where(user.AccessCodeEQ(accessCode)).
@@ -109,35 +199,78 @@ func (s *AttendanceService) ValidateAndRecordAttendanceByAccessCode(ctx context.
 		return nil, ErrAttendanceInvalidInput
 	}
 
-	// Validar que el access_point exista
-	accessPoint, err := s.Client.AccessPoint.Query().Where(accesspoint.IDEQ(accessPointID)).Only(ctx)
+	return s.recordPunch(ctx, punchInput{
+		UserID:        targetUser.ID,
+		BranchID:      accessPoint.BranchID,
+		AccessPointID: accessPoint.ID,
+		DeviceID:      &dev.DeviceID,
+		Direction:     dev.Direction,
+		Method:        PunchMethodAccessCode,
+		RawPayload:    maskAccessCode(accessCode),
+		PunchedAt:     time.Now(),
+	})
+}
+
+// maskAccessCode deja en raw_payload solo los dos últimos caracteres del código de
+// acceso: es una credencial y no se guarda en claro (un hash de un código corto se
+// revierte por fuerza bruta).
+func maskAccessCode(code string) *string {
+	runes := []rune(code)
+	visible := 2
+	if len(runes) <= visible {
+		visible = 0
+	}
+	masked := strings.Repeat("*", len(runes)-visible) + string(runes[len(runes)-visible:])
+	return &masked
+}
+
+// MaskLegacyAccessCodePayloads enmascara los códigos de acceso guardados en claro por
+// versiones anteriores. Es idempotente y se ejecuta al iniciar.
+func MaskLegacyAccessCodePayloads(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, `
+		UPDATE punches
+		SET raw_payload = CASE
+			WHEN length(raw_payload) <= 2 THEN repeat('*', length(raw_payload))
+			ELSE repeat('*', length(raw_payload) - 2) || right(raw_payload, 2)
+		END
+		WHERE method = 'access_code'
+		  AND raw_payload IS NOT NULL
+		  AND raw_payload NOT LIKE '*%'`)
+	return err
+}
+
+// resolveDeviceAccessPoint valida que el dispositivo del token siga activo y asignado
+// al mismo access point indicado en sus claims, y devuelve ese access point.
+func (s *AttendanceService) resolveDeviceAccessPoint(ctx context.Context, dev AttendanceDevice) (*ent.AccessPoint, error) {
+	if dev.DeviceID <= 0 || dev.AccessPointID <= 0 {
+		return nil, ErrAttendanceDeviceNotAllowed
+	}
+
+	d, err := s.Client.Device.Query().
+		Where(device.IDEQ(dev.DeviceID)).
+		WithAccessPoint().
+		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, ErrAttendanceInvalidInput
+			return nil, ErrAttendanceDeviceNotAllowed
 		}
 		return nil, err
 	}
 
-	now := time.Now()
-	shift, workDate, err := s.resolveShiftAndWorkDate(ctx, targetUser.ID, now)
-	if err != nil {
-		return nil, err
+	// si el dispositivo cambió de entrada o de sentido, debe volver a autenticarse
+	if !d.IsActive || d.AccessPointID != dev.AccessPointID || d.Direction != dev.Direction {
+		return nil, ErrAttendanceDeviceNotAllowed
 	}
 
-	// Obtener o crear attendance del ciclo de turno
-	attendance, err := s.Client.AttendanceDay.Query().
-		Where(attendanceday.UserIDEQ(targetUser.ID)).
-		Where(attendanceday.BranchIDEQ(accessPoint.BranchID)).
-		Where(attendanceday.WorkDateEQ(workDate)).
-		Only(ctx)
+	accessPoint, err := d.Edges.AccessPointOrErr()
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return s.createAttendance(ctx, shift, targetUser.ID, accessPoint.BranchID, accessPointID, workDate, now)
-		}
 		return nil, err
 	}
+	if !accessPoint.IsActive {
+		return nil, ErrAttendanceDeviceNotAllowed
+	}
 
-	return s.updateAttendance(ctx, shift, attendance, accessPointID, now)
+	return accessPoint, nil
 }
 
 // goWeekdayToSchema convierte time.Weekday (0=domingo) al esquema (1=lunes ... 7=domingo)
@@ -161,9 +294,11 @@ func parseShiftTime(base time.Time, hhmm string) (time.Time, error) {
 // Valida:
 //   - Si hay un UserDayOverride con is_day_off=true → error
 //   - Si hay override con turno especial → usa ese turno
-//   - Si no es un día laborable del turno (shift_days) → error
+//   - Si workDate es feriado para la sucursal (nacional, regional o de sucursal) → holiday=true:
+//     la marcación se acepta como jornada no programada, aunque no sea día laboral del turno
+//   - Si no es un día laborable del turno (shift_days o posición del ciclo rotativo) → error
 //   - Para turnos nocturnos (crosses_midnight): si marcamos en la madrugada, workDate = ayer
-func (s *AttendanceService) resolveShiftAndWorkDate(ctx context.Context, userID int, now time.Time) (*ent.Shift, time.Time, error) {
+func (s *AttendanceService) resolveShiftAndWorkDate(ctx context.Context, userID, branchID int, now time.Time) (shift *ent.Shift, workDate time.Time, holiday bool, err error) {
 	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 
 	// 1. Verificar override para hoy (día libre o turno especial)
@@ -175,17 +310,17 @@ func (s *AttendanceService) resolveShiftAndWorkDate(ctx context.Context, userID
 		WithShift().
 		Only(ctx)
 	if err != nil && !ent.IsNotFound(err) {
-		return nil, time.Time{}, err
+		return nil, time.Time{}, false, err
 	}
 
 	if override != nil && override.IsDayOff {
-		return nil, time.Time{}, ErrAttendanceNotWorkDay
+		return nil, time.Time{}, false, ErrAttendanceNotWorkDay
 	}
 
 	// Si el override tiene un turno especial para hoy, usarlo (no validar shift_days)
 	if override != nil && override.ShiftID != nil {
 		if overrideShift, shiftErr := override.Edges.ShiftOrErr(); shiftErr == nil && overrideShift != nil {
-			return overrideShift, today, nil
+			return overrideShift, today, false, nil
 		}
 	}
 
@@ -195,27 +330,33 @@ func (s *AttendanceService) resolveShiftAndWorkDate(ctx context.Context, userID
 			usershiftassignment.UserIDEQ(userID),
 			usershiftassignment.IsActiveEQ(true),
 			usershiftassignment.StartDateLTE(today),
+			usershiftassignment.Or(
+				usershiftassignment.EndDateIsNil(),
+				usershiftassignment.EndDateGTE(today),
+			),
 		).
 		WithShift().
+		// si hubiera superposición, gana la asignación con inicio más reciente
+		Order(ent.Desc(usershiftassignment.FieldStartDate), ent.Desc(usershiftassignment.FieldID)).
 		First(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, time.Time{}, ErrAttendanceNoShiftAssigned
+			return nil, time.Time{}, false, ErrAttendanceNoShiftAssigned
 		}
-		return nil, time.Time{}, err
+		return nil, time.Time{}, false, err
 	}
 	if assignment.EndDate != nil && today.After(*assignment.EndDate) {
-		return nil, time.Time{}, ErrAttendanceNoShiftAssigned
+		return nil, time.Time{}, false, ErrAttendanceNoShiftAssigned
 	}
 
-	shift, err := assignment.Edges.ShiftOrErr()
+	shift, err = assignment.Edges.ShiftOrErr()
 	if err != nil {
-		return nil, time.Time{}, err
+		return nil, time.Time{}, false, err
 	}
 
 	// 3. Calcular work_date: para turnos nocturnos, si estamos en la madrugada
 	// antes del end_time y antes del start_time → el turno empezó ayer
-	workDate := today
+	workDate = today
 	if shift.CrossesMidnight {
 		startT, err1 := parseShiftTime(now, shift.StartTime)
 		endT, err2 := parseShiftTime(now, shift.EndTime)
@@ -224,85 +365,383 @@ func (s *AttendanceService) resolveShiftAndWorkDate(ctx context.Context, userID
 		}
 	}
 
-	// 4. Validar que el día de la semana de workDate sea laborable según shift_days
-	weekday := goWeekdayToSchema(workDate.Weekday())
-	isWorkDay, err := s.Client.ShiftDay.Query().
-		Where(
-			shiftday.ShiftIDEQ(shift.ID),
-			shiftday.WeekdayEQ(weekday),
-			shiftday.IsWorkingDayEQ(true),
-		).
-		Exist(ctx)
+	// 4. Feriados: la marcación se registra igual como jornada no programada (salvo que
+	// un override asigne un turno especial, paso 1)
+	hol, err := findHolidayForBranch(ctx, s.Client, branchID, workDate)
+	if err != nil {
+		return nil, time.Time{}, false, err
+	}
+	if hol != nil {
+		return shift, workDate, true, nil
+	}
+
+	// 5. Validar que workDate sea laborable según el patrón del turno
+	// (día de semana en shift_days o posición del ciclo en shift_cycle_days)
+	isWorkDay, err := isShiftWorkingDate(ctx, s.Client, shift, workDate)
 	if err != nil {
-		return nil, time.Time{}, err
+		return nil, time.Time{}, false, err
 	}
 	if !isWorkDay {
-		return nil, time.Time{}, ErrAttendanceNotWorkDay
+		return nil, time.Time{}, false, ErrAttendanceNotWorkDay
 	}
 
-	return shift, workDate, nil
+	return shift, workDate, false, nil
 }
 
-func (s *AttendanceService) createAttendance(ctx context.Context, shift *ent.Shift, userID, branchID, accessPointID int, workDate time.Time, now time.Time) (*ent.AttendanceDay, error) {
-	create := s.Client.AttendanceDay.Create().
-		SetUserID(userID).
-		SetBranchID(branchID).
-		SetAccessPointID(accessPointID).
-		SetWorkDate(workDate).
-		SetWorkInAt(now)
+// recordPunch guarda la marcación cruda y recalcula el AttendanceDay del ciclo de turno
+// a partir de todas sus marcaciones. Ninguna marcación se descarta: si las reglas de
+// turno o de secuencia la rechazan queda guardada como rechazada (ver rejectPunch).
+func (s *AttendanceService) recordPunch(ctx context.Context, in punchInput) (*ent.AttendanceDay, error) {
+	shift, workDate, holiday, err := s.resolveShiftAndWorkDate(ctx, in.UserID, in.BranchID, in.PunchedAt)
+	if err != nil {
+		return nil, s.rejectPunch(ctx, in, err)
+	}
 
-	metrics := computeAttendanceMetrics(workDate, attendanceMetricsSchedule{
-		StartTime:       shift.StartTime,
-		EndTime:         shift.EndTime,
-		CrossesMidnight: shift.CrossesMidnight,
-		BreakMinutes:    shift.BreakMinutes,
-	}, &now, nil, nil, nil)
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
 
-	if metrics.LateMinutes != nil {
-		create.SetLateMinutes(*metrics.LateMinutes)
+	// Obtener o crear attendance del ciclo de turno
+	attendance, err := tx.AttendanceDay.Query().
+		Where(attendanceday.UserIDEQ(in.UserID)).
+		Where(attendanceday.BranchIDEQ(in.BranchID)).
+		Where(attendanceday.WorkDateEQ(workDate)).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return nil, err
+		}
+		attendance, err = tx.AttendanceDay.Create().
+			SetUserID(in.UserID).
+			SetBranchID(in.BranchID).
+			SetAccessPointID(in.AccessPointID).
+			SetWorkDate(workDate).
+			SetIsHoliday(holiday).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
 	}
-	if metrics.BreakDiffMinutes != nil {
-		create.SetBreakDiffMinutes(*metrics.BreakDiffMinutes)
+
+	// Las marcaciones offline ya fueron aceptadas por el lector: no se validan contra
+	// el estado actual del día (puede haber marcaciones posteriores ya registradas).
+	// Se guarda el sentido del lector y, si es "both", se infiere al derivar el día.
+	pins, err := loadAttendancePins(ctx, tx.Client(), attendance)
+	if err != nil {
+		return nil, err
 	}
-	if metrics.OvertimeMinutes != nil {
-		create.SetOvertimeMinutes(*metrics.OvertimeMinutes)
+
+	var direction *string
+	if in.Offline {
+		if in.Direction == "in" || in.Direction == "out" {
+			direction = &in.Direction
+		}
+	} else {
+		d, err := resolvePunchDirection(in.Direction, attendanceSlotsOf(attendance), pins)
+		if err != nil {
+			// el día recién creado no se conserva: la marcación rechazada no pertenece a él
+			_ = tx.Rollback()
+			return nil, s.rejectPunch(ctx, in, err)
+		}
+		direction = &d
+	}
+
+	_, err = tx.Punch.Create().
+		SetUserID(in.UserID).
+		SetBranchID(in.BranchID).
+		SetAccessPointID(in.AccessPointID).
+		SetNillableDeviceID(in.DeviceID).
+		SetAttendanceDayID(attendance.ID).
+		SetPunchedAt(in.PunchedAt).
+		SetMethod(in.Method).
+		SetNillableDirection(direction).
+		SetNillableRawPayload(in.RawPayload).
+		SetNillableClientID(in.ClientID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
 	}
-	if metrics.EarlyExitMinutes != nil {
-		create.SetEarlyExitMinutes(*metrics.EarlyExitMinutes)
+
+	attendance, err = applyPunchesToAttendance(ctx, tx.Client(), shift, holiday, attendance)
+	if err != nil {
+		return nil, err
 	}
-	if metrics.NetMinutes != nil {
-		create.SetNetMinutesBalance(*metrics.NetMinutes)
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
 	}
+	return attendance, nil
+}
+
+// punchRejectionReason devuelve el motivo con que se guarda una marcación rechazada
+// ("" = el error no corresponde a un rechazo y la marcación no se guarda).
+func punchRejectionReason(err error) string {
+	for reason, target := range punchRejections {
+		if errors.Is(err, target) {
+			return reason
+		}
+	}
+	return ""
+}
 
-	return create.Save(ctx)
+// rejectPunch guarda la marcación que las reglas de turno o de secuencia rechazaron,
+// sin día de asistencia, para que quede el registro de que la persona marcó. Devuelve
+// el error original, que es el que recibe el dispositivo.
+func (s *AttendanceService) rejectPunch(ctx context.Context, in punchInput, cause error) error {
+	reason := punchRejectionReason(cause)
+	if reason == "" {
+		return cause
+	}
+
+	var direction *string
+	if in.Direction == "in" || in.Direction == "out" {
+		direction = &in.Direction
+	}
+
+	_, err := s.Client.Punch.Create().
+		SetUserID(in.UserID).
+		SetBranchID(in.BranchID).
+		SetAccessPointID(in.AccessPointID).
+		SetNillableDeviceID(in.DeviceID).
+		SetPunchedAt(in.PunchedAt).
+		SetMethod(in.Method).
+		SetNillableDirection(direction).
+		SetNillableRawPayload(in.RawPayload).
+		SetNillableClientID(in.ClientID).
+		SetRejectedReason(reason).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	return cause
 }
 
-func (s *AttendanceService) updateAttendance(ctx context.Context, shift *ent.Shift, attendance *ent.AttendanceDay, accessPointID int, now time.Time) (*ent.AttendanceDay, error) {
-	update := s.Client.AttendanceDay.UpdateOne(attendance)
-	if attendance.AccessPointID == nil {
-		update.SetAccessPointID(accessPointID)
+// RecomputeAttendanceDay vuelve a derivar las marcaciones y métricas de un día
+// desde su stream de punches (por ejemplo, tras corregir un turno o sincronizar marcaciones).
+func (s *AttendanceService) RecomputeAttendanceDay(ctx context.Context, attendanceDayID int) (*ent.AttendanceDay, error) {
+	if attendanceDayID <= 0 {
+		return nil, ErrAttendanceInvalidInput
 	}
 
-	workIn := attendance.WorkInAt
-	breakOut := attendance.BreakOutAt
-	breakIn := attendance.BreakInAt
-	workOut := attendance.WorkOutAt
+	attendance, err := s.Client.AttendanceDay.Get(ctx, attendanceDayID)
+	if err != nil {
+		return nil, err
+	}
+
+	first, err := s.Client.Punch.Query().
+		Where(punch.AttendanceDayIDEQ(attendance.ID)).
+		Order(ent.Asc(punch.FieldPunchedAt), ent.Asc(punch.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return attendance, nil
+		}
+		return nil, err
+	}
+
+	// un feriado agregado después deja el día como no programado en vez de fallar
+	shift, _, holiday, err := s.resolveShiftAndWorkDate(ctx, attendance.UserID, attendance.BranchID, first.PunchedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return applyPunchesToAttendance(ctx, s.Client, shift, holiday, attendance)
+}
+
+func attendanceSlotsOf(attendance *ent.AttendanceDay) attendanceSlots {
+	return attendanceSlots{
+		WorkIn:   attendance.WorkInAt,
+		BreakOut: attendance.BreakOutAt,
+		BreakIn:  attendance.BreakInAt,
+		WorkOut:  attendance.WorkOutAt,
+	}
+}
+
+// loadAttendancePins arma los slots fijados a partir del historial de ediciones del
+// día: por slot gana el valor de la última edición que lo cambió. Los días editados
+// antes de existir el historial conservan los cuatro slots tal como quedaron.
+func loadAttendancePins(ctx context.Context, client *ent.Client, attendance *ent.AttendanceDay) (attendancePins, error) {
+	var pins attendancePins
+
+	edits, err := client.AttendanceEdit.Query().
+		Where(attendanceedit.AttendanceDayIDEQ(attendance.ID)).
+		Order(ent.Asc(attendanceedit.FieldCreatedAt), ent.Asc(attendanceedit.FieldID)).
+		All(ctx)
+	if err != nil {
+		return pins, err
+	}
+
+	if len(edits) == 0 {
+		if attendance.Edited {
+			pins = attendancePins{WorkIn: true, BreakOut: true, BreakIn: true, WorkOut: true}
+			pins.Values = attendanceSlotsOf(attendance)
+		}
+		return pins, nil
+	}
+
+	for _, e := range edits {
+		if !sameSlotTime(e.WorkInAtBefore, e.WorkInAtAfter) {
+			pins.WorkIn, pins.Values.WorkIn = true, e.WorkInAtAfter
+		}
+		if !sameSlotTime(e.BreakOutAtBefore, e.BreakOutAtAfter) {
+			pins.BreakOut, pins.Values.BreakOut = true, e.BreakOutAtAfter
+		}
+		if !sameSlotTime(e.BreakInAtBefore, e.BreakInAtAfter) {
+			pins.BreakIn, pins.Values.BreakIn = true, e.BreakInAtAfter
+		}
+		if !sameSlotTime(e.WorkOutAtBefore, e.WorkOutAtAfter) {
+			pins.WorkOut, pins.Values.WorkOut = true, e.WorkOutAtAfter
+		}
+	}
+	return pins, nil
+}
+
+func sameSlotTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+// nextAttendanceSlot devuelve la siguiente marcación esperada del día y su sentido.
+// Los slots fijados por una edición cuentan como registrados aunque estén vacíos.
+// Con el día completo devuelve slot vacío y "out": solo se acepta mover la salida.
+func nextAttendanceSlot(slots attendanceSlots, pins attendancePins) (string, string) {
+	workIn := slots.WorkIn != nil || pins.WorkIn
+	breakOut := slots.BreakOut != nil || pins.BreakOut
+	breakIn := slots.BreakIn != nil || pins.BreakIn
+	workOut := slots.WorkOut != nil || pins.WorkOut
 
 	switch {
-	case attendance.WorkInAt == nil:
-		update.SetWorkInAt(now)
-		workIn = &now
-	case attendance.BreakOutAt == nil:
-		update.SetBreakOutAt(now)
-		breakOut = &now
-	case attendance.BreakInAt == nil:
-		update.SetBreakInAt(now)
-		breakIn = &now
-	case attendance.WorkOutAt == nil:
-		update.SetWorkOutAt(now)
-		workOut = &now
+	case !workIn:
+		return "work_in", "in"
+	case !workOut && !breakOut:
+		return "break_out", "out"
+	case !workOut && !breakIn:
+		return "break_in", "in"
+	case !workOut:
+		return "work_out", "out"
 	default:
-		return nil, ErrAttendanceAlreadyCompleted
+		return "", "out"
+	}
+}
+
+// resolvePunchDirection valida el sentido del lector contra la siguiente marcación esperada.
+// Un lector "in" solo registra work_in/break_in, uno "out" solo break_out/work_out,
+// y "both" mantiene el comportamiento secuencial.
+func resolvePunchDirection(deviceDirection string, slots attendanceSlots, pins attendancePins) (string, error) {
+	slot, expected := nextAttendanceSlot(slots, pins)
+
+	switch deviceDirection {
+	case "in", "out":
+		if slot == "" && deviceDirection == "in" {
+			return "", ErrAttendanceAlreadyCompleted
+		}
+		if deviceDirection != expected {
+			return "", &AttendanceDirectionError{
+				DeviceDirection:   deviceDirection,
+				ExpectedDirection: expected,
+				ExpectedSlot:      slot,
+			}
+		}
+		return deviceDirection, nil
+	default:
+		return expected, nil
+	}
+}
+
+// derivePunchSlots asigna las marcaciones ordenadas a los slots del día según su sentido:
+// entrada, salida a colación, regreso de colación y salida. Las salidas posteriores
+// mueven la salida a la última marcación; el resto queda registrado solo en Punch.
+// Los slots fijados por ediciones manuales conservan su valor. Las marcaciones sin
+// sentido (lector "both" offline) toman el de su posición en la secuencia sin ediciones,
+// así la marcación original de un slot editado no se corre al slot siguiente.
+func derivePunchSlots(punches []*ent.Punch, pins attendancePins) attendanceSlots {
+	slots := pins.Values
+	var natural attendanceSlots
+	for _, p := range punches {
+		direction := ""
+		if p.Direction != nil {
+			direction = *p.Direction
+		} else {
+			_, direction = nextAttendanceSlot(natural, attendancePins{})
+		}
+		placePunch(&natural, attendancePins{}, p.PunchedAt, direction)
+		placePunch(&slots, pins, p.PunchedAt, direction)
+	}
+	return slots
+}
+
+// placePunch ubica una marcación con sentido conocido en el siguiente slot esperado.
+// Una salida fijada por edición no cierra el día para las marcaciones de colación
+// anteriores: solo impide que otra salida la reemplace.
+func placePunch(slots *attendanceSlots, pins attendancePins, at time.Time, direction string) {
+	seq, seqPins := *slots, pins
+	if pins.WorkOut {
+		seq.WorkOut, seqPins.WorkOut = nil, false
+	}
+	slot, expected := nextAttendanceSlot(seq, seqPins)
+
+	if direction != expected {
+		// salida sin regreso de colación (p.ej. marcaciones sincronizadas fuera de orden)
+		if direction == "out" && (slots.WorkIn != nil || pins.WorkIn) && !pins.WorkOut {
+			slots.WorkOut = &at
+		}
+		return
+	}
+
+	switch slot {
+	case "work_in":
+		slots.WorkIn = &at
+	case "break_out":
+		slots.BreakOut = &at
+	case "break_in":
+		slots.BreakIn = &at
+	default:
+		if !pins.WorkOut {
+			slots.WorkOut = &at
+		}
+	}
+}
+
+func applyPunchesToAttendance(ctx context.Context, client *ent.Client, shift *ent.Shift, holiday bool, attendance *ent.AttendanceDay) (*ent.AttendanceDay, error) {
+	punches, err := client.Punch.Query().
+		Where(punch.AttendanceDayIDEQ(attendance.ID)).
+		Order(ent.Asc(punch.FieldPunchedAt), ent.Asc(punch.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pins, err := loadAttendancePins(ctx, client, attendance)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := derivePunchSlots(punches, pins)
+	update := client.AttendanceDay.UpdateOne(attendance).SetIsHoliday(holiday)
+
+	if slots.WorkIn != nil {
+		update.SetWorkInAt(*slots.WorkIn)
+	} else {
+		update.ClearWorkInAt()
+	}
+	if slots.BreakOut != nil {
+		update.SetBreakOutAt(*slots.BreakOut)
+	} else {
+		update.ClearBreakOutAt()
+	}
+	if slots.BreakIn != nil {
+		update.SetBreakInAt(*slots.BreakIn)
+	} else {
+		update.ClearBreakInAt()
+	}
+	if slots.WorkOut != nil {
+		update.SetWorkOutAt(*slots.WorkOut)
+	} else {
+		update.ClearWorkOutAt()
 	}
 
 	metrics := computeAttendanceMetrics(attendance.WorkDate, attendanceMetricsSchedule{
@@ -310,7 +749,9 @@ func (s *AttendanceService) updateAttendance(ctx context.Context, shift *ent.Shi
 		EndTime:         shift.EndTime,
 		CrossesMidnight: shift.CrossesMidnight,
 		BreakMinutes:    shift.BreakMinutes,
-	}, workIn, breakOut, breakIn, workOut)
+		Tolerances:      shiftTolerancesOf(shift),
+		Unscheduled:     holiday,
+	}, slots.WorkIn, slots.BreakOut, slots.BreakIn, slots.WorkOut)
 
 	if metrics.LateMinutes != nil {
 		update.SetLateMinutes(*metrics.LateMinutes)
@@ -338,6 +779,39 @@ func (s *AttendanceService) updateAttendance(ctx context.Context, shift *ent.Shi
 		update.ClearNetMinutesBalance()
 	}
 
-	return update.Save(ctx)
+	// horas extra autorizadas vs no autorizadas según el pacto del día
+	if err := setOvertimeSplit(ctx, client, update, attendance.UserID, attendance.WorkDate, metrics.OvertimeMinutes); err != nil {
+		return nil, err
+	}
+
+	saved, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// jornada cerrada => se registra su balance neto en la bolsa de horas
+	if err := postHourBankDay(ctx, client, saved); err != nil {
+		return nil, err
+	}
+	return saved, nil
 }
 
+// ListPunchesForUser devuelve todas las marcaciones crudas del usuario en el rango (inclusive).
+func (s *AttendanceService) ListPunchesForUser(ctx context.Context, userID int, from, to *time.Time) ([]*ent.Punch, error) {
+	if userID <= 0 {
+		return nil, ErrAttendanceInvalidInput
+	}
+	if err := checkUserScope(ctx, s.Client, userID, false); err != nil {
+		return nil, err
+	}
+
+	q := s.Client.Punch.Query().Where(punch.UserIDEQ(userID))
+	if from != nil {
+		q = q.Where(punch.PunchedAtGTE(*from))
+	}
+	if to != nil {
+		q = q.Where(punch.PunchedAtLT(to.AddDate(0, 0, 1)))
+	}
+
+	return q.Order(ent.Asc(punch.FieldPunchedAt), ent.Asc(punch.FieldID)).All(ctx)
+}
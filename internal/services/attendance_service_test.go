@@ -0,0 +1,167 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"back/internal/ent"
+)
+
+var punchDay = time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+// at devuelve la hora hh:mm del día de prueba.
+func at(hhmm string) *time.Time {
+	var h, m int
+	if _, err := fmt.Sscanf(hhmm, "%d:%d", &h, &m); err != nil {
+		panic(err)
+	}
+	t := punchDay.Add(time.Duration(h)*time.Hour + time.Duration(m)*time.Minute)
+	return &t
+}
+
+// punches arma marcaciones desde "hh:mm" (sin sentido) o "hh:mm in|out".
+func punches(specs ...string) []*ent.Punch {
+	out := make([]*ent.Punch, 0, len(specs))
+	for i, spec := range specs {
+		fields := strings.Fields(spec)
+		p := &ent.Punch{ID: i + 1, PunchedAt: *at(fields[0])}
+		if len(fields) > 1 {
+			dir := fields[1]
+			p.Direction = &dir
+		}
+		out = append(out, p)
+	}
+	return out
+}
+
+func formatSlots(s attendanceSlots) string {
+	f := func(t *time.Time) string {
+		if t == nil {
+			return "-"
+		}
+		return t.Format("15:04")
+	}
+	return strings.Join([]string{f(s.WorkIn), f(s.BreakOut), f(s.BreakIn), f(s.WorkOut)}, " ")
+}
+
+func TestDerivePunchSlots(t *testing.T) {
+	tests := []struct {
+		name    string
+		punches []*ent.Punch
+		pins    attendancePins
+		want    string // entrada, salida colación, regreso colación, salida
+	}{
+		{
+			name: "sin marcaciones",
+			want: "- - - -",
+		},
+		{
+			name:    "día completo sin sentido",
+			punches: punches("08:00", "13:00", "14:00", "18:00"),
+			want:    "08:00 13:00 14:00 18:00",
+		},
+		{
+			name:    "día completo con sentido",
+			punches: punches("08:00 in", "13:00 out", "14:00 in", "18:00 out"),
+			want:    "08:00 13:00 14:00 18:00",
+		},
+		{
+			name:    "dos marcaciones sin sentido quedan como entrada y salida a colación",
+			punches: punches("08:00", "17:00"),
+			want:    "08:00 17:00 - -",
+		},
+		{
+			name:    "las salidas posteriores mueven la salida",
+			punches: punches("08:00", "13:00", "14:00", "18:00", "18:30"),
+			want:    "08:00 13:00 14:00 18:30",
+		},
+		{
+			name:    "salida sin regreso de colación",
+			punches: punches("08:00 in", "13:00 out", "18:00 out"),
+			want:    "08:00 13:00 - 18:00",
+		},
+		{
+			name:    "salida sin entrada se ignora",
+			punches: punches("08:00 out"),
+			want:    "- - - -",
+		},
+		{
+			name:    "entrada repetida se ignora",
+			punches: punches("08:00 in", "08:01 in", "13:00 out"),
+			want:    "08:00 13:00 - -",
+		},
+		{
+			name:    "entrada editada conserva su valor",
+			punches: punches("08:05 in", "13:00 out", "14:00 in", "18:00 out"),
+			pins:    attendancePins{WorkIn: true, Values: attendanceSlots{WorkIn: at("08:00")}},
+			want:    "08:00 13:00 14:00 18:00",
+		},
+		{
+			name:    "entrada editada con marcaciones offline sin sentido",
+			punches: punches("08:05", "13:00", "14:00", "18:00"),
+			pins:    attendancePins{WorkIn: true, Values: attendanceSlots{WorkIn: at("08:00")}},
+			want:    "08:00 13:00 14:00 18:00",
+		},
+		{
+			name:    "salida editada no la mueven marcaciones posteriores",
+			punches: punches("08:00 in", "13:00 out", "14:00 in", "18:00 out", "19:00 out"),
+			pins:    attendancePins{WorkOut: true, Values: attendanceSlots{WorkOut: at("17:00")}},
+			want:    "08:00 13:00 14:00 17:00",
+		},
+		{
+			name:    "colación borrada: la siguiente salida es la del día",
+			punches: punches("08:00 in", "18:00 out"),
+			pins:    attendancePins{BreakOut: true, BreakIn: true},
+			want:    "08:00 - - 18:00",
+		},
+		{
+			name:    "colación borrada con marcaciones sin sentido",
+			punches: punches("08:00", "18:00"),
+			pins:    attendancePins{BreakOut: true, BreakIn: true},
+			want:    "08:00 - - 18:00",
+		},
+		{
+			name:    "día editado completo (legado) no cambia",
+			punches: punches("08:10 in", "13:00 out", "14:00 in", "18:00 out", "19:00 out"),
+			pins: attendancePins{
+				WorkIn: true, BreakOut: true, BreakIn: true, WorkOut: true,
+				Values: attendanceSlots{WorkIn: at("08:00"), BreakOut: at("13:00"), BreakIn: at("13:45"), WorkOut: at("18:00")},
+			},
+			want: "08:00 13:00 13:45 18:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := formatSlots(derivePunchSlots(tt.punches, tt.pins))
+			if got != tt.want {
+				t.Errorf("derivePunchSlots = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPunchRejectionReason(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"lector de sentido equivocado", &AttendanceDirectionError{DeviceDirection: "in", ExpectedDirection: "out", ExpectedSlot: "work_out"}, PunchRejectedWrongDirection},
+		{"día completo", ErrAttendanceAlreadyCompleted, PunchRejectedAlreadyCompleted},
+		{"día no laborable", fmt.Errorf("marcando: %w", ErrAttendanceNotWorkDay), PunchRejectedNotWorkDay},
+		{"sin turno", ErrAttendanceNoShiftAssigned, PunchRejectedNoShift},
+		{"entrada inválida no se guarda", ErrAttendanceInvalidInput, ""},
+		{"error de base no se guarda", fmt.Errorf("connection reset"), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := punchRejectionReason(tt.err); got != tt.want {
+				t.Errorf("punchRejectionReason(%v) = %q, want %q", tt.err, got, tt.want)
+			}
+		})
+	}
+}
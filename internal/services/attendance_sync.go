@@ -0,0 +1,226 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"back/internal/ent"
+	"back/internal/ent/punch"
+	"back/internal/ent/user"
+)
+
+var (
+	ErrAttendanceSyncBatchTooLarge = errors.New("too many punches in sync batch")
+	ErrAttendancePunchInFuture     = errors.New("punch timestamp is in the future")
+	ErrAttendancePunchTooOld       = errors.New("punch timestamp is older than the allowed sync window")
+)
+
+const (
+	SyncStatusAccepted  = "accepted"
+	SyncStatusDuplicate = "duplicate"
+	SyncStatusRejected  = "rejected"
+
+	maxSyncBatchSize = 500
+
+	// tolerancia para relojes de dispositivos levemente adelantados
+	maxSyncClockSkew = 5 * time.Minute
+)
+
+var clientIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// SyncPunchInput es una marcación encolada en el dispositivo mientras estaba sin conexión.
+type SyncPunchInput struct {
+	ClientID   string    // UUID generado por el dispositivo
+	Method     string    // "qr" | "access_code"
+	Token      string    // token QR (method=qr)
+	AccessCode string    // código de acceso (method=access_code)
+	PunchedAt  time.Time // hora original del dispositivo
+}
+
+type SyncPunchResult struct {
+	ClientID        string
+	Status          string // accepted | duplicate | rejected
+	Reason          string
+	AttendanceDayID *int
+}
+
+// SyncDevicePunches aplica un lote de marcaciones offline de un dispositivo.
+// Las marcaciones se procesan en orden cronológico con la misma resolución de turno
+// que las marcaciones en línea, y cada client_id se aplica una sola vez.
+// Los resultados se devuelven en el mismo orden del lote recibido.
+func (s *AttendanceService) SyncDevicePunches(ctx context.Context, dev AttendanceDevice, items []SyncPunchInput) ([]SyncPunchResult, error) {
+	if len(items) == 0 {
+		return nil, ErrAttendanceInvalidInput
+	}
+	if len(items) > maxSyncBatchSize {
+		return nil, ErrAttendanceSyncBatchTooLarge
+	}
+
+	accessPoint, err := s.resolveDeviceAccessPoint(ctx, dev)
+	if err != nil {
+		return nil, err
+	}
+
+	order := make([]int, len(items))
+	for i := range items {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return items[order[a]].PunchedAt.Before(items[order[b]].PunchedAt)
+	})
+
+	results := make([]SyncPunchResult, len(items))
+	now := time.Now()
+
+	for _, idx := range order {
+		item := items[idx]
+		clientID := strings.ToLower(strings.TrimSpace(item.ClientID))
+		res := SyncPunchResult{ClientID: item.ClientID}
+
+		if !clientIDPattern.MatchString(clientID) || item.PunchedAt.IsZero() {
+			res.Status = SyncStatusRejected
+			res.Reason = ErrAttendanceInvalidInput.Error()
+			results[idx] = res
+			continue
+		}
+
+		existing, err := s.Client.Punch.Query().Where(punch.ClientIDEQ(clientID)).Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		if existing != nil {
+			results[idx] = syncResultOfExisting(res, existing)
+			continue
+		}
+
+		if item.PunchedAt.After(now.Add(maxSyncClockSkew)) {
+			res.Status = SyncStatusRejected
+			res.Reason = ErrAttendancePunchInFuture.Error()
+			results[idx] = res
+			continue
+		}
+
+		// sin tope hacia atrás un dispositivo podría cargar marcaciones en períodos ya cerrados
+		if s.MaxSyncBackfill > 0 && item.PunchedAt.Before(now.Add(-s.MaxSyncBackfill)) {
+			res.Status = SyncStatusRejected
+			res.Reason = ErrAttendancePunchTooOld.Error()
+			results[idx] = res
+			continue
+		}
+
+		attendance, err := s.recordSyncedPunch(ctx, accessPoint, dev, clientID, item)
+		if err != nil {
+			switch {
+			case ent.IsConstraintError(err):
+				// otro request registró el mismo client_id en paralelo
+				dup, dupErr := s.Client.Punch.Query().Where(punch.ClientIDEQ(clientID)).Only(ctx)
+				if dupErr != nil {
+					return nil, err
+				}
+				res = syncResultOfExisting(res, dup)
+			case isSyncRejection(err):
+				res.Status = SyncStatusRejected
+				res.Reason = err.Error()
+			default:
+				return nil, err
+			}
+			results[idx] = res
+			continue
+		}
+
+		res.Status = SyncStatusAccepted
+		res.AttendanceDayID = &attendance.ID
+		results[idx] = res
+	}
+
+	return results, nil
+}
+
+func (s *AttendanceService) recordSyncedPunch(ctx context.Context, accessPoint *ent.AccessPoint, dev AttendanceDevice, clientID string, item SyncPunchInput) (*ent.AttendanceDay, error) {
+	var (
+		userID  int
+		payload string
+	)
+
+	switch item.Method {
+	case PunchMethodQR:
+		if item.Token == "" {
+			return nil, ErrAttendanceInvalidInput
+		}
+		_, u, err := s.QR.ValidateAndGetQRSessionAt(ctx, item.Token, item.PunchedAt)
+		if err != nil {
+			return nil, err
+		}
+		if u == nil {
+			return nil, ErrAttendanceInvalidInput
+		}
+		userID = u.ID
+		payload = HashQRToken(item.Token)
+
+	case PunchMethodAccessCode:
+		if item.AccessCode == "" {
+			return nil, ErrAttendanceInvalidInput
+		}
+		u, err := s.Client.User.Query().
+			Where(user.AccessCodeEQ(item.AccessCode)).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, ErrAttendanceInvalidInput
+			}
+			return nil, err
+		}
+		if !u.IsActive {
+			return nil, ErrAttendanceInvalidInput
+		}
+		userID = u.ID
+		payload = *maskAccessCode(item.AccessCode)
+
+	default:
+		return nil, ErrAttendanceInvalidInput
+	}
+
+	return s.recordPunch(ctx, punchInput{
+		UserID:        userID,
+		BranchID:      accessPoint.BranchID,
+		AccessPointID: accessPoint.ID,
+		DeviceID:      &dev.DeviceID,
+		Direction:     dev.Direction,
+		Method:        item.Method,
+		RawPayload:    &payload,
+		PunchedAt:     item.PunchedAt,
+		ClientID:      &clientID,
+		Offline:       true,
+	})
+}
+
+// syncResultOfExisting responde un client_id ya registrado: duplicado si se aplicó, o
+// el mismo rechazo con que se guardó la primera vez.
+func syncResultOfExisting(res SyncPunchResult, existing *ent.Punch) SyncPunchResult {
+	if existing.RejectedReason != nil {
+		res.Status = SyncStatusRejected
+		res.Reason = *existing.RejectedReason
+		if err, ok := punchRejections[*existing.RejectedReason]; ok {
+			res.Reason = err.Error()
+		}
+		return res
+	}
+	res.Status = SyncStatusDuplicate
+	res.AttendanceDayID = existing.AttendanceDayID
+	return res
+}
+
+// isSyncRejection indica si el error corresponde a una marcación inválida
+// (se informa en el resultado del ítem) y no a una falla del servidor.
+func isSyncRejection(err error) bool {
+	return errors.Is(err, ErrAttendanceInvalidInput) ||
+		errors.Is(err, ErrAttendanceNotWorkDay) ||
+		errors.Is(err, ErrAttendanceNoShiftAssigned) ||
+		errors.Is(err, ErrQRSessionNotFound) ||
+		errors.Is(err, ErrQRSessionExpired) ||
+		errors.Is(err, ErrQRSessionRevoked)
+}
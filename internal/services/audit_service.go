@@ -0,0 +1,355 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"back/internal/ent"
+	"back/internal/ent/auditlog"
+)
+
+var ErrAuditInvalidInput = errors.New("invalid audit log filter")
+
+const (
+	AuditOperationCreate = "create"
+	AuditOperationUpdate = "update"
+	AuditOperationDelete = "delete"
+)
+
+// valor que reemplaza a los campos sensibles en el diff
+const auditRedacted = "[REDACTED]"
+
+// entidades administrativas que quedan en el audit log (incluye roles y permisos)
+var auditedTypes = map[string]bool{
+	ent.TypeUser:                true,
+	ent.TypeBranch:              true,
+	ent.TypeAccessPoint:         true,
+	ent.TypeDevice:              true,
+	ent.TypeShift:               true,
+	ent.TypeShiftDay:            true,
+	ent.TypeShiftCycleDay:       true,
+	ent.TypeUserShiftAssignment: true,
+	ent.TypeUserBranch:          true,
+	ent.TypeUserAccessPoint:     true,
+	ent.TypeRole:                true,
+}
+
+// campos que nunca se guardan en claro (solo se registra que cambiaron)
+var auditRedactedFields = map[string]bool{
+	"password_hash": true,
+	"access_code":   true,
+}
+
+// campos que cambian solos (cada edición o cada login) y no aportan al diff
+var auditSkippedFields = map[string]bool{
+	"updated_at":    true,
+	"last_login_at": true,
+}
+
+// AuditActor identifica quién hizo el cambio y en qué petición. Un token de dispositivo
+// deja DeviceID en vez de UserID.
+type AuditActor struct {
+	UserID    int
+	DeviceID  int
+	RequestID string
+}
+
+type ctxKeyAuditActor struct{}
+
+// WithAuditActor deja el actor en el contexto para que el hook de auditoría lo registre.
+func WithAuditActor(ctx context.Context, actor AuditActor) context.Context {
+	return context.WithValue(ctx, ctxKeyAuditActor{}, actor)
+}
+
+// AuditActorFrom devuelve el actor del contexto (false = proceso interno).
+func AuditActorFrom(ctx context.Context) (AuditActor, bool) {
+	actor, ok := ctx.Value(ctxKeyAuditActor{}).(AuditActor)
+	return actor, ok
+}
+
+// interfaces que cumplen todas las mutaciones generadas por Ent
+type auditIDMutation interface {
+	ID() (int, bool)
+	IDs(ctx context.Context) ([]int, error)
+	Client() *ent.Client
+	Tx() (*ent.Tx, error)
+}
+
+// AuditHook registra en AuditLog cada create/update/delete de las entidades auditadas.
+// Se instala con client.Use; el registro se escribe con el cliente de la mutación, así
+// queda en la misma transacción cuando la hay.
+func AuditHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			im, ok := m.(auditIDMutation)
+			if !ok || !auditedTypes[m.Type()] {
+				return next.Mutate(ctx, m)
+			}
+
+			var (
+				operation string
+				changes   map[string]any
+				ids       []int
+				err       error
+			)
+			switch {
+			case m.Op().Is(ent.OpCreate):
+				operation = AuditOperationCreate
+				changes = auditNewValues(m)
+
+			case m.Op().Is(ent.OpUpdateOne):
+				operation = AuditOperationUpdate
+				if changes, err = auditDiff(ctx, m); err != nil {
+					return nil, err
+				}
+				if len(changes) == 0 {
+					return next.Mutate(ctx, m)
+				}
+
+			case m.Op().Is(ent.OpUpdate):
+				// en ediciones masivas no hay valor anterior por fila: solo el nuevo
+				operation = AuditOperationUpdate
+				changes = auditNewValues(m)
+				if len(changes) == 0 {
+					return next.Mutate(ctx, m)
+				}
+
+			default:
+				operation = AuditOperationDelete
+			}
+
+			// las filas afectadas se resuelven antes de aplicar el cambio (después el
+			// predicado puede dejar de coincidir o la fila ya no existe)
+			if operation != AuditOperationCreate {
+				if ids, err = im.IDs(ctx); err != nil {
+					return nil, err
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+
+			if operation == AuditOperationCreate {
+				if id, ok := im.ID(); ok {
+					ids = []int{id}
+				}
+			}
+
+			if err := writeAuditLogs(ctx, im.Client(), m.Type(), operation, ids, changes); err != nil {
+				// dentro de una transacción el cambio se revierte junto con su registro;
+				// fuera de ella la mutación ya se aplicó y solo queda dejarlo en el log
+				if _, txErr := im.Tx(); txErr == nil {
+					return nil, err
+				}
+				log.Printf("[audit] error saving %s %s %v: %v", operation, m.Type(), ids, err)
+			}
+			return v, nil
+		})
+	}
+}
+
+// writeAuditLogs inserta una fila por entidad afectada.
+func writeAuditLogs(ctx context.Context, client *ent.Client, entityType, operation string, ids []int, changes map[string]any) error {
+	actor, hasActor := AuditActorFrom(ctx)
+
+	builders := make([]*ent.AuditLogCreate, 0, len(ids))
+	for _, id := range ids {
+		b := client.AuditLog.Create().
+			SetEntityType(entityType).
+			SetEntityID(id).
+			SetOperation(operation)
+		if changes != nil {
+			b.SetChanges(changes)
+		}
+		if hasActor {
+			if actor.UserID > 0 {
+				b.SetActorID(actor.UserID)
+			}
+			if actor.DeviceID > 0 {
+				b.SetActorDeviceID(actor.DeviceID)
+			}
+			if actor.RequestID != "" {
+				b.SetRequestID(actor.RequestID)
+			}
+		}
+		builders = append(builders, b)
+	}
+	if len(builders) == 0 {
+		return nil
+	}
+
+	_, err := client.AuditLog.CreateBulk(builders...).Save(ctx)
+	return err
+}
+
+// auditNewValues arma el diff de un create o una edición masiva (solo valor nuevo).
+func auditNewValues(m ent.Mutation) map[string]any {
+	changes := map[string]any{}
+	for _, name := range m.Fields() {
+		if auditSkippedFields[name] {
+			continue
+		}
+		v, _ := m.Field(name)
+		changes[name] = map[string]any{"new": auditValue(name, v)}
+	}
+	for _, name := range m.ClearedFields() {
+		changes[name] = map[string]any{"new": nil}
+	}
+	return changes
+}
+
+// auditDiff compara los valores nuevos de una edición puntual con los guardados y
+// devuelve solo los campos que efectivamente cambian.
+func auditDiff(ctx context.Context, m ent.Mutation) (map[string]any, error) {
+	changes := map[string]any{}
+
+	for _, name := range m.Fields() {
+		if auditSkippedFields[name] {
+			continue
+		}
+		newValue, _ := m.Field(name)
+		oldValue, err := m.OldField(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if auditEqual(oldValue, newValue) {
+			continue
+		}
+		changes[name] = map[string]any{
+			"old": auditValue(name, oldValue),
+			"new": auditValue(name, newValue),
+		}
+	}
+
+	for _, name := range m.ClearedFields() {
+		oldValue, err := m.OldField(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if auditEqual(oldValue, nil) {
+			continue
+		}
+		changes[name] = map[string]any{
+			"old": auditValue(name, oldValue),
+			"new": nil,
+		}
+	}
+
+	return changes, nil
+}
+
+// auditValue normaliza punteros (los campos Nillable devuelven *T como valor anterior)
+// y redacta los campos sensibles.
+func auditValue(name string, v any) any {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		v = rv.Elem().Interface()
+	}
+	if v != nil && auditRedactedFields[name] {
+		return auditRedacted
+	}
+	return v
+}
+
+func auditEqual(a, b any) bool {
+	a, b = auditValue("", a), auditValue("", b)
+	if ta, ok := a.(time.Time); ok {
+		tb, ok := b.(time.Time)
+		return ok && ta.Equal(tb)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+/* =========================
+   CONSULTA
+   ========================= */
+
+type AuditService struct {
+	Client *ent.Client
+}
+
+func NewAuditService(client *ent.Client) *AuditService {
+	return &AuditService{Client: client}
+}
+
+type AuditLogFilters struct {
+	EntityType    *string
+	EntityID      *int
+	ActorID       *int
+	ActorDeviceID *int
+	Operation     *string
+	RequestID     *string
+	StartDate     *time.Time
+	EndDate       *time.Time
+	Page          int
+	Limit         int
+}
+
+// List devuelve los registros más recientes primero, paginados, y el total que cumple
+// los filtros.
+func (s *AuditService) List(ctx context.Context, f AuditLogFilters) ([]*ent.AuditLog, int, error) {
+	if f.StartDate != nil && f.EndDate != nil && f.EndDate.Before(*f.StartDate) {
+		return nil, 0, ErrAuditInvalidInput
+	}
+	if f.Page <= 0 {
+		f.Page = 1
+	}
+	if f.Limit <= 0 {
+		f.Limit = 50
+	}
+	if f.Limit > 200 {
+		f.Limit = 200
+	}
+
+	q := s.Client.AuditLog.Query()
+
+	if f.EntityType != nil {
+		q = q.Where(auditlog.EntityTypeEqualFold(strings.TrimSpace(*f.EntityType)))
+	}
+	if f.EntityID != nil {
+		q = q.Where(auditlog.EntityIDEQ(*f.EntityID))
+	}
+	if f.ActorID != nil {
+		q = q.Where(auditlog.ActorIDEQ(*f.ActorID))
+	}
+	if f.ActorDeviceID != nil {
+		q = q.Where(auditlog.ActorDeviceIDEQ(*f.ActorDeviceID))
+	}
+	if f.Operation != nil {
+		q = q.Where(auditlog.OperationEQ(strings.ToLower(strings.TrimSpace(*f.Operation))))
+	}
+	if f.RequestID != nil {
+		q = q.Where(auditlog.RequestIDEQ(strings.TrimSpace(*f.RequestID)))
+	}
+	if f.StartDate != nil {
+		q = q.Where(auditlog.CreatedAtGTE(truncateToDay(*f.StartDate)))
+	}
+	if f.EndDate != nil {
+		q = q.Where(auditlog.CreatedAtLT(truncateToDay(*f.EndDate).AddDate(0, 0, 1)))
+	}
+
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	items, err := q.
+		Order(ent.Desc(auditlog.FieldCreatedAt), ent.Desc(auditlog.FieldID)).
+		Offset((f.Page - 1) * f.Limit).
+		Limit(f.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return items, total, nil
+}
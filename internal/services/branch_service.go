@@ -9,10 +9,13 @@ import (
 	"back/internal/ent"
 	"back/internal/ent/accesspoint"
 	"back/internal/ent/attendanceday"
+	"back/internal/ent/attendanceedit"
 	"back/internal/ent/branch"
 	"back/internal/ent/branchaddress"
 	"back/internal/ent/commune"
 	"back/internal/ent/device"
+	"back/internal/ent/hourbankmovement"
+	"back/internal/ent/markcorrectionrequest"
 	"back/internal/ent/useraccesspoint"
 	"back/internal/ent/userbranch"
 )
@@ -349,14 +352,35 @@ func (s *BranchService) DeleteCascade(ctx context.Context, branchID int) error {
 		return err
 	}
 
-	// 7) borrar attendance_days (si ya existe uso)
+	// 7) borrar movimientos diarios de bolsa de horas de esos días
+	if _, err := tx.HourBankMovement.Delete().
+		Where(hourbankmovement.HasAttendanceDayWith(attendanceday.BranchIDEQ(branchID))).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// 8) borrar el historial de ediciones de esos días
+	if _, err := tx.AttendanceEdit.Delete().
+		Where(attendanceedit.HasAttendanceDayWith(attendanceday.BranchIDEQ(branchID))).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// 9) borrar solicitudes de corrección de esos días
+	if _, err := tx.MarkCorrectionRequest.Delete().
+		Where(markcorrectionrequest.HasAttendanceDayWith(attendanceday.BranchIDEQ(branchID))).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// 10) borrar attendance_days (si ya existe uso)
 	if _, err := tx.AttendanceDay.Delete().
 		Where(attendanceday.BranchIDEQ(branchID)).
 		Exec(ctx); err != nil {
 		return err
 	}
 
-	// 8) borrar branch
+	// 11) borrar branch
 	if err := tx.Branch.DeleteOneID(branchID).Exec(ctx); err != nil {
 		return err
 	}
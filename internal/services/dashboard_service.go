@@ -169,8 +169,12 @@ type DashboardPunctualityResponse struct {
 }
 
 func (s *DashboardService) GetStats(ctx context.Context, f DashboardFilters) (*DashboardStatsResponse, error) {
-	// Create cache key
-	cacheKey := fmt.Sprintf("stats_%v_%s_%v_%v", f.BranchID, f.Range, f.StartDate, f.EndDate)
+	if err := checkDashboardScope(ctx, f.BranchID); err != nil {
+		return nil, err
+	}
+
+	// Create cache key (incluye el alcance: cada conjunto de sucursales tiene su propio resultado)
+	cacheKey := fmt.Sprintf("stats_%v_%s_%v_%v_%s", f.BranchID, f.Range, f.StartDate, f.EndDate, AccessScopeFrom(ctx).key())
 
 	// Check cache
 	if cached, ok := s.cache.Load(cacheKey); ok {
@@ -197,6 +201,9 @@ func (s *DashboardService) GetStats(ctx context.Context, f DashboardFilters) (*D
 }
 
 func (s *DashboardService) GetLiveData(ctx context.Context, f DashboardLiveFilters) (*DashboardLiveResponse, error) {
+	if err := checkDashboardScope(ctx, f.BranchID); err != nil {
+		return nil, err
+	}
 	if f.Limit <= 0 {
 		f.Limit = 5
 	}
@@ -409,7 +416,7 @@ func (s *DashboardService) getSummary(
 
 func (s *DashboardService) countMarkings(ctx context.Context, branchID *int, start, end time.Time) (int, error) {
 	args := []any{start, end}
-	branchWhere := buildBranchFilter("ad.branch_id", branchID, &args)
+	branchWhere := buildBranchFilter(ctx, "ad.branch_id", branchID, &args)
 
 	query := fmt.Sprintf(`
 		SELECT COUNT(*) AS total
@@ -426,7 +433,7 @@ func (s *DashboardService) countMarkings(ctx context.Context, branchID *int, sta
 
 func (s *DashboardService) countPeopleInside(ctx context.Context, branchID *int, start, end time.Time) (int, error) {
 	args := []any{start, end}
-	branchWhere := buildBranchFilter("ad.branch_id", branchID, &args)
+	branchWhere := buildBranchFilter(ctx, "ad.branch_id", branchID, &args)
 
 	query := fmt.Sprintf(`
 		SELECT COUNT(*)
@@ -444,19 +451,29 @@ func (s *DashboardService) countPeopleInside(ctx context.Context, branchID *int,
 
 func (s *DashboardService) countLateArrivals(ctx context.Context, branchID *int, start, end time.Time) (int, error) {
 	args := []any{start, end}
-	branchWhere := buildBranchFilter("ub.branch_id", branchID, &args)
+	branchWhere := buildBranchFilter(ctx, "ub.branch_id", branchID, &args)
 
 	query := fmt.Sprintf(`
 		SELECT COUNT(*)
 		FROM attendance_days ad
 		JOIN user_branches ub ON ub.user_id = ad.user_id AND ub.is_active = true
-		JOIN user_shift_assignments usa ON usa.user_id = ad.user_id AND usa.is_active = true
+		JOIN LATERAL (
+			SELECT usa.shift_id
+			FROM user_shift_assignments usa
+			WHERE usa.user_id = ad.user_id
+			  AND usa.is_active = true
+			  AND usa.start_date <= ad.work_date
+			  AND (usa.end_date IS NULL OR usa.end_date >= ad.work_date)
+			ORDER BY usa.start_date DESC, usa.id DESC
+			LIMIT 1
+		) usa ON true
 		JOIN shifts sh ON sh.id = usa.shift_id AND sh.is_active = true
 		WHERE ad.work_date >= $1 AND ad.work_date < $2
 		  AND ad.work_in_at IS NOT NULL
-		  AND ad.work_in_at::time > sh.start_time::time
+		  AND ad.work_in_at > (ad.work_date::timestamp + sh.start_time::time + make_interval(mins => sh.entry_tolerance_minutes))
+		  AND NOT %s
 		  %s
-	`, branchWhere)
+	`, approvedLeaveSQLCondition("ad.user_id", "ad.work_date"), branchWhere)
 
 	var total int
 	err := s.db.QueryRowContext(ctx, query, args...).Scan(&total)
@@ -465,7 +482,7 @@ func (s *DashboardService) countLateArrivals(ctx context.Context, branchID *int,
 
 func (s *DashboardService) countAlerts(ctx context.Context, branchID *int, start, end time.Time) (int, error) {
 	args := []any{start, end}
-	branchWhere := buildBranchFilter("ad.branch_id", branchID, &args)
+	branchWhere := buildBranchFilter(ctx, "ad.branch_id", branchID, &args)
 
 	query := fmt.Sprintf(`
 		SELECT COUNT(*)
@@ -476,23 +493,28 @@ func (s *DashboardService) countAlerts(ctx context.Context, branchID *int, start
 			OR
 			(ad.work_in_at IS NULL AND ad.work_out_at IS NOT NULL)
 		  )
+		  AND NOT %s
 		  %s
-	`, branchWhere)
+	`, approvedLeaveSQLCondition("ad.user_id", "ad.work_date"), branchWhere)
 
 	var total int
 	err := s.db.QueryRowContext(ctx, query, args...).Scan(&total)
 	return total, err
 }
 
+// countJustifiedAbsences cuenta personas con una ausencia aprobada (vacaciones, licencia,
+// permiso) que cubre el rango.
 func (s *DashboardService) countJustifiedAbsences(ctx context.Context, branchID *int, start, end time.Time) (int, error) {
 	args := []any{start, end}
-	branchWhere := buildBranchFilter("ad.branch_id", branchID, &args)
+	branchWhere := buildBranchFilter(ctx, "ub.branch_id", branchID, &args)
 
 	query := fmt.Sprintf(`
-		SELECT COUNT(*)
-		FROM attendance_days ad
-		WHERE ad.work_date >= $1 AND ad.work_date < $2
-		  AND ad.work_in_at IS NULL
+		SELECT COUNT(DISTINCT lr.user_id)
+		FROM leave_requests lr
+		JOIN user_branches ub ON ub.user_id = lr.user_id AND ub.is_active = true
+		WHERE lr.status = 'approved'
+		  AND lr.start_date < $2
+		  AND lr.end_date >= $1
 		  %s
 	`, branchWhere)
 
@@ -503,7 +525,7 @@ func (s *DashboardService) countJustifiedAbsences(ctx context.Context, branchID
 
 func (s *DashboardService) getMarkingsByRange(ctx context.Context, branchID *int, start, end time.Time) ([]ChartPoint, error) {
 	args := []any{start, end}
-	branchWhere := buildBranchFilter("ad.branch_id", branchID, &args)
+	branchWhere := buildBranchFilter(ctx, "ad.branch_id", branchID, &args)
 
 	query := fmt.Sprintf(`
 		SELECT DATE(ad.work_date) AS d,
@@ -564,7 +586,7 @@ func (s *DashboardService) getMarkingsByRange(ctx context.Context, branchID *int
 
 func (s *DashboardService) getEntriesVsExits(ctx context.Context, branchID *int, start, end time.Time) (EntriesVsExits, error) {
 	args := []any{start, end}
-	branchWhere := buildBranchFilter("ad.branch_id", branchID, &args)
+	branchWhere := buildBranchFilter(ctx, "ad.branch_id", branchID, &args)
 
 	query := fmt.Sprintf(`
 		SELECT
@@ -587,7 +609,7 @@ func (s *DashboardService) getCurrentStatus(ctx context.Context, branchID *int,
 	}
 
 	outsideArgs := []any{start, end}
-	outsideBranchWhere := buildBranchFilter("ub.branch_id", branchID, &outsideArgs)
+	outsideBranchWhere := buildBranchFilter(ctx, "ub.branch_id", branchID, &outsideArgs)
 
 	outsideQuery := fmt.Sprintf(`
 		SELECT COUNT(*)
@@ -605,7 +627,7 @@ func (s *DashboardService) getCurrentStatus(ctx context.Context, branchID *int,
 	}
 
 	noMarkArgs := []any{}
-	noMarkBranchWhere := buildBranchFilter("ub.branch_id", branchID, &noMarkArgs)
+	noMarkBranchWhere := buildBranchFilter(ctx, "ub.branch_id", branchID, &noMarkArgs)
 	noMarkArgs = append(noMarkArgs, start, end)
 
 	noMarkQuery := fmt.Sprintf(`
@@ -636,7 +658,7 @@ func (s *DashboardService) getCurrentStatus(ctx context.Context, branchID *int,
 
 func (s *DashboardService) getActivityByHour(ctx context.Context, branchID *int, start, end time.Time) ([]ChartPoint, error) {
 	args := []any{start, end}
-	branchWhere := buildBranchFilter("ad.branch_id", branchID, &args)
+	branchWhere := buildBranchFilter(ctx, "ad.branch_id", branchID, &args)
 
 	query := fmt.Sprintf(`
 		SELECT EXTRACT(HOUR FROM ad.work_in_at)::int AS h,
@@ -680,7 +702,7 @@ func (s *DashboardService) getActivityByHour(ctx context.Context, branchID *int,
 
 func (s *DashboardService) getTopLates(ctx context.Context, branchID *int, start, end time.Time) ([]TopLateItem, error) {
 	args := []any{start, end}
-	branchWhere := buildBranchFilter("ub.branch_id", branchID, &args)
+	branchWhere := buildBranchFilter(ctx, "ub.branch_id", branchID, &args)
 
 	query := fmt.Sprintf(`
 		SELECT
@@ -692,11 +714,20 @@ func (s *DashboardService) getTopLates(ctx context.Context, branchID *int, start
 		JOIN users u ON u.id = ad.user_id
 		JOIN user_branches ub ON ub.user_id = u.id AND ub.is_active = true
 		JOIN branches b ON b.id = ub.branch_id
-		JOIN user_shift_assignments usa ON usa.user_id = u.id AND usa.is_active = true
+		JOIN LATERAL (
+			SELECT usa.shift_id
+			FROM user_shift_assignments usa
+			WHERE usa.user_id = u.id
+			  AND usa.is_active = true
+			  AND usa.start_date <= ad.work_date
+			  AND (usa.end_date IS NULL OR usa.end_date >= ad.work_date)
+			ORDER BY usa.start_date DESC, usa.id DESC
+			LIMIT 1
+		) usa ON true
 		JOIN shifts sh ON sh.id = usa.shift_id AND sh.is_active = true
 		WHERE ad.work_date >= $1 AND ad.work_date < $2
 		  AND ad.work_in_at IS NOT NULL
-		  AND ad.work_in_at > (ad.work_date::timestamp + sh.start_time::time)
+		  AND ad.work_in_at > (ad.work_date::timestamp + sh.start_time::time + make_interval(mins => sh.entry_tolerance_minutes))
 		  %s
 		ORDER BY minutes_late DESC
 		LIMIT 5
@@ -736,13 +767,15 @@ func (s *DashboardService) getTopBranches(ctx context.Context, branchID *int, st
 		LEFT JOIN access_points ap ON ap.branch_id = b.id
 		LEFT JOIN attendance_days ad ON ad.access_point_id = ap.id
 			AND ad.work_date >= $1 AND ad.work_date < $2
-		WHERE $3::int IS NULL OR b.id = $3
+		WHERE ($3::int IS NULL OR b.id = $3)%s
 		GROUP BY b.id, b.name
 		ORDER BY total DESC, b.name ASC
 		LIMIT 5
 	`
+	args := []any{start, end, branchID}
+	query = fmt.Sprintf(query, AccessScopeFrom(ctx).sqlFilter("b.id", "", &args))
 
-	rows, err := s.db.QueryContext(ctx, query, start, end, branchID)
+	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -765,7 +798,7 @@ func (s *DashboardService) getTopBranches(ctx context.Context, branchID *int, st
 
 func (s *DashboardService) getLastMarks(ctx context.Context, branchID *int, start, end time.Time, limit int) ([]DashboardLastMarkItem, error) {
 	args := []any{limit, start, end}
-	branchWhere := buildBranchFilter("ad.branch_id", branchID, &args)
+	branchWhere := buildBranchFilter(ctx, "ad.branch_id", branchID, &args)
 
 	query := fmt.Sprintf(`
 		SELECT marking_id, user_id, name, type, marked_at, branch_id, branch_name
@@ -825,7 +858,7 @@ func (s *DashboardService) getLastMarks(ctx context.Context, branchID *int, star
 
 func (s *DashboardService) getInsideNow(ctx context.Context, branchID *int, start, end time.Time, limit int) ([]DashboardInsideNowItem, error) {
 	args := []any{limit, start, end}
-	branchWhere := buildBranchFilter("ad.branch_id", branchID, &args)
+	branchWhere := buildBranchFilter(ctx, "ad.branch_id", branchID, &args)
 
 	query := fmt.Sprintf(`
 		SELECT u.id AS user_id,
@@ -865,12 +898,28 @@ func (s *DashboardService) getInsideNow(ctx context.Context, branchID *int, star
 	return items, nil
 }
 
-func buildBranchFilter(column string, branchID *int, args *[]any) string {
+// buildBranchFilter filtra por la sucursal pedida y, además, por las sucursales
+// visibles para quien consulta (alcance del contexto).
+func buildBranchFilter(ctx context.Context, column string, branchID *int, args *[]any) string {
+	filter := AccessScopeFrom(ctx).sqlFilter(column, "", args)
 	if branchID == nil {
-		return ""
+		return filter
 	}
 	*args = append(*args, *branchID)
-	return fmt.Sprintf(" AND %s = $%d", column, len(*args))
+	return filter + fmt.Sprintf(" AND %s = $%d", column, len(*args))
+}
+
+// checkDashboardScope: el dashboard es por sucursal, así que quien no ve ninguna
+// (o pide una fuera de su alcance) no tiene acceso.
+func checkDashboardScope(ctx context.Context, branchID *int) error {
+	scope := AccessScopeFrom(ctx)
+	if !scope.Restricted() {
+		return nil
+	}
+	if len(scope.Branches) == 0 || (branchID != nil && !scope.CanView(*branchID)) {
+		return ErrOutOfScope
+	}
+	return nil
 }
 
 func twoDigits(n int) string {
@@ -878,6 +927,9 @@ func twoDigits(n int) string {
 }
 
 func (s *DashboardService) GetPunctuality(ctx context.Context, branchID *int) (*DashboardPunctualityResponse, error) {
+	if err := checkDashboardScope(ctx, branchID); err != nil {
+		return nil, err
+	}
 	now := time.Now()
 	loc := now.Location()
 	todayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
@@ -898,7 +950,7 @@ func (s *DashboardService) GetPunctuality(ctx context.Context, branchID *int) (*
 
 func (s *DashboardService) getTodayPunctuality(ctx context.Context, branchID *int, todayStart, todayEnd time.Time) ([]TodayPunctualityItem, error) {
 	args := []any{todayStart, todayEnd}
-	branchWhere := buildBranchFilter("ad.branch_id", branchID, &args)
+	branchWhere := buildBranchFilter(ctx, "ad.branch_id", branchID, &args)
 
 	query := fmt.Sprintf(`
 		WITH today_override AS (
@@ -912,8 +964,8 @@ func (s *DashboardService) getTodayPunctuality(ctx context.Context, branchID *in
 			FROM user_shift_assignments
 			WHERE is_active = true
 			  AND start_date <= $1
-			  AND (end_date IS NULL OR end_date > $1)
-			ORDER BY user_id, id DESC
+			  AND (end_date IS NULL OR end_date >= $1)
+			ORDER BY user_id, start_date DESC, id DESC
 		)
 		SELECT
 			u.id AS user_id,
@@ -940,22 +992,24 @@ func (s *DashboardService) getTodayPunctuality(ctx context.Context, branchID *in
 					CASE WHEN sh.crosses_midnight THEN INTERVAL '1 day' ELSE INTERVAL '0' END +
 					sh.end_time::time
 				))) / 60)::int
-			ELSE NULL END AS exit_diff_minutes
+			ELSE NULL END AS exit_diff_minutes,
+			sh.entry_tolerance_minutes,
+			sh.exit_tolerance_minutes,
+			sh.min_overtime_minutes
 		FROM attendance_days ad
 		JOIN users u ON u.id = ad.user_id
 		JOIN branches b ON b.id = ad.branch_id
 		LEFT JOIN today_override tov ON tov.user_id = u.id
 		LEFT JOIN active_assignment aa ON aa.user_id = u.id
 		LEFT JOIN shifts sh ON sh.id = COALESCE(tov.shift_id, aa.shift_id) AND sh.is_active = true
-		LEFT JOIN shift_days sd ON sd.shift_id = sh.id
-			AND sd.weekday = EXTRACT(DOW FROM ad.work_date)::int
 		WHERE ad.work_date >= $1 AND ad.work_date < $2
 		  AND (tov.is_day_off IS NULL OR tov.is_day_off = false)
 		  AND sh.id IS NOT NULL
-		  AND (sd.is_working_day IS NULL OR sd.is_working_day = true)
+		  AND (tov.shift_id IS NOT NULL OR %s)
+		  AND (tov.shift_id IS NOT NULL OR NOT %s)
 		  %s
 		ORDER BY ad.work_in_at DESC NULLS LAST
-	`, branchWhere)
+	`, shiftWorkingDaySQLCondition("sh", "ad.work_date"), holidaySQLCondition("ad.work_date", "ad.branch_id"), branchWhere)
 
 	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -968,6 +1022,7 @@ func (s *DashboardService) getTodayPunctuality(ctx context.Context, branchID *in
 		var it TodayPunctualityItem
 		var workInAt, breakOutAt, breakInAt, workOutAt sql.NullTime
 		var entryDiff, breakDiff, exitDiff sql.NullInt64
+		var tol shiftTolerances
 
 		if err := rows.Scan(
 			&it.UserID,
@@ -985,6 +1040,9 @@ func (s *DashboardService) getTodayPunctuality(ctx context.Context, branchID *in
 			&entryDiff,
 			&breakDiff,
 			&exitDiff,
+			&tol.EntryMinutes,
+			&tol.ExitMinutes,
+			&tol.MinOvertimeMinutes,
 		); err != nil {
 			return nil, err
 		}
@@ -1019,11 +1077,13 @@ func (s *DashboardService) getTodayPunctuality(ctx context.Context, branchID *in
 			it.ExitDiffMinutes = &v
 		}
 
-		// Entry status
+		// Entry status (con la tolerancia de entrada del turno)
 		if it.WorkInAt == nil {
 			it.EntryStatus = "no_mark"
-		} else if *it.EntryDiffMinutes > 0 {
+		} else if tol.isLate(*it.EntryDiffMinutes) {
 			it.EntryStatus = "late"
+		} else if *it.EntryDiffMinutes > 0 {
+			it.EntryStatus = "on_time"
 		} else if *it.EntryDiffMinutes < 0 {
 			it.EntryStatus = "early"
 		} else {
@@ -1041,12 +1101,12 @@ func (s *DashboardService) getTodayPunctuality(ctx context.Context, branchID *in
 			it.BreakStatus = "on_time"
 		}
 
-		// Exit status
+		// Exit status (tolerancia de salida y mínimo de sobretiempo del turno)
 		if it.WorkOutAt == nil {
 			it.ExitStatus = "no_mark"
-		} else if *it.ExitDiffMinutes > 0 {
+		} else if tol.isOvertime(*it.ExitDiffMinutes) {
 			it.ExitStatus = "overtime"
-		} else if *it.ExitDiffMinutes < 0 {
+		} else if tol.isEarlyExit(*it.ExitDiffMinutes) {
 			it.ExitStatus = "early"
 		} else {
 			it.ExitStatus = "on_time"
@@ -1074,6 +1134,10 @@ type DashboardExportResponse struct {
 
 // GetExport devuelve todos los datos del rango sin límite de registros.
 func (s *DashboardService) GetExport(ctx context.Context, f DashboardFilters) (*DashboardExportResponse, error) {
+	if err := checkDashboardScope(ctx, f.BranchID); err != nil {
+		return nil, err
+	}
+
 	var start, end time.Time
 	var err error
 
@@ -1181,7 +1245,7 @@ func (s *DashboardService) GetExport(ctx context.Context, f DashboardFilters) (*
 // getAllMarks trae todas las marcaciones (entry, exit, break_out, break_in) del rango sin LIMIT.
 func (s *DashboardService) getAllMarks(ctx context.Context, branchID *int, start, end time.Time) ([]DashboardLastMarkItem, error) {
 	args := []any{start, end}
-	branchWhere := buildBranchFilter("ad.branch_id", branchID, &args)
+	branchWhere := buildBranchFilter(ctx, "ad.branch_id", branchID, &args)
 
 	query := fmt.Sprintf(`
 		SELECT marking_id, user_id, name, type, marked_at, branch_id, branch_name
@@ -1265,7 +1329,7 @@ func (s *DashboardService) getAllMarks(ctx context.Context, branchID *int, start
 // getAllInsideNow trae todos los usuarios actualmente dentro, sin LIMIT.
 func (s *DashboardService) getAllInsideNow(ctx context.Context, branchID *int, start, end time.Time) ([]DashboardInsideNowItem, error) {
 	args := []any{start, end}
-	branchWhere := buildBranchFilter("ad.branch_id", branchID, &args)
+	branchWhere := buildBranchFilter(ctx, "ad.branch_id", branchID, &args)
 
 	query := fmt.Sprintf(`
 		SELECT u.id AS user_id,
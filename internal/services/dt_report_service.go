@@ -0,0 +1,593 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"back/internal/ent"
+	"back/internal/ent/attendanceday"
+	"back/internal/ent/user"
+	"back/internal/ent/userbranch"
+	"back/internal/ent/userdayoverride"
+	"back/internal/ent/usershiftassignment"
+)
+
+var (
+	ErrDTReportInvalidInput = errors.New("invalid report input")
+)
+
+// Reportes exigidos por la Dirección del Trabajo a los sistemas electrónicos de
+// registro de asistencia.
+const (
+	DTReportAttendance    = "attendance"     // asistencia diaria
+	DTReportSundayHoliday = "sunday_holiday" // domingos y festivos trabajados
+	DTReportModifications = "modifications"  // modificaciones de marcaciones
+	DTReportShiftChanges  = "shift_changes"  // modificaciones y/o alteraciones de turnos
+)
+
+type DTReportService struct {
+	Client *ent.Client
+}
+
+func NewDTReportService(client *ent.Client) *DTReportService {
+	return &DTReportService{Client: client}
+}
+
+type DTReportFilters struct {
+	Type      string
+	UserID    *int
+	BranchID  *int
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+// DTReport es un reporte tabular agrupado por trabajador, listo para exportar.
+type DTReport struct {
+	Type        string
+	Title       string
+	From        time.Time
+	To          time.Time
+	BranchName  *string
+	GeneratedAt time.Time
+	Columns     []string
+	Workers     []DTReportWorker
+}
+
+type DTReportWorker struct {
+	UserID       int
+	Name         string
+	EmployeeCode string
+	Rows         [][]string
+	// fila de totales alineada con Columns (nil si el reporte no la usa)
+	Totals []string
+}
+
+var dtReportTitles = map[string]string{
+	DTReportAttendance:    "Reporte de asistencia",
+	DTReportSundayHoliday: "Reporte de domingos y/o días festivos",
+	DTReportModifications: "Reporte de modificaciones de marcaciones",
+	DTReportShiftChanges:  "Reporte de modificaciones y/o alteraciones de turnos",
+}
+
+var dtWeekdayNames = [...]string{"Domingo", "Lunes", "Martes", "Miércoles", "Jueves", "Viernes", "Sábado"}
+
+// Generate arma el reporte solicitado para un trabajador (user_id) o para todos los
+// trabajadores de una sucursal (branch_id) en el rango de fechas.
+func (s *DTReportService) Generate(ctx context.Context, f DTReportFilters) (*DTReport, error) {
+	start := truncateToDay(f.StartDate)
+	end := truncateToDay(f.EndDate)
+	title, ok := dtReportTitles[f.Type]
+	if !ok || start.IsZero() || end.Before(start) ||
+		end.Sub(start) > maxCalendarRangeDays*24*time.Hour ||
+		(f.UserID == nil && f.BranchID == nil) {
+		return nil, ErrDTReportInvalidInput
+	}
+
+	// el supervisor o viewer solo reporta sus sucursales y a sus trabajadores
+	if f.BranchID != nil && !AccessScopeFrom(ctx).CanView(*f.BranchID) {
+		return nil, ErrOutOfScope
+	}
+	if f.UserID != nil {
+		if err := checkUserScope(ctx, s.Client, *f.UserID, false); err != nil {
+			return nil, err
+		}
+	}
+
+	report := &DTReport{
+		Type:        f.Type,
+		Title:       title,
+		From:        start,
+		To:          end,
+		GeneratedAt: time.Now(),
+	}
+
+	if f.BranchID != nil {
+		b, err := s.Client.Branch.Get(ctx, *f.BranchID)
+		if err != nil {
+			return nil, err
+		}
+		report.BranchName = &b.Name
+	}
+
+	users, err := s.reportUsers(ctx, f, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, u := range users {
+		w := DTReportWorker{
+			UserID: u.ID,
+			Name:   dtUserName(u),
+		}
+		if u.EmployeeCode != nil {
+			w.EmployeeCode = *u.EmployeeCode
+		}
+
+		switch f.Type {
+		case DTReportAttendance:
+			report.Columns = []string{"Fecha", "Día", "Jornada", "Entrada", "Salida colación", "Regreso colación", "Salida", "Atraso (min)", "Salida anticipada (min)", "Horas extra (min)", "Horas trabajadas", "Observaciones"}
+			err = s.attendanceRows(ctx, &w, f.BranchID, start, end)
+		case DTReportSundayHoliday:
+			report.Columns = []string{"Fecha", "Tipo", "Sucursal", "Entrada", "Salida", "Horas trabajadas", "Observaciones"}
+			err = s.sundayHolidayRows(ctx, &w, f.BranchID, start, end)
+		case DTReportModifications:
+			report.Columns = []string{"Fecha jornada", "Sucursal", "Entrada", "Salida colación", "Regreso colación", "Salida", "Modificado el", "Motivo"}
+			err = s.modificationRows(ctx, &w, f.BranchID, start, end)
+		case DTReportShiftChanges:
+			report.Columns = []string{"Fecha", "Tipo", "Turno", "Horario", "Detalle", "Registrado el"}
+			err = s.shiftChangeRows(ctx, &w, start, end)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		report.Workers = append(report.Workers, w)
+	}
+
+	return report, nil
+}
+
+// reportUsers devuelve el trabajador pedido o los trabajadores de la sucursal
+// (asignación activa o asistencia registrada en el rango), ordenados por nombre.
+func (s *DTReportService) reportUsers(ctx context.Context, f DTReportFilters, start, end time.Time) ([]*ent.User, error) {
+	if f.UserID != nil {
+		u, err := s.Client.User.Get(ctx, *f.UserID)
+		if err != nil {
+			return nil, err
+		}
+		return []*ent.User{u}, nil
+	}
+
+	assigned, err := s.Client.UserBranch.Query().
+		Where(userbranch.BranchIDEQ(*f.BranchID), userbranch.IsActiveEQ(true)).
+		Select(userbranch.FieldUserID).
+		Ints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attended, err := s.Client.AttendanceDay.Query().
+		Where(
+			attendanceday.BranchIDEQ(*f.BranchID),
+			attendanceday.WorkDateGTE(start),
+			attendanceday.WorkDateLT(end.AddDate(0, 0, 1)),
+		).
+		Unique(true).
+		Select(attendanceday.FieldUserID).
+		Ints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := append(assigned, attended...)
+	if len(ids) == 0 {
+		return []*ent.User{}, nil
+	}
+
+	return s.Client.User.Query().
+		Where(user.IDIn(ids...)).
+		Order(ent.Asc(user.FieldLastName), ent.Asc(user.FieldFirstName), ent.Asc(user.FieldID)).
+		All(ctx)
+}
+
+func (s *DTReportService) attendanceDays(ctx context.Context, userID int, branchID *int, start, end time.Time, extra ...func(*ent.AttendanceDayQuery)) ([]*ent.AttendanceDay, error) {
+	q := s.Client.AttendanceDay.Query().
+		Where(
+			attendanceday.UserIDEQ(userID),
+			attendanceday.WorkDateGTE(start),
+			attendanceday.WorkDateLT(end.AddDate(0, 0, 1)),
+		).
+		WithBranch()
+	if branchID != nil {
+		q = q.Where(attendanceday.BranchIDEQ(*branchID))
+	} else if scope := AccessScopeFrom(ctx); scope.Restricted() && userID != scope.UserID {
+		// reporte por trabajador: solo los días de las sucursales visibles
+		q = q.Where(attendanceday.BranchIDIn(scope.BranchIDs()...))
+	}
+	for _, fn := range extra {
+		fn(q)
+	}
+	return q.Order(ent.Asc(attendanceday.FieldWorkDate), ent.Asc(attendanceday.FieldID)).All(ctx)
+}
+
+// attendanceRows: una fila por día del rango con la jornada pactada y las marcaciones.
+func (s *DTReportService) attendanceRows(ctx context.Context, w *DTReportWorker, branchID *int, start, end time.Time) error {
+	shifts := &ShiftService{Client: s.Client}
+
+	var branchIDs []int
+	if branchID != nil {
+		branchIDs = []int{*branchID}
+	} else {
+		ids, err := s.Client.UserBranch.Query().
+			Where(userbranch.UserIDEQ(w.UserID), userbranch.IsActiveEQ(true)).
+			Select(userbranch.FieldBranchID).
+			Ints(ctx)
+		if err != nil {
+			return err
+		}
+		branchIDs = ids
+	}
+
+	calendar, err := shifts.resolveCalendar(ctx, w.UserID, branchIDs, start, end)
+	if err != nil {
+		return err
+	}
+
+	days, err := s.attendanceDays(ctx, w.UserID, branchID, start, end)
+	if err != nil {
+		return err
+	}
+	byDate := make(map[string][]*ent.AttendanceDay, len(days))
+	for _, ad := range days {
+		key := ad.WorkDate.Format("2006-01-02")
+		byDate[key] = append(byDate[key], ad)
+	}
+
+	today := truncateToDay(time.Now())
+	var totalLate, totalEarly, totalOvertime, totalWorked, attended, absences int
+
+	for _, cd := range calendar {
+		key := cd.Date.Format("2006-01-02")
+
+		jornada := dtCalendarStatusLabel(cd)
+		var notes []string
+		if cd.HolidayName != nil {
+			notes = append(notes, "Feriado: "+*cd.HolidayName)
+		}
+		if cd.LeaveType != nil {
+			notes = append(notes, "Ausencia justificada: "+*cd.LeaveType)
+		}
+
+		entries := byDate[key]
+		if len(entries) == 0 {
+			if cd.Status == CalendarStatusScheduled && cd.Date.Before(today) {
+				notes = append(notes, "Ausente")
+				absences++
+			}
+			w.Rows = append(w.Rows, []string{
+				key, dtWeekdayNames[cd.Date.Weekday()], jornada,
+				"", "", "", "", "", "", "", "", strings.Join(notes, "; "),
+			})
+			continue
+		}
+
+		for _, ad := range entries {
+			rowNotes := append([]string{}, notes...)
+			if len(entries) > 1 && ad.Edges.Branch != nil {
+				rowNotes = append(rowNotes, "Sucursal: "+ad.Edges.Branch.Name)
+			}
+			if ad.Edited {
+				reason := "Marcación modificada"
+				if ad.LastEditReason != nil {
+					reason += ": " + *ad.LastEditReason
+				}
+				rowNotes = append(rowNotes, reason)
+			}
+
+			worked := calendarWorkedMinutes(ad)
+			totalLate += intOrZero(ad.LateMinutes)
+			totalEarly += intOrZero(ad.EarlyExitMinutes)
+			totalOvertime += intOrZero(ad.OvertimeMinutes)
+			totalWorked += worked
+			if ad.WorkInAt != nil {
+				attended++
+			}
+
+			w.Rows = append(w.Rows, []string{
+				key,
+				dtWeekdayNames[cd.Date.Weekday()],
+				jornada,
+				dtClock(ad.WorkInAt),
+				dtClock(ad.BreakOutAt),
+				dtClock(ad.BreakInAt),
+				dtClock(ad.WorkOutAt),
+				dtMinutes(ad.LateMinutes),
+				dtMinutes(ad.EarlyExitMinutes),
+				dtMinutes(ad.OvertimeMinutes),
+				dtDuration(worked),
+				strings.Join(rowNotes, "; "),
+			})
+		}
+	}
+
+	w.Totals = []string{
+		"Totales", "", fmt.Sprintf("%d días asistidos / %d ausencias", attended, absences),
+		"", "", "", "",
+		fmt.Sprintf("%d", totalLate),
+		fmt.Sprintf("%d", totalEarly),
+		fmt.Sprintf("%d", totalOvertime),
+		dtDuration(totalWorked),
+		"",
+	}
+	return nil
+}
+
+// sundayHolidayRows: jornadas trabajadas en domingo o en feriado de la sucursal.
+func (s *DTReportService) sundayHolidayRows(ctx context.Context, w *DTReportWorker, branchID *int, start, end time.Time) error {
+	days, err := s.attendanceDays(ctx, w.UserID, branchID, start, end, func(q *ent.AttendanceDayQuery) {
+		q.Where(attendanceday.WorkInAtNotNil())
+	})
+	if err != nil {
+		return err
+	}
+
+	var sundays, holidays, totalWorked int
+	for _, ad := range days {
+		hol, err := findHolidayForBranch(ctx, s.Client, ad.BranchID, ad.WorkDate)
+		if err != nil {
+			return err
+		}
+		isSunday := ad.WorkDate.Weekday() == time.Sunday
+		if !isSunday && hol == nil {
+			continue
+		}
+
+		var kind []string
+		if isSunday {
+			kind = append(kind, "Domingo")
+			sundays++
+		}
+		if hol != nil {
+			kind = append(kind, "Feriado: "+hol.Name)
+			holidays++
+		}
+
+		var notes string
+		if ad.Edited {
+			notes = "Marcación modificada"
+		}
+
+		worked := calendarWorkedMinutes(ad)
+		totalWorked += worked
+
+		w.Rows = append(w.Rows, []string{
+			ad.WorkDate.Format("2006-01-02"),
+			strings.Join(kind, " / "),
+			dtBranchName(ad),
+			dtClock(ad.WorkInAt),
+			dtClock(ad.WorkOutAt),
+			dtDuration(worked),
+			notes,
+		})
+	}
+
+	w.Totals = []string{
+		"Totales", fmt.Sprintf("%d domingos / %d feriados", sundays, holidays),
+		"", "", "", dtDuration(totalWorked), "",
+	}
+	return nil
+}
+
+// modificationRows: jornadas con marcaciones modificadas manualmente y su motivo.
+func (s *DTReportService) modificationRows(ctx context.Context, w *DTReportWorker, branchID *int, start, end time.Time) error {
+	days, err := s.attendanceDays(ctx, w.UserID, branchID, start, end, func(q *ent.AttendanceDayQuery) {
+		q.Where(attendanceday.EditedEQ(true))
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, ad := range days {
+		editedAt := ""
+		if ad.EditedAt != nil {
+			editedAt = ad.EditedAt.Format("2006-01-02 15:04")
+		}
+		reason := ""
+		if ad.LastEditReason != nil {
+			reason = *ad.LastEditReason
+		}
+		w.Rows = append(w.Rows, []string{
+			ad.WorkDate.Format("2006-01-02"),
+			dtBranchName(ad),
+			dtClock(ad.WorkInAt),
+			dtClock(ad.BreakOutAt),
+			dtClock(ad.BreakInAt),
+			dtClock(ad.WorkOutAt),
+			editedAt,
+			reason,
+		})
+	}
+	return nil
+}
+
+// shiftChangeRows: asignaciones de turno que comienzan o terminan en el rango y
+// cambios puntuales de jornada (overrides) del período.
+func (s *DTReportService) shiftChangeRows(ctx context.Context, w *DTReportWorker, start, end time.Time) error {
+	endExclusive := end.AddDate(0, 0, 1)
+
+	assignments, err := s.Client.UserShiftAssignment.Query().
+		Where(
+			usershiftassignment.UserIDEQ(w.UserID),
+			usershiftassignment.Or(
+				usershiftassignment.And(
+					usershiftassignment.StartDateGTE(start),
+					usershiftassignment.StartDateLT(endExclusive),
+				),
+				usershiftassignment.And(
+					usershiftassignment.EndDateGTE(start),
+					usershiftassignment.EndDateLT(endExclusive),
+				),
+			),
+		).
+		WithShift().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	overrides, err := s.Client.UserDayOverride.Query().
+		Where(
+			userdayoverride.UserIDEQ(w.UserID),
+			userdayoverride.DateGTE(start),
+			userdayoverride.DateLT(endExclusive),
+		).
+		WithShift().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	type changeRow struct {
+		date time.Time
+		row  []string
+	}
+	var rows []changeRow
+
+	for _, a := range assignments {
+		name, schedule := dtShiftLabel(a.Edges.Shift)
+		registered := a.CreatedAt.Format("2006-01-02 15:04")
+
+		startDate := truncateToDay(a.StartDate)
+		if !startDate.Before(start) && startDate.Before(endExclusive) {
+			detail := "Vigente desde " + startDate.Format("2006-01-02")
+			if a.EndDate != nil {
+				detail += " hasta " + a.EndDate.Format("2006-01-02")
+			}
+			if !a.IsActive {
+				detail += " (inactiva)"
+			}
+			rows = append(rows, changeRow{startDate, []string{
+				startDate.Format("2006-01-02"), "Inicio de turno", name, schedule, detail, registered,
+			}})
+		}
+		if a.EndDate != nil {
+			endDate := truncateToDay(*a.EndDate)
+			if !endDate.Before(start) && endDate.Before(endExclusive) {
+				rows = append(rows, changeRow{endDate, []string{
+					endDate.Format("2006-01-02"), "Término de turno", name, schedule,
+					"Vigente desde " + startDate.Format("2006-01-02"), registered,
+				}})
+			}
+		}
+	}
+
+	for _, o := range overrides {
+		date := truncateToDay(o.Date)
+		kind := "Cambio de jornada"
+		name, schedule := dtShiftLabel(o.Edges.Shift)
+		var detail []string
+		if o.IsDayOff {
+			kind = "Día libre"
+		} else {
+			detail = append(detail, "Modalidad: "+o.Mode)
+		}
+		if o.Notes != nil && strings.TrimSpace(*o.Notes) != "" {
+			detail = append(detail, *o.Notes)
+		}
+		rows = append(rows, changeRow{date, []string{
+			date.Format("2006-01-02"), kind, name, schedule,
+			strings.Join(detail, "; "), o.CreatedAt.Format("2006-01-02 15:04"),
+		}})
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool { return rows[i].date.Before(rows[j].date) })
+	for _, r := range rows {
+		w.Rows = append(w.Rows, r.row)
+	}
+	return nil
+}
+
+/* =========================
+   HELPERS
+   ========================= */
+
+func dtUserName(u *ent.User) string {
+	var parts []string
+	for _, p := range []*string{u.FirstName, u.MiddleName, u.LastName} {
+		if p != nil && strings.TrimSpace(*p) != "" {
+			parts = append(parts, strings.TrimSpace(*p))
+		}
+	}
+	if len(parts) == 0 {
+		return u.Username
+	}
+	return strings.Join(parts, " ")
+}
+
+func dtCalendarStatusLabel(cd CalendarDay) string {
+	switch cd.Status {
+	case CalendarStatusScheduled, CalendarStatusHoliday, CalendarStatusLeave:
+		if cd.StartTime != nil && cd.EndTime != nil {
+			label := *cd.StartTime + "-" + *cd.EndTime
+			if cd.Status == CalendarStatusHoliday {
+				label += " (feriado)"
+			}
+			if cd.Status == CalendarStatusLeave {
+				label += " (ausencia)"
+			}
+			return label
+		}
+		return "Programada"
+	case CalendarStatusRest:
+		return "Descanso"
+	case CalendarStatusDayOff:
+		return "Día libre"
+	default:
+		return "Sin turno"
+	}
+}
+
+func dtShiftLabel(sh *ent.Shift) (string, string) {
+	if sh == nil {
+		return "", ""
+	}
+	return sh.Name, sh.StartTime + "-" + sh.EndTime
+}
+
+func dtBranchName(ad *ent.AttendanceDay) string {
+	if ad.Edges.Branch != nil {
+		return ad.Edges.Branch.Name
+	}
+	return ""
+}
+
+func dtClock(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("15:04")
+}
+
+func dtMinutes(v *int) string {
+	if v == nil || *v == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d", *v)
+}
+
+func dtDuration(minutes int) string {
+	if minutes <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d:%02d", minutes/60, minutes%60)
+}
+
+func intOrZero(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
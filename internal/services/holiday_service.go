@@ -0,0 +1,539 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"back/internal/ent"
+	"back/internal/ent/branch"
+	"back/internal/ent/branchaddress"
+	"back/internal/ent/holiday"
+	"back/internal/ent/predicate"
+	"back/internal/ent/region"
+)
+
+var ErrHolidayInvalidInput = errors.New("invalid holiday input")
+
+const (
+	HolidayScopeNational = "national"
+	HolidayScopeRegion   = "region"
+	HolidayScopeBranch   = "branch"
+)
+
+type HolidayService struct {
+	Client *ent.Client
+}
+
+func NewHolidayService(client *ent.Client) *HolidayService {
+	return &HolidayService{Client: client}
+}
+
+type HolidayFilters struct {
+	Year     *int
+	Scope    *string
+	RegionID *int
+	BranchID *int
+}
+
+type CreateHolidayInput struct {
+	Date          time.Time
+	Name          string
+	Scope         string // "national" | "region" | "branch"
+	RegionID      *int
+	BranchID      *int
+	Irrenunciable *bool
+}
+
+type PatchHolidayInput struct {
+	Date          *time.Time
+	Name          *string
+	Scope         *string
+	RegionID      *int
+	BranchID      *int
+	Irrenunciable *bool
+}
+
+type HolidayImportResult struct {
+	Year     int
+	Created  int
+	Skipped  int
+	Holidays []*ent.Holiday
+}
+
+func (s *HolidayService) List(ctx context.Context, f HolidayFilters) ([]*ent.Holiday, error) {
+	q := s.Client.Holiday.Query()
+
+	if f.Year != nil {
+		start := time.Date(*f.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		q = q.Where(holiday.DateGTE(start), holiday.DateLT(start.AddDate(1, 0, 0)))
+	}
+	if f.Scope != nil {
+		q = q.Where(holiday.ScopeEQ(strings.ToLower(strings.TrimSpace(*f.Scope))))
+	}
+	if f.RegionID != nil {
+		q = q.Where(holiday.RegionIDEQ(*f.RegionID))
+	}
+	if f.BranchID != nil {
+		q = q.Where(holiday.BranchIDEQ(*f.BranchID))
+	}
+
+	return q.Order(ent.Asc(holiday.FieldDate), ent.Asc(holiday.FieldName)).All(ctx)
+}
+
+func (s *HolidayService) Create(ctx context.Context, in CreateHolidayInput) (*ent.Holiday, error) {
+	in.Name = strings.TrimSpace(in.Name)
+	in.Scope = strings.ToLower(strings.TrimSpace(in.Scope))
+	if in.Scope == "" {
+		in.Scope = HolidayScopeNational
+	}
+
+	if in.Date.IsZero() || in.Name == "" {
+		return nil, ErrHolidayInvalidInput
+	}
+	if err := s.validateScope(ctx, in.Scope, in.RegionID, in.BranchID); err != nil {
+		return nil, err
+	}
+
+	create := s.Client.Holiday.Create().
+		SetDate(truncateToDay(in.Date)).
+		SetName(in.Name).
+		SetScope(in.Scope).
+		SetNillableRegionID(in.RegionID).
+		SetNillableBranchID(in.BranchID)
+
+	if in.Irrenunciable != nil {
+		create.SetIrrenunciable(*in.Irrenunciable)
+	}
+
+	return create.Save(ctx)
+}
+
+func (s *HolidayService) Patch(ctx context.Context, id int, in PatchHolidayInput) (*ent.Holiday, error) {
+	if id <= 0 {
+		return nil, ErrHolidayInvalidInput
+	}
+	if in.Date == nil &&
+		in.Name == nil &&
+		in.Scope == nil &&
+		in.RegionID == nil &&
+		in.BranchID == nil &&
+		in.Irrenunciable == nil {
+		return nil, ErrHolidayInvalidInput
+	}
+
+	h, err := s.Client.Holiday.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	upd := h.Update()
+
+	if in.Date != nil {
+		if in.Date.IsZero() {
+			return nil, ErrHolidayInvalidInput
+		}
+		upd.SetDate(truncateToDay(*in.Date))
+	}
+	if in.Name != nil {
+		n := strings.TrimSpace(*in.Name)
+		if n == "" {
+			return nil, ErrHolidayInvalidInput
+		}
+		upd.SetName(n)
+	}
+
+	// el alcance se valida con el estado final (lo que viene + lo que ya existía)
+	scope := h.Scope
+	if in.Scope != nil {
+		scope = strings.ToLower(strings.TrimSpace(*in.Scope))
+	}
+	regionID := h.RegionID
+	if in.RegionID != nil {
+		regionID = in.RegionID
+	}
+	branchID := h.BranchID
+	if in.BranchID != nil {
+		branchID = in.BranchID
+	}
+	switch scope {
+	case HolidayScopeNational:
+		regionID, branchID = nil, nil
+	case HolidayScopeRegion:
+		branchID = nil
+	case HolidayScopeBranch:
+		regionID = nil
+	}
+	if err := s.validateScope(ctx, scope, regionID, branchID); err != nil {
+		return nil, err
+	}
+
+	upd.SetScope(scope)
+	if regionID != nil {
+		upd.SetRegionID(*regionID)
+	} else {
+		upd.ClearRegionID()
+	}
+	if branchID != nil {
+		upd.SetBranchID(*branchID)
+	} else {
+		upd.ClearBranchID()
+	}
+
+	if in.Irrenunciable != nil {
+		upd.SetIrrenunciable(*in.Irrenunciable)
+	}
+
+	return upd.Save(ctx)
+}
+
+func (s *HolidayService) Delete(ctx context.Context, id int) error {
+	if id <= 0 {
+		return ErrHolidayInvalidInput
+	}
+	return s.Client.Holiday.DeleteOneID(id).Exec(ctx)
+}
+
+// ImportChileanHolidays crea los feriados nacionales de Chile para el año indicado.
+// Es idempotente: las fechas que ya tienen un feriado nacional se omiten.
+// Los feriados regionales (ej: 7 de junio en Arica) deben crearse manualmente.
+func (s *HolidayService) ImportChileanHolidays(ctx context.Context, year int) (*HolidayImportResult, error) {
+	if year < 2000 || year > 2100 {
+		return nil, ErrHolidayInvalidInput
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	existing, err := tx.Holiday.Query().
+		Where(
+			holiday.ScopeEQ(HolidayScopeNational),
+			holiday.DateGTE(start),
+			holiday.DateLT(start.AddDate(1, 0, 0)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	taken := make(map[string]bool, len(existing))
+	for _, h := range existing {
+		taken[h.Date.Format("2006-01-02")] = true
+	}
+
+	result := &HolidayImportResult{Year: year, Holidays: make([]*ent.Holiday, 0)}
+	for _, ch := range chileanHolidays(year) {
+		key := ch.Date.Format("2006-01-02")
+		if taken[key] {
+			result.Skipped++
+			continue
+		}
+
+		h, err := tx.Holiday.Create().
+			SetDate(ch.Date).
+			SetName(ch.Name).
+			SetScope(HolidayScopeNational).
+			SetIrrenunciable(ch.Irrenunciable).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		taken[key] = true
+		result.Created++
+		result.Holidays = append(result.Holidays, h)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *HolidayService) validateScope(ctx context.Context, scope string, regionID, branchID *int) error {
+	switch scope {
+	case HolidayScopeNational:
+		if regionID != nil || branchID != nil {
+			return ErrHolidayInvalidInput
+		}
+	case HolidayScopeRegion:
+		if regionID == nil || *regionID <= 0 || branchID != nil {
+			return ErrHolidayInvalidInput
+		}
+		exists, err := s.Client.Region.Query().Where(region.IDEQ(*regionID)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrHolidayInvalidInput
+		}
+	case HolidayScopeBranch:
+		if branchID == nil || *branchID <= 0 || regionID != nil {
+			return ErrHolidayInvalidInput
+		}
+		exists, err := s.Client.Branch.Query().Where(branch.IDEQ(*branchID)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrHolidayInvalidInput
+		}
+	default:
+		return ErrHolidayInvalidInput
+	}
+	return nil
+}
+
+/* =========================
+   RESOLUCIÓN (uso interno)
+   ========================= */
+
+// branchRegionID devuelve la región de la sucursal según su dirección (nil si no tiene).
+func branchRegionID(ctx context.Context, client *ent.Client, branchID int) (*int, error) {
+	id, err := client.BranchAddress.Query().
+		Where(branchaddress.BranchIDEQ(branchID)).
+		QueryCommune().
+		QueryCity().
+		QueryRegion().
+		OnlyID(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &id, nil
+}
+
+// holidayAppliesToBranch arma el predicado de feriados que aplican a una sucursal:
+// nacionales, los de su región y los propios de la sucursal.
+func holidayAppliesToBranch(branchID int, regionID *int) predicate.Holiday {
+	preds := []predicate.Holiday{
+		holiday.ScopeEQ(HolidayScopeNational),
+		holiday.And(holiday.ScopeEQ(HolidayScopeBranch), holiday.BranchIDEQ(branchID)),
+	}
+	if regionID != nil {
+		preds = append(preds, holiday.And(holiday.ScopeEQ(HolidayScopeRegion), holiday.RegionIDEQ(*regionID)))
+	}
+	return holiday.Or(preds...)
+}
+
+// findHolidayForBranch devuelve el feriado que aplica a la sucursal en la fecha dada (nil si no hay).
+func findHolidayForBranch(ctx context.Context, client *ent.Client, branchID int, date time.Time) (*ent.Holiday, error) {
+	regionID, err := branchRegionID(ctx, client, branchID)
+	if err != nil {
+		return nil, err
+	}
+
+	day := truncateToDay(date)
+	h, err := client.Holiday.Query().
+		Where(
+			holiday.DateGTE(day),
+			holiday.DateLT(day.AddDate(0, 0, 1)),
+			holidayAppliesToBranch(branchID, regionID),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return h, nil
+}
+
+// holidaysByDateForBranches devuelve los feriados del rango que aplican a alguna de las
+// sucursales indicadas (siempre incluye los nacionales), indexados por "YYYY-MM-DD".
+func holidaysByDateForBranches(ctx context.Context, client *ent.Client, branchIDs []int, start, end time.Time) (map[string]*ent.Holiday, error) {
+	preds := []predicate.Holiday{holiday.ScopeEQ(HolidayScopeNational)}
+	for _, bID := range branchIDs {
+		regionID, err := branchRegionID(ctx, client, bID)
+		if err != nil {
+			return nil, err
+		}
+		preds = append(preds, holidayAppliesToBranch(bID, regionID))
+	}
+
+	items, err := client.Holiday.Query().
+		Where(
+			holiday.DateGTE(truncateToDay(start)),
+			holiday.DateLT(truncateToDay(end).AddDate(0, 0, 1)),
+			holiday.Or(preds...),
+		).
+		Order(ent.Asc(holiday.FieldDate)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]*ent.Holiday, len(items))
+	for _, h := range items {
+		key := h.Date.Format("2006-01-02")
+		if _, ok := out[key]; !ok {
+			out[key] = h
+		}
+	}
+	return out, nil
+}
+
+// holidaySQLCondition devuelve una condición SQL (EXISTS) que es verdadera cuando la fecha
+// dateExpr es feriado para la sucursal branchExpr (nacional, regional o de sucursal).
+func holidaySQLCondition(dateExpr, branchExpr string) string {
+	return fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM holidays hol
+			WHERE hol.date = (%[1]s)::date
+			  AND (
+				hol.scope = 'national'
+				OR (hol.scope = 'branch' AND hol.branch_id = %[2]s)
+				OR (hol.scope = 'region' AND hol.region_id = (
+					SELECT ci.region_cities
+					FROM branch_addresses ba
+					JOIN communes co ON co.id = ba.commune_id
+					JOIN cities ci ON ci.id = co.city_communes
+					WHERE ba.branch_id = %[2]s
+				))
+			  )
+		)`, dateExpr, branchExpr)
+}
+
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+/* =========================
+   FERIADOS CHILE
+   ========================= */
+
+type chileanHoliday struct {
+	Date          time.Time
+	Name          string
+	Irrenunciable bool
+}
+
+// chileanHolidays calcula los feriados nacionales de Chile para un año
+// (fijos, Semana Santa y los trasladables por Ley 19.668 / 20.299 / 20.983). Cada
+// feriado creado por ley se incluye solo desde el año en que rige esa ley; los
+// feriados puntuales declarados por leyes de un solo año no se incluyen.
+func chileanHolidays(year int) []chileanHoliday {
+	d := func(m time.Month, day int) time.Time {
+		return time.Date(year, m, day, 0, 0, 0, 0, time.UTC)
+	}
+	easter := easterSunday(year)
+
+	out := []chileanHoliday{
+		{Date: d(time.January, 1), Name: "Año Nuevo", Irrenunciable: true},
+		{Date: easter.AddDate(0, 0, -2), Name: "Viernes Santo"},
+		{Date: easter.AddDate(0, 0, -1), Name: "Sábado Santo"},
+		{Date: d(time.May, 1), Name: "Día Nacional del Trabajo", Irrenunciable: true},
+		{Date: d(time.May, 21), Name: "Día de las Glorias Navales"},
+	}
+
+	// Ley 21.357 (2021): día del solsticio de invierno; en 2021 se fijó el 21 de junio
+	switch {
+	case year == 2021:
+		out = append(out, chileanHoliday{Date: d(time.June, 21), Name: "Día Nacional de los Pueblos Indígenas"})
+	case year > 2021:
+		out = append(out, chileanHoliday{Date: juneSolsticeDate(year), Name: "Día Nacional de los Pueblos Indígenas"})
+	}
+
+	out = append(out, chileanHoliday{Date: movedToMonday(d(time.June, 29)), Name: "San Pedro y San Pablo"})
+	// Ley 20.148 (2007)
+	if year >= 2007 {
+		out = append(out, chileanHoliday{Date: d(time.July, 16), Name: "Día de la Virgen del Carmen"})
+	}
+	out = append(out, chileanHoliday{Date: d(time.August, 15), Name: "Asunción de la Virgen"})
+
+	// 17 de septiembre es feriado cuando cae lunes (Ley 20.983, desde 2017)
+	if year >= 2017 && d(time.September, 17).Weekday() == time.Monday {
+		out = append(out, chileanHoliday{Date: d(time.September, 17), Name: "Fiestas Patrias"})
+	}
+	out = append(out,
+		chileanHoliday{Date: d(time.September, 18), Name: "Independencia Nacional", Irrenunciable: true},
+		chileanHoliday{Date: d(time.September, 19), Name: "Día de las Glorias del Ejército", Irrenunciable: true},
+	)
+	// 20 de septiembre es feriado cuando cae viernes (Ley 20.215, desde 2007)
+	if year >= 2007 && d(time.September, 20).Weekday() == time.Friday {
+		out = append(out, chileanHoliday{Date: d(time.September, 20), Name: "Fiestas Patrias"})
+	}
+
+	out = append(out, chileanHoliday{Date: movedToMonday(d(time.October, 12)), Name: "Encuentro de Dos Mundos"})
+	// Ley 20.299 (2008)
+	if year >= 2008 {
+		out = append(out, chileanHoliday{Date: evangelicalChurchesDay(year), Name: "Día de las Iglesias Evangélicas y Protestantes"})
+	}
+	out = append(out,
+		chileanHoliday{Date: d(time.November, 1), Name: "Día de Todos los Santos"},
+		chileanHoliday{Date: d(time.December, 8), Name: "Inmaculada Concepción"},
+		chileanHoliday{Date: d(time.December, 25), Name: "Navidad", Irrenunciable: true},
+	)
+
+	return out
+}
+
+// easterSunday calcula el domingo de Pascua (algoritmo de Meeus/Jones/Butcher).
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := ((h + l - 7*m + 114) % 31) + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// movedToMonday aplica la Ley 19.668: si cae martes, miércoles o jueves se traslada al lunes
+// de esa semana; si cae viernes, al lunes siguiente.
+func movedToMonday(t time.Time) time.Time {
+	switch t.Weekday() {
+	case time.Tuesday:
+		return t.AddDate(0, 0, -1)
+	case time.Wednesday:
+		return t.AddDate(0, 0, -2)
+	case time.Thursday:
+		return t.AddDate(0, 0, -3)
+	case time.Friday:
+		return t.AddDate(0, 0, 3)
+	default:
+		return t
+	}
+}
+
+// evangelicalChurchesDay aplica la Ley 20.299: el 31 de octubre se traslada al viernes
+// anterior si cae martes, o al viernes siguiente si cae miércoles.
+func evangelicalChurchesDay(year int) time.Time {
+	t := time.Date(year, time.October, 31, 0, 0, 0, 0, time.UTC)
+	switch t.Weekday() {
+	case time.Tuesday:
+		return t.AddDate(0, 0, -4)
+	case time.Wednesday:
+		return t.AddDate(0, 0, 2)
+	default:
+		return t
+	}
+}
+
+// juneSolsticeDate aproxima el día del solsticio de invierno austral en hora de Chile
+// continental (UTC-4), usando la fórmula del solsticio medio de Meeus.
+func juneSolsticeDate(year int) time.Time {
+	y := (float64(year) - 2000) / 1000
+	jde := 2451716.56767 + 365241.62603*y + 0.00325*y*y + 0.00888*y*y*y - 0.00030*y*y*y*y
+
+	// día juliano → tiempo unix
+	secs := (jde - 2440587.5) * 86400
+	t := time.Unix(int64(secs), 0).UTC().Add(-4 * time.Hour)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEasterSunday(t *testing.T) {
+	tests := []struct {
+		year int
+		want string
+	}{
+		{2000, "2000-04-23"},
+		{2008, "2008-03-23"},
+		{2011, "2011-04-24"},
+		{2019, "2019-04-21"},
+		{2024, "2024-03-31"},
+		{2025, "2025-04-20"},
+		{2026, "2026-04-05"},
+		{2038, "2038-04-25"},
+	}
+
+	for _, tt := range tests {
+		got := easterSunday(tt.year).Format("2006-01-02")
+		if got != tt.want {
+			t.Errorf("easterSunday(%d) = %s, want %s", tt.year, got, tt.want)
+		}
+	}
+}
+
+func TestChileanHolidays(t *testing.T) {
+	tests := []struct {
+		name   string
+		year   int
+		date   string
+		want   string // nombre esperado; "" = la fecha no debe ser feriado
+		irrenu bool
+	}{
+		{"año nuevo", 2025, "2025-01-01", "Año Nuevo", true},
+		{"viernes santo", 2025, "2025-04-18", "Viernes Santo", false},
+		{"sábado santo", 2024, "2024-03-30", "Sábado Santo", false},
+		{"pueblos indígenas no existe antes de 2021", 2020, "2020-06-20", "", false},
+		{"pueblos indígenas 2021 fijado por ley", 2021, "2021-06-21", "Día Nacional de los Pueblos Indígenas", false},
+		{"pueblos indígenas 2021 no usa el solsticio", 2021, "2021-06-20", "", false},
+		{"pueblos indígenas 2022", 2022, "2022-06-21", "Día Nacional de los Pueblos Indígenas", false},
+		{"pueblos indígenas 2024", 2024, "2024-06-20", "Día Nacional de los Pueblos Indígenas", false},
+		{"san pedro en jueves pasa al lunes anterior", 2023, "2023-06-26", "San Pedro y San Pablo", false},
+		{"san pedro en jueves no queda en su fecha", 2023, "2023-06-29", "", false},
+		{"san pedro en viernes pasa al lunes siguiente", 2018, "2018-07-02", "San Pedro y San Pablo", false},
+		{"san pedro en sábado no se traslada", 2024, "2024-06-29", "San Pedro y San Pablo", false},
+		{"virgen del carmen no existe antes de 2007", 2006, "2006-07-16", "", false},
+		{"virgen del carmen 2007", 2007, "2007-07-16", "Día de la Virgen del Carmen", false},
+		{"17 de septiembre en lunes antes de 2017 no es feriado", 2012, "2012-09-17", "", false},
+		{"17 de septiembre en lunes", 2018, "2018-09-17", "Fiestas Patrias", false},
+		{"17 de septiembre en martes no es feriado", 2024, "2024-09-17", "", false},
+		{"18 de septiembre", 2025, "2025-09-18", "Independencia Nacional", true},
+		{"20 de septiembre en viernes", 2024, "2024-09-20", "Fiestas Patrias", false},
+		{"20 de septiembre en sábado no es feriado", 2025, "2025-09-20", "", false},
+		{"20 de septiembre en viernes antes de 2007 no es feriado", 2002, "2002-09-20", "", false},
+		{"12 de octubre en jueves pasa al lunes", 2023, "2023-10-09", "Encuentro de Dos Mundos", false},
+		{"iglesias evangélicas en martes pasa al viernes anterior", 2023, "2023-10-27", "Día de las Iglesias Evangélicas y Protestantes", false},
+		{"iglesias evangélicas en miércoles pasa al viernes siguiente", 2018, "2018-11-02", "Día de las Iglesias Evangélicas y Protestantes", false},
+		{"iglesias evangélicas no existe antes de 2008", 2007, "2007-11-02", "", false},
+		{"iglesias evangélicas 2008", 2008, "2008-10-31", "Día de las Iglesias Evangélicas y Protestantes", false},
+		{"navidad", 2025, "2025-12-25", "Navidad", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var found *chileanHoliday
+			for _, h := range chileanHolidays(tt.year) {
+				if h.Date.Format("2006-01-02") == tt.date {
+					found = &h
+					break
+				}
+			}
+
+			if tt.want == "" {
+				if found != nil {
+					t.Fatalf("%s no debería ser feriado, es %q", tt.date, found.Name)
+				}
+				return
+			}
+			if found == nil {
+				t.Fatalf("%s debería ser feriado (%s)", tt.date, tt.want)
+			}
+			if found.Name != tt.want {
+				t.Errorf("%s: nombre = %q, want %q", tt.date, found.Name, tt.want)
+			}
+			if found.Irrenunciable != tt.irrenu {
+				t.Errorf("%s: irrenunciable = %v, want %v", tt.date, found.Irrenunciable, tt.irrenu)
+			}
+		})
+	}
+}
+
+func TestChileanHolidaysUniqueDates(t *testing.T) {
+	for year := 2000; year <= 2035; year++ {
+		seen := map[time.Time]string{}
+		for _, h := range chileanHolidays(year) {
+			if h.Date.Year() != year {
+				t.Errorf("%d: %q cae en %s", year, h.Name, h.Date.Format("2006-01-02"))
+			}
+			if prev, ok := seen[h.Date]; ok {
+				t.Errorf("%d: %q y %q caen el mismo día %s", year, prev, h.Name, h.Date.Format("2006-01-02"))
+			}
+			seen[h.Date] = h.Name
+		}
+	}
+}
@@ -0,0 +1,330 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"back/internal/ent"
+	"back/internal/ent/hourbankmovement"
+)
+
+var (
+	ErrHourBankInvalidInput = errors.New("invalid hour bank input")
+)
+
+const (
+	HourBankKindDaily  = "daily"
+	HourBankKindCredit = "credit"
+	HourBankKindDebit  = "debit"
+	// calculado al armar el libro (no se persiste)
+	HourBankKindExpiry = "expiry"
+)
+
+type HourBankService struct {
+	Client *ent.Client
+	// días tras los cuales vencen las horas acumuladas (0 = no vencen)
+	ExpiryDays int
+}
+
+func NewHourBankService(client *ent.Client, expiryDays int) *HourBankService {
+	return &HourBankService{Client: client, ExpiryDays: expiryDays}
+}
+
+// HourBankEntry es un movimiento del libro de la bolsa de horas.
+type HourBankEntry struct {
+	Date            time.Time
+	Kind            string // daily | credit | debit | expiry
+	Minutes         int
+	Balance         int
+	Reason          *string
+	MovementID      *int
+	AttendanceDayID *int
+}
+
+type HourBankLedger struct {
+	UserID         int
+	From           time.Time
+	To             time.Time
+	ExpiryDays     int
+	OpeningBalance int
+	ClosingBalance int
+	// totales del período
+	Earned  int
+	Used    int
+	Expired int
+	Entries []HourBankEntry
+}
+
+type CreateHourBankMovementInput struct {
+	Date    *time.Time // por defecto hoy
+	Kind    string     // credit | debit
+	Minutes int        // siempre positivo; el signo lo da kind
+	Reason  string
+}
+
+// hourBankLot es un saldo positivo pendiente de uso, con su fecha de vencimiento.
+type hourBankLot struct {
+	expiresOn time.Time
+	remaining int
+}
+
+// Ledger devuelve los movimientos de la bolsa de horas entre from y to con el saldo
+// acumulado. Los saldos positivos se consumen en orden FIFO y, si hay vencimiento
+// configurado, lo no usado vence ExpiryDays días después de haberse generado.
+// Por defecto cubre el mes en curso hasta hoy.
+func (s *HourBankService) Ledger(ctx context.Context, userID int, from, to *time.Time) (*HourBankLedger, error) {
+	if userID <= 0 {
+		return nil, ErrHourBankInvalidInput
+	}
+	if _, err := s.Client.User.Get(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	today := truncateToDay(time.Now())
+	end := today
+	if to != nil {
+		end = truncateToDay(*to)
+	}
+	start := time.Date(end.Year(), end.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if from != nil {
+		start = truncateToDay(*from)
+	}
+	if end.Before(start) {
+		return nil, ErrHourBankInvalidInput
+	}
+
+	movements, err := s.Client.HourBankMovement.Query().
+		Where(
+			hourbankmovement.UserIDEQ(userID),
+			hourbankmovement.DateLT(end.AddDate(0, 0, 1)),
+		).
+		Order(ent.Asc(hourbankmovement.FieldDate), ent.Asc(hourbankmovement.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ledger := &HourBankLedger{
+		UserID:     userID,
+		From:       start,
+		To:         end,
+		ExpiryDays: s.ExpiryDays,
+		Entries:    []HourBankEntry{},
+	}
+
+	var (
+		lots    []hourBankLot
+		deficit int // saldo negativo arrastrado (se cubre con los próximos abonos)
+		balance int
+	)
+
+	record := func(e HourBankEntry) {
+		balance += e.Minutes
+		e.Balance = balance
+		if e.Date.Before(start) {
+			ledger.OpeningBalance = balance
+			return
+		}
+		switch {
+		case e.Kind == HourBankKindExpiry:
+			ledger.Expired += -e.Minutes
+		case e.Minutes > 0:
+			ledger.Earned += e.Minutes
+		default:
+			ledger.Used += -e.Minutes
+		}
+		ledger.Entries = append(ledger.Entries, e)
+	}
+
+	// vence los saldos cuya fecha de vencimiento es <= until
+	expireUntil := func(until time.Time) {
+		for len(lots) > 0 && !lots[0].expiresOn.After(until) {
+			lot := lots[0]
+			lots = lots[1:]
+			if lot.remaining <= 0 {
+				continue
+			}
+			reason := "Vencimiento de horas acumuladas"
+			record(HourBankEntry{
+				Date:    lot.expiresOn,
+				Kind:    HourBankKindExpiry,
+				Minutes: -lot.remaining,
+				Reason:  &reason,
+			})
+		}
+	}
+
+	for _, m := range movements {
+		date := truncateToDay(m.Date)
+		if s.ExpiryDays > 0 {
+			expireUntil(date)
+		}
+
+		if m.Minutes > 0 {
+			amount := m.Minutes
+			if deficit > 0 {
+				paid := min(amount, deficit)
+				deficit -= paid
+				amount -= paid
+			}
+			if amount > 0 {
+				lots = append(lots, hourBankLot{
+					expiresOn: date.AddDate(0, 0, s.ExpiryDays),
+					remaining: amount,
+				})
+			}
+		} else {
+			need := -m.Minutes
+			for i := range lots {
+				if need == 0 {
+					break
+				}
+				used := min(lots[i].remaining, need)
+				lots[i].remaining -= used
+				need -= used
+			}
+			deficit += need
+		}
+
+		id := m.ID
+		record(HourBankEntry{
+			Date:            date,
+			Kind:            m.Kind,
+			Minutes:         m.Minutes,
+			Reason:          m.Reason,
+			MovementID:      &id,
+			AttendanceDayID: m.AttendanceDayID,
+		})
+	}
+	if s.ExpiryDays > 0 {
+		expireUntil(end)
+	}
+
+	ledger.ClosingBalance = balance
+	return ledger, nil
+}
+
+// ListMovements devuelve los movimientos persistidos (diarios y manuales) del usuario.
+func (s *HourBankService) ListMovements(ctx context.Context, userID int, from, to *time.Time) ([]*ent.HourBankMovement, error) {
+	if userID <= 0 {
+		return nil, ErrHourBankInvalidInput
+	}
+	q := s.Client.HourBankMovement.Query().
+		Where(hourbankmovement.UserIDEQ(userID))
+	if from != nil {
+		q = q.Where(hourbankmovement.DateGTE(truncateToDay(*from)))
+	}
+	if to != nil {
+		q = q.Where(hourbankmovement.DateLTE(truncateToDay(*to)))
+	}
+	return q.Order(ent.Desc(hourbankmovement.FieldDate), ent.Desc(hourbankmovement.FieldID)).All(ctx)
+}
+
+// CreateMovement registra un abono (credit) o cargo (debit) manual con motivo.
+func (s *HourBankService) CreateMovement(ctx context.Context, userID, createdByID int, in CreateHourBankMovementInput) (*ent.HourBankMovement, error) {
+	reason := strings.TrimSpace(in.Reason)
+	kind := strings.ToLower(strings.TrimSpace(in.Kind))
+	if userID <= 0 || in.Minutes <= 0 || reason == "" {
+		return nil, ErrHourBankInvalidInput
+	}
+
+	minutes := in.Minutes
+	switch kind {
+	case HourBankKindCredit:
+	case HourBankKindDebit:
+		minutes = -minutes
+	default:
+		return nil, ErrHourBankInvalidInput
+	}
+
+	if _, err := s.Client.User.Get(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	date := truncateToDay(time.Now())
+	if in.Date != nil {
+		date = truncateToDay(*in.Date)
+	}
+
+	create := s.Client.HourBankMovement.Create().
+		SetUserID(userID).
+		SetDate(date).
+		SetKind(kind).
+		SetMinutes(minutes).
+		SetReason(reason)
+	if createdByID > 0 {
+		create.SetCreatedByID(createdByID)
+	}
+	return create.Save(ctx)
+}
+
+// DeleteMovement elimina un movimiento manual. Los movimientos diarios se mantienen
+// sincronizados con la asistencia y no se pueden borrar a mano.
+func (s *HourBankService) DeleteMovement(ctx context.Context, userID, movementID int) error {
+	if userID <= 0 || movementID <= 0 {
+		return ErrHourBankInvalidInput
+	}
+	m, err := s.Client.HourBankMovement.Query().
+		Where(
+			hourbankmovement.IDEQ(movementID),
+			hourbankmovement.UserIDEQ(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	if m.Kind == HourBankKindDaily {
+		return ErrHourBankInvalidInput
+	}
+	return s.Client.HourBankMovement.DeleteOne(m).Exec(ctx)
+}
+
+// postHourBankDay sincroniza el movimiento diario de la bolsa de horas con el balance
+// neto del día: se registra cuando la jornada está cerrada (con salida) y se elimina si
+// la jornada se reabre o queda sin balance. Las horas extra no autorizadas no suman a
+// la bolsa hasta que se aprueben.
+func postHourBankDay(ctx context.Context, client *ent.Client, ad *ent.AttendanceDay) error {
+	existing, err := client.HourBankMovement.Query().
+		Where(hourbankmovement.AttendanceDayIDEQ(ad.ID)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	minutes := 0
+	if ad.NetMinutesBalance != nil {
+		minutes = *ad.NetMinutesBalance
+		if ad.UnapprovedOvertimeMinutes != nil {
+			minutes -= *ad.UnapprovedOvertimeMinutes
+		}
+	}
+
+	closed := ad.WorkOutAt != nil && minutes != 0
+	if !closed {
+		if existing != nil {
+			return client.HourBankMovement.DeleteOne(existing).Exec(ctx)
+		}
+		return nil
+	}
+
+	date := truncateToDay(ad.WorkDate)
+	if existing != nil {
+		if existing.Minutes == minutes && existing.Date.Equal(date) {
+			return nil
+		}
+		return client.HourBankMovement.UpdateOne(existing).
+			SetMinutes(minutes).
+			SetDate(date).
+			Exec(ctx)
+	}
+
+	return client.HourBankMovement.Create().
+		SetUserID(ad.UserID).
+		SetDate(date).
+		SetKind(HourBankKindDaily).
+		SetMinutes(minutes).
+		SetAttendanceDayID(ad.ID).
+		Exec(ctx)
+}
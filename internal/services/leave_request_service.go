@@ -0,0 +1,247 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"back/internal/ent"
+	"back/internal/ent/leaverequest"
+	"back/internal/ent/user"
+	"back/internal/ent/userbranch"
+)
+
+var (
+	ErrLeaveRequestInvalidInput = errors.New("invalid leave request input")
+	ErrLeaveRequestOverlap      = errors.New("leave request overlaps an existing one")
+	ErrLeaveRequestInvalidState = errors.New("leave request cannot change from its current status")
+	ErrLeaveRequestSelfReview   = errors.New("leave request cannot be reviewed by its owner")
+	ErrLeaveRequestStarted      = errors.New("approved leave request already started")
+)
+
+const (
+	LeaveTypeVacation          = "vacation"
+	LeaveTypeMedicalLicense    = "medical_license"
+	LeaveTypeAdministrativeDay = "administrative_day"
+	LeaveTypeUnpaid            = "unpaid"
+
+	LeaveStatusRequested = "requested"
+	LeaveStatusApproved  = "approved"
+	LeaveStatusRejected  = "rejected"
+	LeaveStatusCancelled = "cancelled"
+)
+
+type LeaveRequestService struct {
+	Client *ent.Client
+}
+
+func NewLeaveRequestService(client *ent.Client) *LeaveRequestService {
+	return &LeaveRequestService{Client: client}
+}
+
+type LeaveRequestFilters struct {
+	UserID    *int
+	BranchID  *int
+	Status    *string
+	Type      *string
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+type CreateLeaveRequestInput struct {
+	Type      string
+	StartDate time.Time
+	EndDate   time.Time
+	Reason    *string
+}
+
+func (s *LeaveRequestService) List(ctx context.Context, f LeaveRequestFilters) ([]*ent.LeaveRequest, error) {
+	q := s.Client.LeaveRequest.Query()
+
+	// con alcance restringido: solo las propias y las de trabajadores de sus sucursales
+	if preds := userScope(ctx); preds != nil {
+		q = q.Where(leaverequest.HasUserWith(preds...))
+	}
+	if f.UserID != nil {
+		q = q.Where(leaverequest.UserIDEQ(*f.UserID))
+	}
+	if f.BranchID != nil {
+		q = q.Where(leaverequest.HasUserWith(
+			user.HasUserBranchesWith(
+				userbranch.BranchIDEQ(*f.BranchID),
+				userbranch.IsActiveEQ(true),
+			),
+		))
+	}
+	if f.Status != nil {
+		q = q.Where(leaverequest.StatusEQ(strings.ToLower(strings.TrimSpace(*f.Status))))
+	}
+	if f.Type != nil {
+		q = q.Where(leaverequest.TypeEQ(strings.ToLower(strings.TrimSpace(*f.Type))))
+	}
+	// solapamiento con el rango pedido
+	if f.StartDate != nil {
+		q = q.Where(leaverequest.EndDateGTE(truncateToDay(*f.StartDate)))
+	}
+	if f.EndDate != nil {
+		q = q.Where(leaverequest.StartDateLTE(truncateToDay(*f.EndDate)))
+	}
+
+	return q.Order(ent.Desc(leaverequest.FieldStartDate), ent.Desc(leaverequest.FieldID)).All(ctx)
+}
+
+func (s *LeaveRequestService) GetByID(ctx context.Context, id int) (*ent.LeaveRequest, error) {
+	if id <= 0 {
+		return nil, ErrLeaveRequestInvalidInput
+	}
+	lr, err := s.Client.LeaveRequest.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUserScope(ctx, s.Client, lr.UserID, false); err != nil {
+		return nil, err
+	}
+	return lr, nil
+}
+
+func (s *LeaveRequestService) Create(ctx context.Context, userID int, in CreateLeaveRequestInput) (*ent.LeaveRequest, error) {
+	in.Type = strings.ToLower(strings.TrimSpace(in.Type))
+	if userID <= 0 || !isValidLeaveType(in.Type) || in.StartDate.IsZero() || in.EndDate.IsZero() {
+		return nil, ErrLeaveRequestInvalidInput
+	}
+
+	start := truncateToDay(in.StartDate)
+	end := truncateToDay(in.EndDate)
+	if end.Before(start) {
+		return nil, ErrLeaveRequestInvalidInput
+	}
+
+	if _, err := s.Client.User.Get(ctx, userID); err != nil {
+		return nil, err
+	}
+	// registrar una ausencia de otro trabajador exige supervisar su sucursal
+	if scope := AccessScopeFrom(ctx); scope.Restricted() && userID != scope.UserID {
+		if err := checkUserScope(ctx, s.Client, userID, true); err != nil {
+			return nil, err
+		}
+	}
+
+	// no se permiten dos solicitudes vigentes sobre los mismos días
+	overlap, err := s.Client.LeaveRequest.Query().
+		Where(
+			leaverequest.UserIDEQ(userID),
+			leaverequest.StatusIn(LeaveStatusRequested, LeaveStatusApproved),
+			leaverequest.StartDateLTE(end),
+			leaverequest.EndDateGTE(start),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if overlap {
+		return nil, ErrLeaveRequestOverlap
+	}
+
+	create := s.Client.LeaveRequest.Create().
+		SetUserID(userID).
+		SetType(in.Type).
+		SetStartDate(start).
+		SetEndDate(end).
+		SetStatus(LeaveStatusRequested)
+
+	if in.Reason != nil {
+		if r := strings.TrimSpace(*in.Reason); r != "" {
+			create.SetReason(r)
+		}
+	}
+
+	return create.Save(ctx)
+}
+
+// Review aprueba o rechaza una solicitud pendiente. reviewerID es el supervisor que resuelve.
+func (s *LeaveRequestService) Review(ctx context.Context, id, reviewerID int, approve bool, notes *string) (*ent.LeaveRequest, error) {
+	if id <= 0 || reviewerID <= 0 {
+		return nil, ErrLeaveRequestInvalidInput
+	}
+
+	lr, err := s.Client.LeaveRequest.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if lr.Status != LeaveStatusRequested {
+		return nil, ErrLeaveRequestInvalidState
+	}
+	// nadie aprueba sus propias ausencias
+	if lr.UserID == reviewerID {
+		return nil, ErrLeaveRequestSelfReview
+	}
+	if err := checkUserScope(ctx, s.Client, lr.UserID, true); err != nil {
+		return nil, err
+	}
+
+	status := LeaveStatusRejected
+	if approve {
+		status = LeaveStatusApproved
+	}
+
+	upd := lr.Update().
+		SetStatus(status).
+		SetReviewedByID(reviewerID).
+		SetReviewedAt(time.Now())
+
+	if notes != nil {
+		if n := strings.TrimSpace(*notes); n != "" {
+			upd.SetReviewNotes(n)
+		}
+	}
+
+	return upd.Save(ctx)
+}
+
+// Cancel anula una solicitud pendiente o aprobada. Solo el dueño (o un admin) puede cancelarla.
+// Una ausencia aprobada que ya comenzó justifica días pasados: solo la anula quien
+// administra permisos (manage), no el trabajador.
+func (s *LeaveRequestService) Cancel(ctx context.Context, id int, manage bool) (*ent.LeaveRequest, error) {
+	if id <= 0 {
+		return nil, ErrLeaveRequestInvalidInput
+	}
+
+	lr, err := s.Client.LeaveRequest.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if scope := AccessScopeFrom(ctx); scope.Restricted() && lr.UserID != scope.UserID {
+		if err := checkUserScope(ctx, s.Client, lr.UserID, true); err != nil {
+			return nil, err
+		}
+	}
+	if lr.Status != LeaveStatusRequested && lr.Status != LeaveStatusApproved {
+		return nil, ErrLeaveRequestInvalidState
+	}
+	if lr.Status == LeaveStatusApproved && !manage && !truncateToDay(lr.StartDate).After(truncateToDay(time.Now())) {
+		return nil, ErrLeaveRequestStarted
+	}
+
+	return lr.Update().SetStatus(LeaveStatusCancelled).Save(ctx)
+}
+
+func isValidLeaveType(t string) bool {
+	switch t {
+	case LeaveTypeVacation, LeaveTypeMedicalLicense, LeaveTypeAdministrativeDay, LeaveTypeUnpaid:
+		return true
+	}
+	return false
+}
+
+// approvedLeaveSQLCondition devuelve una condición SQL (EXISTS) verdadera cuando el usuario
+// userExpr tiene una ausencia aprobada que cubre la fecha dateExpr.
+func approvedLeaveSQLCondition(userExpr, dateExpr string) string {
+	return fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM leave_requests lr
+			WHERE lr.user_id = %s
+			  AND lr.status = 'approved'
+			  AND (%s)::date BETWEEN lr.start_date AND lr.end_date
+		)`, userExpr, dateExpr)
+}
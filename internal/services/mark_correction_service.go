@@ -0,0 +1,358 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"back/internal/ent"
+	"back/internal/ent/attendanceday"
+	"back/internal/ent/markcorrectionrequest"
+	"back/internal/ent/predicate"
+)
+
+var (
+	ErrMarkCorrectionInvalidInput = errors.New("invalid mark correction input")
+	ErrMarkCorrectionPending      = errors.New("a pending correction already exists for this marking")
+	ErrMarkCorrectionInvalidState = errors.New("mark correction cannot change from its current status")
+	ErrMarkCorrectionSelfReview   = errors.New("mark correction cannot be reviewed by its own author")
+)
+
+const (
+	MarkCorrectionStatusRequested = "requested"
+	MarkCorrectionStatusApproved  = "approved"
+	MarkCorrectionStatusRejected  = "rejected"
+	MarkCorrectionStatusCancelled = "cancelled"
+
+	// tamaño máximo del respaldo adjunto
+	MaxMarkCorrectionAttachmentBytes = 5 << 20
+)
+
+// tipos de archivo aceptados como respaldo
+var markCorrectionAttachmentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"application/pdf": true,
+}
+
+type MarkCorrectionService struct {
+	Client   *ent.Client
+	Markings *MarkingsService
+}
+
+func NewMarkCorrectionService(client *ent.Client, markings *MarkingsService) *MarkCorrectionService {
+	return &MarkCorrectionService{Client: client, Markings: markings}
+}
+
+type MarkCorrectionFilters struct {
+	UserID    *int
+	BranchID  *int
+	Status    *string
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+// CreateMarkCorrectionInput: horarios propuestos en HH:MM (al menos uno) y el motivo.
+type CreateMarkCorrectionInput struct {
+	AttendanceDayID int
+	WorkInAt        *string
+	BreakOutAt      *string
+	BreakInAt       *string
+	WorkOutAt       *string
+	Reason          string
+	Attachment      *MarkCorrectionAttachment
+}
+
+type MarkCorrectionAttachment struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
+
+// List no carga el contenido del adjunto (ver Attachment).
+func (s *MarkCorrectionService) List(ctx context.Context, f MarkCorrectionFilters) ([]*ent.MarkCorrectionRequest, error) {
+	q := s.Client.MarkCorrectionRequest.Query().Where(markCorrectionScope(ctx)...)
+
+	if f.UserID != nil {
+		q = q.Where(markcorrectionrequest.UserIDEQ(*f.UserID))
+	}
+	if f.BranchID != nil {
+		q = q.Where(markcorrectionrequest.HasAttendanceDayWith(attendanceday.BranchIDEQ(*f.BranchID)))
+	}
+	if f.Status != nil {
+		q = q.Where(markcorrectionrequest.StatusEQ(strings.ToLower(strings.TrimSpace(*f.Status))))
+	}
+	if f.StartDate != nil {
+		q = q.Where(markcorrectionrequest.WorkDateGTE(truncateToDay(*f.StartDate)))
+	}
+	if f.EndDate != nil {
+		q = q.Where(markcorrectionrequest.WorkDateLTE(truncateToDay(*f.EndDate)))
+	}
+
+	return q.
+		Order(ent.Desc(markcorrectionrequest.FieldWorkDate), ent.Desc(markcorrectionrequest.FieldID)).
+		Select(markCorrectionListColumns()...).
+		All(ctx)
+}
+
+// GetByID no carga el contenido del adjunto (ver Attachment). Las solicitudes fuera del
+// alcance por sucursal de quien consulta no existen para él.
+func (s *MarkCorrectionService) GetByID(ctx context.Context, id int) (*ent.MarkCorrectionRequest, error) {
+	if id <= 0 {
+		return nil, ErrMarkCorrectionInvalidInput
+	}
+	return s.Client.MarkCorrectionRequest.Query().
+		Where(markcorrectionrequest.IDEQ(id)).
+		Where(markCorrectionScope(ctx)...).
+		Select(markCorrectionListColumns()...).
+		Only(ctx)
+}
+
+// Attachment devuelve la solicitud con el contenido del adjunto.
+func (s *MarkCorrectionService) Attachment(ctx context.Context, id int) (*ent.MarkCorrectionRequest, error) {
+	if id <= 0 {
+		return nil, ErrMarkCorrectionInvalidInput
+	}
+	mc, err := s.Client.MarkCorrectionRequest.Query().
+		Where(markcorrectionrequest.IDEQ(id)).
+		Where(markCorrectionScope(ctx)...).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if mc.Attachment == nil {
+		return nil, &ent.NotFoundError{}
+	}
+	return mc, nil
+}
+
+// Create registra la solicitud del trabajador sobre uno de sus días de asistencia.
+// Solo puede haber una solicitud pendiente por día.
+func (s *MarkCorrectionService) Create(ctx context.Context, userID int, in CreateMarkCorrectionInput) (*ent.MarkCorrectionRequest, error) {
+	reason := strings.TrimSpace(in.Reason)
+	if userID <= 0 || in.AttendanceDayID <= 0 || reason == "" {
+		return nil, ErrMarkCorrectionInvalidInput
+	}
+
+	workIn, err := normalizeCorrectionTime(in.WorkInAt)
+	if err != nil {
+		return nil, err
+	}
+	breakOut, err := normalizeCorrectionTime(in.BreakOutAt)
+	if err != nil {
+		return nil, err
+	}
+	breakIn, err := normalizeCorrectionTime(in.BreakInAt)
+	if err != nil {
+		return nil, err
+	}
+	workOut, err := normalizeCorrectionTime(in.WorkOutAt)
+	if err != nil {
+		return nil, err
+	}
+	if workIn == nil && breakOut == nil && breakIn == nil && workOut == nil {
+		return nil, ErrMarkCorrectionInvalidInput
+	}
+	if err := validateMarkCorrectionAttachment(in.Attachment); err != nil {
+		return nil, err
+	}
+
+	// el día debe ser del propio usuario
+	ad, err := s.Client.AttendanceDay.Query().
+		Where(attendanceday.IDEQ(in.AttendanceDayID), attendanceday.UserIDEQ(userID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := s.Client.MarkCorrectionRequest.Query().
+		Where(
+			markcorrectionrequest.AttendanceDayIDEQ(ad.ID),
+			markcorrectionrequest.StatusEQ(MarkCorrectionStatusRequested),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return nil, ErrMarkCorrectionPending
+	}
+
+	create := s.Client.MarkCorrectionRequest.Create().
+		SetUserID(userID).
+		SetAttendanceDayID(ad.ID).
+		SetWorkDate(ad.WorkDate).
+		SetNillableWorkInAt(workIn).
+		SetNillableBreakOutAt(breakOut).
+		SetNillableBreakInAt(breakIn).
+		SetNillableWorkOutAt(workOut).
+		SetReason(reason).
+		SetStatus(MarkCorrectionStatusRequested)
+
+	if a := in.Attachment; a != nil {
+		create.
+			SetAttachment(a.Data).
+			SetAttachmentName(a.Name).
+			SetAttachmentContentType(a.ContentType)
+	}
+
+	mc, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// la respuesta no incluye el contenido del adjunto
+	mc.Attachment = nil
+	return mc, nil
+}
+
+// Review aprueba o rechaza una solicitud pendiente. Al aprobar se aplica la edición de
+// la marcación (MarkingsService.Update) con el ID de la solicitud en la auditoría, en
+// la misma transacción: si la edición falla la solicitud sigue pendiente.
+func (s *MarkCorrectionService) Review(ctx context.Context, id, reviewerID int, approve bool, notes *string) (*ent.MarkCorrectionRequest, error) {
+	if id <= 0 || reviewerID <= 0 {
+		return nil, ErrMarkCorrectionInvalidInput
+	}
+
+	mc, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if mc.Status != MarkCorrectionStatusRequested {
+		return nil, ErrMarkCorrectionInvalidState
+	}
+	if mc.UserID == reviewerID {
+		return nil, ErrMarkCorrectionSelfReview
+	}
+
+	// revisar (aprobar o rechazar) exige gestionar la sucursal del día
+	ad, err := s.Client.AttendanceDay.Get(ctx, mc.AttendanceDayID)
+	if err != nil {
+		return nil, err
+	}
+	if !AccessScopeFrom(ctx).CanManage(ad.BranchID) {
+		return nil, ErrOutOfScope
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	status := MarkCorrectionStatusRejected
+	if approve {
+		status = MarkCorrectionStatusApproved
+	}
+
+	// solo se resuelve si sigue pendiente (dos revisiones simultáneas no aplican dos veces)
+	upd := tx.MarkCorrectionRequest.Update().
+		Where(
+			markcorrectionrequest.IDEQ(id),
+			markcorrectionrequest.StatusEQ(MarkCorrectionStatusRequested),
+		).
+		SetStatus(status).
+		SetReviewedByID(reviewerID).
+		SetReviewedAt(time.Now())
+	if notes != nil {
+		if n := strings.TrimSpace(*notes); n != "" {
+			upd.SetReviewNotes(n)
+		}
+	}
+	n, err := upd.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrMarkCorrectionInvalidState
+	}
+
+	if approve {
+		if err := s.Markings.withClient(tx.Client()).applyUpdate(ctx, mc.AttendanceDayID, UpdateMarkingInput{
+			WorkInAt:            mc.WorkInAt,
+			WorkOutAt:           mc.WorkOutAt,
+			BreakOutAt:          mc.BreakOutAt,
+			BreakInAt:           mc.BreakInAt,
+			Justification:       fmt.Sprintf("Solicitud de corrección #%d: %s", mc.ID, mc.Reason),
+			EditorID:            reviewerID,
+			CorrectionRequestID: &mc.ID,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return s.GetByID(ctx, id)
+}
+
+// Cancel anula una solicitud pendiente (solo el dueño, validado en el handler).
+func (s *MarkCorrectionService) Cancel(ctx context.Context, id int) (*ent.MarkCorrectionRequest, error) {
+	mc, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if mc.Status != MarkCorrectionStatusRequested {
+		return nil, ErrMarkCorrectionInvalidState
+	}
+
+	return mc.Update().SetStatus(MarkCorrectionStatusCancelled).Save(ctx)
+}
+
+// markCorrectionScope: las solicitudes propias y las de días en sucursales visibles.
+func markCorrectionScope(ctx context.Context) []predicate.MarkCorrectionRequest {
+	scope := AccessScopeFrom(ctx)
+	if !scope.Restricted() {
+		return nil
+	}
+	return []predicate.MarkCorrectionRequest{
+		markcorrectionrequest.Or(
+			markcorrectionrequest.UserIDEQ(scope.UserID),
+			markcorrectionrequest.HasAttendanceDayWith(attendanceday.BranchIDIn(scope.BranchIDs()...)),
+		),
+	}
+}
+
+// markCorrectionListColumns son todas las columnas menos el contenido del adjunto.
+func markCorrectionListColumns() []string {
+	cols := make([]string, 0, len(markcorrectionrequest.Columns))
+	for _, c := range markcorrectionrequest.Columns {
+		if c != markcorrectionrequest.FieldAttachment {
+			cols = append(cols, c)
+		}
+	}
+	return cols
+}
+
+func validateMarkCorrectionAttachment(a *MarkCorrectionAttachment) error {
+	if a == nil {
+		return nil
+	}
+	if len(a.Data) == 0 || len(a.Data) > MaxMarkCorrectionAttachmentBytes {
+		return fmt.Errorf("%w: attachment size", ErrMarkCorrectionInvalidInput)
+	}
+	if !markCorrectionAttachmentTypes[a.ContentType] {
+		return fmt.Errorf("%w: attachment type", ErrMarkCorrectionInvalidInput)
+	}
+	if strings.TrimSpace(a.Name) == "" {
+		a.Name = "respaldo"
+	}
+	return nil
+}
+
+// normalizeCorrectionTime valida un horario propuesto HH:MM ("" o nil = sin cambio).
+func normalizeCorrectionTime(v *string) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	hhmm := strings.TrimSpace(*v)
+	if hhmm == "" {
+		return nil, nil
+	}
+	if _, _, err := parseHHMM(hhmm); err != nil {
+		return nil, ErrMarkCorrectionInvalidInput
+	}
+	return &hhmm, nil
+}
@@ -12,6 +12,7 @@ import (
 
 	"back/internal/ent"
 	"back/internal/ent/attendanceday"
+	"back/internal/ent/attendanceedit"
 )
 
 var ErrInvalidMarkingsRange = errors.New("invalid markings range")
@@ -27,12 +28,20 @@ func NewMarkingsService(client *ent.Client, db *sql.DB) *MarkingsService {
 	return &MarkingsService{client: client, db: db}
 }
 
+// withClient devuelve el servicio operando con otro cliente (por ejemplo el de una
+// transacción) para que la edición quede en la misma transacción que quien la invoca.
+func (s *MarkingsService) withClient(client *ent.Client) *MarkingsService {
+	return &MarkingsService{client: client, db: s.db}
+}
+
 type MarkingsFilters struct {
 	Range         string
 	StartDate     *time.Time
 	EndDate       *time.Time
 	BranchID      *int
 	AccessPointID *int
+	// solo las marcaciones de este usuario (autoservicio /me)
+	UserID        *int
 	Search        string
 	Page          int
 	Limit         int
@@ -60,6 +69,8 @@ type MarkingsSummary struct {
 	AvgLateMinutes int `json:"avg_late_minutes"`
 	PeopleInside   int `json:"people_inside"`
 	OvertimeCount  int `json:"overtime_count"`
+	// días con horas extra sin autorización previa
+	UnapprovedOvertimeCount int `json:"unapproved_overtime_count"`
 }
 
 type MarkingItem struct {
@@ -84,11 +95,14 @@ type MarkingItem struct {
 	ExitStatus     string     `json:"exit_status"`
 	HasOvertime    bool       `json:"has_overtime"`
 	OvertimeMins   int        `json:"overtime_minutes"`
+	ApprovedOvertimeMins   int `json:"approved_overtime_minutes"`
+	UnapprovedOvertimeMins int `json:"unapproved_overtime_minutes"`
 	EarlyExitMins  int        `json:"early_exit_minutes"`
 	NetMinutes     int        `json:"net_minutes_balance"`
 	NetStatus      string     `json:"net_status"`
 	Edited         bool       `json:"edited"`
 	LastEditReason *string    `json:"last_edit_reason"`
+	LastCorrectionRequestID *int `json:"last_correction_request_id"`
 }
 
 type MarkingsListResponse struct {
@@ -126,6 +140,10 @@ type UpdateMarkingInput struct {
 	BreakOutAt    *string
 	BreakInAt     *string
 	Justification string
+	// usuario que edita (subject del JWT); queda en el historial
+	EditorID int
+	// solicitud de corrección aprobada que origina la edición (nil = edición manual)
+	CorrectionRequestID *int
 }
 
 type shiftSchedule struct {
@@ -133,6 +151,7 @@ type shiftSchedule struct {
 	EndTime        string
 	CrossesMidnight bool
 	BreakMinutes   int
+	Tolerances     shiftTolerances
 }
 
 func parseHHMM(hhmm string) (int, int, error) {
@@ -166,7 +185,8 @@ func toShiftDateTime(workDate time.Time, hhmm string, shift *shiftSchedule) (tim
 
 func (s *MarkingsService) getShiftSchedule(ctx context.Context, userID int, workDate time.Time) (*shiftSchedule, error) {
 	overrideQuery := `
-		SELECT sh.start_time, sh.end_time, sh.crosses_midnight, sh.break_minutes
+		SELECT sh.start_time, sh.end_time, sh.crosses_midnight, sh.break_minutes,
+			sh.entry_tolerance_minutes, sh.exit_tolerance_minutes, sh.min_overtime_minutes
 		FROM user_day_overrides udo
 		JOIN shifts sh ON sh.id = udo.shift_id
 		WHERE udo.user_id = $1
@@ -177,7 +197,8 @@ func (s *MarkingsService) getShiftSchedule(ctx context.Context, userID int, work
 	`
 
 	var out shiftSchedule
-	err := s.db.QueryRowContext(ctx, overrideQuery, userID, workDate).Scan(&out.StartTime, &out.EndTime, &out.CrossesMidnight, &out.BreakMinutes)
+	err := s.db.QueryRowContext(ctx, overrideQuery, userID, workDate).Scan(&out.StartTime, &out.EndTime, &out.CrossesMidnight, &out.BreakMinutes,
+		&out.Tolerances.EntryMinutes, &out.Tolerances.ExitMinutes, &out.Tolerances.MinOvertimeMinutes)
 	if err == nil {
 		return &out, nil
 	}
@@ -186,7 +207,8 @@ func (s *MarkingsService) getShiftSchedule(ctx context.Context, userID int, work
 	}
 
 	query := `
-		SELECT sh.start_time, sh.end_time, sh.crosses_midnight, sh.break_minutes
+		SELECT sh.start_time, sh.end_time, sh.crosses_midnight, sh.break_minutes,
+			sh.entry_tolerance_minutes, sh.exit_tolerance_minutes, sh.min_overtime_minutes
 		FROM user_shift_assignments usa
 		JOIN shifts sh ON sh.id = usa.shift_id
 		WHERE usa.user_id = $1
@@ -197,7 +219,8 @@ func (s *MarkingsService) getShiftSchedule(ctx context.Context, userID int, work
 		LIMIT 1
 	`
 
-	err = s.db.QueryRowContext(ctx, query, userID, workDate).Scan(&out.StartTime, &out.EndTime, &out.CrossesMidnight, &out.BreakMinutes)
+	err = s.db.QueryRowContext(ctx, query, userID, workDate).Scan(&out.StartTime, &out.EndTime, &out.CrossesMidnight, &out.BreakMinutes,
+		&out.Tolerances.EntryMinutes, &out.Tolerances.ExitMinutes, &out.Tolerances.MinOvertimeMinutes)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -235,7 +258,7 @@ func resolveMarkingsRange(r string, startDate, endDate *time.Time) (time.Time, t
 	}
 }
 
-func buildMarkingsWhere(baseArgs []any, f MarkingsFilters, start, end time.Time) (string, []any) {
+func buildMarkingsWhere(ctx context.Context, baseArgs []any, f MarkingsFilters, start, end time.Time) (string, []any) {
 	args := append(baseArgs, start, end)
 	where := " WHERE ad.work_date >= $1 AND ad.work_date < $2"
 
@@ -247,10 +270,16 @@ func buildMarkingsWhere(baseArgs []any, f MarkingsFilters, start, end time.Time)
 		args = append(args, *f.AccessPointID)
 		where += fmt.Sprintf(" AND ad.access_point_id = $%d", len(args))
 	}
+	if f.UserID != nil {
+		args = append(args, *f.UserID)
+		where += fmt.Sprintf(" AND ad.user_id = $%d", len(args))
+	}
 	if f.Search != "" {
 		args = append(args, "%"+f.Search+"%")
 		where += fmt.Sprintf(" AND (LOWER(COALESCE(u.first_name, '') || ' ' || COALESCE(u.last_name, '')) LIKE LOWER($%d) OR LOWER(COALESCE(u.username, '')) LIKE LOWER($%d))", len(args), len(args))
 	}
+	// alcance por sucursal: las sucursales visibles + las marcas propias
+	where += AccessScopeFrom(ctx).sqlFilter("ad.branch_id", "ad.user_id", &args)
 
 	return where, args
 }
@@ -275,7 +304,7 @@ func (s *MarkingsService) List(ctx context.Context, f MarkingsFilters) (*Marking
 		return nil, err
 	}
 
-	where, args := buildMarkingsWhere(nil, f, start, end)
+	where, args := buildMarkingsWhere(ctx, nil, f, start, end)
 
 	countQuery := "SELECT COUNT(*) FROM attendance_days ad JOIN users u ON u.id = ad.user_id" + where
 	var total int
@@ -294,7 +323,8 @@ func (s *MarkingsService) List(ctx context.Context, f MarkingsFilters) (*Marking
 			COALESCE(SUM(CASE WHEN ad.late_minutes IS NOT NULL AND ad.late_minutes > 0 THEN 1 ELSE 0 END), 0) AS late_count,
 			COALESCE(ROUND(AVG(NULLIF(ad.late_minutes, 0))), 0)::int AS avg_late_minutes,
 			COALESCE(SUM(CASE WHEN ad.work_in_at IS NOT NULL AND ad.work_out_at IS NULL THEN 1 ELSE 0 END), 0) AS people_inside,
-			COALESCE(SUM(CASE WHEN ad.overtime_minutes IS NOT NULL AND ad.overtime_minutes > 0 THEN 1 ELSE 0 END), 0) AS overtime_count
+			COALESCE(SUM(CASE WHEN ad.overtime_minutes IS NOT NULL AND ad.overtime_minutes > 0 THEN 1 ELSE 0 END), 0) AS overtime_count,
+			COALESCE(SUM(CASE WHEN ad.unapproved_overtime_minutes IS NOT NULL AND ad.unapproved_overtime_minutes > 0 THEN 1 ELSE 0 END), 0) AS unapproved_overtime_count
 		FROM attendance_days ad
 		JOIN users u ON u.id = ad.user_id
 	` + where
@@ -306,6 +336,7 @@ func (s *MarkingsService) List(ctx context.Context, f MarkingsFilters) (*Marking
 		&summary.AvgLateMinutes,
 		&summary.PeopleInside,
 		&summary.OvertimeCount,
+		&summary.UnapprovedOvertimeCount,
 	); err != nil {
 		return nil, err
 	}
@@ -313,7 +344,165 @@ func (s *MarkingsService) List(ctx context.Context, f MarkingsFilters) (*Marking
 	offset := (f.Page - 1) * f.Limit
 	itemsArgs := append(args, f.Limit, offset)
 
-	itemsQuery := fmt.Sprintf(`
+	itemsQuery := fmt.Sprintf(markingsItemsSelect+`
+		ORDER BY COALESCE(ad.work_in_at, ad.work_out_at, ad.updated_at) DESC
+		LIMIT $%d OFFSET $%d
+	`, where, len(itemsArgs)-1, len(itemsArgs))
+
+	rows, err := s.db.QueryContext(ctx, itemsQuery, itemsArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]MarkingItem, 0)
+	for rows.Next() {
+		it, err := scanMarkingItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	totalPages := 0
+	if total > 0 {
+		totalPages = int(math.Ceil(float64(total) / float64(f.Limit)))
+	}
+
+	resp := &MarkingsListResponse{
+		Filters: MarkingsFiltersResponse{
+			Range:         f.Range,
+			StartDate:     start.Format("2006-01-02"),
+			EndDate:       end.Add(-time.Nanosecond).Format("2006-01-02"),
+			BranchID:      f.BranchID,
+			AccessPointID: f.AccessPointID,
+			Search:        f.Search,
+		},
+		Pagination: MarkingsPagination{
+			Page:       f.Page,
+			Limit:      f.Limit,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+		Summary: summary,
+		Items:   items,
+	}
+	if resp.Items == nil {
+		resp.Items = []MarkingItem{}
+	}
+	return resp, nil
+}
+
+// markingsExportBatch es la cantidad de filas que se piden al cursor en cada FETCH.
+const markingsExportBatch = 500
+
+// Export recorre todas las marcaciones que cumplen los filtros, sin paginación ni tope
+// de filas, y entrega cada una a fn a medida que se leen de la base de datos.
+// Usa un cursor del servidor (DECLARE/FETCH) dentro de una transacción de solo lectura,
+// por lo que la memoria es constante sin importar el tamaño del rango.
+func (s *MarkingsService) Export(ctx context.Context, f MarkingsFilters, fn func(MarkingItem) error) (*MarkingsFiltersResponse, error) {
+	if f.Range == "" {
+		f.Range = "today"
+	}
+
+	start, end, err := resolveMarkingsRange(f.Range, f.StartDate, f.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	where, args := buildMarkingsWhere(ctx, nil, f, start, end)
+	query := fmt.Sprintf(markingsItemsSelect+`
+		ORDER BY ad.work_date ASC, full_name ASC, ad.id ASC
+	`, where)
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, "DECLARE markings_export NO SCROLL CURSOR FOR "+query, args...); err != nil {
+		return nil, err
+	}
+
+	fetch := fmt.Sprintf("FETCH FORWARD %d FROM markings_export", markingsExportBatch)
+	for {
+		n, err := fetchMarkingItems(ctx, tx, fetch, fn)
+		if err != nil {
+			return nil, err
+		}
+		if n < markingsExportBatch {
+			break
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, "CLOSE markings_export"); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &MarkingsFiltersResponse{
+		Range:         f.Range,
+		StartDate:     start.Format("2006-01-02"),
+		EndDate:       end.Add(-time.Nanosecond).Format("2006-01-02"),
+		BranchID:      f.BranchID,
+		AccessPointID: f.AccessPointID,
+		Search:        f.Search,
+	}, nil
+}
+
+// fetchMarkingItems ejecuta un FETCH sobre el cursor de exportación y entrega cada fila a fn.
+// Retorna la cantidad de filas leídas.
+func fetchMarkingItems(ctx context.Context, tx *sql.Tx, fetch string, fn func(MarkingItem) error) (int, error) {
+	rows, err := tx.QueryContext(ctx, fetch)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	n := 0
+	for rows.Next() {
+		it, err := scanMarkingItem(rows)
+		if err != nil {
+			return n, err
+		}
+		if err := fn(it); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, rows.Err()
+}
+
+// getItem retorna una marcación por id con los mismos cálculos del listado.
+func (s *MarkingsService) getItem(ctx context.Context, id int) (*MarkingItem, error) {
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(markingsItemsSelect, " WHERE ad.id = $1"), id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, ErrMarkingNotFound
+	}
+	it, err := scanMarkingItem(rows)
+	if err != nil {
+		return nil, err
+	}
+	return &it, nil
+}
+
+// markingsItemsSelect es el SELECT de las filas de marcaciones (con el turno vigente
+// del día) compartido por el listado paginado y las exportaciones. Recibe el WHERE.
+const markingsItemsSelect = `
 		SELECT
 			ad.id,
 			u.id,
@@ -334,6 +523,8 @@ func (s *MarkingsService) List(ctx context.Context, f MarkingsFilters) (*Marking
 					ROUND(EXTRACT(EPOCH FROM (ad.break_in_at - ad.break_out_at)) / 60)::int - COALESCE(sh.break_minutes, 0)
 			ELSE NULL END AS break_diff,
 			COALESCE(ad.overtime_minutes, 0) AS overtime_minutes,
+			COALESCE(ad.approved_overtime_minutes, 0) AS approved_overtime_minutes,
+			COALESCE(ad.unapproved_overtime_minutes, ad.overtime_minutes, 0) AS unapproved_overtime_minutes,
 			COALESCE(ad.early_exit_minutes, 0) AS early_exit_minutes,
 			COALESCE(
 				ad.net_minutes_balance,
@@ -349,7 +540,11 @@ func (s *MarkingsService) List(ctx context.Context, f MarkingsFilters) (*Marking
 				)
 			) AS net_minutes_balance,
 			ad.edited,
-			ad.last_edit_reason
+			ad.last_edit_reason,
+			ad.last_correction_request_id,
+			COALESCE(sh.entry_tolerance_minutes, 0),
+			COALESCE(sh.exit_tolerance_minutes, 0),
+			COALESCE(sh.min_overtime_minutes, 0)
 		FROM attendance_days ad
 		JOIN users u ON u.id = ad.user_id
 		JOIN branches b ON b.id = ad.branch_id
@@ -375,179 +570,170 @@ func (s *MarkingsService) List(ctx context.Context, f MarkingsFilters) (*Marking
 		) usa ON true
 		LEFT JOIN shifts sh ON sh.id = COALESCE(udo.shift_id, usa.shift_id)
 		%s
-		ORDER BY COALESCE(ad.work_in_at, ad.work_out_at, ad.updated_at) DESC
-		LIMIT $%d OFFSET $%d
-	`, where, len(itemsArgs)-1, len(itemsArgs))
-
-	rows, err := s.db.QueryContext(ctx, itemsQuery, itemsArgs...)
-	if err != nil {
-		return nil, err
+`
+
+// scanMarkingItem lee una fila de markingsItemsSelect y deriva los estados del día.
+func scanMarkingItem(rows *sql.Rows) (MarkingItem, error) {
+	var it MarkingItem
+	var workDate time.Time
+	var workIn, workOut, breakOut, breakIn sql.NullTime
+	var accessPointName sql.NullString
+	var lastEditReason sql.NullString
+	var breakDiff sql.NullInt64
+	var tol shiftTolerances
+
+	if err := rows.Scan(
+		&it.ID,
+		&it.UserID,
+		&it.Name,
+		&it.BranchID,
+		&it.BranchName,
+		&it.AccessPointID,
+		&accessPointName,
+		&workDate,
+		&workIn,
+		&workOut,
+		&breakOut,
+		&breakIn,
+		&it.LateMinutes,
+		&breakDiff,
+		&it.OvertimeMins,
+		&it.ApprovedOvertimeMins,
+		&it.UnapprovedOvertimeMins,
+		&it.EarlyExitMins,
+		&it.NetMinutes,
+		&it.Edited,
+		&lastEditReason,
+		&it.LastCorrectionRequestID,
+		&tol.EntryMinutes,
+		&tol.ExitMinutes,
+		&tol.MinOvertimeMinutes,
+	); err != nil {
+		return MarkingItem{}, err
 	}
-	defer rows.Close()
 
-	items := make([]MarkingItem, 0)
-	for rows.Next() {
-		var it MarkingItem
-		var workDate time.Time
-		var workIn, workOut, breakOut, breakIn sql.NullTime
-		var accessPointName sql.NullString
-		var lastEditReason sql.NullString
-		var breakDiff sql.NullInt64
-
-		if err := rows.Scan(
-			&it.ID,
-			&it.UserID,
-			&it.Name,
-			&it.BranchID,
-			&it.BranchName,
-			&it.AccessPointID,
-			&accessPointName,
-			&workDate,
-			&workIn,
-			&workOut,
-			&breakOut,
-			&breakIn,
-			&it.LateMinutes,
-			&breakDiff,
-			&it.OvertimeMins,
-			&it.EarlyExitMins,
-			&it.NetMinutes,
-			&it.Edited,
-			&lastEditReason,
-		); err != nil {
-			return nil, err
-		}
+	it.WorkDate = workDate.Format("2006-01-02")
+	if accessPointName.Valid {
+		v := accessPointName.String
+		it.AccessPointName = &v
+	}
+	if workIn.Valid {
+		t := workIn.Time
+		it.WorkInAt = &t
+	}
+	if workOut.Valid {
+		t := workOut.Time
+		it.WorkOutAt = &t
+	}
+	if breakOut.Valid {
+		t := breakOut.Time
+		it.BreakOutAt = &t
+	}
+	if breakIn.Valid {
+		t := breakIn.Time
+		it.BreakInAt = &t
+	}
+	if breakDiff.Valid {
+		v := int(breakDiff.Int64)
+		it.BreakDiff = &v
+	}
+	if lastEditReason.Valid {
+		v := lastEditReason.String
+		it.LastEditReason = &v
+	}
 
-		it.WorkDate = workDate.Format("2006-01-02")
-		if accessPointName.Valid {
-			v := accessPointName.String
-			it.AccessPointName = &v
-		}
-		if workIn.Valid {
-			t := workIn.Time
-			it.WorkInAt = &t
-		}
-		if workOut.Valid {
-			t := workOut.Time
-			it.WorkOutAt = &t
-		}
-		if breakOut.Valid {
-			t := breakOut.Time
-			it.BreakOutAt = &t
-		}
-		if breakIn.Valid {
-			t := breakIn.Time
-			it.BreakInAt = &t
-		}
-		if breakDiff.Valid {
-			v := int(breakDiff.Int64)
-			it.BreakDiff = &v
-		}
-		if lastEditReason.Valid {
-			v := lastEditReason.String
-			it.LastEditReason = &v
-		}
+	it.EntryDiff = it.LateMinutes
+	if it.OvertimeMins > 0 {
+		it.ExitDiff = it.OvertimeMins
+	} else if it.EarlyExitMins > 0 {
+		it.ExitDiff = -it.EarlyExitMins
+	} else {
+		it.ExitDiff = 0
+	}
 
-		it.EntryDiff = it.LateMinutes
-		if it.OvertimeMins > 0 {
-			it.ExitDiff = it.OvertimeMins
-		} else if it.EarlyExitMins > 0 {
-			it.ExitDiff = -it.EarlyExitMins
-		} else {
-			it.ExitDiff = 0
-		}
+	switch {
+	case it.NetMinutes > 0:
+		it.NetStatus = "positive"
+	case it.NetMinutes < 0:
+		it.NetStatus = "negative"
+	default:
+		it.NetStatus = "neutral"
+	}
 
-		switch {
-		case it.NetMinutes > 0:
-			it.NetStatus = "positive"
-		case it.NetMinutes < 0:
-			it.NetStatus = "negative"
-		default:
-			it.NetStatus = "neutral"
-		}
+	if it.WorkInAt == nil {
+		it.EntryStatus = "no_mark"
+	} else if tol.isLate(it.EntryDiff) {
+		it.EntryStatus = "late"
+	} else {
+		it.EntryStatus = "ok"
+	}
 
-		if it.WorkInAt == nil {
-			it.EntryStatus = "no_mark"
-		} else if it.EntryDiff > 0 {
-			it.EntryStatus = "late"
-		} else {
-			it.EntryStatus = "ok"
-		}
+	if it.BreakDiff == nil {
+		it.BreakStatus = "no_mark"
+	} else if *it.BreakDiff > 0 {
+		it.BreakStatus = "over"
+	} else if *it.BreakDiff < 0 {
+		it.BreakStatus = "early"
+	} else {
+		it.BreakStatus = "ok"
+	}
 
-		if it.BreakDiff == nil {
-			it.BreakStatus = "no_mark"
-		} else if *it.BreakDiff > 0 {
-			it.BreakStatus = "over"
-		} else if *it.BreakDiff < 0 {
-			it.BreakStatus = "early"
-		} else {
-			it.BreakStatus = "ok"
-		}
+	if it.WorkOutAt == nil {
+		it.ExitStatus = "no_mark"
+	} else if tol.isOvertime(it.ExitDiff) {
+		it.ExitStatus = "overtime"
+	} else if tol.isEarlyExit(it.ExitDiff) {
+		it.ExitStatus = "early"
+	} else {
+		it.ExitStatus = "ok"
+	}
 
-		if it.WorkOutAt == nil {
-			it.ExitStatus = "no_mark"
-		} else if it.ExitDiff > 0 {
-			it.ExitStatus = "overtime"
-		} else if it.ExitDiff < 0 {
-			it.ExitStatus = "early"
-		} else {
-			it.ExitStatus = "ok"
-		}
+	it.HasOvertime = tol.isOvertime(it.OvertimeMins)
 
-		it.HasOvertime = it.OvertimeMins > 0
+	return it, nil
+}
 
-		items = append(items, it)
-	}
-	if err := rows.Err(); err != nil {
+// Update edita los slots de un día y deja registro de la edición en el historial
+// (AttendanceEdit), todo en una misma transacción.
+func (s *MarkingsService) Update(ctx context.Context, id int, in UpdateMarkingInput) (*MarkingItem, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
 		return nil, err
 	}
+	defer func() { _ = tx.Rollback() }()
 
-	totalPages := 0
-	if total > 0 {
-		totalPages = int(math.Ceil(float64(total) / float64(f.Limit)))
-	}
-
-	resp := &MarkingsListResponse{
-		Filters: MarkingsFiltersResponse{
-			Range:         f.Range,
-			StartDate:     start.Format("2006-01-02"),
-			EndDate:       end.Add(-time.Nanosecond).Format("2006-01-02"),
-			BranchID:      f.BranchID,
-			AccessPointID: f.AccessPointID,
-			Search:        f.Search,
-		},
-		Pagination: MarkingsPagination{
-			Page:       f.Page,
-			Limit:      f.Limit,
-			Total:      total,
-			TotalPages: totalPages,
-		},
-		Summary: summary,
-		Items:   items,
+	if err := s.withClient(tx.Client()).applyUpdate(ctx, id, in); err != nil {
+		return nil, err
 	}
-	if resp.Items == nil {
-		resp.Items = []MarkingItem{}
+	if err := tx.Commit(); err != nil {
+		return nil, err
 	}
-	return resp, nil
+
+	return s.getItem(ctx, id)
 }
 
-func (s *MarkingsService) Update(ctx context.Context, id int, in UpdateMarkingInput) (*MarkingItem, error) {
+// applyUpdate aplica la edición con el cliente del servicio; quien ya tiene una
+// transacción abierta (por ejemplo la aprobación de una solicitud) la invoca directo.
+func (s *MarkingsService) applyUpdate(ctx context.Context, id int, in UpdateMarkingInput) error {
 	justification := strings.TrimSpace(in.Justification)
-	if justification == "" {
-		return nil, ErrInvalidMarkingUpdate
+	if justification == "" || in.EditorID <= 0 {
+		return ErrInvalidMarkingUpdate
 	}
 
 	ad, err := s.client.AttendanceDay.Query().Where(attendanceday.IDEQ(id)).Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, ErrMarkingNotFound
+			return ErrMarkingNotFound
 		}
-		return nil, err
+		return err
+	}
+	if !AccessScopeFrom(ctx).CanManage(ad.BranchID) {
+		return ErrOutOfScope
 	}
 
 	shift, err := s.getShiftSchedule(ctx, ad.UserID, ad.WorkDate)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	update := s.client.AttendanceDay.UpdateOneID(id)
@@ -561,7 +747,7 @@ func (s *MarkingsService) Update(ctx context.Context, id int, in UpdateMarkingIn
 		} else {
 			t, parseErr := toShiftDateTime(ad.WorkDate, v, shift)
 			if parseErr != nil {
-				return nil, ErrInvalidMarkingUpdate
+				return ErrInvalidMarkingUpdate
 			}
 			update.SetWorkInAt(t)
 			workIn = &t
@@ -577,7 +763,7 @@ func (s *MarkingsService) Update(ctx context.Context, id int, in UpdateMarkingIn
 		} else {
 			t, parseErr := toShiftDateTime(ad.WorkDate, v, shift)
 			if parseErr != nil {
-				return nil, ErrInvalidMarkingUpdate
+				return ErrInvalidMarkingUpdate
 			}
 			update.SetWorkOutAt(t)
 			workOut = &t
@@ -594,7 +780,7 @@ func (s *MarkingsService) Update(ctx context.Context, id int, in UpdateMarkingIn
 		} else {
 			t, parseErr := toShiftDateTime(ad.WorkDate, v, shift)
 			if parseErr != nil {
-				return nil, ErrInvalidMarkingUpdate
+				return ErrInvalidMarkingUpdate
 			}
 			update.SetBreakOutAt(t)
 			breakOut = &t
@@ -611,7 +797,7 @@ func (s *MarkingsService) Update(ctx context.Context, id int, in UpdateMarkingIn
 		} else {
 			t, parseErr := toShiftDateTime(ad.WorkDate, v, shift)
 			if parseErr != nil {
-				return nil, ErrInvalidMarkingUpdate
+				return ErrInvalidMarkingUpdate
 			}
 			update.SetBreakInAt(t)
 			breakIn = &t
@@ -623,6 +809,8 @@ func (s *MarkingsService) Update(ctx context.Context, id int, in UpdateMarkingIn
 		EndTime:         shift.EndTime,
 		CrossesMidnight: shift.CrossesMidnight,
 		BreakMinutes:    shift.BreakMinutes,
+		Tolerances:      shift.Tolerances,
+		Unscheduled:     ad.IsHoliday,
 	}, workIn, breakOut, breakIn, workOut)
 
 	if metrics.LateMinutes != nil {
@@ -651,46 +839,92 @@ func (s *MarkingsService) Update(ctx context.Context, id int, in UpdateMarkingIn
 		update.ClearNetMinutesBalance()
 	}
 
+	if err := setOvertimeSplit(ctx, s.client, update, ad.UserID, ad.WorkDate, metrics.OvertimeMinutes); err != nil {
+		return err
+	}
+
 	now := time.Now()
 	update.SetEdited(true)
 	update.SetLastEditReason(justification)
 	update.SetEditedAt(now)
+	if in.CorrectionRequestID != nil {
+		update.SetLastCorrectionRequestID(*in.CorrectionRequestID)
+	} else {
+		update.ClearLastCorrectionRequestID()
+	}
 
-	if _, err := update.Save(ctx); err != nil {
+	saved, err := update.Save(ctx)
+	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, ErrMarkingNotFound
+			return ErrMarkingNotFound
 		}
-		return nil, err
+		return err
+	}
+
+	if err := postHourBankDay(ctx, s.client, saved); err != nil {
+		return err
 	}
 
-	resp, err := s.List(ctx, MarkingsFilters{
-		Range: "custom",
-		StartDate: &ad.WorkDate,
-		EndDate: &ad.WorkDate,
-		Page: 1,
-		Limit: 500,
-	})
+	edit := s.client.AttendanceEdit.Create().
+		SetAttendanceDayID(id).
+		SetEditorID(in.EditorID).
+		SetNillableCorrectionRequestID(in.CorrectionRequestID).
+		SetReason(justification).
+		SetNillableWorkInAtBefore(ad.WorkInAt).
+		SetNillableWorkInAtAfter(workIn).
+		SetNillableBreakOutAtBefore(ad.BreakOutAt).
+		SetNillableBreakOutAtAfter(breakOut).
+		SetNillableBreakInAtBefore(ad.BreakInAt).
+		SetNillableBreakInAtAfter(breakIn).
+		SetNillableWorkOutAtBefore(ad.WorkOutAt).
+		SetNillableWorkOutAtAfter(workOut).
+		SetCreatedAt(now)
+	if _, err := edit.Save(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// History devuelve las ediciones de un día de asistencia en orden cronológico. Con
+// userID (autoservicio /me) el día debe ser de ese usuario; sin él se exige que la
+// sucursal del día sea visible para quien consulta o que el día sea propio.
+func (s *MarkingsService) History(ctx context.Context, id int, userID *int) ([]*ent.AttendanceEdit, error) {
+	ad, err := s.client.AttendanceDay.Query().Where(attendanceday.IDEQ(id)).Only(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrMarkingNotFound
+		}
 		return nil, err
 	}
 
-	for i := range resp.Items {
-		if resp.Items[i].ID == id {
-			item := resp.Items[i]
-			return &item, nil
+	if userID != nil {
+		// los días de otro usuario no existen para quien consulta
+		if ad.UserID != *userID {
+			return nil, ErrMarkingNotFound
 		}
+	} else if scope := AccessScopeFrom(ctx); !scope.CanView(ad.BranchID) && ad.UserID != scope.UserID {
+		return nil, ErrOutOfScope
 	}
 
-	return nil, ErrMarkingNotFound
+	return s.client.AttendanceEdit.Query().
+		Where(attendanceedit.AttendanceDayIDEQ(id)).
+		WithEditor().
+		Order(ent.Asc(attendanceedit.FieldCreatedAt), ent.Asc(attendanceedit.FieldID)).
+		All(ctx)
 }
 
 func (s *MarkingsService) Filters(ctx context.Context) (*MarkingsFiltersOptionsResponse, error) {
+	scope := AccessScopeFrom(ctx)
+
+	var branchArgs []any
+	branchWhere := scope.sqlFilter("id", "", &branchArgs)
 	branchesRows, err := s.db.QueryContext(ctx, `
 		SELECT id, name
 		FROM branches
-		WHERE is_active = true
+		WHERE is_active = true`+branchWhere+`
 		ORDER BY name ASC
-	`)
+	`, branchArgs...)
 	if err != nil {
 		return nil, err
 	}
@@ -708,12 +942,14 @@ func (s *MarkingsService) Filters(ctx context.Context) (*MarkingsFiltersOptionsR
 		return nil, err
 	}
 
+	var apArgs []any
+	apWhere := scope.sqlFilter("branch_id", "", &apArgs)
 	accessRows, err := s.db.QueryContext(ctx, `
 		SELECT id, branch_id, name
 		FROM access_points
-		WHERE is_active = true
+		WHERE is_active = true`+apWhere+`
 		ORDER BY name ASC
-	`)
+	`, apArgs...)
 	if err != nil {
 		return nil, err
 	}
@@ -758,6 +994,7 @@ func (s *MarkingsService) TopBranches(ctx context.Context, f MarkingsFilters) (*
 		args = append(args, *f.BranchID)
 		where += fmt.Sprintf(" AND ad.branch_id = $%d", len(args))
 	}
+	where += AccessScopeFrom(ctx).sqlFilter("ad.branch_id", "ad.user_id", &args)
 
 	query := `
 		SELECT
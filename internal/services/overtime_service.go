@@ -0,0 +1,438 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"back/internal/ent"
+	"back/internal/ent/attendanceday"
+	"back/internal/ent/overtimeauthorization"
+	"back/internal/ent/predicate"
+	"back/internal/ent/user"
+	"back/internal/ent/userbranch"
+)
+
+var (
+	ErrOvertimeInvalidInput = errors.New("invalid overtime authorization input")
+	ErrOvertimeDuplicate    = errors.New("overtime authorization already exists for that day")
+	ErrOvertimeInvalidState = errors.New("overtime authorization cannot change from its current status")
+	ErrOvertimeSelfReview   = errors.New("overtime authorization cannot be reviewed by its owner")
+)
+
+const (
+	OvertimeStatusRequested = "requested"
+	OvertimeStatusApproved  = "approved"
+	OvertimeStatusRejected  = "rejected"
+	OvertimeStatusCancelled = "cancelled"
+)
+
+type OvertimeService struct {
+	Client *ent.Client
+}
+
+func NewOvertimeService(client *ent.Client) *OvertimeService {
+	return &OvertimeService{Client: client}
+}
+
+type OvertimeAuthorizationFilters struct {
+	UserID    *int
+	BranchID  *int
+	Status    *string
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+type CreateOvertimeAuthorizationInput struct {
+	UserID     int
+	Date       time.Time
+	MaxMinutes int
+	Reason     *string
+}
+
+type PendingOvertimeFilters struct {
+	BranchID  *int
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+func (s *OvertimeService) List(ctx context.Context, f OvertimeAuthorizationFilters) ([]*ent.OvertimeAuthorization, error) {
+	q := s.Client.OvertimeAuthorization.Query()
+
+	// con alcance restringido: solo las propias y las de trabajadores de sus sucursales
+	if preds := userScope(ctx); preds != nil {
+		q = q.Where(overtimeauthorization.HasUserWith(preds...))
+	}
+	if f.UserID != nil {
+		q = q.Where(overtimeauthorization.UserIDEQ(*f.UserID))
+	}
+	if f.BranchID != nil {
+		q = q.Where(overtimeauthorization.HasUserWith(
+			user.HasUserBranchesWith(
+				userbranch.BranchIDEQ(*f.BranchID),
+				userbranch.IsActiveEQ(true),
+			),
+		))
+	}
+	if f.Status != nil {
+		q = q.Where(overtimeauthorization.StatusEQ(strings.ToLower(strings.TrimSpace(*f.Status))))
+	}
+	if f.StartDate != nil {
+		q = q.Where(overtimeauthorization.DateGTE(truncateToDay(*f.StartDate)))
+	}
+	if f.EndDate != nil {
+		q = q.Where(overtimeauthorization.DateLTE(truncateToDay(*f.EndDate)))
+	}
+
+	return q.Order(ent.Desc(overtimeauthorization.FieldDate), ent.Desc(overtimeauthorization.FieldID)).All(ctx)
+}
+
+func (s *OvertimeService) GetByID(ctx context.Context, id int) (*ent.OvertimeAuthorization, error) {
+	if id <= 0 {
+		return nil, ErrOvertimeInvalidInput
+	}
+	auth, err := s.Client.OvertimeAuthorization.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUserScope(ctx, s.Client, auth.UserID, false); err != nil {
+		return nil, err
+	}
+	return auth, nil
+}
+
+// Create registra un pacto de horas extra. Si lo crea un supervisor (approverID > 0)
+// queda aprobado de inmediato; si lo pide el propio trabajador queda pendiente.
+func (s *OvertimeService) Create(ctx context.Context, requestedByID, approverID int, in CreateOvertimeAuthorizationInput) (*ent.OvertimeAuthorization, error) {
+	if in.UserID <= 0 || in.Date.IsZero() || in.MaxMinutes <= 0 || in.MaxMinutes >= 24*60 {
+		return nil, ErrOvertimeInvalidInput
+	}
+	// nadie se autoriza sus propias horas extra
+	if approverID > 0 && approverID == in.UserID {
+		return nil, ErrOvertimeSelfReview
+	}
+	date := truncateToDay(in.Date)
+
+	if _, err := s.Client.User.Get(ctx, in.UserID); err != nil {
+		return nil, err
+	}
+	// pactar horas extra a otro trabajador exige supervisar su sucursal
+	if approverID > 0 || requestedByID != in.UserID {
+		if err := checkUserScope(ctx, s.Client, in.UserID, true); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	exists, err := tx.OvertimeAuthorization.Query().
+		Where(
+			overtimeauthorization.UserIDEQ(in.UserID),
+			overtimeauthorization.DateEQ(date),
+			overtimeauthorization.StatusIn(OvertimeStatusRequested, OvertimeStatusApproved),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrOvertimeDuplicate
+	}
+
+	create := tx.OvertimeAuthorization.Create().
+		SetUserID(in.UserID).
+		SetDate(date).
+		SetMaxMinutes(in.MaxMinutes).
+		SetStatus(OvertimeStatusRequested)
+
+	if requestedByID > 0 {
+		create.SetRequestedByID(requestedByID)
+	}
+	if approverID > 0 {
+		create.SetStatus(OvertimeStatusApproved).
+			SetApprovedByID(approverID).
+			SetReviewedAt(time.Now())
+	}
+	if in.Reason != nil {
+		if r := strings.TrimSpace(*in.Reason); r != "" {
+			create.SetReason(r)
+		}
+	}
+
+	auth, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := refreshOvertimeSplit(ctx, tx.Client(), in.UserID, date); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return auth, nil
+}
+
+// Review aprueba o rechaza una autorización pendiente. Al aprobar se puede ajustar
+// el máximo de minutos autorizado.
+func (s *OvertimeService) Review(ctx context.Context, id, reviewerID int, approve bool, maxMinutes *int, notes *string) (*ent.OvertimeAuthorization, error) {
+	if id <= 0 || reviewerID <= 0 {
+		return nil, ErrOvertimeInvalidInput
+	}
+	if maxMinutes != nil && (*maxMinutes <= 0 || *maxMinutes >= 24*60) {
+		return nil, ErrOvertimeInvalidInput
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	auth, err := tx.OvertimeAuthorization.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if auth.Status != OvertimeStatusRequested {
+		return nil, ErrOvertimeInvalidState
+	}
+	if auth.UserID == reviewerID {
+		return nil, ErrOvertimeSelfReview
+	}
+	if err := checkUserScope(ctx, s.Client, auth.UserID, true); err != nil {
+		return nil, err
+	}
+
+	status := OvertimeStatusRejected
+	if approve {
+		status = OvertimeStatusApproved
+	}
+
+	upd := auth.Update().
+		SetStatus(status).
+		SetApprovedByID(reviewerID).
+		SetReviewedAt(time.Now())
+
+	if approve && maxMinutes != nil {
+		upd.SetMaxMinutes(*maxMinutes)
+	}
+	if notes != nil {
+		if n := strings.TrimSpace(*notes); n != "" {
+			upd.SetReviewNotes(n)
+		}
+	}
+
+	auth, err = upd.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := refreshOvertimeSplit(ctx, tx.Client(), auth.UserID, auth.Date); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return auth, nil
+}
+
+// Cancel anula una autorización pendiente o aprobada; las horas extra del día
+// vuelven a quedar como no autorizadas.
+func (s *OvertimeService) Cancel(ctx context.Context, id int) (*ent.OvertimeAuthorization, error) {
+	if id <= 0 {
+		return nil, ErrOvertimeInvalidInput
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	auth, err := tx.OvertimeAuthorization.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if scope := AccessScopeFrom(ctx); scope.Restricted() && auth.UserID != scope.UserID {
+		if err := checkUserScope(ctx, s.Client, auth.UserID, true); err != nil {
+			return nil, err
+		}
+	}
+	if auth.Status != OvertimeStatusRequested && auth.Status != OvertimeStatusApproved {
+		return nil, ErrOvertimeInvalidState
+	}
+
+	auth, err = auth.Update().SetStatus(OvertimeStatusCancelled).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := refreshOvertimeSplit(ctx, tx.Client(), auth.UserID, auth.Date); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return auth, nil
+}
+
+// Pending devuelve la cola del supervisor: días con horas extra no autorizadas
+// que todavía no tienen una autorización rechazada.
+func (s *OvertimeService) Pending(ctx context.Context, f PendingOvertimeFilters) ([]*ent.AttendanceDay, error) {
+	q := s.Client.AttendanceDay.Query().
+		Where(attendanceday.UnapprovedOvertimeMinutesGT(0))
+
+	if f.BranchID != nil {
+		q = q.Where(attendanceday.BranchIDEQ(*f.BranchID))
+	}
+	if scope := AccessScopeFrom(ctx); scope.Restricted() {
+		q = q.Where(attendanceday.BranchIDIn(scope.ManagedBranchIDs()...))
+	}
+	if f.StartDate != nil {
+		q = q.Where(attendanceday.WorkDateGTE(truncateToDay(*f.StartDate)))
+	}
+	if f.EndDate != nil {
+		q = q.Where(attendanceday.WorkDateLT(truncateToDay(*f.EndDate).AddDate(0, 0, 1)))
+	}
+
+	days, err := q.
+		WithUser().
+		WithBranch().
+		Order(ent.Desc(attendanceday.FieldWorkDate), ent.Asc(attendanceday.FieldUserID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(days) == 0 {
+		return days, nil
+	}
+
+	// descartar los días cuya autorización ya fue rechazada
+	preds := make([]predicate.OvertimeAuthorization, 0, len(days))
+	for _, ad := range days {
+		preds = append(preds, overtimeauthorization.And(
+			overtimeauthorization.UserIDEQ(ad.UserID),
+			overtimeauthorization.DateEQ(truncateToDay(ad.WorkDate)),
+		))
+	}
+	rejected, err := s.Client.OvertimeAuthorization.Query().
+		Where(
+			overtimeauthorization.StatusEQ(OvertimeStatusRejected),
+			overtimeauthorization.Or(preds...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(rejected) == 0 {
+		return days, nil
+	}
+
+	rejectedKeys := make(map[string]bool, len(rejected))
+	for _, r := range rejected {
+		rejectedKeys[overtimeKey(r.UserID, r.Date)] = true
+	}
+
+	out := make([]*ent.AttendanceDay, 0, len(days))
+	for _, ad := range days {
+		if rejectedKeys[overtimeKey(ad.UserID, ad.WorkDate)] {
+			continue
+		}
+		out = append(out, ad)
+	}
+	return out, nil
+}
+
+// overtimeSplit separa las horas extra del día entre autorizadas (hasta el máximo de
+// la autorización aprobada) y no autorizadas.
+func overtimeSplit(ctx context.Context, client *ent.Client, userID int, workDate time.Time, overtime *int) (*int, *int, error) {
+	if overtime == nil {
+		return nil, nil, nil
+	}
+
+	auth, err := client.OvertimeAuthorization.Query().
+		Where(
+			overtimeauthorization.UserIDEQ(userID),
+			overtimeauthorization.DateEQ(truncateToDay(workDate)),
+			overtimeauthorization.StatusEQ(OvertimeStatusApproved),
+		).
+		Order(ent.Desc(overtimeauthorization.FieldID)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, nil, err
+	}
+
+	approved := 0
+	if auth != nil {
+		approved = *overtime
+		if approved > auth.MaxMinutes {
+			approved = auth.MaxMinutes
+		}
+	}
+	unapproved := *overtime - approved
+	return &approved, &unapproved, nil
+}
+
+// setOvertimeSplit deja en el update del AttendanceDay la separación de horas extra.
+func setOvertimeSplit(ctx context.Context, client *ent.Client, update *ent.AttendanceDayUpdateOne, userID int, workDate time.Time, overtime *int) error {
+	approved, unapproved, err := overtimeSplit(ctx, client, userID, workDate, overtime)
+	if err != nil {
+		return err
+	}
+	if approved != nil {
+		update.SetApprovedOvertimeMinutes(*approved)
+	} else {
+		update.ClearApprovedOvertimeMinutes()
+	}
+	if unapproved != nil {
+		update.SetUnapprovedOvertimeMinutes(*unapproved)
+	} else {
+		update.ClearUnapprovedOvertimeMinutes()
+	}
+	return nil
+}
+
+// refreshOvertimeSplit recalcula la separación en los AttendanceDay del usuario para el día
+// (tras crear, revisar o cancelar una autorización).
+func refreshOvertimeSplit(ctx context.Context, client *ent.Client, userID int, date time.Time) error {
+	day := truncateToDay(date)
+	days, err := client.AttendanceDay.Query().
+		Where(
+			attendanceday.UserIDEQ(userID),
+			attendanceday.WorkDateGTE(day),
+			attendanceday.WorkDateLT(day.AddDate(0, 0, 1)),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, ad := range days {
+		update := client.AttendanceDay.UpdateOneID(ad.ID)
+		if err := setOvertimeSplit(ctx, client, update, userID, ad.WorkDate, ad.OvertimeMinutes); err != nil {
+			return err
+		}
+		saved, err := update.Save(ctx)
+		if err != nil {
+			return err
+		}
+		// aprobar o anular horas extra cambia lo que entra a la bolsa de horas
+		if err := postHourBankDay(ctx, client, saved); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func overtimeKey(userID int, date time.Time) string {
+	return fmt.Sprintf("%d|%s", userID, truncateToDay(date).Format("2006-01-02"))
+}
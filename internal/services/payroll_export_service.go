@@ -0,0 +1,625 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"back/internal/ent"
+	"back/internal/ent/attendanceday"
+	"back/internal/ent/payrollexportprofile"
+	"back/internal/ent/schema"
+	"back/internal/ent/user"
+	"back/internal/ent/userbranch"
+)
+
+var ErrPayrollInvalidInput = errors.New("invalid payroll export input")
+var ErrPayrollProfileNameTaken = errors.New("payroll export profile name already exists")
+
+const (
+	PayrollPeriodMonth = "month"
+	PayrollPeriodWeek  = "week"
+	PayrollPeriodDay   = "day"
+)
+
+// PayrollFields son los datos que se pueden mapear a columnas del archivo.
+var PayrollFields = []string{
+	// trabajador
+	"employee_code", "username", "first_name", "last_name", "full_name", "email",
+	// período
+	"period", "period_start", "period_end",
+	// días
+	"scheduled_days", "worked_days", "absent_days", "leave_days", "holiday_days",
+	// minutos / horas
+	"worked_minutes", "worked_hours",
+	"late_minutes", "early_exit_minutes",
+	"overtime_minutes", "overtime_hours",
+	"approved_overtime_minutes", "approved_overtime_hours",
+	"unapproved_overtime_minutes",
+	"net_minutes_balance",
+	// valor fijo (usa Value)
+	"constant",
+}
+
+type PayrollExportService struct {
+	Client *ent.Client
+}
+
+func NewPayrollExportService(client *ent.Client) *PayrollExportService {
+	return &PayrollExportService{Client: client}
+}
+
+type PayrollProfileInput struct {
+	Name             *string
+	Description      *string
+	Separator        *string
+	DateFormat       *string
+	DecimalSeparator *string
+	Period           *string
+	IncludeHeader    *bool
+	Columns          *[]schema.PayrollColumn
+}
+
+// PayrollExport es el archivo renderizado: filas ya formateadas según el perfil.
+type PayrollExport struct {
+	Profile *ent.PayrollExportProfile
+	Month   time.Time
+	Header  []string
+	Rows    [][]string
+	// trabajadores omitidos por no tener employee_code
+	SkippedUserIDs []int
+}
+
+func (s *PayrollExportService) List(ctx context.Context) ([]*ent.PayrollExportProfile, error) {
+	return s.Client.PayrollExportProfile.Query().
+		Order(ent.Asc(payrollexportprofile.FieldName)).
+		All(ctx)
+}
+
+func (s *PayrollExportService) Get(ctx context.Context, id int) (*ent.PayrollExportProfile, error) {
+	if id <= 0 {
+		return nil, ErrPayrollInvalidInput
+	}
+	return s.Client.PayrollExportProfile.Get(ctx, id)
+}
+
+func (s *PayrollExportService) Create(ctx context.Context, in PayrollProfileInput) (*ent.PayrollExportProfile, error) {
+	if in.Name == nil || in.Columns == nil {
+		return nil, ErrPayrollInvalidInput
+	}
+	if err := normalizePayrollProfileInput(&in); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.Client.PayrollExportProfile.Query().
+		Where(payrollexportprofile.NameEQ(*in.Name)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrPayrollProfileNameTaken
+	}
+
+	create := s.Client.PayrollExportProfile.Create().
+		SetName(*in.Name).
+		SetNillableDescription(in.Description).
+		SetNillableSeparator(in.Separator).
+		SetNillableDateFormat(in.DateFormat).
+		SetNillableDecimalSeparator(in.DecimalSeparator).
+		SetNillablePeriod(in.Period).
+		SetNillableIncludeHeader(in.IncludeHeader).
+		SetColumns(*in.Columns)
+
+	return create.Save(ctx)
+}
+
+func (s *PayrollExportService) Patch(ctx context.Context, id int, in PayrollProfileInput) (*ent.PayrollExportProfile, error) {
+	if id <= 0 {
+		return nil, ErrPayrollInvalidInput
+	}
+	if in.Name == nil &&
+		in.Description == nil &&
+		in.Separator == nil &&
+		in.DateFormat == nil &&
+		in.DecimalSeparator == nil &&
+		in.Period == nil &&
+		in.IncludeHeader == nil &&
+		in.Columns == nil {
+		return nil, ErrPayrollInvalidInput
+	}
+	if err := normalizePayrollProfileInput(&in); err != nil {
+		return nil, err
+	}
+
+	p, err := s.Client.PayrollExportProfile.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Name != nil && *in.Name != p.Name {
+		exists, err := s.Client.PayrollExportProfile.Query().
+			Where(payrollexportprofile.NameEQ(*in.Name), payrollexportprofile.IDNEQ(id)).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, ErrPayrollProfileNameTaken
+		}
+	}
+
+	upd := p.Update().
+		SetNillableName(in.Name).
+		SetNillableSeparator(in.Separator).
+		SetNillableDateFormat(in.DateFormat).
+		SetNillableDecimalSeparator(in.DecimalSeparator).
+		SetNillablePeriod(in.Period).
+		SetNillableIncludeHeader(in.IncludeHeader)
+
+	if in.Description != nil {
+		if *in.Description == "" {
+			upd.ClearDescription()
+		} else {
+			upd.SetDescription(*in.Description)
+		}
+	}
+	if in.Columns != nil {
+		upd.SetColumns(*in.Columns)
+	}
+
+	return upd.Save(ctx)
+}
+
+func (s *PayrollExportService) Delete(ctx context.Context, id int) error {
+	if id <= 0 {
+		return ErrPayrollInvalidInput
+	}
+	return s.Client.PayrollExportProfile.DeleteOneID(id).Exec(ctx)
+}
+
+// Render arma el archivo del perfil para el mes indicado: una fila por trabajador
+// (employee_code) y período, con los agregados de sus días de asistencia.
+// branchID opcional limita a los trabajadores y la asistencia de esa sucursal.
+func (s *PayrollExportService) Render(ctx context.Context, profileID int, month time.Time, branchID *int) (*PayrollExport, error) {
+	p, err := s.Get(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+	layout, ok := payrollDateLayout(p.DateFormat)
+	if !ok {
+		return nil, ErrPayrollInvalidInput
+	}
+
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
+	end := start.AddDate(0, 1, -1)
+
+	// con alcance restringido la exportación es siempre por sucursal visible
+	scope := AccessScopeFrom(ctx)
+	if scope.Restricted() && (branchID == nil || !scope.CanView(*branchID)) {
+		return nil, ErrOutOfScope
+	}
+	if branchID != nil {
+		if _, err := s.Client.Branch.Get(ctx, *branchID); err != nil {
+			return nil, err
+		}
+	}
+
+	users, err := s.payrollUsers(ctx, branchID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &PayrollExport{
+		Profile:        p,
+		Month:          start,
+		Rows:           [][]string{},
+		SkippedUserIDs: []int{},
+	}
+	if p.IncludeHeader {
+		for _, c := range p.Columns {
+			h := c.Header
+			if h == "" {
+				h = c.Field
+			}
+			out.Header = append(out.Header, h)
+		}
+	}
+
+	// calendario y asistencia se cargan una vez para todos los trabajadores del archivo
+	userIDs := make([]int, 0, len(users))
+	for _, u := range users {
+		userIDs = append(userIDs, u.ID)
+	}
+	shifts := &ShiftService{Client: s.Client}
+	sources, err := shifts.loadCalendarSources(ctx, userIDs, start, end)
+	if err != nil {
+		return nil, err
+	}
+	userBranches, err := s.activeUserBranches(ctx, userIDs, branchID)
+	if err != nil {
+		return nil, err
+	}
+	holidays := newCalendarHolidays(s.Client, start, end)
+	today := truncateToDay(time.Now())
+
+	for _, u := range users {
+		if u.EmployeeCode == nil || strings.TrimSpace(*u.EmployeeCode) == "" {
+			out.SkippedUserIDs = append(out.SkippedUserIDs, u.ID)
+			continue
+		}
+
+		branchIDs := userBranches[u.ID]
+		hols, err := holidays.forBranches(ctx, branchIDs)
+		if err != nil {
+			return nil, err
+		}
+		src := sources[u.ID]
+		calendar := buildCalendar(src, branchIDs, hols, start, end)
+
+		days := src.attendances
+		if branchID != nil {
+			days = make([]*ent.AttendanceDay, 0, len(src.attendances))
+			for _, ad := range src.attendances {
+				if ad.BranchID == *branchID {
+					days = append(days, ad)
+				}
+			}
+		}
+
+		for _, b := range payrollBuckets(p.Period, start, end) {
+			agg := aggregatePayrollBucket(b, calendar, days, today)
+			out.Rows = append(out.Rows, payrollRow(p, layout, u, agg))
+		}
+	}
+
+	return out, nil
+}
+
+/* =========================
+   INTERNAL
+   ========================= */
+
+// payrollUsers: trabajadores activos (de la sucursal si se indica) más los que
+// registraron asistencia en el mes, ordenados por employee_code.
+func (s *PayrollExportService) payrollUsers(ctx context.Context, branchID *int, start, end time.Time) ([]*ent.User, error) {
+	attendedQ := s.Client.AttendanceDay.Query().
+		Where(
+			attendanceday.WorkDateGTE(start),
+			attendanceday.WorkDateLT(end.AddDate(0, 0, 1)),
+		)
+	if branchID != nil {
+		attendedQ = attendedQ.Where(attendanceday.BranchIDEQ(*branchID))
+	}
+	attended, err := attendedQ.Unique(true).Select(attendanceday.FieldUserID).Ints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	q := s.Client.User.Query()
+	if branchID != nil {
+		assigned, err := s.Client.UserBranch.Query().
+			Where(userbranch.BranchIDEQ(*branchID), userbranch.IsActiveEQ(true)).
+			Select(userbranch.FieldUserID).
+			Ints(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids := append(assigned, attended...)
+		if len(ids) == 0 {
+			return []*ent.User{}, nil
+		}
+		q = q.Where(user.IDIn(ids...))
+	} else {
+		q = q.Where(user.Or(user.IsActiveEQ(true), user.IDIn(attended...)))
+	}
+
+	return q.
+		Order(ent.Asc(user.FieldEmployeeCode), ent.Asc(user.FieldID)).
+		All(ctx)
+}
+
+// activeUserBranches devuelve las sucursales activas de cada trabajador; con branchID
+// todos quedan limitados a esa sucursal.
+func (s *PayrollExportService) activeUserBranches(ctx context.Context, userIDs []int, branchID *int) (map[int][]int, error) {
+	out := make(map[int][]int, len(userIDs))
+	if branchID != nil {
+		for _, id := range userIDs {
+			out[id] = []int{*branchID}
+		}
+		return out, nil
+	}
+	if len(userIDs) == 0 {
+		return out, nil
+	}
+
+	rows, err := s.Client.UserBranch.Query().
+		Where(userbranch.UserIDIn(userIDs...), userbranch.IsActiveEQ(true)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, ub := range rows {
+		out[ub.UserID] = append(out[ub.UserID], ub.BranchID)
+	}
+	return out, nil
+}
+
+type payrollBucket struct {
+	Start time.Time
+	End   time.Time
+}
+
+// payrollBuckets divide el mes según la agregación del perfil. Las semanas van de
+// lunes a domingo, recortadas a los límites del mes.
+func payrollBuckets(period string, start, end time.Time) []payrollBucket {
+	switch period {
+	case PayrollPeriodDay:
+		out := []payrollBucket{}
+		for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+			out = append(out, payrollBucket{Start: d, End: d})
+		}
+		return out
+	case PayrollPeriodWeek:
+		out := []payrollBucket{}
+		for d := start; !d.After(end); {
+			offset := (int(d.Weekday()) + 6) % 7 // lunes = 0
+			weekEnd := d.AddDate(0, 0, 6-offset)
+			if weekEnd.After(end) {
+				weekEnd = end
+			}
+			out = append(out, payrollBucket{Start: d, End: weekEnd})
+			d = weekEnd.AddDate(0, 0, 1)
+		}
+		return out
+	default:
+		return []payrollBucket{{Start: start, End: end}}
+	}
+}
+
+type payrollAggregate struct {
+	Bucket                    payrollBucket
+	ScheduledDays             int
+	WorkedDays                int
+	AbsentDays                int
+	LeaveDays                 int
+	HolidayDays               int
+	WorkedMinutes             int
+	LateMinutes               int
+	EarlyExitMinutes          int
+	OvertimeMinutes           int
+	ApprovedOvertimeMinutes   int
+	UnapprovedOvertimeMinutes int
+	NetMinutesBalance         int
+}
+
+func aggregatePayrollBucket(b payrollBucket, calendar []CalendarDay, days []*ent.AttendanceDay, today time.Time) payrollAggregate {
+	agg := payrollAggregate{Bucket: b}
+	inBucket := func(t time.Time) bool {
+		d := truncateToDay(t)
+		return !d.Before(b.Start) && !d.After(b.End)
+	}
+
+	worked := map[string]bool{}
+	for _, ad := range days {
+		if !inBucket(ad.WorkDate) {
+			continue
+		}
+		if ad.WorkInAt != nil {
+			worked[ad.WorkDate.Format("2006-01-02")] = true
+		}
+		agg.WorkedMinutes += calendarWorkedMinutes(ad)
+		agg.LateMinutes += intOrZero(ad.LateMinutes)
+		agg.EarlyExitMinutes += intOrZero(ad.EarlyExitMinutes)
+		agg.OvertimeMinutes += intOrZero(ad.OvertimeMinutes)
+		agg.ApprovedOvertimeMinutes += intOrZero(ad.ApprovedOvertimeMinutes)
+		if ad.UnapprovedOvertimeMinutes != nil {
+			agg.UnapprovedOvertimeMinutes += *ad.UnapprovedOvertimeMinutes
+		} else {
+			agg.UnapprovedOvertimeMinutes += intOrZero(ad.OvertimeMinutes)
+		}
+		agg.NetMinutesBalance += intOrZero(ad.NetMinutesBalance)
+	}
+	agg.WorkedDays = len(worked)
+
+	for _, cd := range calendar {
+		if !inBucket(cd.Date) {
+			continue
+		}
+		switch cd.Status {
+		case CalendarStatusScheduled:
+			agg.ScheduledDays++
+			if !worked[cd.Date.Format("2006-01-02")] && cd.Date.Before(today) {
+				agg.AbsentDays++
+			}
+		case CalendarStatusLeave:
+			agg.LeaveDays++
+		case CalendarStatusHoliday:
+			agg.HolidayDays++
+		}
+	}
+
+	return agg
+}
+
+func payrollRow(p *ent.PayrollExportProfile, layout string, u *ent.User, agg payrollAggregate) []string {
+	hours := func(minutes int) string {
+		v := strconv.FormatFloat(float64(minutes)/60, 'f', 2, 64)
+		if p.DecimalSeparator != "." {
+			v = strings.Replace(v, ".", p.DecimalSeparator, 1)
+		}
+		return v
+	}
+	str := func(v *string) string {
+		if v == nil {
+			return ""
+		}
+		return *v
+	}
+
+	row := make([]string, 0, len(p.Columns))
+	for _, c := range p.Columns {
+		var v string
+		switch c.Field {
+		case "employee_code":
+			v = str(u.EmployeeCode)
+		case "username":
+			v = u.Username
+		case "first_name":
+			v = str(u.FirstName)
+		case "last_name":
+			v = str(u.LastName)
+		case "full_name":
+			v = strings.TrimSpace(str(u.FirstName) + " " + str(u.LastName))
+		case "email":
+			v = str(u.Email)
+		case "period":
+			v = agg.Bucket.Start.Format("2006-01")
+		case "period_start":
+			v = agg.Bucket.Start.Format(layout)
+		case "period_end":
+			v = agg.Bucket.End.Format(layout)
+		case "scheduled_days":
+			v = strconv.Itoa(agg.ScheduledDays)
+		case "worked_days":
+			v = strconv.Itoa(agg.WorkedDays)
+		case "absent_days":
+			v = strconv.Itoa(agg.AbsentDays)
+		case "leave_days":
+			v = strconv.Itoa(agg.LeaveDays)
+		case "holiday_days":
+			v = strconv.Itoa(agg.HolidayDays)
+		case "worked_minutes":
+			v = strconv.Itoa(agg.WorkedMinutes)
+		case "worked_hours":
+			v = hours(agg.WorkedMinutes)
+		case "late_minutes":
+			v = strconv.Itoa(agg.LateMinutes)
+		case "early_exit_minutes":
+			v = strconv.Itoa(agg.EarlyExitMinutes)
+		case "overtime_minutes":
+			v = strconv.Itoa(agg.OvertimeMinutes)
+		case "overtime_hours":
+			v = hours(agg.OvertimeMinutes)
+		case "approved_overtime_minutes":
+			v = strconv.Itoa(agg.ApprovedOvertimeMinutes)
+		case "approved_overtime_hours":
+			v = hours(agg.ApprovedOvertimeMinutes)
+		case "unapproved_overtime_minutes":
+			v = strconv.Itoa(agg.UnapprovedOvertimeMinutes)
+		case "net_minutes_balance":
+			v = strconv.Itoa(agg.NetMinutesBalance)
+		case "constant":
+			v = c.Value
+		}
+		row = append(row, v)
+	}
+	return row
+}
+
+// payrollDateLayout convierte el formato del perfil (YYYY, YY, MM, DD) al layout de Go.
+// Fuera de los tokens solo se aceptan separadores (no letras ni dígitos).
+func payrollDateLayout(format string) (string, bool) {
+	if strings.TrimSpace(format) == "" {
+		return "", false
+	}
+	var b strings.Builder
+	for i := 0; i < len(format); {
+		switch {
+		case strings.HasPrefix(format[i:], "YYYY"):
+			b.WriteString("2006")
+			i += 4
+		case strings.HasPrefix(format[i:], "YY"):
+			b.WriteString("06")
+			i += 2
+		case strings.HasPrefix(format[i:], "MM"):
+			b.WriteString("01")
+			i += 2
+		case strings.HasPrefix(format[i:], "DD"):
+			b.WriteString("02")
+			i += 2
+		default:
+			c := format[i]
+			if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') {
+				return "", false
+			}
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return b.String(), true
+}
+
+func normalizePayrollProfileInput(in *PayrollProfileInput) error {
+	if in.Name != nil {
+		n := strings.TrimSpace(*in.Name)
+		if n == "" {
+			return ErrPayrollInvalidInput
+		}
+		in.Name = &n
+	}
+	if in.Description != nil {
+		d := strings.TrimSpace(*in.Description)
+		in.Description = &d
+	}
+	if in.Separator != nil {
+		sep := *in.Separator
+		if sep == `\t` || strings.EqualFold(sep, "tab") {
+			sep = "\t"
+		}
+		r, size := utf8.DecodeRuneInString(sep)
+		if size == 0 || size != len(sep) || r == '"' || r == '\r' || r == '\n' || r == utf8.RuneError {
+			return ErrPayrollInvalidInput
+		}
+		in.Separator = &sep
+	}
+	if in.DateFormat != nil {
+		f := strings.TrimSpace(*in.DateFormat)
+		if _, ok := payrollDateLayout(f); !ok {
+			return ErrPayrollInvalidInput
+		}
+		in.DateFormat = &f
+	}
+	if in.DecimalSeparator != nil && *in.DecimalSeparator != "," && *in.DecimalSeparator != "." {
+		return ErrPayrollInvalidInput
+	}
+	if in.Period != nil {
+		p := strings.ToLower(strings.TrimSpace(*in.Period))
+		if p != PayrollPeriodMonth && p != PayrollPeriodWeek && p != PayrollPeriodDay {
+			return ErrPayrollInvalidInput
+		}
+		in.Period = &p
+	}
+	if in.Columns != nil {
+		if len(*in.Columns) == 0 {
+			return ErrPayrollInvalidInput
+		}
+		cols := make([]schema.PayrollColumn, 0, len(*in.Columns))
+		for _, c := range *in.Columns {
+			c.Field = strings.ToLower(strings.TrimSpace(c.Field))
+			c.Header = strings.TrimSpace(c.Header)
+			if !isPayrollField(c.Field) {
+				return fmt.Errorf("%w: unknown field %q", ErrPayrollInvalidInput, c.Field)
+			}
+			if c.Field != "constant" {
+				c.Value = ""
+			}
+			cols = append(cols, c)
+		}
+		in.Columns = &cols
+	}
+	return nil
+}
+
+func isPayrollField(f string) bool {
+	for _, v := range PayrollFields {
+		if v == f {
+			return true
+		}
+	}
+	return false
+}
@@ -50,12 +50,14 @@ func (s *QRSessionService) GenerateQRSession(ctx context.Context, userID int) (*
 
 	// Revocar cualquier QR anterior del mismo usuario que siga activo
 	// (opcional, para evitar múltiples QRs simultáneos)
+	now := time.Now()
 	_, _ = s.Client.UserQRSession.
 		Update().
 		Where(userqrsession.UserIDEQ(userID)).
-		Where(userqrsession.ExpiresAtGT(time.Now())).
+		Where(userqrsession.ExpiresAtGT(now)).
 		Where(userqrsession.IsRevokedEQ(false)).
 		SetIsRevoked(true).
+		SetRevokedAt(now).
 		Save(ctx)
 
 	// Crear nueva sesión
@@ -82,6 +84,12 @@ func (s *QRSessionService) GenerateQRSession(ctx context.Context, userID int) (*
 
 // ValidateAndGetQRSession valida que el token exista, no esté revocado y no haya expirado
 func (s *QRSessionService) ValidateAndGetQRSession(ctx context.Context, tokenPlain string) (*QRSessionInfo, *ent.User, error) {
+	return s.ValidateAndGetQRSessionAt(ctx, tokenPlain, time.Now())
+}
+
+// ValidateAndGetQRSessionAt valida el token en un instante dado (marcaciones offline
+// sincronizadas después): el QR debía estar vigente cuando se escaneó.
+func (s *QRSessionService) ValidateAndGetQRSessionAt(ctx context.Context, tokenPlain string, at time.Time) (*QRSessionInfo, *ent.User, error) {
 	// Hashear el token pasado
 	hash := HashQRToken(tokenPlain)
 
@@ -98,13 +106,14 @@ func (s *QRSessionService) ValidateAndGetQRSession(ctx context.Context, tokenPla
 		return nil, nil, err
 	}
 
-	// Validar que no esté revocado
-	if qr.IsRevoked {
+	// Validar que no estuviera revocado al escanearse: un QR reemplazado por uno nuevo
+	// sigue valiendo para las marcaciones offline hechas antes de la revocación
+	if qr.IsRevoked && (qr.RevokedAt == nil || !at.Before(*qr.RevokedAt)) {
 		return nil, nil, ErrQRSessionRevoked
 	}
 
 	// Validar que no haya expirado
-	if time.Now().After(qr.ExpiresAt) {
+	if at.Before(qr.IssuedAt) || at.After(qr.ExpiresAt) {
 		return nil, nil, ErrQRSessionExpired
 	}
 
@@ -124,7 +133,9 @@ func (s *QRSessionService) RevokeQRSession(ctx context.Context, tokenPlain strin
 	err := s.Client.UserQRSession.
 		Update().
 		Where(userqrsession.TokenHashEQ(hash)).
+		Where(userqrsession.IsRevokedEQ(false)).
 		SetIsRevoked(true).
+		SetRevokedAt(time.Now()).
 		Exec(ctx)
 	if err != nil {
 		return err
@@ -0,0 +1,276 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"back/internal/auth"
+	"back/internal/ent"
+	"back/internal/ent/role"
+	"back/internal/ent/user"
+)
+
+var (
+	ErrRoleInvalidInput = errors.New("invalid role input")
+	ErrRoleNameTaken    = errors.New("role name already exists")
+	ErrRoleSystem       = errors.New("system roles cannot be renamed or deleted")
+	ErrRoleInUse        = errors.New("role is assigned to users")
+)
+
+type RoleService struct {
+	Client *ent.Client
+}
+
+func NewRoleService(client *ent.Client) *RoleService {
+	return &RoleService{Client: client}
+}
+
+type CreateRoleInput struct {
+	Name        string
+	Description *string
+	Permissions []string
+}
+
+type PatchRoleInput struct {
+	Name        *string
+	Description *string
+	Permissions *[]string
+}
+
+func (s *RoleService) List(ctx context.Context) ([]*ent.Role, error) {
+	return s.Client.Role.Query().
+		Order(ent.Desc(role.FieldIsSystem), ent.Asc(role.FieldName)).
+		All(ctx)
+}
+
+func (s *RoleService) Get(ctx context.Context, id int) (*ent.Role, error) {
+	if id <= 0 {
+		return nil, ErrRoleInvalidInput
+	}
+	return s.Client.Role.Get(ctx, id)
+}
+
+func (s *RoleService) Create(ctx context.Context, in CreateRoleInput) (*ent.Role, error) {
+	name, err := normalizeRoleName(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	perms, err := normalizeRolePermissions(in.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureNameFree(ctx, name, 0); err != nil {
+		return nil, err
+	}
+
+	return s.Client.Role.Create().
+		SetName(name).
+		SetNillableDescription(in.Description).
+		SetPermissions(perms).
+		Save(ctx)
+}
+
+// Patch edita el rol. Los roles de sistema no se renombran y admin conserva siempre
+// el catálogo completo. Los cambios de permisos aplican a los tokens que se emitan
+// desde ahora (login o refresh).
+func (s *RoleService) Patch(ctx context.Context, id int, in PatchRoleInput) (*ent.Role, error) {
+	if in.Name == nil && in.Description == nil && in.Permissions == nil {
+		return nil, ErrRoleInvalidInput
+	}
+
+	r, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	upd := tx.Role.UpdateOneID(r.ID)
+
+	if in.Name != nil {
+		name, err := normalizeRoleName(*in.Name)
+		if err != nil {
+			return nil, err
+		}
+		if name != r.Name {
+			if r.IsSystem {
+				return nil, ErrRoleSystem
+			}
+			if err := s.ensureNameFree(ctx, name, r.ID); err != nil {
+				return nil, err
+			}
+			upd.SetName(name)
+
+			// User.role guarda el nombre: los usuarios del rol pasan al nombre nuevo
+			if _, err := tx.User.Update().
+				Where(user.RoleEQ(r.Name)).
+				SetRole(name).
+				Save(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if in.Description != nil {
+		if v := strings.TrimSpace(*in.Description); v != "" {
+			upd.SetDescription(v)
+		} else {
+			upd.ClearDescription()
+		}
+	}
+
+	if in.Permissions != nil {
+		if r.Name == "admin" {
+			return nil, ErrRoleSystem
+		}
+		perms, err := normalizeRolePermissions(*in.Permissions)
+		if err != nil {
+			return nil, err
+		}
+		upd.SetPermissions(perms)
+	}
+
+	saved, err := upd.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+func (s *RoleService) Delete(ctx context.Context, id int) error {
+	r, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if r.IsSystem {
+		return ErrRoleSystem
+	}
+
+	inUse, err := s.Client.User.Query().Where(user.RoleEQ(r.Name)).Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return ErrRoleInUse
+	}
+
+	return s.Client.Role.DeleteOneID(id).Exec(ctx)
+}
+
+// EnsureDefaults crea los roles de sistema que falten y mantiene admin con el
+// catálogo completo (para que los permisos nuevos queden disponibles al desplegar).
+func (s *RoleService) EnsureDefaults(ctx context.Context) error {
+	names := make([]string, 0, len(auth.DefaultRoles))
+	for name := range auth.DefaultRoles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		r, err := s.Client.Role.Query().Where(role.NameEQ(name)).Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			if _, err := s.Client.Role.Create().
+				SetName(name).
+				SetPermissions(auth.DefaultRoles[name]).
+				SetIsSystem(true).
+				Save(ctx); err != nil {
+				return fmt.Errorf("creating role %s: %w", name, err)
+			}
+		case err != nil:
+			return err
+		case name == "admin":
+			if _, err := r.Update().
+				SetPermissions(auth.AllPermissions()).
+				SetIsSystem(true).
+				Save(ctx); err != nil {
+				return err
+			}
+		case !r.IsSystem:
+			if _, err := r.Update().SetIsSystem(true).Save(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rolePermissions devuelve los permisos del rol para embeber en el token. Un rol que
+// ya no existe no tiene permisos (nunca nil, para no caer en los permisos por defecto).
+func rolePermissions(ctx context.Context, client *ent.Client, name string) ([]string, error) {
+	r, err := client.Role.Query().Where(role.NameEQ(name)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	if r.Permissions == nil {
+		return []string{}, nil
+	}
+	return r.Permissions, nil
+}
+
+// ensureRoleExists valida el rol asignado a un usuario (un rol inexistente es
+// ErrInvalidInput, igual que cualquier otro dato inválido del usuario).
+func ensureRoleExists(ctx context.Context, client *ent.Client, name string) error {
+	ok, err := client.Role.Query().Where(role.NameEQ(name)).Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidInput
+	}
+	return nil
+}
+
+func (s *RoleService) ensureNameFree(ctx context.Context, name string, excludeID int) error {
+	q := s.Client.Role.Query().Where(role.NameEQ(name))
+	if excludeID > 0 {
+		q = q.Where(role.IDNEQ(excludeID))
+	}
+	taken, err := q.Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return ErrRoleNameTaken
+	}
+	return nil
+}
+
+func normalizeRoleName(v string) (string, error) {
+	name := strings.ToLower(strings.TrimSpace(v))
+	if name == "" || len(name) > 50 || strings.ContainsAny(name, " \t/") {
+		return "", fmt.Errorf("%w: name", ErrRoleInvalidInput)
+	}
+	return name, nil
+}
+
+// normalizeRolePermissions valida contra el catálogo y elimina duplicados.
+func normalizeRolePermissions(perms []string) ([]string, error) {
+	out := make([]string, 0, len(perms))
+	seen := map[string]bool{}
+	for _, p := range perms {
+		p = strings.TrimSpace(p)
+		if !auth.IsPermission(p) {
+			return nil, fmt.Errorf("%w: unknown permission %q", ErrRoleInvalidInput, p)
+		}
+		if !seen[p] {
+			seen[p] = true
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
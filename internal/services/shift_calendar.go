@@ -0,0 +1,416 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"back/internal/ent"
+	"back/internal/ent/attendanceday"
+	"back/internal/ent/leaverequest"
+	"back/internal/ent/userbranch"
+	"back/internal/ent/userdayoverride"
+	"back/internal/ent/usershiftassignment"
+)
+
+const (
+	CalendarStatusScheduled  = "scheduled"
+	CalendarStatusRest       = "rest"
+	CalendarStatusDayOff     = "day_off"
+	CalendarStatusHoliday    = "holiday"
+	CalendarStatusLeave      = "leave"
+	CalendarStatusUnassigned = "unassigned"
+
+	CalendarSourceAssignment = "assignment"
+	CalendarSourceOverride   = "override"
+
+	// rango máximo por consulta (un trimestre)
+	maxCalendarRangeDays = 93
+)
+
+// CalendarDay es el horario resuelto de un usuario para un día.
+type CalendarDay struct {
+	Date            time.Time
+	Status          string // scheduled | rest | day_off | holiday | leave | unassigned
+	Source          string // assignment | override
+	ShiftID         *int
+	ShiftName       *string
+	StartTime       *string
+	EndTime         *string
+	Mode            string // onsite | remote | hybrid_office | hybrid_home | off
+	ExpectedMinutes int
+	HolidayName     *string
+	LeaveType       *string
+	Attendance      *CalendarAttendance
+}
+
+// CalendarAttendance es la asistencia real registrada para el día.
+type CalendarAttendance struct {
+	AttendanceDayID int
+	BranchID        int
+	WorkInAt        *time.Time
+	BreakOutAt      *time.Time
+	BreakInAt       *time.Time
+	WorkOutAt       *time.Time
+	WorkedMinutes   int
+}
+
+type UserCalendar struct {
+	UserID int
+	Days   []CalendarDay
+}
+
+// ResolveUserCalendar arma el calendario del usuario día a día combinando asignaciones
+// de turno, overrides, configuración de días del turno (semanal o rotativa), feriados,
+// ausencias aprobadas y la asistencia registrada.
+func (s *ShiftService) ResolveUserCalendar(ctx context.Context, userID int, start, end time.Time) ([]CalendarDay, error) {
+	start = truncateToDay(start)
+	end = truncateToDay(end)
+	if userID <= 0 || end.Before(start) || end.Sub(start) > maxCalendarRangeDays*24*time.Hour {
+		return nil, ErrShiftInvalidInput
+	}
+
+	if _, err := s.Client.User.Get(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	branchIDs, err := s.Client.UserBranch.Query().
+		Where(userbranch.UserIDEQ(userID), userbranch.IsActiveEQ(true)).
+		Select(userbranch.FieldBranchID).
+		Ints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.resolveCalendar(ctx, userID, branchIDs, start, end)
+}
+
+// ResolveBranchCalendar resuelve el calendario de todos los usuarios activos de la sucursal.
+func (s *ShiftService) ResolveBranchCalendar(ctx context.Context, branchID int, start, end time.Time) ([]UserCalendar, error) {
+	start = truncateToDay(start)
+	end = truncateToDay(end)
+	if branchID <= 0 || end.Before(start) || end.Sub(start) > maxCalendarRangeDays*24*time.Hour {
+		return nil, ErrShiftInvalidInput
+	}
+	if !AccessScopeFrom(ctx).CanView(branchID) {
+		return nil, ErrOutOfScope
+	}
+
+	if _, err := s.Client.Branch.Get(ctx, branchID); err != nil {
+		return nil, err
+	}
+
+	userIDs, err := s.Client.UserBranch.Query().
+		Where(userbranch.BranchIDEQ(branchID), userbranch.IsActiveEQ(true)).
+		Order(ent.Asc(userbranch.FieldUserID)).
+		Select(userbranch.FieldUserID).
+		Ints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// todo se carga una vez para la sucursal y el rango, no por usuario
+	sources, err := s.loadCalendarSources(ctx, userIDs, start, end)
+	if err != nil {
+		return nil, err
+	}
+	branchIDs := []int{branchID}
+	holidays, err := holidaysByDateForBranches(ctx, s.Client, branchIDs, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]UserCalendar, 0, len(userIDs))
+	for _, uID := range userIDs {
+		out = append(out, UserCalendar{
+			UserID: uID,
+			Days:   buildCalendar(sources[uID], branchIDs, holidays, start, end),
+		})
+	}
+	return out, nil
+}
+
+func (s *ShiftService) resolveCalendar(ctx context.Context, userID int, branchIDs []int, start, end time.Time) ([]CalendarDay, error) {
+	sources, err := s.loadCalendarSources(ctx, []int{userID}, start, end)
+	if err != nil {
+		return nil, err
+	}
+	holidays, err := holidaysByDateForBranches(ctx, s.Client, branchIDs, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return buildCalendar(sources[userID], branchIDs, holidays, start, end), nil
+}
+
+// calendarSources reúne lo que se necesita para resolver el calendario de un usuario
+// en un rango (asignaciones con su turno, overrides, ausencias aprobadas y asistencia).
+type calendarSources struct {
+	assignments []*ent.UserShiftAssignment // start_date DESC, id DESC
+	overrides   map[string]*ent.UserDayOverride
+	leaves      []*ent.LeaveRequest
+	attendances []*ent.AttendanceDay
+}
+
+// loadCalendarSources carga con una consulta por tipo los datos de todos los usuarios
+// indicados. Siempre devuelve una entrada por usuario (vacía si no tiene datos).
+func (s *ShiftService) loadCalendarSources(ctx context.Context, userIDs []int, start, end time.Time) (map[int]*calendarSources, error) {
+	endExclusive := end.AddDate(0, 0, 1)
+
+	out := make(map[int]*calendarSources, len(userIDs))
+	for _, id := range userIDs {
+		out[id] = &calendarSources{overrides: map[string]*ent.UserDayOverride{}}
+	}
+	if len(userIDs) == 0 {
+		return out, nil
+	}
+
+	assignments, err := s.Client.UserShiftAssignment.Query().
+		Where(
+			usershiftassignment.UserIDIn(userIDs...),
+			usershiftassignment.IsActiveEQ(true),
+			usershiftassignment.StartDateLT(endExclusive),
+			usershiftassignment.Or(
+				usershiftassignment.EndDateIsNil(),
+				usershiftassignment.EndDateGTE(start),
+			),
+		).
+		WithShift(func(q *ent.ShiftQuery) {
+			q.WithDays().WithCycleDays()
+		}).
+		Order(ent.Desc(usershiftassignment.FieldStartDate), ent.Desc(usershiftassignment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range assignments {
+		out[a.UserID].assignments = append(out[a.UserID].assignments, a)
+	}
+
+	overrides, err := s.Client.UserDayOverride.Query().
+		Where(
+			userdayoverride.UserIDIn(userIDs...),
+			userdayoverride.DateGTE(start),
+			userdayoverride.DateLT(endExclusive),
+		).
+		WithShift().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range overrides {
+		out[o.UserID].overrides[o.Date.Format("2006-01-02")] = o
+	}
+
+	leaves, err := s.Client.LeaveRequest.Query().
+		Where(
+			leaverequest.UserIDIn(userIDs...),
+			leaverequest.StatusEQ(LeaveStatusApproved),
+			leaverequest.StartDateLTE(end),
+			leaverequest.EndDateGTE(start),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, lr := range leaves {
+		out[lr.UserID].leaves = append(out[lr.UserID].leaves, lr)
+	}
+
+	attendances, err := s.Client.AttendanceDay.Query().
+		Where(
+			attendanceday.UserIDIn(userIDs...),
+			attendanceday.WorkDateGTE(start),
+			attendanceday.WorkDateLT(endExclusive),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, ad := range attendances {
+		out[ad.UserID].attendances = append(out[ad.UserID].attendances, ad)
+	}
+
+	return out, nil
+}
+
+// calendarHolidays cachea los feriados por conjunto de sucursales: los trabajadores de
+// una misma sucursal comparten el mismo calendario de feriados.
+type calendarHolidays struct {
+	client     *ent.Client
+	start, end time.Time
+	byBranches map[string]map[string]*ent.Holiday
+}
+
+func newCalendarHolidays(client *ent.Client, start, end time.Time) *calendarHolidays {
+	return &calendarHolidays{
+		client:     client,
+		start:      start,
+		end:        end,
+		byBranches: map[string]map[string]*ent.Holiday{},
+	}
+}
+
+func (h *calendarHolidays) forBranches(ctx context.Context, branchIDs []int) (map[string]*ent.Holiday, error) {
+	ids := append([]int(nil), branchIDs...)
+	sort.Ints(ids)
+	key := fmt.Sprint(ids)
+	if hols, ok := h.byBranches[key]; ok {
+		return hols, nil
+	}
+	hols, err := holidaysByDateForBranches(ctx, h.client, ids, h.start, h.end)
+	if err != nil {
+		return nil, err
+	}
+	h.byBranches[key] = hols
+	return hols, nil
+}
+
+// buildCalendar resuelve día a día el calendario de un usuario a partir de sus datos
+// precargados. Con una sola sucursal, la asistencia se limita a esa sucursal.
+func buildCalendar(src *calendarSources, branchIDs []int, holidays map[string]*ent.Holiday, start, end time.Time) []CalendarDay {
+	endExclusive := end.AddDate(0, 0, 1)
+	if src == nil {
+		src = &calendarSources{}
+	}
+	assignments, overrideByDate, leaves := src.assignments, src.overrides, src.leaves
+
+	attendanceByDate := make(map[string]*ent.AttendanceDay, len(src.attendances))
+	for _, ad := range src.attendances {
+		if len(branchIDs) == 1 && ad.BranchID != branchIDs[0] {
+			continue
+		}
+		key := ad.WorkDate.Format("2006-01-02")
+		// si marcó en más de una sucursal el mismo día, se informa la primera entrada
+		if prev, ok := attendanceByDate[key]; ok && prev.WorkInAt != nil &&
+			(ad.WorkInAt == nil || !ad.WorkInAt.Before(*prev.WorkInAt)) {
+			continue
+		}
+		attendanceByDate[key] = ad
+	}
+
+	days := make([]CalendarDay, 0, int(endExclusive.Sub(start).Hours()/24))
+	for d := start; d.Before(endExclusive); d = d.AddDate(0, 0, 1) {
+		key := d.Format("2006-01-02")
+		day := CalendarDay{
+			Date:   d,
+			Status: CalendarStatusUnassigned,
+			Mode:   "off",
+		}
+
+		var sh *ent.Shift
+		override := overrideByDate[key]
+
+		switch {
+		case override != nil && override.IsDayOff:
+			day.Status = CalendarStatusDayOff
+			day.Source = CalendarSourceOverride
+
+		case override != nil && override.ShiftID != nil && override.Edges.Shift != nil:
+			// turno especial del día: laborable aunque sea feriado o día de descanso
+			sh = override.Edges.Shift
+			day.Status = CalendarStatusScheduled
+			day.Source = CalendarSourceOverride
+			day.Mode = override.Mode
+
+		default:
+			if a := assignmentForDate(assignments, d); a != nil && a.Edges.Shift != nil {
+				sh = a.Edges.Shift
+				day.Source = CalendarSourceAssignment
+				working, mode := shiftDayConfig(sh, d)
+				if working {
+					day.Status = CalendarStatusScheduled
+					day.Mode = mode
+				} else {
+					day.Status = CalendarStatusRest
+				}
+				if override != nil && working {
+					// override sin turno: solo cambia la modalidad del día
+					day.Source = CalendarSourceOverride
+					day.Mode = override.Mode
+				}
+			}
+		}
+
+		if sh != nil {
+			shiftID, name := sh.ID, sh.Name
+			startTime, endTime := sh.StartTime, sh.EndTime
+			day.ShiftID = &shiftID
+			day.ShiftName = &name
+			day.StartTime = &startTime
+			day.EndTime = &endTime
+		}
+
+		if day.Status == CalendarStatusScheduled && day.Source == CalendarSourceAssignment {
+			if hol, ok := holidays[key]; ok {
+				name := hol.Name
+				day.Status = CalendarStatusHoliday
+				day.HolidayName = &name
+			}
+		}
+
+		if day.Status == CalendarStatusScheduled {
+			for _, lr := range leaves {
+				if !d.Before(truncateToDay(lr.StartDate)) && !d.After(truncateToDay(lr.EndDate)) {
+					leaveType := lr.Type
+					day.Status = CalendarStatusLeave
+					day.LeaveType = &leaveType
+					break
+				}
+			}
+		}
+
+		if day.Status == CalendarStatusScheduled {
+			day.ExpectedMinutes = shiftExpectedMinutes(sh)
+		} else {
+			day.Mode = "off"
+		}
+
+		if ad, ok := attendanceByDate[key]; ok {
+			day.Attendance = &CalendarAttendance{
+				AttendanceDayID: ad.ID,
+				BranchID:        ad.BranchID,
+				WorkInAt:        ad.WorkInAt,
+				BreakOutAt:      ad.BreakOutAt,
+				BreakInAt:       ad.BreakInAt,
+				WorkOutAt:       ad.WorkOutAt,
+				WorkedMinutes:   calendarWorkedMinutes(ad),
+			}
+		}
+
+		days = append(days, day)
+	}
+
+	return days
+}
+
+// assignmentForDate devuelve la asignación vigente en la fecha (la de start_date más reciente).
+// Las asignaciones vienen ordenadas por start_date DESC, id DESC.
+func assignmentForDate(assignments []*ent.UserShiftAssignment, d time.Time) *ent.UserShiftAssignment {
+	for _, a := range assignments {
+		if truncateToDay(a.StartDate).After(d) {
+			continue
+		}
+		if a.EndDate != nil && truncateToDay(*a.EndDate).Before(d) {
+			continue
+		}
+		return a
+	}
+	return nil
+}
+
+// calendarWorkedMinutes calcula los minutos trabajados de una jornada cerrada
+// (entrada a salida, descontando la colación marcada).
+func calendarWorkedMinutes(ad *ent.AttendanceDay) int {
+	if ad.WorkInAt == nil || ad.WorkOutAt == nil {
+		return 0
+	}
+	worked := int(ad.WorkOutAt.Sub(*ad.WorkInAt).Minutes())
+	if ad.BreakOutAt != nil && ad.BreakInAt != nil {
+		worked -= int(ad.BreakInAt.Sub(*ad.BreakOutAt).Minutes())
+	}
+	if worked < 0 {
+		return 0
+	}
+	return worked
+}
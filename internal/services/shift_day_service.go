@@ -6,6 +6,7 @@ import (
 
 	"back/internal/ent"
 	"back/internal/ent/shift"
+	"back/internal/ent/shiftcycleday"
 	"back/internal/ent/shiftday"
 )
 
@@ -78,3 +79,71 @@ func (s *ShiftDayService) ReplaceForShift(ctx context.Context, shiftID int, days
 
 	return tx.Commit()
 }
+
+type ShiftCycleDayInput struct {
+	Day          int
+	IsWorkingDay bool
+	Mode         string
+}
+
+func (s *ShiftDayService) ListCycleForShift(ctx context.Context, shiftID int) ([]*ent.ShiftCycleDay, error) {
+	if shiftID <= 0 {
+		return nil, ErrShiftDayInvalidInput
+	}
+
+	return s.Client.ShiftCycleDay.
+		Query().
+		Where(shiftcycleday.ShiftIDEQ(shiftID)).
+		Order(ent.Asc(shiftcycleday.FieldDayIndex)).
+		All(ctx)
+}
+
+// ReplaceCycleForShift reemplaza la configuración del ciclo de un turno rotativo.
+// Cada día debe estar entre 1 y el cycle_length del turno.
+func (s *ShiftDayService) ReplaceCycleForShift(ctx context.Context, shiftID int, days []ShiftCycleDayInput) error {
+	if shiftID <= 0 || len(days) == 0 {
+		return ErrShiftDayInvalidInput
+	}
+
+	sh, err := s.Client.Shift.Query().Where(shift.IDEQ(shiftID)).Only(ctx)
+	if err != nil {
+		return err
+	}
+	if sh.PatternType != ShiftPatternCycle || sh.CycleLength == nil {
+		return ErrShiftDayInvalidInput
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ShiftCycleDay.Delete().
+		Where(shiftcycleday.ShiftIDEQ(shiftID)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	seen := make(map[int]bool, len(days))
+	for _, d := range days {
+		if d.Day < 1 || d.Day > *sh.CycleLength || seen[d.Day] {
+			return ErrShiftDayInvalidInput
+		}
+		if d.Mode == "" {
+			return ErrShiftDayInvalidInput
+		}
+		seen[d.Day] = true
+
+		if _, err := tx.ShiftCycleDay.Create().
+			SetShiftID(shiftID).
+			SetDayIndex(d.Day).
+			SetIsWorkingDay(d.IsWorkingDay).
+			SetMode(d.Mode).
+			Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
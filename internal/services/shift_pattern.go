@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"back/internal/ent"
+	"back/internal/ent/shiftcycleday"
+	"back/internal/ent/shiftday"
+)
+
+const (
+	ShiftPatternWeekly = "weekly"
+	ShiftPatternCycle  = "cycle"
+
+	// tope razonable para rotaciones (ej: 14x14 = 28 días)
+	maxShiftCycleLength = 366
+)
+
+// shiftCyclePosition devuelve la posición (1..cycle_length) de la fecha dentro del ciclo
+// del turno. Fechas anteriores al ancla también se resuelven (el ciclo se repite hacia atrás).
+func shiftCyclePosition(sh *ent.Shift, date time.Time) (int, bool) {
+	if sh == nil || sh.PatternType != ShiftPatternCycle ||
+		sh.CycleLength == nil || *sh.CycleLength <= 0 || sh.CycleAnchorDate == nil {
+		return 0, false
+	}
+
+	anchor := truncateToDay(*sh.CycleAnchorDate)
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	anchor = time.Date(anchor.Year(), anchor.Month(), anchor.Day(), 0, 0, 0, 0, time.UTC)
+
+	diff := int(day.Sub(anchor).Hours() / 24)
+	n := *sh.CycleLength
+	pos := ((diff % n) + n) % n
+	return pos + 1, true
+}
+
+// isShiftWorkingDate indica si la fecha es laborable para el turno según su patrón:
+// día de semana (shift_days) o posición en el ciclo (shift_cycle_days). Si el turno ya
+// trae cargada la configuración de días (WithDays / WithCycleDays) no consulta la base.
+func isShiftWorkingDate(ctx context.Context, client *ent.Client, sh *ent.Shift, date time.Time) (bool, error) {
+	if (sh.PatternType == ShiftPatternCycle && sh.Edges.CycleDays != nil) ||
+		(sh.PatternType != ShiftPatternCycle && sh.Edges.Days != nil) {
+		working, _ := shiftDayConfig(sh, date)
+		return working, nil
+	}
+
+	if sh.PatternType == ShiftPatternCycle {
+		pos, ok := shiftCyclePosition(sh, date)
+		if !ok {
+			return false, nil
+		}
+		return client.ShiftCycleDay.Query().
+			Where(
+				shiftcycleday.ShiftIDEQ(sh.ID),
+				shiftcycleday.DayIndexEQ(pos),
+				shiftcycleday.IsWorkingDayEQ(true),
+			).
+			Exist(ctx)
+	}
+
+	return client.ShiftDay.Query().
+		Where(
+			shiftday.ShiftIDEQ(sh.ID),
+			shiftday.WeekdayEQ(goWeekdayToSchema(date.Weekday())),
+			shiftday.IsWorkingDayEQ(true),
+		).
+		Exist(ctx)
+}
+
+// shiftWorkingDaySQLCondition devuelve una condición SQL verdadera cuando dateExpr es día
+// laborable del turno shiftAlias. Usa la misma regla que isShiftWorkingDate: el día de
+// semana (shift_days) o la posición del ciclo (shift_cycle_days) debe estar configurado
+// como laborable; un día sin configuración no es laborable.
+func shiftWorkingDaySQLCondition(shiftAlias, dateExpr string) string {
+	return fmt.Sprintf(`(
+			CASE WHEN %[1]s.pattern_type = 'cycle' THEN EXISTS (
+				SELECT 1 FROM shift_cycle_days scd
+				WHERE scd.shift_id = %[1]s.id
+				  AND scd.is_working_day = true
+				  AND scd.day_index = (((((%[2]s)::date - %[1]s.cycle_anchor_date) %% %[1]s.cycle_length) + %[1]s.cycle_length) %% %[1]s.cycle_length) + 1
+			)
+			ELSE EXISTS (
+				SELECT 1 FROM shift_days sd
+				WHERE sd.shift_id = %[1]s.id
+				  AND sd.weekday = EXTRACT(ISODOW FROM (%[2]s)::date)::int
+				  AND sd.is_working_day = true
+			)
+			END
+		)`, shiftAlias, dateExpr)
+}
+
+// shiftDayConfig resuelve si la fecha es laborable y su modalidad usando los edges
+// days / cycle_days ya cargados del turno (sin consultas adicionales).
+func shiftDayConfig(sh *ent.Shift, date time.Time) (bool, string) {
+	if sh.PatternType == ShiftPatternCycle {
+		pos, ok := shiftCyclePosition(sh, date)
+		if !ok {
+			return false, ""
+		}
+		for _, cd := range sh.Edges.CycleDays {
+			if cd.DayIndex == pos {
+				return cd.IsWorkingDay, cd.Mode
+			}
+		}
+		return false, ""
+	}
+
+	weekday := goWeekdayToSchema(date.Weekday())
+	for _, d := range sh.Edges.Days {
+		if d.Weekday == weekday {
+			return d.IsWorkingDay, d.Mode
+		}
+	}
+	return false, ""
+}
+
+// shiftExpectedMinutes devuelve los minutos efectivos esperados de una jornada del turno
+// (duración entre inicio y término menos la colación).
+func shiftExpectedMinutes(sh *ent.Shift) int {
+	sh1, sm1, err1 := parseHHMM(sh.StartTime)
+	sh2, sm2, err2 := parseHHMM(sh.EndTime)
+	if err1 != nil || err2 != nil {
+		return 0
+	}
+	minutes := (sh2*60 + sm2) - (sh1*60 + sm1)
+	if sh.CrossesMidnight || minutes < 0 {
+		minutes += 24 * 60
+	}
+	minutes -= sh.BreakMinutes
+	if minutes < 0 {
+		return 0
+	}
+	return minutes
+}
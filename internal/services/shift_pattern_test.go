@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"back/internal/ent"
+)
+
+func mustDate(s string) time.Time {
+	d, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func cycleShift(length int, anchor time.Time) *ent.Shift {
+	return &ent.Shift{
+		PatternType:     ShiftPatternCycle,
+		CycleLength:     &length,
+		CycleAnchorDate: &anchor,
+	}
+}
+
+func TestShiftCyclePosition(t *testing.T) {
+	anchor := mustDate("2026-01-01")
+	santiago := time.FixedZone("CLT", -3*60*60)
+
+	tests := []struct {
+		name   string
+		shift  *ent.Shift
+		date   time.Time
+		want   int
+		wantOK bool
+	}{
+		{"el ancla es la posición 1", cycleShift(4, anchor), mustDate("2026-01-01"), 1, true},
+		{"último día del ciclo", cycleShift(4, anchor), mustDate("2026-01-04"), 4, true},
+		{"el ciclo vuelve a empezar", cycleShift(4, anchor), mustDate("2026-01-05"), 1, true},
+		{"varios ciclos después", cycleShift(28, anchor), mustDate("2026-03-01"), 4, true},
+		{"día anterior al ancla", cycleShift(4, anchor), mustDate("2025-12-31"), 4, true},
+		{"varios días antes del ancla", cycleShift(4, anchor), mustDate("2025-12-29"), 2, true},
+		{"ciclo de un día", cycleShift(1, anchor), mustDate("2026-07-19"), 1, true},
+		{"la hora del día no cambia la posición", cycleShift(4, anchor), time.Date(2026, 1, 2, 23, 30, 0, 0, santiago), 2, true},
+		{"ancla con hora local", cycleShift(4, time.Date(2026, 1, 1, 22, 0, 0, 0, santiago)), mustDate("2026-01-03"), 3, true},
+		{"turno semanal", &ent.Shift{PatternType: ShiftPatternWeekly}, mustDate("2026-01-01"), 0, false},
+		{"sin largo de ciclo", &ent.Shift{PatternType: ShiftPatternCycle, CycleAnchorDate: &anchor}, mustDate("2026-01-01"), 0, false},
+		{"sin ancla", &ent.Shift{PatternType: ShiftPatternCycle, CycleLength: cycleShift(4, anchor).CycleLength}, mustDate("2026-01-01"), 0, false},
+		{"largo de ciclo cero", cycleShift(0, anchor), mustDate("2026-01-01"), 0, false},
+		{"turno nil", nil, mustDate("2026-01-01"), 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := shiftCyclePosition(tt.shift, tt.date)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("shiftCyclePosition = (%d, %v), want (%d, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestIsShiftWorkingDate(t *testing.T) {
+	// 4x3: cuatro días de trabajo y tres de descanso desde el ancla (jueves 2026-01-01)
+	cycle := cycleShift(7, mustDate("2026-01-01"))
+	for i := 1; i <= 7; i++ {
+		cycle.Edges.CycleDays = append(cycle.Edges.CycleDays, &ent.ShiftCycleDay{
+			DayIndex:     i,
+			IsWorkingDay: i <= 4,
+			Mode:         "onsite",
+		})
+	}
+
+	// lunes a viernes; el sábado está configurado como no laborable y el domingo no existe
+	weekly := &ent.Shift{PatternType: ShiftPatternWeekly}
+	for wd := 1; wd <= 6; wd++ {
+		weekly.Edges.Days = append(weekly.Edges.Days, &ent.ShiftDay{
+			Weekday:      wd,
+			IsWorkingDay: wd <= 5,
+			Mode:         "onsite",
+		})
+	}
+
+	// ciclo con la configuración de días cargada pero vacía: nada es laborable
+	empty := cycleShift(3, mustDate("2026-01-01"))
+	empty.Edges.CycleDays = []*ent.ShiftCycleDay{}
+
+	tests := []struct {
+		name  string
+		shift *ent.Shift
+		date  time.Time
+		want  bool
+	}{
+		{"ciclo: primer día", cycle, mustDate("2026-01-01"), true},
+		{"ciclo: último día de trabajo", cycle, mustDate("2026-01-04"), true},
+		{"ciclo: primer descanso", cycle, mustDate("2026-01-05"), false},
+		{"ciclo: último descanso", cycle, mustDate("2026-01-07"), false},
+		{"ciclo: segunda vuelta", cycle, mustDate("2026-01-08"), true},
+		{"ciclo: antes del ancla", cycle, mustDate("2025-12-31"), false},
+		{"ciclo: días sin configurar", empty, mustDate("2026-01-02"), false},
+		{"semanal: lunes", weekly, mustDate("2026-01-05"), true},
+		{"semanal: viernes", weekly, mustDate("2026-01-09"), true},
+		{"semanal: sábado no laborable", weekly, mustDate("2026-01-10"), false},
+		{"semanal: domingo sin configurar", weekly, mustDate("2026-01-11"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// con la configuración cargada no se consulta la base (client nil)
+			got, err := isShiftWorkingDate(context.Background(), nil, tt.shift, tt.date)
+			if err != nil {
+				t.Fatalf("isShiftWorkingDate: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("isShiftWorkingDate(%s) = %v, want %v", tt.date.Format("2006-01-02"), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShiftWorkingDaySQLCondition(t *testing.T) {
+	cond := strings.Join(strings.Fields(shiftWorkingDaySQLCondition("sh", "ad.work_date")), " ")
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{
+			"semanal: el día debe estar configurado como laborable (domingo sin configurar no lo es)",
+			"ELSE EXISTS ( SELECT 1 FROM shift_days sd WHERE sd.shift_id = sh.id AND sd.weekday = EXTRACT(ISODOW FROM (ad.work_date)::date)::int AND sd.is_working_day = true )",
+		},
+		{
+			"ciclo: la posición se calcula como shiftCyclePosition",
+			"AND scd.day_index = (((((ad.work_date)::date - sh.cycle_anchor_date) % sh.cycle_length) + sh.cycle_length) % sh.cycle_length) + 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !strings.Contains(cond, tt.want) {
+				t.Errorf("condición sin %q:\n%s", tt.want, cond)
+			}
+		})
+	}
+	if strings.Contains(cond, "NOT EXISTS") {
+		t.Errorf("un día sin configuración no debe contar como laborable:\n%s", cond)
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"back/internal/ent"
 	"back/internal/ent/shift"
+	"back/internal/ent/shiftcycleday"
 	"back/internal/ent/shiftinstance"
 )
 
@@ -31,6 +32,13 @@ type WorkDayInput struct {
 	Mode         string `json:"mode"`
 }
 
+// CycleDayInput configura un día de un turno rotativo (1..cycle_length).
+type CycleDayInput struct {
+	Day          int    `json:"day"`
+	IsWorkingDay bool   `json:"is_working_day"`
+	Mode         string `json:"mode"`
+}
+
 type CreateShiftInput struct {
 	Name            string
 	Description     *string
@@ -42,6 +50,17 @@ type CreateShiftInput struct {
 	ScheduleType    string         `json:"schedule_type"` // "monthly", "yearly", etc.
 	WorkDays        []WorkDayInput `json:"work_days"`     // [1, 2, 3, 4, 5] (Lunes a Viernes)
 	StartDate       time.Time      `json:"start_date"`    // Fecha de inicio del calendariox
+
+	// Tolerancias en minutos (0 si no vienen)
+	EntryToleranceMinutes *int `json:"entry_tolerance_minutes"`
+	ExitToleranceMinutes  *int `json:"exit_tolerance_minutes"`
+	MinOvertimeMinutes    *int `json:"min_overtime_minutes"`
+
+	// Turnos rotativos (4x4, 7x7, ...)
+	PatternType     string          `json:"pattern_type"`      // "weekly" (default) | "cycle"
+	CycleLength     *int            `json:"cycle_length"`      // largo del ciclo en días
+	CycleAnchorDate *time.Time      `json:"cycle_anchor_date"` // día 1 del ciclo (default: StartDate)
+	CycleDays       []CycleDayInput `json:"cycle_days"`
 }
 
 type PatchShiftInput struct {
@@ -52,12 +71,21 @@ type PatchShiftInput struct {
 	BreakMinutes    *int
 	CrossesMidnight *bool
 	IsActive        *bool
+
+	PatternType     *string
+	CycleLength     *int
+	CycleAnchorDate *time.Time
+
+	EntryToleranceMinutes *int
+	ExitToleranceMinutes  *int
+	MinOvertimeMinutes    *int
 }
 
 func (s *ShiftService) List(ctx context.Context) ([]*ent.Shift, error) {
 	return s.Client.Shift.
 		Query().
 		WithDays().
+		WithCycleDays().
 		Order(ent.Asc(shift.FieldName)).
 		All(ctx)
 }
@@ -70,6 +98,7 @@ func (s *ShiftService) GetByID(ctx context.Context, shiftID int) (*ent.Shift, er
 		Query().
 		Where(shift.ID(shiftID)).
 		WithDays().
+		WithCycleDays().
 		Only(ctx)
 }
 
@@ -80,6 +109,28 @@ func (s *ShiftService) Create(ctx context.Context, in CreateShiftInput) (*ent.Sh
 		return nil, ErrShiftInvalidInput
 	}
 
+	in.PatternType = strings.ToLower(strings.TrimSpace(in.PatternType))
+	if in.PatternType == "" {
+		in.PatternType = ShiftPatternWeekly
+	}
+	if in.PatternType == ShiftPatternCycle && in.CycleAnchorDate == nil {
+		// por defecto el ciclo parte el día de inicio del calendario
+		anchor := in.StartDate
+		if anchor.IsZero() {
+			anchor = time.Now()
+		}
+		in.CycleAnchorDate = &anchor
+	}
+	if err := validateShiftPattern(in.PatternType, in.CycleLength, in.CycleAnchorDate); err != nil {
+		return nil, err
+	}
+	if err := validateCycleDays(in.PatternType, in.CycleLength, in.CycleDays); err != nil {
+		return nil, err
+	}
+	if !validTolerance(in.EntryToleranceMinutes) || !validTolerance(in.ExitToleranceMinutes) || !validTolerance(in.MinOvertimeMinutes) {
+		return nil, ErrShiftInvalidInput
+	}
+
 	// 2. Iniciar la Transacción
 	tx, err := s.Client.Tx(ctx)
 	if err != nil {
@@ -114,6 +165,22 @@ func (s *ShiftService) Create(ctx context.Context, in CreateShiftInput) (*ent.Sh
 		builder.SetIsActive(*in.IsActive)
 	}
 
+	if in.EntryToleranceMinutes != nil {
+		builder.SetEntryToleranceMinutes(*in.EntryToleranceMinutes)
+	}
+	if in.ExitToleranceMinutes != nil {
+		builder.SetExitToleranceMinutes(*in.ExitToleranceMinutes)
+	}
+	if in.MinOvertimeMinutes != nil {
+		builder.SetMinOvertimeMinutes(*in.MinOvertimeMinutes)
+	}
+
+	builder.SetPatternType(in.PatternType)
+	if in.PatternType == ShiftPatternCycle {
+		builder.SetCycleLength(*in.CycleLength).
+			SetCycleAnchorDate(truncateToDay(*in.CycleAnchorDate))
+	}
+
 	newShift, err := builder.Save(ctx)
 	if err != nil {
 		tx.Rollback()
@@ -136,16 +203,32 @@ func (s *ShiftService) Create(ctx context.Context, in CreateShiftInput) (*ent.Sh
 		}
 	}
 
+	var cycleBulk []*ent.ShiftCycleDayCreate
+	for _, cd := range in.CycleDays {
+		cycleBulk = append(cycleBulk, tx.ShiftCycleDay.Create().
+			SetShiftID(newShift.ID).
+			SetDayIndex(cd.Day).
+			SetIsWorkingDay(cd.IsWorkingDay).
+			SetMode(cd.Mode))
+	}
+
+	if len(cycleBulk) > 0 {
+		if err := tx.ShiftCycleDay.CreateBulk(cycleBulk...).Exec(ctx); err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("error guardando configuración del ciclo: %w", err)
+		}
+	}
+
 	// 5. Generar Calendario (ShiftInstances)
 	// Solo si se especificó un tipo de horario y días de trabajo
-	if in.ScheduleType != "" && len(in.WorkDays) > 0 {
+	if in.ScheduleType != "" && (len(in.WorkDays) > 0 || len(in.CycleDays) > 0) {
 		// Si no viene fecha, usamos el tiempo actual
 		start := in.StartDate
 		if start.IsZero() {
 			start = time.Now()
 		}
 
-		err = s.GenerateShiftInstances(ctx, tx, newShift.ID, start, in.ScheduleType, in.WorkDays)
+		err = s.GenerateShiftInstances(ctx, tx, newShift, start, in.ScheduleType, in.WorkDays, in.CycleDays)
 		if err != nil {
 			tx.Rollback()
 			return nil, fmt.Errorf("error generando instancias: %w", err)
@@ -170,7 +253,13 @@ func (s *ShiftService) Patch(ctx context.Context, shiftID int, in PatchShiftInpu
 		in.EndTime == nil &&
 		in.BreakMinutes == nil &&
 		in.CrossesMidnight == nil &&
-		in.IsActive == nil {
+		in.IsActive == nil &&
+		in.PatternType == nil &&
+		in.CycleLength == nil &&
+		in.CycleAnchorDate == nil &&
+		in.EntryToleranceMinutes == nil &&
+		in.ExitToleranceMinutes == nil &&
+		in.MinOvertimeMinutes == nil {
 		return nil, ErrShiftInvalidInput
 	}
 
@@ -179,7 +268,14 @@ func (s *ShiftService) Patch(ctx context.Context, shiftID int, in PatchShiftInpu
 		return nil, err
 	}
 
-	upd := row.Update()
+	// el turno, los días del ciclo que sobran y el calendario se actualizan juntos
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	upd := tx.Shift.UpdateOneID(row.ID)
 
 	if in.Name != nil {
 		v := strings.TrimSpace(*in.Name)
@@ -239,7 +335,139 @@ func (s *ShiftService) Patch(ctx context.Context, shiftID int, in PatchShiftInpu
 		upd.SetIsActive(*in.IsActive)
 	}
 
-	return upd.Save(ctx)
+	if in.EntryToleranceMinutes != nil {
+		if !validTolerance(in.EntryToleranceMinutes) {
+			return nil, ErrShiftInvalidInput
+		}
+		upd.SetEntryToleranceMinutes(*in.EntryToleranceMinutes)
+	}
+	if in.ExitToleranceMinutes != nil {
+		if !validTolerance(in.ExitToleranceMinutes) {
+			return nil, ErrShiftInvalidInput
+		}
+		upd.SetExitToleranceMinutes(*in.ExitToleranceMinutes)
+	}
+	if in.MinOvertimeMinutes != nil {
+		if !validTolerance(in.MinOvertimeMinutes) {
+			return nil, ErrShiftInvalidInput
+		}
+		upd.SetMinOvertimeMinutes(*in.MinOvertimeMinutes)
+	}
+
+	if in.PatternType != nil || in.CycleLength != nil || in.CycleAnchorDate != nil {
+		patternType := row.PatternType
+		if in.PatternType != nil {
+			patternType = strings.ToLower(strings.TrimSpace(*in.PatternType))
+		}
+		cycleLength := row.CycleLength
+		if in.CycleLength != nil {
+			cycleLength = in.CycleLength
+		}
+		anchor := row.CycleAnchorDate
+		if in.CycleAnchorDate != nil {
+			anchor = in.CycleAnchorDate
+		}
+
+		if err := validateShiftPattern(patternType, cycleLength, anchor); err != nil {
+			return nil, err
+		}
+
+		upd.SetPatternType(patternType)
+		if patternType == ShiftPatternCycle {
+			upd.SetCycleLength(*cycleLength).
+				SetCycleAnchorDate(truncateToDay(*anchor))
+		}
+	}
+
+	updated, err := upd.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// días fuera del nuevo largo del ciclo dejan de aplicar
+	if updated.PatternType == ShiftPatternCycle && in.CycleLength != nil {
+		if _, err := tx.ShiftCycleDay.Delete().
+			Where(
+				shiftcycleday.ShiftIDEQ(updated.ID),
+				shiftcycleday.DayIndexGT(*updated.CycleLength),
+			).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	// cambió el patrón: el calendario generado desde hoy ya no corresponde
+	if in.PatternType != nil || in.CycleLength != nil || in.CycleAnchorDate != nil {
+		now := time.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		if err := regenerateShiftInstances(ctx, tx, updated.ID, today); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// regenerateShiftInstances rehace las instancias del turno desde from hasta la última
+// ya generada, según su patrón y días configurados actuales. Las anteriores a from se
+// conservan como historial.
+func regenerateShiftInstances(ctx context.Context, tx *ent.Tx, shiftID int, from time.Time) error {
+	last, err := tx.ShiftInstance.Query().
+		Where(
+			shiftinstance.ShiftIDEQ(shiftID),
+			shiftinstance.DateGTE(from),
+		).
+		Order(ent.Desc(shiftinstance.FieldDate)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	// las instancias se generan con fecha a medianoche UTC (ver GenerateShiftInstances)
+	until := time.Date(last.Date.Year(), last.Date.Month(), last.Date.Day(), 0, 0, 0, 0, time.UTC)
+
+	if _, err := tx.ShiftInstance.Delete().
+		Where(
+			shiftinstance.ShiftIDEQ(shiftID),
+			shiftinstance.DateGTE(from),
+		).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	sh, err := tx.Shift.Query().
+		Where(shift.IDEQ(shiftID)).
+		WithDays().
+		WithCycleDays().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	var bulk []*ent.ShiftInstanceCreate
+	for d := from; !d.After(until); d = d.AddDate(0, 0, 1) {
+		working, mode := shiftDayConfig(sh, d)
+		if !working {
+			continue
+		}
+		create := tx.ShiftInstance.Create().
+			SetShiftID(sh.ID).
+			SetDate(d).
+			SetState("scheduled")
+		if mode != "" {
+			create.SetMode(mode)
+		}
+		bulk = append(bulk, create)
+	}
+	if len(bulk) == 0 {
+		return nil
+	}
+	return tx.ShiftInstance.CreateBulk(bulk...).Exec(ctx)
 }
 
 func (s *ShiftService) Delete(ctx context.Context, shiftID int) error {
@@ -252,10 +480,11 @@ func (s *ShiftService) Delete(ctx context.Context, shiftID int) error {
 func (s *ShiftService) GenerateShiftInstances(
 	ctx context.Context,
 	tx *ent.Tx,
-	shiftID int,
+	sh *ent.Shift,
 	startDate time.Time,
 	scheduleType string,
-	workDays []WorkDayInput) error {
+	workDays []WorkDayInput,
+	cycleDays []CycleDayInput) error {
 
 	// 1. Normalizar fecha de inicio
 	current := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, time.UTC)
@@ -279,6 +508,23 @@ func (s *ShiftService) GenerateShiftInstances(
 
 	// 3. Un solo bucle limpio
 	for current.Before(endDate) {
+		// Turno rotativo: el día laborable depende de la posición en el ciclo
+		if sh.PatternType == ShiftPatternCycle {
+			if pos, ok := shiftCyclePosition(sh, current); ok {
+				for _, cd := range cycleDays {
+					if cd.Day == pos && cd.IsWorkingDay {
+						bulk = append(bulk, tx.ShiftInstance.Create().
+							SetShiftID(sh.ID).
+							SetDate(current).
+							SetState("scheduled").
+							SetMode(cd.Mode))
+					}
+				}
+			}
+			current = current.AddDate(0, 0, 1)
+			continue
+		}
+
 		goWeekday := int(current.Weekday())
 		if goWeekday == 0 {
 			goWeekday = 7
@@ -287,7 +533,7 @@ func (s *ShiftService) GenerateShiftInstances(
 		for _, wd := range workDays {
 			if wd.Weekday == goWeekday && wd.IsWorkingDay {
 				bulk = append(bulk, tx.ShiftInstance.Create().
-					SetShiftID(shiftID).
+					SetShiftID(sh.ID).
 					SetDate(current).
 					SetState("scheduled"))
 			}
@@ -302,12 +548,42 @@ func (s *ShiftService) GenerateShiftInstances(
 	return tx.ShiftInstance.CreateBulk(bulk...).Exec(ctx)
 }
 
-func (s *ShiftService) GetCalendar(ctx context.Context, userID int, start, end time.Time) ([]*ent.ShiftInstance, error) {
-	return s.Client.ShiftInstance.Query().
-		Where(
-			shiftinstance.DateGTE(start),
-			shiftinstance.DateLTE(end),
-		).
-		WithShift(). // Importante para sacar el nombre del turno
-		All(ctx)
+// validateShiftPattern valida la configuración del patrón: los turnos rotativos
+// requieren largo de ciclo y fecha ancla.
+func validateShiftPattern(patternType string, cycleLength *int, anchor *time.Time) error {
+	switch patternType {
+	case ShiftPatternWeekly:
+		return nil
+	case ShiftPatternCycle:
+		if cycleLength == nil || *cycleLength < 1 || *cycleLength > maxShiftCycleLength {
+			return ErrShiftInvalidInput
+		}
+		if anchor == nil || anchor.IsZero() {
+			return ErrShiftInvalidInput
+		}
+		return nil
+	}
+	return ErrShiftInvalidInput
+}
+
+func validateCycleDays(patternType string, cycleLength *int, days []CycleDayInput) error {
+	if len(days) == 0 {
+		return nil
+	}
+	if patternType != ShiftPatternCycle || cycleLength == nil {
+		return ErrShiftInvalidInput
+	}
+	seen := make(map[int]bool, len(days))
+	for _, d := range days {
+		if d.Day < 1 || d.Day > *cycleLength || seen[d.Day] || d.Mode == "" {
+			return ErrShiftInvalidInput
+		}
+		seen[d.Day] = true
+	}
+	return nil
+}
+
+// validTolerance: las tolerancias son opcionales, no negativas y menores a una jornada.
+func validTolerance(v *int) bool {
+	return v == nil || (*v >= 0 && *v < 24*60)
 }
@@ -37,7 +37,12 @@ func NewTokenService(cfg *config.Config, client *ent.Client) *TokenService {
 }
 
 func (s *TokenService) IssueForUser(ctx context.Context, u *ent.User) (*TokenPair, error) {
-	access, accessExp, err := auth.GenerateAccessToken(s.Cfg, u.ID, u.Username, u.Role)
+	perms, err := rolePermissions(ctx, s.Client, u.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	access, accessExp, err := auth.GenerateAccessToken(s.Cfg, u.ID, u.Username, u.Role, perms)
 	if err != nil {
 		return nil, err
 	}
@@ -253,7 +258,12 @@ func (s *TokenService) ListActiveSessions(ctx context.Context, userID int) ([]Se
 }
 
 func (s *TokenService) IssueForDevice(ctx context.Context, d *ent.Device) (*TokenPair, error) {
-	access, accessExp, err := auth.GenerateAccessToken(s.Cfg, d.ID, d.Username, d.Role)
+	perms, err := rolePermissions(ctx, s.Client, d.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	access, accessExp, err := auth.GenerateDeviceAccessToken(s.Cfg, d.ID, d.Username, d.Role, perms, d.AccessPointID, d.Direction)
 	if err != nil {
 		return nil, err
 	}
@@ -27,6 +27,8 @@ func NewUserBranchService(client *ent.Client) *UserBranchService {
 
 type AssignUserBranchInput struct {
 	BranchID int
+	// rol en la sucursal: supervisor | viewer | "" (colaborador)
+	RoleInBranch string
 }
 
 func (s *UserBranchService) ListForUser(ctx context.Context, userID int) ([]*ent.UserBranch, error) {
@@ -45,6 +47,10 @@ func (s *UserBranchService) Assign(ctx context.Context, userID int, in AssignUse
 	if userID <= 0 || in.BranchID <= 0 {
 		return nil, ErrUserBranchInvalidInput
 	}
+	role, ok := NormalizeBranchRole(in.RoleInBranch)
+	if !ok {
+		return nil, ErrUserBranchInvalidInput
+	}
 
 	if _, err := s.Client.User.Query().Where(user.IDEQ(userID)).Only(ctx); err != nil {
 		return nil, err
@@ -66,11 +72,40 @@ func (s *UserBranchService) Assign(ctx context.Context, userID int, in AssignUse
 		return nil, ErrUserBranchAlreadyExists
 	}
 
-	return s.Client.UserBranch.
+	create := s.Client.UserBranch.
 		Create().
 		SetUserID(userID).
-		SetBranchID(in.BranchID).
-		Save(ctx)
+		SetBranchID(in.BranchID)
+	if role != "" {
+		create.SetRoleInBranch(role)
+	}
+	return create.Save(ctx)
+}
+
+// SetRole cambia el rol del usuario en la sucursal ("" lo deja como colaborador).
+func (s *UserBranchService) SetRole(ctx context.Context, userID, branchID int, role string) (*ent.UserBranch, error) {
+	if userID <= 0 || branchID <= 0 {
+		return nil, ErrUserBranchInvalidInput
+	}
+	role, ok := NormalizeBranchRole(role)
+	if !ok {
+		return nil, ErrUserBranchInvalidInput
+	}
+
+	ub, err := s.Client.UserBranch.Query().
+		Where(userbranch.UserIDEQ(userID), userbranch.BranchIDEQ(branchID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	upd := ub.Update()
+	if role == "" {
+		upd.ClearRoleInBranch()
+	} else {
+		upd.SetRoleInBranch(role)
+	}
+	return upd.Save(ctx)
 }
 
 func (s *UserBranchService) Delete(ctx context.Context, userID, branchID int) error {
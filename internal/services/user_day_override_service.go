@@ -3,10 +3,12 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
 	"back/internal/ent"
+	"back/internal/ent/predicate"
 	"back/internal/ent/shift"
 	"back/internal/ent/user"
 	"back/internal/ent/userdayoverride"
@@ -146,3 +148,153 @@ func (s *UserDayOverrideService) Delete(ctx context.Context, overrideID int) err
 	}
 	return s.Client.UserDayOverride.DeleteOneID(overrideID).Exec(ctx)
 }
+
+type BulkDayOverrideInput struct {
+	StartDate time.Time
+	EndDate   time.Time
+	ShiftID   *int
+	IsDayOff  *bool
+	Mode      string
+	Notes     *string
+	// reemplaza los overrides que ya existan en esas fechas (si no, se omiten)
+	ReplaceExisting bool
+	// destino: todos los usuarios activos de la sucursal y/o una lista de usuarios
+	BranchID *int
+	UserIDs  []int
+}
+
+type BulkDayOverrideResult struct {
+	StartDate time.Time
+	EndDate   time.Time
+	Users     int
+	Days      int
+	Created   int
+	Replaced  int // overrides previos eliminados (ReplaceExisting); sus fechas se cuentan en Created
+	Skipped   int // fechas que ya tenían override (sin ReplaceExisting)
+}
+
+// lote de inserción para CreateBulk
+const bulkDayOverrideBatch = 500
+
+// BulkCreate crea el mismo override para todos los usuarios destino y todas las fechas
+// del rango (ej: cierre de una sucursal), en una sola transacción.
+func (s *UserDayOverrideService) BulkCreate(ctx context.Context, in BulkDayOverrideInput) (*BulkDayOverrideResult, error) {
+	if in.StartDate.IsZero() || in.EndDate.IsZero() {
+		return nil, ErrUserDayOverrideInvalidInput
+	}
+	start := truncateToDay(in.StartDate)
+	end := truncateToDay(in.EndDate)
+	if end.Before(start) || end.Sub(start) > maxCalendarRangeDays*24*time.Hour {
+		return nil, ErrUserDayOverrideInvalidInput
+	}
+
+	in.Mode = strings.TrimSpace(in.Mode)
+	if in.Mode == "" {
+		in.Mode = "onsite"
+	}
+	var notes *string
+	if in.Notes != nil {
+		if v := strings.TrimSpace(*in.Notes); v != "" {
+			notes = &v
+		}
+	}
+	if in.ShiftID != nil {
+		if _, err := s.Client.Shift.Query().Where(shift.IDEQ(*in.ShiftID)).Only(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	userIDs, err := bulkTargetUsers(ctx, s.Client, in.BranchID, in.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var dates []time.Time
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		dates = append(dates, d)
+	}
+
+	res := &BulkDayOverrideResult{
+		StartDate: start,
+		EndDate:   end,
+		Users:     len(userIDs),
+		Days:      len(dates),
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	inRange := []predicate.UserDayOverride{
+		userdayoverride.UserIDIn(userIDs...),
+		userdayoverride.DateGTE(start),
+		userdayoverride.DateLT(end.AddDate(0, 0, 1)),
+	}
+
+	existing := map[string]bool{}
+	if in.ReplaceExisting {
+		n, err := tx.UserDayOverride.Delete().Where(inRange...).Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res.Replaced = n
+	} else {
+		rows, err := tx.UserDayOverride.Query().Where(inRange...).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range rows {
+			existing[bulkOverrideKey(o.UserID, o.Date)] = true
+		}
+	}
+
+	batch := make([]*ent.UserDayOverrideCreate, 0, bulkDayOverrideBatch)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if _, err := tx.UserDayOverride.CreateBulk(batch...).Save(ctx); err != nil {
+			return err
+		}
+		res.Created += len(batch)
+		batch = batch[:0]
+		return nil
+	}
+
+	for _, userID := range userIDs {
+		for _, d := range dates {
+			if existing[bulkOverrideKey(userID, d)] {
+				res.Skipped++
+				continue
+			}
+			create := tx.UserDayOverride.Create().
+				SetUserID(userID).
+				SetDate(d).
+				SetMode(in.Mode).
+				SetNillableShiftID(in.ShiftID).
+				SetNillableIsDayOff(in.IsDayOff).
+				SetNillableNotes(notes)
+			batch = append(batch, create)
+			if len(batch) == bulkDayOverrideBatch {
+				if err := flush(); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func bulkOverrideKey(userID int, date time.Time) string {
+	return fmt.Sprintf("%d|%s", userID, date.Format("2006-01-02"))
+}
@@ -3,15 +3,29 @@ package services
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"back/internal/ent"
 	"back/internal/ent/shift"
 	"back/internal/ent/user"
+	"back/internal/ent/userbranch"
 	"back/internal/ent/usershiftassignment"
 )
 
 var ErrUserShiftAssignmentInvalidInput = errors.New("invalid user shift assignment input")
+var ErrUserShiftAssignmentOverlap = errors.New("shift assignment overlaps an existing active assignment")
+
+// qué hacer cuando la asignación se superpone con otra activa del usuario
+const (
+	// cierra la anterior el día previo (o recorta la nueva si la otra empieza después)
+	AssignmentOverlapClose = "close"
+	// rechaza la operación con ErrUserShiftAssignmentOverlap
+	AssignmentOverlapReject = "reject"
+)
+
+// ErrBulkNoTargets: la operación masiva no tiene usuarios destino válidos.
+var ErrBulkNoTargets = errors.New("bulk operation has no target users")
 
 type UserShiftAssignmentService struct {
 	Client *ent.Client
@@ -26,6 +40,16 @@ type CreateUserShiftAssignmentInput struct {
 	StartDate time.Time
 	EndDate   *time.Time
 	IsActive  *bool
+	OnOverlap string // "close" (por defecto) | "reject"
+}
+
+type PatchUserShiftAssignmentInput struct {
+	ShiftID      *int
+	StartDate    *time.Time
+	EndDate      *time.Time
+	ClearEndDate bool
+	IsActive     *bool
+	OnOverlap    string // "close" (por defecto) | "reject"
 }
 
 func (s *UserShiftAssignmentService) ListForUser(ctx context.Context, userID int) ([]*ent.UserShiftAssignment, error) {
@@ -37,14 +61,30 @@ func (s *UserShiftAssignmentService) ListForUser(ctx context.Context, userID int
 		Query().
 		Where(usershiftassignment.UserIDEQ(userID)).
 		WithShift().
-		Order(ent.Desc(usershiftassignment.FieldStartDate)).
+		Order(ent.Desc(usershiftassignment.FieldStartDate), ent.Desc(usershiftassignment.FieldID)).
 		All(ctx)
 }
 
+// Create asigna un turno al usuario. Si se superpone con otra asignación activa se
+// resuelve según OnOverlap (ver resolveAssignmentOverlaps), de modo que nunca quedan
+// dos asignaciones activas vigentes el mismo día.
 func (s *UserShiftAssignmentService) Create(ctx context.Context, userID int, in CreateUserShiftAssignmentInput) (*ent.UserShiftAssignment, error) {
 	if userID <= 0 || in.ShiftID <= 0 || in.StartDate.IsZero() {
 		return nil, ErrUserShiftAssignmentInvalidInput
 	}
+	mode, err := normalizeAssignmentOverlapMode(in.OnOverlap)
+	if err != nil {
+		return nil, err
+	}
+	start := truncateToDay(in.StartDate)
+	end := in.EndDate
+	if end != nil {
+		e := truncateToDay(*end)
+		if e.Before(start) {
+			return nil, ErrUserShiftAssignmentInvalidInput
+		}
+		end = &e
+	}
 
 	if _, err := s.Client.User.Query().Where(user.IDEQ(userID)).Only(ctx); err != nil {
 		return nil, err
@@ -53,39 +93,396 @@ func (s *UserShiftAssignmentService) Create(ctx context.Context, userID int, in
 		return nil, err
 	}
 
-	if _, err := s.Client.Shift.Query().Where(shift.IDEQ(in.ShiftID)).Only(ctx); err != nil {
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
 		return nil, err
 	}
+	defer func() { _ = tx.Rollback() }()
+
+	if in.IsActive == nil || *in.IsActive {
+		res, err := resolveAssignmentOverlaps(ctx, tx.Client(), userID, 0, start, end, mode)
+		if err != nil {
+			return nil, err
+		}
+		end = res.End
+	}
+
+	create := tx.UserShiftAssignment.Create().
+		SetUserID(userID).
+		SetShiftID(in.ShiftID).
+		SetStartDate(start).
+		SetNillableEndDate(end)
 
-	_, err := s.Client.UserShiftAssignment.Update().Where(
-		usershiftassignment.UserID(userID),
-		usershiftassignment.IsActiveEQ(true),
-	).
-		SetIsActive(false).
-		Save(ctx)
+	if in.IsActive != nil {
+		create.SetIsActive(*in.IsActive)
+	}
 
+	a, err := create.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
 
-	create := s.Client.UserShiftAssignment.Create().
-		SetUserID(userID).
-		SetShiftID(in.ShiftID).
-		SetStartDate(in.StartDate)
+// Patch edita una asignación del usuario aplicando las mismas reglas de superposición
+// que Create sobre el rango final (lo que viene + lo que ya existía).
+func (s *UserShiftAssignmentService) Patch(ctx context.Context, userID, assignmentID int, in PatchUserShiftAssignmentInput) (*ent.UserShiftAssignment, error) {
+	if userID <= 0 || assignmentID <= 0 {
+		return nil, ErrUserShiftAssignmentInvalidInput
+	}
+	if in.ShiftID == nil && in.StartDate == nil && in.EndDate == nil && !in.ClearEndDate && in.IsActive == nil {
+		return nil, ErrUserShiftAssignmentInvalidInput
+	}
+	mode, err := normalizeAssignmentOverlapMode(in.OnOverlap)
+	if err != nil {
+		return nil, err
+	}
 
-	if in.EndDate != nil {
-		create.SetEndDate(*in.EndDate)
+	a, err := s.Client.UserShiftAssignment.Query().
+		Where(usershiftassignment.IDEQ(assignmentID), usershiftassignment.UserIDEQ(userID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shiftID := a.ShiftID
+	if in.ShiftID != nil {
+		if *in.ShiftID <= 0 {
+			return nil, ErrUserShiftAssignmentInvalidInput
+		}
+		if _, err := s.Client.Shift.Query().Where(shift.IDEQ(*in.ShiftID)).Only(ctx); err != nil {
+			return nil, err
+		}
+		shiftID = *in.ShiftID
+	}
+	start := a.StartDate
+	if in.StartDate != nil {
+		if in.StartDate.IsZero() {
+			return nil, ErrUserShiftAssignmentInvalidInput
+		}
+		start = truncateToDay(*in.StartDate)
+	}
+	end := a.EndDate
+	if in.ClearEndDate {
+		end = nil
+	} else if in.EndDate != nil {
+		e := truncateToDay(*in.EndDate)
+		end = &e
 	}
+	if end != nil && end.Before(start) {
+		return nil, ErrUserShiftAssignmentInvalidInput
+	}
+	active := a.IsActive
 	if in.IsActive != nil {
-		create.SetIsActive(*in.IsActive)
+		active = *in.IsActive
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
 	}
+	defer func() { _ = tx.Rollback() }()
 
-	return create.Save(ctx)
+	if active {
+		res, err := resolveAssignmentOverlaps(ctx, tx.Client(), userID, a.ID, start, end, mode)
+		if err != nil {
+			return nil, err
+		}
+		end = res.End
+	}
+
+	upd := tx.UserShiftAssignment.UpdateOneID(a.ID).
+		SetShiftID(shiftID).
+		SetStartDate(start).
+		SetIsActive(active)
+	if end != nil {
+		upd.SetEndDate(*end)
+	} else {
+		upd.ClearEndDate()
+	}
+
+	saved, err := upd.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return saved, nil
 }
 
-func (s *UserShiftAssignmentService) Delete(ctx context.Context, assignmentID int) error {
-	if assignmentID <= 0 {
+func (s *UserShiftAssignmentService) Delete(ctx context.Context, userID, assignmentID int) error {
+	if userID <= 0 || assignmentID <= 0 {
 		return ErrUserShiftAssignmentInvalidInput
 	}
-	return s.Client.UserShiftAssignment.DeleteOneID(assignmentID).Exec(ctx)
+	n, err := s.Client.UserShiftAssignment.Delete().
+		Where(usershiftassignment.IDEQ(assignmentID), usershiftassignment.UserIDEQ(userID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+	return nil
+}
+
+type BulkShiftAssignmentInput struct {
+	ShiftID   int
+	StartDate time.Time
+	EndDate   *time.Time
+	// destino: todos los usuarios activos de la sucursal y/o una lista de usuarios
+	BranchID *int
+	UserIDs  []int
+}
+
+type BulkShiftAssignmentResult struct {
+	ShiftID     int
+	StartDate   time.Time
+	EndDate     *time.Time
+	Users       int
+	Closed      int // asignaciones previas cerradas el día anterior a StartDate
+	Deactivated int // asignaciones que comenzaban el mismo StartDate (reemplazadas)
+	Split       int // asignaciones anteriores retomadas después de EndDate
+	Assignments []*ent.UserShiftAssignment
+}
+
+// BulkAssign asigna el turno a todos los usuarios destino desde StartDate, en una sola
+// transacción. Las superposiciones se resuelven como en Create (modo "close"): las
+// vigentes se cierran el día anterior, las que comienzan el mismo día se desactivan y,
+// si alguna empieza después, la nueva termina el día anterior a esa.
+func (s *UserShiftAssignmentService) BulkAssign(ctx context.Context, in BulkShiftAssignmentInput) (*BulkShiftAssignmentResult, error) {
+	if in.ShiftID <= 0 || in.StartDate.IsZero() {
+		return nil, ErrUserShiftAssignmentInvalidInput
+	}
+	in.StartDate = truncateToDay(in.StartDate)
+	if in.EndDate != nil {
+		end := truncateToDay(*in.EndDate)
+		if end.Before(in.StartDate) {
+			return nil, ErrUserShiftAssignmentInvalidInput
+		}
+		in.EndDate = &end
+	}
+
+	if _, err := s.Client.Shift.Get(ctx, in.ShiftID); err != nil {
+		return nil, err
+	}
+
+	userIDs, err := bulkTargetUsers(ctx, s.Client, in.BranchID, in.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &BulkShiftAssignmentResult{
+		ShiftID:     in.ShiftID,
+		StartDate:   in.StartDate,
+		EndDate:     in.EndDate,
+		Users:       len(userIDs),
+		Assignments: make([]*ent.UserShiftAssignment, 0, len(userIDs)),
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, userID := range userIDs {
+		overlaps, err := resolveAssignmentOverlaps(ctx, tx.Client(), userID, 0, in.StartDate, in.EndDate, AssignmentOverlapClose)
+		if err != nil {
+			return nil, err
+		}
+		res.Closed += overlaps.Closed
+		res.Deactivated += overlaps.Deactivated
+		res.Split += overlaps.Split
+
+		a, err := tx.UserShiftAssignment.Create().
+			SetUserID(userID).
+			SetShiftID(in.ShiftID).
+			SetStartDate(in.StartDate).
+			SetNillableEndDate(overlaps.End).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res.Assignments = append(res.Assignments, a)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type assignmentOverlapResult struct {
+	Closed      int
+	Deactivated int
+	Split       int
+	// fecha de término final de la asignación nueva/editada (puede quedar recortada)
+	End *time.Time
+}
+
+// resolveAssignmentOverlaps busca las asignaciones activas del usuario (salvo excludeID)
+// que se superponen con [start, end] y, en modo "close", las resuelve sin dejar dos
+// asignaciones vigentes el mismo día:
+//   - la que empezó antes se cierra el día anterior a start
+//   - la que empieza el mismo día se desactiva (queda reemplazada)
+//   - si otra empieza después, la nueva termina el día anterior a esa
+//   - si la nueva tiene término y cae dentro de una anterior que sigue después de ese
+//     término, la anterior se parte: se recrea desde el día siguiente al término
+//
+// En modo "reject" cualquier superposición devuelve ErrUserShiftAssignmentOverlap.
+func resolveAssignmentOverlaps(ctx context.Context, client *ent.Client, userID, excludeID int, start time.Time, end *time.Time, mode string) (*assignmentOverlapResult, error) {
+	q := client.UserShiftAssignment.Query().
+		Where(
+			usershiftassignment.UserIDEQ(userID),
+			usershiftassignment.IsActiveEQ(true),
+			usershiftassignment.Or(
+				usershiftassignment.EndDateIsNil(),
+				usershiftassignment.EndDateGTE(start),
+			),
+		)
+	if excludeID > 0 {
+		q = q.Where(usershiftassignment.IDNEQ(excludeID))
+	}
+	if end != nil {
+		q = q.Where(usershiftassignment.StartDateLTE(*end))
+	}
+
+	overlaps, err := q.
+		Order(ent.Asc(usershiftassignment.FieldStartDate), ent.Asc(usershiftassignment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &assignmentOverlapResult{End: end}
+	if len(overlaps) == 0 {
+		return res, nil
+	}
+	if mode == AssignmentOverlapReject {
+		return nil, ErrUserShiftAssignmentOverlap
+	}
+
+	for _, a := range overlaps {
+		// tramo de la anterior que queda después de una asignación nueva acotada
+		if end != nil && !a.StartDate.After(start) && (a.EndDate == nil || a.EndDate.After(*end)) {
+			if _, err := client.UserShiftAssignment.Create().
+				SetUserID(a.UserID).
+				SetShiftID(a.ShiftID).
+				SetStartDate(end.AddDate(0, 0, 1)).
+				SetNillableEndDate(a.EndDate).
+				Save(ctx); err != nil {
+				return nil, err
+			}
+			res.Split++
+		}
+
+		switch {
+		case a.StartDate.Before(start):
+			if _, err := a.Update().SetEndDate(start.AddDate(0, 0, -1)).Save(ctx); err != nil {
+				return nil, err
+			}
+			res.Closed++
+		case a.StartDate.Equal(start):
+			if _, err := a.Update().SetIsActive(false).Save(ctx); err != nil {
+				return nil, err
+			}
+			res.Deactivated++
+		default:
+			limit := truncateToDay(a.StartDate).AddDate(0, 0, -1)
+			if res.End == nil || limit.Before(*res.End) {
+				res.End = &limit
+			}
+		}
+	}
+	return res, nil
+}
+
+func normalizeAssignmentOverlapMode(v string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(v)) {
+	case "", AssignmentOverlapClose:
+		return AssignmentOverlapClose, nil
+	case AssignmentOverlapReject:
+		return AssignmentOverlapReject, nil
+	}
+	return "", ErrUserShiftAssignmentInvalidInput
+}
+
+// bulkTargetUsers resuelve los usuarios destino de una operación masiva: los usuarios
+// con asignación activa en la sucursal más los indicados explícitamente (sin repetir).
+// Con alcance restringido la sucursal y cada usuario deben estar en sucursales que
+// quien opera supervisa.
+func bulkTargetUsers(ctx context.Context, client *ent.Client, branchID *int, userIDs []int) ([]int, error) {
+	if branchID == nil && len(userIDs) == 0 {
+		return nil, ErrBulkNoTargets
+	}
+
+	seen := map[int]bool{}
+	out := []int{}
+
+	if branchID != nil {
+		if !AccessScopeFrom(ctx).CanManage(*branchID) {
+			return nil, ErrOutOfScope
+		}
+		if _, err := client.Branch.Get(ctx, *branchID); err != nil {
+			return nil, err
+		}
+		ids, err := client.UserBranch.Query().
+			Where(userbranch.BranchIDEQ(*branchID), userbranch.IsActiveEQ(true)).
+			Order(ent.Asc(userbranch.FieldUserID)).
+			Select(userbranch.FieldUserID).
+			Ints(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			if !seen[id] {
+				seen[id] = true
+				out = append(out, id)
+			}
+		}
+	}
+
+	if len(userIDs) > 0 {
+		for _, id := range userIDs {
+			if id <= 0 {
+				return nil, ErrBulkNoTargets
+			}
+		}
+		existing, err := client.User.Query().
+			Where(user.IDIn(userIDs...)).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		found := make(map[int]bool, len(existing))
+		for _, id := range existing {
+			found[id] = true
+		}
+		for _, id := range userIDs {
+			if !found[id] {
+				_, err := client.User.Get(ctx, id)
+				if err == nil {
+					err = ErrBulkNoTargets
+				}
+				return nil, err
+			}
+			if !seen[id] {
+				if err := checkUserScope(ctx, client, id, true); err != nil {
+					return nil, err
+				}
+				seen[id] = true
+				out = append(out, id)
+			}
+		}
+	}
+
+	if len(out) == 0 {
+		return nil, ErrBulkNoTargets
+	}
+	return out, nil
 }
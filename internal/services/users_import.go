@@ -0,0 +1,564 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"back/internal/ent"
+	"back/internal/ent/user"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var ErrUserImportInvalidFile = errors.New("invalid user import file")
+
+// máximo de filas por importación: cada contraseña pasa por bcrypt (costo 12, ~250 ms)
+// y la importación completa tiene que caber en el timeout de la petición
+const maxUserImportRows = 200
+
+// columnas reconocidas en el encabezado de la planilla (sin distinguir mayúsculas)
+var userImportColumns = map[string]bool{
+	"username":            true,
+	"password":            true,
+	"role":                true,
+	"first_name":          true,
+	"middle_name":         true,
+	"last_name":           true,
+	"email":               true,
+	"employee_code":       true,
+	"access_code":         true,
+	"is_active":           true,
+	"hire_date":           true,
+	"prior_service_years": true,
+	"branch_codes":        true, // códigos separados por "|" o ","
+	"access_points":       true, // "CODIGO_SUCURSAL:Nombre" o "Nombre", separados por "|" o ","
+	"shift":               true, // nombre del turno
+	"shift_start_date":    true, // por defecto hire_date o hoy
+}
+
+var userImportRequiredColumns = []string{"username", "password", "first_name", "last_name", "email", "access_code"}
+
+// UserImportError es un error de validación de una fila (Row es el número de fila
+// de la planilla, contando el encabezado como fila 1).
+type UserImportError struct {
+	Row     int
+	Column  string
+	Message string
+}
+
+type UserImportUser struct {
+	Row      int
+	Username string
+	UserID   *int
+}
+
+type UserImportReport struct {
+	DryRun    bool
+	TotalRows int
+	ValidRows int
+	Created   int
+	Errors    []UserImportError
+	Users     []UserImportUser
+}
+
+type importedUser struct {
+	row            int
+	reportIdx      int
+	in             CreateUserInput
+	branchIDs      []int
+	accessPointIDs []int
+	shiftID        *int
+	shiftStart     time.Time
+}
+
+// Import valida todas las filas de la planilla (encabezado + datos) contra los índices
+// únicos existentes (username, email, access_code) y contra el resto del archivo.
+// Con dryRun, o si hay errores, solo devuelve el reporte. Si no, crea todos los
+// usuarios con sus sucursales, accesos y turno en una única transacción.
+func (s *UsersService) Import(ctx context.Context, rows [][]string, dryRun bool) (*UserImportReport, error) {
+	if len(rows) < 2 {
+		return nil, ErrUserImportInvalidFile
+	}
+	if len(rows)-1 > maxUserImportRows {
+		return nil, fmt.Errorf("%w: max %d rows", ErrUserImportInvalidFile, maxUserImportRows)
+	}
+
+	report := &UserImportReport{
+		DryRun:    dryRun,
+		TotalRows: len(rows) - 1,
+		Errors:    []UserImportError{},
+		Users:     []UserImportUser{},
+	}
+
+	cols := map[string]int{}
+	for i, h := range rows[0] {
+		name := strings.ToLower(strings.TrimSpace(h))
+		if name == "" {
+			continue
+		}
+		if !userImportColumns[name] {
+			report.Errors = append(report.Errors, UserImportError{Row: 1, Column: h, Message: "columna desconocida"})
+			continue
+		}
+		if _, dup := cols[name]; dup {
+			report.Errors = append(report.Errors, UserImportError{Row: 1, Column: h, Message: "columna duplicada"})
+			continue
+		}
+		cols[name] = i
+	}
+	for _, c := range userImportRequiredColumns {
+		if _, ok := cols[c]; !ok {
+			report.Errors = append(report.Errors, UserImportError{Row: 1, Column: c, Message: "columna requerida"})
+		}
+	}
+	if len(report.Errors) > 0 {
+		return report, nil
+	}
+
+	lookup, err := s.loadUserImportLookup(ctx, rows[1:], cols)
+	if err != nil {
+		return nil, err
+	}
+
+	seenUsername := map[string]int{}
+	seenEmail := map[string]int{}
+	seenAccessCode := map[string]int{}
+
+	items := make([]importedUser, 0, len(rows)-1)
+	for i, raw := range rows[1:] {
+		rowNum := i + 2
+		cell := func(name string) string {
+			idx, ok := cols[name]
+			if !ok || idx >= len(raw) {
+				return ""
+			}
+			return strings.TrimSpace(raw[idx])
+		}
+		before := len(report.Errors)
+		fail := func(col, msg string) {
+			report.Errors = append(report.Errors, UserImportError{Row: rowNum, Column: col, Message: msg})
+		}
+
+		it := importedUser{row: rowNum}
+		it.in = CreateUserInput{
+			Username:   cell("username"),
+			Password:   cell("password"),
+			Role:       strings.ToLower(cell("role")),
+			FirstName:  cell("first_name"),
+			LastName:   cell("last_name"),
+			Email:      cell("email"),
+			AccessCode: cell("access_code"),
+		}
+		if v := cell("middle_name"); v != "" {
+			it.in.MiddleName = &v
+		}
+		if v := cell("employee_code"); v != "" {
+			it.in.EmployeeCode = &v
+		}
+		if it.in.Role == "" {
+			it.in.Role = "user"
+		}
+
+		for _, c := range userImportRequiredColumns {
+			if cell(c) == "" {
+				fail(c, "requerido")
+			}
+		}
+		if !lookup.roles[it.in.Role] {
+			fail("role", "rol inexistente")
+		}
+
+		// índices únicos: contra la base de datos y contra las filas anteriores
+		uniques := []struct {
+			col    string
+			value  string
+			inDB   map[string]bool
+			inFile map[string]int
+		}{
+			{"username", it.in.Username, lookup.usernames, seenUsername},
+			{"email", it.in.Email, lookup.emails, seenEmail},
+			{"access_code", it.in.AccessCode, lookup.accessCodes, seenAccessCode},
+		}
+		for _, u := range uniques {
+			if u.value == "" {
+				continue
+			}
+			if u.inDB[u.value] {
+				fail(u.col, "ya existe")
+			} else if prev, ok := u.inFile[u.value]; ok {
+				fail(u.col, fmt.Sprintf("duplicado en la fila %d", prev))
+			} else {
+				u.inFile[u.value] = rowNum
+			}
+		}
+
+		if v := cell("is_active"); v != "" {
+			b, ok := parseImportBool(v)
+			if !ok {
+				fail("is_active", "valor invalido")
+			}
+			it.in.IsActive = &b
+		}
+		if v := cell("hire_date"); v != "" {
+			d, ok := parseImportDate(v)
+			if !ok {
+				fail("hire_date", "fecha invalida")
+			}
+			it.in.HireDate = &d
+		}
+		if v := cell("prior_service_years"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 0 {
+				fail("prior_service_years", "debe ser un entero >= 0")
+			}
+			it.in.PriorServiceYears = &n
+		}
+
+		rowBranches := map[int]bool{}
+		for _, code := range splitImportList(cell("branch_codes")) {
+			b, ok := lookup.branchesByCode[strings.ToLower(code)]
+			if !ok {
+				fail("branch_codes", fmt.Sprintf("sucursal %q no existe", code))
+				continue
+			}
+			if !rowBranches[b.ID] {
+				rowBranches[b.ID] = true
+				it.branchIDs = append(it.branchIDs, b.ID)
+			}
+		}
+
+		for _, item := range splitImportList(cell("access_points")) {
+			apID, msg := lookup.resolveAccessPoint(item, rowBranches)
+			if msg != "" {
+				fail("access_points", msg)
+				continue
+			}
+			it.accessPointIDs = append(it.accessPointIDs, apID)
+		}
+
+		if v := cell("shift"); v != "" {
+			sh, ok := lookup.shiftsByName[strings.ToLower(v)]
+			if !ok {
+				fail("shift", fmt.Sprintf("turno %q no existe", v))
+			} else {
+				it.shiftID = &sh.ID
+			}
+		}
+		it.shiftStart = truncateToDay(time.Now())
+		if it.in.HireDate != nil {
+			it.shiftStart = truncateToDay(*it.in.HireDate)
+		}
+		if v := cell("shift_start_date"); v != "" {
+			d, ok := parseImportDate(v)
+			if !ok {
+				fail("shift_start_date", "fecha invalida")
+			}
+			it.shiftStart = d
+		}
+
+		it.reportIdx = len(report.Users)
+		report.Users = append(report.Users, UserImportUser{Row: rowNum, Username: it.in.Username})
+		if len(report.Errors) == before {
+			report.ValidRows++
+			items = append(items, it)
+		}
+	}
+
+	if dryRun || len(report.Errors) > 0 {
+		return report, nil
+	}
+
+	// el hash es costoso: se calcula en paralelo antes de abrir la transacción
+	hashes, err := hashImportPasswords(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for i, it := range items {
+		create := tx.User.Create().
+			SetUsername(it.in.Username).
+			SetPasswordHash(hashes[i]).
+			SetRole(it.in.Role).
+			SetFirstName(it.in.FirstName).
+			SetLastName(it.in.LastName).
+			SetEmail(it.in.Email).
+			SetAccessCode(it.in.AccessCode).
+			SetNillableMiddleName(it.in.MiddleName).
+			SetNillableEmployeeCode(it.in.EmployeeCode).
+			SetNillableIsActive(it.in.IsActive).
+			SetNillablePriorServiceYears(it.in.PriorServiceYears)
+		if it.in.HireDate != nil {
+			create.SetHireDate(truncateToDay(*it.in.HireDate))
+		}
+
+		u, err := create.Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				// otra petición creó el mismo username/email/access_code entre la validación y el insert
+				return nil, fmt.Errorf("%w: fila %d", ErrUserAlreadyExists, it.row)
+			}
+			return nil, err
+		}
+
+		for _, branchID := range it.branchIDs {
+			if _, err := tx.UserBranch.Create().
+				SetUserID(u.ID).
+				SetBranchID(branchID).
+				Save(ctx); err != nil {
+				return nil, err
+			}
+		}
+		for _, apID := range it.accessPointIDs {
+			if _, err := tx.UserAccessPoint.Create().
+				SetUserID(u.ID).
+				SetAccessPointID(apID).
+				Save(ctx); err != nil {
+				return nil, err
+			}
+		}
+		if it.shiftID != nil {
+			if _, err := tx.UserShiftAssignment.Create().
+				SetUserID(u.ID).
+				SetShiftID(*it.shiftID).
+				SetStartDate(it.shiftStart).
+				Save(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		id := u.ID
+		report.Users[it.reportIdx].UserID = &id
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	report.Created = len(items)
+	return report, nil
+}
+
+/* =========================
+   INTERNAL
+   ========================= */
+
+// hashImportPasswords calcula los hashes bcrypt con un worker por CPU. Se corta si la
+// petición se cancela (timeout) para no seguir ocupando CPU en vano.
+func hashImportPasswords(ctx context.Context, items []importedUser) ([]string, error) {
+	hashes := make([]string, len(items))
+	errs := make([]error, len(items))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	workers := min(runtime.NumCPU(), len(items))
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				hash, err := bcrypt.GenerateFromPassword([]byte(items[i].in.Password), 12)
+				hashes[i], errs[i] = string(hash), err
+			}
+		}()
+	}
+
+	var ctxErr error
+	for i := range items {
+		if ctxErr = ctx.Err(); ctxErr != nil {
+			break
+		}
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	if ctxErr != nil {
+		return nil, ctxErr
+	}
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return hashes, nil
+}
+
+type userImportLookup struct {
+	usernames      map[string]bool
+	emails         map[string]bool
+	accessCodes    map[string]bool
+	branchesByCode map[string]*ent.Branch
+	accessPoints   map[int][]*ent.AccessPoint // por sucursal
+	shiftsByName   map[string]*ent.Shift
+	roles          map[string]bool
+}
+
+// loadUserImportLookup precarga lo necesario para validar todas las filas sin
+// consultar la base de datos por cada una.
+func (s *UsersService) loadUserImportLookup(ctx context.Context, rows [][]string, cols map[string]int) (*userImportLookup, error) {
+	values := func(col string) []string {
+		idx := cols[col]
+		out := make([]string, 0, len(rows))
+		for _, r := range rows {
+			if idx < len(r) {
+				if v := strings.TrimSpace(r[idx]); v != "" {
+					out = append(out, v)
+				}
+			}
+		}
+		return out
+	}
+
+	l := &userImportLookup{
+		usernames:      map[string]bool{},
+		emails:         map[string]bool{},
+		accessCodes:    map[string]bool{},
+		branchesByCode: map[string]*ent.Branch{},
+		accessPoints:   map[int][]*ent.AccessPoint{},
+		shiftsByName:   map[string]*ent.Shift{},
+		roles:          map[string]bool{},
+	}
+
+	roles, err := s.Client.Role.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range roles {
+		l.roles[r.Name] = true
+	}
+
+	existing, err := s.Client.User.Query().
+		Where(user.Or(
+			user.UsernameIn(values("username")...),
+			user.EmailIn(values("email")...),
+			user.AccessCodeIn(values("access_code")...),
+		)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range existing {
+		l.usernames[u.Username] = true
+		if u.Email != nil {
+			l.emails[*u.Email] = true
+		}
+		if u.AccessCode != nil {
+			l.accessCodes[*u.AccessCode] = true
+		}
+	}
+
+	branches, err := s.Client.Branch.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range branches {
+		if b.Code != nil {
+			l.branchesByCode[strings.ToLower(*b.Code)] = b
+		}
+	}
+
+	aps, err := s.Client.AccessPoint.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, ap := range aps {
+		l.accessPoints[ap.BranchID] = append(l.accessPoints[ap.BranchID], ap)
+	}
+
+	shifts, err := s.Client.Shift.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, sh := range shifts {
+		l.shiftsByName[strings.ToLower(sh.Name)] = sh
+	}
+
+	return l, nil
+}
+
+// resolveAccessPoint busca el acceso entre las sucursales de la fila. Acepta
+// "CODIGO_SUCURSAL:Nombre" o solo "Nombre" (debe ser único entre esas sucursales).
+func (l *userImportLookup) resolveAccessPoint(item string, rowBranches map[int]bool) (int, string) {
+	name := item
+	var branchID *int
+	if code, rest, ok := strings.Cut(item, ":"); ok {
+		b, found := l.branchesByCode[strings.ToLower(strings.TrimSpace(code))]
+		if !found {
+			return 0, fmt.Sprintf("sucursal %q no existe", code)
+		}
+		if !rowBranches[b.ID] {
+			return 0, fmt.Sprintf("el usuario no queda asignado a la sucursal %q", code)
+		}
+		branchID = &b.ID
+		name = strings.TrimSpace(rest)
+	}
+
+	var matches []*ent.AccessPoint
+	for bID := range rowBranches {
+		if branchID != nil && bID != *branchID {
+			continue
+		}
+		for _, ap := range l.accessPoints[bID] {
+			if strings.EqualFold(ap.Name, name) {
+				matches = append(matches, ap)
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return 0, fmt.Sprintf("acceso %q no existe en las sucursales del usuario", item)
+	case 1:
+		return matches[0].ID, ""
+	default:
+		return 0, fmt.Sprintf("acceso %q es ambiguo: use CODIGO_SUCURSAL:Nombre", item)
+	}
+}
+
+func splitImportList(v string) []string {
+	parts := strings.FieldsFunc(v, func(r rune) bool { return r == '|' || r == ',' })
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func parseImportBool(v string) (bool, bool) {
+	switch strings.ToLower(strings.TrimSpace(v)) {
+	case "1", "true", "si", "sí", "s", "yes", "y":
+		return true, true
+	case "0", "false", "no", "n":
+		return false, true
+	}
+	return false, false
+}
+
+// parseImportDate acepta YYYY-MM-DD, DD-MM-YYYY, DD/MM/YYYY o el número de serie
+// con que Excel guarda las fechas.
+func parseImportDate(v string) (time.Time, bool) {
+	for _, layout := range []string{"2006-01-02", "02-01-2006", "02/01/2006"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, true
+		}
+	}
+	if serial, err := strconv.ParseFloat(v, 64); err == nil && serial > 0 && serial < 2958466 {
+		base := time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+		return base.AddDate(0, 0, int(serial)), true
+	}
+	return time.Time{}, false
+}
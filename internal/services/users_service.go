@@ -9,6 +9,7 @@ import (
 
 	"back/internal/ent"
 	"back/internal/ent/address"
+	"back/internal/ent/predicate"
 	"back/internal/ent/refreshtoken"
 	"back/internal/ent/user"
 	"back/internal/ent/useraccesspoint"
@@ -46,6 +47,9 @@ type CreateUserInput struct {
 	EmployeeCode *string
 	AccessCode   string
 	IsActive     *bool
+
+	HireDate          *time.Time
+	PriorServiceYears *int
 }
 
 type PatchUserInput struct {
@@ -59,6 +63,9 @@ type PatchUserInput struct {
 	EmployeeCode *string
 	AccessCode   *string
 	IsActive     *bool
+
+	HireDate          *time.Time
+	PriorServiceYears *int
 }
 
 func (s *UsersService) Register(ctx context.Context, username, password, role string) (int, error) {
@@ -71,6 +78,9 @@ func (s *UsersService) Register(ctx context.Context, username, password, role st
 	if role == "" {
 		role = "user"
 	}
+	if err := ensureRoleExists(ctx, s.Client, role); err != nil {
+		return 0, err
+	}
 
 	hash, err := bcrypt.GenerateFromPassword([]byte(password), 12)
 	if err != nil {
@@ -124,6 +134,9 @@ func (s *UsersService) Create(ctx context.Context, in CreateUserInput) (*ent.Use
 	if in.Role == "" {
 		in.Role = "user"
 	}
+	if err := ensureRoleExists(ctx, s.Client, in.Role); err != nil {
+		return nil, err
+	}
 
 	existsUsername, err := s.Client.User.
 		Query().
@@ -183,12 +196,23 @@ func (s *UsersService) Create(ctx context.Context, in CreateUserInput) (*ent.Use
 		create.SetIsActive(*in.IsActive)
 	}
 
+	if in.HireDate != nil {
+		create.SetHireDate(truncateToDay(*in.HireDate))
+	}
+	if in.PriorServiceYears != nil {
+		if *in.PriorServiceYears < 0 {
+			return nil, ErrInvalidInput
+		}
+		create.SetPriorServiceYears(*in.PriorServiceYears)
+	}
+
 	return create.Save(ctx)
 }
 
 func (s *UsersService) List(ctx context.Context) ([]*ent.User, error) {
 	return s.Client.User.
 		Query().
+		Where(userScope(ctx)...).
 		WithUserBranches(func(ubq *ent.UserBranchQuery) {
 			ubq.WithBranch(func(bq *ent.BranchQuery) {
 				bq.WithAccessPoints()
@@ -199,7 +223,47 @@ func (s *UsersService) List(ctx context.Context) ([]*ent.User, error) {
 }
 
 func (s *UsersService) GetByID(ctx context.Context, userID int) (*ent.User, error) {
-	return s.Client.User.Get(ctx, userID)
+	return s.Client.User.
+		Query().
+		Where(user.IDEQ(userID)).
+		Where(userScope(ctx)...).
+		Only(ctx)
+}
+
+// userScope restringe los usuarios al alcance de quien consulta: los que tienen una
+// sucursal activa visible más el propio usuario. Fuera del alcance un usuario se
+// responde como inexistente.
+func userScope(ctx context.Context) []predicate.User {
+	scope := AccessScopeFrom(ctx)
+	if !scope.Restricted() {
+		return nil
+	}
+	return []predicate.User{
+		user.Or(
+			user.IDEQ(scope.UserID),
+			user.HasUserBranchesWith(
+				userbranch.BranchIDIn(scope.BranchIDs()...),
+				userbranch.IsActiveEQ(true),
+			),
+		),
+	}
+}
+
+// userManageScope restringe los usuarios a los que quien consulta puede modificar: los
+// que tienen una sucursal activa donde es supervisor. A diferencia de userScope no
+// incluye al propio usuario (nadie se cambia el rol ni se elimina por fuera de sus
+// sucursales).
+func userManageScope(ctx context.Context) []predicate.User {
+	scope := AccessScopeFrom(ctx)
+	if !scope.Restricted() {
+		return nil
+	}
+	return []predicate.User{
+		user.HasUserBranchesWith(
+			userbranch.BranchIDIn(scope.ManagedBranchIDs()...),
+			userbranch.IsActiveEQ(true),
+		),
+	}
 }
 
 func (s *UsersService) GetByUsername(ctx context.Context, username string) (*ent.User, error) {
@@ -226,7 +290,11 @@ func (s *UsersService) Patch(ctx context.Context, userID int, in PatchUserInput)
 		return nil, ErrInvalidInput
 	}
 
-	u, err := s.Client.User.Get(ctx, userID)
+	u, err := s.Client.User.
+		Query().
+		Where(user.IDEQ(userID)).
+		Where(userManageScope(ctx)...).
+		Only(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -272,6 +340,9 @@ func (s *UsersService) Patch(ctx context.Context, userID int, in PatchUserInput)
 		if v == "" {
 			return nil, ErrInvalidInput
 		}
+		if err := ensureRoleExists(ctx, s.Client, v); err != nil {
+			return nil, err
+		}
 		upd.SetRole(v)
 	}
 
@@ -355,6 +426,16 @@ func (s *UsersService) Patch(ctx context.Context, userID int, in PatchUserInput)
 		upd.SetIsActive(*in.IsActive)
 	}
 
+	if in.HireDate != nil {
+		upd.SetHireDate(truncateToDay(*in.HireDate))
+	}
+	if in.PriorServiceYears != nil {
+		if *in.PriorServiceYears < 0 {
+			return nil, ErrInvalidInput
+		}
+		upd.SetPriorServiceYears(*in.PriorServiceYears)
+	}
+
 	return upd.Save(ctx)
 }
 
@@ -362,6 +443,15 @@ func (s *UsersService) Delete(ctx context.Context, userID int) error {
 	if userID <= 0 {
 		return ErrInvalidInput
 	}
+	if preds := userManageScope(ctx); preds != nil {
+		ok, err := s.Client.User.Query().Where(user.IDEQ(userID)).Where(preds...).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return &ent.NotFoundError{}
+		}
+	}
 
 	tx, err := s.Client.Tx(ctx)
 	if err != nil {
@@ -443,11 +533,13 @@ func (s *UsersService) GetOverviewData(ctx context.Context, userID int) (*ent.Us
 	return s.Client.User.
 		Query().
 		Where(user.IDEQ(userID)).
+		Where(userScope(ctx)...).
 		WithUserBranches(func(uq *ent.UserBranchQuery) {
 			uq.WithBranch()
 		}).
 		WithShiftAssignments(func(sq *ent.UserShiftAssignmentQuery) {
 			sq.WithShift()
+			sq.Order(ent.Desc(usershiftassignment.FieldStartDate), ent.Desc(usershiftassignment.FieldID))
 		}).
 		Only(ctx)
 }
@@ -455,11 +547,13 @@ func (s *UsersService) GetOverviewData(ctx context.Context, userID int) (*ent.Us
 func (s *UsersService) ListOverview(ctx context.Context) ([]*ent.User, error) {
 	return s.Client.User.
 		Query().
+		Where(userScope(ctx)...).
 		WithUserBranches(func(uq *ent.UserBranchQuery) {
 			uq.WithBranch()
 		}).
 		WithShiftAssignments(func(sq *ent.UserShiftAssignmentQuery) {
 			sq.WithShift()
+			sq.Order(ent.Desc(usershiftassignment.FieldStartDate), ent.Desc(usershiftassignment.FieldID))
 		}).
 		Order(ent.Asc(user.FieldFirstName), ent.Asc(user.FieldLastName), ent.Asc(user.FieldUsername)).
 		All(ctx)
@@ -469,6 +563,7 @@ func (s *UsersService) GetUserFullData(ctx context.Context, userID int) (*ent.Us
 	return s.Client.User.
 		Query().
 		Where(user.IDEQ(userID)).
+		Where(userScope(ctx)...).
 		WithAddresses(func(aq *ent.AddressQuery) {
 			aq.WithCommune(func(cq *ent.CommuneQuery) {
 				cq.WithCity(func(cityq *ent.CityQuery) {
@@ -495,6 +590,7 @@ func (s *UsersService) GetUserFullData(ctx context.Context, userID int) (*ent.Us
 			saq.WithShift(func(sq *ent.ShiftQuery) {
 				sq.WithDays()
 			})
+			saq.Order(ent.Desc(usershiftassignment.FieldStartDate), ent.Desc(usershiftassignment.FieldID))
 		}).
 		WithDayOverrides(func(doq *ent.UserDayOverrideQuery) {
 			doq.WithShift(func(sq *ent.ShiftQuery) {
@@ -0,0 +1,483 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"back/internal/ent"
+	"back/internal/ent/leaverequest"
+	"back/internal/ent/userbranch"
+	"back/internal/ent/userdayoverride"
+	"back/internal/ent/usershiftassignment"
+	"back/internal/ent/vacationadjustment"
+)
+
+var (
+	ErrVacationInvalidInput    = errors.New("invalid vacation input")
+	ErrVacationHireDateMissing = errors.New("user has no hire date")
+)
+
+const (
+	VacationEntryAccrual    = "accrual"
+	VacationEntryLeave      = "leave"
+	VacationEntryAdjustment = "adjustment"
+
+	// feriado legal: 15 días hábiles por año => 1,25 por mes trabajado
+	vacationDaysPerYear = 15.0
+
+	// feriado progresivo: 10 años de trabajo (máx. 10 con empleadores anteriores)
+	// y luego 1 día adicional por cada 3 nuevos años
+	progressiveBaseYears     = 10
+	progressiveMaxPriorYears = 10
+	progressiveStepYears     = 3
+)
+
+type VacationService struct {
+	Client *ent.Client
+}
+
+func NewVacationService(client *ent.Client) *VacationService {
+	return &VacationService{Client: client}
+}
+
+// VacationEntry es un movimiento del libro de vacaciones.
+type VacationEntry struct {
+	Date        time.Time
+	Type        string // accrual | leave | adjustment
+	Days        float64
+	Balance     float64
+	Description string
+	ReferenceID *int // leave_request o vacation_adjustment
+}
+
+type VacationBalance struct {
+	UserID            int
+	HireDate          time.Time
+	AsOf              time.Time
+	YearsOfService    int
+	ProgressiveDays   int // días adicionales por año vigentes a la fecha
+	AnnualEntitlement float64
+	Accrued           float64
+	Taken             float64
+	Adjustments       float64
+	Balance           float64
+	// vacaciones aprobadas posteriores a AsOf (aún no descontadas)
+	Scheduled float64
+	Available float64
+}
+
+type VacationLedger struct {
+	UserID         int
+	From           time.Time
+	To             time.Time
+	OpeningBalance float64
+	ClosingBalance float64
+	Entries        []VacationEntry
+}
+
+type CreateVacationAdjustmentInput struct {
+	Date   *time.Time
+	Days   float64
+	Reason string
+}
+
+// Balance calcula el saldo de vacaciones del usuario al día asOf.
+func (s *VacationService) Balance(ctx context.Context, userID int, asOf time.Time) (*VacationBalance, error) {
+	if userID <= 0 {
+		return nil, ErrVacationInvalidInput
+	}
+	asOf = truncateToDay(asOf)
+
+	u, err := s.Client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if u.HireDate == nil {
+		return nil, ErrVacationHireDateMissing
+	}
+	hireDate := truncateToDay(*u.HireDate)
+	prior := 0
+	if u.PriorServiceYears != nil {
+		prior = *u.PriorServiceYears
+	}
+
+	entries, err := s.buildEntries(ctx, userID, hireDate, prior, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &VacationBalance{
+		UserID:   userID,
+		HireDate: hireDate,
+		AsOf:     asOf,
+	}
+	out.YearsOfService = completedYears(hireDate, asOf)
+	out.ProgressiveDays = progressiveVacationDays(prior, out.YearsOfService)
+	out.AnnualEntitlement = vacationDaysPerYear + float64(out.ProgressiveDays)
+
+	for _, e := range entries {
+		if e.Date.After(asOf) {
+			// solo las vacaciones ya aprobadas quedan comprometidas a futuro
+			if e.Type == VacationEntryLeave {
+				out.Scheduled -= e.Days
+			}
+			continue
+		}
+		switch e.Type {
+		case VacationEntryAccrual:
+			out.Accrued += e.Days
+		case VacationEntryLeave:
+			out.Taken -= e.Days
+		case VacationEntryAdjustment:
+			out.Adjustments += e.Days
+		}
+		out.Balance = e.Balance
+	}
+
+	out.Accrued = roundVacationDays(out.Accrued)
+	out.Taken = roundVacationDays(out.Taken)
+	out.Adjustments = roundVacationDays(out.Adjustments)
+	out.Scheduled = roundVacationDays(out.Scheduled)
+	out.Available = roundVacationDays(out.Balance - out.Scheduled)
+
+	return out, nil
+}
+
+// Ledger devuelve los movimientos (devengos, días tomados y ajustes) entre from y to,
+// con el saldo acumulado de cada movimiento. Por defecto cubre desde la fecha de
+// contratación hasta hoy.
+func (s *VacationService) Ledger(ctx context.Context, userID int, from, to *time.Time) (*VacationLedger, error) {
+	if userID <= 0 {
+		return nil, ErrVacationInvalidInput
+	}
+
+	u, err := s.Client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if u.HireDate == nil {
+		return nil, ErrVacationHireDateMissing
+	}
+	hireDate := truncateToDay(*u.HireDate)
+	prior := 0
+	if u.PriorServiceYears != nil {
+		prior = *u.PriorServiceYears
+	}
+
+	end := truncateToDay(time.Now())
+	if to != nil {
+		end = truncateToDay(*to)
+	}
+	start := hireDate
+	if from != nil {
+		start = truncateToDay(*from)
+	}
+	if end.Before(start) {
+		return nil, ErrVacationInvalidInput
+	}
+
+	entries, err := s.buildEntries(ctx, userID, hireDate, prior, end)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &VacationLedger{
+		UserID:  userID,
+		From:    start,
+		To:      end,
+		Entries: make([]VacationEntry, 0, len(entries)),
+	}
+	for _, e := range entries {
+		if e.Date.After(end) {
+			break
+		}
+		if e.Date.Before(start) {
+			out.OpeningBalance = e.Balance
+			continue
+		}
+		out.Entries = append(out.Entries, e)
+	}
+	out.ClosingBalance = out.OpeningBalance
+	if n := len(out.Entries); n > 0 {
+		out.ClosingBalance = out.Entries[n-1].Balance
+	}
+
+	return out, nil
+}
+
+func (s *VacationService) ListAdjustments(ctx context.Context, userID int) ([]*ent.VacationAdjustment, error) {
+	if userID <= 0 {
+		return nil, ErrVacationInvalidInput
+	}
+	return s.Client.VacationAdjustment.Query().
+		Where(vacationadjustment.UserIDEQ(userID)).
+		Order(ent.Desc(vacationadjustment.FieldDate), ent.Desc(vacationadjustment.FieldID)).
+		All(ctx)
+}
+
+// CreateAdjustment registra un ajuste manual al saldo. createdByID es el admin que lo registra.
+func (s *VacationService) CreateAdjustment(ctx context.Context, userID, createdByID int, in CreateVacationAdjustmentInput) (*ent.VacationAdjustment, error) {
+	reason := strings.TrimSpace(in.Reason)
+	if userID <= 0 || reason == "" || in.Days == 0 || math.IsNaN(in.Days) || math.IsInf(in.Days, 0) {
+		return nil, ErrVacationInvalidInput
+	}
+
+	if _, err := s.Client.User.Get(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	date := truncateToDay(time.Now())
+	if in.Date != nil {
+		date = truncateToDay(*in.Date)
+	}
+
+	create := s.Client.VacationAdjustment.Create().
+		SetUserID(userID).
+		SetDate(date).
+		SetDays(roundVacationDays(in.Days)).
+		SetReason(reason)
+
+	if createdByID > 0 {
+		create.SetCreatedByID(createdByID)
+	}
+
+	return create.Save(ctx)
+}
+
+// buildEntries arma el libro completo desde la contratación hasta "until", incluyendo
+// además las vacaciones aprobadas posteriores (para informar días comprometidos).
+func (s *VacationService) buildEntries(ctx context.Context, userID int, hireDate time.Time, priorYears int, until time.Time) ([]VacationEntry, error) {
+	entries := make([]VacationEntry, 0)
+
+	// devengo mensual en cada aniversario mensual de la contratación
+	for m := 1; ; m++ {
+		d := addMonthsClamped(hireDate, m)
+		if d.After(until) {
+			break
+		}
+		years := completedYears(hireDate, d)
+		extra := progressiveVacationDays(priorYears, years)
+		entries = append(entries, VacationEntry{
+			Date:        d,
+			Type:        VacationEntryAccrual,
+			Days:        (vacationDaysPerYear + float64(extra)) / 12,
+			Description: "Devengo mensual",
+		})
+	}
+
+	branchIDs, err := s.Client.UserBranch.Query().
+		Where(userbranch.UserIDEQ(userID), userbranch.IsActiveEQ(true)).
+		Select(userbranch.FieldBranchID).
+		Ints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	leaves, err := s.Client.LeaveRequest.Query().
+		Where(
+			leaverequest.UserIDEQ(userID),
+			leaverequest.TypeEQ(LeaveTypeVacation),
+			leaverequest.StatusEQ(LeaveStatusApproved),
+			leaverequest.EndDateGTE(hireDate),
+		).
+		Order(ent.Asc(leaverequest.FieldStartDate)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rangeEnd := until
+	for _, lr := range leaves {
+		if e := truncateToDay(lr.EndDate); e.After(rangeEnd) {
+			rangeEnd = e
+		}
+	}
+
+	// el turno de cada día (asignaciones y overrides) decide qué días de las vacaciones
+	// eran laborables; solo esos se descuentan
+	src := &calendarSources{overrides: map[string]*ent.UserDayOverride{}, leaves: leaves}
+	if len(leaves) > 0 {
+		first := truncateToDay(leaves[0].StartDate)
+		if first.Before(hireDate) {
+			first = hireDate
+		}
+
+		src.assignments, err = s.Client.UserShiftAssignment.Query().
+			Where(
+				usershiftassignment.UserIDEQ(userID),
+				usershiftassignment.IsActiveEQ(true),
+				usershiftassignment.StartDateLTE(rangeEnd),
+				usershiftassignment.Or(
+					usershiftassignment.EndDateIsNil(),
+					usershiftassignment.EndDateGTE(first),
+				),
+			).
+			WithShift(func(q *ent.ShiftQuery) {
+				q.WithDays().WithCycleDays()
+			}).
+			Order(ent.Desc(usershiftassignment.FieldStartDate), ent.Desc(usershiftassignment.FieldID)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		overrides, err := s.Client.UserDayOverride.Query().
+			Where(
+				userdayoverride.UserIDEQ(userID),
+				userdayoverride.DateGTE(first),
+				userdayoverride.DateLT(rangeEnd.AddDate(0, 0, 1)),
+			).
+			WithShift().
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range overrides {
+			src.overrides[o.Date.Format("2006-01-02")] = o
+		}
+	}
+
+	holidays, err := holidaysByDateForBranches(ctx, s.Client, branchIDs, hireDate, rangeEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	entries = append(entries, vacationTakenEntries(src, branchIDs, holidays, hireDate)...)
+
+	adjustments, err := s.Client.VacationAdjustment.Query().
+		Where(
+			vacationadjustment.UserIDEQ(userID),
+			vacationadjustment.DateLT(until.AddDate(0, 0, 1)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range adjustments {
+		adjID := a.ID
+		entries = append(entries, VacationEntry{
+			Date:        truncateToDay(a.Date),
+			Type:        VacationEntryAdjustment,
+			Days:        a.Days,
+			Description: a.Reason,
+			ReferenceID: &adjID,
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if !entries[i].Date.Equal(entries[j].Date) {
+			return entries[i].Date.Before(entries[j].Date)
+		}
+		return vacationEntryOrder(entries[i].Type) < vacationEntryOrder(entries[j].Type)
+	})
+
+	balance := 0.0
+	for i := range entries {
+		balance += entries[i].Days
+		entries[i].Days = roundVacationDays(entries[i].Days)
+		entries[i].Balance = roundVacationDays(balance)
+	}
+
+	return entries, nil
+}
+
+// vacationTakenEntries descuenta un día por cada día de las vacaciones aprobadas
+// (src.leaves, por fecha de inicio) que el calendario del usuario tenía como laborable:
+// día de trabajo de su turno semanal o rotativo, sin feriado ni día libre (override
+// is_day_off). Un día cubierto por dos solicitudes se descuenta una sola vez.
+func vacationTakenEntries(src *calendarSources, branchIDs []int, holidays map[string]*ent.Holiday, hireDate time.Time) []VacationEntry {
+	entries := make([]VacationEntry, 0)
+	covered := make(map[string]bool)
+	for _, lr := range src.leaves {
+		start := truncateToDay(lr.StartDate)
+		if start.Before(hireDate) {
+			start = hireDate
+		}
+		end := truncateToDay(lr.EndDate)
+		if end.Before(start) {
+			continue
+		}
+
+		leaveID := lr.ID
+		for _, day := range buildCalendar(src, branchIDs, holidays, start, end) {
+			key := day.Date.Format("2006-01-02")
+			if day.Status != CalendarStatusLeave || covered[key] {
+				continue
+			}
+			covered[key] = true
+			entries = append(entries, VacationEntry{
+				Date:        day.Date,
+				Type:        VacationEntryLeave,
+				Days:        -1,
+				Description: "Vacaciones aprobadas",
+				ReferenceID: &leaveID,
+			})
+		}
+	}
+	return entries
+}
+
+// progressiveVacationDays devuelve los días adicionales de feriado progresivo
+// (art. 68 del Código del Trabajo).
+func progressiveVacationDays(priorYears, yearsAtCompany int) int {
+	if priorYears > progressiveMaxPriorYears {
+		priorYears = progressiveMaxPriorYears
+	}
+	if priorYears < 0 {
+		priorYears = 0
+	}
+	over := priorYears + yearsAtCompany - progressiveBaseYears
+	if over <= 0 {
+		return 0
+	}
+	// los años sobre la base deben haberse trabajado en la empresa actual
+	if over > yearsAtCompany {
+		over = yearsAtCompany
+	}
+	return over / progressiveStepYears
+}
+
+// completedYears cuenta los años cumplidos entre from y to.
+func completedYears(from, to time.Time) int {
+	years := to.Year() - from.Year()
+	if years > 0 && addYearsClamped(from, years).After(to) {
+		years--
+	}
+	if years < 0 {
+		return 0
+	}
+	return years
+}
+
+// addMonthsClamped suma meses sin desbordar al mes siguiente (31-ene + 1 mes = 28/29-feb).
+func addMonthsClamped(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).AddDate(0, months, 0)
+	lastDay := first.AddDate(0, 1, -1).Day()
+	day := t.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(first.Year(), first.Month(), day, 0, 0, 0, 0, t.Location())
+}
+
+func addYearsClamped(t time.Time, years int) time.Time {
+	return addMonthsClamped(t, years*12)
+}
+
+func vacationEntryOrder(t string) int {
+	switch t {
+	case VacationEntryAccrual:
+		return 0
+	case VacationEntryAdjustment:
+		return 1
+	}
+	return 2
+}
+
+func roundVacationDays(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,151 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	"back/internal/ent"
+)
+
+func TestProgressiveVacationDays(t *testing.T) {
+	tests := []struct {
+		name           string
+		priorYears     int
+		yearsAtCompany int
+		want           int
+	}{
+		{"sin antigüedad", 0, 0, 0},
+		{"justo en la base", 0, 10, 0},
+		{"primer tramo solo en la empresa", 0, 13, 1},
+		{"un año antes del segundo tramo", 0, 15, 1},
+		{"segundo tramo solo en la empresa", 0, 16, 2},
+		{"base completa con años anteriores", 10, 3, 1},
+		{"base completa sin el tramo trabajado", 10, 2, 0},
+		{"años anteriores topados en 10", 15, 3, 1},
+		{"años anteriores topados con varios tramos", 12, 9, 3},
+		{"los años sobre la base deben ser en la empresa", 8, 4, 0},
+		{"mezcla de años anteriores y empresa", 5, 8, 1},
+		{"años anteriores negativos se ignoran", -3, 13, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := progressiveVacationDays(tt.priorYears, tt.yearsAtCompany)
+			if got != tt.want {
+				t.Errorf("progressiveVacationDays(%d, %d) = %d, want %d",
+					tt.priorYears, tt.yearsAtCompany, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVacationTakenEntries(t *testing.T) {
+	hire := mustDate("2025-01-01")
+
+	// lunes a viernes
+	weekly := &ent.Shift{ID: 1, PatternType: ShiftPatternWeekly}
+	for wd := 1; wd <= 7; wd++ {
+		weekly.Edges.Days = append(weekly.Edges.Days, &ent.ShiftDay{Weekday: wd, IsWorkingDay: wd <= 5, Mode: "onsite"})
+	}
+	// 4x3 desde el jueves 2026-01-01: trabaja jueves a domingo
+	cycle := cycleShift(7, mustDate("2026-01-01"))
+	cycle.ID = 2
+	for i := 1; i <= 7; i++ {
+		cycle.Edges.CycleDays = append(cycle.Edges.CycleDays, &ent.ShiftCycleDay{DayIndex: i, IsWorkingDay: i <= 4, Mode: "onsite"})
+	}
+	assign := func(sh *ent.Shift) []*ent.UserShiftAssignment {
+		a := &ent.UserShiftAssignment{StartDate: hire}
+		a.Edges.Shift = sh
+		return []*ent.UserShiftAssignment{a}
+	}
+	vacation := func(id int, start, end string) *ent.LeaveRequest {
+		return &ent.LeaveRequest{ID: id, Type: LeaveTypeVacation, StartDate: mustDate(start), EndDate: mustDate(end)}
+	}
+
+	tests := []struct {
+		name        string
+		assignments []*ent.UserShiftAssignment
+		overrides   []*ent.UserDayOverride
+		leaves      []*ent.LeaveRequest
+		holidays    []string
+		want        []string
+	}{
+		{
+			name:        "semanal: no se descuenta el fin de semana",
+			assignments: assign(weekly),
+			leaves:      []*ent.LeaveRequest{vacation(1, "2026-01-09", "2026-01-12")},
+			want:        []string{"2026-01-09", "2026-01-12"},
+		},
+		{
+			name:        "ciclo: se descuentan sábado y domingo de trabajo, no los descansos",
+			assignments: assign(cycle),
+			leaves:      []*ent.LeaveRequest{vacation(1, "2026-01-03", "2026-01-08")},
+			want:        []string{"2026-01-03", "2026-01-04", "2026-01-08"},
+		},
+		{
+			name:        "feriado dentro de las vacaciones no se descuenta",
+			assignments: assign(weekly),
+			leaves:      []*ent.LeaveRequest{vacation(1, "2026-05-20", "2026-05-22")},
+			holidays:    []string{"2026-05-21"},
+			want:        []string{"2026-05-20", "2026-05-22"},
+		},
+		{
+			name:        "día libre masivo fuera de vacaciones no descuenta",
+			assignments: assign(weekly),
+			overrides:   []*ent.UserDayOverride{{Date: mustDate("2026-12-24"), IsDayOff: true}},
+			want:        []string{},
+		},
+		{
+			name:        "día libre dentro de vacaciones no se descuenta dos veces ni como vacaciones",
+			assignments: assign(weekly),
+			overrides:   []*ent.UserDayOverride{{Date: mustDate("2026-03-03"), IsDayOff: true}},
+			leaves:      []*ent.LeaveRequest{vacation(1, "2026-03-02", "2026-03-04")},
+			want:        []string{"2026-03-02", "2026-03-04"},
+		},
+		{
+			name:        "solicitudes superpuestas descuentan cada día una vez",
+			assignments: assign(weekly),
+			leaves:      []*ent.LeaveRequest{vacation(1, "2026-03-02", "2026-03-03"), vacation(2, "2026-03-03", "2026-03-04")},
+			want:        []string{"2026-03-02", "2026-03-03", "2026-03-04"},
+		},
+		{
+			name:   "sin turno asignado no hay días laborables",
+			leaves: []*ent.LeaveRequest{vacation(1, "2026-03-02", "2026-03-04")},
+			want:   []string{},
+		},
+		{
+			name:        "días anteriores a la contratación se ignoran",
+			assignments: assign(weekly),
+			leaves:      []*ent.LeaveRequest{vacation(1, "2024-12-30", "2025-01-02")},
+			want:        []string{"2025-01-01", "2025-01-02"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := &calendarSources{
+				assignments: tt.assignments,
+				overrides:   map[string]*ent.UserDayOverride{},
+				leaves:      tt.leaves,
+			}
+			for _, o := range tt.overrides {
+				src.overrides[o.Date.Format("2006-01-02")] = o
+			}
+			holidays := map[string]*ent.Holiday{}
+			for _, h := range tt.holidays {
+				holidays[h] = &ent.Holiday{Date: mustDate(h), Name: "Feriado"}
+			}
+
+			got := []string{}
+			for _, e := range vacationTakenEntries(src, nil, holidays, hire) {
+				if e.Type != VacationEntryLeave || e.Days != -1 {
+					t.Errorf("%s: movimiento %s de %v días", e.Date.Format("2006-01-02"), e.Type, e.Days)
+				}
+				got = append(got, e.Date.Format("2006-01-02"))
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("días descontados = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
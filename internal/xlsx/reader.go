@@ -0,0 +1,237 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidFile = errors.New("xlsx: invalid file")
+
+// ReadFirstSheet lee la primera hoja del libro y devuelve sus filas como texto.
+// Las celdas vacías intermedias se completan con "" y las filas vacías se omiten.
+// Los números (incluidas las fechas) se devuelven tal como están guardados.
+func ReadFirstSheet(r io.ReaderAt, size int64) ([][]string, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, ErrInvalidFile
+	}
+
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	sheetPath, err := firstSheetPath(files)
+	if err != nil {
+		return nil, err
+	}
+
+	var shared []string
+	if f, ok := files["xl/sharedStrings.xml"]; ok {
+		if shared, err = readSharedStrings(f); err != nil {
+			return nil, err
+		}
+	}
+
+	f, ok := files[sheetPath]
+	if !ok {
+		return nil, ErrInvalidFile
+	}
+	return readSheet(f, shared)
+}
+
+// firstSheetPath resuelve la ruta de la primera hoja vía workbook.xml y sus relaciones.
+func firstSheetPath(files map[string]*zip.File) (string, error) {
+	var wb struct {
+		Sheets []struct {
+			RID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	if err := decodeXML(files["xl/workbook.xml"], &wb); err != nil || len(wb.Sheets) == 0 {
+		return "", ErrInvalidFile
+	}
+
+	var rels struct {
+		Rels []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+	if err := decodeXML(files["xl/_rels/workbook.xml.rels"], &rels); err != nil {
+		return "", ErrInvalidFile
+	}
+
+	for _, rel := range rels.Rels {
+		if rel.ID != wb.Sheets[0].RID {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+	return "", ErrInvalidFile
+}
+
+func readSharedStrings(f *zip.File) ([]string, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var out []string
+	var cur strings.Builder
+	inItem, inText := false, false
+
+	dec := xml.NewDecoder(rc)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return out, nil
+		}
+		if err != nil {
+			return nil, ErrInvalidFile
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "si":
+				inItem = true
+				cur.Reset()
+			case "t":
+				inText = inItem
+			case "rPh":
+				// texto fonético (japonés): no forma parte del valor
+				inItem = false
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "si":
+				out = append(out, cur.String())
+				inItem = false
+			case "t":
+				inText = false
+			case "rPh":
+				inItem = true
+			}
+		case xml.CharData:
+			if inText {
+				cur.Write(t)
+			}
+		}
+	}
+}
+
+func readSheet(f *zip.File, shared []string) ([][]string, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var rows [][]string
+	var row []string
+	var cellType, cellRef string
+	var value strings.Builder
+	inValue := false
+
+	dec := xml.NewDecoder(rc)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, ErrInvalidFile
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "row":
+				row = nil
+			case "c":
+				cellType, cellRef = "", ""
+				for _, a := range t.Attr {
+					switch a.Name.Local {
+					case "t":
+						cellType = a.Value
+					case "r":
+						cellRef = a.Value
+					}
+				}
+				value.Reset()
+			case "v", "t":
+				inValue = true
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "v", "t":
+				inValue = false
+			case "c":
+				v := value.String()
+				if cellType == "s" {
+					idx, err := strconv.Atoi(strings.TrimSpace(v))
+					if err != nil || idx < 0 || idx >= len(shared) {
+						return nil, ErrInvalidFile
+					}
+					v = shared[idx]
+				}
+				col := len(row)
+				if cellRef != "" {
+					col = columnIndex(cellRef)
+				}
+				for len(row) < col {
+					row = append(row, "")
+				}
+				row = append(row, v)
+			case "row":
+				if !emptyRow(row) {
+					rows = append(rows, row)
+				}
+			}
+		case xml.CharData:
+			if inValue {
+				value.Write(t)
+			}
+		}
+	}
+}
+
+// columnIndex convierte la referencia de celda ("C12") al índice de columna (2).
+func columnIndex(ref string) int {
+	n := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		n = n*26 + int(r-'A'+1)
+	}
+	return n - 1
+}
+
+func emptyRow(row []string) bool {
+	for _, v := range row {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func decodeXML(f *zip.File, v any) error {
+	if f == nil {
+		return ErrInvalidFile
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	return xml.NewDecoder(rc).Decode(v)
+}
@@ -0,0 +1,347 @@
+// Package xlsx escribe planillas Excel (.xlsx) en streaming sin dependencias externas.
+// Las hojas se escriben una tras otra directamente sobre el io.Writer de salida, por lo
+// que el consumo de memoria no depende de la cantidad de filas. También lee la primera
+// hoja de un libro (importaciones).
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ContentType es el MIME type de los archivos .xlsx.
+const ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+const (
+	styleDefault  = 0
+	styleHeader   = 1
+	styleDateTime = 2
+	styleDate     = 3
+)
+
+var ErrClosed = errors.New("xlsx: writer closed")
+
+// Writer genera un libro con una o más hojas.
+type Writer struct {
+	zw     *zip.Writer
+	sheet  *bufio.Writer
+	sheets []string
+	row    int
+	closed bool
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{zw: zip.NewWriter(w)}
+}
+
+// AddSheet cierra la hoja actual (si existe) y comienza una nueva.
+func (x *Writer) AddSheet(name string) error {
+	if x.closed {
+		return ErrClosed
+	}
+	if err := x.endSheet(); err != nil {
+		return err
+	}
+
+	x.sheets = append(x.sheets, x.uniqueSheetName(name))
+	f, err := x.zw.Create(fmt.Sprintf("xl/worksheets/sheet%d.xml", len(x.sheets)))
+	if err != nil {
+		return err
+	}
+	x.sheet = bufio.NewWriter(f)
+	x.row = 0
+
+	_, err = x.sheet.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	return err
+}
+
+// WriteHeader escribe una fila en negrita.
+func (x *Writer) WriteHeader(cells ...string) error {
+	values := make([]any, len(cells))
+	for i, c := range cells {
+		values[i] = c
+	}
+	return x.writeRow(values, styleHeader)
+}
+
+// WriteRow escribe una fila. Acepta string, enteros, float64, bool, time.Time y
+// sus punteros (nil = celda vacía).
+func (x *Writer) WriteRow(cells ...any) error {
+	return x.writeRow(cells, styleDefault)
+}
+
+// Close cierra la última hoja y escribe las partes del libro.
+func (x *Writer) Close() error {
+	if x.closed {
+		return nil
+	}
+	if len(x.sheets) == 0 {
+		if err := x.AddSheet("Hoja1"); err != nil {
+			return err
+		}
+	}
+	if err := x.endSheet(); err != nil {
+		return err
+	}
+	x.closed = true
+
+	var (
+		overrides strings.Builder
+		sheets    strings.Builder
+		rels      strings.Builder
+	)
+	for i, name := range x.sheets {
+		n := i + 1
+		fmt.Fprintf(&overrides, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, n)
+		fmt.Fprintf(&sheets, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, escape(name), n, n)
+		fmt.Fprintf(&rels, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, n, n)
+	}
+	stylesRel := len(x.sheets) + 1
+
+	parts := []struct{ name, body string }{
+		{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+			`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+			`<Default Extension="xml" ContentType="application/xml"/>` +
+			`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+			overrides.String() +
+			`</Types>`},
+		{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+			`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+			`</Relationships>`},
+		{"xl/workbook.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+			`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets>` + sheets.String() + `</sheets></workbook>`},
+		{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+			`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			rels.String() +
+			fmt.Sprintf(`<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, stylesRel) +
+			`</Relationships>`},
+		{"xl/styles.xml", stylesXML},
+	}
+	for _, p := range parts {
+		f, err := x.zw.Create(p.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, p.body); err != nil {
+			return err
+		}
+	}
+
+	return x.zw.Close()
+}
+
+/* =========================
+   INTERNAL
+   ========================= */
+
+func (x *Writer) writeRow(cells []any, style int) error {
+	if x.closed {
+		return ErrClosed
+	}
+	if x.sheet == nil {
+		if err := x.AddSheet("Hoja1"); err != nil {
+			return err
+		}
+	}
+
+	x.row++
+	var b strings.Builder
+	fmt.Fprintf(&b, `<row r="%d">`, x.row)
+	for i, v := range cells {
+		writeCell(&b, columnName(i)+strconv.Itoa(x.row), v, style)
+	}
+	b.WriteString(`</row>`)
+
+	_, err := x.sheet.WriteString(b.String())
+	return err
+}
+
+func (x *Writer) endSheet() error {
+	if x.sheet == nil {
+		return nil
+	}
+	if _, err := x.sheet.WriteString(`</sheetData></worksheet>`); err != nil {
+		return err
+	}
+	err := x.sheet.Flush()
+	x.sheet = nil
+	return err
+}
+
+// uniqueSheetName aplica las restricciones de Excel: máx. 31 caracteres, sin : \ / ? * [ ]
+// y sin repetir nombres.
+func (x *Writer) uniqueSheetName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`:\/?*[]`, r) {
+			return '-'
+		}
+		return r
+	}, strings.TrimSpace(name))
+	if name == "" {
+		name = fmt.Sprintf("Hoja%d", len(x.sheets)+1)
+	}
+
+	base := truncateRunes(name, 31)
+	candidate := base
+	for i := 2; x.hasSheet(candidate); i++ {
+		suffix := fmt.Sprintf(" (%d)", i)
+		candidate = truncateRunes(base, 31-len(suffix)) + suffix
+	}
+	return candidate
+}
+
+func (x *Writer) hasSheet(name string) bool {
+	for _, s := range x.sheets {
+		if strings.EqualFold(s, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func writeCell(b *strings.Builder, ref string, v any, style int) {
+	styleAttr := ""
+	if style != styleDefault {
+		styleAttr = fmt.Sprintf(` s="%d"`, style)
+	}
+
+	number := func(s string) {
+		fmt.Fprintf(b, `<c r="%s"%s><v>%s</v></c>`, ref, styleAttr, s)
+	}
+	text := func(s string) {
+		fmt.Fprintf(b, `<c r="%s" t="inlineStr"%s><is><t xml:space="preserve">%s</t></is></c>`, ref, styleAttr, escape(s))
+	}
+	datetime := func(t time.Time, st int) {
+		if style == styleDefault {
+			styleAttr = fmt.Sprintf(` s="%d"`, st)
+		}
+		number(strconv.FormatFloat(excelSerial(t), 'f', -1, 64))
+	}
+
+	switch val := v.(type) {
+	case nil:
+		return
+	case string:
+		if val == "" {
+			return
+		}
+		text(val)
+	case *string:
+		if val != nil && *val != "" {
+			text(*val)
+		}
+	case int:
+		number(strconv.Itoa(val))
+	case *int:
+		if val != nil {
+			number(strconv.Itoa(*val))
+		}
+	case int64:
+		number(strconv.FormatInt(val, 10))
+	case float64:
+		number(strconv.FormatFloat(val, 'f', -1, 64))
+	case *float64:
+		if val != nil {
+			number(strconv.FormatFloat(*val, 'f', -1, 64))
+		}
+	case bool:
+		flag := "0"
+		if val {
+			flag = "1"
+		}
+		fmt.Fprintf(b, `<c r="%s" t="b"%s><v>%s</v></c>`, ref, styleAttr, flag)
+	case time.Time:
+		if !val.IsZero() {
+			datetime(val, styleDateTime)
+		}
+	case *time.Time:
+		if val != nil && !val.IsZero() {
+			datetime(*val, styleDateTime)
+		}
+	case Date:
+		if !time.Time(val).IsZero() {
+			datetime(time.Time(val), styleDate)
+		}
+	default:
+		text(fmt.Sprint(val))
+	}
+}
+
+// Date marca un time.Time para escribirse solo como fecha (sin hora).
+type Date time.Time
+
+// excelSerial convierte una fecha al número de serie de Excel (días desde 1899-12-30).
+func excelSerial(t time.Time) float64 {
+	epoch := time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+	local := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+	return local.Sub(epoch).Hours() / 24
+}
+
+// columnName convierte un índice (0 = A) a la letra de columna de Excel.
+func columnName(i int) string {
+	name := ""
+	for i >= 0 {
+		name = string(rune('A'+i%26)) + name
+		i = i/26 - 1
+	}
+	return name
+}
+
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
+
+// escape escapa texto para XML y descarta caracteres de control no válidos.
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch r {
+		case '&':
+			b.WriteString("&amp;")
+		case '<':
+			b.WriteString("&lt;")
+		case '>':
+			b.WriteString("&gt;")
+		case '"':
+			b.WriteString("&quot;")
+		case '\t', '\n', '\r':
+			b.WriteRune(r)
+		default:
+			if r < 0x20 || r == 0xFFFE || r == 0xFFFF {
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+const stylesXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+	`<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy-mm-dd hh:mm"/></numFmts>` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="4">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="14" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`</cellXfs>` +
+	`</styleSheet>`